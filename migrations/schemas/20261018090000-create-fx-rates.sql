@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS fx_rates (
+    id              UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at      TIMESTAMP(6),
+    created_at      TIMESTAMP(6) DEFAULT (now()),
+    updated_at      TIMESTAMP(6) DEFAULT (now()),
+
+    date            DATE NOT NULL,
+    source_currency TEXT NOT NULL,
+    target_currency TEXT NOT NULL,
+    rate            DECIMAL NOT NULL,
+    source          TEXT NOT NULL,
+    note            TEXT,
+    created_by      UUID,
+
+    CONSTRAINT fx_rates_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id),
+    UNIQUE (date, source_currency, target_currency, source)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fx_rates_pair_date
+ON fx_rates(source_currency, target_currency, date DESC);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_fx_rates_pair_date;
+DROP TABLE IF EXISTS fx_rates;
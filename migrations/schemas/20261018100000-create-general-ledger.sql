@@ -0,0 +1,89 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+    id          UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at  TIMESTAMP(6),
+    created_at  TIMESTAMP(6) DEFAULT (now()),
+    updated_at  TIMESTAMP(6) DEFAULT (now()),
+
+    code        TEXT NOT NULL UNIQUE,
+    name        TEXT NOT NULL,
+    type        TEXT NOT NULL,
+    description TEXT,
+    is_active   BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id                        UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at                TIMESTAMP(6),
+    created_at                TIMESTAMP(6) DEFAULT (now()),
+    updated_at                TIMESTAMP(6) DEFAULT (now()),
+
+    date                      DATE NOT NULL,
+    description               TEXT NOT NULL,
+    source_type               TEXT NOT NULL,
+    source_id                 TEXT,
+    accounting_transaction_id UUID,
+    reversal_of_id            UUID,
+    created_by                UUID,
+
+    CONSTRAINT journal_entries_accounting_transaction_id_fkey FOREIGN KEY (accounting_transaction_id) REFERENCES accounting_transactions (id),
+    CONSTRAINT journal_entries_reversal_of_id_fkey FOREIGN KEY (reversal_of_id) REFERENCES journal_entries (id),
+    CONSTRAINT journal_entries_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_journal_entries_source
+ON journal_entries(source_type, source_id)
+WHERE source_id IS NOT NULL AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_date ON journal_entries(date);
+CREATE INDEX IF NOT EXISTS idx_journal_entries_accounting_transaction_id ON journal_entries(accounting_transaction_id);
+
+CREATE TABLE IF NOT EXISTS journal_lines (
+    id               UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at       TIMESTAMP(6),
+    created_at       TIMESTAMP(6) DEFAULT (now()),
+    updated_at       TIMESTAMP(6) DEFAULT (now()),
+
+    journal_entry_id UUID NOT NULL,
+    account_id       UUID NOT NULL,
+    debit            BIGINT NOT NULL DEFAULT 0,
+    credit           BIGINT NOT NULL DEFAULT 0,
+    currency         TEXT,
+    original_amount  DECIMAL,
+    memo             TEXT,
+
+    CONSTRAINT journal_lines_journal_entry_id_fkey FOREIGN KEY (journal_entry_id) REFERENCES journal_entries (id),
+    CONSTRAINT journal_lines_account_id_fkey FOREIGN KEY (account_id) REFERENCES ledger_accounts (id),
+    CONSTRAINT journal_lines_one_side CHECK ((debit > 0 AND credit = 0) OR (credit > 0 AND debit = 0))
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_lines_journal_entry_id ON journal_lines(journal_entry_id);
+CREATE INDEX IF NOT EXISTS idx_journal_lines_account_id ON journal_lines(account_id);
+
+INSERT INTO ledger_accounts (code, name, type, description) VALUES
+('1000', 'Cash and Bank', 'asset', 'Company bank accounts'),
+('1100', 'Accounts Receivable', 'asset', 'Invoices sent and not paid yet'),
+('1200', 'ICY Treasury', 'asset', 'ICY held in the company vaults'),
+('1500', 'Fixed Assets', 'asset', 'Equipment and other long-lived assets'),
+('2000', 'Accounts Payable', 'liability', 'Bills to pay'),
+('2100', 'Inbound Fund', 'liability', 'Commissions kept in the inbound fund'),
+('3000', 'Owner Equity', 'equity', 'Capital and retained earnings'),
+('4000', 'Service Revenue', 'income', 'Revenue from client invoices'),
+('4900', 'Other Income', 'income', 'Income not coming from invoices'),
+('5000', 'Payroll Expense', 'expense', 'Salaries'),
+('5100', 'Commission Expense', 'expense', 'Commissions and bonuses'),
+('5200', 'ICY Reward Expense', 'expense', 'ICY rewarded to the team'),
+('5300', 'Office Supply Expense', 'expense', 'Office supplies and reimbursed expenses'),
+('5400', 'Office Services Expense', 'expense', 'Office services'),
+('5500', 'Office Space Expense', 'expense', 'Office rent'),
+('5600', 'Tools Expense', 'expense', 'Software and tools'),
+('5900', 'Other Operating Expense', 'expense', 'Operating expenses not classified elsewhere')
+ON CONFLICT (code) DO NOTHING;
+
+-- +migrate Down
+DROP TABLE IF EXISTS journal_lines;
+DROP INDEX IF EXISTS idx_journal_entries_accounting_transaction_id;
+DROP INDEX IF EXISTS idx_journal_entries_date;
+DROP INDEX IF EXISTS uidx_journal_entries_source;
+DROP TABLE IF EXISTS journal_entries;
+DROP TABLE IF EXISTS ledger_accounts;
@@ -0,0 +1,60 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS bank_statements (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6) DEFAULT (now()),
+    updated_at        TIMESTAMP(6) DEFAULT (now()),
+
+    file_name         TEXT NOT NULL,
+    format            TEXT NOT NULL,
+    account_number    TEXT,
+    currency          TEXT,
+    period_start      DATE,
+    period_end        DATE,
+    transaction_count INTEGER NOT NULL DEFAULT 0,
+    imported_by       UUID,
+
+    CONSTRAINT bank_statements_imported_by_fkey FOREIGN KEY (imported_by) REFERENCES employees (id)
+);
+
+CREATE TABLE IF NOT EXISTS bank_transactions (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6) DEFAULT (now()),
+    updated_at        TIMESTAMP(6) DEFAULT (now()),
+
+    bank_statement_id UUID NOT NULL,
+    fingerprint       TEXT NOT NULL,
+    external_id       TEXT,
+    booked_at         DATE NOT NULL,
+    direction         TEXT NOT NULL,
+    amount            DECIMAL NOT NULL,
+    currency          TEXT,
+    counterparty      TEXT,
+    memo              TEXT,
+    reference         TEXT,
+
+    status            TEXT NOT NULL DEFAULT 'unmatched',
+    match_type        TEXT,
+    match_id          TEXT,
+    match_reference   TEXT,
+    match_score       DECIMAL,
+    match_note        TEXT,
+    matched_at        TIMESTAMP(6),
+    matched_by        UUID,
+
+    CONSTRAINT bank_transactions_bank_statement_id_fkey FOREIGN KEY (bank_statement_id) REFERENCES bank_statements (id),
+    CONSTRAINT bank_transactions_matched_by_fkey FOREIGN KEY (matched_by) REFERENCES employees (id)
+);
+
+-- the same transaction exported in two overlapping statements is only imported once
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_bank_transactions_fingerprint
+ON bank_transactions(fingerprint)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_bank_transactions_status ON bank_transactions(status);
+CREATE INDEX IF NOT EXISTS idx_bank_transactions_bank_statement_id ON bank_transactions(bank_statement_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS bank_transactions;
+DROP TABLE IF EXISTS bank_statements;
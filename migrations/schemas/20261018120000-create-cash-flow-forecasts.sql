@@ -0,0 +1,22 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS cash_flow_forecasts (
+    id         UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at TIMESTAMP(6),
+    created_at TIMESTAMP(6) DEFAULT (now()),
+    updated_at TIMESTAMP(6) DEFAULT (now()),
+
+    horizon    TEXT NOT NULL,
+    start_date DATE NOT NULL,
+    currency   TEXT NOT NULL,
+    scenario   JSONB,
+    periods    JSONB NOT NULL,
+    warnings   JSONB,
+    created_by UUID,
+
+    CONSTRAINT cash_flow_forecasts_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS cash_flow_forecasts_horizon_start_date_idx ON cash_flow_forecasts (horizon, start_date);
+
+-- +migrate Down
+DROP TABLE IF EXISTS cash_flow_forecasts;
@@ -0,0 +1,120 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS candidates (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6) DEFAULT (now()),
+    updated_at         TIMESTAMP(6) DEFAULT (now()),
+
+    name               TEXT,
+    email              TEXT,
+    detail             TEXT,
+    cv_url             TEXT,
+    role               TEXT,
+    source             TEXT,
+    type               TEXT,
+    status             TEXT,
+    phone              TEXT,
+    "CCAT"             INT4 DEFAULT 0,
+    "EPP"              INT4 DEFAULT 0,
+    is_referral        BOOLEAN DEFAULT FALSE,
+    referral_info      JSONB,
+    basecamp_todo_id   INT4,
+    offer_salary       INT4,
+    offer_start_date   DATE,
+    probation_duration INT4,
+    is_email_sent      BOOLEAN DEFAULT FALSE,
+    onboard_todo_id    INT4
+);
+
+CREATE TABLE IF NOT EXISTS recruitment_stages (
+    id               UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at       TIMESTAMP(6),
+    created_at       TIMESTAMP(6) DEFAULT (now()),
+    updated_at       TIMESTAMP(6) DEFAULT (now()),
+
+    position_id      UUID,
+    code             TEXT NOT NULL,
+    name             TEXT NOT NULL,
+    sort_order       INT4 NOT NULL DEFAULT 0,
+    candidate_status TEXT NOT NULL,
+    email_template   TEXT,
+    is_terminal      BOOLEAN NOT NULL DEFAULT FALSE,
+
+    CONSTRAINT recruitment_stages_position_id_fkey FOREIGN KEY (position_id) REFERENCES positions (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS recruitment_stages_position_id_code_idx
+    ON recruitment_stages (COALESCE(position_id, '00000000-0000-0000-0000-000000000000'::UUID), code)
+    WHERE deleted_at IS NULL;
+
+-- the default pipeline, used by the positions without their own stages
+INSERT INTO recruitment_stages (code, name, sort_order, candidate_status, email_template, is_terminal) VALUES
+    ('applied', 'Applied', 1, 'approach', 'candidate_inform.tpl', FALSE),
+    ('screening', 'Screening', 2, 'approach', NULL, FALSE),
+    ('assessment', 'Assessment', 3, 'approach', NULL, FALSE),
+    ('interview', 'Interview', 4, 'approach', NULL, FALSE),
+    ('offered', 'Offered', 5, 'offered', 'hiring_offered.tpl', FALSE),
+    ('hired', 'Hired', 6, 'hired', NULL, TRUE),
+    ('failed', 'Failed', 7, 'failed', 'hiring_failed.tpl', TRUE),
+    ('rejected', 'Offer Rejected', 8, 'reject', NULL, TRUE);
+
+ALTER TABLE candidates ADD COLUMN IF NOT EXISTS position_id UUID REFERENCES positions (id);
+ALTER TABLE candidates ADD COLUMN IF NOT EXISTS stage_id UUID REFERENCES recruitment_stages (id);
+ALTER TABLE candidates ADD COLUMN IF NOT EXISTS employee_id UUID REFERENCES employees (id);
+
+CREATE INDEX IF NOT EXISTS candidates_stage_id_idx ON candidates (stage_id);
+
+CREATE TABLE IF NOT EXISTS candidate_stage_transitions (
+    id             UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at     TIMESTAMP(6),
+    created_at     TIMESTAMP(6) DEFAULT (now()),
+    updated_at     TIMESTAMP(6) DEFAULT (now()),
+
+    candidate_id   UUID NOT NULL,
+    from_stage_id  UUID,
+    to_stage_id    UUID NOT NULL,
+    note           TEXT,
+    actor_id       UUID,
+    email_template TEXT,
+    email_sent_at  TIMESTAMP(6),
+    email_error    TEXT,
+
+    CONSTRAINT candidate_stage_transitions_candidate_id_fkey FOREIGN KEY (candidate_id) REFERENCES candidates (id),
+    CONSTRAINT candidate_stage_transitions_from_stage_id_fkey FOREIGN KEY (from_stage_id) REFERENCES recruitment_stages (id),
+    CONSTRAINT candidate_stage_transitions_to_stage_id_fkey FOREIGN KEY (to_stage_id) REFERENCES recruitment_stages (id),
+    CONSTRAINT candidate_stage_transitions_actor_id_fkey FOREIGN KEY (actor_id) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS candidate_stage_transitions_candidate_id_idx ON candidate_stage_transitions (candidate_id);
+
+CREATE TABLE IF NOT EXISTS candidate_interviews (
+    id             UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at     TIMESTAMP(6),
+    created_at     TIMESTAMP(6) DEFAULT (now()),
+    updated_at     TIMESTAMP(6) DEFAULT (now()),
+
+    candidate_id   UUID NOT NULL,
+    stage_id       UUID,
+    interviewer_id UUID NOT NULL,
+    scheduled_at   TIMESTAMP(6),
+    scores         JSONB,
+    overall_score  DECIMAL,
+    recommendation TEXT,
+    feedback       TEXT,
+    submitted_at   TIMESTAMP(6),
+
+    CONSTRAINT candidate_interviews_candidate_id_fkey FOREIGN KEY (candidate_id) REFERENCES candidates (id),
+    CONSTRAINT candidate_interviews_stage_id_fkey FOREIGN KEY (stage_id) REFERENCES recruitment_stages (id),
+    CONSTRAINT candidate_interviews_interviewer_id_fkey FOREIGN KEY (interviewer_id) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS candidate_interviews_candidate_id_idx ON candidate_interviews (candidate_id);
+CREATE INDEX IF NOT EXISTS candidate_interviews_interviewer_id_idx ON candidate_interviews (interviewer_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS candidate_interviews;
+DROP TABLE IF EXISTS candidate_stage_transitions;
+ALTER TABLE candidates DROP COLUMN IF EXISTS employee_id;
+ALTER TABLE candidates DROP COLUMN IF EXISTS stage_id;
+ALTER TABLE candidates DROP COLUMN IF EXISTS position_id;
+DROP TABLE IF EXISTS recruitment_stages;
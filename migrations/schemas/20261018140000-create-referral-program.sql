@@ -0,0 +1,74 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS referral_bonus_milestones (
+    id            UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at    TIMESTAMP(6),
+    created_at    TIMESTAMP(6) DEFAULT (now()),
+    updated_at    TIMESTAMP(6) DEFAULT (now()),
+
+    code          TEXT NOT NULL,
+    name          TEXT NOT NULL,
+    trigger       TEXT NOT NULL,
+    tenure_months INT4 NOT NULL DEFAULT 0,
+    amount        INT8 NOT NULL DEFAULT 0,
+    is_active     BOOLEAN NOT NULL DEFAULT TRUE,
+    sort_order    INT4 NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS referral_bonus_milestones_code_idx ON referral_bonus_milestones (code) WHERE deleted_at IS NULL;
+
+INSERT INTO referral_bonus_milestones (code, name, trigger, tenure_months, amount, sort_order) VALUES
+    ('probation-passed', 'Passed probation', 'probation', 0, 5000000, 1),
+    ('six-month-tenure', '6 months tenure', 'tenure', 6, 5000000, 2);
+
+CREATE TABLE IF NOT EXISTS referrals (
+    id                  UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at          TIMESTAMP(6),
+    created_at          TIMESTAMP(6) DEFAULT (now()),
+    updated_at          TIMESTAMP(6) DEFAULT (now()),
+
+    referrer_id         UUID NOT NULL,
+    candidate_id        UUID,
+    employee_id         UUID,
+    candidate_name      TEXT,
+    candidate_email     TEXT,
+    role                TEXT,
+    status              TEXT NOT NULL,
+    hired_at            DATE,
+    probation_passed_at DATE,
+    left_at             DATE,
+
+    CONSTRAINT referrals_referrer_id_fkey FOREIGN KEY (referrer_id) REFERENCES employees (id),
+    CONSTRAINT referrals_candidate_id_fkey FOREIGN KEY (candidate_id) REFERENCES candidates (id),
+    CONSTRAINT referrals_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS referrals_candidate_id_idx ON referrals (candidate_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS referrals_referrer_id_idx ON referrals (referrer_id);
+
+CREATE TABLE IF NOT EXISTS referral_bonuses (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6) DEFAULT (now()),
+    updated_at        TIMESTAMP(6) DEFAULT (now()),
+
+    referral_id       UUID NOT NULL,
+    milestone_id      UUID NOT NULL,
+    referrer_id       UUID NOT NULL,
+    amount            INT8 NOT NULL,
+    status            TEXT NOT NULL,
+    reached_at        DATE NOT NULL,
+    employee_bonus_id UUID,
+    paid_at           TIMESTAMP(6),
+
+    CONSTRAINT referral_bonuses_referral_id_fkey FOREIGN KEY (referral_id) REFERENCES referrals (id),
+    CONSTRAINT referral_bonuses_milestone_id_fkey FOREIGN KEY (milestone_id) REFERENCES referral_bonus_milestones (id),
+    CONSTRAINT referral_bonuses_referrer_id_fkey FOREIGN KEY (referrer_id) REFERENCES employees (id),
+    CONSTRAINT referral_bonuses_employee_bonus_id_fkey FOREIGN KEY (employee_bonus_id) REFERENCES employee_bonuses (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS referral_bonuses_referral_id_milestone_id_idx ON referral_bonuses (referral_id, milestone_id) WHERE deleted_at IS NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS referral_bonuses;
+DROP TABLE IF EXISTS referrals;
+DROP TABLE IF EXISTS referral_bonus_milestones;
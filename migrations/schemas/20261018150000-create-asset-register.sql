@@ -0,0 +1,87 @@
+-- +migrate Up
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP(6) DEFAULT (now());
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS serial_number TEXT;
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS category TEXT NOT NULL DEFAULT 'other';
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'in-stock';
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS useful_life_months INT4 NOT NULL DEFAULT 0;
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS salvage_value INT8 NOT NULL DEFAULT 0;
+ALTER TABLE assets ADD COLUMN IF NOT EXISTS expense_id UUID;
+ALTER TABLE assets ADD CONSTRAINT assets_expense_id_fkey FOREIGN KEY (expense_id) REFERENCES expenses (id);
+
+-- the holder is kept when the employee record is removed, the assignment history tells it
+ALTER TABLE assets DROP CONSTRAINT IF EXISTS assets_used_by_fkey;
+ALTER TABLE assets ADD CONSTRAINT assets_used_by_fkey FOREIGN KEY (used_by) REFERENCES employees (id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS assets_serial_number_idx ON assets (serial_number) WHERE deleted_at IS NULL AND serial_number IS NOT NULL AND serial_number <> '';
+
+CREATE TABLE IF NOT EXISTS asset_assignments (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6) DEFAULT (now()),
+    updated_at         TIMESTAMP(6) DEFAULT (now()),
+
+    asset_id           UUID NOT NULL,
+    employee_id        UUID NOT NULL,
+    assigned_at        DATE NOT NULL,
+    assigned_condition TEXT,
+    assigned_by        UUID,
+    returned_at        DATE,
+    returned_condition TEXT,
+    received_by        UUID,
+    note               TEXT,
+    CONSTRAINT asset_assignments_asset_id_fkey FOREIGN KEY (asset_id) REFERENCES assets (id),
+    CONSTRAINT asset_assignments_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id),
+    CONSTRAINT asset_assignments_assigned_by_fkey FOREIGN KEY (assigned_by) REFERENCES employees (id),
+    CONSTRAINT asset_assignments_received_by_fkey FOREIGN KEY (received_by) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS asset_assignments_asset_id_idx ON asset_assignments (asset_id);
+CREATE INDEX IF NOT EXISTS asset_assignments_employee_id_idx ON asset_assignments (employee_id);
+-- an asset is held by one employee at a time
+CREATE UNIQUE INDEX IF NOT EXISTS asset_assignments_open_idx ON asset_assignments (asset_id) WHERE returned_at IS NULL AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS asset_depreciations (
+    id                        UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at                TIMESTAMP(6),
+    created_at                TIMESTAMP(6) DEFAULT (now()),
+    updated_at                TIMESTAMP(6) DEFAULT (now()),
+
+    asset_id                  UUID NOT NULL,
+    month                     INT4 NOT NULL,
+    year                      INT4 NOT NULL,
+    amount                    INT8 NOT NULL,
+    accumulated               INT8 NOT NULL,
+    book_value                INT8 NOT NULL,
+    currency                  TEXT NOT NULL,
+    accounting_transaction_id UUID,
+    journal_entry_id          UUID,
+    CONSTRAINT asset_depreciations_asset_id_fkey FOREIGN KEY (asset_id) REFERENCES assets (id),
+    CONSTRAINT asset_depreciations_accounting_transaction_id_fkey FOREIGN KEY (accounting_transaction_id) REFERENCES accounting_transactions (id),
+    CONSTRAINT asset_depreciations_journal_entry_id_fkey FOREIGN KEY (journal_entry_id) REFERENCES journal_entries (id),
+    CONSTRAINT asset_depreciations_period_key UNIQUE (asset_id, year, month)
+);
+
+INSERT INTO ledger_accounts (code, name, type, description) VALUES
+('1590', 'Accumulated Depreciation', 'asset', 'Depreciation booked against the fixed assets'),
+('5700', 'Depreciation Expense', 'expense', 'Monthly depreciation of the fixed assets')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO discord_log_templates (id, type, content) VALUES
+('6f1d7a52-3c0e-4e8b-9a57-0b6b2c1f4d83', 'employee_unreturned_assets', '{{ updated_employee_id }} left without returning {{ asset_count }} asset(s): {{ assets }}.');
+
+-- +migrate Down
+DELETE FROM discord_log_templates WHERE type = 'employee_unreturned_assets';
+DELETE FROM ledger_accounts WHERE code IN ('1590', '5700');
+DROP TABLE IF EXISTS asset_depreciations;
+DROP TABLE IF EXISTS asset_assignments;
+DROP INDEX IF EXISTS assets_serial_number_idx;
+ALTER TABLE assets DROP CONSTRAINT IF EXISTS assets_used_by_fkey;
+ALTER TABLE assets ADD CONSTRAINT assets_used_by_fkey FOREIGN KEY (used_by) REFERENCES employees (id) ON DELETE CASCADE;
+ALTER TABLE assets DROP CONSTRAINT IF EXISTS assets_expense_id_fkey;
+ALTER TABLE assets DROP COLUMN IF EXISTS expense_id;
+ALTER TABLE assets DROP COLUMN IF EXISTS salvage_value;
+ALTER TABLE assets DROP COLUMN IF EXISTS useful_life_months;
+ALTER TABLE assets DROP COLUMN IF EXISTS status;
+ALTER TABLE assets DROP COLUMN IF EXISTS category;
+ALTER TABLE assets DROP COLUMN IF EXISTS serial_number;
+ALTER TABLE assets DROP COLUMN IF EXISTS updated_at;
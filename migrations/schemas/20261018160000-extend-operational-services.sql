@@ -0,0 +1,52 @@
+-- +migrate Up
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP(6) DEFAULT (now());
+-- the billing cycle, the accounting todos already read the monthly ones from it
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'monthly';
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS vendor TEXT;
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS plan TEXT;
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS seat_count INT4 NOT NULL DEFAULT 0;
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS renewal_date DATE;
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS reminder_days INT4 NOT NULL DEFAULT 7;
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS reminded_renewal_date DATE;
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS owner_id UUID;
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS organization_id UUID;
+ALTER TABLE operational_services ADD COLUMN IF NOT EXISTS cost_center TEXT;
+ALTER TABLE operational_services ADD CONSTRAINT operational_services_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES employees (id);
+ALTER TABLE operational_services ADD CONSTRAINT operational_services_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id);
+
+CREATE TABLE IF NOT EXISTS operational_service_seats (
+    id                     UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at             TIMESTAMP(6),
+    created_at             TIMESTAMP(6) DEFAULT (now()),
+    updated_at             TIMESTAMP(6) DEFAULT (now()),
+
+    operational_service_id UUID NOT NULL,
+    employee_id            UUID NOT NULL,
+    assigned_at            DATE NOT NULL,
+    released_at            DATE,
+    note                   TEXT,
+    CONSTRAINT operational_service_seats_operational_service_id_fkey FOREIGN KEY (operational_service_id) REFERENCES operational_services (id),
+    CONSTRAINT operational_service_seats_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id)
+);
+
+-- an employee holds one seat of a service at a time
+CREATE UNIQUE INDEX IF NOT EXISTS operational_service_seats_open_idx ON operational_service_seats (operational_service_id, employee_id) WHERE released_at IS NULL AND deleted_at IS NULL;
+
+INSERT INTO discord_log_templates (id, type, content) VALUES
+('b3e0c9a4-5d27-4f61-8c1e-2a9f7d4e6b15', 'operational_service_renewal', '{{ service }} renews on {{ renewal_date }} for {{ amount }} {{ currency }} ({{ seats }} seat(s)), owner {{ owner }}. Cancel or downsize before then if it is no longer needed.');
+
+-- +migrate Down
+DELETE FROM discord_log_templates WHERE type = 'operational_service_renewal';
+DROP TABLE IF EXISTS operational_service_seats;
+ALTER TABLE operational_services DROP CONSTRAINT IF EXISTS operational_services_organization_id_fkey;
+ALTER TABLE operational_services DROP CONSTRAINT IF EXISTS operational_services_owner_id_fkey;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS cost_center;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS reminded_renewal_date;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS reminder_days;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS renewal_date;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS seat_count;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS plan;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS vendor;
+ALTER TABLE operational_services DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,49 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS timesheets (
+    id              UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at      TIMESTAMP(6),
+    created_at      TIMESTAMP(6) DEFAULT (now()),
+    updated_at      TIMESTAMP(6) DEFAULT (now()),
+
+    employee_id     UUID NOT NULL,
+    project_id      UUID NOT NULL,
+    week_start      DATE NOT NULL,
+    status          TEXT NOT NULL DEFAULT 'draft',
+    note            TEXT,
+    submitted_at    TIMESTAMP(6),
+    reviewed_by     UUID,
+    reviewed_at     TIMESTAMP(6),
+    rejected_reason TEXT,
+    locked_at       TIMESTAMP(6),
+    CONSTRAINT timesheets_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id),
+    CONSTRAINT timesheets_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id),
+    CONSTRAINT timesheets_reviewed_by_fkey FOREIGN KEY (reviewed_by) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS timesheets_project_id_idx ON timesheets (project_id, week_start);
+-- one timesheet a week per employee and project
+CREATE UNIQUE INDEX IF NOT EXISTS timesheets_week_idx ON timesheets (employee_id, project_id, week_start) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS timesheet_entries (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6) DEFAULT (now()),
+    updated_at   TIMESTAMP(6) DEFAULT (now()),
+
+    timesheet_id UUID NOT NULL,
+    work_unit_id UUID,
+    date         DATE NOT NULL,
+    hours        DECIMAL NOT NULL,
+    description  TEXT,
+    invoice_id   UUID,
+    CONSTRAINT timesheet_entries_timesheet_id_fkey FOREIGN KEY (timesheet_id) REFERENCES timesheets (id),
+    CONSTRAINT timesheet_entries_work_unit_id_fkey FOREIGN KEY (work_unit_id) REFERENCES work_units (id),
+    CONSTRAINT timesheet_entries_invoice_id_fkey FOREIGN KEY (invoice_id) REFERENCES invoices (id)
+);
+
+CREATE INDEX IF NOT EXISTS timesheet_entries_timesheet_id_idx ON timesheet_entries (timesheet_id);
+CREATE INDEX IF NOT EXISTS timesheet_entries_invoice_id_idx ON timesheet_entries (invoice_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS timesheet_entries;
+DROP TABLE IF EXISTS timesheets;
@@ -0,0 +1,27 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS client_portal_accesses (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6) DEFAULT (now()),
+    updated_at        TIMESTAMP(6) DEFAULT (now()),
+
+    client_id         UUID NOT NULL,
+    client_contact_id UUID NOT NULL,
+    email             TEXT NOT NULL,
+    hashed_key        TEXT NOT NULL,
+    created_by        UUID,
+    last_login_at     TIMESTAMP(6),
+    revoked_at        TIMESTAMP(6),
+    revoked_by        UUID,
+    CONSTRAINT client_portal_accesses_client_id_fkey FOREIGN KEY (client_id) REFERENCES clients (id),
+    CONSTRAINT client_portal_accesses_client_contact_id_fkey FOREIGN KEY (client_contact_id) REFERENCES client_contacts (id),
+    CONSTRAINT client_portal_accesses_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id),
+    CONSTRAINT client_portal_accesses_revoked_by_fkey FOREIGN KEY (revoked_by) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS client_portal_accesses_client_id_idx ON client_portal_accesses (client_id);
+-- an email logs in to one client at a time
+CREATE UNIQUE INDEX IF NOT EXISTS client_portal_accesses_email_idx ON client_portal_accesses (lower(email)) WHERE revoked_at IS NULL AND deleted_at IS NULL;
+
+-- +migrate Down
+DROP TABLE IF EXISTS client_portal_accesses;
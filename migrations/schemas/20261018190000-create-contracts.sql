@@ -0,0 +1,75 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS contracts (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6) DEFAULT (now()),
+    updated_at         TIMESTAMP(6) DEFAULT (now()),
+
+    client_id          UUID NOT NULL,
+    project_id         UUID,
+    code               TEXT NOT NULL,
+    name               TEXT NOT NULL,
+    type               TEXT NOT NULL DEFAULT 'sow',
+    status             TEXT NOT NULL DEFAULT 'draft',
+    start_date         DATE NOT NULL,
+    end_date           DATE,
+    signed_at          DATE,
+    currency_id        UUID,
+    payment_term_days  INTEGER NOT NULL DEFAULT 30,
+    value_cap          DECIMAL,
+    note               TEXT,
+    expiry_notified_at TIMESTAMP(6),
+    created_by         UUID,
+    CONSTRAINT contracts_client_id_fkey FOREIGN KEY (client_id) REFERENCES clients (id),
+    CONSTRAINT contracts_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id),
+    CONSTRAINT contracts_currency_id_fkey FOREIGN KEY (currency_id) REFERENCES currencies (id),
+    CONSTRAINT contracts_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS contracts_client_id_idx ON contracts (client_id);
+CREATE INDEX IF NOT EXISTS contracts_project_id_idx ON contracts (project_id);
+CREATE UNIQUE INDEX IF NOT EXISTS contracts_code_idx ON contracts (code) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS contract_rates (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6) DEFAULT (now()),
+    updated_at   TIMESTAMP(6) DEFAULT (now()),
+
+    contract_id  UUID NOT NULL,
+    seniority_id UUID,
+    position_id  UUID,
+    rate         DECIMAL NOT NULL,
+    unit         TEXT NOT NULL DEFAULT 'month',
+    CONSTRAINT contract_rates_contract_id_fkey FOREIGN KEY (contract_id) REFERENCES contracts (id),
+    CONSTRAINT contract_rates_seniority_id_fkey FOREIGN KEY (seniority_id) REFERENCES seniorities (id),
+    CONSTRAINT contract_rates_position_id_fkey FOREIGN KEY (position_id) REFERENCES positions (id)
+);
+
+CREATE INDEX IF NOT EXISTS contract_rates_contract_id_idx ON contract_rates (contract_id);
+
+CREATE TABLE IF NOT EXISTS contract_attachments (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6) DEFAULT (now()),
+    updated_at   TIMESTAMP(6) DEFAULT (now()),
+
+    contract_id  UUID NOT NULL,
+    name         TEXT NOT NULL,
+    content_type TEXT,
+    url          TEXT NOT NULL,
+    uploaded_by  UUID,
+    CONSTRAINT contract_attachments_contract_id_fkey FOREIGN KEY (contract_id) REFERENCES contracts (id),
+    CONSTRAINT contract_attachments_uploaded_by_fkey FOREIGN KEY (uploaded_by) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS contract_attachments_contract_id_idx ON contract_attachments (contract_id);
+
+INSERT INTO discord_log_templates (id, type, content) VALUES
+('5c7e2a19-8d4b-4f03-b6e1-9a2d3c4f5e60', 'contract_expiry', 'Contract {{ contract }} with {{ client }} expires on {{ end_date }} ({{ days }} day(s) left), {{ account_managers }} please plan the renewal.');
+
+-- +migrate Down
+DELETE FROM discord_log_templates WHERE type = 'contract_expiry';
+DROP TABLE IF EXISTS contract_attachments;
+DROP TABLE IF EXISTS contract_rates;
+DROP TABLE IF EXISTS contracts;
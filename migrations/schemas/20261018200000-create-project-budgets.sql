@@ -0,0 +1,50 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS project_budgets (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6) DEFAULT (now()),
+    updated_at        TIMESTAMP(6) DEFAULT (now()),
+
+    project_id        UUID NOT NULL,
+    po_number         TEXT,
+    name              TEXT NOT NULL,
+    total_value       DECIMAL NOT NULL,
+    currency_id       UUID NOT NULL,
+    start_date        DATE NOT NULL,
+    end_date          DATE,
+    status            TEXT NOT NULL DEFAULT 'active',
+    alert_thresholds  INTEGER[],
+    alerted_threshold INTEGER NOT NULL DEFAULT 0,
+    note              TEXT,
+    created_by        UUID,
+    CONSTRAINT project_budgets_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id),
+    CONSTRAINT project_budgets_currency_id_fkey FOREIGN KEY (currency_id) REFERENCES currencies (id),
+    CONSTRAINT project_budgets_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id)
+);
+
+CREATE INDEX IF NOT EXISTS project_budgets_project_id_idx ON project_budgets (project_id);
+
+CREATE TABLE IF NOT EXISTS project_budget_milestones (
+    id                UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at        TIMESTAMP(6),
+    created_at        TIMESTAMP(6) DEFAULT (now()),
+    updated_at        TIMESTAMP(6) DEFAULT (now()),
+
+    project_budget_id UUID NOT NULL,
+    name              TEXT NOT NULL,
+    amount            DECIMAL NOT NULL,
+    due_date          DATE,
+    invoice_id        UUID,
+    CONSTRAINT project_budget_milestones_project_budget_id_fkey FOREIGN KEY (project_budget_id) REFERENCES project_budgets (id),
+    CONSTRAINT project_budget_milestones_invoice_id_fkey FOREIGN KEY (invoice_id) REFERENCES invoices (id)
+);
+
+CREATE INDEX IF NOT EXISTS project_budget_milestones_project_budget_id_idx ON project_budget_milestones (project_budget_id);
+
+INSERT INTO discord_log_templates (id, type, content) VALUES
+('75f445a1-8972-4ec0-b09e-34dcbdf18921', 'project_budget_burn', 'Budget {{ budget }} of {{ project }} is {{ burn }}% burned ({{ drawn }}/{{ total }} {{ currency }}), crossing the {{ threshold }}% alert. {{ projection }} {{ heads }}');
+
+-- +migrate Down
+DELETE FROM discord_log_templates WHERE type = 'project_budget_burn';
+DROP TABLE IF EXISTS project_budget_milestones;
+DROP TABLE IF EXISTS project_budgets;
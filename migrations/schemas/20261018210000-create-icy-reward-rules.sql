@@ -0,0 +1,61 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS icy_reward_rules (
+    id               UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at       TIMESTAMP(6),
+    created_at       TIMESTAMP(6) DEFAULT (now()),
+    updated_at       TIMESTAMP(6) DEFAULT (now()),
+
+    trigger          TEXT NOT NULL,
+    name             TEXT NOT NULL,
+    category         enum_icy_txn_category NOT NULL,
+    amount           DECIMAL NOT NULL,
+    cap_per_period   INTEGER NOT NULL DEFAULT 0,
+    cap_period       TEXT NOT NULL DEFAULT 'month',
+    monthly_budget   DECIMAL,
+    review_threshold DECIMAL,
+    is_active        BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS icy_reward_rules_trigger_idx ON icy_reward_rules (trigger) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS icy_reward_payouts (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6) DEFAULT (now()),
+    updated_at         TIMESTAMP(6) DEFAULT (now()),
+
+    rule_id            UUID NOT NULL,
+    employee_id        UUID NOT NULL,
+    idempotency_key    TEXT NOT NULL,
+    reference          TEXT,
+    amount             DECIMAL NOT NULL,
+    status             TEXT NOT NULL,
+    reason             TEXT,
+    occurred_at        TIMESTAMP(6) NOT NULL,
+    reviewed_by        UUID,
+    reviewed_at        TIMESTAMP(6),
+    mochi_tx_id        BIGINT NOT NULL DEFAULT 0,
+    icy_transaction_id UUID,
+    CONSTRAINT icy_reward_payouts_rule_id_fkey FOREIGN KEY (rule_id) REFERENCES icy_reward_rules (id),
+    CONSTRAINT icy_reward_payouts_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id),
+    CONSTRAINT icy_reward_payouts_reviewed_by_fkey FOREIGN KEY (reviewed_by) REFERENCES employees (id),
+    CONSTRAINT icy_reward_payouts_icy_transaction_id_fkey FOREIGN KEY (icy_transaction_id) REFERENCES icy_transactions (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS icy_reward_payouts_idempotency_key_idx ON icy_reward_payouts (idempotency_key);
+CREATE INDEX IF NOT EXISTS icy_reward_payouts_rule_id_employee_id_idx ON icy_reward_payouts (rule_id, employee_id, occurred_at);
+CREATE INDEX IF NOT EXISTS icy_reward_payouts_status_idx ON icy_reward_payouts (status);
+
+ALTER TABLE icy_transactions ADD COLUMN IF NOT EXISTS reward_rule_id UUID REFERENCES icy_reward_rules (id);
+
+INSERT INTO icy_reward_rules (id, trigger, name, category, amount, cap_per_period, cap_period) VALUES
+('d39b08f7-bb0a-4b2a-bec3-818b7e5a35fe', 'office-check-in', 'Office check-in', 'community', 5, 1, 'day'),
+('345dcb46-7f44-4e58-b3fd-da0fc1cf440b', 'memo-published', 'Memo published', 'learning', 25, 4, 'week'),
+('30a706fc-5e43-469d-bf41-96cdb5b4169e', 'ogif-talk', 'OGIF talk given', 'learning', 50, 1, 'week'),
+('20c5f0d4-eb85-483c-8a26-0e7db7ce97bd', 'brainery-log', 'Brainery log', 'learning', 10, 5, 'week'),
+('3dff3782-2b3c-4327-b981-bea680096ddf', 'delivery-leaderboard', 'Top of the delivery leaderboard', 'delivery', 100, 1, 'month');
+
+-- +migrate Down
+ALTER TABLE icy_transactions DROP COLUMN IF EXISTS reward_rule_id;
+DROP TABLE IF EXISTS icy_reward_payouts;
+DROP TABLE IF EXISTS icy_reward_rules;
@@ -0,0 +1,51 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS icy_treasury_snapshots (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6) DEFAULT (now()),
+    updated_at         TIMESTAMP(6) DEFAULT (now()),
+
+    snapshot_date      DATE NOT NULL,
+    taken_at           TIMESTAMP(6) NOT NULL,
+    total_supply       DECIMAL NOT NULL,
+    onchain_locked     DECIMAL NOT NULL,
+    offchain_locked    DECIMAL NOT NULL,
+    circulating        DECIMAL NOT NULL,
+    usdc_fund          DECIMAL NOT NULL,
+    conversion_rate    DECIMAL NOT NULL,
+    offset_usdc        DECIMAL NOT NULL,
+    custody_balance    DECIMAL,
+    ledger_deposits    DECIMAL NOT NULL DEFAULT 0,
+    ledger_withdrawals DECIMAL NOT NULL DEFAULT 0,
+    ledger_tx_count    INTEGER NOT NULL DEFAULT 0,
+    drift              DECIMAL NOT NULL DEFAULT 0,
+    cumulative_drift   DECIMAL NOT NULL DEFAULT 0,
+    drift_flagged      BOOLEAN NOT NULL DEFAULT FALSE,
+    note               TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS icy_treasury_snapshots_snapshot_date_idx ON icy_treasury_snapshots (snapshot_date) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS icy_treasury_vault_balances (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6) DEFAULT (now()),
+    updated_at   TIMESTAMP(6) DEFAULT (now()),
+
+    snapshot_id  UUID NOT NULL,
+    profile_id   TEXT NOT NULL,
+    profile_name TEXT,
+    profile_type TEXT NOT NULL,
+    amount       DECIMAL NOT NULL,
+    CONSTRAINT icy_treasury_vault_balances_snapshot_id_fkey FOREIGN KEY (snapshot_id) REFERENCES icy_treasury_snapshots (id)
+);
+
+CREATE INDEX IF NOT EXISTS icy_treasury_vault_balances_snapshot_id_idx ON icy_treasury_vault_balances (snapshot_id);
+
+INSERT INTO discord_log_templates (id, type, content) VALUES
+('b6f1d0a2-5c1e-4f0b-9d8e-2a7c4e3f9b15', 'icy_treasury_drift', 'ICY treasury drift on {{ date }}: the Mochi custody balance moved by {{ onchain_delta }} ICY on-chain while the Mochi ledger recorded {{ ledger_net }} ICY of deposits less withdrawals, a drift of {{ drift }} ICY ({{ cumulative_drift }} ICY since the first snapshot).');
+
+-- +migrate Down
+DELETE FROM discord_log_templates WHERE type = 'icy_treasury_drift';
+DROP TABLE IF EXISTS icy_treasury_vault_balances;
+DROP TABLE IF EXISTS icy_treasury_snapshots;
@@ -0,0 +1,44 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS office_locations (
+    id                  UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at          TIMESTAMP(6),
+    created_at          TIMESTAMP(6) DEFAULT (now()),
+    updated_at          TIMESTAMP(6) DEFAULT (now()),
+
+    name                TEXT NOT NULL,
+    address             TEXT,
+    latitude            DOUBLE PRECISION,
+    longitude           DOUBLE PRECISION,
+    geofence_radius     INTEGER NOT NULL DEFAULT 0,
+    qr_secret           TEXT NOT NULL,
+    qr_rotation_seconds INTEGER NOT NULL DEFAULT 60,
+    is_active           BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE IF NOT EXISTS office_checkins (
+    id                  UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at          TIMESTAMP(6),
+    created_at          TIMESTAMP(6) DEFAULT (now()),
+    updated_at          TIMESTAMP(6) DEFAULT (now()),
+
+    employee_id         UUID NOT NULL,
+    office_location_id  UUID NOT NULL,
+    date                DATE NOT NULL,
+    checked_in_at       TIMESTAMP(6) NOT NULL,
+    check_in_latitude   DOUBLE PRECISION,
+    check_in_longitude  DOUBLE PRECISION,
+    check_in_distance   DOUBLE PRECISION,
+    checked_out_at      TIMESTAMP(6),
+    check_out_latitude  DOUBLE PRECISION,
+    check_out_longitude DOUBLE PRECISION,
+    check_out_distance  DOUBLE PRECISION,
+    CONSTRAINT office_checkins_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id),
+    CONSTRAINT office_checkins_office_location_id_fkey FOREIGN KEY (office_location_id) REFERENCES office_locations (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS office_checkins_employee_id_date_idx ON office_checkins (employee_id, date) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS office_checkins_date_idx ON office_checkins (date);
+
+-- +migrate Down
+DROP TABLE IF EXISTS office_checkins;
+DROP TABLE IF EXISTS office_locations;
@@ -0,0 +1,76 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS engagement_activities (
+    id              UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at      TIMESTAMP(6),
+    created_at      TIMESTAMP(6) DEFAULT (now()),
+    updated_at      TIMESTAMP(6) DEFAULT (now()),
+
+    date            DATE NOT NULL,
+    discord_user_id BIGINT NOT NULL,
+    channel_id      BIGINT NOT NULL,
+    category_id     BIGINT,
+    message_count   INT NOT NULL DEFAULT 0,
+    reaction_count  INT NOT NULL DEFAULT 0,
+    is_baseline     BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS engagement_activities_date_user_channel_idx ON engagement_activities (date, discord_user_id, channel_id);
+
+CREATE TABLE IF NOT EXISTS engagement_weekly_stats (
+    id             UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at     TIMESTAMP(6),
+    created_at     TIMESTAMP(6) DEFAULT (now()),
+    updated_at     TIMESTAMP(6) DEFAULT (now()),
+
+    week_start     DATE NOT NULL,
+    scope          TEXT NOT NULL,
+    chapter_id     UUID,
+    members        INT NOT NULL DEFAULT 0,
+    active_members INT NOT NULL DEFAULT 0,
+    message_count  INT NOT NULL DEFAULT 0,
+    reaction_count INT NOT NULL DEFAULT 0,
+    CONSTRAINT engagement_weekly_stats_chapter_id_fkey FOREIGN KEY (chapter_id) REFERENCES chapters (id)
+);
+
+CREATE INDEX IF NOT EXISTS engagement_weekly_stats_week_start_idx ON engagement_weekly_stats (week_start, scope);
+
+CREATE TABLE IF NOT EXISTS engagement_channel_stats (
+    id               UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at       TIMESTAMP(6),
+    created_at       TIMESTAMP(6) DEFAULT (now()),
+    updated_at       TIMESTAMP(6) DEFAULT (now()),
+
+    week_start       DATE NOT NULL,
+    channel_id       BIGINT NOT NULL,
+    category_id      BIGINT,
+    channel_name     TEXT,
+    active_members   INT NOT NULL DEFAULT 0,
+    message_count    INT NOT NULL DEFAULT 0,
+    reaction_count   INT NOT NULL DEFAULT 0,
+    top_member_count INT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS engagement_channel_stats_week_start_channel_idx ON engagement_channel_stats (week_start, channel_id);
+
+CREATE TABLE IF NOT EXISTS engagement_member_stats (
+    id               UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at       TIMESTAMP(6),
+    created_at       TIMESTAMP(6) DEFAULT (now()),
+    updated_at       TIMESTAMP(6) DEFAULT (now()),
+
+    employee_id      UUID NOT NULL,
+    discord_user_id  BIGINT NOT NULL,
+    last_active_at   TIMESTAMP(6),
+    recent_messages  INT NOT NULL DEFAULT 0,
+    recent_reactions INT NOT NULL DEFAULT 0,
+    computed_on      DATE NOT NULL,
+    CONSTRAINT engagement_member_stats_employee_id_fkey FOREIGN KEY (employee_id) REFERENCES employees (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS engagement_member_stats_employee_id_idx ON engagement_member_stats (employee_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS engagement_member_stats;
+DROP TABLE IF EXISTS engagement_channel_stats;
+DROP TABLE IF EXISTS engagement_weekly_stats;
+DROP TABLE IF EXISTS engagement_activities;
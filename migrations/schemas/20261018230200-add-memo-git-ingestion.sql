@@ -0,0 +1,41 @@
+-- +migrate Up
+ALTER TABLE memo_logs ADD COLUMN file_path TEXT;
+ALTER TABLE memo_logs ADD COLUMN content_hash TEXT;
+ALTER TABLE memo_logs ADD COLUMN is_draft BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE memo_logs ADD COLUMN source TEXT NOT NULL DEFAULT 'rss';
+ALTER TABLE memo_logs ADD COLUMN author_handles JSONB DEFAULT '[]'::JSONB;
+ALTER TABLE memo_logs ADD COLUMN unresolved_authors JSONB DEFAULT '[]'::JSONB;
+ALTER TABLE memo_logs ADD COLUMN edited_at TIMESTAMP(6);
+
+CREATE UNIQUE INDEX IF NOT EXISTS memo_logs_file_path_idx ON memo_logs (file_path) WHERE file_path IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS memo_ingestions (
+    id                  UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at          TIMESTAMP(6),
+    created_at          TIMESTAMP(6) DEFAULT (now()),
+    updated_at          TIMESTAMP(6) DEFAULT (now()),
+
+    commit_sha          TEXT NOT NULL,
+    previous_commit_sha TEXT,
+    is_full_scan        BOOLEAN NOT NULL DEFAULT FALSE,
+    created             INT NOT NULL DEFAULT 0,
+    updated             INT NOT NULL DEFAULT 0,
+    renamed             INT NOT NULL DEFAULT 0,
+    deleted             INT NOT NULL DEFAULT 0,
+    drafts              INT NOT NULL DEFAULT 0,
+    skipped             INT NOT NULL DEFAULT 0,
+    unresolved_authors  JSONB DEFAULT '[]'::JSONB
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS memo_ingestions;
+
+DROP INDEX IF EXISTS memo_logs_file_path_idx;
+
+ALTER TABLE memo_logs DROP COLUMN edited_at;
+ALTER TABLE memo_logs DROP COLUMN unresolved_authors;
+ALTER TABLE memo_logs DROP COLUMN author_handles;
+ALTER TABLE memo_logs DROP COLUMN source;
+ALTER TABLE memo_logs DROP COLUMN is_draft;
+ALTER TABLE memo_logs DROP COLUMN content_hash;
+ALTER TABLE memo_logs DROP COLUMN file_path;
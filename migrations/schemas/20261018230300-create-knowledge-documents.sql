@@ -0,0 +1,29 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS knowledge_documents (
+    id            UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at    TIMESTAMP(6),
+    created_at    TIMESTAMP(6) DEFAULT (now()),
+    updated_at    TIMESTAMP(6) DEFAULT (now()),
+
+    source        TEXT NOT NULL,
+    source_id     TEXT NOT NULL,
+    title         TEXT NOT NULL,
+    url           TEXT,
+    content       TEXT NOT NULL DEFAULT '',
+    authors       JSONB NOT NULL DEFAULT '[]'::JSONB,
+    tags          JSONB NOT NULL DEFAULT '[]'::JSONB,
+    published_at  TIMESTAMP(6),
+    search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(jsonb_to_tsvector('english', tags, '["string"]'), 'B') ||
+        setweight(to_tsvector('english', coalesce(content, '')), 'C')
+    ) STORED
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS knowledge_documents_source_idx ON knowledge_documents (source, source_id);
+CREATE INDEX IF NOT EXISTS knowledge_documents_search_vector_idx ON knowledge_documents USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS knowledge_documents_authors_idx ON knowledge_documents USING GIN (authors);
+CREATE INDEX IF NOT EXISTS knowledge_documents_tags_idx ON knowledge_documents USING GIN (tags);
+
+-- +migrate Down
+DROP TABLE IF EXISTS knowledge_documents;
@@ -0,0 +1,65 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS delivery_metric_imports (
+    id             UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at     TIMESTAMP(6),
+    created_at     TIMESTAMP(6) DEFAULT (now()),
+    updated_at     TIMESTAMP(6) DEFAULT (now()),
+
+    source         TEXT NOT NULL,
+    file_name      TEXT,
+    dataset        TEXT,
+    dry_run        BOOLEAN NOT NULL DEFAULT FALSE,
+    total_rows     INTEGER NOT NULL DEFAULT 0,
+    created_rows   INTEGER NOT NULL DEFAULT 0,
+    updated_rows   INTEGER NOT NULL DEFAULT 0,
+    unchanged_rows INTEGER NOT NULL DEFAULT 0,
+    rejected_rows  INTEGER NOT NULL DEFAULT 0,
+    deleted_rows   INTEGER NOT NULL DEFAULT 0,
+    rejections     JSONB NOT NULL DEFAULT '[]',
+    imported_by    UUID,
+
+    CONSTRAINT delivery_metric_imports_imported_by_fkey FOREIGN KEY (imported_by) REFERENCES employees (id)
+);
+
+CREATE TABLE IF NOT EXISTS delivery_metric_mappings (
+    id         UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at TIMESTAMP(6),
+    created_at TIMESTAMP(6) DEFAULT (now()),
+    updated_at TIMESTAMP(6) DEFAULT (now()),
+
+    kind       TEXT NOT NULL,
+    value      TEXT NOT NULL,
+    target_id  UUID NOT NULL,
+    created_by UUID,
+
+    CONSTRAINT delivery_metric_mappings_created_by_fkey FOREIGN KEY (created_by) REFERENCES employees (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_delivery_metric_mappings_kind_value
+ON delivery_metric_mappings(kind, value)
+WHERE deleted_at IS NULL;
+
+ALTER TABLE delivery_metrics ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'sheet';
+ALTER TABLE delivery_metrics ADD COLUMN IF NOT EXISTS source_key TEXT;
+ALTER TABLE delivery_metrics ADD COLUMN IF NOT EXISTS import_id UUID;
+ALTER TABLE delivery_metrics
+    ADD CONSTRAINT delivery_metrics_import_id_fkey FOREIGN KEY (import_id) REFERENCES delivery_metric_imports (id);
+
+-- a re-import matches the metrics already stored on the row they are imported from
+CREATE INDEX IF NOT EXISTS idx_delivery_metrics_source_key
+ON delivery_metrics(source_key)
+WHERE deleted_at IS NULL;
+
+-- the "Internal" rows of the sheet were hardcoded to Fortress
+INSERT INTO delivery_metric_mappings (kind, value, target_id)
+SELECT 'project', 'internal', id FROM projects WHERE name = 'Fortress v2.0' AND deleted_at IS NULL
+LIMIT 1;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_delivery_metrics_source_key;
+ALTER TABLE delivery_metrics DROP CONSTRAINT IF EXISTS delivery_metrics_import_id_fkey;
+ALTER TABLE delivery_metrics DROP COLUMN IF EXISTS import_id;
+ALTER TABLE delivery_metrics DROP COLUMN IF EXISTS source_key;
+ALTER TABLE delivery_metrics DROP COLUMN IF EXISTS source;
+DROP TABLE IF EXISTS delivery_metric_mappings;
+DROP TABLE IF EXISTS delivery_metric_imports;
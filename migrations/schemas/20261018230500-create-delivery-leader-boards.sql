@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS delivery_leader_boards (
+    id                 UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at         TIMESTAMP(6),
+    created_at         TIMESTAMP(6) DEFAULT (now()),
+    updated_at         TIMESTAMP(6) DEFAULT (now()),
+
+    name               TEXT NOT NULL,
+    formula            TEXT NOT NULL DEFAULT 'weight',
+    scope              TEXT NOT NULL DEFAULT 'company',
+    scope_id           UUID,
+    period             TEXT NOT NULL DEFAULT 'weekly',
+    exclude_leads      BOOLEAN NOT NULL DEFAULT FALSE,
+    min_effort         DECIMAL NOT NULL DEFAULT 0,
+    size               INTEGER NOT NULL DEFAULT 5,
+    discord_channel_id TEXT,
+    is_active          BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_delivery_metrics_date ON delivery_metrics(date);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_delivery_metrics_date;
+DROP TABLE IF EXISTS delivery_leader_boards;
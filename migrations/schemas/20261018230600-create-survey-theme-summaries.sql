@@ -0,0 +1,24 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS survey_theme_summaries (
+    id           UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at   TIMESTAMP(6),
+    created_at   TIMESTAMP(6) DEFAULT (now()),
+    updated_at   TIMESTAMP(6) DEFAULT (now()),
+
+    event_id     UUID NOT NULL REFERENCES feedback_events(id),
+    question_id  UUID NOT NULL REFERENCES questions(id),
+    content      TEXT NOT NULL,
+    summary      TEXT NOT NULL,
+    answer_count INTEGER NOT NULL DEFAULT 0,
+    model        TEXT NOT NULL,
+    created_by   UUID REFERENCES employees(id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_survey_theme_summaries_event_question
+    ON survey_theme_summaries(event_id, question_id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employee_event_questions_event_id ON employee_event_questions(event_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_employee_event_questions_event_id;
+DROP TABLE IF EXISTS survey_theme_summaries;
@@ -0,0 +1,66 @@
+-- +migrate Up
+ALTER TYPE event_types ADD VALUE IF NOT EXISTS 'review';
+ALTER TYPE event_subtypes ADD VALUE IF NOT EXISTS 'self-review';
+ALTER TYPE event_subtypes ADD VALUE IF NOT EXISTS 'manager-review';
+
+CREATE TABLE IF NOT EXISTS review_cycles (
+    id                      UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at              TIMESTAMP(6),
+    created_at              TIMESTAMP(6) DEFAULT (now()),
+    updated_at              TIMESTAMP(6) DEFAULT (now()),
+
+    name                    TEXT NOT NULL,
+    period_from             DATE NOT NULL,
+    period_to               DATE NOT NULL,
+    status                  TEXT NOT NULL DEFAULT 'draft',
+    self_review_event_id    UUID REFERENCES feedback_events(id),
+    manager_review_event_id UUID REFERENCES feedback_events(id),
+    created_by              UUID NOT NULL REFERENCES employees(id)
+);
+
+CREATE TABLE IF NOT EXISTS review_packets (
+    id                      UUID PRIMARY KEY DEFAULT (UUID()),
+    deleted_at              TIMESTAMP(6),
+    created_at              TIMESTAMP(6) DEFAULT (now()),
+    updated_at              TIMESTAMP(6) DEFAULT (now()),
+
+    cycle_id                UUID NOT NULL REFERENCES review_cycles(id),
+    employee_id             UUID NOT NULL REFERENCES employees(id),
+    manager_id              UUID REFERENCES employees(id),
+    self_review_topic_id    UUID REFERENCES employee_event_topics(id),
+    manager_review_topic_id UUID REFERENCES employee_event_topics(id),
+    status                  TEXT NOT NULL DEFAULT 'open',
+    evidence                JSONB,
+    suggested_rating        INTEGER NOT NULL DEFAULT 0,
+    suggested_score         DECIMAL NOT NULL DEFAULT 0,
+    final_rating            INTEGER NOT NULL DEFAULT 0,
+    current_seniority_id    UUID NOT NULL REFERENCES seniorities(id),
+    proposed_seniority_id   UUID REFERENCES seniorities(id),
+    calibration_note        TEXT NOT NULL DEFAULT '',
+    calibrated_by           UUID REFERENCES employees(id),
+    calibrated_at           TIMESTAMP(6),
+    approved_by             UUID REFERENCES employees(id),
+    approved_at             TIMESTAMP(6)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uidx_review_packets_cycle_employee
+    ON review_packets(cycle_id, employee_id) WHERE deleted_at IS NULL;
+
+INSERT INTO questions (id, category, subcategory, content, type, "order") VALUES
+('93b6a9a2-7217-4b57-b7e5-b5ca10970d5d', 'review', 'self-review', 'I delivered the outcomes expected of my role this period.', 'likert-scale', 1),
+('26f410b2-7c4a-4fd4-a8ce-bea5c913f35b', 'review', 'self-review', 'I grew my skills and took on harder problems.', 'likert-scale', 2),
+('1731e3a2-4d97-46a2-a192-d597c46efe65', 'review', 'self-review', 'I worked well with my team and helped others succeed.', 'likert-scale', 3),
+('22357b73-93d0-4815-ac0f-af7da7501692', 'review', 'self-review', 'What are you most proud of this period?', 'general', 4),
+('2957d254-1426-4b7d-8a32-0b57a4f1e602', 'review', 'self-review', 'Where do you want to grow next period, and what support do you need?', 'general', 5),
+('9eb939c9-5132-4c85-a463-8f54b831ca94', 'review', 'manager-review', 'They delivered the outcomes expected of their role this period.', 'likert-scale', 1),
+('0a6524b1-4ce6-4624-ba9f-9318c79223eb', 'review', 'manager-review', 'They grew their skills and took on harder problems.', 'likert-scale', 2),
+('844f9dfe-1669-4273-9ece-de5193b6daee', 'review', 'manager-review', 'They worked well with the team and helped others succeed.', 'likert-scale', 3),
+('8d930ecb-efc0-4ac0-9913-679025d30a68', 'review', 'manager-review', 'They are ready for more responsibility.', 'likert-scale', 4),
+('9239ba74-eb29-4c02-95fe-b17214de045d', 'review', 'manager-review', 'What were their main contributions this period?', 'general', 5),
+('98b3b0bf-4718-49c1-80ae-9cdaafc997bb', 'review', 'manager-review', 'What should they focus on to grow next period?', 'general', 6)
+ON CONFLICT (id) DO NOTHING;
+
+-- +migrate Down
+DELETE FROM questions WHERE category = 'review';
+DROP TABLE IF EXISTS review_packets;
+DROP TABLE IF EXISTS review_cycles;
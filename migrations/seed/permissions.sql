@@ -100,4 +100,48 @@ INSERT INTO public.permissions (id, deleted_at, created_at, updated_at, name, co
 ('1641106e-9a94-42fa-80e0-9d6978cd3596', null, '2024-05-29 15:41:12.475872', '2024-05-29 15:41:12.475872', 'Employee Discord Edit','employees.discord.edit'),
 ('b069e35c-1144-4554-9854-ff529506d4e5', null, '2024-05-29 15:41:12.475872', '2024-05-29 15:41:12.475872', 'Employee Discord Create','employees.discord.create'),
 ('f84e4e32-b104-4e9c-9694-b1a86e90ec25', null, '2024-09-19 15:41:12.475872', '2024-09-19 15:41:12.475872', 'Transfer Check-in Icy','employees.transferCheckinIcy.fullAccess'),
-('8fe4de41-15e8-4027-a769-e9344cd04415', null, '2024-09-19 15:41:12.475872', '2024-09-19 15:41:12.475872', 'Project Commission Models Read','projects.commissionModels.read');
+('8fe4de41-15e8-4027-a769-e9344cd04415', null, '2024-09-19 15:41:12.475872', '2024-09-19 15:41:12.475872', 'Project Commission Models Read','projects.commissionModels.read'),
+('208407e7-4a10-4383-a822-bd4bec4b5098', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'FX Rates Read','fxRates.read'),
+('4c099ea4-66ee-4fd3-bbc1-c08876d7958e', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'FX Rates Edit','fxRates.edit'),
+('313b5791-290f-47c2-b971-7d5ec27bd449', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Ledger Read','ledger.read'),
+('955e12ae-560a-4417-89a5-7d29316bbdac', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Ledger Edit','ledger.edit'),
+('27b25e92-5338-44ec-8537-0dc0b34ecd04', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Bank Reconciliation Read','bankReconciliation.read'),
+('ffb733ee-7955-4953-a493-5d59c6b891f9', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Bank Reconciliation Edit','bankReconciliation.edit'),
+('80bac112-d9a5-4d6e-8585-4255e3dab0a4', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Cash Flow Read','cashFlow.read'),
+('ef70f8fb-2583-4974-b728-34c601f4dfa1', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Cash Flow Edit','cashFlow.edit'),
+('6130bdf6-f4e4-422a-9f84-b09391a77253', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Projects Profitability Read','projects.profitability.read'),
+('1b78a7f1-b414-49f3-9e72-d35c3a95e1b5', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Recruitment Read','recruitment.read'),
+('877d39bb-181a-4634-89de-7dab6f271cc6', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Recruitment Edit','recruitment.edit'),
+('08cbab4b-af7f-42b3-bd29-33fdfd008528', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Referrals Read','referrals.read'),
+('b2e1e01f-a238-486a-96d8-5ddcd1594267', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Referrals Edit','referrals.edit'),
+('007fd33f-a271-4b2d-85c7-504c1d22cfd5', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Assets Read','assets.read'),
+('d20bf056-876f-40e9-86a3-dc608b4de753', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Assets Edit','assets.edit'),
+('34b6891c-5dce-4cfe-993d-2bbd26ac9b42', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Operational Services Read','operationalServices.read'),
+('7b61a6a3-55f6-40e5-8f5e-48cc698b1196', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Operational Services Edit','operationalServices.edit'),
+('9506c6d2-e624-49c6-960e-62c91b391be3', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Read Project Staffing Recommendations','projects.staffing.read'),
+('498dd295-362c-43e1-afa6-09a8b2946269', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Read Timesheets','timesheets.read'),
+('1e1ba906-cd68-42b6-b959-0f256dd87e43', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Create Timesheets','timesheets.create'),
+('a5112cfd-0d08-43ed-9b78-55f5d23bb2ad', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Approve Timesheets','timesheets.approve'),
+('ab6a2bdf-97eb-4616-a7a5-7b67136510a6', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Full Access Timesheets','timesheets.fullAccess'),
+('0234e78d-4f87-44b2-a68c-3e35a19359bd', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Client Portal Read','clientPortal.read'),
+('d3df9ccc-5833-4457-85da-3ef20cb0ce0b', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Client Portal Edit','clientPortal.edit'),
+('ab9ce6a3-7c39-48e0-a81b-bc067e80a2b4', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Read Contracts','contracts.read'),
+('b1b49d9e-bb29-416f-8832-7c8a0f17754b', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Edit Contracts','contracts.edit'),
+('3ba89e4d-1820-46e7-b6c0-e0a94bf311be', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Read Project Budgets','projects.budgets.read'),
+('77ad1710-302d-4ff1-b7cd-469b430aed40', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Edit Project Budgets','projects.budgets.edit'),
+('e94cd4ac-ad83-4eb0-a748-4859e37de228', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Read ICY Reward Rules And Payouts','icyRewards.read'),
+('9fe7a642-cb2a-40d8-9c9a-c116fc962ffc', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Edit ICY Reward Rules And Review Payouts','icyRewards.edit'),
+('e148b44a-1d44-4577-87d3-e67a1adffe22', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Trigger ICY Rewards','icyRewards.trigger'),
+('bb374726-3eec-4caa-b115-342d47a7f869', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Read ICY Treasury Reconciliation','icyTreasury.read'),
+('22aea880-2446-406c-bdee-684b693a3040', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Office Locations Read','officeLocations.read'),
+('2ca72bd6-c56b-44bc-be42-eeef672b5391', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Office Locations Edit','officeLocations.edit'),
+('232ef5c0-459e-43af-82cf-bddad0150a0b', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Office Locations QR Code','officeLocations.qrCode'),
+('aef060e0-c682-4cd2-bf6c-ad324fe46fbd', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Office Checkins Create','officeCheckins.create'),
+('1965765f-4ebb-4f51-a212-c301a4abda54', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Office Checkins Read','officeCheckins.read'),
+('1aead5c5-f493-49ab-9549-cf2a491facce', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Engagement Analytics Read','engagementAnalytics.read'),
+('d05e879e-9712-4ab7-85a1-9b44cb628f7b', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Engagement Analytics Quiet Members Read','engagementAnalytics.quietMembers.read'),
+('e6d09ee5-692b-4cec-ac76-e32f475ddbb4', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Read Knowledge Base','knowledgeBase.read'),
+('41c694a6-fb67-492e-a342-b3e9a7a7729d', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Edit Knowledge Base','knowledgeBase.edit'),
+('622428fc-c644-4a40-8765-156112710f1c', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Read Review Cycles','reviewCycles.read'),
+('afde687e-c8a4-4bd9-a2e7-442000c01771', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Edit Review Cycles','reviewCycles.edit'),
+('64c56c15-07f8-47e7-a781-5b5f9e0ac403', null, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'Approve Review Cycles','reviewCycles.approve');
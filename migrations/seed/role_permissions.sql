@@ -284,4 +284,59 @@ INSERT INTO public.role_permissions (id, deleted_at, created_at, updated_at, rol
 ('556e5273-630a-499c-8f69-0cda68c6ebda', NULL, '2023-07-26 16:35:12.475872', '2023-07-26 16:35:12.475872', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'fea2497c-694d-43d7-82cd-764d622a6706'), -- deliveryMetrics.leaderBoard.sync
 ('27700990-ae6c-4a93-a9aa-5e9e71d8ac56', NULL, '2023-07-26 16:35:12.475872', '2023-07-26 16:35:12.475872', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '1991c441-39bc-4b0f-9afa-491bebb1c965'),
 ('67c5a96e-9e89-4e29-90f9-661b47e43c65', NULL, '2023-07-26 16:35:12.475872', '2023-07-26 16:35:12.475872', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'f84e4e32-b104-4e9c-9694-b1a86e90ec25'), -- employees.transferCheckinIcy.fullAccess
-('b97c2450-63d5-4a6a-9f8d-9ac9541e0163', NULL, '2023-07-26 16:35:12.475872', '2023-07-26 16:35:12.475872', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '8fe4de41-15e8-4027-a769-e9344cd04415');
+('b97c2450-63d5-4a6a-9f8d-9ac9541e0163', NULL, '2023-07-26 16:35:12.475872', '2023-07-26 16:35:12.475872', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '8fe4de41-15e8-4027-a769-e9344cd04415'),
+('161c4fe4-e876-4b68-8c26-2d4b66b5097d', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '208407e7-4a10-4383-a822-bd4bec4b5098'), -- fxRates.read
+('6ca18798-1584-477c-862d-844119f9c2bc', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '4c099ea4-66ee-4fd3-bbc1-c08876d7958e'), -- fxRates.edit
+('20c14770-2d03-4d66-804d-9d310c76ad42', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '313b5791-290f-47c2-b971-7d5ec27bd449'), -- ledger.read
+('7e2fe3c5-5c38-4044-a2df-0c918dc73440', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '955e12ae-560a-4417-89a5-7d29316bbdac'), -- ledger.edit
+('4c63ba46-38f5-4693-a21f-97136c99557a', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '27b25e92-5338-44ec-8537-0dc0b34ecd04'), -- bankReconciliation.read
+('3760e92a-39f6-42bb-ac8d-4f9ba70c9906', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'ffb733ee-7955-4953-a493-5d59c6b891f9'), -- bankReconciliation.edit
+('0f53ac5c-a3fb-4ac6-9530-7d198c8e4111', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '80bac112-d9a5-4d6e-8585-4255e3dab0a4'), -- cashFlow.read
+('add6ea43-fd9a-4b15-91ce-77faaaa9d9c1', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'ef70f8fb-2583-4974-b728-34c601f4dfa1'), -- cashFlow.edit
+('e1018b0d-3824-4f03-b601-22dc9811faff', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '6130bdf6-f4e4-422a-9f84-b09391a77253'), -- projects.profitability.read
+('7ad8474b-e268-4dea-87e8-d08215f5dcb5', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '1b78a7f1-b414-49f3-9e72-d35c3a95e1b5'), -- recruitment.read
+('308f7b6d-546f-42ed-8f00-266ba17856af', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '877d39bb-181a-4634-89de-7dab6f271cc6'), -- recruitment.edit
+('0c4155bc-4223-4de2-9d4f-5e9b93f4bdd1', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '08cbab4b-af7f-42b3-bd29-33fdfd008528'), -- referrals.read
+('1a88ab0a-96dd-4d85-927b-3dcdecc951da', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'b2e1e01f-a238-486a-96d8-5ddcd1594267'), -- referrals.edit
+('4c17b1ab-9b40-46d4-83a5-d241eb6ca7c3', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '007fd33f-a271-4b2d-85c7-504c1d22cfd5'), -- assets.read
+('96b87734-4dca-441e-bf4a-854a60a8d5e4', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'd20bf056-876f-40e9-86a3-dc608b4de753'), -- assets.edit
+('67d4f112-88d0-40c0-9097-4fe0534244e9', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '34b6891c-5dce-4cfe-993d-2bbd26ac9b42'), -- operationalServices.read
+('8b3128ca-f6df-46aa-83af-050a23efd9ee', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '7b61a6a3-55f6-40e5-8f5e-48cc698b1196'), -- operationalServices.edit
+('d9104f2f-3be5-46f7-a9c9-b4e1298a54e6', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '9506c6d2-e624-49c6-960e-62c91b391be3'), -- projects.staffing.read
+('d1d9ab64-170f-42cf-b5b7-9d900a31a7c1', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '498dd295-362c-43e1-afa6-09a8b2946269'), -- timesheets.read
+('7c5faa38-5cf7-40c0-b4a9-863e7d8c8732', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '1e1ba906-cd68-42b6-b959-0f256dd87e43'), -- timesheets.create
+('88c9890a-1e71-4f5e-8077-8373416b3985', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'a5112cfd-0d08-43ed-9b78-55f5d23bb2ad'), -- timesheets.approve
+('5e2e59a8-30eb-4145-9a90-1debc85001bf', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'ab6a2bdf-97eb-4616-a7a5-7b67136510a6'), -- timesheets.fullAccess
+('d6188709-c021-4ad5-9b2b-237f906a9cb2', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'd796884d-a8c4-4525-81e7-54a3b6099eac', '498dd295-362c-43e1-afa6-09a8b2946269'), -- timesheets.read (member)
+('5a1dcf71-0164-4a1c-8c69-a304d5530f0e', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'd796884d-a8c4-4525-81e7-54a3b6099eac', '1e1ba906-cd68-42b6-b959-0f256dd87e43'), -- timesheets.create (member)
+('7e1b87df-e7a6-4daf-a4c9-ae80d129023f', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', '0ed4d996-669f-4198-8fa5-bacddc7f85dd', '498dd295-362c-43e1-afa6-09a8b2946269'), -- timesheets.read (project-lead)
+('779d97fe-b469-42db-aa05-b25c572e3190', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', '0ed4d996-669f-4198-8fa5-bacddc7f85dd', '1e1ba906-cd68-42b6-b959-0f256dd87e43'), -- timesheets.create (project-lead)
+('856c8900-a476-4cd0-83b9-5fc7f0dcb9e2', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', '0ed4d996-669f-4198-8fa5-bacddc7f85dd', 'a5112cfd-0d08-43ed-9b78-55f5d23bb2ad'), -- timesheets.approve (project-lead)
+('4131ef3d-f138-4032-9640-aa546c3b9f60', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', '11ccffea-2cc9-4e98-9bef-3464dfe4dec8', '498dd295-362c-43e1-afa6-09a8b2946269'), -- timesheets.read (engineering-manager)
+('d5835fa4-c537-4515-87cb-433272c322fa', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', '11ccffea-2cc9-4e98-9bef-3464dfe4dec8', '1e1ba906-cd68-42b6-b959-0f256dd87e43'), -- timesheets.create (engineering-manager)
+('95b261e0-6ac5-4804-a9b9-63bb9d4e5507', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', '11ccffea-2cc9-4e98-9bef-3464dfe4dec8', 'a5112cfd-0d08-43ed-9b78-55f5d23bb2ad'), -- timesheets.approve (engineering-manager)
+('69a40c31-8d5d-48cd-8f75-1e131a60bfc7', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '0234e78d-4f87-44b2-a68c-3e35a19359bd'), -- clientPortal.read
+('0dd70323-5483-4b60-bd84-11aec4ee738b', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'd3df9ccc-5833-4457-85da-3ef20cb0ce0b'), -- clientPortal.edit
+('fcb53b18-0f37-452e-84f2-3916320e166d', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'ab9ce6a3-7c39-48e0-a81b-bc067e80a2b4'), -- contracts.read
+('d192b27e-fbb1-47b5-8dc9-d338143ffaff', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'b1b49d9e-bb29-416f-8832-7c8a0f17754b'), -- contracts.edit
+('c388e218-4e2a-4bf5-8546-3c003a9f1bce', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '3ba89e4d-1820-46e7-b6c0-e0a94bf311be'), -- projects.budgets.read
+('8c9bf7de-1d13-49bd-ab67-a4c8252e2a08', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '77ad1710-302d-4ff1-b7cd-469b430aed40'), -- projects.budgets.edit
+('ac16862a-4f51-4ae4-a6a9-47fd00c2217a', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'e94cd4ac-ad83-4eb0-a748-4859e37de228'), -- icyRewards.read
+('829cfc7f-00cd-4880-aa19-e5928c96a404', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '9fe7a642-cb2a-40d8-9c9a-c116fc962ffc'), -- icyRewards.edit
+('f6c1b336-916e-4e91-9003-f00c184a34f6', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'e148b44a-1d44-4577-87d3-e67a1adffe22'), -- icyRewards.trigger
+('6bffbc52-f267-401c-a2c8-7239fb710eb7', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'bb374726-3eec-4caa-b115-342d47a7f869'), -- icyTreasury.read
+('085acfe3-6b7e-4338-b428-aad2991d460d', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '22aea880-2446-406c-bdee-684b693a3040'), -- officeLocations.read
+('ef4f9d50-19ab-41a1-b59d-9f4c7f006925', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '2ca72bd6-c56b-44bc-be42-eeef672b5391'), -- officeLocations.edit
+('b53dc8ef-6d84-4a5a-8016-4163013f1617', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '232ef5c0-459e-43af-82cf-bddad0150a0b'), -- officeLocations.qrCode
+('85e97624-fad1-4568-b455-019d110fa6d8', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'aef060e0-c682-4cd2-bf6c-ad324fe46fbd'), -- officeCheckins.create
+('50584643-f2f1-4ee3-b5dd-400112a5bf09', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '1965765f-4ebb-4f51-a212-c301a4abda54'), -- officeCheckins.read
+('0f511362-a76b-4aba-80d5-4a345f50dbf0', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'd796884d-a8c4-4525-81e7-54a3b6099eac', 'aef060e0-c682-4cd2-bf6c-ad324fe46fbd'), -- officeCheckins.create (member)
+('78e0f347-108c-430b-9a3f-67a22123269f', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '1aead5c5-f493-49ab-9549-cf2a491facce'), -- engagementAnalytics.read
+('e5bd5a92-05f3-4aaf-9e00-3bbd348e79cc', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'd05e879e-9712-4ab7-85a1-9b44cb628f7b'), -- engagementAnalytics.quietMembers.read
+('a9976778-44d7-4816-a8fc-5a67727f6e29', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'd796884d-a8c4-4525-81e7-54a3b6099eac', 'd05e879e-9712-4ab7-85a1-9b44cb628f7b'), -- engagementAnalytics.quietMembers.read (member)
+('c2a94864-f66a-45a9-890f-feb9ee83cdca', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'e6d09ee5-692b-4cec-ac76-e32f475ddbb4'), -- knowledgeBase.read
+('bf68f98b-bfe8-4ba6-93e0-d1de73ecaa90', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '41c694a6-fb67-492e-a342-b3e9a7a7729d'), -- knowledgeBase.edit
+('c5afc79b-86bc-4163-a912-0e4b2b504776', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'd796884d-a8c4-4525-81e7-54a3b6099eac', 'e6d09ee5-692b-4cec-ac76-e32f475ddbb4'), -- knowledgeBase.read (member)
+('0a271654-521e-4464-99ce-60ccfedca007', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '622428fc-c644-4a40-8765-156112710f1c'), -- reviewCycles.read
+('f206ce41-fce6-4901-a658-7d3141ee274f', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', 'afde687e-c8a4-4bd9-a2e7-442000c01771'), -- reviewCycles.edit
+('8101cb56-54d4-42d6-b954-93cd10604290', NULL, '2026-10-18 09:00:00.000000', '2026-10-18 09:00:00.000000', 'c23c1c1c-bfaf-41e6-a4d7-6ef196fd2736', '64c56c15-07f8-47e7-a781-5b5f9e0ac403'); -- reviewCycles.approve
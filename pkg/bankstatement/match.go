@@ -0,0 +1,162 @@
+package bankstatement
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// Weights of the signals a match is scored on, they sum up to 1
+const (
+	weightReference = 0.5
+	weightAmount    = 0.3
+	weightCurrency  = 0.1
+	weightDate      = 0.1
+)
+
+// minKeywordLength avoids matching short names or codes found by chance in a memo
+const minKeywordLength = 4
+
+// invoiceNumberRe finds the invoice numbers, e.g. 202412-KAFI-003, as generated by the invoice store
+var invoiceNumberRe = regexp.MustCompile(`(?i)\b(20\d{4,})[- ]?([A-Z][A-Z0-9]*)[- ]?(\d{3})\b`)
+
+// Candidate is a record a bank transaction may settle
+type Candidate struct {
+	Type      model.BankMatchType
+	ID        string
+	Reference string // what the match is displayed as, e.g. the invoice number or the employee name
+	Amount    float64
+	Currency  string
+	From      *time.Time // window in which the money is expected to move
+	To        *time.Time
+	Keywords  []string // texts identifying the record in the memo, e.g. the invoice number
+}
+
+// Match is a candidate with its score
+type Match struct {
+	Candidate
+	Score   float64
+	Reasons []string
+}
+
+// Options tunes the matching
+type Options struct {
+	// AmountTolerance is the accepted relative difference on the amount, to absorb the bank fees
+	AmountTolerance float64
+	// AutoMatchScore is the score from which a match is confirmed without review
+	AutoMatchScore float64
+	// ReviewScore is the score from which a match is suggested in the review queue
+	ReviewScore float64
+}
+
+// Score rates how likely a transaction settles a candidate, from 0 to 1
+func Score(tx *model.BankTransaction, c Candidate, opts Options) Match {
+	m := Match{Candidate: c}
+
+	if tx.Currency != "" && c.Currency != "" {
+		if !strings.EqualFold(tx.Currency, c.Currency) {
+			return m
+		}
+		m.Score += weightCurrency
+		m.Reasons = append(m.Reasons, "currency")
+	}
+
+	if c.Amount > 0 && math.Abs(tx.Amount-c.Amount) <= c.Amount*opts.AmountTolerance+0.005 {
+		m.Score += weightAmount
+		m.Reasons = append(m.Reasons, "amount")
+	}
+
+	text := Normalize(tx.Text())
+	for _, k := range c.Keywords {
+		if k = Normalize(k); len(k) >= minKeywordLength && strings.Contains(text, k) {
+			m.Score += weightReference
+			m.Reasons = append(m.Reasons, "reference")
+			break
+		}
+	}
+
+	day := tx.BookedAt
+	if (c.From != nil || c.To != nil) &&
+		(c.From == nil || !day.Before(truncateDay(*c.From))) &&
+		(c.To == nil || !day.After(truncateDay(*c.To))) {
+		m.Score += weightDate
+		m.Reasons = append(m.Reasons, "date")
+	}
+
+	m.Score = math.Round(m.Score*100) / 100
+	return m
+}
+
+// Best returns the best scored candidate reaching the review score. A best match tied
+// with another candidate is reported as ambiguous, it can't be confirmed automatically.
+func Best(tx *model.BankTransaction, candidates []Candidate, opts Options) (best *Match, ambiguous bool) {
+	matches := make([]Match, 0, len(candidates))
+	for _, c := range candidates {
+		if m := Score(tx, c, opts); m.Score >= opts.ReviewScore {
+			matches = append(matches, m)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, false
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	ambiguous = len(matches) > 1 && matches[1].Score == matches[0].Score
+	return &matches[0], ambiguous
+}
+
+// Status is the status a transaction gets for its best match
+func Status(best *Match, ambiguous bool, opts Options) model.BankTransactionStatus {
+	switch {
+	case best == nil:
+		return model.BankTransactionStatusUnmatched
+	case !ambiguous && best.Score >= opts.AutoMatchScore:
+		return model.BankTransactionStatusMatched
+	default:
+		return model.BankTransactionStatusReview
+	}
+}
+
+// InvoiceNumbers returns the invoice numbers written in a text, in their canonical form
+func InvoiceNumbers(text string) []string {
+	var numbers []string
+	for _, m := range invoiceNumberRe.FindAllStringSubmatch(text, -1) {
+		numbers = append(numbers, strings.ToUpper(m[1]+"-"+m[2]+"-"+m[3]))
+	}
+	return numbers
+}
+
+// Normalize uppercases a text and strips its accents, spaces and punctuation,
+// so "Nguyễn Văn A" is found in "NGUYEN VAN A CK LUONG"
+func Normalize(s string) string {
+	s = strings.NewReplacer("đ", "d", "Đ", "D").Replace(s)
+	// the transformer keeps a state, it can't be shared between goroutines
+	accentRemover := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	if out, _, err := transform.String(accentRemover, s); err == nil {
+		s = out
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToUpper(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,117 @@
+package bankstatement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+var testOptions = Options{AmountTolerance: 0.01, AutoMatchScore: 0.9, ReviewScore: 0.5}
+
+func date(y int, m time.Month, d int) *time.Time {
+	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestScore(t *testing.T) {
+	tx := &model.BankTransaction{
+		BookedAt: *date(2026, 9, 5),
+		Amount:   1495,
+		Currency: "USD",
+		Memo:     "Payment 202608-KAFI-003",
+	}
+	invoice := Candidate{
+		Type:      model.BankMatchTypeInvoice,
+		ID:        "1",
+		Reference: "202608-KAFI-003",
+		Amount:    1500,
+		Currency:  "USD",
+		From:      date(2026, 8, 31),
+		To:        date(2026, 10, 15),
+		Keywords:  []string{"202608-KAFI-003"},
+	}
+
+	t.Run("all signals", func(t *testing.T) {
+		m := Score(tx, invoice, testOptions)
+		assert.Equal(t, 1.0, m.Score)
+		assert.Equal(t, []string{"currency", "amount", "reference", "date"}, m.Reasons)
+	})
+
+	t.Run("amount out of tolerance", func(t *testing.T) {
+		c := invoice
+		c.Amount = 2000
+		assert.Equal(t, 0.7, Score(tx, c, testOptions).Score)
+	})
+
+	t.Run("currency mismatch", func(t *testing.T) {
+		c := invoice
+		c.Currency = "VND"
+		assert.Equal(t, 0.0, Score(tx, c, testOptions).Score)
+	})
+
+	t.Run("outside of the date window", func(t *testing.T) {
+		c := invoice
+		c.To = date(2026, 9, 1)
+		assert.Equal(t, 0.9, Score(tx, c, testOptions).Score)
+	})
+
+	t.Run("name written without accents", func(t *testing.T) {
+		payroll := &model.BankTransaction{BookedAt: *date(2026, 9, 30), Amount: 15000000, Memo: "CK LUONG NGUYEN VAN DUNG T9"}
+		c := Candidate{Type: model.BankMatchTypePayroll, Amount: 15000000, Currency: "VND", Keywords: []string{"Nguyễn Văn Dũng"}}
+		m := Score(payroll, c, testOptions)
+		assert.Equal(t, 0.8, m.Score)
+		assert.Contains(t, m.Reasons, "reference")
+	})
+}
+
+func TestBest(t *testing.T) {
+	tx := &model.BankTransaction{BookedAt: *date(2026, 9, 5), Amount: 1000, Currency: "USD"}
+
+	t.Run("no candidate reaches the review score", func(t *testing.T) {
+		best, ambiguous := Best(tx, []Candidate{{Amount: 50, Currency: "USD"}}, testOptions)
+		assert.Nil(t, best)
+		assert.False(t, ambiguous)
+		assert.Equal(t, model.BankTransactionStatusUnmatched, Status(best, ambiguous, testOptions))
+	})
+
+	t.Run("tied candidates are ambiguous", func(t *testing.T) {
+		candidates := []Candidate{
+			{ID: "1", Amount: 1000, Currency: "USD", From: date(2026, 9, 1)},
+			{ID: "2", Amount: 1000, Currency: "USD", From: date(2026, 9, 1)},
+		}
+		best, ambiguous := Best(tx, candidates, testOptions)
+		require.NotNil(t, best)
+		assert.True(t, ambiguous)
+		assert.Equal(t, model.BankTransactionStatusReview, Status(best, ambiguous, testOptions))
+	})
+
+	t.Run("reference decides", func(t *testing.T) {
+		withRef := *tx
+		withRef.Memo = "202608-KAFI-003"
+		candidates := []Candidate{
+			{ID: "1", Amount: 1000, Currency: "USD", Keywords: []string{"202608-KAFI-002"}},
+			{ID: "2", Amount: 1000, Currency: "USD", Keywords: []string{"202608-KAFI-003"}},
+		}
+		best, ambiguous := Best(&withRef, candidates, testOptions)
+		require.NotNil(t, best)
+		assert.Equal(t, "2", best.ID)
+		assert.False(t, ambiguous)
+		assert.Equal(t, model.BankTransactionStatusMatched, Status(best, ambiguous, testOptions))
+	})
+}
+
+func TestInvoiceNumbers(t *testing.T) {
+	assert.Equal(t, []string{"202608-KAFI-003", "202609-ACME-012"},
+		InvoiceNumbers("TT hoa don 202608 kafi 003 va 202609-ACME-012"))
+	assert.Empty(t, InvoiceNumbers("no invoice here 2026"))
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "NGUYENVANDUNG", Normalize("Nguyễn Văn Dũng"))
+	assert.Equal(t, "DANG", Normalize("đặng"))
+	assert.Equal(t, "202608KAFI003", Normalize("202608-KAFI-003"))
+}
@@ -0,0 +1,498 @@
+// Package bankstatement parses the bank statements exports and matches their
+// transactions against the invoices and payouts they settle
+package bankstatement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported bank statement format")
+	ErrMissingColumns    = errors.New("csv statement needs a date column and an amount or debit/credit columns")
+	ErrNoTransactions    = errors.New("bank statement has no transactions")
+)
+
+// Statement is a parsed statement file
+type Statement struct {
+	AccountNumber string
+	Currency      string
+	Transactions  []model.BankTransaction
+}
+
+// Period returns the first and last booking dates of the statement
+func (s *Statement) Period() (start, end *time.Time) {
+	for i := range s.Transactions {
+		d := s.Transactions[i].BookedAt
+		if start == nil || d.Before(*start) {
+			start = &d
+		}
+		if end == nil || d.After(*end) {
+			end = &d
+		}
+	}
+	return start, end
+}
+
+// Parse reads a statement in the given format
+func Parse(format model.BankStatementFormat, r io.Reader) (*Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	var stmt *Statement
+	switch format {
+	case model.BankStatementFormatCSV:
+		stmt, err = parseCSV(data)
+	case model.BankStatementFormatOFX:
+		stmt, err = parseOFX(data)
+	case model.BankStatementFormatCAMT053:
+		stmt, err = parseCAMT053(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(stmt.Transactions) == 0 {
+		return nil, ErrNoTransactions
+	}
+
+	for i := range stmt.Transactions {
+		if stmt.Transactions[i].Currency == "" {
+			stmt.Transactions[i].Currency = stmt.Currency
+		}
+		stmt.Transactions[i].Status = model.BankTransactionStatusUnmatched
+		stmt.Transactions[i].Fingerprint = stmt.Transactions[i].ComputeFingerprint(stmt.AccountNumber)
+	}
+
+	return stmt, nil
+}
+
+// csvColumns are the header names used by the banks for each field, compared lowercased
+// without spaces, dashes and underscores
+var csvColumns = map[string][]string{
+	"date":         {"date", "bookingdate", "bookeddate", "transactiondate", "posteddate", "postingdate", "valuedate", "ngaygiaodich"},
+	"amount":       {"amount", "transactionamount", "sotien"},
+	"credit":       {"credit", "creditamount", "moneyin", "paidin", "deposit", "ghico"},
+	"debit":        {"debit", "debitamount", "moneyout", "paidout", "withdrawal", "ghino"},
+	"currency":     {"currency", "ccy"},
+	"memo":         {"description", "memo", "details", "narrative", "remittanceinformation", "content", "noidung"},
+	"counterparty": {"counterparty", "payee", "payer", "name", "beneficiary", "counterpartyname"},
+	"reference":    {"reference", "ref", "transactionid", "transactionreference", "fitid", "id"},
+}
+
+func parseCSV(data []byte) (*Statement, error) {
+	records, err := readCSV(data, ',')
+	if err == nil && len(records) > 0 && len(records[0]) == 1 {
+		records, err = readCSV(data, ';')
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv statement: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, ErrNoTransactions
+	}
+
+	cols := map[string]int{}
+	for i, h := range records[0] {
+		name := normalizeHeader(h)
+		for field, aliases := range csvColumns {
+			if _, ok := cols[field]; ok {
+				continue
+			}
+			for _, a := range aliases {
+				if name == a {
+					cols[field] = i
+					break
+				}
+			}
+		}
+	}
+
+	_, hasAmount := cols["amount"]
+	_, hasCredit := cols["credit"]
+	_, hasDebit := cols["debit"]
+	if _, ok := cols["date"]; !ok || (!hasAmount && !(hasCredit || hasDebit)) {
+		return nil, ErrMissingColumns
+	}
+
+	get := func(record []string, field string) string {
+		i, ok := cols[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	stmt := &Statement{}
+	for n, record := range records[1:] {
+		if isBlank(record) {
+			continue
+		}
+
+		date, err := parseDate(get(record, "date"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+2, err)
+		}
+
+		var amount float64
+		if hasAmount && get(record, "amount") != "" {
+			amount, err = parseAmount(get(record, "amount"))
+		} else {
+			var credit, debit float64
+			if credit, err = parseOptionalAmount(get(record, "credit")); err == nil {
+				debit, err = parseOptionalAmount(get(record, "debit"))
+			}
+			amount = math.Abs(credit) - math.Abs(debit)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+2, err)
+		}
+		if amount == 0 {
+			continue
+		}
+
+		stmt.Transactions = append(stmt.Transactions, newTransaction(
+			date, amount, strings.ToUpper(get(record, "currency")),
+			get(record, "reference"), get(record, "counterparty"), get(record, "memo"), get(record, "reference"),
+		))
+	}
+
+	return stmt, nil
+}
+
+func readCSV(data []byte, delimiter rune) ([][]string, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = delimiter
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+	return r.ReadAll()
+}
+
+var headerReplacer = strings.NewReplacer(" ", "", "_", "", "-", "", ".", "", "(", "", ")", "")
+
+func normalizeHeader(h string) string {
+	return headerReplacer.Replace(strings.ToLower(strings.TrimSpace(h)))
+}
+
+func isBlank(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	ofxTransactionRe = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+	ofxFieldRes      = func() map[string]*regexp.Regexp {
+		res := map[string]*regexp.Regexp{}
+		for _, tag := range []string{"CURDEF", "ACCTID", "DTPOSTED", "TRNAMT", "FITID", "NAME", "MEMO", "CHECKNUM", "REFNUM"} {
+			res[tag] = regexp.MustCompile(`(?i)<` + tag + `>([^<\r\n]*)`)
+		}
+		return res
+	}()
+)
+
+// ofxField reads a field of an OFX block, OFX 1.x files are SGML and don't close the leaf tags
+func ofxField(block, tag string) string {
+	m := ofxFieldRes[tag].FindStringSubmatch(block)
+	if len(m) < 2 {
+		return ""
+	}
+	return strings.TrimSpace(xmlUnescape(m[1]))
+}
+
+func parseOFX(data []byte) (*Statement, error) {
+	content := string(data)
+	stmt := &Statement{
+		AccountNumber: ofxField(content, "ACCTID"),
+		Currency:      strings.ToUpper(ofxField(content, "CURDEF")),
+	}
+
+	for _, m := range ofxTransactionRe.FindAllStringSubmatch(content, -1) {
+		block := m[1]
+
+		posted := ofxField(block, "DTPOSTED")
+		if len(posted) < 8 {
+			return nil, fmt.Errorf("invalid ofx posted date %q", posted)
+		}
+		date, err := time.Parse("20060102", posted[:8])
+		if err != nil {
+			return nil, fmt.Errorf("invalid ofx posted date %q", posted)
+		}
+
+		amount, err := parseAmount(ofxField(block, "TRNAMT"))
+		if err != nil {
+			return nil, err
+		}
+		if amount == 0 {
+			continue
+		}
+
+		reference := ofxField(block, "REFNUM")
+		if reference == "" {
+			reference = ofxField(block, "CHECKNUM")
+		}
+
+		stmt.Transactions = append(stmt.Transactions, newTransaction(
+			date, amount, "", ofxField(block, "FITID"), ofxField(block, "NAME"), ofxField(block, "MEMO"), reference,
+		))
+	}
+
+	return stmt, nil
+}
+
+// camtDocument is the part of a CAMT.053 (BankToCustomerStatement) document we read,
+// the namespaces are ignored so every version of the schema is accepted
+type camtDocument struct {
+	Statements []struct {
+		Account struct {
+			IBAN     string `xml:"Id>IBAN"`
+			Other    string `xml:"Id>Othr>Id"`
+			Currency string `xml:"Ccy"`
+		} `xml:"Acct"`
+		Entries []camtEntry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtEntry struct {
+	Amount struct {
+		Value    string `xml:",chardata"`
+		Currency string `xml:"Ccy,attr"`
+	} `xml:"Amt"`
+	CreditDebit     string `xml:"CdtDbtInd"`
+	BookingDate     string `xml:"BookgDt>Dt"`
+	BookingDateTime string `xml:"BookgDt>DtTm"`
+	ValueDate       string `xml:"ValDt>Dt"`
+	ServicerRef     string `xml:"AcctSvcrRef"`
+	EntryRef        string `xml:"NtryRef"`
+	AdditionalInfo  string `xml:"AddtlNtryInf"`
+	Details         []struct {
+		EndToEndID     string   `xml:"Refs>EndToEndId"`
+		ServicerRef    string   `xml:"Refs>AcctSvcrRef"`
+		DebtorName     string   `xml:"RltdPties>Dbtr>Nm"`
+		DebtorPartyNm  string   `xml:"RltdPties>Dbtr>Pty>Nm"`
+		CreditorName   string   `xml:"RltdPties>Cdtr>Nm"`
+		CreditorPtyNm  string   `xml:"RltdPties>Cdtr>Pty>Nm"`
+		Unstructured   []string `xml:"RmtInf>Ustrd"`
+		StructuredRef  string   `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+		AdditionalInfo string   `xml:"AddtlTxInf"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+func parseCAMT053(data []byte) (*Statement, error) {
+	var doc camtDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid camt.053 statement: %w", err)
+	}
+
+	stmt := &Statement{}
+	for _, s := range doc.Statements {
+		if stmt.AccountNumber == "" {
+			stmt.AccountNumber = s.Account.IBAN
+			if stmt.AccountNumber == "" {
+				stmt.AccountNumber = s.Account.Other
+			}
+		}
+		if stmt.Currency == "" {
+			stmt.Currency = strings.ToUpper(s.Account.Currency)
+		}
+
+		for _, e := range s.Entries {
+			tx, err := camtTransaction(e)
+			if err != nil {
+				return nil, err
+			}
+			if tx != nil {
+				stmt.Transactions = append(stmt.Transactions, *tx)
+			}
+		}
+	}
+
+	return stmt, nil
+}
+
+func camtTransaction(e camtEntry) (*model.BankTransaction, error) {
+	dateStr := e.BookingDate
+	if dateStr == "" && len(e.BookingDateTime) >= 10 {
+		dateStr = e.BookingDateTime[:10]
+	}
+	if dateStr == "" {
+		dateStr = e.ValueDate
+	}
+	date, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid camt.053 booking date %q", dateStr)
+	}
+
+	amount, err := parseAmount(e.Amount.Value)
+	if err != nil {
+		return nil, err
+	}
+	if amount == 0 {
+		return nil, nil
+	}
+	if strings.EqualFold(e.CreditDebit, "DBIT") {
+		amount = -math.Abs(amount)
+	}
+
+	externalID := e.ServicerRef
+	if externalID == "" {
+		externalID = e.EntryRef
+	}
+
+	var counterparty, reference string
+	memo := []string{}
+	for _, d := range e.Details {
+		if externalID == "" {
+			externalID = d.ServicerRef
+		}
+		if reference == "" {
+			reference = firstNonEmpty(d.StructuredRef, d.EndToEndID)
+		}
+		if counterparty == "" {
+			if amount > 0 {
+				counterparty = firstNonEmpty(d.DebtorName, d.DebtorPartyNm)
+			} else {
+				counterparty = firstNonEmpty(d.CreditorName, d.CreditorPtyNm)
+			}
+		}
+		memo = append(memo, d.Unstructured...)
+		if d.AdditionalInfo != "" {
+			memo = append(memo, d.AdditionalInfo)
+		}
+	}
+	if e.AdditionalInfo != "" {
+		memo = append(memo, e.AdditionalInfo)
+	}
+	if strings.EqualFold(reference, "NOTPROVIDED") {
+		reference = ""
+	}
+
+	tx := newTransaction(date, amount, strings.ToUpper(e.Amount.Currency), externalID, counterparty, strings.Join(memo, " "), reference)
+	return &tx, nil
+}
+
+func newTransaction(date time.Time, amount float64, currency, externalID, counterparty, memo, reference string) model.BankTransaction {
+	direction := model.BankTransactionDirectionCredit
+	if amount < 0 {
+		direction = model.BankTransactionDirectionDebit
+	}
+
+	return model.BankTransaction{
+		ExternalID:   externalID,
+		BookedAt:     date,
+		Direction:    direction,
+		Amount:       math.Abs(amount),
+		Currency:     currency,
+		Counterparty: counterparty,
+		Memo:         memo,
+		Reference:    reference,
+	}
+}
+
+// dateLayouts are tried in order, day first dates are preferred over month first ones
+// since that's what the Vietnamese and European banks export
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+	"02/01/2006",
+	"02/01/2006 15:04:05",
+	"02-01-2006",
+	"02.01.2006",
+	"2006/01/02",
+	"20060102",
+	"2 Jan 2006",
+	"02 Jan 2006",
+	"Jan 2, 2006",
+}
+
+func parseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if d, err := time.Parse(layout, s); err == nil {
+			return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+var amountCleaner = regexp.MustCompile(`[^0-9,.\-+]`)
+
+// parseAmount reads amounts written with any thousands separator, e.g. 1,234.56, 1.234,56 or 1 234
+func parseAmount(s string) (float64, error) {
+	raw := strings.TrimSpace(s)
+	negative := strings.HasPrefix(raw, "(") && strings.HasSuffix(raw, ")")
+	clean := amountCleaner.ReplaceAllString(raw, "")
+	if clean == "" {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	lastComma, lastDot := strings.LastIndex(clean, ","), strings.LastIndex(clean, ".")
+	switch {
+	case lastComma >= 0 && lastDot >= 0:
+		if lastComma > lastDot {
+			clean = strings.ReplaceAll(clean, ".", "")
+			clean = strings.Replace(clean, ",", ".", 1)
+		} else {
+			clean = strings.ReplaceAll(clean, ",", "")
+		}
+	case lastComma >= 0:
+		// a single comma followed by 1 or 2 digits is a decimal separator
+		if strings.Count(clean, ",") == 1 && len(clean)-lastComma-1 <= 2 {
+			clean = strings.Replace(clean, ",", ".", 1)
+		} else {
+			clean = strings.ReplaceAll(clean, ",", "")
+		}
+	case lastDot >= 0 && strings.Count(clean, ".") > 1:
+		clean = strings.ReplaceAll(clean, ".", "")
+	}
+
+	amount, err := strconv.ParseFloat(clean, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	if negative {
+		amount = -math.Abs(amount)
+	}
+	return amount, nil
+}
+
+func parseOptionalAmount(s string) (float64, error) {
+	if strings.TrimSpace(s) == "" {
+		return 0, nil
+	}
+	return parseAmount(s)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+var xmlEntities = strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">", "&quot;", `"`, "&apos;", "'")
+
+func xmlUnescape(s string) string {
+	return xmlEntities.Replace(s)
+}
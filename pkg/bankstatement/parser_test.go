@@ -0,0 +1,188 @@
+package bankstatement
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func TestParseCSV(t *testing.T) {
+	t.Run("signed amount column", func(t *testing.T) {
+		data := "Date,Description,Amount,Currency,Reference\n" +
+			"2026-09-05,Payment for 202608-KAFI-003,\"1,500.00\",usd,FT123\n" +
+			"2026-09-06,Bank fee,-2.5,USD,FT124\n" +
+			",,,,\n"
+
+		stmt, err := Parse(model.BankStatementFormatCSV, strings.NewReader(data))
+		require.NoError(t, err)
+		require.Len(t, stmt.Transactions, 2)
+
+		in := stmt.Transactions[0]
+		assert.Equal(t, time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC), in.BookedAt)
+		assert.Equal(t, model.BankTransactionDirectionCredit, in.Direction)
+		assert.Equal(t, 1500.0, in.Amount)
+		assert.Equal(t, "USD", in.Currency)
+		assert.Equal(t, "FT123", in.ExternalID)
+		assert.Equal(t, model.BankTransactionStatusUnmatched, in.Status)
+		assert.NotEmpty(t, in.Fingerprint)
+
+		out := stmt.Transactions[1]
+		assert.Equal(t, model.BankTransactionDirectionDebit, out.Direction)
+		assert.Equal(t, 2.5, out.Amount)
+	})
+
+	t.Run("semicolon delimited with debit and credit columns", func(t *testing.T) {
+		data := "Ngay giao dich;Noi dung;Ghi no;Ghi co\n" +
+			"05/09/2026;CK LUONG NGUYEN VAN A;15.000.000;\n" +
+			"06/09/2026;TT HOA DON;;2.000.000\n"
+
+		stmt, err := Parse(model.BankStatementFormatCSV, strings.NewReader(data))
+		require.NoError(t, err)
+		require.Len(t, stmt.Transactions, 2)
+		assert.Equal(t, model.BankTransactionDirectionDebit, stmt.Transactions[0].Direction)
+		assert.Equal(t, 15000000.0, stmt.Transactions[0].Amount)
+		assert.Equal(t, time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC), stmt.Transactions[0].BookedAt)
+		assert.Equal(t, model.BankTransactionDirectionCredit, stmt.Transactions[1].Direction)
+		assert.Equal(t, 2000000.0, stmt.Transactions[1].Amount)
+	})
+
+	t.Run("missing amount column", func(t *testing.T) {
+		_, err := Parse(model.BankStatementFormatCSV, strings.NewReader("Date,Description\n2026-09-05,foo\n"))
+		assert.ErrorIs(t, err, ErrMissingColumns)
+	})
+
+	t.Run("no transactions", func(t *testing.T) {
+		_, err := Parse(model.BankStatementFormatCSV, strings.NewReader("Date,Amount\n"))
+		assert.ErrorIs(t, err, ErrNoTransactions)
+	})
+}
+
+func TestParseOFX(t *testing.T) {
+	data := `OFXHEADER:100
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKACCTFROM><ACCTID>123456789</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20260905120000[0:GMT]
+<TRNAMT>1500.00
+<FITID>A1
+<NAME>ACME Corp
+<MEMO>Invoice 202608-KAFI-003 &amp; fees
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20260906
+<TRNAMT>-20.00
+<FITID>A2
+<NAME>Bank
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+	stmt, err := Parse(model.BankStatementFormatOFX, strings.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "123456789", stmt.AccountNumber)
+	assert.Equal(t, "USD", stmt.Currency)
+	require.Len(t, stmt.Transactions, 2)
+
+	in := stmt.Transactions[0]
+	assert.Equal(t, "A1", in.ExternalID)
+	assert.Equal(t, "ACME Corp", in.Counterparty)
+	assert.Equal(t, "Invoice 202608-KAFI-003 & fees", in.Memo)
+	assert.Equal(t, "USD", in.Currency)
+	assert.Equal(t, model.BankTransactionDirectionCredit, in.Direction)
+
+	assert.Equal(t, model.BankTransactionDirectionDebit, stmt.Transactions[1].Direction)
+	assert.Equal(t, 20.0, stmt.Transactions[1].Amount)
+
+	start, end := stmt.Period()
+	require.NotNil(t, start)
+	require.NotNil(t, end)
+	assert.Equal(t, time.Date(2026, 9, 5, 0, 0, 0, 0, time.UTC), *start)
+	assert.Equal(t, time.Date(2026, 9, 6, 0, 0, 0, 0, time.UTC), *end)
+}
+
+func TestParseCAMT053(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+<BkToCstmrStmt><Stmt>
+<Acct><Id><IBAN>NL91ABNA0417164300</IBAN></Id><Ccy>EUR</Ccy></Acct>
+<Ntry>
+<Amt Ccy="EUR">1200.50</Amt><CdtDbtInd>CRDT</CdtDbtInd>
+<BookgDt><Dt>2026-09-05</Dt></BookgDt>
+<AcctSvcrRef>REF-1</AcctSvcrRef>
+<NtryDtls><TxDtls>
+<Refs><EndToEndId>NOTPROVIDED</EndToEndId></Refs>
+<RltdPties><Dbtr><Nm>ACME BV</Nm></Dbtr><Cdtr><Nm>Dwarves</Nm></Cdtr></RltdPties>
+<RmtInf><Ustrd>202608-ACME-001</Ustrd></RmtInf>
+</TxDtls></NtryDtls>
+</Ntry>
+<Ntry>
+<Amt Ccy="EUR">30</Amt><CdtDbtInd>DBIT</CdtDbtInd>
+<BookgDt><DtTm>2026-09-07T10:00:00</DtTm></BookgDt>
+<NtryDtls><TxDtls>
+<RltdPties><Cdtr><Nm>Hosting Ltd</Nm></Cdtr></RltdPties>
+</TxDtls></NtryDtls>
+</Ntry>
+</Stmt></BkToCstmrStmt>
+</Document>`
+
+	stmt, err := Parse(model.BankStatementFormatCAMT053, strings.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "NL91ABNA0417164300", stmt.AccountNumber)
+	assert.Equal(t, "EUR", stmt.Currency)
+	require.Len(t, stmt.Transactions, 2)
+
+	in := stmt.Transactions[0]
+	assert.Equal(t, "REF-1", in.ExternalID)
+	assert.Equal(t, "ACME BV", in.Counterparty)
+	assert.Equal(t, "202608-ACME-001", in.Memo)
+	assert.Empty(t, in.Reference)
+	assert.Equal(t, 1200.5, in.Amount)
+
+	out := stmt.Transactions[1]
+	assert.Equal(t, model.BankTransactionDirectionDebit, out.Direction)
+	assert.Equal(t, "Hosting Ltd", out.Counterparty)
+	assert.Equal(t, time.Date(2026, 9, 7, 0, 0, 0, 0, time.UTC), out.BookedAt)
+}
+
+func TestParseUnsupportedFormat(t *testing.T) {
+	_, err := Parse("pdf", strings.NewReader("x"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in   string
+		want float64
+	}{
+		{"1500", 1500},
+		{"1,234.56", 1234.56},
+		{"1.234,56", 1234.56},
+		{"15.000.000", 15000000},
+		{"15,000,000", 15000000},
+		{"12,5", 12.5},
+		{"-20.00", -20},
+		{"(20.00)", -20},
+		{"1 234 VND", 1234},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseAmount(tt.in)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 0.0001)
+		})
+	}
+
+	_, err := parseAmount("abc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,179 @@
+// Package budget follows how the invoices of a project draw its purchase order down, which burn
+// thresholds are crossed and when the budget runs out at the current team cost
+package budget
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultThresholds are the burn percents alerted on when none are configured
+var DefaultThresholds = []int64{50, 80, 100}
+
+// ParseThresholds parses comma separated percents, sorted and without duplicates. The default
+// thresholds are returned when there is none or one is not a positive number.
+func ParseThresholds(s string) []int64 {
+	parts := strings.Split(s, ",")
+	thresholds := make([]int64, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(p, 10, 64)
+		if err != nil || v <= 0 {
+			return DefaultThresholds
+		}
+		thresholds = append(thresholds, v)
+	}
+	if len(thresholds) == 0 {
+		return DefaultThresholds
+	}
+	return Normalize(thresholds)
+}
+
+// Normalize sorts the thresholds and drops the duplicates and the non positive ones
+func Normalize(thresholds []int64) []int64 {
+	sorted := append([]int64(nil), thresholds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rs := make([]int64, 0, len(sorted))
+	for _, t := range sorted {
+		if t <= 0 || (len(rs) > 0 && rs[len(rs)-1] == t) {
+			continue
+		}
+		rs = append(rs, t)
+	}
+	return rs
+}
+
+// BurnPercent is the share of the budget drawn, in percent
+func BurnPercent(drawn, total float64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return drawn / total * 100
+}
+
+// CrossedThreshold returns the highest threshold reached by the burn above the one already
+// alerted on, false when there is no new threshold to alert on
+func CrossedThreshold(thresholds []int64, alerted int64, burn float64) (int64, bool) {
+	var crossed int64
+	for _, t := range thresholds {
+		if t > alerted && burn >= float64(t) {
+			crossed = t
+		}
+	}
+	return crossed, crossed > 0
+}
+
+// Draw is an amount drawn from the budget in a month
+type Draw struct {
+	Month  time.Time
+	Amount float64
+}
+
+// Point is a month of the burn down, Projected when it is after the current month
+type Point struct {
+	Month     time.Time
+	Drawn     float64
+	Burned    float64 // cumulative
+	Remaining float64
+	Projected bool
+}
+
+// Projection is when the budget runs out if the team keeps costing the same every month
+type Projection struct {
+	MonthlyCost      float64
+	MonthsLeft       float64    // months until the budget runs out, -1 when it never does
+	ExhaustedAt      *time.Time // the month the budget runs out
+	ProjectedAtEnd   float64    // the burn at the end of the budget, when it has one
+	ProjectedOverrun float64    // how much over the budget the burn ends, 0 when within
+}
+
+func monthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// monthsBetween counts the months from the month after today to the month of end
+func monthsBetween(today, end time.Time) int {
+	from, to := monthOf(today), monthOf(end)
+	n := (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// Project projects the burn of the budget from the month after today at the monthly team cost
+func Project(total, drawn, monthlyCost float64, today time.Time, end *time.Time) Projection {
+	p := Projection{MonthlyCost: monthlyCost, MonthsLeft: -1}
+
+	remaining := total - drawn
+	switch {
+	case remaining <= 0:
+		p.MonthsLeft = 0
+		month := monthOf(today)
+		p.ExhaustedAt = &month
+	case monthlyCost > 0:
+		p.MonthsLeft = math.Round(remaining/monthlyCost*10) / 10
+		month := monthOf(today).AddDate(0, int(math.Ceil(remaining/monthlyCost)), 0)
+		p.ExhaustedAt = &month
+	}
+
+	if end != nil {
+		p.ProjectedAtEnd = drawn + monthlyCost*float64(monthsBetween(today, *end))
+		if p.ProjectedAtEnd > total {
+			p.ProjectedOverrun = p.ProjectedAtEnd - total
+		}
+	}
+
+	return p
+}
+
+// BurnDown returns the budget month by month from its start: what was drawn up to the month of
+// today, then the team cost until its end, or until it runs out when it is open ended
+func BurnDown(total float64, start time.Time, end *time.Time, draws []Draw, monthlyCost float64, today time.Time) []Point {
+	drawn := map[time.Time]float64{}
+	last := monthOf(today)
+	for _, d := range draws {
+		m := monthOf(d.Month)
+		drawn[m] += d.Amount
+		if m.After(last) {
+			last = m
+		}
+	}
+
+	// an open ended budget is projected until it runs out, a year at most
+	until := last.AddDate(1, 0, 0)
+	if end != nil {
+		until = monthOf(*end)
+		if until.Before(last) {
+			until = last
+		}
+	}
+
+	var (
+		points []Point
+		burned float64
+	)
+	for m := monthOf(start); !m.After(until); m = m.AddDate(0, 1, 0) {
+		p := Point{Month: m, Drawn: drawn[m]}
+		if m.After(monthOf(today)) && p.Drawn == 0 {
+			if end == nil && burned >= total {
+				break
+			}
+			p.Drawn = monthlyCost
+			p.Projected = true
+		}
+		burned += p.Drawn
+		p.Burned = burned
+		p.Remaining = total - burned
+		points = append(points, p)
+	}
+
+	return points
+}
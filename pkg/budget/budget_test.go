@@ -0,0 +1,84 @@
+package budget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func month(year int, m time.Month) time.Time {
+	return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseThresholds(t *testing.T) {
+	require.Equal(t, []int64{50, 80, 100}, ParseThresholds(""))
+	require.Equal(t, []int64{25, 75, 100}, ParseThresholds("100, 25,75,75"))
+	require.Equal(t, DefaultThresholds, ParseThresholds("50,abc"))
+	require.Equal(t, DefaultThresholds, ParseThresholds("50,-10"))
+}
+
+func TestCrossedThreshold(t *testing.T) {
+	thresholds := []int64{50, 80, 100}
+
+	_, ok := CrossedThreshold(thresholds, 0, 49.9)
+	require.False(t, ok)
+
+	crossed, ok := CrossedThreshold(thresholds, 0, 85)
+	require.True(t, ok)
+	require.Equal(t, int64(80), crossed)
+
+	// alerted once per threshold
+	_, ok = CrossedThreshold(thresholds, 80, 95)
+	require.False(t, ok)
+
+	crossed, ok = CrossedThreshold(thresholds, 80, 120)
+	require.True(t, ok)
+	require.Equal(t, int64(100), crossed)
+}
+
+func TestProject(t *testing.T) {
+	today := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	p := Project(100000, 40000, 20000, today, &end)
+	require.Equal(t, 3.0, p.MonthsLeft)
+	require.Equal(t, month(2027, 1), *p.ExhaustedAt)
+	// 5 more months from November to March
+	require.Equal(t, 140000.0, p.ProjectedAtEnd)
+	require.Equal(t, 40000.0, p.ProjectedOverrun)
+
+	p = Project(100000, 40000, 0, today, nil)
+	require.Equal(t, -1.0, p.MonthsLeft)
+	require.Nil(t, p.ExhaustedAt)
+	require.Zero(t, p.ProjectedOverrun)
+
+	p = Project(100000, 110000, 20000, today, &end)
+	require.Zero(t, p.MonthsLeft)
+	require.Equal(t, month(2026, 10), *p.ExhaustedAt)
+}
+
+func TestBurnDown(t *testing.T) {
+	today := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	points := BurnDown(100000, time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC), &end, []Draw{
+		{Month: month(2026, 8), Amount: 30000},
+		{Month: month(2026, 9), Amount: 20000},
+		{Month: month(2026, 9), Amount: 5000},
+	}, 30000, today)
+
+	require.Len(t, points, 5)
+	require.Equal(t, 55000.0, points[1].Burned)
+	// nothing invoiced yet in the current month
+	require.False(t, points[2].Projected)
+	require.Equal(t, 45000.0, points[2].Remaining)
+	require.True(t, points[3].Projected)
+	require.Equal(t, 15000.0, points[3].Remaining)
+	require.Equal(t, -15000.0, points[4].Remaining)
+
+	// an open ended budget stops once it runs out
+	points = BurnDown(60000, month(2026, 10), nil, nil, 30000, today)
+	require.Len(t, points, 3)
+	require.Equal(t, 0.0, points[2].Remaining)
+}
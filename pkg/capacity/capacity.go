@@ -0,0 +1,335 @@
+// Package capacity projects the allocation of the employees week by week from their deployments,
+// their approved leave and the demand of the project slots, to see the bench and the unstaffed
+// slots coming before they happen
+package capacity
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+const (
+	workingDays = 5
+	// BenchThreshold is the free share of a week from which an employee is on the bench
+	BenchThreshold = 0.5
+)
+
+// Weights is the share of the week a member spends on the project, by deployment type
+type Weights struct {
+	Official float64
+	PartTime float64
+	Shadow   float64
+}
+
+func (w Weights) of(t model.DeploymentType) float64 {
+	switch t {
+	case model.MemberDeploymentTypePartTime:
+		return w.PartTime
+	case model.MemberDeploymentTypeShadow:
+		return w.Shadow
+	default:
+		return w.Official
+	}
+}
+
+type Employee struct {
+	ID        string
+	Name      string
+	Seniority string
+	Stacks    []string
+}
+
+// Deployment is an employee deployed on a project, WhatIf marks the ones modelled and not committed
+type Deployment struct {
+	ID             string
+	EmployeeID     string
+	ProjectID      string
+	ProjectName    string
+	SlotID         string
+	DeploymentType model.DeploymentType
+	StartDate      *time.Time
+	EndDate        *time.Time
+	WhatIf         bool
+}
+
+// Leave is an approved day off, HalfDay when only a shift of each day is taken
+type Leave struct {
+	EmployeeID string
+	StartDate  time.Time
+	EndDate    time.Time
+	HalfDay    bool
+}
+
+// Slot is a seat of a project to staff until the project ends
+type Slot struct {
+	ID             string
+	ProjectID      string
+	ProjectName    string
+	Seniority      string
+	DeploymentType model.DeploymentType
+	ProjectEndDate *time.Time
+}
+
+type Input struct {
+	From        time.Time
+	Weeks       int
+	Weights     Weights
+	Employees   []Employee
+	Deployments []Deployment
+	Leaves      []Leave
+	Slots       []Slot
+}
+
+// Week is the capacity of a week in FTE. Allocated counts every deployment, Billable leaves the
+// shadow ones out, and Bench is the capacity not billed. Unstaffed is the demand of the slots
+// nobody covers.
+type Week struct {
+	Start     time.Time
+	Capacity  float64
+	Allocated float64
+	Billable  float64
+	Bench     float64
+	Unstaffed float64
+}
+
+type EmployeePlan struct {
+	Employee
+	Weeks []Week
+	// BenchFrom is the first week the employee has at least BenchThreshold of the week free
+	BenchFrom  *time.Time
+	BenchWeeks int
+}
+
+// GroupPlan sums the weeks of the employees of a stack or a seniority, an employee with several
+// stacks counts in each of them
+type GroupPlan struct {
+	Name      string
+	Headcount int
+	Weeks     []Week
+}
+
+// SlotGap is a slot left without anyone for some of the weeks
+type SlotGap struct {
+	Slot
+	UnstaffedFrom time.Time
+	Weeks         int
+}
+
+type Plan struct {
+	Weeks          []time.Time
+	Employees      []EmployeePlan
+	Stacks         []GroupPlan
+	Seniorities    []GroupPlan
+	Total          []Week
+	UnstaffedSlots []SlotGap
+}
+
+// Build projects the weeks from the week of From
+func Build(in Input) Plan {
+	weeks := Weeks(in.From, in.Weeks)
+	plan := Plan{
+		Weeks:          weeks,
+		Employees:      make([]EmployeePlan, 0, len(in.Employees)),
+		Total:          emptyWeeks(weeks),
+		UnstaffedSlots: []SlotGap{},
+	}
+
+	deployments := map[string][]Deployment{}
+	for _, d := range in.Deployments {
+		deployments[d.EmployeeID] = append(deployments[d.EmployeeID], d)
+	}
+	leaves := map[string][]Leave{}
+	for _, l := range in.Leaves {
+		leaves[l.EmployeeID] = append(leaves[l.EmployeeID], l)
+	}
+
+	stacks := map[string]*GroupPlan{}
+	seniorities := map[string]*GroupPlan{}
+	group := func(groups map[string]*GroupPlan, name string) *GroupPlan {
+		g, ok := groups[name]
+		if !ok {
+			g = &GroupPlan{Name: name, Weeks: emptyWeeks(weeks)}
+			groups[name] = g
+		}
+		return g
+	}
+
+	for _, e := range in.Employees {
+		p := EmployeePlan{Employee: e, Weeks: emptyWeeks(weeks)}
+		for i, start := range weeks {
+			w := &p.Weeks[i]
+			w.Capacity = capacityOf(start, leaves[e.ID])
+			for _, d := range deployments[e.ID] {
+				share := in.Weights.of(d.DeploymentType) * coverage(start, d.StartDate, d.EndDate)
+				w.Allocated += share
+				if d.DeploymentType != model.MemberDeploymentTypeShadow {
+					w.Billable += share
+				}
+			}
+			if w.Capacity > w.Billable {
+				w.Bench = w.Capacity - w.Billable
+			}
+			if w.Bench >= BenchThreshold {
+				if p.BenchFrom == nil {
+					benchFrom := start
+					p.BenchFrom = &benchFrom
+				}
+				p.BenchWeeks++
+			}
+		}
+		plan.Employees = append(plan.Employees, p)
+
+		groups := []*GroupPlan{group(seniorities, e.Seniority)}
+		for _, s := range e.Stacks {
+			groups = append(groups, group(stacks, s))
+		}
+		for _, g := range groups {
+			g.Headcount++
+			add(g.Weeks, p.Weeks)
+		}
+		add(plan.Total, p.Weeks)
+	}
+
+	staffed := map[string][]Deployment{}
+	for _, d := range in.Deployments {
+		if d.SlotID != "" {
+			staffed[d.SlotID] = append(staffed[d.SlotID], d)
+		}
+	}
+	for _, s := range in.Slots {
+		var gap *SlotGap
+		for i, start := range weeks {
+			if s.ProjectEndDate != nil && s.ProjectEndDate.Before(start) {
+				break
+			}
+			covered := 0.0
+			for _, d := range staffed[s.ID] {
+				covered += coverage(start, d.StartDate, d.EndDate)
+			}
+			if covered > 0 {
+				continue
+			}
+
+			demand := in.Weights.of(s.DeploymentType)
+			group(seniorities, s.Seniority).Weeks[i].Unstaffed += demand
+			plan.Total[i].Unstaffed += demand
+			if gap == nil {
+				gap = &SlotGap{Slot: s, UnstaffedFrom: start}
+			}
+			gap.Weeks++
+		}
+		if gap != nil {
+			plan.UnstaffedSlots = append(plan.UnstaffedSlots, *gap)
+		}
+	}
+
+	plan.Stacks = sortedGroups(stacks)
+	plan.Seniorities = sortedGroups(seniorities)
+	sort.SliceStable(plan.UnstaffedSlots, func(i, j int) bool {
+		return plan.UnstaffedSlots[i].UnstaffedFrom.Before(plan.UnstaffedSlots[j].UnstaffedFrom)
+	})
+	return plan
+}
+
+// Weeks returns the Mondays of the n weeks from the week of from
+func Weeks(from time.Time, n int) []time.Time {
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7
+	monday := day.AddDate(0, 0, -offset)
+
+	weeks := make([]time.Time, 0, n)
+	for i := 0; i < n; i++ {
+		weeks = append(weeks, monday.AddDate(0, 0, 7*i))
+	}
+	return weeks
+}
+
+// coverage returns the share of the working days of the week within [start, end]
+func coverage(week time.Time, start, end *time.Time) float64 {
+	days := 0
+	for i := 0; i < workingDays; i++ {
+		day := week.AddDate(0, 0, i)
+		if start != nil && day.Before(truncateDay(*start)) {
+			continue
+		}
+		if end != nil && day.After(truncateDay(*end)) {
+			continue
+		}
+		days++
+	}
+	return float64(days) / workingDays
+}
+
+// capacityOf returns the share of the working days of the week not on leave
+func capacityOf(week time.Time, leaves []Leave) float64 {
+	off := 0.0
+	for i := 0; i < workingDays; i++ {
+		day := week.AddDate(0, 0, i)
+		dayOff := 0.0
+		for _, l := range leaves {
+			if day.Before(truncateDay(l.StartDate)) || day.After(truncateDay(l.EndDate)) {
+				continue
+			}
+			share := 1.0
+			if l.HalfDay {
+				share = 0.5
+			}
+			if share > dayOff {
+				dayOff = share
+			}
+		}
+		off += dayOff
+	}
+	return (workingDays - off) / workingDays
+}
+
+func emptyWeeks(weeks []time.Time) []Week {
+	rs := make([]Week, len(weeks))
+	for i, start := range weeks {
+		rs[i].Start = start
+	}
+	return rs
+}
+
+func add(dst, src []Week) {
+	for i := range src {
+		dst[i].Capacity += src[i].Capacity
+		dst[i].Allocated += src[i].Allocated
+		dst[i].Billable += src[i].Billable
+		dst[i].Bench += src[i].Bench
+	}
+}
+
+func sortedGroups(groups map[string]*GroupPlan) []GroupPlan {
+	rs := make([]GroupPlan, 0, len(groups))
+	for _, g := range groups {
+		rs = append(rs, *g)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].Name < rs[j].Name
+	})
+	return rs
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Availability returns the share of the first week allocated and the first week with at least
+// share of it free, false when no week has. A week fully on leave frees no one.
+func (p EmployeePlan) Availability(share float64) (utilization float64, from time.Time, ok bool) {
+	if len(p.Weeks) == 0 {
+		return 0, time.Time{}, false
+	}
+
+	utilization = math.Min(1, p.Weeks[0].Allocated)
+	for _, w := range p.Weeks {
+		if w.Capacity > 0 && 1-w.Allocated >= share {
+			return utilization, w.Start, true
+		}
+	}
+	return utilization, time.Time{}, false
+}
@@ -0,0 +1,182 @@
+package capacity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func date(year int, month time.Month, day int) *time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+var weights = Weights{Official: 1, PartTime: 0.5, Shadow: 1}
+
+func TestWeeks(t *testing.T) {
+	// Wednesday 2026-10-21
+	weeks := Weeks(*date(2026, 10, 21), 3)
+	require.Equal(t, []time.Time{*date(2026, 10, 19), *date(2026, 10, 26), *date(2026, 11, 2)}, weeks)
+
+	// a Sunday belongs to the week started the Monday before
+	require.Equal(t, *date(2026, 10, 19), Weeks(*date(2026, 10, 25), 1)[0])
+}
+
+func TestBuild_Allocation(t *testing.T) {
+	in := Input{
+		From:    *date(2026, 10, 19),
+		Weeks:   3,
+		Weights: weights,
+		Employees: []Employee{
+			{ID: "alice", Seniority: "senior", Stacks: []string{"go", "react"}},
+			{ID: "bob", Seniority: "junior", Stacks: []string{"go"}},
+		},
+		Deployments: []Deployment{
+			// rolls off on the Wednesday of the second week
+			{EmployeeID: "alice", ProjectID: "p1", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 1, 1), EndDate: date(2026, 10, 28)},
+			{EmployeeID: "bob", ProjectID: "p1", DeploymentType: model.MemberDeploymentTypePartTime, StartDate: date(2026, 1, 1)},
+			{EmployeeID: "bob", ProjectID: "p2", DeploymentType: model.MemberDeploymentTypeShadow, StartDate: date(2026, 1, 1)},
+		},
+		Leaves: []Leave{
+			// Thursday and Friday of the first week
+			{EmployeeID: "alice", StartDate: *date(2026, 10, 22), EndDate: *date(2026, 10, 23)},
+		},
+	}
+
+	plan := Build(in)
+	require.Len(t, plan.Employees, 2)
+
+	alice := plan.Employees[0]
+	require.InDelta(t, 0.6, alice.Weeks[0].Capacity, 1e-9)
+	require.InDelta(t, 1, alice.Weeks[0].Billable, 1e-9)
+	require.InDelta(t, 0, alice.Weeks[0].Bench, 1e-9)
+	require.InDelta(t, 0.6, alice.Weeks[1].Billable, 1e-9)
+	require.InDelta(t, 0.4, alice.Weeks[1].Bench, 1e-9)
+	require.InDelta(t, 1, alice.Weeks[2].Bench, 1e-9)
+	require.Equal(t, date(2026, 11, 2), alice.BenchFrom)
+	require.Equal(t, 1, alice.BenchWeeks)
+
+	bob := plan.Employees[1]
+	require.InDelta(t, 1.5, bob.Weeks[0].Allocated, 1e-9)
+	require.InDelta(t, 0.5, bob.Weeks[0].Billable, 1e-9)
+	require.InDelta(t, 0.5, bob.Weeks[0].Bench, 1e-9)
+	require.Equal(t, date(2026, 10, 19), bob.BenchFrom)
+
+	require.Len(t, plan.Stacks, 2)
+	require.Equal(t, "go", plan.Stacks[0].Name)
+	require.Equal(t, 2, plan.Stacks[0].Headcount)
+	require.InDelta(t, 1.5, plan.Stacks[0].Weeks[2].Bench, 1e-9)
+	require.Equal(t, "react", plan.Stacks[1].Name)
+	require.Equal(t, 1, plan.Stacks[1].Headcount)
+
+	require.InDelta(t, 1.6, plan.Total[0].Capacity, 1e-9)
+	require.InDelta(t, 1.5, plan.Total[2].Bench, 1e-9)
+}
+
+func TestBuild_HalfDayLeave(t *testing.T) {
+	plan := Build(Input{
+		From:      *date(2026, 10, 19),
+		Weeks:     1,
+		Weights:   weights,
+		Employees: []Employee{{ID: "alice"}},
+		Leaves: []Leave{
+			{EmployeeID: "alice", StartDate: *date(2026, 10, 19), EndDate: *date(2026, 10, 19), HalfDay: true},
+			// the weekend takes nothing off
+			{EmployeeID: "alice", StartDate: *date(2026, 10, 24), EndDate: *date(2026, 10, 25)},
+		},
+	})
+
+	require.InDelta(t, 0.9, plan.Employees[0].Weeks[0].Capacity, 1e-9)
+}
+
+func TestBuild_UnstaffedSlots(t *testing.T) {
+	in := Input{
+		From:    *date(2026, 10, 19),
+		Weeks:   4,
+		Weights: weights,
+		Slots: []Slot{
+			{ID: "staffed", Seniority: "senior", DeploymentType: model.MemberDeploymentTypeOfficial},
+			{ID: "rolling-off", Seniority: "senior", DeploymentType: model.MemberDeploymentTypeOfficial},
+			{ID: "pending", Seniority: "junior", DeploymentType: model.MemberDeploymentTypePartTime, ProjectEndDate: date(2026, 10, 30)},
+		},
+		Deployments: []Deployment{
+			{EmployeeID: "alice", SlotID: "staffed", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 1, 1)},
+			{EmployeeID: "bob", SlotID: "rolling-off", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 1, 1), EndDate: date(2026, 10, 30)},
+		},
+	}
+
+	plan := Build(in)
+	require.Len(t, plan.UnstaffedSlots, 2)
+
+	require.Equal(t, "pending", plan.UnstaffedSlots[0].ID)
+	require.Equal(t, *date(2026, 10, 19), plan.UnstaffedSlots[0].UnstaffedFrom)
+	require.Equal(t, 2, plan.UnstaffedSlots[0].Weeks)
+
+	require.Equal(t, "rolling-off", plan.UnstaffedSlots[1].ID)
+	require.Equal(t, *date(2026, 11, 2), plan.UnstaffedSlots[1].UnstaffedFrom)
+	require.Equal(t, 2, plan.UnstaffedSlots[1].Weeks)
+
+	require.InDelta(t, 0.5, plan.Total[0].Unstaffed, 1e-9)
+	require.InDelta(t, 1, plan.Total[3].Unstaffed, 1e-9)
+	require.Equal(t, "junior", plan.Seniorities[0].Name)
+	require.InDelta(t, 0.5, plan.Seniorities[0].Weeks[1].Unstaffed, 1e-9)
+}
+
+func TestBuild_WhatIfStaffsSlot(t *testing.T) {
+	in := Input{
+		From:      *date(2026, 10, 19),
+		Weeks:     2,
+		Weights:   weights,
+		Employees: []Employee{{ID: "alice"}},
+		Slots:     []Slot{{ID: "pending", DeploymentType: model.MemberDeploymentTypeOfficial}},
+	}
+
+	plan := Build(in)
+	require.Len(t, plan.UnstaffedSlots, 1)
+	require.Equal(t, 2, plan.Employees[0].BenchWeeks)
+
+	in.Deployments = append(in.Deployments, Deployment{
+		EmployeeID:     "alice",
+		SlotID:         "pending",
+		DeploymentType: model.MemberDeploymentTypeOfficial,
+		StartDate:      date(2026, 10, 26),
+		WhatIf:         true,
+	})
+	plan = Build(in)
+	require.Len(t, plan.UnstaffedSlots, 1)
+	require.Equal(t, 1, plan.UnstaffedSlots[0].Weeks)
+	require.Equal(t, 1, plan.Employees[0].BenchWeeks)
+}
+
+func TestEmployeePlan_Availability(t *testing.T) {
+	in := Input{
+		From:      *date(2026, 10, 19),
+		Weeks:     4,
+		Weights:   weights,
+		Employees: []Employee{{ID: "alice"}},
+		Deployments: []Deployment{
+			{EmployeeID: "alice", ProjectID: "p1", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 1, 1), EndDate: date(2026, 10, 30)},
+			{EmployeeID: "alice", ProjectID: "p2", DeploymentType: model.MemberDeploymentTypePartTime, StartDate: date(2026, 1, 1)},
+		},
+	}
+
+	p := Build(in).Employees[0]
+
+	utilization, from, ok := p.Availability(0.5)
+	require.True(t, ok)
+	require.Equal(t, 1.0, utilization)
+	require.Equal(t, *date(2026, 11, 2), from)
+
+	// a full week is never free while the part-time deployment runs
+	_, _, ok = p.Availability(1)
+	require.False(t, ok)
+
+	// the week on leave frees no one
+	in.Leaves = []Leave{{EmployeeID: "alice", StartDate: *date(2026, 11, 2), EndDate: *date(2026, 11, 6)}}
+	_, from, ok = Build(in).Employees[0].Availability(0.5)
+	require.True(t, ok)
+	require.Equal(t, *date(2026, 11, 9), from)
+}
@@ -0,0 +1,161 @@
+// Package cashflow lays the expected receipts and payments out on the periods of a rolling
+// cash-flow forecast, and compares a forecast with the actuals once its periods are over
+package cashflow
+
+import (
+	"math"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// Flow is an expected cash movement, in the forecast currency. The amount is positive for
+// money in and negative for money out, a negative amount in an inflow category is a refund
+// and a positive one in an outflow category a deduction, e.g. a salary advance paid back.
+type Flow struct {
+	Category model.CashFlowCategory
+	Date     time.Time
+	Amount   float64
+}
+
+// Periods returns the periods of the rolling forecast containing date: the weeks start on
+// Monday and the months on the 1st. The end of a period is its last day.
+func Periods(horizon model.CashFlowHorizon, date time.Time) []model.CashFlowPeriod {
+	day := truncateDay(date)
+	count := horizon.PeriodCount()
+	periods := make([]model.CashFlowPeriod, 0, count)
+
+	if horizon == model.CashFlowHorizonWeekly {
+		start := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		for i := 0; i < count; i++ {
+			s := start.AddDate(0, 0, 7*i)
+			periods = append(periods, model.CashFlowPeriod{Start: s, End: s.AddDate(0, 0, 6)})
+		}
+		return periods
+	}
+
+	start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < count; i++ {
+		s := start.AddDate(0, i, 0)
+		periods = append(periods, model.CashFlowPeriod{Start: s, End: s.AddDate(0, 1, -1)})
+	}
+	return periods
+}
+
+// MonthlyDates returns the dates between from and to, both included, a monthly flow paid on
+// the given day falls on. The day is moved to the last day of the shorter months.
+func MonthlyDates(day int, from, to time.Time) []time.Time {
+	if day < 1 {
+		day = 1
+	}
+	from, to = truncateDay(from), truncateDay(to)
+
+	var dates []time.Time
+	for month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC); !month.After(to); month = month.AddDate(0, 1, 0) {
+		lastDay := month.AddDate(0, 1, -1).Day()
+		d := time.Date(month.Year(), month.Month(), min(day, lastDay), 0, 0, 0, 0, time.UTC)
+		if !d.Before(from) && !d.After(to) {
+			dates = append(dates, d)
+		}
+	}
+	return dates
+}
+
+// Build sums the flows up by period and category and rolls the balance from the opening one.
+// The flows due before the first period are late and expected in the first one, the ones
+// after the last period are out of the forecast.
+func Build(periods []model.CashFlowPeriod, flows []Flow, openingBalance float64) []model.CashFlowPeriod {
+	if len(periods) == 0 {
+		return periods
+	}
+
+	totals := make([]map[model.CashFlowCategory]float64, len(periods))
+	for i := range totals {
+		totals[i] = map[model.CashFlowCategory]float64{}
+	}
+
+	last := periods[len(periods)-1].End
+	for _, f := range flows {
+		d := truncateDay(f.Date)
+		if d.After(last) {
+			continue
+		}
+
+		i := 0
+		for i < len(periods)-1 && d.After(periods[i].End) {
+			i++
+		}
+		totals[i][f.Category] += f.Amount
+	}
+
+	rs := make([]model.CashFlowPeriod, len(periods))
+	balance := openingBalance
+	for i, p := range periods {
+		p.Inflow, p.Outflow = 0, 0
+		p.Lines = make([]model.CashFlowLine, 0, len(totals[i]))
+		for _, c := range model.CashFlowCategories {
+			amount, ok := totals[i][c]
+			if !ok {
+				continue
+			}
+			amount = round(amount)
+			p.Lines = append(p.Lines, model.CashFlowLine{Category: c, Amount: amount})
+			if c.IsInflow() {
+				p.Inflow += amount
+			} else {
+				p.Outflow -= amount
+			}
+		}
+
+		p.Net = p.Inflow - p.Outflow
+		p.OpeningBalance = balance
+		balance += p.Net
+		p.ClosingBalance = balance
+		rs[i] = p
+	}
+
+	return rs
+}
+
+// Compare sets the actuals against the periods of a forecast. The periods not over yet at now
+// are reported as incomplete.
+func Compare(periods []model.CashFlowPeriod, actuals []model.CashFlowActual, now time.Time) []model.CashFlowVariance {
+	today := truncateDay(now)
+
+	rs := make([]model.CashFlowVariance, 0, len(periods))
+	for _, p := range periods {
+		v := model.CashFlowVariance{
+			Start:           p.Start,
+			End:             p.End,
+			Complete:        today.After(truncateDay(p.End)),
+			ForecastInflow:  p.Inflow,
+			ForecastOutflow: p.Outflow,
+			ForecastNet:     p.Net,
+		}
+
+		start, end := truncateDay(p.Start), truncateDay(p.End)
+		for _, a := range actuals {
+			d := truncateDay(a.Date)
+			if d.Before(start) || d.After(end) {
+				continue
+			}
+			v.ActualInflow += a.Inflow
+			v.ActualOutflow += a.Outflow
+		}
+
+		v.ActualInflow, v.ActualOutflow = round(v.ActualInflow), round(v.ActualOutflow)
+		v.ActualNet = v.ActualInflow - v.ActualOutflow
+		v.NetVariance = v.ActualNet - v.ForecastNet
+		rs = append(rs, v)
+	}
+
+	return rs
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
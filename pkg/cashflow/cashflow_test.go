@@ -0,0 +1,138 @@
+package cashflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestPeriods(t *testing.T) {
+	t.Run("weekly starts on monday", func(t *testing.T) {
+		// 2026-10-18 is a Sunday
+		periods := Periods(model.CashFlowHorizonWeekly, time.Date(2026, 10, 18, 15, 30, 0, 0, time.UTC))
+		require.Len(t, periods, 13)
+		assert.Equal(t, date(2026, 10, 12), periods[0].Start)
+		assert.Equal(t, date(2026, 10, 18), periods[0].End)
+		assert.Equal(t, date(2027, 1, 4), periods[12].Start)
+		assert.Equal(t, date(2027, 1, 10), periods[12].End)
+	})
+
+	t.Run("monthly starts on the 1st", func(t *testing.T) {
+		periods := Periods(model.CashFlowHorizonMonthly, date(2026, 10, 18))
+		require.Len(t, periods, 12)
+		assert.Equal(t, date(2026, 10, 1), periods[0].Start)
+		assert.Equal(t, date(2026, 10, 31), periods[0].End)
+		assert.Equal(t, date(2027, 2, 28), periods[4].End)
+		assert.Equal(t, date(2027, 9, 30), periods[11].End)
+	})
+}
+
+func TestMonthlyDates(t *testing.T) {
+	tests := []struct {
+		name     string
+		day      int
+		from, to time.Time
+		want     []time.Time
+	}{
+		{
+			name: "within the range",
+			day:  15,
+			from: date(2026, 10, 18),
+			to:   date(2027, 1, 10),
+			want: []time.Time{date(2026, 11, 15), date(2026, 12, 15)},
+		},
+		{
+			name: "clamped to the end of the month",
+			day:  31,
+			from: date(2027, 1, 1),
+			to:   date(2027, 4, 30),
+			want: []time.Time{date(2027, 1, 31), date(2027, 2, 28), date(2027, 3, 31), date(2027, 4, 30)},
+		},
+		{
+			name: "bounds included",
+			day:  1,
+			from: date(2026, 10, 1),
+			to:   date(2026, 11, 1),
+			want: []time.Time{date(2026, 10, 1), date(2026, 11, 1)},
+		},
+		{
+			name: "empty range",
+			day:  1,
+			from: date(2026, 10, 2),
+			to:   date(2026, 10, 31),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MonthlyDates(tt.day, tt.from, tt.to))
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	periods := Periods(model.CashFlowHorizonMonthly, date(2026, 10, 1))[:3]
+	flows := []Flow{
+		{Category: model.CashFlowCategoryReceivable, Date: date(2026, 9, 20), Amount: 1000},  // overdue, expected now
+		{Category: model.CashFlowCategoryReceivable, Date: date(2026, 11, 5), Amount: 500},   // next month
+		{Category: model.CashFlowCategoryPayroll, Date: date(2026, 10, 1), Amount: -600},     // out
+		{Category: model.CashFlowCategorySalaryAdvance, Date: date(2026, 10, 1), Amount: 50}, // paid back
+		{Category: model.CashFlowCategoryPayroll, Date: date(2026, 11, 1), Amount: -600},
+		{Category: model.CashFlowCategoryContractor, Date: date(2027, 1, 15), Amount: -300}, // after the forecast
+	}
+
+	rs := Build(periods, flows, 200)
+	require.Len(t, rs, 3)
+
+	assert.Equal(t, []model.CashFlowLine{
+		{Category: model.CashFlowCategoryReceivable, Amount: 1000},
+		{Category: model.CashFlowCategoryPayroll, Amount: -600},
+		{Category: model.CashFlowCategorySalaryAdvance, Amount: 50},
+	}, rs[0].Lines)
+	assert.Equal(t, 1000.0, rs[0].Inflow)
+	assert.Equal(t, 550.0, rs[0].Outflow)
+	assert.Equal(t, 450.0, rs[0].Net)
+	assert.Equal(t, 200.0, rs[0].OpeningBalance)
+	assert.Equal(t, 650.0, rs[0].ClosingBalance)
+
+	assert.Equal(t, -100.0, rs[1].Net)
+	assert.Equal(t, 650.0, rs[1].OpeningBalance)
+	assert.Equal(t, 550.0, rs[1].ClosingBalance)
+
+	assert.Empty(t, rs[2].Lines)
+	assert.Equal(t, 550.0, rs[2].ClosingBalance)
+}
+
+func TestCompare(t *testing.T) {
+	periods := Build(Periods(model.CashFlowHorizonMonthly, date(2026, 9, 1))[:2], []Flow{
+		{Category: model.CashFlowCategoryReceivable, Date: date(2026, 9, 10), Amount: 1000},
+		{Category: model.CashFlowCategoryPayroll, Date: date(2026, 9, 15), Amount: -400},
+		{Category: model.CashFlowCategoryPayroll, Date: date(2026, 10, 15), Amount: -400},
+	}, 0)
+	actuals := []model.CashFlowActual{
+		{Date: date(2026, 9, 12), Inflow: 900},
+		{Date: date(2026, 9, 15), Outflow: 420},
+		{Date: date(2026, 10, 15), Outflow: 400},
+		{Date: date(2026, 11, 1), Inflow: 5000}, // outside of the forecast
+	}
+
+	rs := Compare(periods, actuals, time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC))
+	require.Len(t, rs, 2)
+
+	assert.True(t, rs[0].Complete)
+	assert.Equal(t, 600.0, rs[0].ForecastNet)
+	assert.Equal(t, 480.0, rs[0].ActualNet)
+	assert.Equal(t, -120.0, rs[0].NetVariance)
+
+	assert.False(t, rs[1].Complete)
+	assert.Equal(t, 0.0, rs[1].ActualInflow)
+	assert.Equal(t, 400.0, rs[1].ActualOutflow)
+	assert.Equal(t, 0.0, rs[1].NetVariance)
+}
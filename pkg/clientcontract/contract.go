@@ -0,0 +1,168 @@
+// Package clientcontract picks the client contract ruling a project at a date and checks invoices
+// against its rate card, payment terms and value cap
+package clientcontract
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// RateTolerance is how far a unit cost can be from a rate of the card, to absorb rounding
+var RateTolerance = decimal.NewFromFloat(0.01)
+
+// Invoice is what is checked of an invoice against its contract
+type Invoice struct {
+	InvoicedAt *time.Time
+	DueAt      *time.Time
+	Items      []model.InvoiceItem
+	Total      decimal.Decimal
+}
+
+// Violation is a term of the contract an invoice breaks, Item is the index of the line item or -1
+// when it is about the whole invoice
+type Violation struct {
+	Item   int
+	Reason string
+}
+
+func (v Violation) String() string {
+	if v.Item < 0 {
+		return v.Reason
+	}
+	return fmt.Sprintf("line item %d: %s", v.Item+1, v.Reason)
+}
+
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// IsActiveAt tells whether the contract is signed off and covers the date
+func IsActiveAt(c *model.Contract, at time.Time) bool {
+	if c == nil || c.Status != model.ContractStatusActive {
+		return false
+	}
+	at = day(at)
+	if at.Before(day(c.StartDate)) {
+		return false
+	}
+	return c.EndDate == nil || !at.After(day(*c.EndDate))
+}
+
+// Select returns the contract ruling the project at the date. A SOW of the project wins over a
+// contract of the whole client, then the latest started one.
+func Select(contracts []*model.Contract, projectID model.UUID, at time.Time) *model.Contract {
+	candidates := make([]*model.Contract, 0, len(contracts))
+	for _, c := range contracts {
+		if !IsActiveAt(c, at) {
+			continue
+		}
+		if c.ProjectID != nil && *c.ProjectID != projectID {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, pj := candidates[i].ProjectID != nil, candidates[j].ProjectID != nil
+		if pi != pj {
+			return pi
+		}
+		return candidates[i].StartDate.After(candidates[j].StartDate)
+	})
+
+	return candidates[0]
+}
+
+// DueDate returns the latest due date of an invoice under the payment terms
+func DueDate(invoicedAt time.Time, termDays int) time.Time {
+	return day(invoicedAt).AddDate(0, 0, termDays)
+}
+
+// matchesRateCard tells whether the unit cost is one of the rates of the card
+func matchesRateCard(rates []model.ContractRate, unitCost decimal.Decimal) bool {
+	for _, r := range rates {
+		if r.Rate.Sub(unitCost).Abs().LessThanOrEqual(RateTolerance) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns the terms of the contract the invoice breaks, billed is the total already invoiced
+// under the contract. External line items are costs passed through to the client and are not on
+// the rate card.
+func Check(c *model.Contract, inv Invoice, billed decimal.Decimal) []Violation {
+	violations := make([]Violation, 0)
+	if c == nil {
+		return violations
+	}
+
+	if len(c.Rates) > 0 {
+		for i, item := range inv.Items {
+			if item.IsExternal || item.UnitCost <= 0 {
+				continue
+			}
+			unitCost := decimal.NewFromFloat(item.UnitCost)
+			if !matchesRateCard(c.Rates, unitCost) {
+				violations = append(violations, Violation{
+					Item:   i,
+					Reason: fmt.Sprintf("unit cost %s is not on the rate card of contract %s", unitCost.StringFixed(2), c.Code),
+				})
+			}
+		}
+	}
+
+	if c.PaymentTermDays > 0 && inv.InvoicedAt != nil && inv.DueAt != nil {
+		latest := DueDate(*inv.InvoicedAt, c.PaymentTermDays)
+		if day(*inv.DueAt).After(latest) {
+			violations = append(violations, Violation{
+				Item:   -1,
+				Reason: fmt.Sprintf("due date is later than the net-%d payment terms, %s at the latest", c.PaymentTermDays, latest.Format("2006-01-02")),
+			})
+		}
+	}
+
+	if c.ValueCap.Valid {
+		after := billed.Add(inv.Total)
+		if after.GreaterThan(c.ValueCap.Decimal) {
+			violations = append(violations, Violation{
+				Item: -1,
+				Reason: fmt.Sprintf("invoice brings the billed total to %s over the cap of %s of contract %s",
+					after.StringFixed(2), c.ValueCap.Decimal.StringFixed(2), c.Code),
+			})
+		}
+	}
+
+	return violations
+}
+
+// DaysToExpiry returns the days left before an active contract ends, false when it is open ended,
+// not active or already over
+func DaysToExpiry(c *model.Contract, today time.Time) (int, bool) {
+	if c == nil || c.EndDate == nil || c.Status != model.ContractStatusActive {
+		return 0, false
+	}
+	days := int(day(*c.EndDate).Sub(day(today)).Hours() / 24)
+	if days < 0 {
+		return 0, false
+	}
+	return days, true
+}
+
+// ExpiryNoticeDue tells whether the expiry of the contract is to announce, once per contract when
+// it ends within the days
+func ExpiryNoticeDue(c *model.Contract, today time.Time, within int) bool {
+	if c == nil || c.ExpiryNotifiedAt != nil {
+		return false
+	}
+	days, ok := DaysToExpiry(c, today)
+	return ok && days <= within
+}
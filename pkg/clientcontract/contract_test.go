@@ -0,0 +1,131 @@
+package clientcontract
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func date(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestIsActiveAt(t *testing.T) {
+	c := &model.Contract{
+		Status:    model.ContractStatusActive,
+		StartDate: date(2026, 1, 1),
+		EndDate:   ptr(date(2026, 12, 31)),
+	}
+
+	require.False(t, IsActiveAt(c, date(2025, 12, 31)))
+	require.True(t, IsActiveAt(c, date(2026, 1, 1)))
+	// the end date is the last day covered
+	require.True(t, IsActiveAt(c, time.Date(2026, 12, 31, 18, 0, 0, 0, time.UTC)))
+	require.False(t, IsActiveAt(c, date(2027, 1, 1)))
+
+	c.Status = model.ContractStatusDraft
+	require.False(t, IsActiveAt(c, date(2026, 6, 1)))
+}
+
+func TestSelect(t *testing.T) {
+	projectID := model.NewUUID()
+	otherProjectID := model.NewUUID()
+
+	msa := &model.Contract{Code: "MSA", Status: model.ContractStatusActive, StartDate: date(2025, 1, 1)}
+	oldSOW := &model.Contract{Code: "SOW-1", ProjectID: &projectID, Status: model.ContractStatusActive,
+		StartDate: date(2025, 1, 1), EndDate: ptr(date(2026, 12, 31))}
+	newSOW := &model.Contract{Code: "SOW-2", ProjectID: &projectID, Status: model.ContractStatusActive,
+		StartDate: date(2026, 6, 1)}
+	otherSOW := &model.Contract{Code: "SOW-X", ProjectID: &otherProjectID, Status: model.ContractStatusActive,
+		StartDate: date(2026, 1, 1)}
+	contracts := []*model.Contract{msa, oldSOW, newSOW, otherSOW}
+
+	require.Equal(t, "SOW-1", Select(contracts, projectID, date(2026, 3, 1)).Code)
+	require.Equal(t, "SOW-2", Select(contracts, projectID, date(2026, 7, 1)).Code)
+	// the client contract covers a project without SOW
+	require.Equal(t, "MSA", Select(contracts, model.NewUUID(), date(2026, 7, 1)).Code)
+	require.Nil(t, Select(contracts, model.NewUUID(), date(2024, 7, 1)))
+}
+
+func TestCheck(t *testing.T) {
+	c := &model.Contract{
+		Code:            "SOW-1",
+		PaymentTermDays: 30,
+		ValueCap:        decimal.NewNullDecimal(decimal.NewFromInt(10000)),
+		Rates: []model.ContractRate{
+			{Rate: decimal.NewFromInt(4000), Unit: model.ContractRateUnitMonth},
+			{Rate: decimal.NewFromInt(25), Unit: model.ContractRateUnitHour},
+		},
+	}
+
+	t.Run("invoice within the terms", func(t *testing.T) {
+		violations := Check(c, Invoice{
+			InvoicedAt: ptr(date(2026, 10, 1)),
+			DueAt:      ptr(date(2026, 10, 31)),
+			Items: []model.InvoiceItem{
+				{Quantity: 1, UnitCost: 4000, Cost: 4000},
+				{Quantity: 10, UnitCost: 25.004, Cost: 250},
+				{Quantity: 1, UnitCost: 120, Cost: 120, IsExternal: true},
+			},
+			Total: decimal.NewFromInt(4370),
+		}, decimal.NewFromInt(5000))
+		require.Empty(t, violations)
+	})
+
+	t.Run("invoice breaking the terms", func(t *testing.T) {
+		violations := Check(c, Invoice{
+			InvoicedAt: ptr(date(2026, 10, 1)),
+			DueAt:      ptr(date(2026, 11, 15)),
+			Items: []model.InvoiceItem{
+				{Quantity: 1, UnitCost: 4000, Cost: 4000},
+				{Quantity: 1, UnitCost: 4500, Cost: 4500},
+			},
+			Total: decimal.NewFromInt(8500),
+		}, decimal.NewFromInt(5000))
+		require.Len(t, violations, 3)
+		require.Equal(t, 1, violations[0].Item)
+		require.Equal(t, "line item 2: unit cost 4500.00 is not on the rate card of contract SOW-1", violations[0].String())
+		require.Contains(t, violations[1].Reason, "net-30")
+		require.Contains(t, violations[2].Reason, "13500.00 over the cap of 10000.00")
+	})
+
+	t.Run("contract without rate card nor cap", func(t *testing.T) {
+		violations := Check(&model.Contract{Code: "MSA"}, Invoice{
+			Items: []model.InvoiceItem{{Quantity: 1, UnitCost: 9999, Cost: 9999}},
+			Total: decimal.NewFromInt(9999),
+		}, decimal.NewFromInt(1000000))
+		require.Empty(t, violations)
+	})
+}
+
+func TestExpiryNoticeDue(t *testing.T) {
+	today := date(2026, 10, 19)
+	c := &model.Contract{
+		Status:    model.ContractStatusActive,
+		StartDate: date(2026, 1, 1),
+		EndDate:   ptr(date(2026, 11, 15)),
+	}
+
+	days, ok := DaysToExpiry(c, today)
+	require.True(t, ok)
+	require.Equal(t, 27, days)
+	require.True(t, ExpiryNoticeDue(c, today, 30))
+	require.False(t, ExpiryNoticeDue(c, today, 14))
+
+	// announced once
+	c.ExpiryNotifiedAt = ptr(today)
+	require.False(t, ExpiryNoticeDue(c, today, 30))
+
+	// open ended and already over contracts never expire
+	require.False(t, ExpiryNoticeDue(&model.Contract{Status: model.ContractStatusActive}, today, 30))
+	_, ok = DaysToExpiry(&model.Contract{Status: model.ContractStatusActive, EndDate: ptr(date(2026, 10, 1))}, today)
+	require.False(t, ok)
+}
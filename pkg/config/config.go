@@ -35,6 +35,15 @@ type Config struct {
 	Discord               Discord
 	Basecamp              Basecamp
 	CurrencyLayer         CurrencyLayer
+	FXRate                FXRate
+	Ledger                Ledger
+	BankReconciliation    BankReconciliation
+	CashFlow              CashFlow
+	Profitability         Profitability
+	ProjectBudget         ProjectBudget
+	IcyReward             IcyReward
+	IcyTreasury           IcyTreasury
+	MemoRepo              MemoRepo
 	Mochi                 Mochi
 	MochiPay              MochiPay
 	MochiProfile          MochiProfile
@@ -56,6 +65,7 @@ type Config struct {
 	Github          Github
 	CheckIn         CheckIn
 	InvoiceListener InvoiceListener
+	ClientPortal    ClientPortal
 
 	OpenRouter OpenRouter
 	Redis      Redis
@@ -85,6 +95,15 @@ func getIntWithDefault(v ENV, key string, fallback int) int {
 	return fallback
 }
 
+func getFloatWithDefault(v ENV, key string, fallback float64) float64 {
+	if val := v.GetString(key); val != "" {
+		if parsed, err := strconv.ParseFloat(val, 64); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
 func getStringWithDefault(v ENV, key, fallback string) string {
 	if val := v.GetString(key); val != "" {
 		return val
@@ -223,6 +242,51 @@ type CurrencyLayer struct {
 	APIKey string
 }
 
+type FXRate struct {
+	SourcePriority []string // Sources tried in order when several have a rate for the same day
+	LookbackDays   int      // How far back to look for a rate when a day has none (weekends, holidays)
+}
+
+type Ledger struct {
+	IcyUSDRate float64 // USD value of one ICY, used to value the ICY rewards in the books
+}
+
+type BankReconciliation struct {
+	AutoMatchScore  float64 // score from which a bank transaction is matched without review
+	ReviewScore     float64 // score from which a match is suggested in the review queue
+	AmountTolerance float64 // accepted relative difference on the amount, to absorb the bank fees
+	DateWindowDays  int     // days after the due date an invoice payment is still expected
+}
+
+type CashFlow struct {
+	PaymentTermDays int // days after the end of the month a client is expected to pay the invoice of the month
+}
+
+type Profitability struct {
+	PartTimeAllocation float64 // share of the month a part-time member spends on the project
+	ShadowAllocation   float64 // share of the month a shadow member spends on the project
+}
+
+type ProjectBudget struct {
+	AlertThresholds string // comma separated burn percents alerted on, for the budgets without their own
+}
+
+type IcyReward struct {
+	MonthlyBudget   float64 // ICY paid by all the reward rules in a month, 0 for no budget
+	ReviewThreshold float64 // payouts from this amount wait for a review, 0 for no review
+}
+
+type IcyTreasury struct {
+	CustodyAddresses string  // comma separated addresses holding on-chain the ICY of the Mochi wallets
+	DriftTolerance   float64 // ICY of drift between the ledger and the custody balance accepted in a day
+}
+
+type MemoRepo struct {
+	URL        string // the git repository of the memos
+	ContentDir string // the directory of the memos in the repository
+	SiteURL    string // the site the memos are published at
+}
+
 type Vault struct {
 	Address string
 	Token   string
@@ -332,6 +396,7 @@ type Invoice struct {
 	DirID                  string
 	ContractorInvoiceDirID string
 	ContractorPaymentDirID string
+	ContractDirID          string
 	TestEmail              string
 }
 
@@ -429,6 +494,16 @@ type Reddit struct {
 
 type CheckIn struct {
 	WhitelistedEmployeeIDs []string
+	PerkPerDay             float64 // office perks budget earned by a day at the office
+	PerkCurrency           string
+	// RequireCode rejects the Discord check-ins without the QR code scanned at the office, off
+	// until the Discord bot sends it
+	RequireCode bool
+}
+
+type ClientPortal struct {
+	JWTSecretKey  string // signs the client tokens, kept apart from the employee one
+	TokenTTLHours int
 }
 
 // InvoiceListener configuration for Gmail inbox monitoring
@@ -574,6 +649,42 @@ func Generate(v ENV) *Config {
 		CurrencyLayer: CurrencyLayer{
 			APIKey: v.GetString("CURRENCY_LAYER_API_KEY"),
 		},
+		FXRate: FXRate{
+			SourcePriority: strings.Split(getStringWithDefault(v, "FX_RATE_SOURCE_PRIORITY", "manual,wise,currencylayer"), ","),
+			LookbackDays:   getIntWithDefault(v, "FX_RATE_LOOKBACK_DAYS", 7),
+		},
+		Ledger: Ledger{
+			IcyUSDRate: getFloatWithDefault(v, "LEDGER_ICY_USD_RATE", 1.5),
+		},
+		BankReconciliation: BankReconciliation{
+			AutoMatchScore:  getFloatWithDefault(v, "BANK_RECONCILIATION_AUTO_MATCH_SCORE", 0.9),
+			ReviewScore:     getFloatWithDefault(v, "BANK_RECONCILIATION_REVIEW_SCORE", 0.5),
+			AmountTolerance: getFloatWithDefault(v, "BANK_RECONCILIATION_AMOUNT_TOLERANCE", 0.01),
+			DateWindowDays:  getIntWithDefault(v, "BANK_RECONCILIATION_DATE_WINDOW_DAYS", 45),
+		},
+		CashFlow: CashFlow{
+			PaymentTermDays: getIntWithDefault(v, "CASH_FLOW_PAYMENT_TERM_DAYS", 30),
+		},
+		Profitability: Profitability{
+			PartTimeAllocation: getFloatWithDefault(v, "PROFITABILITY_PART_TIME_ALLOCATION", 0.5),
+			ShadowAllocation:   getFloatWithDefault(v, "PROFITABILITY_SHADOW_ALLOCATION", 1),
+		},
+		ProjectBudget: ProjectBudget{
+			AlertThresholds: getStringWithDefault(v, "PROJECT_BUDGET_ALERT_THRESHOLDS", "50,80,100"),
+		},
+		IcyReward: IcyReward{
+			MonthlyBudget:   getFloatWithDefault(v, "ICY_REWARD_MONTHLY_BUDGET", 0),
+			ReviewThreshold: getFloatWithDefault(v, "ICY_REWARD_REVIEW_THRESHOLD", 500),
+		},
+		IcyTreasury: IcyTreasury{
+			CustodyAddresses: v.GetString("ICY_TREASURY_CUSTODY_ADDRESSES"),
+			DriftTolerance:   getFloatWithDefault(v, "ICY_TREASURY_DRIFT_TOLERANCE", 1),
+		},
+		MemoRepo: MemoRepo{
+			URL:        getStringWithDefault(v, "MEMO_REPO_URL", "https://github.com/dwarvesf/memo.d.foundation"),
+			ContentDir: getStringWithDefault(v, "MEMO_REPO_CONTENT_DIR", "vault"),
+			SiteURL:    getStringWithDefault(v, "MEMO_SITE_URL", "https://memo.d.foundation"),
+		},
 		Vault: Vault{
 			Address: v.GetString("VAULT_ADDR"),
 			Token:   v.GetString("VAULT_TOKEN"),
@@ -639,6 +750,7 @@ func Generate(v ENV) *Config {
 			DirID:                  v.GetString("INVOICE_DIR_ID"),
 			ContractorInvoiceDirID: v.GetString("CONTRACTOR_INVOICE_DIR_ID"),
 			ContractorPaymentDirID: v.GetString("CONTRACTOR_PAYMENT_DIR_ID"),
+			ContractDirID:          v.GetString("CONTRACT_DIR_ID"),
 			TestEmail:              v.GetString("INVOICE_TEST_EMAIL"),
 		},
 
@@ -678,8 +790,15 @@ func Generate(v ENV) *Config {
 		},
 		CheckIn: CheckIn{
 			WhitelistedEmployeeIDs: strings.Split(v.GetString("CHECKIN_WHITELISTED_EMPLOYEE_IDS"), ","),
+			PerkPerDay:             getFloatWithDefault(v, "CHECKIN_PERK_PER_DAY", 0),
+			PerkCurrency:           getStringWithDefault(v, "CHECKIN_PERK_CURRENCY", "VND"),
+			RequireCode:            getBoolWithDefault(v, "CHECKIN_REQUIRE_CODE", false),
 		},
 		InvoiceListener: parseInvoiceListenerConfig(v),
+		ClientPortal: ClientPortal{
+			JWTSecretKey:  v.GetString("CLIENT_PORTAL_JWT_SECRET_KEY"),
+			TokenTTLHours: getIntWithDefault(v, "CLIENT_PORTAL_TOKEN_TTL_HOURS", 24),
+		},
 		Parquet: Parquet{
 			LocalFilePath:   v.GetString("PARQUET_LOCAL_FILE_PATH"),
 			SyncInterval:    v.GetString("PARQUET_SYNC_INTERVAL"),
@@ -790,6 +909,10 @@ func LoadTestConfig() Config {
 			Port: "8080",
 		},
 		JWTSecretKey: "JWTSecretKey",
+		ClientPortal: ClientPortal{
+			JWTSecretKey:  "ClientPortalJWTSecretKey",
+			TokenTTLHours: 24,
+		},
 		Postgres: DBConnection{
 			Host:    "127.0.0.1",
 			Port:    "35432",
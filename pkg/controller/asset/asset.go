@@ -0,0 +1,190 @@
+package asset
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/depreciation"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	assetstore "github.com/dwarvesf/fortress-api/pkg/store/asset"
+)
+
+type ListInput struct {
+	Category model.AssetCategory
+	Status   model.AssetStatus
+	HolderID string
+	Keyword  string
+}
+
+type AssetInput struct {
+	Name             string
+	SerialNumber     string
+	Category         model.AssetCategory
+	Status           model.AssetStatus // in-stock when empty, an assigned asset keeps its status
+	Cost             int64
+	Currency         string // VND when empty
+	PurchaseDate     *time.Time
+	UsefulLifeMonths int
+	SalvageValue     int64
+	ExpenseID        *model.UUID
+	Location         string
+	Note             string
+}
+
+func (r *controller) List(input ListInput, pagination model.Pagination) ([]*model.Asset, int64, error) {
+	return r.store.Asset.All(r.repo.DB(), assetstore.Query{
+		Category: input.Category,
+		Status:   input.Status,
+		HolderID: input.HolderID,
+		Keyword:  strings.TrimSpace(input.Keyword),
+	}, pagination)
+}
+
+func (r *controller) Get(id string) (*model.Asset, error) {
+	asset, err := r.store.Asset.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAssetNotFound
+		}
+		return nil, err
+	}
+	return asset, nil
+}
+
+func (r *controller) Create(input AssetInput) (*model.Asset, error) {
+	if input.Status == "" {
+		input.Status = model.AssetStatusInStock
+	}
+	if input.Status == model.AssetStatusAssigned {
+		return nil, ErrStatusSetByAssignment
+	}
+
+	asset := &model.Asset{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		Status:    input.Status,
+	}
+	if err := r.applyInput(asset, input); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.Asset.Create(r.repo.DB(), asset); err != nil {
+		return nil, err
+	}
+
+	return r.Get(asset.ID.String())
+}
+
+// Update changes the details of an asset, its holder is changed by assigning and returning it
+func (r *controller) Update(id string, input AssetInput) (*model.Asset, error) {
+	asset, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case input.Status == "":
+		input.Status = asset.Status
+	case asset.Status == model.AssetStatusAssigned && input.Status != model.AssetStatusAssigned:
+		return nil, ErrAssetNotAvailable
+	case asset.Status != model.AssetStatusAssigned && input.Status == model.AssetStatusAssigned:
+		return nil, ErrStatusSetByAssignment
+	}
+	asset.Status = input.Status
+
+	if err := r.applyInput(asset, input); err != nil {
+		return nil, err
+	}
+
+	_, err = r.store.Asset.UpdateSelectedFieldsByID(r.repo.DB(), id, model.Asset{
+		Name:             asset.Name,
+		SerialNumber:     asset.SerialNumber,
+		Category:         asset.Category,
+		Status:           asset.Status,
+		Price:            asset.Price,
+		CurrencyID:       asset.CurrencyID,
+		PurchaseDate:     asset.PurchaseDate,
+		UsefulLifeMonths: asset.UsefulLifeMonths,
+		SalvageValue:     asset.SalvageValue,
+		ExpenseID:        asset.ExpenseID,
+		Location:         asset.Location,
+		Note:             asset.Note,
+	}, "name", "serial_number", "category", "status", "price", "currency_id", "purchased_at",
+		"useful_life_months", "salvage_value", "expense_id", "location", "note")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(id)
+}
+
+func (r *controller) applyInput(asset *model.Asset, input AssetInput) error {
+	if !input.Category.IsValid() {
+		return ErrInvalidCategory
+	}
+	if !input.Status.IsValid() {
+		return ErrInvalidStatus
+	}
+
+	if input.UsefulLifeMonths > 0 {
+		if input.PurchaseDate == nil {
+			return ErrMissingPurchaseDate
+		}
+		err := depreciation.Asset{
+			Cost:             input.Cost,
+			SalvageValue:     input.SalvageValue,
+			UsefulLifeMonths: input.UsefulLifeMonths,
+		}.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	serial := strings.TrimSpace(input.SerialNumber)
+	if serial != "" {
+		existing, err := r.store.Asset.OneBySerialNumber(r.repo.DB(), serial)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err == nil && existing.ID != asset.ID {
+			return ErrSerialNumberExisted
+		}
+	}
+
+	currencyName := strings.ToUpper(strings.TrimSpace(input.Currency))
+	if currencyName == "" {
+		currencyName = "VND"
+	}
+	currency, err := r.store.Currency.GetByName(r.repo.DB(), currencyName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCurrencyNotFound
+		}
+		return err
+	}
+
+	if input.ExpenseID != nil {
+		if _, err := r.store.Expense.One(r.repo.DB(), input.ExpenseID.String()); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrExpenseNotFound
+			}
+			return err
+		}
+	}
+
+	asset.Name = strings.TrimSpace(input.Name)
+	asset.SerialNumber = serial
+	asset.Category = input.Category
+	asset.Price = input.Cost
+	asset.CurrencyID = &currency.ID
+	asset.Currency = currency
+	asset.PurchaseDate = input.PurchaseDate
+	asset.UsefulLifeMonths = input.UsefulLifeMonths
+	asset.SalvageValue = input.SalvageValue
+	asset.ExpenseID = input.ExpenseID
+	asset.Location = strings.TrimSpace(input.Location)
+	asset.Note = input.Note
+	return nil
+}
@@ -0,0 +1,140 @@
+package asset
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type AssignInput struct {
+	EmployeeID model.UUID
+	AssignedAt *time.Time // today when empty
+	Condition  string
+	Note       string
+	ActorID    *model.UUID
+}
+
+type ReturnInput struct {
+	ReturnedAt *time.Time // today when empty
+	Condition  string
+	Status     model.AssetStatus // in-stock when empty
+	Note       string
+	ActorID    *model.UUID
+}
+
+// Assign hands an asset in stock over to an employee
+func (r *controller) Assign(id string, input AssignInput) (*model.AssetAssignment, error) {
+	asset, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Status != model.AssetStatusInStock {
+		return nil, ErrAssetNotAvailable
+	}
+
+	employee, err := r.store.Employee.One(r.repo.DB(), input.EmployeeID.String(), false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+
+	assignedAt := time.Now()
+	if input.AssignedAt != nil {
+		assignedAt = *input.AssignedAt
+	}
+
+	assignment := &model.AssetAssignment{
+		BaseModel:         model.BaseModel{ID: model.NewUUID()},
+		AssetID:           asset.ID,
+		EmployeeID:        employee.ID,
+		AssignedAt:        assignedAt,
+		AssignedCondition: input.Condition,
+		AssignedBy:        input.ActorID,
+		Note:              input.Note,
+	}
+
+	tx, done := r.repo.NewTransaction()
+	if _, err := r.store.AssetAssignment.Create(tx.DB(), assignment); err != nil {
+		return nil, done(err)
+	}
+	_, err = r.store.Asset.UpdateSelectedFieldsByID(tx.DB(), id, model.Asset{
+		Status: model.AssetStatusAssigned,
+		UsedBy: &employee.ID,
+	}, "status", "used_by")
+	if err != nil {
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	assignment.Employee = employee
+	return assignment, nil
+}
+
+// Return takes an asset back from its holder along with the condition it is returned in
+func (r *controller) Return(id string, input ReturnInput) (*model.AssetAssignment, error) {
+	if input.Status == "" {
+		input.Status = model.AssetStatusInStock
+	}
+	if !input.Status.IsReturnable() {
+		return nil, ErrInvalidReturnStatus
+	}
+
+	asset, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Status != model.AssetStatusAssigned {
+		return nil, ErrAssetNotAssigned
+	}
+
+	assignment, err := r.store.AssetAssignment.OneOpenByAssetID(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAssetNotAssigned
+		}
+		return nil, err
+	}
+
+	returnedAt := time.Now()
+	if input.ReturnedAt != nil {
+		returnedAt = *input.ReturnedAt
+	}
+	assignment.ReturnedAt = &returnedAt
+	assignment.ReturnedCondition = input.Condition
+	assignment.ReceivedBy = input.ActorID
+	if input.Note != "" {
+		assignment.Note = input.Note
+	}
+
+	tx, done := r.repo.NewTransaction()
+	_, err = r.store.AssetAssignment.UpdateSelectedFieldsByID(tx.DB(), assignment.ID.String(), model.AssetAssignment{
+		ReturnedAt:        assignment.ReturnedAt,
+		ReturnedCondition: assignment.ReturnedCondition,
+		ReceivedBy:        assignment.ReceivedBy,
+		Note:              assignment.Note,
+	}, "returned_at", "returned_condition", "received_by", "note")
+	if err != nil {
+		return nil, done(err)
+	}
+	_, err = r.store.Asset.UpdateSelectedFieldsByID(tx.DB(), id, model.Asset{Status: input.Status}, "status", "used_by")
+	if err != nil {
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return assignment, nil
+}
+
+// ListUnreturned lists the assets an employee still holds
+func (r *controller) ListUnreturned(employeeID string) ([]*model.Asset, error) {
+	return r.store.Asset.AllByHolderID(r.repo.DB(), employeeID)
+}
@@ -0,0 +1,140 @@
+package asset
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/depreciation"
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// Depreciate books the depreciation of the month of every asset in its useful life, as an
+// accounting transaction of the Assets category and a journal entry of the ledger against the
+// accumulated depreciation. A month already booked for an asset is skipped, and the assets written
+// off as retired or lost are left out.
+func (r *controller) Depreciate(year int, month int) ([]*model.AssetDepreciation, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "asset",
+		"method":     "Depreciate",
+		"year":       year,
+		"month":      month,
+	})
+
+	if month < 1 || month > 12 || year < 2000 {
+		return nil, ErrInvalidPeriod
+	}
+
+	assets, err := r.store.Asset.AllDepreciable(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	var rs []*model.AssetDepreciation
+	for _, a := range assets {
+		if a.Status == model.AssetStatusRetired || a.Status == model.AssetStatusLost {
+			continue
+		}
+
+		d, err := r.depreciate(a, year, time.Month(month))
+		if err != nil {
+			l.AddField("asset", a.ID.String()).Error(err, "failed to depreciate asset")
+			continue
+		}
+		if d != nil {
+			rs = append(rs, d)
+		}
+	}
+
+	return rs, nil
+}
+
+func (r *controller) depreciate(a *model.Asset, year int, month time.Month) (*model.AssetDepreciation, error) {
+	m, ok := depreciation.For(depreciation.Asset{
+		Cost:             a.Price,
+		SalvageValue:     a.SalvageValue,
+		UsefulLifeMonths: a.UsefulLifeMonths,
+		PurchaseDate:     *a.PurchaseDate,
+	}, year, month)
+	if !ok || m.Amount == 0 {
+		return nil, nil
+	}
+
+	booked, err := r.store.AssetDepreciation.IsExist(r.repo.DB(), a.ID.String(), year, int(month))
+	if err != nil {
+		return nil, err
+	}
+	if booked {
+		return nil, nil
+	}
+
+	// the depreciation is booked on the last day of the month
+	date := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	currency := a.CurrencyName()
+
+	rate := 1.0
+	if currency != "VND" {
+		fxRate, err := r.fxRate.GetRateAt(currency, "VND", date)
+		if err != nil {
+			return nil, err
+		}
+		rate = fxRate.Rate
+	}
+
+	metadata, err := json.Marshal(model.AccountingMetadata{Source: model.AssetDepreciationSource, ID: a.ID.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	name := a.Name
+	if a.SerialNumber != "" {
+		name = fmt.Sprintf("%s (%s)", a.Name, a.SerialNumber)
+	}
+	description := fmt.Sprintf("Depreciation - %s - %02d/%d", name, month, year)
+
+	transaction := &model.AccountingTransaction{
+		BaseModel:        model.BaseModel{ID: model.NewUUID()},
+		Name:             description,
+		Date:             &date,
+		Amount:           float64(m.Amount),
+		ConversionAmount: model.VietnamDong(math.Round(float64(m.Amount) * rate)),
+		Category:         model.AccountingAssets,
+		Type:             model.AccountingCA,
+		Currency:         currency,
+		CurrencyID:       a.CurrencyID,
+		ConversionRate:   rate,
+		Metadata:         metadata,
+	}
+
+	d := &model.AssetDepreciation{
+		BaseModel:               model.BaseModel{ID: model.NewUUID()},
+		AssetID:                 a.ID,
+		Month:                   int(month),
+		Year:                    year,
+		Amount:                  m.Amount,
+		Accumulated:             m.Accumulated,
+		BookValue:               m.BookValue,
+		Currency:                currency,
+		AccountingTransactionID: &transaction.ID,
+	}
+
+	tx, done := r.repo.NewTransaction()
+	if err := r.store.Accounting.CreateTransaction(tx.DB(), transaction); err != nil {
+		return nil, done(err)
+	}
+	// the accounting transaction moves no cash, the ledger posts the depreciation against the
+	// accumulated depreciation instead
+	entry, err := ledger.New(r.store).PostAssetDepreciation(tx.DB(), d, description, date, rate)
+	if err != nil {
+		return nil, done(err)
+	}
+	d.JournalEntryID = &entry.ID
+	if _, err := r.store.AssetDepreciation.Create(tx.DB(), d); err != nil {
+		return nil, done(err)
+	}
+
+	return d, done(nil)
+}
@@ -0,0 +1,19 @@
+package asset
+
+import "errors"
+
+var (
+	ErrAssetNotFound         = errors.New("asset not found")
+	ErrSerialNumberExisted   = errors.New("serial number already exists")
+	ErrInvalidCategory       = errors.New("invalid asset category")
+	ErrInvalidStatus         = errors.New("invalid asset status")
+	ErrCurrencyNotFound      = errors.New("currency not found")
+	ErrExpenseNotFound       = errors.New("purchase expense not found")
+	ErrEmployeeNotFound      = errors.New("employee not found")
+	ErrMissingPurchaseDate   = errors.New("purchase date is required to depreciate the asset")
+	ErrAssetNotAvailable     = errors.New("asset is not in stock")
+	ErrAssetNotAssigned      = errors.New("asset is not assigned")
+	ErrInvalidReturnStatus   = errors.New("a returned asset is in stock, in repair or lost")
+	ErrStatusSetByAssignment = errors.New("the assigned status is set by assigning the asset")
+	ErrInvalidPeriod         = errors.New("invalid depreciation period")
+)
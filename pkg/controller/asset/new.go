@@ -0,0 +1,45 @@
+package asset
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	fxRate  fxrate.IController
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+// New returns the asset controller, the depreciation of the assets bought in a foreign
+// currency is booked in VND at the rates recorded by the fx rate controller
+func New(store *store.Store, repo store.DBRepo, service *service.Service, fxRate fxrate.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		fxRate:  fxRate,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	List(input ListInput, pagination model.Pagination) ([]*model.Asset, int64, error)
+	Get(id string) (*model.Asset, error)
+	Create(input AssetInput) (*model.Asset, error)
+	Update(id string, input AssetInput) (*model.Asset, error)
+
+	Assign(id string, input AssignInput) (*model.AssetAssignment, error)
+	Return(id string, input ReturnInput) (*model.AssetAssignment, error)
+	ListUnreturned(employeeID string) ([]*model.Asset, error)
+
+	Depreciate(year int, month int) ([]*model.AssetDepreciation, error)
+}
@@ -2,7 +2,9 @@ package brainerylogs
 
 import (
 	"errors"
+	"time"
 
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"gorm.io/gorm"
 )
@@ -19,11 +21,31 @@ func (c *controller) Create(log model.BraineryLog) (model.BraineryLog, error) {
 		log.EmployeeID = emp.ID
 	}
 
-	_, err = c.store.BraineryLog.Create(c.repo.DB(), []model.BraineryLog{log})
+	logs, err := c.store.BraineryLog.Create(c.repo.DB(), []model.BraineryLog{log})
 	if err != nil {
 		c.logger.Errorf(err, "failed to create brainery logs", "braineryLog", log)
 		return model.BraineryLog{}, err
 	}
 
-	return log, nil
+	if err := c.knowledgeBase.IndexBraineryLogs(logs); err != nil {
+		c.logger.Errorf(err, "failed to index brainery log", "braineryLog", log)
+	}
+
+	if !logs[0].EmployeeID.IsZero() {
+		occurredAt := time.Now()
+		if logs[0].PublishedAt != nil {
+			occurredAt = *logs[0].PublishedAt
+		}
+		_, _, err := c.icyReward.Trigger(icyreward.TriggerInput{
+			Trigger:    model.IcyRewardTriggerBraineryLog,
+			EmployeeID: logs[0].EmployeeID.String(),
+			Reference:  logs[0].ID.String(),
+			OccurredAt: occurredAt,
+		})
+		if err != nil && !errors.Is(err, icyreward.ErrNoActiveRule) {
+			c.logger.Errorf(err, "failed to reward brainery log %v", logs[0].ID)
+		}
+	}
+
+	return logs[0], nil
 }
@@ -2,6 +2,8 @@ package brainerylogs
 
 import (
 	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/controller/knowledgebase"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service"
@@ -10,20 +12,24 @@ import (
 )
 
 type controller struct {
-	store   *store.Store
-	service *service.Service
-	logger  logger.Logger
-	repo    store.DBRepo
-	config  *config.Config
+	store         *store.Store
+	service       *service.Service
+	knowledgeBase knowledgebase.IController
+	icyReward     icyreward.IController
+	logger        logger.Logger
+	repo          store.DBRepo
+	config        *config.Config
 }
 
-func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+func New(store *store.Store, repo store.DBRepo, service *service.Service, knowledgeBase knowledgebase.IController, icyReward icyreward.IController, logger logger.Logger, cfg *config.Config) IController {
 	return &controller{
-		store:   store,
-		repo:    repo,
-		service: service,
-		logger:  logger,
-		config:  cfg,
+		store:         store,
+		repo:          repo,
+		service:       service,
+		knowledgeBase: knowledgeBase,
+		icyReward:     icyReward,
+		logger:        logger,
+		config:        cfg,
 	}
 }
 
@@ -0,0 +1,12 @@
+package capacity
+
+import "errors"
+
+var (
+	ErrInvalidWeeks          = errors.New("invalid number of weeks")
+	ErrEmployeeNotFound      = errors.New("employee not found in the plan")
+	ErrSlotNotFound          = errors.New("slot not found in the running projects")
+	ErrDeploymentNotFound    = errors.New("deployment not found in the plan")
+	ErrInvalidDeploymentType = errors.New("invalid deployment type")
+	ErrInvalidDateRange      = errors.New("end date must not be before the start date")
+)
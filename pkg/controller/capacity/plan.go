@@ -0,0 +1,198 @@
+package capacity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/capacity"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+const (
+	defaultWeeks = 12
+	maxWeeks     = 26
+)
+
+type PlanInput struct {
+	From           time.Time // the plan starts on the week of From
+	Weeks          int       // 12 when empty
+	WhatIf         []WhatIfDeployment
+	EndDateChanges []EndDateChange
+}
+
+// WhatIfDeployment is a deployment modelled in the plan and not committed, on a slot or a project
+type WhatIfDeployment struct {
+	EmployeeID     string
+	SlotID         string
+	ProjectID      string
+	DeploymentType model.DeploymentType // the type of the slot, or official, when empty
+	StartDate      *time.Time
+	EndDate        *time.Time
+}
+
+// EndDateChange models an existing deployment extended or ended at another date, nil for open-ended
+type EndDateChange struct {
+	ProjectMemberID string
+	EndDate         *time.Time
+}
+
+// Plan projects the weekly allocation of the employees from their deployments, their approved
+// leave and the slots of the running projects. The what-if deployments and end date changes
+// are only applied to the plan.
+func (r *controller) Plan(input PlanInput) (*capacity.Plan, error) {
+	if input.Weeks == 0 {
+		input.Weeks = defaultWeeks
+	}
+	if input.Weeks < 0 || input.Weeks > maxWeeks {
+		return nil, ErrInvalidWeeks
+	}
+
+	weeks := capacity.Weeks(input.From, input.Weeks)
+	from, to := weeks[0], weeks[len(weeks)-1].AddDate(0, 0, 7)
+	db := r.repo.DB()
+
+	employees, err := r.store.Capacity.GetEmployees(db)
+	if err != nil {
+		return nil, err
+	}
+	deployments, err := r.store.Capacity.GetDeployments(db, from, to)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := r.store.Capacity.GetSlots(db)
+	if err != nil {
+		return nil, err
+	}
+	leaves, err := r.store.Capacity.GetLeaves(db, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	in := capacity.Input{
+		From:  from,
+		Weeks: input.Weeks,
+		Weights: capacity.Weights{
+			Official: 1,
+			PartTime: r.config.Profitability.PartTimeAllocation,
+			Shadow:   r.config.Profitability.ShadowAllocation,
+		},
+		Employees:   make([]capacity.Employee, 0, len(employees)),
+		Deployments: make([]capacity.Deployment, 0, len(deployments)+len(input.WhatIf)),
+		Leaves:      make([]capacity.Leave, 0, len(leaves)),
+		Slots:       make([]capacity.Slot, 0, len(slots)),
+	}
+
+	inPlan := map[string]bool{}
+	for _, e := range employees {
+		name := e.FullName
+		if name == "" {
+			name = e.DisplayName
+		}
+		in.Employees = append(in.Employees, capacity.Employee{
+			ID:        e.EmployeeID,
+			Name:      name,
+			Seniority: e.SeniorityName,
+			Stacks:    splitStacks(e.Stacks),
+		})
+		inPlan[e.EmployeeID] = true
+	}
+
+	endDates := map[string]*time.Time{}
+	for _, c := range input.EndDateChanges {
+		endDates[c.ProjectMemberID] = c.EndDate
+	}
+	for _, d := range deployments {
+		endDate := d.EndDate
+		if changed, ok := endDates[d.ProjectMemberID]; ok {
+			endDate = changed
+			delete(endDates, d.ProjectMemberID)
+		}
+		in.Deployments = append(in.Deployments, capacity.Deployment{
+			ID:             d.ProjectMemberID,
+			EmployeeID:     d.EmployeeID,
+			ProjectID:      d.ProjectID,
+			ProjectName:    d.ProjectName,
+			SlotID:         d.ProjectSlotID,
+			DeploymentType: d.DeploymentType,
+			StartDate:      d.StartDate,
+			EndDate:        endDate,
+		})
+	}
+	if len(endDates) > 0 {
+		return nil, ErrDeploymentNotFound
+	}
+
+	slotByID := map[string]model.CapacitySlot{}
+	for _, s := range slots {
+		slotByID[s.SlotID] = s
+		in.Slots = append(in.Slots, capacity.Slot{
+			ID:             s.SlotID,
+			ProjectID:      s.ProjectID,
+			ProjectName:    s.ProjectName,
+			Seniority:      s.SeniorityName,
+			DeploymentType: s.DeploymentType,
+			ProjectEndDate: s.ProjectEndDate,
+		})
+	}
+
+	for _, w := range input.WhatIf {
+		if !inPlan[w.EmployeeID] {
+			return nil, ErrEmployeeNotFound
+		}
+		if w.StartDate != nil && w.EndDate != nil && w.EndDate.Before(*w.StartDate) {
+			return nil, ErrInvalidDateRange
+		}
+
+		d := capacity.Deployment{
+			EmployeeID:     w.EmployeeID,
+			ProjectID:      w.ProjectID,
+			SlotID:         w.SlotID,
+			DeploymentType: w.DeploymentType,
+			StartDate:      w.StartDate,
+			EndDate:        w.EndDate,
+			WhatIf:         true,
+		}
+		if w.SlotID != "" {
+			slot, ok := slotByID[w.SlotID]
+			if !ok {
+				return nil, ErrSlotNotFound
+			}
+			d.ProjectID, d.ProjectName = slot.ProjectID, slot.ProjectName
+			if d.DeploymentType == "" {
+				d.DeploymentType = slot.DeploymentType
+			}
+		}
+		if d.DeploymentType == "" {
+			d.DeploymentType = model.MemberDeploymentTypeOfficial
+		}
+		if !d.DeploymentType.IsValid() {
+			return nil, ErrInvalidDeploymentType
+		}
+		in.Deployments = append(in.Deployments, d)
+	}
+
+	for _, l := range leaves {
+		in.Leaves = append(in.Leaves, capacity.Leave{
+			EmployeeID: l.EmployeeID,
+			StartDate:  l.StartDate,
+			EndDate:    l.EndDate,
+			HalfDay:    isHalfDay(l.Shift),
+		})
+	}
+
+	plan := capacity.Build(in)
+	return &plan, nil
+}
+
+func splitStacks(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// isHalfDay tells whether a leave only takes the morning or the afternoon off
+func isHalfDay(shift string) bool {
+	shift = strings.ToLower(shift)
+	return strings.Contains(shift, "morning") || strings.Contains(shift, "afternoon") || strings.Contains(shift, "half")
+}
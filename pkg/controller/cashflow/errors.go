@@ -0,0 +1,8 @@
+package cashflow
+
+import "errors"
+
+var (
+	ErrInvalidHorizon   = errors.New("invalid horizon, expected weekly or monthly")
+	ErrForecastNotFound = errors.New("cash flow forecast not found")
+)
@@ -0,0 +1,311 @@
+package cashflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/cashflow"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// contractorBatches are the pay days the contractor rates are listed by
+var contractorBatches = []int{1, 15}
+
+// flowBuilder gathers the expected cash movements of a forecast between from and to.
+// A source failing to load is reported as a warning rather than failing the forecast.
+type flowBuilder struct {
+	*controller
+	l        logger.Logger
+	scenario model.CashFlowScenario
+	from     time.Time
+	to       time.Time
+
+	rates    map[string]float64
+	flows    []cashflow.Flow
+	warnings []string
+
+	// payDays are the next pay day of each employee, the salary advances are paid back then
+	payDays map[string]time.Time
+}
+
+func (b *flowBuilder) build() error {
+	steps := []func() error{
+		b.receivables,
+		b.recurringRevenues,
+		b.payroll,
+		b.salaryAdvances,
+		b.operationalServices,
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+
+	b.contractors()
+	b.newHires()
+
+	return nil
+}
+
+func (b *flowBuilder) add(category model.CashFlowCategory, date time.Time, amount float64) {
+	b.flows = append(b.flows, cashflow.Flow{Category: category, Date: date, Amount: amount})
+}
+
+func (b *flowBuilder) warn(format string, args ...any) {
+	b.warnings = append(b.warnings, fmt.Sprintf(format, args...))
+}
+
+// convert turns an amount into the forecast currency at today's rate moved by the FX shift
+// of the scenario. The amounts whose currency has no rate are left out with a warning.
+func (b *flowBuilder) convert(amount float64, currency string) (float64, bool) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" || currency == forecastCurrency {
+		return amount, true
+	}
+
+	rate, ok := b.rates[currency]
+	if !ok {
+		fxRate, err := b.fxRate.GetRateAt(currency, forecastCurrency, time.Now())
+		if err != nil {
+			b.l.AddField("currency", currency).Error(err, "failed to get fx rate")
+			b.warn("no %s/%s rate, the %s amounts are left out", currency, forecastCurrency, currency)
+			rate = 0
+		} else {
+			rate = fxRate.Rate * (1 + b.scenario.FXShiftPercent/100)
+		}
+		b.rates[currency] = rate
+	}
+	if rate == 0 {
+		return 0, false
+	}
+
+	return amount * rate, true
+}
+
+func (b *flowBuilder) churned(clientID string, date time.Time) bool {
+	for _, id := range b.scenario.ChurnClientIDs {
+		if id != clientID {
+			continue
+		}
+		return b.scenario.ChurnDate == nil || !date.Before(*b.scenario.ChurnDate)
+	}
+	return false
+}
+
+// receivables expects the open invoices on their due date, the overdue ones in the first period
+func (b *flowBuilder) receivables() error {
+	invoices, err := b.store.CashFlow.GetOpenReceivables(b.repo.DB())
+	if err != nil {
+		return err
+	}
+
+	for _, iv := range invoices {
+		amount, ok := b.convert(iv.Total, iv.Currency)
+		if !ok {
+			continue
+		}
+
+		date := b.from
+		switch {
+		case iv.DueAt != nil:
+			date = *iv.DueAt
+		case iv.InvoicedAt != nil:
+			date = iv.InvoicedAt.AddDate(0, 0, b.config.CashFlow.PaymentTermDays)
+		}
+
+		b.add(model.CashFlowCategoryReceivable, date, amount)
+	}
+
+	return nil
+}
+
+// recurringRevenues expects the billing of the active projects for the months not invoiced
+// yet, paid the payment term after the end of the month
+func (b *flowBuilder) recurringRevenues() error {
+	revenues, err := b.store.CashFlow.GetRecurringRevenues(b.repo.DB())
+	if err != nil {
+		return err
+	}
+
+	// the invoice paid in the first period was issued up to a payment term earlier
+	firstMonth := b.from.AddDate(0, 0, -b.config.CashFlow.PaymentTermDays)
+	for _, rev := range revenues {
+		amount, ok := b.convert(rev.MonthlyAmount, rev.Currency)
+		if !ok {
+			continue
+		}
+
+		for _, monthEnd := range cashflow.MonthlyDates(31, firstMonth, b.to) {
+			if monthEnd.Year()*12+int(monthEnd.Month()) <= rev.LastInvoicedYear*12+rev.LastInvoicedMonth {
+				continue
+			}
+			if b.churned(rev.ClientID, monthEnd) {
+				continue
+			}
+
+			b.add(model.CashFlowCategoryRecurringRevenue, monthEnd.AddDate(0, 0, b.config.CashFlow.PaymentTermDays), amount)
+		}
+	}
+
+	return nil
+}
+
+// payroll pays the base salaries on the day of their batch, until the employees leave
+func (b *flowBuilder) payroll() error {
+	salaries, err := b.store.CashFlow.GetBaseSalaries(b.repo.DB())
+	if err != nil {
+		return err
+	}
+
+	b.payDays = make(map[string]time.Time, len(salaries))
+	for _, s := range salaries {
+		if s.Amount <= 0 {
+			continue
+		}
+		amount, ok := b.convert(s.Amount, s.Currency)
+		if !ok {
+			continue
+		}
+
+		for _, d := range cashflow.MonthlyDates(s.Batch, b.from, b.to) {
+			if s.LeftDate != nil && d.After(*s.LeftDate) {
+				break
+			}
+			if _, ok := b.payDays[s.EmployeeID]; !ok {
+				b.payDays[s.EmployeeID] = d
+			}
+			b.add(model.CashFlowCategoryPayroll, d, -amount)
+		}
+	}
+
+	return nil
+}
+
+// salaryAdvances deducts the outstanding advances from the next salary of the employees
+func (b *flowBuilder) salaryAdvances() error {
+	notPaidBack := false
+	advances, err := b.store.SalaryAdvance.ListAggregatedSalaryAdvance(b.repo.DB(), &notPaidBack, model.Pagination{}, "")
+	if err != nil {
+		return err
+	}
+
+	for _, a := range advances {
+		payDay, ok := b.payDays[a.EmployeeID]
+		if !ok || a.AmountUSD <= 0 {
+			continue
+		}
+		amount, ok := b.convert(a.AmountUSD, "USD")
+		if !ok {
+			continue
+		}
+
+		b.add(model.CashFlowCategorySalaryAdvance, payDay, amount)
+	}
+
+	return nil
+}
+
+// operationalServices pays the active monthly services on the day of the month they started
+func (b *flowBuilder) operationalServices() error {
+	services, err := b.store.OperationalService.FindOperationByMonth(b.repo.DB(), b.from.Month())
+	if err != nil {
+		return err
+	}
+
+	for _, s := range services {
+		currency := ""
+		if s.Currency != nil {
+			currency = s.Currency.Name
+		}
+		amount, ok := b.convert(float64(s.Amount), currency)
+		if !ok {
+			continue
+		}
+
+		from, to := b.from, b.to
+		if s.StartAt.After(from) {
+			from = s.StartAt
+		}
+		if !s.EndAt.IsZero() && s.EndAt.Before(to) {
+			to = s.EndAt
+		}
+
+		for _, d := range cashflow.MonthlyDates(s.StartAt.Day(), from, to) {
+			b.add(model.CashFlowCategoryOperationalService, d, -amount)
+		}
+	}
+
+	return nil
+}
+
+// contractors pays the monthly fixed contractor rates on their pay day. The hourly rates
+// depend on the hours worked and are left out.
+func (b *flowBuilder) contractors() {
+	if b.service.Notion == nil || b.service.Notion.ContractorRates == nil {
+		b.warn("contractor rates are not available, the contractor payouts are left out")
+		return
+	}
+
+	var hourly int
+	month := b.from.Format("2006-01")
+	for _, batch := range contractorBatches {
+		rates, err := b.service.Notion.ContractorRates.ListActiveContractorsByBatch(context.Background(), month, batch)
+		if err != nil {
+			b.l.AddField("batch", batch).Error(err, "failed to list contractor rates")
+			b.warn("contractor rates of batch %d could not be loaded, their payouts are left out", batch)
+			continue
+		}
+
+		for _, rate := range rates {
+			if rate.MonthlyFixed <= 0 {
+				hourly++
+				continue
+			}
+			amount, ok := b.convert(rate.MonthlyFixed, rate.Currency)
+			if !ok {
+				continue
+			}
+
+			payDay := rate.PayDay
+			if payDay == 0 {
+				payDay = batch
+			}
+			for _, d := range cashflow.MonthlyDates(payDay, b.from, b.to) {
+				if rate.EndDate != nil && d.After(*rate.EndDate) {
+					break
+				}
+				b.add(model.CashFlowCategoryContractor, d, -amount)
+			}
+		}
+	}
+
+	if hourly > 0 {
+		b.warn("%d contractors paid by the hour are left out", hourly)
+	}
+}
+
+// newHires pays the hires of the scenario each month from their start date
+func (b *flowBuilder) newHires() {
+	s := b.scenario
+	if s.NewHires <= 0 || s.NewHireMonthlyCost <= 0 {
+		return
+	}
+
+	start := time.Date(b.from.Year(), b.from.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+	if s.NewHireStartDate != nil {
+		start = *s.NewHireStartDate
+	}
+
+	from := b.from
+	if start.After(from) {
+		from = start
+	}
+
+	for _, d := range cashflow.MonthlyDates(start.Day(), from, b.to) {
+		b.add(model.CashFlowCategoryNewHire, d, -float64(s.NewHires)*s.NewHireMonthlyCost)
+	}
+}
@@ -0,0 +1,139 @@
+package cashflow
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/cashflow"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/cashflowforecast"
+)
+
+// forecastCurrency is the currency the forecast is made in, the payroll and the
+// accounting transactions the actuals come from are in VND
+const forecastCurrency = "VND"
+
+type ForecastInput struct {
+	Horizon  model.CashFlowHorizon
+	Date     time.Time // the forecast starts with the period containing it, today when empty
+	Scenario model.CashFlowScenario
+}
+
+// Forecast projects the open invoices, the recurring project revenue, the payroll, the
+// contractor payouts and the operational services on the weeks or months to come
+func (r *controller) Forecast(input ForecastInput) (*model.CashFlowReport, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "cashflow",
+		"method":     "Forecast",
+		"horizon":    input.Horizon,
+	})
+
+	if !input.Horizon.IsValid() {
+		return nil, ErrInvalidHorizon
+	}
+	if input.Date.IsZero() {
+		input.Date = time.Now()
+	}
+
+	periods := cashflow.Periods(input.Horizon, input.Date)
+	from, to := periods[0].Start, periods[len(periods)-1].End
+
+	b := &flowBuilder{
+		controller: r,
+		l:          l,
+		scenario:   input.Scenario,
+		from:       from,
+		to:         to,
+		rates:      map[string]float64{},
+	}
+	if err := b.build(); err != nil {
+		return nil, err
+	}
+
+	return &model.CashFlowReport{
+		Horizon:   input.Horizon,
+		StartDate: from,
+		Currency:  forecastCurrency,
+		Scenario:  input.Scenario,
+		Periods:   cashflow.Build(periods, b.flows, input.Scenario.OpeningBalance),
+		Warnings:  b.warnings,
+	}, nil
+}
+
+// SaveForecast computes a forecast and keeps it to compare it with the actuals later
+func (r *controller) SaveForecast(input ForecastInput, createdBy *model.UUID) (*model.CashFlowForecast, *model.CashFlowReport, error) {
+	report, err := r.Forecast(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	forecast, err := report.ToCashFlowForecast(createdBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	forecast, err = r.store.CashFlowForecast.Create(r.repo.DB(), forecast)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return forecast, report, nil
+}
+
+// SnapshotForecasts saves the weekly and monthly forecasts without scenario, it runs monthly
+// so leadership gets the numbers they used to put together from the valuation
+func (r *controller) SnapshotForecasts() ([]*model.CashFlowForecast, error) {
+	var forecasts []*model.CashFlowForecast
+	for _, horizon := range []model.CashFlowHorizon{model.CashFlowHorizonWeekly, model.CashFlowHorizonMonthly} {
+		forecast, _, err := r.SaveForecast(ForecastInput{Horizon: horizon}, nil)
+		if err != nil {
+			return forecasts, err
+		}
+		forecasts = append(forecasts, forecast)
+	}
+	return forecasts, nil
+}
+
+func (r *controller) ListForecasts(horizon model.CashFlowHorizon, pagination model.Pagination) ([]*model.CashFlowForecast, int64, error) {
+	return r.store.CashFlowForecast.All(r.repo.DB(), cashflowforecast.Query{Horizon: horizon}, pagination)
+}
+
+func (r *controller) GetForecast(id string) (*model.CashFlowForecast, *model.CashFlowReport, error) {
+	forecast, err := r.store.CashFlowForecast.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrForecastNotFound
+		}
+		return nil, nil, err
+	}
+
+	report, err := forecast.Report()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return forecast, report, nil
+}
+
+// CompareActuals sets a saved forecast against the cash booked in the accounting transactions
+func (r *controller) CompareActuals(id string) (*model.CashFlowForecast, []model.CashFlowVariance, error) {
+	forecast, report, err := r.GetForecast(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(report.Periods) == 0 {
+		return forecast, []model.CashFlowVariance{}, nil
+	}
+
+	from := report.Periods[0].Start
+	to := report.Periods[len(report.Periods)-1].End.AddDate(0, 0, 1)
+	actuals, err := r.store.CashFlow.GetActuals(r.repo.DB(), from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return forecast, cashflow.Compare(report.Periods, actuals, time.Now()), nil
+}
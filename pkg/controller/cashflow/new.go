@@ -0,0 +1,41 @@
+package cashflow
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	fxRate  fxrate.IController
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+// New returns the cash-flow controller, the foreign currencies are converted with the
+// rates recorded by the fx rate controller
+func New(store *store.Store, repo store.DBRepo, service *service.Service, fxRate fxrate.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		fxRate:  fxRate,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Forecast(input ForecastInput) (*model.CashFlowReport, error)
+	SaveForecast(input ForecastInput, createdBy *model.UUID) (*model.CashFlowForecast, *model.CashFlowReport, error)
+	SnapshotForecasts() ([]*model.CashFlowForecast, error)
+	ListForecasts(horizon model.CashFlowHorizon, pagination model.Pagination) ([]*model.CashFlowForecast, int64, error)
+	GetForecast(id string) (*model.CashFlowForecast, *model.CashFlowReport, error)
+	CompareActuals(id string) (*model.CashFlowForecast, []model.CashFlowVariance, error)
+}
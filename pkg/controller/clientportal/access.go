@@ -0,0 +1,234 @@
+package clientportal
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/clientportal"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+type GrantAccessInput struct {
+	ClientID        string
+	ClientContactID string
+	Email           string // one of the emails of the contact, the first one when empty
+	GrantedBy       string
+}
+
+// GrantedAccess holds the key of a new access, it is not stored and can't be shown again
+type GrantedAccess struct {
+	Access *model.ClientPortalAccess
+	Key    string
+}
+
+type LoginResult struct {
+	Access    *model.ClientPortalAccess
+	Token     string
+	ExpiresAt time.Time
+}
+
+// GrantAccess lets a contact of the client log in to the client portal with one of their emails
+func (r *controller) GrantAccess(input GrantAccessInput) (*GrantedAccess, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "GrantAccess",
+		"clientID":   input.ClientID,
+		"contactID":  input.ClientContactID,
+	})
+
+	db := r.repo.DB()
+	if _, err := r.store.Client.One(db, input.ClientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrClientNotFound
+		}
+		l.Error(err, "failed to get client")
+		return nil, err
+	}
+
+	contact, err := r.store.ClientContact.One(db, input.ClientContactID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrContactNotFound
+		}
+		l.Error(err, "failed to get client contact")
+		return nil, err
+	}
+	if contact.ClientID.String() != input.ClientID {
+		return nil, ErrContactNotFound
+	}
+
+	email := strings.TrimSpace(input.Email)
+	if email == "" {
+		emails := contact.EmailList()
+		if len(emails) == 0 {
+			return nil, ErrContactHasNoEmail
+		}
+		email = strings.TrimSpace(emails[0])
+	}
+	if !contact.HasEmail(email) {
+		return nil, ErrEmailNotOfContact
+	}
+
+	_, err = r.store.ClientPortal.OneActiveAccessByEmail(db, email)
+	if err == nil {
+		return nil, ErrAccessExists
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Error(err, "failed to get client portal access by email")
+		return nil, err
+	}
+
+	key, err := authutils.GenerateUniqueNanoID(authutils.SecretKeyLength)
+	if err != nil {
+		l.Error(err, "failed to generate access key")
+		return nil, err
+	}
+	hashedKey, err := authutils.GenerateHashedKey(key)
+	if err != nil {
+		l.Error(err, "failed to hash access key")
+		return nil, err
+	}
+
+	access := &model.ClientPortalAccess{
+		ClientID:        contact.ClientID,
+		ClientContactID: contact.ID,
+		Email:           strings.ToLower(email),
+		HashedKey:       hashedKey,
+	}
+	if input.GrantedBy != "" {
+		grantedBy := model.MustGetUUIDFromString(input.GrantedBy)
+		access.CreatedBy = &grantedBy
+	}
+
+	if _, err := r.store.ClientPortal.CreateAccess(db, access); err != nil {
+		l.Error(err, "failed to create client portal access")
+		return nil, err
+	}
+	access.ClientContact = contact
+
+	return &GrantedAccess{Access: access, Key: key}, nil
+}
+
+func (r *controller) ListAccesses(clientID string, includeRevoked bool) ([]*model.ClientPortalAccess, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "ListAccesses",
+		"clientID":   clientID,
+	})
+
+	db := r.repo.DB()
+	if _, err := r.store.Client.One(db, clientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrClientNotFound
+		}
+		l.Error(err, "failed to get client")
+		return nil, err
+	}
+
+	accesses, err := r.store.ClientPortal.AllAccesses(db, clientportal.AccessQuery{
+		ClientID:       clientID,
+		IncludeRevoked: includeRevoked,
+	})
+	if err != nil {
+		l.Error(err, "failed to get client portal accesses")
+		return nil, err
+	}
+
+	return accesses, nil
+}
+
+// RevokeAccess stops an access from logging in, the tokens it got are rejected from the next request
+func (r *controller) RevokeAccess(clientID, accessID, revokedBy string) (*model.ClientPortalAccess, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "RevokeAccess",
+		"clientID":   clientID,
+		"accessID":   accessID,
+	})
+
+	db := r.repo.DB()
+	access, err := r.store.ClientPortal.OneAccess(db, accessID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccessNotFound
+		}
+		l.Error(err, "failed to get client portal access")
+		return nil, err
+	}
+	if access.ClientID.String() != clientID {
+		return nil, ErrAccessNotFound
+	}
+	if access.IsRevoked() {
+		return nil, ErrAccessRevoked
+	}
+
+	now := time.Now()
+	access.RevokedAt = &now
+	fields := []string{"revoked_at"}
+	if revokedBy != "" {
+		by := model.MustGetUUIDFromString(revokedBy)
+		access.RevokedBy = &by
+		fields = append(fields, "revoked_by")
+	}
+
+	if _, err := r.store.ClientPortal.UpdateAccessSelectedFieldsByID(db, accessID, *access, fields...); err != nil {
+		l.Error(err, "failed to revoke client portal access")
+		return nil, err
+	}
+
+	return access, nil
+}
+
+// Login exchanges the email and key of an access for a client portal token
+func (r *controller) Login(email, key string) (*LoginResult, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "Login",
+	})
+
+	if r.config.ClientPortal.JWTSecretKey == "" {
+		return nil, ErrClientPortalDisabled
+	}
+
+	db := r.repo.DB()
+	access, err := r.store.ClientPortal.OneActiveAccessByEmail(db, email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidCredentials
+		}
+		l.Error(err, "failed to get client portal access by email")
+		return nil, err
+	}
+	if err := authutils.ValidateHashedKey(access.HashedKey, strings.TrimSpace(key)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	ttl := r.config.ClientPortal.TokenTTLHours
+	if ttl <= 0 {
+		ttl = 24
+	}
+	expiresAt := time.Now().Add(time.Duration(ttl) * time.Hour)
+
+	token, err := authutils.GenerateClientPortalToken(&model.ClientPortalClaims{
+		AccessID:  access.ID.String(),
+		ContactID: access.ClientContactID.String(),
+		ClientID:  access.ClientID.String(),
+	}, expiresAt.Unix(), r.config.ClientPortal.JWTSecretKey)
+	if err != nil {
+		l.Error(err, "failed to generate client portal token")
+		return nil, err
+	}
+
+	now := time.Now()
+	access.LastLoginAt = &now
+	if _, err := r.store.ClientPortal.UpdateAccessSelectedFieldsByID(db, access.ID.String(), *access, "last_login_at"); err != nil {
+		l.Error(err, "failed to update last login of client portal access")
+	}
+
+	return &LoginResult{Access: access, Token: token, ExpiresAt: expiresAt}, nil
+}
@@ -0,0 +1,19 @@
+package clientportal
+
+import "errors"
+
+var (
+	ErrClientNotFound       = errors.New("client not found")
+	ErrContactNotFound      = errors.New("contact not found in the client")
+	ErrEmailNotOfContact    = errors.New("email is not one of the contact")
+	ErrContactHasNoEmail    = errors.New("contact has no email to log in with")
+	ErrAccessExists         = errors.New("email already has an access to the client portal")
+	ErrAccessNotFound       = errors.New("client portal access not found")
+	ErrAccessRevoked        = errors.New("client portal access is already revoked")
+	ErrInvalidCredentials   = errors.New("invalid email or access key")
+	ErrInvoiceNotFound      = errors.New("invoice not found")
+	ErrInvoiceNotPaid       = errors.New("invoice is not paid yet")
+	ErrInvoiceFileNotFound  = errors.New("invoice file not found")
+	ErrInvalidInvoiceStatus = errors.New("invalid invoice status")
+	ErrClientPortalDisabled = errors.New("client portal is not configured")
+)
@@ -0,0 +1,40 @@
+package clientportal
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	GrantAccess(input GrantAccessInput) (*GrantedAccess, error)
+	ListAccesses(clientID string, includeRevoked bool) ([]*model.ClientPortalAccess, error)
+	RevokeAccess(clientID, accessID, revokedBy string) (*model.ClientPortalAccess, error)
+	Login(email, key string) (*LoginResult, error)
+
+	ListInvoices(clientID string, statuses []model.InvoiceStatus, pagination model.Pagination) ([]*model.Invoice, int64, error)
+	GetInvoice(clientID, invoiceID string) (*model.Invoice, error)
+	GetInvoicePDF(clientID, invoiceID string) (*InvoiceFile, error)
+	GetReceipt(clientID, invoiceID string) (*model.Invoice, error)
+	GetTeam(clientID string) ([]model.ClientPortalTeamMember, error)
+}
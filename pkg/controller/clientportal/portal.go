@@ -0,0 +1,140 @@
+package clientportal
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/invoice"
+)
+
+// InvoiceFile is the PDF of an invoice as sent to the client
+type InvoiceFile struct {
+	Invoice *model.Invoice
+	Name    string
+	Content []byte
+}
+
+// ListInvoices returns the invoices sent to the client, statuses only narrow down the visible ones
+func (r *controller) ListInvoices(clientID string, statuses []model.InvoiceStatus, pagination model.Pagination) ([]*model.Invoice, int64, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "ListInvoices",
+		"clientID":   clientID,
+	})
+
+	if len(statuses) == 0 {
+		statuses = model.ClientPortalInvoiceStatuses
+	}
+	filter := invoice.GetInvoicesFilter{
+		Preload:  true,
+		ClientID: clientID,
+	}
+	for _, s := range statuses {
+		if !s.IsClientPortalVisible() {
+			return nil, 0, ErrInvalidInvoiceStatus
+		}
+		filter.Statuses = append(filter.Statuses, s.String())
+	}
+	// clients don't pick the order, the sort goes as is into the query
+	pagination.Sort = "invoiced_at DESC NULLS LAST, created_at DESC"
+
+	invoices, total, err := r.store.Invoice.All(r.repo.DB(), filter, pagination)
+	if err != nil {
+		l.Error(err, "failed to get invoices")
+		return nil, 0, err
+	}
+
+	return invoices, total, nil
+}
+
+// GetInvoice returns an invoice of the client, invoices of other clients or not sent yet are not found
+func (r *controller) GetInvoice(clientID, invoiceID string) (*model.Invoice, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "GetInvoice",
+		"clientID":   clientID,
+		"invoiceID":  invoiceID,
+	})
+
+	iv, err := r.store.Invoice.One(r.repo.DB(), &invoice.Query{ID: invoiceID})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvoiceNotFound
+		}
+		l.Error(err, "failed to get invoice")
+		return nil, err
+	}
+	if iv.Project == nil || iv.Project.ClientID.String() != clientID || !iv.Status.IsClientPortalVisible() {
+		return nil, ErrInvoiceNotFound
+	}
+
+	return iv, nil
+}
+
+// GetInvoicePDF downloads the PDF sent to the client, a paid invoice is moved to the Paid folder
+// but may still be in the Sent one when the move failed
+func (r *controller) GetInvoicePDF(clientID, invoiceID string) (*InvoiceFile, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "GetInvoicePDF",
+		"clientID":   clientID,
+		"invoiceID":  invoiceID,
+	})
+
+	iv, err := r.GetInvoice(clientID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if r.service.GoogleDrive == nil {
+		return nil, ErrInvoiceFileNotFound
+	}
+
+	dirs := []string{"Sent"}
+	if iv.Status == model.InvoiceStatusPaid {
+		dirs = []string{"Paid", "Sent"}
+	}
+
+	for _, dir := range dirs {
+		content, err := r.service.GoogleDrive.DownloadInvoicePDF(iv, dir)
+		if err != nil {
+			l.Warnf("failed to download invoice pdf from %v folder: %v", dir, err)
+			continue
+		}
+		return &InvoiceFile{Invoice: iv, Name: iv.Number + ".pdf", Content: content}, nil
+	}
+
+	return nil, ErrInvoiceFileNotFound
+}
+
+// GetReceipt returns a paid invoice of the client to issue its receipt
+func (r *controller) GetReceipt(clientID, invoiceID string) (*model.Invoice, error) {
+	iv, err := r.GetInvoice(clientID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if iv.Status != model.InvoiceStatusPaid || iv.PaidAt == nil {
+		return nil, ErrInvoiceNotPaid
+	}
+
+	return iv, nil
+}
+
+// GetTeam returns the members currently deployed on the projects of the client
+func (r *controller) GetTeam(clientID string) ([]model.ClientPortalTeamMember, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "clientportal",
+		"method":     "GetTeam",
+		"clientID":   clientID,
+	})
+
+	members, err := r.store.ClientPortal.GetTeam(r.repo.DB(), clientID)
+	if err != nil {
+		l.Error(err, "failed to get team of client")
+		return nil, err
+	}
+
+	return members, nil
+}
@@ -0,0 +1,347 @@
+package contract
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	contractstore "github.com/dwarvesf/fortress-api/pkg/store/contract"
+)
+
+// defaultPaymentTermDays is the payment term of a contract when none is given, net-30
+const defaultPaymentTermDays = 30
+
+type ListInput struct {
+	ClientID  string
+	ProjectID string
+	Type      model.ContractType
+	Status    model.ContractStatus
+}
+
+type ContractInput struct {
+	ClientID        model.UUID
+	ProjectID       *model.UUID
+	Code            string
+	Name            string
+	Type            model.ContractType   // sow when empty
+	Status          model.ContractStatus // draft when empty
+	StartDate       time.Time
+	EndDate         *time.Time
+	SignedAt        *time.Time
+	Currency        string
+	PaymentTermDays int
+	ValueCap        *decimal.Decimal
+	Note            string
+}
+
+type RateInput struct {
+	SeniorityID *model.UUID
+	PositionID  *model.UUID
+	Rate        decimal.Decimal
+	Unit        model.ContractRateUnit // month when empty
+}
+
+type AttachmentInput struct {
+	Name        string
+	ContentType string
+	Content     []byte
+	UploadedBy  string
+}
+
+func (r *controller) List(input ListInput, pagination model.Pagination) ([]*model.Contract, int64, error) {
+	return r.store.Contract.All(r.repo.DB(), contractstore.Query{
+		ClientID:  input.ClientID,
+		ProjectID: input.ProjectID,
+		Type:      input.Type,
+		Status:    input.Status,
+	}, pagination)
+}
+
+func (r *controller) Get(id string) (*model.Contract, error) {
+	contract, err := r.store.Contract.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrContractNotFound
+		}
+		return nil, err
+	}
+	return contract, nil
+}
+
+func (r *controller) Create(input ContractInput, createdBy string) (*model.Contract, error) {
+	contract := &model.Contract{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+	}
+	if createdBy != "" {
+		id, err := model.UUIDFromString(createdBy)
+		if err == nil {
+			contract.CreatedBy = &id
+		}
+	}
+	if err := r.applyInput(contract, input); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.Contract.Create(r.repo.DB(), contract); err != nil {
+		return nil, err
+	}
+
+	return r.Get(contract.ID.String())
+}
+
+func (r *controller) Update(id string, input ContractInput) (*model.Contract, error) {
+	contract, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	endDate := contract.EndDate
+	if err := r.applyInput(contract, input); err != nil {
+		return nil, err
+	}
+	if !sameDate(endDate, contract.EndDate) {
+		// a renewed contract is announced again when its new end comes close
+		contract.ExpiryNotifiedAt = nil
+	}
+
+	_, err = r.store.Contract.UpdateSelectedFieldsByID(r.repo.DB(), id, model.Contract{
+		ClientID:         contract.ClientID,
+		ProjectID:        contract.ProjectID,
+		Code:             contract.Code,
+		Name:             contract.Name,
+		Type:             contract.Type,
+		Status:           contract.Status,
+		StartDate:        contract.StartDate,
+		EndDate:          contract.EndDate,
+		SignedAt:         contract.SignedAt,
+		CurrencyID:       contract.CurrencyID,
+		PaymentTermDays:  contract.PaymentTermDays,
+		ValueCap:         contract.ValueCap,
+		Note:             contract.Note,
+		ExpiryNotifiedAt: contract.ExpiryNotifiedAt,
+	}, "client_id", "project_id", "code", "name", "type", "status", "start_date", "end_date", "signed_at",
+		"currency_id", "payment_term_days", "value_cap", "note", "expiry_notified_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(id)
+}
+
+// SetRates replaces the rate card of the contract
+func (r *controller) SetRates(id string, input []RateInput) (*model.Contract, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "contract",
+		"method":     "SetRates",
+		"id":         id,
+	})
+
+	contract, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]model.ContractRate, 0, len(input))
+	for _, in := range input {
+		if !in.Rate.IsPositive() {
+			return nil, ErrInvalidRate
+		}
+		if in.Unit == "" {
+			in.Unit = model.ContractRateUnitMonth
+		}
+		if !in.Unit.IsValid() {
+			return nil, ErrInvalidRateUnit
+		}
+		if in.SeniorityID != nil {
+			if _, err := r.store.Seniority.One(r.repo.DB(), *in.SeniorityID); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, ErrSeniorityNotFound
+				}
+				return nil, err
+			}
+		}
+		if in.PositionID != nil {
+			if _, err := r.store.Position.One(r.repo.DB(), *in.PositionID); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, ErrPositionNotFound
+				}
+				return nil, err
+			}
+		}
+
+		rates = append(rates, model.ContractRate{
+			BaseModel:   model.BaseModel{ID: model.NewUUID()},
+			ContractID:  contract.ID,
+			SeniorityID: in.SeniorityID,
+			PositionID:  in.PositionID,
+			Rate:        in.Rate,
+			Unit:        in.Unit,
+		})
+	}
+
+	tx, done := r.repo.NewTransaction()
+	if err := r.store.ContractRate.DeleteByContractID(tx.DB(), id); err != nil {
+		l.Error(err, "failed to delete contract rates")
+		return nil, done(err)
+	}
+	if len(rates) > 0 {
+		if _, err := r.store.ContractRate.BatchCreate(tx.DB(), rates); err != nil {
+			l.Error(err, "failed to create contract rates")
+			return nil, done(err)
+		}
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.Get(id)
+}
+
+// UploadAttachment keeps the file in the folder of the client in Google Drive and links it to
+// the contract
+func (r *controller) UploadAttachment(id string, input AttachmentInput) (*model.ContractAttachment, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "contract",
+		"method":     "UploadAttachment",
+		"id":         id,
+	})
+
+	contract, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.Content) == 0 {
+		return nil, ErrEmptyAttachment
+	}
+
+	clientName := contract.ClientID.String()
+	if contract.Client != nil {
+		clientName = contract.Client.Name
+	}
+
+	url, err := r.service.GoogleDrive.UploadContractFile(clientName, input.Name, input.ContentType, input.Content)
+	if err != nil {
+		l.Error(err, "failed to upload contract file")
+		return nil, err
+	}
+
+	attachment := &model.ContractAttachment{
+		BaseModel:   model.BaseModel{ID: model.NewUUID()},
+		ContractID:  contract.ID,
+		Name:        input.Name,
+		ContentType: input.ContentType,
+		URL:         url,
+	}
+	if input.UploadedBy != "" {
+		uploadedBy, err := model.UUIDFromString(input.UploadedBy)
+		if err == nil {
+			attachment.UploadedBy = &uploadedBy
+		}
+	}
+
+	return r.store.ContractAttachment.Create(r.repo.DB(), attachment)
+}
+
+func (r *controller) applyInput(contract *model.Contract, input ContractInput) error {
+	if input.Type == "" {
+		input.Type = model.ContractTypeSOW
+	}
+	if !input.Type.IsValid() {
+		return ErrInvalidType
+	}
+	if input.Status == "" {
+		input.Status = model.ContractStatusDraft
+	}
+	if !input.Status.IsValid() {
+		return ErrInvalidStatus
+	}
+	if input.PaymentTermDays == 0 {
+		input.PaymentTermDays = defaultPaymentTermDays
+	}
+	if !model.IsValidContractPaymentTerm(input.PaymentTermDays) {
+		return ErrInvalidPaymentTerm
+	}
+	if input.EndDate != nil && input.EndDate.Before(input.StartDate) {
+		return ErrInvalidEndDate
+	}
+	if input.ValueCap != nil && !input.ValueCap.IsPositive() {
+		return ErrInvalidValueCap
+	}
+	switch {
+	case input.Type == model.ContractTypeSOW && input.ProjectID == nil:
+		return ErrSOWWithoutProject
+	case input.Type == model.ContractTypeMSA && input.ProjectID != nil:
+		return ErrMSAWithProject
+	}
+
+	if _, err := r.store.Client.One(r.repo.DB(), input.ClientID.String()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrClientNotFound
+		}
+		return err
+	}
+	if input.ProjectID != nil {
+		project, err := r.store.Project.One(r.repo.DB(), input.ProjectID.String(), false)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrProjectNotFound
+			}
+			return err
+		}
+		if project.ClientID != input.ClientID {
+			return ErrProjectNotOfClient
+		}
+	}
+
+	var currencyID *model.UUID
+	if currencyName := strings.ToUpper(strings.TrimSpace(input.Currency)); currencyName != "" {
+		currency, err := r.store.Currency.GetByName(r.repo.DB(), currencyName)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrCurrencyNotFound
+			}
+			return err
+		}
+		currencyID = &currency.ID
+	}
+
+	code := strings.TrimSpace(input.Code)
+	exists, err := r.store.Contract.IsCodeExist(r.repo.DB(), code, contract.ID.String())
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrCodeExists
+	}
+
+	contract.ClientID = input.ClientID
+	contract.ProjectID = input.ProjectID
+	contract.Code = code
+	contract.Name = strings.TrimSpace(input.Name)
+	contract.Type = input.Type
+	contract.Status = input.Status
+	contract.StartDate = input.StartDate
+	contract.EndDate = input.EndDate
+	contract.SignedAt = input.SignedAt
+	contract.CurrencyID = currencyID
+	contract.PaymentTermDays = input.PaymentTermDays
+	contract.ValueCap = decimal.NullDecimal{}
+	if input.ValueCap != nil {
+		contract.ValueCap = decimal.NewNullDecimal(*input.ValueCap)
+	}
+	contract.Note = strings.TrimSpace(input.Note)
+
+	return nil
+}
+
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Format("2006-01-02") == b.Format("2006-01-02")
+}
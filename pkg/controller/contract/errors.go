@@ -0,0 +1,25 @@
+package contract
+
+import "errors"
+
+var (
+	ErrContractNotFound        = errors.New("contract not found")
+	ErrClientNotFound          = errors.New("client not found")
+	ErrProjectNotFound         = errors.New("project not found")
+	ErrProjectNotOfClient      = errors.New("project does not belong to the client")
+	ErrCurrencyNotFound        = errors.New("currency not found")
+	ErrSeniorityNotFound       = errors.New("seniority not found")
+	ErrPositionNotFound        = errors.New("position not found")
+	ErrInvalidType             = errors.New("invalid contract type")
+	ErrInvalidStatus           = errors.New("invalid contract status")
+	ErrInvalidPaymentTerm      = errors.New("invalid payment term")
+	ErrInvalidEndDate          = errors.New("end date must not be before the start date")
+	ErrInvalidValueCap         = errors.New("value cap must be positive")
+	ErrInvalidRate             = errors.New("rate must be positive")
+	ErrInvalidRateUnit         = errors.New("invalid rate unit")
+	ErrSOWWithoutProject       = errors.New("a SOW must be linked to a project")
+	ErrMSAWithProject          = errors.New("a MSA covers the whole client and must not be linked to a project")
+	ErrCodeExists              = errors.New("contract code already exists")
+	ErrEmptyAttachment         = errors.New("attachment is empty")
+	ErrInvoiceViolatesContract = errors.New("invoice violates the contract")
+)
@@ -0,0 +1,104 @@
+package contract
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/clientcontract"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// expiryNoticeDays is how many days before its end a contract is announced
+const expiryNoticeDays = 30
+
+// NotifyExpiring announces on Discord to the account managers of their projects the active
+// contracts ending within the notice window. A contract is announced once until its end date
+// changes.
+func (r *controller) NotifyExpiring(today time.Time) ([]*model.Contract, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "contract",
+		"method":     "NotifyExpiring",
+	})
+
+	contracts, err := r.store.Contract.GetExpiring(r.repo.DB(), today.AddDate(0, 0, expiryNoticeDays))
+	if err != nil {
+		return nil, err
+	}
+
+	notified := make([]*model.Contract, 0)
+	for _, c := range contracts {
+		if !clientcontract.ExpiryNoticeDue(c, today, expiryNoticeDays) {
+			continue
+		}
+		days, _ := clientcontract.DaysToExpiry(c, today)
+
+		managers, err := r.store.Contract.GetAccountManagers(r.repo.DB(), c)
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.discord.Log(model.LogDiscordInput{
+			Type: "contract_expiry",
+			Data: map[string]interface{}{
+				"contract":         contractLabel(c),
+				"client":           clientName(c),
+				"end_date":         c.EndDate.Format("2006-01-02"),
+				"days":             days,
+				"account_managers": mentions(managers),
+			},
+		})
+		if err != nil {
+			// the next run tries again
+			l.AddField("contractID", c.ID).Error(err, "failed to announce the contract expiry")
+			continue
+		}
+
+		now := time.Now()
+		c.ExpiryNotifiedAt = &now
+		_, err = r.store.Contract.UpdateSelectedFieldsByID(r.repo.DB(), c.ID.String(), model.Contract{
+			ExpiryNotifiedAt: c.ExpiryNotifiedAt,
+		}, "expiry_notified_at")
+		if err != nil {
+			return nil, err
+		}
+		notified = append(notified, c)
+	}
+
+	return notified, nil
+}
+
+func contractLabel(c *model.Contract) string {
+	label := c.Code
+	if c.Name != "" {
+		label = fmt.Sprintf("%s - %s", label, c.Name)
+	}
+	if c.Project != nil {
+		label = fmt.Sprintf("%s (%s)", label, c.Project.Name)
+	}
+	return label
+}
+
+func clientName(c *model.Contract) string {
+	if c.Client == nil {
+		return "the client"
+	}
+	return c.Client.Name
+}
+
+func mentions(employees []*model.Employee) string {
+	if len(employees) == 0 {
+		return "account managers"
+	}
+
+	names := make([]string, 0, len(employees))
+	for _, e := range employees {
+		if e.DiscordAccount != nil && e.DiscordAccount.DiscordID != "" {
+			names = append(names, fmt.Sprintf("<@%s>", e.DiscordAccount.DiscordID))
+			continue
+		}
+		names = append(names, e.DisplayName)
+	}
+	return strings.Join(names, ", ")
+}
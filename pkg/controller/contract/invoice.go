@@ -0,0 +1,86 @@
+package contract
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/clientcontract"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// ValidateInvoice checks the invoice against the contract ruling its project in the invoiced
+// month. Invoices of projects without active contract are not checked.
+func (r *controller) ValidateInvoice(iv *model.Invoice) error {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "contract",
+		"method":     "ValidateInvoice",
+		"projectID":  iv.ProjectID,
+	})
+
+	project, err := r.store.Project.One(r.repo.DB(), iv.ProjectID.String(), false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrProjectNotFound
+		}
+		return err
+	}
+	if project.ClientID.IsZero() {
+		return nil
+	}
+
+	contracts, err := r.store.Contract.GetByProject(r.repo.DB(), project.ClientID.String(), project.ID.String())
+	if err != nil {
+		l.Error(err, "failed to get contracts of the project")
+		return err
+	}
+
+	at := time.Now()
+	switch {
+	case iv.Year > 0 && iv.Month > 0:
+		at = time.Date(iv.Year, time.Month(iv.Month), 1, 0, 0, 0, 0, time.UTC)
+	case iv.InvoicedAt != nil:
+		at = *iv.InvoicedAt
+	}
+	c := clientcontract.Select(contracts, project.ID, at)
+	if c == nil {
+		return nil
+	}
+
+	items, err := model.GetInfoItems(iv.LineItems)
+	if err != nil {
+		l.Error(err, "failed to parse invoice line items")
+		return err
+	}
+
+	excludeID := ""
+	if !iv.ID.IsZero() {
+		excludeID = iv.ID.String()
+	}
+	billed, err := r.store.Contract.GetBilledTotal(r.repo.DB(), c, excludeID)
+	if err != nil {
+		l.Error(err, "failed to get billed total of the contract")
+		return err
+	}
+
+	violations := clientcontract.Check(c, clientcontract.Invoice{
+		InvoicedAt: iv.InvoicedAt,
+		DueAt:      iv.DueAt,
+		Items:      items,
+		Total:      decimal.NewFromFloat(iv.Total),
+	}, billed)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	reasons := make([]string, 0, len(violations))
+	for _, v := range violations {
+		reasons = append(reasons, v.String())
+	}
+	return fmt.Errorf("%w: %s", ErrInvoiceViolatesContract, strings.Join(reasons, "; "))
+}
@@ -0,0 +1,46 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/discord"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	discord discord.IController
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+// New returns the contract controller, the expiry notices are posted through the discord log
+// templates
+func New(store *store.Store, repo store.DBRepo, service *service.Service, discord discord.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		discord: discord,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	List(input ListInput, pagination model.Pagination) ([]*model.Contract, int64, error)
+	Get(id string) (*model.Contract, error)
+	Create(input ContractInput, createdBy string) (*model.Contract, error)
+	Update(id string, input ContractInput) (*model.Contract, error)
+	SetRates(id string, rates []RateInput) (*model.Contract, error)
+	UploadAttachment(id string, input AttachmentInput) (*model.ContractAttachment, error)
+
+	ValidateInvoice(iv *model.Invoice) error
+	NotifyExpiring(today time.Time) ([]*model.Contract, error)
+}
@@ -2,11 +2,16 @@ package controller
 
 import (
 	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/asset"
 	"github.com/dwarvesf/fortress-api/pkg/controller/auth"
 	"github.com/dwarvesf/fortress-api/pkg/controller/brainerylogs"
+	"github.com/dwarvesf/fortress-api/pkg/controller/capacity"
+	"github.com/dwarvesf/fortress-api/pkg/controller/cashflow"
 	"github.com/dwarvesf/fortress-api/pkg/controller/client"
+	"github.com/dwarvesf/fortress-api/pkg/controller/clientportal"
 	"github.com/dwarvesf/fortress-api/pkg/controller/communitynft"
 	"github.com/dwarvesf/fortress-api/pkg/controller/companyinfo"
+	"github.com/dwarvesf/fortress-api/pkg/controller/contract"
 	"github.com/dwarvesf/fortress-api/pkg/controller/contractorpayables"
 	"github.com/dwarvesf/fortress-api/pkg/controller/conversionrate"
 	"github.com/dwarvesf/fortress-api/pkg/controller/deliverymetrics"
@@ -14,11 +19,27 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/controller/dynamicevents"
 	"github.com/dwarvesf/fortress-api/pkg/controller/earn"
 	"github.com/dwarvesf/fortress-api/pkg/controller/employee"
+	"github.com/dwarvesf/fortress-api/pkg/controller/engagement"
 	"github.com/dwarvesf/fortress-api/pkg/controller/event"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
 	"github.com/dwarvesf/fortress-api/pkg/controller/icy"
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
 	"github.com/dwarvesf/fortress-api/pkg/controller/invoice"
+	"github.com/dwarvesf/fortress-api/pkg/controller/knowledgebase"
+	"github.com/dwarvesf/fortress-api/pkg/controller/ledger"
 	"github.com/dwarvesf/fortress-api/pkg/controller/memologs"
 	"github.com/dwarvesf/fortress-api/pkg/controller/news"
+	"github.com/dwarvesf/fortress-api/pkg/controller/officecheckin"
+	"github.com/dwarvesf/fortress-api/pkg/controller/operationalservice"
+	"github.com/dwarvesf/fortress-api/pkg/controller/profitability"
+	"github.com/dwarvesf/fortress-api/pkg/controller/projectbudget"
+	"github.com/dwarvesf/fortress-api/pkg/controller/reconciliation"
+	"github.com/dwarvesf/fortress-api/pkg/controller/recruitment"
+	"github.com/dwarvesf/fortress-api/pkg/controller/referral"
+	"github.com/dwarvesf/fortress-api/pkg/controller/reviewcycle"
+	"github.com/dwarvesf/fortress-api/pkg/controller/staffing"
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveyanalytics"
+	"github.com/dwarvesf/fortress-api/pkg/controller/timesheet"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/service"
 	"github.com/dwarvesf/fortress-api/pkg/store"
@@ -26,8 +47,10 @@ import (
 )
 
 type Controller struct {
+	Asset              asset.IController
 	Auth               auth.IController
 	BraineryLog        brainerylogs.IController
+	Capacity           capacity.IController
 	Client             client.IController
 	CompanyInfo        companyinfo.IController
 	ContractorPayables contractorpayables.IController
@@ -41,28 +64,77 @@ type Controller struct {
 	CommunityNft       communitynft.IController
 	Earn               earn.IController
 	News               news.IController
+	OperationalService operationalservice.IController
 	Event              event.IController
 	DynamicEvents      dynamicevents.IController
+	FxRate             fxrate.IController
+	Ledger             ledger.IController
+	Reconciliation     reconciliation.IController
+	CashFlow           cashflow.IController
+	Profitability      profitability.IController
+	Recruitment        recruitment.IController
+	Referral           referral.IController
+	Staffing           staffing.IController
+	Timesheet          timesheet.IController
+	ClientPortal       clientportal.IController
+	Contract           contract.IController
+	ProjectBudget      projectbudget.IController
+	IcyReward          icyreward.IController
+	OfficeCheckin      officecheckin.IController
+	Engagement         engagement.IController
+	KnowledgeBase      knowledgebase.IController
+	SurveyAnalytics    surveyanalytics.IController
+	ReviewCycle        reviewcycle.IController
 }
 
 func New(store *store.Store, repo store.DBRepo, service *service.Service, worker *worker.Worker, logger logger.Logger, cfg *config.Config) *Controller {
+	invoiceController := invoice.New(store, repo, service, worker, logger, cfg)
+	fxRateController := fxrate.New(store, repo, service, logger, cfg)
+	employeeController := employee.New(store, repo, service, logger, cfg)
+	referralController := referral.New(store, repo, service, logger, cfg)
+	discordController := discord.New(store, repo, service, logger, cfg)
+	capacityController := capacity.New(store, repo, service, logger, cfg)
+	icyRewardController := icyreward.New(store, repo, service, fxRateController, logger, cfg)
+	knowledgeBaseController := knowledgebase.New(store, repo, service, logger, cfg)
+
 	return &Controller{
+		Asset:              asset.New(store, repo, service, fxRateController, logger, cfg),
 		Auth:               auth.New(store, repo, service, logger, cfg),
-		BraineryLog:        brainerylogs.New(store, repo, service, logger, cfg),
+		BraineryLog:        brainerylogs.New(store, repo, service, knowledgeBaseController, icyRewardController, logger, cfg),
+		Capacity:           capacityController,
 		Client:             client.New(store, repo, service, logger, cfg),
 		CompanyInfo:        companyinfo.New(store, repo, service, logger, cfg),
 		ContractorPayables: contractorpayables.New(service, logger, cfg),
 		ConversionRate:     conversionrate.New(store, repo, service, logger, cfg),
 		DeliveryMetric:     deliverymetrics.New(store, repo, service, logger, cfg),
-		Employee:           employee.New(store, repo, service, logger, cfg),
-		Invoice:            invoice.New(store, repo, service, worker, logger, cfg),
-		Discord:            discord.New(store, repo, service, logger, cfg),
-		Icy:                icy.New(service, logger, cfg),
-		MemoLog:            memologs.New(store, repo, service, logger, cfg),
+		Employee:           employeeController,
+		Invoice:            invoiceController,
+		Discord:            discordController,
+		Icy:                icy.New(store, repo, service, discordController, logger, cfg),
+		MemoLog:            memologs.New(store, repo, service, knowledgeBaseController, icyRewardController, logger, cfg),
 		CommunityNft:       communitynft.New(store, repo, service, logger, cfg),
 		Earn:               earn.New(store, repo, service, logger, cfg),
 		News:               news.New(store, service, logger, cfg),
-		Event:              event.New(store, repo, service, logger, cfg),
+		OperationalService: operationalservice.New(store, repo, service, fxRateController, discordController, logger, cfg),
+		Event:              event.New(store, repo, service, icyRewardController, logger, cfg),
 		DynamicEvents:      dynamicevents.New(store, service, logger, cfg),
+		FxRate:             fxRateController,
+		Ledger:             ledger.New(store, repo, service, fxRateController, logger, cfg),
+		Reconciliation:     reconciliation.New(store, repo, service, invoiceController, logger, cfg),
+		CashFlow:           cashflow.New(store, repo, service, fxRateController, logger, cfg),
+		Profitability:      profitability.New(store, repo, service, fxRateController, logger, cfg),
+		Recruitment:        recruitment.New(store, repo, service, employeeController, referralController, logger, cfg),
+		Referral:           referralController,
+		Staffing:           staffing.New(store, repo, service, capacityController, logger, cfg),
+		Timesheet:          timesheet.New(store, repo, service, logger, cfg),
+		ClientPortal:       clientportal.New(store, repo, service, logger, cfg),
+		Contract:           contract.New(store, repo, service, discordController, logger, cfg),
+		ProjectBudget:      projectbudget.New(store, repo, service, fxRateController, discordController, logger, cfg),
+		IcyReward:          icyRewardController,
+		OfficeCheckin:      officecheckin.New(store, repo, service, icyRewardController, logger, cfg),
+		Engagement:         engagement.New(store, repo, service, logger, cfg),
+		KnowledgeBase:      knowledgeBaseController,
+		SurveyAnalytics:    surveyanalytics.New(store, repo, service, logger, cfg),
+		ReviewCycle:        reviewcycle.New(store, repo, service, logger, cfg),
 	}
 }
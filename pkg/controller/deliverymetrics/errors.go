@@ -0,0 +1,21 @@
+package deliverymetrics
+
+import "errors"
+
+var (
+	ErrUnsupportedSource     = errors.New("unsupported delivery metrics file, expected csv or xlsx")
+	ErrInvalidFile           = errors.New("invalid delivery metrics file")
+	ErrImportNotFound        = errors.New("delivery metric import not found")
+	ErrInvalidMappingKind    = errors.New("invalid mapping kind, expected employee or project")
+	ErrInvalidMappingValue   = errors.New("mapping value must contain a letter or a digit")
+	ErrMappingTargetNotFound = errors.New("mapping target not found")
+	ErrMappingNotFound       = errors.New("delivery metric mapping not found")
+	ErrLeaderBoardNotFound   = errors.New("leaderboard not found")
+	ErrInvalidFormula        = errors.New("invalid leaderboard formula")
+	ErrInvalidScope          = errors.New("invalid leaderboard scope, expected company, chapter or project")
+	ErrScopeIDRequired       = errors.New("a chapter or project leaderboard needs the id of its chapter or project")
+	ErrScopeNotFound         = errors.New("leaderboard chapter or project not found")
+	ErrInvalidPeriod         = errors.New("invalid leaderboard period, expected weekly, monthly or quarterly")
+	ErrInvalidSize           = errors.New("leaderboard size must be between 0 and 50")
+	ErrInvalidMinEffort      = errors.New("leaderboard minimum effort can't be negative")
+)
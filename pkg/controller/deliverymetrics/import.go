@@ -0,0 +1,368 @@
+package deliverymetrics
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/deliveryimport"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employee"
+)
+
+type ImportInput struct {
+	Source   model.DeliveryMetricSource
+	FileName string
+	// Dataset names the metrics of an upload, the file name by default: the upload of a dataset
+	// under another file name replaces it instead of adding to it
+	Dataset    string
+	DryRun     bool
+	ImportedBy *model.UUID
+}
+
+// Import reads the delivery metrics of an uploaded CSV or XLSX file, with the same validation,
+// mapping and idempotency as the sync of the sheet. The file is the whole dataset: the metrics of
+// the rows of the dataset it no longer has are deleted.
+func (c controller) Import(input ImportInput, data io.Reader) (*model.DeliveryMetricImport, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "deliverymetrics",
+		"method":     "Import",
+		"fileName":   input.FileName,
+	})
+
+	if input.Source == "" {
+		input.Source = model.DeliveryMetricSourceFromFileName(input.FileName)
+	}
+	if input.Source != model.DeliveryMetricSourceCSV && input.Source != model.DeliveryMetricSourceXLSX {
+		return nil, ErrUnsupportedSource
+	}
+	input.Dataset = strings.TrimSpace(input.Dataset)
+	if input.Dataset == "" {
+		input.Dataset = input.FileName
+	}
+
+	records, err := deliveryimport.Read(input.Source, data)
+	if err != nil {
+		// every reader error comes from the content of the file
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	rows, rejections := deliveryimport.Parse(records)
+	result, err := c.importRows(input, rows, rejections)
+	if err != nil {
+		l.Errorf(err, "failed to import delivery metrics")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// sourceKey identifies the row a delivery metric is imported from: a re-import of a row updates
+// the metric imported from it instead of adding another one. The rows of the sheet are its lines,
+// the rows of an upload their id in the dataset, or their line when the file has no id column.
+func sourceKey(input ImportInput, id string, line int) string {
+	if id == "" {
+		id = strconv.Itoa(line)
+	}
+	return sourcePrefix(input) + id
+}
+
+// sourcePrefix starts the source keys of the rows of the sheet or of a dataset, whatever the format
+// of the file it is uploaded in
+func sourcePrefix(input ImportInput) string {
+	if input.Source == model.DeliveryMetricSourceSheet {
+		return fmt.Sprintf("%s:", input.Source)
+	}
+	return fmt.Sprintf("file:%s:", input.Dataset)
+}
+
+// legacyKey identifies a delivery metric synced before the imports were idempotent, by its
+// employee, project and week
+type legacyKey struct {
+	employeeID model.UUID
+	projectID  model.UUID
+	date       string
+}
+
+func newLegacyKey(m model.DeliveryMetric) legacyKey {
+	return legacyKey{employeeID: m.EmployeeID, projectID: m.ProjectID, date: m.Date.Format("2006-01-02")}
+}
+
+// importRows maps the valid rows to the employees and projects, then creates the new metrics,
+// updates the edited ones and deletes the ones of the rows gone from the sheet or the dataset. The
+// rows that can't be mapped are rejected, their metrics are kept.
+func (c controller) importRows(input ImportInput, rows []deliveryimport.Row, rejections []model.DeliveryMetricRejection) (*model.DeliveryMetricImport, error) {
+	employees, projects, err := c.matchers()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.DeliveryMetricImport{
+		Source:     input.Source,
+		FileName:   input.FileName,
+		Dataset:    input.Dataset,
+		DryRun:     input.DryRun,
+		TotalRows:  len(rows) + len(rejections),
+		ImportedBy: input.ImportedBy,
+	}
+
+	metrics := make([]model.DeliveryMetric, 0, len(rows))
+	keys := map[string]bool{}
+	dates := map[time.Time]bool{}
+	for _, row := range rows {
+		var reasons []string
+
+		employeeID, err := employees.Match(row.Email, row.Person)
+		if err != nil {
+			reasons = append(reasons, mappingReason("employee", firstNonEmpty(row.Email, row.Person), err))
+		}
+		projectID, err := projects.Match(row.Project)
+		if err != nil {
+			reasons = append(reasons, mappingReason("project", row.Project, err))
+		}
+		if len(reasons) > 0 {
+			rejections = append(rejections, row.Rejection(reasons...))
+			continue
+		}
+
+		m := model.DeliveryMetric{
+			Weight:        row.Weight,
+			Effort:        row.Effort,
+			Effectiveness: row.Effectiveness,
+			EmployeeID:    model.MustGetUUIDFromString(employeeID),
+			ProjectID:     model.MustGetUUIDFromString(projectID),
+			Date:          &row.Date,
+			Source:        input.Source,
+			SourceKey:     sourceKey(input, row.ID, row.Line),
+		}
+		if input.Source == model.DeliveryMetricSourceSheet {
+			m.Ref = row.Line
+		}
+
+		keys[m.SourceKey] = true
+		dates[row.Date] = true
+		metrics = append(metrics, m)
+	}
+	for _, r := range rejections {
+		keys[sourceKey(input, r.RowID, r.Line)] = true
+	}
+
+	existing, stale, legacy, err := c.existingMetrics(sourcePrefix(input), keys, dates)
+	if err != nil {
+		return nil, err
+	}
+
+	toCreate := make([]model.DeliveryMetric, 0, len(metrics))
+	toUpdate := make([]model.DeliveryMetric, 0)
+	for _, m := range metrics {
+		current, ok := existing[m.SourceKey]
+		if !ok {
+			// a metric synced before the imports were idempotent is taken over by one row only
+			lk := newLegacyKey(m)
+			if candidates := legacy[lk]; len(candidates) > 0 {
+				current, ok = candidates[0], true
+				legacy[lk] = candidates[1:]
+			}
+		}
+
+		switch {
+		case !ok:
+			toCreate = append(toCreate, m)
+		case current.SourceKey == m.SourceKey && sameMetric(current, m):
+			result.UnchangedRows++
+		default:
+			m.ID = current.ID
+			toUpdate = append(toUpdate, m)
+		}
+	}
+
+	result.CreatedRows = len(toCreate)
+	result.UpdatedRows = len(toUpdate)
+	result.RejectedRows = len(rejections)
+	result.DeletedRows = len(stale)
+	result.Rejections = rejections
+	if result.Rejections == nil {
+		result.Rejections = model.DeliveryMetricRejections{}
+	}
+
+	tx, done := c.repo.NewTransaction()
+	if _, err := c.store.DeliveryMetricImport.Create(tx.DB(), result); err != nil {
+		return nil, done(err)
+	}
+	if input.DryRun {
+		return result, done(nil)
+	}
+
+	for i := range toCreate {
+		toCreate[i].ImportID = &result.ID
+	}
+	if len(toCreate) > 0 {
+		if _, err := c.store.DeliveryMetric.Create(tx.DB(), toCreate); err != nil {
+			return nil, done(fmt.Errorf("failed to create delivery metrics: %w", err))
+		}
+	}
+
+	for _, m := range toUpdate {
+		m.ImportID = &result.ID
+		fields := []string{"weight", "effort", "effectiveness", "employee_id", "project_id", "date", "source", "source_key", "import_id"}
+		if m.Ref != 0 {
+			fields = append(fields, "ref")
+		}
+		if _, err := c.store.DeliveryMetric.UpdateSelectedFieldsByID(tx.DB(), m.ID.String(), m, fields...); err != nil {
+			return nil, done(fmt.Errorf("failed to update delivery metric %s: %w", m.ID, err))
+		}
+	}
+
+	if len(stale) > 0 {
+		ids := make([]string, 0, len(stale))
+		for _, m := range stale {
+			ids = append(ids, m.ID.String())
+		}
+		if err := c.store.DeliveryMetric.DeleteByIDs(tx.DB(), ids); err != nil {
+			return nil, done(fmt.Errorf("failed to delete delivery metrics: %w", err))
+		}
+	}
+
+	return result, done(nil)
+}
+
+// matchers returns the matchers of the employees and the projects with their manual mappings
+func (c controller) matchers() (*deliveryimport.Matcher, *deliveryimport.Matcher, error) {
+	employees, err := c.store.Employee.GetRawList(c.repo.DB(), employee.EmployeeFilter{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch employees: %w", err)
+	}
+
+	projects, err := c.store.Project.GetRawList(c.repo.DB())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch projects: %w", err)
+	}
+
+	mappings, err := c.store.DeliveryMetricMapping.All(c.repo.DB(), "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch delivery metric mappings: %w", err)
+	}
+	overrides := map[model.DeliveryMetricMappingKind]map[string]string{
+		model.DeliveryMetricMappingKindEmployee: {},
+		model.DeliveryMetricMappingKindProject:  {},
+	}
+	for _, m := range mappings {
+		if o, ok := overrides[m.Kind]; ok {
+			o[m.Value] = m.TargetID.String()
+		}
+	}
+
+	employeeCandidates := make([]deliveryimport.Candidate, 0, len(employees))
+	for _, e := range employees {
+		employeeCandidates = append(employeeCandidates, deliveryimport.Candidate{
+			ID:    e.ID.String(),
+			Label: firstNonEmpty(e.FullName, e.TeamEmail),
+			Exact: []string{e.TeamEmail, e.PersonalEmail, e.Username},
+			Fuzzy: []string{e.FullName, e.DisplayName},
+		})
+	}
+
+	projectCandidates := make([]deliveryimport.Candidate, 0, len(projects))
+	for _, p := range projects {
+		projectCandidates = append(projectCandidates, deliveryimport.Candidate{
+			ID:    p.ID.String(),
+			Label: p.Name,
+			Exact: []string{p.Code},
+			Fuzzy: []string{p.Name},
+		})
+	}
+
+	return deliveryimport.NewMatcher(employeeCandidates, overrides[model.DeliveryMetricMappingKindEmployee]),
+		deliveryimport.NewMatcher(projectCandidates, overrides[model.DeliveryMetricMappingKindProject]),
+		nil
+}
+
+// existingMetrics returns the stored metrics imported from the rows by their source key, the stale
+// metrics of the sheet or the dataset whose row is gone or imported twice, and the metrics of the
+// weeks synced before the imports were idempotent by their legacy key. An import without rows
+// leaves every metric, an empty upload is rather a mistake than a dataset emptied.
+func (c controller) existingMetrics(prefix string, keys map[string]bool, dates map[time.Time]bool) (map[string]model.DeliveryMetric, []model.DeliveryMetric, map[legacyKey][]model.DeliveryMetric, error) {
+	existing := map[string]model.DeliveryMetric{}
+	var stale []model.DeliveryMetric
+	legacy := map[legacyKey][]model.DeliveryMetric{}
+	if len(keys) == 0 {
+		return existing, stale, legacy, nil
+	}
+
+	metrics, err := c.store.DeliveryMetric.ListBySourcePrefix(c.repo.DB(), prefix)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to fetch delivery metrics: %w", err)
+	}
+	for _, m := range metrics {
+		if _, ok := existing[m.SourceKey]; ok || !keys[m.SourceKey] {
+			stale = append(stale, m)
+			continue
+		}
+		existing[m.SourceKey] = m
+	}
+
+	list := make([]time.Time, 0, len(dates))
+	for d := range dates {
+		list = append(list, d)
+	}
+	unkeyed, err := c.store.DeliveryMetric.ListUnkeyedByDates(c.repo.DB(), list)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to fetch delivery metrics: %w", err)
+	}
+	for _, m := range unkeyed {
+		if m.Date == nil {
+			continue
+		}
+		key := newLegacyKey(m)
+		legacy[key] = append(legacy[key], m)
+	}
+
+	return existing, stale, legacy, nil
+}
+
+// sameMetric tells whether a row imports the values a metric already has
+func sameMetric(current, m model.DeliveryMetric) bool {
+	return current.EmployeeID == m.EmployeeID &&
+		current.ProjectID == m.ProjectID &&
+		current.Date != nil && current.Date.Equal(*m.Date) &&
+		current.Weight.Equal(m.Weight) &&
+		current.Effort.Equal(m.Effort) &&
+		current.Effectiveness.Equal(m.Effectiveness)
+}
+
+func (c controller) ListImports(pagination model.Pagination) ([]*model.DeliveryMetricImport, int64, error) {
+	return c.store.DeliveryMetricImport.All(c.repo.DB(), pagination)
+}
+
+func (c controller) GetImport(id string) (*model.DeliveryMetricImport, error) {
+	rs, err := c.store.DeliveryMetricImport.One(c.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrImportNotFound
+		}
+		return nil, err
+	}
+	return rs, nil
+}
+
+func mappingReason(kind, value string, err error) string {
+	if errors.Is(err, deliveryimport.ErrNoMatch) {
+		return fmt.Sprintf("unknown %s %q, add a mapping", kind, value)
+	}
+	return fmt.Sprintf("%s %q: %v", kind, value, err)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+package deliverymetrics
+
+import (
+	"strings"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+const (
+	legacyMetricID = "5f6a7b8c-9d0e-4f1a-9b2c-4d5e6f7a8b9c"
+	editedMetricID = "1b2c3d4e-5f6a-4b7c-9d8e-0f1a2b3c4d5e"
+)
+
+// metricsFile is the "metrics" dataset with a row inserted on top, a1 left as is, a2 edited and the
+// row "gone" removed
+const metricsFile = `id,email,project,date,weight,effort,effectiveness
+a3,ducnv@dwarvesv.com,Fortress,2026-09-28,1,1,1
+a1,ducnv@dwarvesv.com,Fortress,2026-09-28,3,8,0.4
+a2,ducnv@dwarvesv.com,Fortress,2026-09-28,2,4,0.5
+`
+
+func TestController_Import(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger("info")
+	storeMock := store.New()
+
+	tests := []struct {
+		name  string
+		input ImportInput
+		file  string
+		want  model.DeliveryMetricImport
+		// wantKeys are the source keys of the metrics of the dataset once imported
+		wantKeys []string
+		check    func(t *testing.T, txRepo store.DBRepo)
+	}{
+		{
+			name:     "dataset_uploaded_under_another_name",
+			input:    ImportInput{FileName: "metrics-v2.csv", Dataset: "metrics"},
+			file:     metricsFile,
+			want:     model.DeliveryMetricImport{TotalRows: 3, CreatedRows: 1, UpdatedRows: 1, UnchangedRows: 1, DeletedRows: 2},
+			wantKeys: []string{"file:metrics:a1", "file:metrics:a2", "file:metrics:a3"},
+			check: func(t *testing.T, txRepo store.DBRepo) {
+				edited, err := storeMock.DeliveryMetric.One(txRepo.DB(), editedMetricID)
+				require.NoError(t, err)
+				require.Equal(t, "2", edited.Weight.String())
+			},
+		},
+		{
+			name:  "dry_run",
+			input: ImportInput{FileName: "metrics-v2.csv", Dataset: "metrics", DryRun: true},
+			file:  metricsFile,
+			want:  model.DeliveryMetricImport{TotalRows: 3, CreatedRows: 1, UpdatedRows: 1, UnchangedRows: 1, DeletedRows: 2},
+			wantKeys: []string{
+				"file:metrics:a1", "file:metrics:a2", "file:metrics:gone", "file:metrics:a1",
+			},
+		},
+		{
+			name:  "rejected_row_keeps_its_metric",
+			input: ImportInput{FileName: "metrics.csv", Dataset: "metrics"},
+			file: `id,email,project,date,weight,effort,effectiveness
+a1,ducnv@dwarvesv.com,Fortress,2026-09-28,3,8,0.4
+a2,ducnv@dwarvesv.com,Unknown,2026-09-28,2,4,0.5
+`,
+			want:     model.DeliveryMetricImport{TotalRows: 2, UnchangedRows: 1, RejectedRows: 1, DeletedRows: 2},
+			wantKeys: []string{"file:metrics:a1", "file:metrics:a2"},
+		},
+		{
+			name:  "take_over_a_metric_synced_before_the_imports_were_idempotent",
+			input: ImportInput{FileName: "legacy.csv"},
+			file: `email,project,date,weight,effort,effectiveness
+ducnv@dwarvesv.com,Fortress,2026-09-21,2,6,0.3
+`,
+			want:     model.DeliveryMetricImport{TotalRows: 1, UpdatedRows: 1},
+			wantKeys: []string{"file:legacy.csv:2"},
+			check: func(t *testing.T, txRepo store.DBRepo) {
+				legacy, err := storeMock.DeliveryMetric.One(txRepo.DB(), legacyMetricID)
+				require.NoError(t, err)
+				require.Equal(t, "file:legacy.csv:2", legacy.SourceKey)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/import/import.sql")
+
+				c := New(storeMock, txRepo, nil, loggerMock, &cfg)
+				result, err := c.Import(tt.input, strings.NewReader(tt.file))
+				require.NoError(t, err)
+				require.Equal(t, tt.want.TotalRows, result.TotalRows)
+				require.Equal(t, tt.want.CreatedRows, result.CreatedRows)
+				require.Equal(t, tt.want.UpdatedRows, result.UpdatedRows)
+				require.Equal(t, tt.want.UnchangedRows, result.UnchangedRows)
+				require.Equal(t, tt.want.RejectedRows, result.RejectedRows)
+				require.Equal(t, tt.want.DeletedRows, result.DeletedRows)
+
+				metrics, err := storeMock.DeliveryMetric.ListBySourcePrefix(txRepo.DB(), sourcePrefix(ImportInput{Dataset: result.Dataset}))
+				require.NoError(t, err)
+				keys := make([]string, 0, len(metrics))
+				for _, m := range metrics {
+					keys = append(keys, m.SourceKey)
+				}
+				require.ElementsMatch(t, tt.wantKeys, keys)
+
+				// the other datasets are left as they are
+				other, err := storeMock.DeliveryMetric.ListBySourcePrefix(txRepo.DB(), "file:other:")
+				require.NoError(t, err)
+				require.Len(t, other, 1)
+
+				if tt.check != nil {
+					tt.check(t, txRepo)
+				}
+			})
+		})
+	}
+}
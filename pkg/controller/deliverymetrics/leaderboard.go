@@ -1,91 +1,127 @@
 package deliverymetrics
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
 
+	"github.com/dwarvesf/fortress-api/pkg/leaderboard"
 	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/deliverymetric"
+)
+
+// The company boards of the weekly and monthly delivery reports
+var (
+	weeklyLeaderBoard = model.DeliveryLeaderBoard{
+		Name:    "Weekly Leaderboard",
+		Formula: leaderboard.VariableWeight,
+		Scope:   model.LeaderBoardScopeCompany,
+		Period:  model.LeaderBoardPeriodWeekly,
+		Size:    5,
+	}
+	monthlyLeaderBoard = model.DeliveryLeaderBoard{
+		Name:    "Monthly Leaderboard",
+		Formula: leaderboard.VariableWeight,
+		Scope:   model.LeaderBoardScopeCompany,
+		Period:  model.LeaderBoardPeriodMonthly,
+		Size:    10,
+	}
 )
 
 func (c controller) GetWeeklyLeaderBoard() (*model.LeaderBoard, error) {
-	w, err := c.store.DeliveryMetric.GetLatestWeek(c.repo.DB())
+	return c.evaluate(&weeklyLeaderBoard, nil)
+}
+
+func (c controller) GetMonthlyLeaderBoard(month *time.Time) (*model.LeaderBoard, error) {
+	return c.evaluate(&monthlyLeaderBoard, month)
+}
+
+// GetLeaderBoard evaluates a leaderboard definition on the period of the date, the latest period
+// with metrics when the date is nil
+func (c controller) GetLeaderBoard(id string, date *time.Time) (*model.LeaderBoard, error) {
+	board, err := c.oneLeaderBoard(id)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get latest week")
+		return nil, err
 	}
+	return c.evaluate(board, date)
+}
 
-	// Get top 10 users with highest points
-	metrics, err := c.store.DeliveryMetric.GetTopWeighMetrics(c.repo.DB(), w, 5)
+func (c controller) evaluate(board *model.DeliveryLeaderBoard, date *time.Time) (*model.LeaderBoard, error) {
+	formula, err := leaderboard.ParseFormula(board.Formula)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get top users with highest points")
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFormula, err)
 	}
 
-	items := make([]model.LeaderBoardItem, 0, len(metrics))
-	// Get user info
-	for _, m := range metrics {
-		e, err := c.store.Employee.One(c.repo.DB(), m.EmployeeID.String(), false)
+	if date == nil {
+		date, err = c.store.DeliveryMetric.GetLatestWeek(c.repo.DB())
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get employee "+m.EmployeeID.String())
-		}
-
-		// Get discord acc
-		d, err := c.store.DiscordAccount.One(c.repo.DB(), e.DiscordAccountID.String())
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get discord account "+e.DiscordAccountID.String()+" of employee "+e.ID.String())
-		}
-
-		item := model.LeaderBoardItem{
-			EmployeeID:      e.ID.String(),
-			EmployeeName:    e.DisplayName,
-			Points:          m.SumWeight,
-			DiscordID:       d.DiscordID,
-			DiscordUsername: d.DiscordUsername,
+			return nil, errors.Wrap(err, "failed to get latest week")
 		}
-		if m.SumEffort.IsZero() {
-			item.Effectiveness = decimal.NewFromFloat(0)
-		} else {
-			item.Effectiveness = m.SumWeight.DivRound(m.SumEffort, 2)
-		}
-
-		items = append(items, item)
 	}
+	from, to := board.Period.Range(*date)
 
-	return &model.LeaderBoard{
-		Date:  w,
-		Items: rankItems(items),
-	}, nil
-}
+	filter := deliverymetric.SumFilter{From: from, To: to}
+	switch board.Scope {
+	case model.LeaderBoardScopeChapter:
+		filter.ChapterID = board.ScopeID.String()
+	case model.LeaderBoardScopeProject:
+		filter.ProjectID = board.ScopeID.String()
+	}
+	sums, err := c.store.DeliveryMetric.SumByEmployee(c.repo.DB(), filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum delivery metrics")
+	}
 
-func (c controller) GetMonthlyLeaderBoard(month *time.Time) (*model.LeaderBoard, error) {
-	m := month
-	if m == nil {
-		var err error
-		m, err = c.store.DeliveryMetric.GetLatestMonth(c.repo.DB())
+	leads := map[model.UUID]bool{}
+	if board.ExcludeLeads {
+		ids, err := c.store.DeliveryMetric.GetLeadIDs(c.repo.DB())
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get latest month")
+			return nil, errors.Wrap(err, "failed to get leads")
+		}
+		for _, id := range ids {
+			leads[id] = true
 		}
 	}
 
-	// Get top 10 users with highest points
-	metrics, err := c.store.DeliveryMetric.GetTopMonthlyWeighMetrics(c.repo.DB(), m, 10)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get top users with highest points")
+	entries := make([]leaderboard.Entry, 0, len(sums))
+	for _, s := range sums {
+		entries = append(entries, leaderboard.Entry{
+			EmployeeID: s.EmployeeID.String(),
+			Vars: leaderboard.Vars{
+				Weight:        s.Weight,
+				Effort:        s.Effort,
+				Effectiveness: s.Effectiveness.Round(2),
+			},
+			IsLead: leads[s.EmployeeID],
+		})
 	}
 
-	items := make([]model.LeaderBoardItem, 0, len(metrics))
+	ranked := leaderboard.Rank(entries, leaderboard.Rules{
+		Formula:      formula,
+		ExcludeLeads: board.ExcludeLeads,
+		MinEffort:    board.MinEffort,
+		Size:         board.Size,
+	})
+
+	items := make([]model.LeaderBoardItem, 0, len(ranked))
 	// Get user info
-	for _, m := range metrics {
-		e, err := c.store.Employee.One(c.repo.DB(), m.EmployeeID.String(), false)
+	for _, r := range ranked {
+		e, err := c.store.Employee.One(c.repo.DB(), r.EmployeeID, false)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get employee "+m.EmployeeID.String())
+			return nil, errors.Wrap(err, "failed to get employee "+r.EmployeeID)
 		}
 
 		item := model.LeaderBoardItem{
 			EmployeeID:    e.ID.String(),
 			EmployeeName:  e.DisplayName,
-			Points:        m.Weight,
-			Effectiveness: m.Effectiveness,
+			Points:        r.Score.Round(2),
+			Weight:        r.Weight,
+			Effort:        r.Effort,
+			Effectiveness: r.Effectiveness,
+			Rank:          r.Rank,
 		}
 
 		// Get discord acc
@@ -104,20 +140,130 @@ func (c controller) GetMonthlyLeaderBoard(month *time.Time) (*model.LeaderBoard,
 	}
 
 	return &model.LeaderBoard{
-		Date:  m,
-		Items: rankItems(items),
+		Name:    board.Name,
+		Formula: formula.String(),
+		Period:  board.Period,
+		Date:    &from,
+		Items:   items,
 	}, nil
 }
 
-func rankItems(data []model.LeaderBoardItem) []model.LeaderBoardItem {
-	// Set the rank for each employee
-	for i := range data {
-		if i > 0 && data[i].Points.Equal(data[i-1].Points) && data[i].Effectiveness.Equal(data[i-1].Effectiveness) {
-			data[i].Rank = data[i-1].Rank
-		} else {
-			data[i].Rank = i + 1
+type LeaderBoardInput struct {
+	Name             string
+	Formula          string
+	Scope            model.LeaderBoardScope
+	ScopeID          string
+	Period           model.LeaderBoardPeriod
+	ExcludeLeads     bool
+	MinEffort        float64
+	Size             int
+	DiscordChannelID string
+	IsActive         bool
+}
+
+// maxLeaderBoardSize keeps a board readable in a Discord embed
+const maxLeaderBoardSize = 50
+
+func (c controller) ListLeaderBoards() ([]*model.DeliveryLeaderBoard, error) {
+	return c.store.DeliveryLeaderBoard.All(c.repo.DB(), false)
+}
+
+func (c controller) CreateLeaderBoard(input LeaderBoardInput) (*model.DeliveryLeaderBoard, error) {
+	board, err := c.toLeaderBoard(input)
+	if err != nil {
+		return nil, err
+	}
+	return c.store.DeliveryLeaderBoard.Create(c.repo.DB(), board)
+}
+
+func (c controller) UpdateLeaderBoard(id string, input LeaderBoardInput) (*model.DeliveryLeaderBoard, error) {
+	if _, err := c.oneLeaderBoard(id); err != nil {
+		return nil, err
+	}
+
+	board, err := c.toLeaderBoard(input)
+	if err != nil {
+		return nil, err
+	}
+	return c.store.DeliveryLeaderBoard.UpdateSelectedFieldsByID(c.repo.DB(), id, *board,
+		"name", "formula", "scope", "scope_id", "period", "exclude_leads", "min_effort", "size", "discord_channel_id", "is_active")
+}
+
+func (c controller) DeleteLeaderBoard(id string) error {
+	if _, err := c.oneLeaderBoard(id); err != nil {
+		return err
+	}
+	return c.store.DeliveryLeaderBoard.Delete(c.repo.DB(), id)
+}
+
+func (c controller) oneLeaderBoard(id string) (*model.DeliveryLeaderBoard, error) {
+	board, err := c.store.DeliveryLeaderBoard.One(c.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLeaderBoardNotFound
+		}
+		return nil, err
+	}
+	return board, nil
+}
+
+// toLeaderBoard validates a leaderboard definition, the scope of a chapter or project board must exist
+func (c controller) toLeaderBoard(input LeaderBoardInput) (*model.DeliveryLeaderBoard, error) {
+	formula, err := leaderboard.ParseFormula(input.Formula)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFormula, err)
+	}
+	if !input.Scope.IsValid() {
+		return nil, ErrInvalidScope
+	}
+	if !input.Period.IsValid() {
+		return nil, ErrInvalidPeriod
+	}
+	if input.Size < 0 || input.Size > maxLeaderBoardSize {
+		return nil, ErrInvalidSize
+	}
+	if input.MinEffort < 0 {
+		return nil, ErrInvalidMinEffort
+	}
+
+	board := &model.DeliveryLeaderBoard{
+		Name:             input.Name,
+		Formula:          formula.String(),
+		Scope:            input.Scope,
+		Period:           input.Period,
+		ExcludeLeads:     input.ExcludeLeads,
+		MinEffort:        decimal.NewFromFloat(input.MinEffort),
+		Size:             input.Size,
+		DiscordChannelID: input.DiscordChannelID,
+		IsActive:         input.IsActive,
+	}
+
+	if input.Scope == model.LeaderBoardScopeCompany {
+		return board, nil
+	}
+
+	if !model.IsUUIDFromString(input.ScopeID) {
+		return nil, ErrScopeIDRequired
+	}
+	switch input.Scope {
+	case model.LeaderBoardScopeChapter:
+		exists, err := c.store.Chapter.IsExist(c.repo.DB(), input.ScopeID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrScopeNotFound
+		}
+	case model.LeaderBoardScopeProject:
+		if _, err := c.store.Project.One(c.repo.DB(), input.ScopeID, false); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrScopeNotFound
+			}
+			return nil, err
 		}
 	}
+	scopeID := model.MustGetUUIDFromString(input.ScopeID)
+	board.ScopeID = &scopeID
 
-	return data
+	return board, nil
 }
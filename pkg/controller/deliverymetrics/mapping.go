@@ -0,0 +1,80 @@
+package deliverymetrics
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/deliveryimport"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type SaveMappingInput struct {
+	Kind      model.DeliveryMetricMappingKind
+	Value     string
+	TargetID  string
+	CreatedBy *model.UUID
+}
+
+func (c controller) ListMappings(kind model.DeliveryMetricMappingKind) ([]*model.DeliveryMetricMapping, error) {
+	if kind != "" && !kind.IsValid() {
+		return nil, ErrInvalidMappingKind
+	}
+	return c.store.DeliveryMetricMapping.All(c.repo.DB(), kind)
+}
+
+// SaveMapping maps a value of the rows to an employee or a project, the mapping of the same value
+// is replaced. The rows rejected for the value are imported by the next sync or upload.
+func (c controller) SaveMapping(input SaveMappingInput) (*model.DeliveryMetricMapping, error) {
+	if !input.Kind.IsValid() {
+		return nil, ErrInvalidMappingKind
+	}
+
+	value := deliveryimport.Normalize(input.Value)
+	if value == "" {
+		return nil, ErrInvalidMappingValue
+	}
+
+	var err error
+	switch input.Kind {
+	case model.DeliveryMetricMappingKindEmployee:
+		_, err = c.store.Employee.One(c.repo.DB(), input.TargetID, false)
+	case model.DeliveryMetricMappingKindProject:
+		_, err = c.store.Project.One(c.repo.DB(), input.TargetID, false)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMappingTargetNotFound
+		}
+		return nil, err
+	}
+	targetID := model.MustGetUUIDFromString(input.TargetID)
+
+	existing, err := c.store.DeliveryMetricMapping.OneByValue(c.repo.DB(), input.Kind, value)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		return c.store.DeliveryMetricMapping.UpdateSelectedFieldsByID(c.repo.DB(), existing.ID.String(), model.DeliveryMetricMapping{
+			TargetID:  targetID,
+			CreatedBy: input.CreatedBy,
+		}, "target_id", "created_by")
+	}
+
+	return c.store.DeliveryMetricMapping.Create(c.repo.DB(), &model.DeliveryMetricMapping{
+		Kind:      input.Kind,
+		Value:     value,
+		TargetID:  targetID,
+		CreatedBy: input.CreatedBy,
+	})
+}
+
+func (c controller) DeleteMapping(id string) error {
+	if _, err := c.store.DeliveryMetricMapping.One(c.repo.DB(), id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMappingNotFound
+		}
+		return err
+	}
+	return c.store.DeliveryMetricMapping.Delete(c.repo.DB(), id)
+}
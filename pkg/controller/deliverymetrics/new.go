@@ -1,6 +1,7 @@
 package deliverymetrics
 
 import (
+	"io"
 	"time"
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
@@ -15,8 +16,21 @@ type IController interface {
 	GetMonthlyReport() (*model.MonthlyReport, error)
 	GetWeeklyLeaderBoard() (*model.LeaderBoard, error)
 	GetMonthlyLeaderBoard(month *time.Time) (*model.LeaderBoard, error)
+	GetLeaderBoard(id string, date *time.Time) (*model.LeaderBoard, error)
 
-	Sync() error
+	ListLeaderBoards() ([]*model.DeliveryLeaderBoard, error)
+	CreateLeaderBoard(input LeaderBoardInput) (*model.DeliveryLeaderBoard, error)
+	UpdateLeaderBoard(id string, input LeaderBoardInput) (*model.DeliveryLeaderBoard, error)
+	DeleteLeaderBoard(id string) error
+
+	Sync() (*model.DeliveryMetricImport, error)
+	Import(input ImportInput, data io.Reader) (*model.DeliveryMetricImport, error)
+	ListImports(pagination model.Pagination) ([]*model.DeliveryMetricImport, int64, error)
+	GetImport(id string) (*model.DeliveryMetricImport, error)
+
+	ListMappings(kind model.DeliveryMetricMappingKind) ([]*model.DeliveryMetricMapping, error)
+	SaveMapping(input SaveMappingInput) (*model.DeliveryMetricMapping, error)
+	DeleteMapping(id string) error
 }
 
 type controller struct {
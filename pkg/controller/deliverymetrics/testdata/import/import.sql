@@ -0,0 +1,10 @@
+INSERT INTO public.projects (id, deleted_at, created_at, updated_at, name, type, start_date, end_date, status, country_id, client_email, project_email) VALUES
+('8dc3be2e-19a4-4942-8a79-56db391a0b15', NULL, '2022-11-11 18:06:56.362902', '2022-11-11 18:06:56.362902', 'Fortress', 'dwarves', '2022-11-01', NULL, 'active', NULL, 'team@d.foundation', 'fortress@d.foundation');
+
+INSERT INTO public.delivery_metrics (id, deleted_at, created_at, updated_at, weight, effort, effectiveness, date, employee_id, project_id, ref, source, source_key) VALUES
+('0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d', NULL, '2026-10-01 00:00:00', '2026-10-01 00:00:00', 3, 8, 0.4, '2026-09-28', 'f7c6016b-85b5-47f7-8027-23c2db482197', '8dc3be2e-19a4-4942-8a79-56db391a0b15', 0, 'csv', 'file:metrics:a1'),
+('1b2c3d4e-5f6a-4b7c-9d8e-0f1a2b3c4d5e', NULL, '2026-10-01 00:00:00', '2026-10-01 00:00:00', 5, 8, 0.4, '2026-09-28', 'f7c6016b-85b5-47f7-8027-23c2db482197', '8dc3be2e-19a4-4942-8a79-56db391a0b15', 0, 'csv', 'file:metrics:a2'),
+('2c3d4e5f-6a7b-4c8d-8e9f-1a2b3c4d5e6f', NULL, '2026-10-01 00:00:00', '2026-10-01 00:00:00', 1, 2, 0.5, '2026-09-28', 'f7c6016b-85b5-47f7-8027-23c2db482197', '8dc3be2e-19a4-4942-8a79-56db391a0b15', 0, 'csv', 'file:metrics:gone'),
+('3d4e5f6a-7b8c-4d9e-9f0a-2b3c4d5e6f7a', NULL, '2026-10-02 00:00:00', '2026-10-02 00:00:00', 3, 8, 0.4, '2026-09-28', 'f7c6016b-85b5-47f7-8027-23c2db482197', '8dc3be2e-19a4-4942-8a79-56db391a0b15', 0, 'csv', 'file:metrics:a1'),
+('4e5f6a7b-8c9d-4e0f-8a1b-3c4d5e6f7a8b', NULL, '2026-10-01 00:00:00', '2026-10-01 00:00:00', 4, 8, 0.5, '2026-09-28', 'f7c6016b-85b5-47f7-8027-23c2db482197', '8dc3be2e-19a4-4942-8a79-56db391a0b15', 0, 'xlsx', 'file:other:2'),
+('5f6a7b8c-9d0e-4f1a-9b2c-4d5e6f7a8b9c', NULL, '2023-09-25 00:00:00', '2023-09-25 00:00:00', 2, 6, 0.3, '2026-09-21', 'f7c6016b-85b5-47f7-8027-23c2db482197', '8dc3be2e-19a4-4942-8a79-56db391a0b15', 12, 'sheet', NULL);
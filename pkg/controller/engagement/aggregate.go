@@ -0,0 +1,192 @@
+package engagement
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/engagementanalytics"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// recentWeeks is the period of the recent activity of the members
+const recentWeeks = 4
+
+// Aggregate records the activity the engagement rollups gained since the last run as the activity
+// of the day, then computes again the weekly stats of this week and the last one and the latest
+// activity of the members. The first run records the rollups as a baseline.
+func (r *controller) Aggregate(today time.Time) (*model.EngagementAggregation, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "engagement",
+		"method":     "Aggregate",
+	})
+
+	date := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+
+	rollups, err := r.store.EngagementsRollup.All(r.repo.DB())
+	if err != nil {
+		l.Error(err, "failed to get engagements rollup")
+		return nil, err
+	}
+	count, err := r.store.EngagementActivity.Count(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+	baseline := count == 0
+
+	seen := make(map[engagementanalytics.Key]model.EngagementActivity)
+	if !baseline {
+		totals, err := r.store.EngagementActivity.GetTotals(r.repo.DB())
+		if err != nil {
+			l.Error(err, "failed to get engagement activity totals")
+			return nil, err
+		}
+		for _, t := range totals {
+			seen[engagementanalytics.Key{DiscordUserID: t.DiscordUserID, ChannelID: t.ChannelID}] = t
+		}
+	}
+	activities := engagementanalytics.Deltas(rollups, seen, date, baseline)
+
+	members, err := r.members()
+	if err != nil {
+		l.Error(err, "failed to get members")
+		return nil, err
+	}
+	names := r.channelNames(l)
+
+	tx, done := r.repo.NewTransaction()
+	if err := r.store.EngagementActivity.Upsert(tx.DB(), activities); err != nil {
+		l.Error(err, "failed to upsert engagement activities")
+		return nil, done(err)
+	}
+
+	thisWeek := engagementanalytics.WeekStart(date)
+	weeks := []time.Time{thisWeek.AddDate(0, 0, -7), thisWeek}
+	for _, week := range weeks {
+		weekActivities, err := r.store.EngagementActivity.GetByPeriod(tx.DB(), week, week.AddDate(0, 0, 7))
+		if err != nil {
+			l.Error(err, "failed to get engagement activities of the week")
+			return nil, done(err)
+		}
+
+		if err := r.store.EngagementWeeklyStat.DeleteByWeek(tx.DB(), week); err != nil {
+			return nil, done(err)
+		}
+		if _, err := r.store.EngagementWeeklyStat.BatchCreate(tx.DB(), engagementanalytics.WeeklyStats(week, members, weekActivities)); err != nil {
+			l.Error(err, "failed to create engagement weekly stats")
+			return nil, done(err)
+		}
+
+		if err := r.store.EngagementChannelStat.DeleteByWeek(tx.DB(), week); err != nil {
+			return nil, done(err)
+		}
+		if _, err := r.store.EngagementChannelStat.BatchCreate(tx.DB(), engagementanalytics.ChannelStats(week, weekActivities, names)); err != nil {
+			l.Error(err, "failed to create engagement channel stats")
+			return nil, done(err)
+		}
+	}
+
+	recent, err := r.store.EngagementActivity.GetByPeriod(tx.DB(), date.AddDate(0, 0, -7*recentWeeks), date.AddDate(0, 0, 1))
+	if err != nil {
+		l.Error(err, "failed to get recent engagement activities")
+		return nil, done(err)
+	}
+	if err := r.store.EngagementMemberStat.Upsert(tx.DB(), memberStats(members, rollups, recent, date)); err != nil {
+		l.Error(err, "failed to upsert engagement member stats")
+		return nil, done(err)
+	}
+	if err := r.store.EngagementMemberStat.DeleteNotComputedOn(tx.DB(), date); err != nil {
+		return nil, done(err)
+	}
+
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return &model.EngagementAggregation{
+		Date:       date,
+		IsBaseline: baseline,
+		Activities: len(activities),
+		WeekStarts: weeks,
+		Members:    len(members),
+	}, nil
+}
+
+// members returns the employees still working with a Discord account
+func (r *controller) members() ([]engagementanalytics.Member, error) {
+	employees, err := r.store.Employee.SimpleList(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]engagementanalytics.Member, 0, len(employees))
+	for _, e := range employees {
+		if e.DiscordAccount == nil || e.DiscordAccount.DiscordID == "" {
+			continue
+		}
+		discordID, err := strconv.ParseInt(e.DiscordAccount.DiscordID, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		m := engagementanalytics.Member{EmployeeID: e.ID, DiscordUserID: discordID}
+		for _, ec := range e.EmployeeChapters {
+			m.ChapterIDs = append(m.ChapterIDs, ec.ChapterID)
+		}
+		rs = append(rs, m)
+	}
+	return rs, nil
+}
+
+// channelNames returns the names of the channels of the guild, the stats keep the ids only when
+// Discord is not reachable
+func (r *controller) channelNames(l logger.Logger) map[int64]string {
+	rs := make(map[int64]string)
+	channels, err := r.service.Discord.GetChannels()
+	if err != nil {
+		l.Error(err, "failed to get discord channels")
+		return rs
+	}
+	for _, c := range channels {
+		id, err := strconv.ParseInt(c.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		rs[id] = c.Name
+	}
+	return rs
+}
+
+func memberStats(members []engagementanalytics.Member, rollups []*model.EngagementsRollup, recent []model.EngagementActivity, date time.Time) []*model.EngagementMemberStat {
+	lastActive := engagementanalytics.LastActive(rollups)
+	type counts struct{ messages, reactions int }
+	byUser := make(map[int64]*counts)
+	for _, a := range recent {
+		c, ok := byUser[a.DiscordUserID]
+		if !ok {
+			c = &counts{}
+			byUser[a.DiscordUserID] = c
+		}
+		c.messages += a.MessageCount
+		c.reactions += a.ReactionCount
+	}
+
+	rs := make([]*model.EngagementMemberStat, 0, len(members))
+	for _, m := range members {
+		s := &model.EngagementMemberStat{
+			BaseModel:     model.BaseModel{ID: model.NewUUID()},
+			EmployeeID:    m.EmployeeID,
+			DiscordUserID: m.DiscordUserID,
+			ComputedOn:    date,
+		}
+		if t, ok := lastActive[m.DiscordUserID]; ok {
+			s.LastActiveAt = &t
+		}
+		if c, ok := byUser[m.DiscordUserID]; ok {
+			s.RecentMessages = c.messages
+			s.RecentReactions = c.reactions
+		}
+		rs = append(rs, s)
+	}
+	return rs
+}
@@ -0,0 +1,140 @@
+package engagement
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/engagementanalytics"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	memberstatstore "github.com/dwarvesf/fortress-api/pkg/store/engagementmemberstat"
+	weeklystatstore "github.com/dwarvesf/fortress-api/pkg/store/engagementweeklystat"
+)
+
+const (
+	defaultTrendWeeks   = 12
+	defaultQuietWeeks   = 2
+	defaultChannelWeeks = 4
+	maxWeeks            = 52
+)
+
+type TrendsInput struct {
+	Scope     model.EngagementScope // organization when empty
+	ChapterID string                // every chapter when empty
+	Weeks     int                   // 12 when empty
+}
+
+type QuietMembersInput struct {
+	Weeks         int // 2 when empty
+	LineManagerID string
+	ChapterID     string
+}
+
+// Trends returns the participation of the scope by week over the last weeks, this week so far
+// included. The trends of the chapters come one chapter after another.
+func (r *controller) Trends(input TrendsInput, today time.Time) ([]model.EngagementTrend, error) {
+	if input.Scope == "" {
+		input.Scope = model.EngagementScopeOrganization
+		if input.ChapterID != "" {
+			input.Scope = model.EngagementScopeChapter
+		}
+	}
+	if !input.Scope.IsValid() {
+		return nil, ErrInvalidScope
+	}
+	weeks, err := normalizeWeeks(input.Weeks, defaultTrendWeeks)
+	if err != nil {
+		return nil, err
+	}
+	if input.ChapterID != "" {
+		if err := r.checkChapter(input.ChapterID); err != nil {
+			return nil, err
+		}
+	}
+
+	stats, err := r.store.EngagementWeeklyStat.All(r.repo.DB(), weeklystatstore.Query{
+		Scope:     input.Scope,
+		ChapterID: input.ChapterID,
+		From:      engagementanalytics.WeekStart(today).AddDate(0, 0, -7*(weeks-1)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Scope == model.EngagementScopeOrganization {
+		return engagementanalytics.Trends(stats), nil
+	}
+
+	byChapter := make(map[model.UUID][]*model.EngagementWeeklyStat)
+	order := make([]model.UUID, 0)
+	for _, s := range stats {
+		if s.ChapterID == nil {
+			continue
+		}
+		if _, ok := byChapter[*s.ChapterID]; !ok {
+			order = append(order, *s.ChapterID)
+		}
+		byChapter[*s.ChapterID] = append(byChapter[*s.ChapterID], s)
+	}
+
+	rs := make([]model.EngagementTrend, 0, len(stats))
+	for _, id := range order {
+		rs = append(rs, engagementanalytics.Trends(byChapter[id])...)
+	}
+	return rs, nil
+}
+
+// QuietMembers returns the members with no message for the weeks, as of the last aggregation
+func (r *controller) QuietMembers(input QuietMembersInput, now time.Time) ([]*model.EngagementMemberStat, error) {
+	weeks, err := normalizeWeeks(input.Weeks, defaultQuietWeeks)
+	if err != nil {
+		return nil, err
+	}
+	if input.ChapterID != "" {
+		if err := r.checkChapter(input.ChapterID); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.store.EngagementMemberStat.GetQuiet(r.repo.DB(), memberstatstore.Query{
+		Since:         now.AddDate(0, 0, -7*weeks),
+		LineManagerID: input.LineManagerID,
+		ChapterID:     input.ChapterID,
+	})
+}
+
+// ChannelHealth compares the activity of the channels over the last weeks, this week so far
+// included, with as many weeks before
+func (r *controller) ChannelHealth(weeks int, today time.Time) ([]model.EngagementChannelHealth, error) {
+	weeks, err := normalizeWeeks(weeks, defaultChannelWeeks)
+	if err != nil {
+		return nil, err
+	}
+
+	recentFrom := engagementanalytics.WeekStart(today).AddDate(0, 0, -7*(weeks-1))
+	stats, err := r.store.EngagementChannelStat.AllSince(r.repo.DB(), recentFrom.AddDate(0, 0, -7*weeks))
+	if err != nil {
+		return nil, err
+	}
+
+	return engagementanalytics.ChannelHealth(stats, recentFrom), nil
+}
+
+func (r *controller) checkChapter(id string) error {
+	exists, err := r.store.Chapter.IsExist(r.repo.DB(), id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrChapterNotFound
+	}
+	return nil
+}
+
+func normalizeWeeks(weeks, defaultWeeks int) (int, error) {
+	if weeks == 0 {
+		return defaultWeeks, nil
+	}
+	if weeks < 1 || weeks > maxWeeks {
+		return 0, ErrInvalidWeeks
+	}
+	return weeks, nil
+}
@@ -0,0 +1,9 @@
+package engagement
+
+import "errors"
+
+var (
+	ErrInvalidScope    = errors.New("invalid scope, expected organization or chapter")
+	ErrInvalidWeeks    = errors.New("weeks must be from 1 to 52")
+	ErrChapterNotFound = errors.New("chapter not found")
+)
@@ -0,0 +1,36 @@
+package engagement
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Aggregate(today time.Time) (*model.EngagementAggregation, error)
+	Trends(input TrendsInput, today time.Time) ([]model.EngagementTrend, error)
+	QuietMembers(input QuietMembersInput, now time.Time) ([]*model.EngagementMemberStat, error)
+	ChannelHealth(weeks int, today time.Time) ([]model.EngagementChannelHealth, error)
+}
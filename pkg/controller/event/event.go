@@ -2,10 +2,12 @@ package event
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service"
@@ -20,20 +22,22 @@ type IController interface {
 }
 
 type controller struct {
-	service *service.Service
-	logger  logger.Logger
-	config  *config.Config
-	store   *store.Store
-	repo    store.DBRepo
+	service   *service.Service
+	icyReward icyreward.IController
+	logger    logger.Logger
+	config    *config.Config
+	store     *store.Store
+	repo      store.DBRepo
 }
 
-func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+func New(store *store.Store, repo store.DBRepo, service *service.Service, icyReward icyreward.IController, logger logger.Logger, cfg *config.Config) IController {
 	return &controller{
-		store:   store,
-		service: service,
-		logger:  logger,
-		config:  cfg,
-		repo:    repo,
+		store:     store,
+		service:   service,
+		icyReward: icyReward,
+		logger:    logger,
+		config:    cfg,
+		repo:      repo,
 	}
 }
 func (c *controller) SweepOgifEvent(ctx context.Context) error {
@@ -135,6 +139,16 @@ func (c *controller) SweepOgifEvent(ctx context.Context) error {
 				continue
 			}
 
+			_, _, err = c.icyReward.Trigger(icyreward.TriggerInput{
+				Trigger:    model.IcyRewardTriggerOGIFTalk,
+				DiscordID:  discordID,
+				Reference:  event.ID.String(),
+				OccurredAt: event.Date,
+			})
+			if err != nil && !errors.Is(err, icyreward.ErrNoActiveRule) && !errors.Is(err, icyreward.ErrEmployeeNotFound) {
+				c.logger.Error(err, "failed to reward ogif talk")
+			}
+
 			txMap[tx.Id] = true
 		}
 	}
@@ -0,0 +1,15 @@
+package fxrate
+
+import "errors"
+
+var (
+	ErrRateNotFound            = errors.New("fx rate not found")
+	ErrInvalidDateRange        = errors.New("invalid date range")
+	ErrDateRangeTooLarge       = errors.New("date range exceeds the backfill limit")
+	ErrBackfillRunning         = errors.New("a backfill is already running")
+	ErrInvalidRate             = errors.New("rate must be greater than zero")
+	ErrUnsupportedTransaction  = errors.New("unsupported transaction type")
+	ErrTransactionNotFound     = errors.New("transaction not found")
+	ErrTransactionMissingRate  = errors.New("transaction has no conversion rate")
+	ErrTransactionMissingDates = errors.New("transaction has no date to look up the rate")
+)
@@ -0,0 +1,368 @@
+package fxrate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/fxrate"
+)
+
+// destCurrencies are the currencies every tracked currency is snapshotted against
+var destCurrencies = []string{"USD", "VND"}
+
+// maxBackfillDays limits a single backfill run so it doesn't burn the provider quotas
+const maxBackfillDays = 366
+
+type ListInput struct {
+	SourceCurrency string
+	TargetCurrency string
+	Source         model.FxRateSource
+	From           *time.Time
+	To             *time.Time
+}
+
+type BackfillInput struct {
+	From    time.Time
+	To      time.Time
+	Pairs   []Pair
+	Sources []model.FxRateSource
+}
+
+type ManualRateInput struct {
+	Date           time.Time
+	SourceCurrency string
+	TargetCurrency string
+	Rate           float64
+	Note           string
+	CreatedBy      *model.UUID
+}
+
+// Pair is a source/target currency pair, e.g. USD/VND
+type Pair struct {
+	Source string
+	Target string
+}
+
+func (p Pair) String() string {
+	return p.Source + "/" + p.Target
+}
+
+// ParsePair parses a pair written as SRC/DST
+func ParsePair(s string) (Pair, error) {
+	parts := strings.Split(strings.ToUpper(strings.TrimSpace(s)), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return Pair{}, fmt.Errorf("invalid currency pair %q, expected SRC/DST", s)
+	}
+	return Pair{Source: parts[0], Target: parts[1]}, nil
+}
+
+// GetRateAt returns the rate of src to dst in effect on the given date.
+// It looks in the history only (direct pair, then inverse pair), within the
+// configured lookback window. The history is filled by Sync and backfills.
+func (r *controller) GetRateAt(src, dst string, date time.Time) (*model.FxRate, error) {
+	src, dst = strings.ToUpper(src), strings.ToUpper(dst)
+	day := truncateDay(date)
+
+	if src == dst {
+		return &model.FxRate{Date: day, SourceCurrency: src, TargetCurrency: dst, Rate: 1}, nil
+	}
+
+	priority := r.sourcePriority()
+
+	rates, err := r.store.FxRate.ListOnOrBefore(r.repo.DB(), src, dst, day, r.config.FXRate.LookbackDays)
+	if err != nil {
+		return nil, err
+	}
+	if rate := model.SelectFxRate(rates, priority); rate != nil {
+		return rate, nil
+	}
+
+	inverseRates, err := r.store.FxRate.ListOnOrBefore(r.repo.DB(), dst, src, day, r.config.FXRate.LookbackDays)
+	if err != nil {
+		return nil, err
+	}
+	if rate := model.SelectFxRate(inverseRates, priority); rate != nil {
+		return invert(rate), nil
+	}
+
+	return nil, ErrRateNotFound
+}
+
+func (r *controller) List(input ListInput) ([]model.FxRate, error) {
+	return r.store.FxRate.ListInRange(r.repo.DB(), fxrate.Query{
+		SourceCurrency: strings.ToUpper(input.SourceCurrency),
+		TargetCurrency: strings.ToUpper(input.TargetCurrency),
+		Source:         input.Source,
+		From:           input.From,
+		To:             input.To,
+	})
+}
+
+// Sync snapshots the rates of every tracked pair from every provider for the given date
+func (r *controller) Sync(date time.Time) (int, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "fxrate",
+		"method":     "Sync",
+		"date":       date,
+	})
+
+	pairs, err := r.trackedPairs()
+	if err != nil {
+		return 0, err
+	}
+
+	day := truncateDay(date)
+	stored := 0
+	for _, pair := range pairs {
+		for _, source := range r.sourcePriority() {
+			rate, err := r.fetchRate(source, pair.Source, pair.Target, day)
+			if err != nil {
+				l.Fields(logger.Fields{"source": source, "pair": pair.String()}).Error(err, "failed to fetch rate from provider")
+				continue
+			}
+			if rate == nil {
+				continue
+			}
+
+			if err := r.store.FxRate.Upsert(r.repo.DB(), rate); err != nil {
+				return stored, err
+			}
+			stored++
+		}
+	}
+
+	return stored, nil
+}
+
+// StartBackfill fills the missing days of the history for the given pairs and sources in the
+// background, asking the providers once per day. One backfill runs at a time, its result is
+// logged once done.
+func (r *controller) StartBackfill(input BackfillInput) error {
+	job, err := r.backfillJob(input)
+	if err != nil {
+		return err
+	}
+	if !r.backfilling.CompareAndSwap(false, true) {
+		return ErrBackfillRunning
+	}
+
+	go func() {
+		defer r.backfilling.Store(false)
+
+		l := r.logger.Fields(logger.Fields{
+			"controller": "fxrate",
+			"method":     "StartBackfill",
+			"from":       job.From,
+			"to":         job.To,
+		})
+		res, err := r.backfill(job)
+		if err != nil {
+			l.Error(err, "failed to backfill fx rates")
+			return
+		}
+		l.Infof("backfilled fx rates: %d inserted, %d skipped, %d failed %v", res.Inserted, res.Skipped, len(res.Failed), res.Failed)
+	}()
+
+	return nil
+}
+
+// backfillJob checks the date range of a backfill and fills in the tracked pairs and the sources
+// when not given
+func (r *controller) backfillJob(input BackfillInput) (BackfillInput, error) {
+	from, to := truncateDay(input.From), truncateDay(input.To)
+	if to.Before(from) {
+		return input, ErrInvalidDateRange
+	}
+	if today := truncateDay(time.Now()); to.After(today) {
+		to = today
+	}
+	if to.Sub(from) > maxBackfillDays*24*time.Hour {
+		return input, ErrDateRangeTooLarge
+	}
+
+	pairs := input.Pairs
+	if len(pairs) == 0 {
+		var err error
+		pairs, err = r.trackedPairs()
+		if err != nil {
+			return input, err
+		}
+	}
+
+	sources := input.Sources
+	if len(sources) == 0 {
+		sources = r.sourcePriority()
+	}
+
+	return BackfillInput{From: from, To: to, Pairs: pairs, Sources: sources}, nil
+}
+
+// backfill fetches the missing days of a checked backfill
+func (r *controller) backfill(input BackfillInput) (*model.FxBackfillResult, error) {
+	from, to, pairs, sources := input.From, input.To, input.Pairs, input.Sources
+
+	l := r.logger.Fields(logger.Fields{
+		"controller": "fxrate",
+		"method":     "backfill",
+		"from":       from,
+		"to":         to,
+	})
+
+	res := &model.FxBackfillResult{Failed: []string{}}
+	for _, pair := range pairs {
+		existing, err := r.store.FxRate.ListInRange(r.repo.DB(), fxrate.Query{
+			SourceCurrency: pair.Source,
+			TargetCurrency: pair.Target,
+			From:           &from,
+			To:             &to,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		recorded := make(map[string]bool, len(existing))
+		for _, e := range existing {
+			recorded[rateKey(e.Date, e.Source)] = true
+		}
+
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			for _, source := range sources {
+				if recorded[rateKey(day, source)] {
+					res.Skipped++
+					continue
+				}
+
+				rate, err := r.fetchRate(source, pair.Source, pair.Target, day)
+				if err != nil {
+					l.Fields(logger.Fields{"source": source, "pair": pair.String(), "day": day}).Error(err, "failed to fetch rate from provider")
+					res.Failed = append(res.Failed, fmt.Sprintf("%s %s %s", pair.String(), source, day.Format("2006-01-02")))
+					continue
+				}
+				if rate == nil {
+					continue
+				}
+
+				if err := r.store.FxRate.Upsert(r.repo.DB(), rate); err != nil {
+					return nil, err
+				}
+				res.Inserted++
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// SetManualRate records a rate entered by hand for a pair and a date
+func (r *controller) SetManualRate(input ManualRateInput) (*model.FxRate, error) {
+	if input.Rate <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	rate := &model.FxRate{
+		Date:           truncateDay(input.Date),
+		SourceCurrency: strings.ToUpper(input.SourceCurrency),
+		TargetCurrency: strings.ToUpper(input.TargetCurrency),
+		Rate:           input.Rate,
+		Source:         model.FxRateSourceManual,
+		Note:           input.Note,
+		CreatedBy:      input.CreatedBy,
+	}
+
+	return rate, r.store.FxRate.Upsert(r.repo.DB(), rate)
+}
+
+// fetchRate asks a provider for the rate of a pair on a date.
+// Manual rates have no provider, so it returns nil for them.
+func (r *controller) fetchRate(source model.FxRateSource, src, dst string, day time.Time) (*model.FxRate, error) {
+	var (
+		rate float64
+		err  error
+	)
+
+	switch source {
+	case model.FxRateSourceWise:
+		rate, err = r.service.Wise.GetRateAt(src, dst, closingTime(day))
+	case model.FxRateSourceCurrencyLayer:
+		rate, err = r.service.Currency.GetRateAt(src, dst, day)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rate <= 0 {
+		return nil, ErrRateNotFound
+	}
+
+	return &model.FxRate{
+		Date:           day,
+		SourceCurrency: src,
+		TargetCurrency: dst,
+		Rate:           rate,
+		Source:         source,
+	}, nil
+}
+
+// trackedPairs returns every currency we keep a conversion rate for, against USD and VND
+func (r *controller) trackedPairs() ([]Pair, error) {
+	conversionRates, err := r.store.ConversionRate.GetList(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []Pair
+	for _, cr := range conversionRates {
+		for _, dst := range destCurrencies {
+			if cr.Currency.Name == "" || cr.Currency.Name == dst {
+				continue
+			}
+			pairs = append(pairs, Pair{Source: cr.Currency.Name, Target: dst})
+		}
+	}
+
+	return pairs, nil
+}
+
+// sourcePriority returns the sources in the order their rates are preferred, a manual rate comes
+// first by default so finance can correct a bad provider rate
+func (r *controller) sourcePriority() []model.FxRateSource {
+	priority := model.ToFxRateSources(r.config.FXRate.SourcePriority)
+	if len(priority) == 0 {
+		return []model.FxRateSource{model.FxRateSourceManual, model.FxRateSourceWise, model.FxRateSourceCurrencyLayer}
+	}
+	return priority
+}
+
+func invert(rate *model.FxRate) *model.FxRate {
+	return &model.FxRate{
+		BaseModel:      rate.BaseModel,
+		Date:           rate.Date,
+		SourceCurrency: rate.TargetCurrency,
+		TargetCurrency: rate.SourceCurrency,
+		Rate:           1 / rate.Rate,
+		Source:         rate.Source,
+		Note:           fmt.Sprintf("inverse of %s/%s", rate.SourceCurrency, rate.TargetCurrency),
+		CreatedBy:      rate.CreatedBy,
+	}
+}
+
+func rateKey(day time.Time, source model.FxRateSource) string {
+	return day.Format("2006-01-02") + "|" + source.String()
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// closingTime is the last moment of the day we can ask a provider about
+func closingTime(day time.Time) time.Time {
+	end := day.Add(24*time.Hour - time.Second)
+	if now := time.Now().UTC(); end.After(now) {
+		return now
+	}
+	return end
+}
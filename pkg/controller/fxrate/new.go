@@ -0,0 +1,41 @@
+package fxrate
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+
+	backfilling atomic.Bool
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	GetRateAt(src, dst string, date time.Time) (*model.FxRate, error)
+	List(input ListInput) ([]model.FxRate, error)
+	Sync(date time.Time) (int, error)
+	StartBackfill(input BackfillInput) error
+	SetManualRate(input ManualRateInput) (*model.FxRate, error)
+	GetTransactionRate(txType model.FxTransactionType, id string) (*model.FxTransactionRate, error)
+}
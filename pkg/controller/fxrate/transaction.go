@@ -0,0 +1,115 @@
+package fxrate
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/invoice"
+)
+
+// transactionInfo is what we need from a transaction to look up its historical rate
+type transactionInfo struct {
+	date     *time.Time
+	currency string
+	rate     float64
+}
+
+// GetTransactionRate returns the rate applied on a transaction together with
+// the historical rate of the transaction date, to audit the conversions done at the time
+func (r *controller) GetTransactionRate(txType model.FxTransactionType, id string) (*model.FxTransactionRate, error) {
+	info, err := r.getTransactionInfo(txType, id)
+	if err != nil {
+		return nil, err
+	}
+	if info.date == nil {
+		return nil, ErrTransactionMissingDates
+	}
+	if info.currency == "" || info.rate <= 0 {
+		return nil, ErrTransactionMissingRate
+	}
+
+	// every transaction is converted to VND when it is recorded
+	historical, err := r.GetRateAt(info.currency, "VND", *info.date)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FxTransactionRate{
+		Type:             txType,
+		ID:               id,
+		Date:             truncateDay(*info.date),
+		SourceCurrency:   info.currency,
+		TargetCurrency:   "VND",
+		AppliedRate:      info.rate,
+		HistoricalRate:   historical,
+		DeviationPercent: deviationPercent(info.rate, historical.Rate),
+	}, nil
+}
+
+func (r *controller) getTransactionInfo(txType model.FxTransactionType, id string) (*transactionInfo, error) {
+	switch txType {
+	case model.FxTransactionTypeInvoice:
+		iv, err := r.store.Invoice.One(r.repo.DB(), &invoice.Query{ID: id})
+		if err != nil {
+			return nil, notFoundOr(err)
+		}
+
+		date := iv.PaidAt
+		if date == nil {
+			date = iv.InvoicedAt
+		}
+		return &transactionInfo{date: date, currency: projectCurrency(iv), rate: iv.ConversionRate}, nil
+
+	case model.FxTransactionTypeAccountingTransaction:
+		tx, err := r.store.Accounting.GetTransactionByID(r.repo.DB(), id)
+		if err != nil {
+			return nil, notFoundOr(err)
+		}
+
+		currency := tx.Currency
+		if tx.CurrencyInfo != nil && tx.CurrencyInfo.Name != "" {
+			currency = tx.CurrencyInfo.Name
+		}
+		return &transactionInfo{date: tx.Date, currency: currency, rate: tx.ConversionRate}, nil
+
+	case model.FxTransactionTypeInboundFundTransaction:
+		ift, err := r.store.InboundFundTransaction.One(r.repo.DB(), id)
+		if err != nil {
+			return nil, notFoundOr(err)
+		}
+
+		date := ift.PaidAt
+		if date == nil {
+			date = &ift.CreatedAt
+		}
+		return &transactionInfo{date: date, currency: projectCurrency(ift.Invoice), rate: ift.ConversionRate}, nil
+	}
+
+	return nil, ErrUnsupportedTransaction
+}
+
+func projectCurrency(iv *model.Invoice) string {
+	if iv == nil || iv.Project == nil || iv.Project.BankAccount == nil || iv.Project.BankAccount.Currency == nil {
+		return ""
+	}
+	return iv.Project.BankAccount.Currency.Name
+}
+
+func notFoundOr(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrTransactionNotFound
+	}
+	return err
+}
+
+// deviationPercent is how far the applied rate is from the historical one, in percent
+func deviationPercent(applied, historical float64) float64 {
+	if historical == 0 {
+		return 0
+	}
+	return math.Round((applied-historical)/historical*10000) / 100
+}
@@ -0,0 +1,8 @@
+package icy
+
+import "errors"
+
+var (
+	ErrSnapshotNotFound = errors.New("icy treasury snapshot not found")
+	ErrInvalidRange     = errors.New("from must not be after to")
+)
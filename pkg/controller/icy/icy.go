@@ -4,32 +4,46 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/discord"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service"
 	"github.com/dwarvesf/fortress-api/pkg/service/icyswap"
 	"github.com/dwarvesf/fortress-api/pkg/service/mochipay"
 	"github.com/dwarvesf/fortress-api/pkg/service/mochiprofile"
+	"github.com/dwarvesf/fortress-api/pkg/store"
 )
 
 type IController interface {
 	Accounting() (*model.IcyAccounting, error)
+
+	Snapshot(today time.Time) (*model.IcyTreasurySnapshot, error)
+	GetSnapshot(id string) (*model.IcyTreasurySnapshot, error)
+	Reconciliation(from, to *time.Time) (*model.IcyTreasuryReconciliation, error)
 }
 
 type controller struct {
+	store   *store.Store
+	repo    store.DBRepo
 	service *service.Service
+	discord discord.IController
 	logger  logger.Logger
 	config  *config.Config
 }
 
-func New(service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+// New returns the icy controller, the treasury drift is alerted through the discord log templates
+func New(store *store.Store, repo store.DBRepo, service *service.Service, discord discord.IController, logger logger.Logger, cfg *config.Config) IController {
 	return &controller{
+		store:   store,
+		repo:    repo,
 		service: service,
+		discord: discord,
 		logger:  logger,
 		config:  cfg,
 	}
@@ -76,16 +90,8 @@ func (c *controller) Accounting() (*model.IcyAccounting, error) {
 	}
 
 	// 3.3 Calculate circulating icy amount
-	circulatingIcy := new(big.Int).Sub(icyTotalSupply, lockedIcyAmount)
-
 	// 4.Get offset usdt
-	// offset usd: circulating icy in usdt - usd fund in contract -> get how many usd left to redeem
-
-	circulatingIcyInUsdt := new(big.Int).Mul(circulatingIcy, conversionRate)
-	// continue to divide to 10^18 for get the amount in usdt decimals
-	circulatingIcyInUsdt = new(big.Int).Div(circulatingIcyInUsdt, math.BigPow(10, 18))
-
-	offsetUsdt := new(big.Int).Sub(circulatingIcyInUsdt, icyswapUsdtBal)
+	circulatingIcy, offsetUsdt := circulation(icyTotalSupply, lockedIcyAmount, conversionRate, icyswapUsdtBal)
 
 	// 5. Return accounting result
 	return &model.IcyAccounting{
@@ -99,6 +105,19 @@ func (c *controller) Accounting() (*model.IcyAccounting, error) {
 	}, nil
 }
 
+// circulation returns the circulating icy and the usdt left to redeem it beyond the fund of the
+// icyswap contract
+func circulation(totalSupply, locked, conversionRate, usdtFund *big.Int) (*big.Int, *big.Int) {
+	circulatingIcy := new(big.Int).Sub(totalSupply, locked)
+
+	// offset usd: circulating icy in usdt - usd fund in contract -> get how many usd left to redeem
+	circulatingIcyInUsdt := new(big.Int).Mul(circulatingIcy, conversionRate)
+	// continue to divide to 10^18 for get the amount in usdt decimals
+	circulatingIcyInUsdt = new(big.Int).Div(circulatingIcyInUsdt, math.BigPow(10, 18))
+
+	return circulatingIcy, new(big.Int).Sub(circulatingIcyInUsdt, usdtFund)
+}
+
 func (c *controller) lockedIcyAmount() (*big.Int, error) {
 	lockedIcyAmount := big.NewInt(0)
 
@@ -165,7 +184,28 @@ func (c *controller) onchainLockedIcyAmount() (*big.Int, error) {
 }
 
 func (c *controller) offchainLockedIcyAmount() (*big.Int, error) {
+	balances, err := c.vaultBalances()
+	if err != nil {
+		return nil, err
+	}
+
+	total := big.NewInt(0)
+	for _, b := range balances {
+		total = new(big.Int).Add(total, b.amount)
+	}
+
+	return total, nil
+}
+
+// vaultBalance is the ICY a vault or application profile holds in mochi
+type vaultBalance struct {
+	profile mochiprofile.MochiProfile
+	amount  *big.Int
+}
+
+func (c *controller) vaultBalances() ([]vaultBalance, error) {
 	// 0. get all profile, which type is vault or app
+	profiles := make(map[string]mochiprofile.MochiProfile)
 	profileIds := make([]string, 0)
 	const pageSize int64 = 50
 	var page int64 = 0
@@ -183,6 +223,7 @@ func (c *controller) offchainLockedIcyAmount() (*big.Int, error) {
 		}
 		for _, p := range res.Data {
 			profileIds = append(profileIds, p.ID)
+			profiles[p.ID] = p
 		}
 
 		hasNext := res.Pagination.Total/pageSize-page > 0
@@ -198,17 +239,22 @@ func (c *controller) offchainLockedIcyAmount() (*big.Int, error) {
 		return nil, err
 	}
 
-	total := big.NewInt(0)
+	balances := make([]vaultBalance, 0)
 	icy := c.icy()
 	for _, b := range balRes.Data {
 		// filter token icy
 		if strings.EqualFold(b.Token.Address, icy.Address) && strings.EqualFold(b.Token.ChainId, icy.ChainID) {
 			amount, _ := new(big.Int).SetString(b.Amount, 10)
-			total = new(big.Int).Add(total, amount)
+			if amount == nil {
+				amount = big.NewInt(0)
+			}
+			profile := profiles[b.ProfileID]
+			profile.ID = b.ProfileID
+			balances = append(balances, vaultBalance{profile: profile, amount: amount})
 		}
 	}
 
-	return total, nil
+	return balances, nil
 }
 
 func (c *controller) icy() model.TokenInfo {
@@ -0,0 +1,240 @@
+package icy
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/icytreasury"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service/mochipay"
+	snapshotstore "github.com/dwarvesf/fortress-api/pkg/store/icytreasurysnapshot"
+)
+
+const (
+	// ledgerPageSize and ledgerMaxPages bound the mochi ledger read between two snapshots
+	ledgerPageSize int64 = 100
+	ledgerMaxPages int64 = 100
+)
+
+// Snapshot stores the state of the treasury for the day of today and reconciles the mochi ledger
+// with the custody balance since the previous snapshot. A day is snapshotted once, the snapshot
+// of the day is returned when it exists.
+func (c *controller) Snapshot(today time.Time) (*model.IcyTreasurySnapshot, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "icy",
+		"method":     "Snapshot",
+	})
+
+	date := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	existing, err := c.store.IcyTreasurySnapshot.OneByDate(c.repo.DB(), date)
+	if err == nil {
+		return c.GetSnapshot(existing.ID.String())
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	prev, err := c.store.IcyTreasurySnapshot.LatestBefore(c.repo.DB(), date)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		prev = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := c.treasury(date, today)
+	if err != nil {
+		l.Error(err, "failed to read the treasury")
+		return nil, err
+	}
+
+	if prev != nil {
+		ledger, err := c.custodyLedger(prev.TakenAt, snapshot.TakenAt)
+		if err != nil {
+			l.Error(err, "failed to read the mochi ledger")
+			return nil, err
+		}
+		snapshot.LedgerDeposits = ledger.Deposits
+		snapshot.LedgerWithdrawals = ledger.Withdrawals
+		snapshot.LedgerTxCount = ledger.Count
+	}
+	icytreasury.Reconcile(prev, snapshot, decimal.NewFromFloat(c.config.IcyTreasury.DriftTolerance))
+
+	if _, err := c.store.IcyTreasurySnapshot.Create(c.repo.DB(), snapshot); err != nil {
+		l.Error(err, "failed to create icy treasury snapshot")
+		return nil, err
+	}
+
+	if snapshot.DriftFlagged {
+		err := c.discord.Log(model.LogDiscordInput{
+			Type: "icy_treasury_drift",
+			Data: map[string]interface{}{
+				"date":             date.Format("2006-01-02"),
+				"onchain_delta":    snapshot.CustodyBalance.Decimal.Sub(prev.CustodyBalance.Decimal).StringFixed(2),
+				"ledger_net":       snapshot.LedgerNet().StringFixed(2),
+				"drift":            snapshot.Drift.StringFixed(2),
+				"cumulative_drift": snapshot.CumulativeDrift.StringFixed(2),
+			},
+		})
+		if err != nil {
+			// the snapshot keeps the flag, the drift shows in the reconciliation report
+			l.Error(err, "failed to log icy treasury drift")
+		}
+	}
+
+	return c.GetSnapshot(snapshot.ID.String())
+}
+
+func (c *controller) GetSnapshot(id string) (*model.IcyTreasurySnapshot, error) {
+	snapshot, err := c.store.IcyTreasurySnapshot.One(c.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSnapshotNotFound
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Reconciliation returns the snapshots of the days in the range with their drift, all of them
+// when the range is open
+func (c *controller) Reconciliation(from, to *time.Time) (*model.IcyTreasuryReconciliation, error) {
+	if from != nil && to != nil && from.After(*to) {
+		return nil, ErrInvalidRange
+	}
+
+	snapshots, err := c.store.IcyTreasurySnapshot.All(c.repo.DB(), snapshotstore.Query{From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &model.IcyTreasuryReconciliation{Snapshots: snapshots}
+	rs.Drift, rs.FlaggedDays = icytreasury.Summarize(snapshots)
+	if len(snapshots) > 0 {
+		rs.From = snapshots[0].SnapshotDate
+		rs.To = snapshots[len(snapshots)-1].SnapshotDate
+	}
+	return rs, nil
+}
+
+// treasury reads the supply, the locked icy, the icyswap fund and the custody balance
+func (c *controller) treasury(date, takenAt time.Time) (*model.IcyTreasurySnapshot, error) {
+	icy, usdc := c.icy(), c.usdc()
+
+	conversionRate, err := c.service.IcySwap.ConversionRate()
+	if err != nil {
+		return nil, err
+	}
+	usdcFund, err := c.service.IcySwap.UsdcFund()
+	if err != nil {
+		return nil, err
+	}
+	onchainLocked, err := c.onchainLockedIcyAmount()
+	if err != nil {
+		return nil, err
+	}
+	vaults, err := c.vaultBalances()
+	if err != nil {
+		return nil, err
+	}
+
+	offchainLocked := big.NewInt(0)
+	balances := make([]model.IcyTreasuryVaultBalance, 0, len(vaults))
+	for _, v := range vaults {
+		offchainLocked = new(big.Int).Add(offchainLocked, v.amount)
+		balances = append(balances, model.IcyTreasuryVaultBalance{
+			ProfileID:   v.profile.ID,
+			ProfileName: v.profile.ProfileName,
+			ProfileType: v.profile.Type,
+			Amount:      icytreasury.ToUnits(v.amount, icy.Decimals),
+		})
+	}
+
+	totalSupply, _ := new(big.Int).SetString(icy.TotalSupply, 10)
+	circulating, offset := circulation(totalSupply, new(big.Int).Add(onchainLocked, offchainLocked), conversionRate, usdcFund)
+
+	snapshot := &model.IcyTreasurySnapshot{
+		BaseModel:      model.BaseModel{ID: model.NewUUID()},
+		SnapshotDate:   date,
+		TakenAt:        takenAt,
+		TotalSupply:    icytreasury.ToUnits(totalSupply, icy.Decimals),
+		OnchainLocked:  icytreasury.ToUnits(onchainLocked, icy.Decimals),
+		OffchainLocked: icytreasury.ToUnits(offchainLocked, icy.Decimals),
+		Circulating:    icytreasury.ToUnits(circulating, icy.Decimals),
+		UsdcFund:       icytreasury.ToUnits(usdcFund, usdc.Decimals),
+		ConversionRate: icytreasury.ToUnits(conversionRate, usdc.Decimals),
+		OffsetUsdc:     icytreasury.ToUnits(offset, usdc.Decimals),
+		VaultBalances:  balances,
+	}
+
+	addrs := icytreasury.ParseAddresses(c.config.IcyTreasury.CustodyAddresses)
+	if len(addrs) == 0 {
+		snapshot.Note = "no custody address configured, the ledger is not reconciled"
+		return snapshot, nil
+	}
+	custody := big.NewInt(0)
+	for _, addr := range addrs {
+		bal, err := c.service.BaseClient.ERC20Balance(common.HexToAddress(icy.Address), common.HexToAddress(addr))
+		if err != nil {
+			return nil, err
+		}
+		custody = new(big.Int).Add(custody, bal)
+	}
+	snapshot.CustodyBalance = decimal.NewNullDecimal(icytreasury.ToUnits(custody, icy.Decimals))
+
+	return snapshot, nil
+}
+
+// custodyLedger sums the icy deposited to and withdrawn from mochi in (since, until]
+func (c *controller) custodyLedger(since, until time.Time) (*icytreasury.Ledger, error) {
+	ledger := &icytreasury.Ledger{}
+	icyDecimals := c.icy().Decimals
+
+	for page := int64(0); page < ledgerMaxPages; page++ {
+		res, err := c.service.MochiPay.GetListTransactions(mochipay.ListTransactionsRequest{
+			ActionList:   []mochipay.TransactionAction{mochipay.TransactionActionDeposit, mochipay.TransactionActionWithdraw},
+			Status:       mochipay.TransactionStatusSuccess,
+			TokenAddress: mochipay.ICYAddress,
+			ChainIDs:     []string{mochipay.BASEChainID},
+			Page:         page,
+			Size:         ledgerPageSize,
+			SortBy:       "created_at-",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range res.Data {
+			if !tx.CreatedAt.After(since) || tx.CreatedAt.After(until) {
+				continue
+			}
+			decimals := icyDecimals
+			if tx.Token != nil && tx.Token.Decimal > 0 {
+				decimals = int(tx.Token.Decimal)
+			}
+			amount, err := icytreasury.ParseUnits(tx.Amount, decimals)
+			if err != nil {
+				return nil, err
+			}
+
+			switch tx.Action {
+			case mochipay.TransactionActionDeposit:
+				ledger.Deposit(amount)
+			case mochipay.TransactionActionWithdraw:
+				ledger.Withdraw(amount)
+			}
+		}
+
+		// the transactions are latest first, stop once past the previous snapshot
+		if len(res.Data) < int(ledgerPageSize) || !res.Data[len(res.Data)-1].CreatedAt.After(since) {
+			break
+		}
+	}
+
+	return ledger, nil
+}
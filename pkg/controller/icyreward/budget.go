@@ -0,0 +1,48 @@
+package icyreward
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// Budget returns what each rule paid and holds in review over the month of the time
+func (r *controller) Budget(month time.Time) (*model.IcyRewardBudgetReport, error) {
+	from := icyreward.MonthStart(month)
+
+	rules, err := r.store.IcyRewardRule.All(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+	spends, err := r.store.IcyRewardPayout.SpendByRule(r.repo.DB(), from, from.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+	byRule := make(map[string]model.IcyRewardRuleSpend, len(spends))
+	for _, s := range spends {
+		byRule[s.RuleID] = s
+	}
+
+	report := &model.IcyRewardBudgetReport{
+		Year:          from.Year(),
+		Month:         int(from.Month()),
+		MonthlyBudget: r.limits().MonthlyBudget,
+		Paid:          decimal.Zero,
+		InReview:      decimal.Zero,
+		Rules:         make([]model.IcyRewardRuleBudget, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		spend, ok := byRule[rule.ID.String()]
+		if !ok {
+			spend = model.IcyRewardRuleSpend{RuleID: rule.ID.String()}
+		}
+		report.Paid = report.Paid.Add(spend.Paid)
+		report.InReview = report.InReview.Add(spend.InReview)
+		report.Rules = append(report.Rules, model.IcyRewardRuleBudget{Rule: *rule, IcyRewardRuleSpend: spend})
+	}
+
+	return report, nil
+}
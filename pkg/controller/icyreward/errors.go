@@ -0,0 +1,25 @@
+package icyreward
+
+import "errors"
+
+var (
+	ErrRuleNotFound         = errors.New("icy reward rule not found")
+	ErrNoActiveRule         = errors.New("no active icy reward rule for the trigger")
+	ErrPayoutNotFound       = errors.New("icy reward payout not found")
+	ErrEmployeeNotFound     = errors.New("employee not found")
+	ErrInvalidTrigger       = errors.New("invalid trigger")
+	ErrTriggerExists        = errors.New("trigger already has a reward rule")
+	ErrInvalidCategory      = errors.New("invalid icy transaction category")
+	ErrInvalidAmount        = errors.New("amount must be positive")
+	ErrInvalidCap           = errors.New("cap per period must not be negative")
+	ErrInvalidCapPeriod     = errors.New("invalid cap period")
+	ErrInvalidBudget        = errors.New("monthly budget must be positive")
+	ErrInvalidThreshold     = errors.New("review threshold must be positive")
+	ErrEmployeeRequired     = errors.New("employee id or discord id is required")
+	ErrPayoutNotReviewable  = errors.New("payout is not waiting for a review or a retry")
+	ErrRejectReasonRequired = errors.New("reason is required to reject a payout")
+	ErrOwnPayout            = errors.New("cannot review your own payout")
+	ErrInvalidStatus        = errors.New("invalid payout status")
+	ErrNoDiscordAccount     = errors.New("employee has no discord account to be paid on")
+	ErrNoTransactionFound   = errors.New("no transaction returned by mochi")
+)
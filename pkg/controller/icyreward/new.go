@@ -0,0 +1,47 @@
+package icyreward
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	fxRate  fxrate.IController
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+// New returns the ICY reward controller, the rewards are sent through mochi and posted to the
+// ledger at the ICY rate of the config valued in VND at the rates of the fx rate controller
+func New(store *store.Store, repo store.DBRepo, service *service.Service, fxRate fxrate.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		fxRate:  fxRate,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	ListRules() ([]*model.IcyRewardRule, error)
+	CreateRule(input RuleInput) (*model.IcyRewardRule, error)
+	UpdateRule(id string, input RuleInput) (*model.IcyRewardRule, error)
+
+	Trigger(input TriggerInput) (*model.IcyRewardPayout, bool, error)
+	ListPayouts(input ListPayoutsInput, pagination model.Pagination) ([]*model.IcyRewardPayout, int64, error)
+	Approve(id string, reviewerID string) (*model.IcyRewardPayout, error)
+	Reject(id string, reviewerID string, reason string) (*model.IcyRewardPayout, error)
+
+	Budget(month time.Time) (*model.IcyRewardBudgetReport, error)
+}
@@ -0,0 +1,348 @@
+package icyreward
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	payoutstore "github.com/dwarvesf/fortress-api/pkg/store/icyrewardpayout"
+)
+
+// TriggerInput is an event to reward, the employee is given by id or by discord id. The reference
+// identifies the event among the ones of the employee, e.g. the memo or the talk.
+type TriggerInput struct {
+	Trigger    model.IcyRewardTrigger
+	EmployeeID string
+	DiscordID  string
+	Reference  string
+	OccurredAt time.Time // now when empty
+}
+
+type ListPayoutsInput struct {
+	Status     model.IcyRewardPayoutStatus
+	RuleID     string
+	EmployeeID string
+}
+
+// Trigger rewards an event by the active rule of its trigger. An event is rewarded once: when it
+// was already, its payout is returned and created is false.
+func (r *controller) Trigger(input TriggerInput) (*model.IcyRewardPayout, bool, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "icyreward",
+		"method":     "Trigger",
+		"trigger":    input.Trigger,
+	})
+
+	if !input.Trigger.IsValid() {
+		return nil, false, ErrInvalidTrigger
+	}
+	employee, err := r.getEmployee(input)
+	if err != nil {
+		return nil, false, err
+	}
+	rule, err := r.store.IcyRewardRule.OneActiveByTrigger(r.repo.DB(), input.Trigger)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, ErrNoActiveRule
+		}
+		return nil, false, err
+	}
+
+	occurredAt := input.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	key := icyreward.Key(rule.Trigger, employee.ID.String(), input.Reference, occurredAt)
+
+	existing, err := r.store.IcyRewardPayout.OneByKey(r.repo.DB(), key)
+	if err == nil {
+		return existing, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	usage, err := r.usage(rule, employee.ID.String(), occurredAt)
+	if err != nil {
+		l.Error(err, "failed to get the usage of the rule")
+		return nil, false, err
+	}
+	decision := icyreward.Decide(rule, *usage, r.limits())
+
+	payout := &model.IcyRewardPayout{
+		BaseModel:      model.BaseModel{ID: model.NewUUID()},
+		RuleID:         rule.ID,
+		EmployeeID:     employee.ID,
+		IdempotencyKey: key,
+		Reference:      strings.TrimSpace(input.Reference),
+		Amount:         rule.Amount,
+		Status:         decision.Status,
+		Reason:         decision.Reason,
+		OccurredAt:     occurredAt,
+	}
+	if decision.Status == model.IcyRewardPayoutStatusPaid {
+		payout.Status = model.IcyRewardPayoutStatusProcessing
+	}
+
+	created, err := r.store.IcyRewardPayout.Create(r.repo.DB(), payout)
+	if err != nil {
+		l.Error(err, "failed to create payout")
+		return nil, false, err
+	}
+	if !created {
+		// the same event was rewarded in the meantime
+		existing, err := r.store.IcyRewardPayout.OneByKey(r.repo.DB(), key)
+		return existing, false, err
+	}
+
+	if payout.Status == model.IcyRewardPayoutStatusProcessing {
+		payout.Rule, payout.Employee = rule, employee
+		r.pay(payout)
+	}
+
+	p, err := r.getPayout(payout.ID.String())
+	return p, true, err
+}
+
+func (r *controller) ListPayouts(input ListPayoutsInput, pagination model.Pagination) ([]*model.IcyRewardPayout, int64, error) {
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, 0, ErrInvalidStatus
+	}
+	return r.store.IcyRewardPayout.All(r.repo.DB(), payoutstore.Query{
+		Status:     input.Status,
+		RuleID:     input.RuleID,
+		EmployeeID: input.EmployeeID,
+	}, pagination)
+}
+
+// Approve sends a payout waiting for a review, or sends a failed one again
+func (r *controller) Approve(id string, reviewerID string) (*model.IcyRewardPayout, error) {
+	payout, reviewer, err := r.reviewed(id, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ok, err := r.store.IcyRewardPayout.UpdateStatus(r.repo.DB(), id, model.IcyRewardPayableStatuses, model.IcyRewardPayout{
+		Status:     model.IcyRewardPayoutStatusProcessing,
+		Reason:     "",
+		ReviewedBy: &reviewer,
+		ReviewedAt: &now,
+	}, "status", "reason", "reviewed_by", "reviewed_at")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrPayoutNotReviewable
+	}
+
+	r.pay(payout)
+	return r.getPayout(id)
+}
+
+// Reject drops a payout waiting for a review or a retry, it no longer counts toward the budgets
+func (r *controller) Reject(id string, reviewerID string, reason string) (*model.IcyRewardPayout, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrRejectReasonRequired
+	}
+	_, reviewer, err := r.reviewed(id, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ok, err := r.store.IcyRewardPayout.UpdateStatus(r.repo.DB(), id, model.IcyRewardPayableStatuses, model.IcyRewardPayout{
+		Status:     model.IcyRewardPayoutStatusRejected,
+		Reason:     reason,
+		ReviewedBy: &reviewer,
+		ReviewedAt: &now,
+	}, "status", "reason", "reviewed_by", "reviewed_at")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrPayoutNotReviewable
+	}
+
+	return r.getPayout(id)
+}
+
+// pay sends a payout being processed through mochi and records its ICY transaction, a payout
+// mochi did not send is marked failed to be retried
+func (r *controller) pay(payout *model.IcyRewardPayout) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "icyreward",
+		"method":     "pay",
+		"payoutID":   payout.ID.String(),
+	})
+
+	employee, rule := payout.Employee, payout.Rule
+	if employee.DiscordAccount == nil || employee.DiscordAccount.DiscordID == "" {
+		r.fail(payout, ErrNoDiscordAccount)
+		return
+	}
+	discordID := employee.DiscordAccount.DiscordID
+
+	description := fmt.Sprintf("%s - %s on %s", employee.DisplayName, rule.Name, payout.OccurredAt.Format("2006-01-02"))
+	txs, err := r.service.Mochi.SendFromAccountToUser(payout.Amount.InexactFloat64(), discordID, description, payout.IdempotencyKey)
+	if err != nil {
+		l.Error(err, "failed to request to mochi")
+		r.fail(payout, err)
+		return
+	}
+	if len(txs) == 0 {
+		r.fail(payout, ErrNoTransactionFound)
+		return
+	}
+
+	now := time.Now()
+	icyTx := model.IcyTransaction{
+		BaseModel:      model.BaseModel{ID: model.NewUUID()},
+		Category:       rule.Category,
+		TxnTime:        now,
+		Amount:         payout.Amount.String(),
+		Note:           description,
+		DestEmployeeID: employee.ID,
+		Sender:         r.config.Mochi.ApplicationName,
+		Target:         discordID,
+		RewardRuleID:   &rule.ID,
+	}
+
+	tx, done := r.repo.NewTransaction()
+	if err := r.store.IcyTransaction.Create(tx.DB(), []model.IcyTransaction{icyTx}); err != nil {
+		l.Error(done(err), "failed to create icy transaction")
+		return
+	}
+	if _, err := r.store.IcyRewardPayout.UpdateSelectedFieldsByID(tx.DB(), payout.ID.String(), model.IcyRewardPayout{
+		Status:           model.IcyRewardPayoutStatusPaid,
+		MochiTxID:        txs[0].TransactionID,
+		IcyTransactionID: &icyTx.ID,
+	}, "status", "mochi_tx_id", "icy_transaction_id"); err != nil {
+		l.Error(done(err), "failed to mark payout paid")
+		return
+	}
+	if err := done(nil); err != nil {
+		l.Error(err, "failed to commit payout")
+		return
+	}
+
+	usdVND, err := r.fxRate.GetRateAt("USD", "VND", now)
+	if err != nil {
+		l.Error(err, "failed to get USD to VND rate, icy transaction is not posted to the ledger")
+		return
+	}
+	if _, err := ledger.New(r.store).PostIcyTransactions(r.repo.DB(), []model.IcyTransaction{icyTx}, r.config.Ledger.IcyUSDRate*usdVND.Rate); err != nil {
+		l.Error(err, "failed to post icy transaction to the ledger")
+	}
+}
+
+func (r *controller) fail(payout *model.IcyRewardPayout, reason error) {
+	if _, err := r.store.IcyRewardPayout.UpdateSelectedFieldsByID(r.repo.DB(), payout.ID.String(), model.IcyRewardPayout{
+		Status: model.IcyRewardPayoutStatusFailed,
+		Reason: reason.Error(),
+	}, "status", "reason"); err != nil {
+		r.logger.Fields(logger.Fields{
+			"controller": "icyreward",
+			"method":     "fail",
+			"payoutID":   payout.ID.String(),
+		}).Error(err, "failed to mark payout failed")
+	}
+}
+
+// usage returns what the employee and the rule committed before the event
+func (r *controller) usage(rule *model.IcyRewardRule, employeeID string, occurredAt time.Time) (*icyreward.Usage, error) {
+	db := r.repo.DB()
+	monthStart := icyreward.MonthStart(occurredAt)
+
+	count, err := r.store.IcyRewardPayout.CountInPeriod(db, rule.ID.String(), employeeID, icyreward.PeriodStart(rule.CapPeriod, occurredAt))
+	if err != nil {
+		return nil, err
+	}
+	ruleSpent, err := r.store.IcyRewardPayout.SumSince(db, rule.ID.String(), monthStart)
+	if err != nil {
+		return nil, err
+	}
+	totalSpent, err := r.store.IcyRewardPayout.SumSince(db, "", monthStart)
+	if err != nil {
+		return nil, err
+	}
+
+	return &icyreward.Usage{
+		PeriodPayouts:   int(count),
+		RuleMonthSpent:  ruleSpent,
+		TotalMonthSpent: totalSpent,
+	}, nil
+}
+
+func (r *controller) limits() icyreward.Limits {
+	var limits icyreward.Limits
+	if r.config.IcyReward.MonthlyBudget > 0 {
+		limits.MonthlyBudget = decimal.NewNullDecimal(decimal.NewFromFloat(r.config.IcyReward.MonthlyBudget))
+	}
+	if r.config.IcyReward.ReviewThreshold > 0 {
+		limits.ReviewThreshold = decimal.NewNullDecimal(decimal.NewFromFloat(r.config.IcyReward.ReviewThreshold))
+	}
+	return limits
+}
+
+func (r *controller) getEmployee(input TriggerInput) (*model.Employee, error) {
+	var (
+		employee *model.Employee
+		err      error
+	)
+	switch {
+	case input.EmployeeID != "":
+		employee, err = r.store.Employee.One(r.repo.DB(), input.EmployeeID, false)
+	case input.DiscordID != "":
+		employee, err = r.store.Employee.GetByDiscordID(r.repo.DB(), input.DiscordID, false)
+	default:
+		return nil, ErrEmployeeRequired
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	if employee.DiscordAccount == nil && input.DiscordID != "" {
+		// the account is joined on, not loaded
+		employee.DiscordAccount = &model.DiscordAccount{DiscordID: input.DiscordID}
+	}
+	return employee, nil
+}
+
+func (r *controller) getPayout(id string) (*model.IcyRewardPayout, error) {
+	payout, err := r.store.IcyRewardPayout.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPayoutNotFound
+		}
+		return nil, err
+	}
+	return payout, nil
+}
+
+// reviewed returns a payout the reviewer can review, one of someone else
+func (r *controller) reviewed(id string, reviewerID string) (*model.IcyRewardPayout, model.UUID, error) {
+	reviewer, err := model.UUIDFromString(reviewerID)
+	if err != nil {
+		return nil, reviewer, err
+	}
+	payout, err := r.getPayout(id)
+	if err != nil {
+		return nil, reviewer, err
+	}
+	if payout.EmployeeID == reviewer {
+		return nil, reviewer, ErrOwnPayout
+	}
+	return payout, reviewer, nil
+}
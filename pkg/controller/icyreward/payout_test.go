@@ -0,0 +1,125 @@
+package icyreward
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+const (
+	employeeID = "f7c6016b-85b5-47f7-8027-23c2db482197"
+	paidMemoID = "2a3b4c5d-6e7f-4a8b-8c9d-0e1f2a3b4c5d"
+)
+
+// the controller has no services: a payout to send through mochi would panic, the events of the
+// tests are never paid
+func TestController_Trigger(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger("info")
+	storeMock := store.New()
+
+	occurredAt := time.Date(2026, 10, 6, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       TriggerInput
+		wantErr     error
+		wantCreated bool
+		wantID      string
+		wantKey     string
+		wantStatus  model.IcyRewardPayoutStatus
+	}{
+		{
+			name:       "event_already_rewarded",
+			input:      TriggerInput{Trigger: model.IcyRewardTriggerMemoPublished, EmployeeID: employeeID, Reference: "memo-1", OccurredAt: occurredAt},
+			wantID:     paidMemoID,
+			wantKey:    "memo-published:" + employeeID + ":memo-1",
+			wantStatus: model.IcyRewardPayoutStatusPaid,
+		},
+		{
+			name:        "event_over_the_cap_of_the_rule",
+			input:       TriggerInput{Trigger: model.IcyRewardTriggerMemoPublished, EmployeeID: employeeID, Reference: "memo-2", OccurredAt: occurredAt},
+			wantCreated: true,
+			wantKey:     "memo-published:" + employeeID + ":memo-2",
+			wantStatus:  model.IcyRewardPayoutStatusSkipped,
+		},
+		{
+			name:        "event_without_reference_keyed_by_its_day",
+			input:       TriggerInput{Trigger: model.IcyRewardTriggerMemoPublished, EmployeeID: employeeID, OccurredAt: occurredAt},
+			wantCreated: true,
+			wantKey:     "memo-published:" + employeeID + ":2026-10-06",
+			wantStatus:  model.IcyRewardPayoutStatusSkipped,
+		},
+		{
+			name:    "no_active_rule",
+			input:   TriggerInput{Trigger: model.IcyRewardTriggerOfficeCheckIn, EmployeeID: employeeID, OccurredAt: occurredAt},
+			wantErr: ErrNoActiveRule,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/trigger/trigger.sql")
+
+				c := New(storeMock, txRepo, nil, nil, loggerMock, &cfg)
+				payout, created, err := c.Trigger(tt.input)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tt.wantCreated, created)
+				require.Equal(t, tt.wantKey, payout.IdempotencyKey)
+				require.Equal(t, tt.wantStatus, payout.Status)
+				if tt.wantID != "" {
+					require.Equal(t, tt.wantID, payout.ID.String())
+				}
+			})
+		})
+	}
+}
+
+// TestController_Trigger_Concurrently checks an event is rewarded once: the payout of the trigger
+// which comes second is not created, whether it looked the event up before or after the first
+func TestController_Trigger_Concurrently(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger("info")
+	storeMock := store.New()
+
+	testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+		testhelper.LoadTestSQLFile(t, txRepo, "./testdata/trigger/trigger.sql")
+		c := New(storeMock, txRepo, nil, nil, loggerMock, &cfg)
+
+		input := TriggerInput{Trigger: model.IcyRewardTriggerMemoPublished, EmployeeID: employeeID, Reference: "memo-2", OccurredAt: time.Date(2026, 10, 6, 10, 0, 0, 0, time.UTC)}
+		first, created, err := c.Trigger(input)
+		require.NoError(t, err)
+		require.True(t, created)
+
+		// the second trigger looked the event up before the first created its payout
+		created, err = storeMock.IcyRewardPayout.Create(txRepo.DB(), &model.IcyRewardPayout{
+			BaseModel:      model.BaseModel{ID: model.NewUUID()},
+			RuleID:         first.RuleID,
+			EmployeeID:     first.EmployeeID,
+			IdempotencyKey: first.IdempotencyKey,
+			Amount:         first.Amount,
+			Status:         model.IcyRewardPayoutStatusProcessing,
+			OccurredAt:     first.OccurredAt,
+		})
+		require.NoError(t, err)
+		require.False(t, created)
+
+		// the second trigger looked the event up after
+		second, created, err := c.Trigger(input)
+		require.NoError(t, err)
+		require.False(t, created)
+		require.Equal(t, first.ID, second.ID)
+	})
+}
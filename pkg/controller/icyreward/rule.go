@@ -0,0 +1,138 @@
+package icyreward
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type RuleInput struct {
+	Trigger         model.IcyRewardTrigger
+	Name            string
+	Category        string
+	Amount          decimal.Decimal
+	CapPerPeriod    int
+	CapPeriod       model.IcyRewardPeriod // month when empty
+	MonthlyBudget   *decimal.Decimal
+	ReviewThreshold *decimal.Decimal // the configured threshold when empty
+	IsActive        bool
+}
+
+func (r *controller) ListRules() ([]*model.IcyRewardRule, error) {
+	return r.store.IcyRewardRule.All(r.repo.DB())
+}
+
+func (r *controller) CreateRule(input RuleInput) (*model.IcyRewardRule, error) {
+	rule := &model.IcyRewardRule{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+	}
+	if err := r.applyInput(rule, input); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.IcyRewardRule.Create(r.repo.DB(), rule); err != nil {
+		return nil, err
+	}
+
+	return r.getRule(rule.ID.String())
+}
+
+// UpdateRule changes a rule, the payouts made keep the amount they were made with
+func (r *controller) UpdateRule(id string, input RuleInput) (*model.IcyRewardRule, error) {
+	rule, err := r.getRule(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyInput(rule, input); err != nil {
+		return nil, err
+	}
+
+	_, err = r.store.IcyRewardRule.UpdateSelectedFieldsByID(r.repo.DB(), id, model.IcyRewardRule{
+		Trigger:         rule.Trigger,
+		Name:            rule.Name,
+		Category:        rule.Category,
+		Amount:          rule.Amount,
+		CapPerPeriod:    rule.CapPerPeriod,
+		CapPeriod:       rule.CapPeriod,
+		MonthlyBudget:   rule.MonthlyBudget,
+		ReviewThreshold: rule.ReviewThreshold,
+		IsActive:        rule.IsActive,
+	}, "trigger", "name", "category", "amount", "cap_per_period", "cap_period", "monthly_budget",
+		"review_threshold", "is_active")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.getRule(id)
+}
+
+func (r *controller) getRule(id string) (*model.IcyRewardRule, error) {
+	rule, err := r.store.IcyRewardRule.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRuleNotFound
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *controller) applyInput(rule *model.IcyRewardRule, input RuleInput) error {
+	if !input.Trigger.IsValid() {
+		return ErrInvalidTrigger
+	}
+	exists, err := r.store.IcyRewardRule.IsTriggerExist(r.repo.DB(), input.Trigger, rule.ID.String())
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrTriggerExists
+	}
+
+	if !model.IsValidIcyTxnCategory(input.Category) {
+		return ErrInvalidCategory
+	}
+	if !input.Amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+	if input.CapPerPeriod < 0 {
+		return ErrInvalidCap
+	}
+	if input.CapPeriod == "" {
+		input.CapPeriod = model.IcyRewardPeriodMonth
+	}
+	if !input.CapPeriod.IsValid() {
+		return ErrInvalidCapPeriod
+	}
+
+	rule.MonthlyBudget = decimal.NullDecimal{}
+	if input.MonthlyBudget != nil {
+		if !input.MonthlyBudget.IsPositive() {
+			return ErrInvalidBudget
+		}
+		rule.MonthlyBudget = decimal.NewNullDecimal(*input.MonthlyBudget)
+	}
+	rule.ReviewThreshold = decimal.NullDecimal{}
+	if input.ReviewThreshold != nil {
+		if !input.ReviewThreshold.IsPositive() {
+			return ErrInvalidThreshold
+		}
+		rule.ReviewThreshold = decimal.NewNullDecimal(*input.ReviewThreshold)
+	}
+
+	rule.Trigger = input.Trigger
+	rule.Name = strings.TrimSpace(input.Name)
+	if rule.Name == "" {
+		rule.Name = input.Trigger.String()
+	}
+	rule.Category = input.Category
+	rule.Amount = input.Amount
+	rule.CapPerPeriod = input.CapPerPeriod
+	rule.CapPeriod = input.CapPeriod
+	rule.IsActive = input.IsActive
+	return nil
+}
@@ -0,0 +1,5 @@
+UPDATE public.icy_reward_rules SET is_active = TRUE, cap_per_period = 1 WHERE id = '345dcb46-7f44-4e58-b3fd-da0fc1cf440b';
+UPDATE public.icy_reward_rules SET is_active = FALSE WHERE id = 'd39b08f7-bb0a-4b2a-bec3-818b7e5a35fe';
+
+INSERT INTO public.icy_reward_payouts (id, deleted_at, created_at, updated_at, rule_id, employee_id, idempotency_key, reference, amount, status, reason, occurred_at, reviewed_by, reviewed_at, mochi_tx_id, icy_transaction_id) VALUES
+('2a3b4c5d-6e7f-4a8b-8c9d-0e1f2a3b4c5d', NULL, '2026-10-05 10:00:00', '2026-10-05 10:00:00', '345dcb46-7f44-4e58-b3fd-da0fc1cf440b', 'f7c6016b-85b5-47f7-8027-23c2db482197', 'memo-published:f7c6016b-85b5-47f7-8027-23c2db482197:memo-1', 'memo-1', 25, 'paid', NULL, '2026-10-05 10:00:00', NULL, NULL, 1, NULL);
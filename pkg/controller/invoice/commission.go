@@ -8,12 +8,14 @@ import (
 	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	bcConst "github.com/dwarvesf/fortress-api/pkg/service/basecamp/consts"
 	bcModel "github.com/dwarvesf/fortress-api/pkg/service/basecamp/model"
 	"github.com/dwarvesf/fortress-api/pkg/store"
 	storeemployeecommission "github.com/dwarvesf/fortress-api/pkg/store/employeecommission"
+	"github.com/dwarvesf/fortress-api/pkg/store/inboundfundtransaction"
 	storeinvoice "github.com/dwarvesf/fortress-api/pkg/store/invoice"
 )
 
@@ -64,7 +66,7 @@ func (c *controller) storeCommission(db *gorm.DB, l logger.Logger, invoice *mode
 
 	for _, commission := range employeeCommissions {
 		if strings.Contains(commission.Note, "Inbound Fund") {
-			_, err := c.store.InboundFundTransaction.Create(db, &model.InboundFundTransaction{
+			ift, err := c.store.InboundFundTransaction.Create(db, &model.InboundFundTransaction{
 				InvoiceID:      invoice.ID,
 				Amount:         commission.Amount,
 				ConversionRate: commission.ConversionRate,
@@ -74,6 +76,11 @@ func (c *controller) storeCommission(db *gorm.DB, l logger.Logger, invoice *mode
 				l.Errorf(err, "failed to create inbound fund transaction for invoice(%s)", invoice.ID.String())
 				return nil, err
 			}
+
+			if _, err := ledger.New(c.store).PostInboundFundTransaction(db, ift); err != nil {
+				l.Errorf(err, "failed to post inbound fund transaction for invoice(%s)", invoice.ID.String())
+				return nil, err
+			}
 		}
 	}
 	// remove inbound fund commission from employee commissions
@@ -518,6 +525,19 @@ func (c *controller) ProcessCommissions(invoiceID string, dryRun bool, l logger.
 		l.Error(err, "failed to delete existing commissions")
 		return nil, err
 	}
+	unpaidInboundFunds, err := c.store.InboundFundTransaction.Get(tx, inboundfundtransaction.Query{InvoiceID: invoiceID})
+	if err != nil {
+		tx.Rollback()
+		l.Error(err, "failed to get existing inbound fund transactions")
+		return nil, err
+	}
+	for _, ift := range unpaidInboundFunds {
+		if err := ledger.New(c.store).ReverseSource(tx, model.JournalSourceInboundFund, ift.ID.String()); err != nil {
+			tx.Rollback()
+			l.Error(err, "failed to reverse existing inbound fund transactions")
+			return nil, err
+		}
+	}
 	if err := c.store.InboundFundTransaction.DeleteUnpaidByInvoiceID(tx, invoiceID); err != nil {
 		tx.Rollback()
 		l.Error(err, "failed to delete existing inbound fund transactions")
@@ -531,7 +551,7 @@ func (c *controller) ProcessCommissions(invoiceID string, dryRun bool, l logger.
 			if err == nil && inboundFunCommission.PaidAt != nil {
 				continue // already paid, skip creation
 			}
-			_, err = c.store.InboundFundTransaction.Create(tx, &model.InboundFundTransaction{
+			ift, err := c.store.InboundFundTransaction.Create(tx, &model.InboundFundTransaction{
 				InvoiceID:      invoice.ID,
 				Amount:         commission.Amount,
 				ConversionRate: commission.ConversionRate,
@@ -542,6 +562,12 @@ func (c *controller) ProcessCommissions(invoiceID string, dryRun bool, l logger.
 				l.Errorf(err, "failed to create inbound fund transaction for invoice(%s)", invoice.ID.String())
 				return nil, err
 			}
+
+			if _, err := ledger.New(c.store).PostInboundFundTransaction(tx, ift); err != nil {
+				tx.Rollback()
+				l.Errorf(err, "failed to post inbound fund transaction for invoice(%s)", invoice.ID.String())
+				return nil, err
+			}
 		}
 	}
 
@@ -11,6 +11,7 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service/basecamp/consts"
@@ -329,6 +330,11 @@ func (c *controller) processPaidInvoiceData(l logger.Logger, wg *sync.WaitGroup,
 		return done(err)
 	}
 
+	if _, err := ledger.New(c.store).PostAccountingTransaction(tx.DB(), model.JournalSourceInvoice, accountingTxn); err != nil {
+		l.Errorf(err, "failed to post journal entry", "invoiceNumber", req.Invoice.Number)
+		return done(err)
+	}
+
 	msg = consts.CommentUpdateInvoiceSuccessfully
 	msgType = bcModel.CommentMsgTypeCompleted
 
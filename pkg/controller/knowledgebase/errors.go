@@ -0,0 +1,9 @@
+package knowledgebase
+
+import "errors"
+
+var (
+	ErrEmptyKeyword     = errors.New("keyword is required")
+	ErrInvalidSource    = errors.New("invalid source, expected memo, brainery or ogif")
+	ErrInvalidDateRange = errors.New("from must be before to")
+)
@@ -0,0 +1,231 @@
+package knowledgebase
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/memolog"
+)
+
+// IndexMemos indexes the memos with their content, by memo log ID, the description of the memos
+// without one
+func (c *controller) IndexMemos(memos []model.MemoLog, contents map[string]string) error {
+	if len(memos) == 0 {
+		return nil
+	}
+
+	db := c.repo.DB()
+	accounts, err := c.discordAccounts(db)
+	if err != nil {
+		return err
+	}
+
+	docs := make([]model.KnowledgeDocument, 0, len(memos))
+	for _, m := range memos {
+		content, ok := contents[m.ID.String()]
+		if !ok {
+			content = m.Description
+		}
+
+		authors := append([]string{}, m.AuthorHandles...)
+		for _, id := range m.DiscordAccountIDs {
+			if a, ok := accounts[id]; ok {
+				authors = append(authors, a.DiscordUsername, a.MemoUsername, a.GithubUsername)
+			}
+		}
+
+		docs = append(docs, model.KnowledgeDocument{
+			Source:      model.KnowledgeSourceMemo,
+			SourceID:    m.ID.String(),
+			Title:       m.Title,
+			URL:         m.URL,
+			Content:     content,
+			Authors:     normalize(authors),
+			Tags:        normalize(m.Tags),
+			PublishedAt: m.PublishedAt,
+		})
+	}
+
+	return c.store.KnowledgeDocument.Upsert(db, docs)
+}
+
+// IndexBraineryLogs indexes the brainery logs, a log is a link so its title and tags are what is
+// searched
+func (c *controller) IndexBraineryLogs(logs []model.BraineryLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	db := c.repo.DB()
+	employeeIDs := make([]model.UUID, 0, len(logs))
+	for _, l := range logs {
+		if !l.EmployeeID.IsZero() {
+			employeeIDs = append(employeeIDs, l.EmployeeID)
+		}
+	}
+	employees, err := c.store.Employee.GetByIDs(db, employeeIDs)
+	if err != nil {
+		return err
+	}
+	usernames := make(map[string]string, len(employees))
+	for _, e := range employees {
+		usernames[e.ID.String()] = e.Username
+	}
+
+	docs := make([]model.KnowledgeDocument, 0, len(logs))
+	for _, l := range logs {
+		docs = append(docs, model.KnowledgeDocument{
+			Source:      model.KnowledgeSourceBrainery,
+			SourceID:    l.ID.String(),
+			Title:       l.Title,
+			URL:         l.URL,
+			Authors:     normalize([]string{l.GithubID, usernames[l.EmployeeID.String()]}),
+			Tags:        normalize(l.Tags),
+			PublishedAt: l.PublishedAt,
+		})
+	}
+
+	return c.store.KnowledgeDocument.Upsert(db, docs)
+}
+
+// IndexOGIF indexes the summary of an OGIF broadcast
+func (c *controller) IndexOGIF(broadcastID, title, url, content string, publishedAt time.Time) error {
+	return c.store.KnowledgeDocument.Upsert(c.repo.DB(), []model.KnowledgeDocument{{
+		Source:      model.KnowledgeSourceOGIF,
+		SourceID:    broadcastID,
+		Title:       title,
+		URL:         url,
+		Content:     content,
+		Authors:     model.JSONArrayString{},
+		Tags:        model.JSONArrayString{"ogif"},
+		PublishedAt: &publishedAt,
+	}})
+}
+
+func (c *controller) Remove(source model.KnowledgeSource, sourceIDs ...string) error {
+	return c.store.KnowledgeDocument.DeleteBySourceIDs(c.repo.DB(), source, sourceIDs)
+}
+
+// Reindex indexes the memos and brainery logs missing from the index, and removes the ones deleted
+// since. The documents indexed already are kept, the memos of the memo repository are indexed with
+// their content as they are ingested.
+func (c *controller) Reindex() ([]model.KnowledgeIndexResult, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "knowledgebase",
+		"method":     "Reindex",
+	})
+
+	db := c.repo.DB()
+
+	memos, err := c.store.MemoLog.List(db, memolog.ListFilter{})
+	if err != nil {
+		l.Error(err, "failed to list memo logs")
+		return nil, err
+	}
+	memoIDs := make([]string, 0, len(memos))
+	for _, m := range memos {
+		memoIDs = append(memoIDs, m.ID.String())
+	}
+	missing, removed, err := c.diff(db, model.KnowledgeSourceMemo, memoIDs)
+	if err != nil {
+		l.Error(err, "failed to diff the memo index")
+		return nil, err
+	}
+	var toIndex []model.MemoLog
+	for _, m := range memos {
+		if missing[m.ID.String()] {
+			toIndex = append(toIndex, m)
+		}
+	}
+	if err := c.IndexMemos(toIndex, nil); err != nil {
+		l.Error(err, "failed to index memos")
+		return nil, err
+	}
+	memoResult := model.KnowledgeIndexResult{Source: model.KnowledgeSourceMemo, Indexed: len(toIndex), Removed: len(removed)}
+
+	logs, err := c.store.BraineryLog.All(db)
+	if err != nil {
+		l.Error(err, "failed to list brainery logs")
+		return nil, err
+	}
+	logIDs := make([]string, 0, len(logs))
+	for _, b := range logs {
+		logIDs = append(logIDs, b.ID.String())
+	}
+	missing, removedLogs, err := c.diff(db, model.KnowledgeSourceBrainery, logIDs)
+	if err != nil {
+		l.Error(err, "failed to diff the brainery index")
+		return nil, err
+	}
+	var logsToIndex []model.BraineryLog
+	for _, b := range logs {
+		if missing[b.ID.String()] {
+			logsToIndex = append(logsToIndex, *b)
+		}
+	}
+	if err := c.IndexBraineryLogs(logsToIndex); err != nil {
+		l.Error(err, "failed to index brainery logs")
+		return nil, err
+	}
+
+	return []model.KnowledgeIndexResult{
+		memoResult,
+		{Source: model.KnowledgeSourceBrainery, Indexed: len(logsToIndex), Removed: len(removedLogs)},
+	}, nil
+}
+
+// diff returns the source IDs missing from the index, and removes the documents of the sources
+// not there anymore
+func (c *controller) diff(db *gorm.DB, source model.KnowledgeSource, sourceIDs []string) (map[string]bool, []string, error) {
+	indexed, err := c.store.KnowledgeDocument.GetSourceIDs(db, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missing := make(map[string]bool, len(sourceIDs))
+	for _, id := range sourceIDs {
+		missing[id] = true
+	}
+
+	var removed []string
+	for _, id := range indexed {
+		if !missing[id] {
+			removed = append(removed, id)
+		}
+		delete(missing, id)
+	}
+
+	return missing, removed, c.store.KnowledgeDocument.DeleteBySourceIDs(db, source, removed)
+}
+
+func (c *controller) discordAccounts(db *gorm.DB) (map[string]*model.DiscordAccount, error) {
+	accounts, err := c.store.DiscordAccount.All(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make(map[string]*model.DiscordAccount, len(accounts))
+	for _, a := range accounts {
+		rs[a.ID.String()] = a
+	}
+	return rs, nil
+}
+
+// normalize lowercases and trims the values, dropping the empty and duplicate ones
+func normalize(values []string) model.JSONArrayString {
+	rs := make(model.JSONArrayString, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		v = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(v), "@"))
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		rs = append(rs, v)
+	}
+	return rs
+}
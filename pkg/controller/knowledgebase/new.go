@@ -0,0 +1,40 @@
+package knowledgebase
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Search(input SearchInput, pagination model.Pagination) ([]model.KnowledgeSearchResult, int64, error)
+	Reindex() ([]model.KnowledgeIndexResult, error)
+
+	// The sources index what they sync as they sync it
+	IndexMemos(memos []model.MemoLog, contents map[string]string) error
+	IndexBraineryLogs(logs []model.BraineryLog) error
+	IndexOGIF(broadcastID, title, url, content string, publishedAt time.Time) error
+	Remove(source model.KnowledgeSource, sourceIDs ...string) error
+}
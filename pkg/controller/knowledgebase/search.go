@@ -0,0 +1,55 @@
+package knowledgebase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/knowledgedocument"
+)
+
+// SearchInput is a web search query, quoted phrases, OR and - to exclude a word, over the
+// documents filtered by source, author, tag and publish date
+type SearchInput struct {
+	Keyword string
+	Source  model.KnowledgeSource
+	Author  string // a GitHub, Discord or memo username
+	Tag     string
+	From    *time.Time
+	To      *time.Time
+}
+
+func (c *controller) Search(input SearchInput, pagination model.Pagination) ([]model.KnowledgeSearchResult, int64, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "knowledgebase",
+		"method":     "Search",
+		"input":      input,
+	})
+
+	input.Keyword = strings.TrimSpace(input.Keyword)
+	if input.Keyword == "" {
+		return nil, 0, ErrEmptyKeyword
+	}
+	if input.Source != "" && !input.Source.IsValid() {
+		return nil, 0, ErrInvalidSource
+	}
+	if input.From != nil && input.To != nil && input.From.After(*input.To) {
+		return nil, 0, ErrInvalidDateRange
+	}
+
+	results, total, err := c.store.KnowledgeDocument.Search(c.repo.DB(), knowledgedocument.Query{
+		Keyword: input.Keyword,
+		Source:  input.Source,
+		Author:  strings.TrimPrefix(strings.TrimSpace(input.Author), "@"),
+		Tag:     strings.TrimPrefix(strings.TrimSpace(input.Tag), "#"),
+		From:    input.From,
+		To:      input.To,
+	}, pagination)
+	if err != nil {
+		l.Error(err, "failed to search knowledge documents")
+		return nil, 0, err
+	}
+
+	return results, total, nil
+}
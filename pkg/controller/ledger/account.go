@@ -0,0 +1,81 @@
+package ledger
+
+import (
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type AccountInput struct {
+	Code        string
+	Name        string
+	Type        model.LedgerAccountType
+	Description string
+}
+
+type UpdateAccountInput struct {
+	Name        string
+	Description string
+	IsActive    *bool
+}
+
+func (r *controller) ListAccounts(activeOnly bool) ([]model.LedgerAccount, error) {
+	return r.store.LedgerAccount.All(r.repo.DB(), activeOnly)
+}
+
+func (r *controller) CreateAccount(input AccountInput) (*model.LedgerAccount, error) {
+	if !input.Type.IsValid() {
+		return nil, ErrInvalidAccountType
+	}
+
+	code := strings.TrimSpace(input.Code)
+	existing, err := r.store.LedgerAccount.GetByCodes(r.repo.DB(), []string{code})
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, ErrAccountCodeExists
+	}
+
+	return r.store.LedgerAccount.Create(r.repo.DB(), &model.LedgerAccount{
+		Code:        code,
+		Name:        strings.TrimSpace(input.Name),
+		Type:        input.Type,
+		Description: input.Description,
+		IsActive:    true,
+	})
+}
+
+// UpdateAccount updates the name, description and status of an account. The code and
+// type are kept since the postings already made depend on them.
+func (r *controller) UpdateAccount(id string, input UpdateAccountInput) (*model.LedgerAccount, error) {
+	account, err := r.store.LedgerAccount.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, err
+	}
+
+	fields := make([]string, 0, 3)
+	if input.Name != "" {
+		account.Name = strings.TrimSpace(input.Name)
+		fields = append(fields, "name")
+	}
+	if input.Description != "" {
+		account.Description = input.Description
+		fields = append(fields, "description")
+	}
+	if input.IsActive != nil {
+		account.IsActive = *input.IsActive
+		fields = append(fields, "is_active")
+	}
+	if len(fields) == 0 {
+		return account, nil
+	}
+
+	return r.store.LedgerAccount.UpdateSelectedFieldsByID(r.repo.DB(), id, *account, fields...)
+}
@@ -0,0 +1,212 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/journalentry"
+)
+
+type ListEntriesInput struct {
+	SourceType model.JournalSource
+	AccountID  string
+	From       *time.Time
+	To         *time.Time
+}
+
+type LineInput struct {
+	AccountID model.UUID
+	Debit     int64
+	Credit    int64
+	Memo      string
+}
+
+type ManualEntryInput struct {
+	Date        time.Time
+	Description string
+	Lines       []LineInput
+	CreatedBy   *model.UUID
+}
+
+type ReverseEntryInput struct {
+	Date        time.Time
+	Description string
+	CreatedBy   *model.UUID
+}
+
+func (r *controller) ListEntries(input ListEntriesInput, pagination model.Pagination) ([]*model.JournalEntry, int64, error) {
+	return r.store.JournalEntry.All(r.repo.DB(), journalentry.Query{
+		SourceType: input.SourceType,
+		AccountID:  input.AccountID,
+		From:       input.From,
+		To:         input.To,
+	}, pagination)
+}
+
+func (r *controller) GetEntry(id string) (*model.JournalEntry, error) {
+	entry, err := r.store.JournalEntry.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEntryNotFound
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// CreateManualEntry posts an adjustment entered by the accountant, every line must
+// reference an active account
+func (r *controller) CreateManualEntry(input ManualEntryInput) (*model.JournalEntry, error) {
+	entry := &model.JournalEntry{
+		Date:        input.Date,
+		Description: input.Description,
+		SourceType:  model.JournalSourceManual,
+		CreatedBy:   input.CreatedBy,
+		Lines:       make([]model.JournalLine, 0, len(input.Lines)),
+	}
+
+	checked := map[model.UUID]bool{}
+	for _, l := range input.Lines {
+		if !checked[l.AccountID] {
+			if err := r.checkAccount(l.AccountID); err != nil {
+				return nil, err
+			}
+			checked[l.AccountID] = true
+		}
+
+		entry.Lines = append(entry.Lines, model.JournalLine{
+			AccountID: l.AccountID,
+			Debit:     l.Debit,
+			Credit:    l.Credit,
+			Currency:  "VND",
+			Memo:      l.Memo,
+		})
+	}
+
+	created, err := ledger.New(r.store).Post(r.repo.DB(), entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetEntry(created.ID.String())
+}
+
+func (r *controller) checkAccount(id model.UUID) error {
+	account, err := r.store.LedgerAccount.One(r.repo.DB(), id.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrAccountNotFound
+		}
+		return err
+	}
+	if !account.IsActive {
+		return fmt.Errorf("%w: %s", ErrAccountInactive, account.Code)
+	}
+	return nil
+}
+
+// ReverseEntry posts the entry cancelling a posted one, entries are never edited nor deleted
+func (r *controller) ReverseEntry(id string, input ReverseEntryInput) (*model.JournalEntry, error) {
+	entry, err := r.GetEntry(id)
+	if err != nil {
+		return nil, err
+	}
+
+	description := input.Description
+	if description == "" {
+		description = "Reversal - " + entry.Description
+	}
+
+	reversal, err := ledger.New(r.store).Reverse(r.repo.DB(), entry, input.Date, description, input.CreatedBy)
+	if err != nil {
+		if errors.Is(err, ledger.ErrAlreadyReversed) {
+			return nil, ErrEntryAlreadyReversed
+		}
+		return nil, err
+	}
+
+	return r.GetEntry(reversal.ID.String())
+}
+
+// PostIcyTransactions posts the ICY rewards valued at the ICY rate of the config, in VND at the
+// USD rate of their day in the fx rate history. A transaction failing to post is skipped and
+// left to PostUnposted. It returns the number of entries posted.
+func (r *controller) PostIcyTransactions(transactions []model.IcyTransaction) (int, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "ledger",
+		"method":     "PostIcyTransactions",
+	})
+
+	poster := ledger.New(r.store)
+	usdVND := make(map[string]float64)
+	posted := 0
+	var lastErr error
+	for i := range transactions {
+		t := &transactions[i]
+		day := t.TxnTime.Format("2006-01-02")
+		if _, ok := usdVND[day]; !ok {
+			rate, err := r.fxRate.GetRateAt("USD", "VND", t.TxnTime)
+			if err != nil {
+				l.AddField("date", day).Error(err, "failed to get USD to VND rate")
+				lastErr = err
+				continue
+			}
+			usdVND[day] = rate.Rate
+		}
+
+		n, err := poster.PostIcyTransactions(r.repo.DB(), []model.IcyTransaction{*t}, r.config.Ledger.IcyUSDRate*usdVND[day])
+		if err != nil {
+			l.AddField("transactionID", t.ID).Error(err, "failed to post icy transaction")
+			lastErr = err
+			continue
+		}
+		posted += n
+	}
+
+	return posted, lastErr
+}
+
+// PostUnposted posts the accounting and ICY transactions recorded before the ledger existed,
+// or missed by the automatic postings. It returns the number of entries posted.
+func (r *controller) PostUnposted(limit int) (int, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "ledger",
+		"method":     "PostUnposted",
+	})
+
+	transactions, err := r.store.Accounting.GetUnpostedTransactions(r.repo.DB(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	poster := ledger.New(r.store)
+	posted := 0
+	for i := range transactions {
+		t := &transactions[i]
+		entry, err := poster.PostAccountingTransaction(r.repo.DB(), ledger.SourceOf(t), t)
+		if err != nil {
+			l.AddField("transactionID", t.ID).Error(err, "failed to post accounting transaction")
+			continue
+		}
+		if entry != nil {
+			posted++
+		}
+	}
+
+	icyTransactions, err := r.store.IcyTransaction.GetUnposted(r.repo.DB(), limit)
+	if err != nil {
+		return posted, err
+	}
+	n, err := r.PostIcyTransactions(icyTransactions)
+	if err != nil {
+		l.Error(err, "failed to post some icy transactions")
+	}
+
+	return posted + n, nil
+}
@@ -0,0 +1,114 @@
+package ledger
+
+import (
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+const (
+	oct5MorningTx   = "8c9d0e1f-2a3b-4c4d-8e5f-6a7b8c9d0e1f"
+	oct5EveningTx   = "9d0e1f2a-3b4c-4d5e-9f6a-7b8c9d0e1f2a"
+	oct6Tx          = "0e1f2a3b-4c5d-4e6f-8a7b-8c9d0e1f2a3b"
+	withoutRateTx   = "1f2a3b4c-5d6e-4f7a-9b8c-9d0e1f2a3b4c"
+	unpostedTxLimit = 100
+)
+
+func newTestController(txRepo store.DBRepo) IController {
+	cfg := config.LoadTestConfig()
+	cfg.Ledger.IcyUSDRate = 1.5
+	cfg.FXRate.LookbackDays = 7
+	loggerMock := logger.NewLogrusLogger("info")
+	storeMock := store.New()
+
+	return New(storeMock, txRepo, nil, fxrate.New(storeMock, txRepo, nil, loggerMock, &cfg), loggerMock, &cfg)
+}
+
+// requireExpense checks the ICY reward expense of the entry posted for the transaction, in VND
+func requireExpense(t *testing.T, txRepo store.DBRepo, transactionID string, want int64) {
+	entry, err := store.New().JournalEntry.GetBySource(txRepo.DB(), model.JournalSourceIcy, transactionID)
+	require.NoError(t, err)
+	for _, line := range entry.Lines {
+		if line.Debit > 0 {
+			require.Equal(t, want, line.Debit)
+			return
+		}
+	}
+	t.Fatalf("no debit line in the entry of %s", transactionID)
+}
+
+func TestController_PostIcyTransactions(t *testing.T) {
+	storeMock := store.New()
+
+	t.Run("value_the_rewards_at_the_usd_rate_of_their_day", func(t *testing.T) {
+		testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+			testhelper.LoadTestSQLFile(t, txRepo, "./testdata/post_icy/post_icy.sql")
+			txs, err := storeMock.IcyTransaction.GetUnposted(txRepo.DB(), unpostedTxLimit)
+			require.NoError(t, err)
+
+			// the rewards of a day without rate are left to the backfill
+			posted, err := newTestController(txRepo).PostIcyTransactions(txs)
+			require.ErrorIs(t, err, fxrate.ErrRateNotFound)
+			require.Equal(t, 3, posted)
+			requireExpense(t, txRepo, oct5MorningTx, 375000)
+			requireExpense(t, txRepo, oct5EveningTx, 750000)
+			requireExpense(t, txRepo, oct6Tx, 390000)
+
+			_, err = storeMock.JournalEntry.GetBySource(txRepo.DB(), model.JournalSourceIcy, withoutRateTx)
+			require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		})
+	})
+
+	t.Run("post_a_reward_once", func(t *testing.T) {
+		testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+			testhelper.LoadTestSQLFile(t, txRepo, "./testdata/post_icy/post_icy.sql")
+			txs, err := storeMock.IcyTransaction.GetUnposted(txRepo.DB(), unpostedTxLimit)
+			require.NoError(t, err)
+			c := newTestController(txRepo)
+
+			var tx model.IcyTransaction
+			for _, it := range txs {
+				if it.ID.String() == oct6Tx {
+					tx = it
+				}
+			}
+			for i := 0; i < 2; i++ {
+				posted, err := c.PostIcyTransactions([]model.IcyTransaction{tx})
+				require.NoError(t, err)
+				require.Equal(t, 1, posted)
+			}
+
+			var count int64
+			require.NoError(t, txRepo.DB().Model(&model.JournalEntry{}).Where("source_type = ? AND source_id = ?", model.JournalSourceIcy, oct6Tx).Count(&count).Error)
+			require.Equal(t, int64(1), count)
+		})
+	})
+}
+
+func TestController_PostUnposted(t *testing.T) {
+	storeMock := store.New()
+
+	testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+		testhelper.LoadTestSQLFile(t, txRepo, "./testdata/post_icy/post_icy.sql")
+
+		posted, err := newTestController(txRepo).PostUnposted(unpostedTxLimit)
+		require.NoError(t, err)
+		require.Equal(t, 3, posted)
+		requireExpense(t, txRepo, oct5MorningTx, 375000)
+
+		// the reward without rate is still unposted
+		txs, err := storeMock.IcyTransaction.GetUnposted(txRepo.DB(), unpostedTxLimit)
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		require.Equal(t, withoutRateTx, txs[0].ID.String())
+	})
+}
@@ -0,0 +1,13 @@
+package ledger
+
+import "errors"
+
+var (
+	ErrAccountNotFound      = errors.New("ledger account not found")
+	ErrAccountCodeExists    = errors.New("ledger account code already exists")
+	ErrAccountInactive      = errors.New("ledger account is inactive")
+	ErrInvalidAccountType   = errors.New("invalid ledger account type")
+	ErrEntryNotFound        = errors.New("journal entry not found")
+	ErrEntryAlreadyReversed = errors.New("journal entry is already reversed")
+	ErrInvalidDateRange     = errors.New("invalid date range")
+)
@@ -0,0 +1,49 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	fxRate  fxrate.IController
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+// New returns the ledger controller, the ICY rewards are valued at the rates of the fx rate controller
+func New(store *store.Store, repo store.DBRepo, service *service.Service, fxRate fxrate.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		fxRate:  fxRate,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	ListAccounts(activeOnly bool) ([]model.LedgerAccount, error)
+	CreateAccount(input AccountInput) (*model.LedgerAccount, error)
+	UpdateAccount(id string, input UpdateAccountInput) (*model.LedgerAccount, error)
+
+	ListEntries(input ListEntriesInput, pagination model.Pagination) ([]*model.JournalEntry, int64, error)
+	GetEntry(id string) (*model.JournalEntry, error)
+	CreateManualEntry(input ManualEntryInput) (*model.JournalEntry, error)
+	ReverseEntry(id string, input ReverseEntryInput) (*model.JournalEntry, error)
+	PostIcyTransactions(transactions []model.IcyTransaction) (int, error)
+	PostUnposted(limit int) (int, error)
+
+	TrialBalance(asOf time.Time) (*model.TrialBalance, error)
+	ProfitAndLoss(from, to time.Time) (*model.ProfitAndLoss, error)
+}
@@ -0,0 +1,27 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func (r *controller) TrialBalance(asOf time.Time) (*model.TrialBalance, error) {
+	balances, err := r.store.JournalEntry.SumByAccount(r.repo.DB(), nil, &asOf)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewTrialBalance(asOf, balances), nil
+}
+
+func (r *controller) ProfitAndLoss(from, to time.Time) (*model.ProfitAndLoss, error) {
+	if to.Before(from) {
+		return nil, ErrInvalidDateRange
+	}
+
+	balances, err := r.store.JournalEntry.SumByAccount(r.repo.DB(), &from, &to)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewProfitAndLoss(from, to, balances), nil
+}
@@ -0,0 +1,9 @@
+INSERT INTO public.fx_rates (id, deleted_at, created_at, updated_at, date, source_currency, target_currency, rate, source, note, created_by) VALUES
+('6a7b8c9d-0e1f-4a2b-8c3d-4e5f6a7b8c9d', NULL, '2026-10-05 00:00:00', '2026-10-05 00:00:00', '2026-10-05', 'USD', 'VND', 25000, 'manual', NULL, NULL),
+('7b8c9d0e-1f2a-4b3c-9d4e-5f6a7b8c9d0e', NULL, '2026-10-06 00:00:00', '2026-10-06 00:00:00', '2026-10-06', 'USD', 'VND', 26000, 'manual', NULL, NULL);
+
+INSERT INTO public.icy_transactions (id, created_at, updated_at, deleted_at, txn_time, src_employee_id, dest_employee_id, category, amount, note, sender, target) VALUES
+('8c9d0e1f-2a3b-4c4d-8e5f-6a7b8c9d0e1f', '2026-10-05 09:00:00', '2026-10-05 09:00:00', NULL, '2026-10-05 09:00:00+00', NULL, 'f7c6016b-85b5-47f7-8027-23c2db482197', 'learning', 10, NULL, 'treasury', 'ducnv'),
+('9d0e1f2a-3b4c-4d5e-9f6a-7b8c9d0e1f2a', '2026-10-05 17:00:00', '2026-10-05 17:00:00', NULL, '2026-10-05 17:00:00+00', NULL, 'f7c6016b-85b5-47f7-8027-23c2db482197', 'community', 20, NULL, 'treasury', 'ducnv'),
+('0e1f2a3b-4c5d-4e6f-8a7b-8c9d0e1f2a3b', '2026-10-06 09:00:00', '2026-10-06 09:00:00', NULL, '2026-10-06 09:00:00+00', NULL, 'f7c6016b-85b5-47f7-8027-23c2db482197', 'delivery', 10, NULL, 'treasury', 'ducnv'),
+('1f2a3b4c-5d6e-4f7a-9b8c-9d0e1f2a3b4c', '2026-09-01 09:00:00', '2026-09-01 09:00:00', NULL, '2026-09-01 09:00:00+00', NULL, 'f7c6016b-85b5-47f7-8027-23c2db482197', 'tooling', 10, NULL, 'treasury', 'ducnv');
@@ -0,0 +1,7 @@
+package memologs
+
+import "errors"
+
+var (
+	ErrCloneMemoRepo = errors.New("failed to clone the memo repository")
+)
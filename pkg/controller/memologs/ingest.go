@@ -0,0 +1,427 @@
+package memologs
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/memo"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service/git"
+	"github.com/dwarvesf/fortress-api/pkg/store/memolog"
+)
+
+const (
+	memoRepoUsername = "x-access-token"
+	memoFileExt      = ".md"
+
+	// memoRewardWindow is how long after being published a memo is rewarded, for the authors
+	// linking their GitHub account late
+	memoRewardWindow = 30 * 24 * time.Hour
+)
+
+// Ingest reads the memos changed in the memo repository since the previous ingestion, the whole
+// repository on the first one. The authors of the front matter are resolved to Discord accounts
+// through the GitHub social accounts of the employees; the memos with unresolved authors are
+// resolved again on every ingestion.
+func (c *controller) Ingest() (*model.MemoIngestion, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "memologs",
+		"method":     "Ingest",
+	})
+
+	db := c.repo.DB()
+	cfg := c.config.MemoRepo
+
+	repo := git.New(cfg.URL, memoRepoUsername, c.config.Github.Token)
+	if repo == nil {
+		return nil, ErrCloneMemoRepo
+	}
+	defer os.RemoveAll(repo.Dest())
+
+	head, err := repo.HeadCommit()
+	if err != nil {
+		l.Errorf(err, "failed to get the head commit of the memo repository")
+		return nil, err
+	}
+
+	ingestion := &model.MemoIngestion{CommitSHA: head}
+	latest, err := c.store.MemoIngestion.Latest(db)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Errorf(err, "failed to get the latest memo ingestion")
+		return nil, err
+	}
+	if err == nil {
+		ingestion.PreviousCommitSHA = latest.CommitSHA
+	}
+
+	changes, err := repo.Changes(ingestion.PreviousCommitSHA, cfg.ContentDir, memoFileExt)
+	if errors.Is(err, git.ErrCommitNotFound) {
+		// the history is rewritten, fall back to reading the whole repository
+		l.Warnf("commit %s of the previous ingestion not found, scanning the whole memo repository", ingestion.PreviousCommitSHA)
+		changes, err = repo.Changes("", cfg.ContentDir, memoFileExt)
+		ingestion.IsFullScan = true
+	}
+	if err != nil {
+		l.Errorf(err, "failed to list the changes of the memo repository")
+		return nil, err
+	}
+	if ingestion.PreviousCommitSHA == "" {
+		ingestion.IsFullScan = true
+	}
+
+	authors, err := c.memoAuthors(db)
+	if err != nil {
+		l.Errorf(err, "failed to get the GitHub accounts of the memo authors")
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, change := range changes {
+		var err error
+		switch change.Action {
+		case git.ChangeActionDeleted:
+			err = c.deleteMemo(db, change.Path, ingestion)
+		default:
+			seen[change.Path] = true
+			err = c.ingestMemo(db, repo, change, authors, ingestion)
+		}
+		if err != nil {
+			l.AddField("path", change.Path).Errorf(err, "failed to ingest memo")
+			return nil, err
+		}
+	}
+
+	if ingestion.IsFullScan {
+		if err := c.deleteUnseenMemos(db, seen, ingestion); err != nil {
+			l.Errorf(err, "failed to delete the memos removed from the memo repository")
+			return nil, err
+		}
+	}
+
+	if err := c.resolveUnresolvedMemos(db, authors, ingestion); err != nil {
+		l.Errorf(err, "failed to resolve the memo authors")
+		return nil, err
+	}
+
+	if _, err := c.store.MemoIngestion.Create(db, ingestion); err != nil {
+		l.Errorf(err, "failed to create memo ingestion")
+		return nil, err
+	}
+
+	return ingestion, nil
+}
+
+// ingestMemo creates or updates the memo of an added, modified or renamed file. A memo published
+// from the RSS feed before is adopted by its URL. Drafts are kept deleted until published.
+func (c *controller) ingestMemo(db *gorm.DB, repo git.IService, change git.FileChange, authors memoAuthors, ingestion *model.MemoIngestion) error {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "memologs",
+		"method":     "ingestMemo",
+		"path":       change.Path,
+	})
+
+	content, err := repo.ReadFile(change.Path)
+	if err != nil {
+		return err
+	}
+	m, err := memo.Parse(change.Path, content, c.config.MemoRepo.ContentDir, c.config.MemoRepo.SiteURL)
+	if err != nil {
+		l.Warnf("skipping memo: %v", err)
+		ingestion.Skipped++
+		return nil
+	}
+
+	existing, err := c.existingMemo(db, change, m.URL)
+	if err != nil {
+		return err
+	}
+
+	discordAccountIDs, unresolved := authors.resolve(m.Authors)
+	now := time.Now()
+	log := model.MemoLog{
+		Title:             m.Title,
+		URL:               m.URL,
+		Description:       m.Description,
+		Tags:              m.Tags,
+		Category:          m.Category,
+		PublishedAt:       m.Date,
+		Source:            model.MemoLogSourceGit,
+		FilePath:          m.Path,
+		ContentHash:       m.Hash,
+		IsDraft:           m.Draft,
+		AuthorHandles:     m.Authors,
+		UnresolvedAuthors: unresolved,
+		DiscordAccountIDs: discordAccountIDs,
+	}
+	if m.Draft {
+		ingestion.Drafts++
+		log.DeletedAt = &gorm.DeletedAt{Time: now, Valid: true}
+	}
+
+	if existing == nil {
+		if log.PublishedAt == nil {
+			log.PublishedAt = &now
+		}
+		created, err := c.store.MemoLog.Create(db, []model.MemoLog{log})
+		if err != nil {
+			return err
+		}
+		if !m.Draft {
+			ingestion.Created++
+			c.rewardMemo(created[0], authors, ingestion)
+		}
+		return c.index(created[0], m)
+	}
+
+	wasDeleted := existing.DeletedAt != nil && existing.DeletedAt.Valid
+	renamed := existing.FilePath != "" && existing.FilePath != m.Path
+	edited := existing.ContentHash != "" && existing.ContentHash != m.Hash
+	if !renamed && !edited && existing.ContentHash != "" && wasDeleted == m.Draft {
+		return nil
+	}
+
+	if log.PublishedAt == nil {
+		log.PublishedAt = existing.PublishedAt
+	}
+	if len(m.Authors) == 0 {
+		// without authors in the front matter, keep the ones the memo has
+		log.DiscordAccountIDs = existing.DiscordAccountIDs
+	}
+	log.EditedAt = existing.EditedAt
+	if edited {
+		log.EditedAt = &now
+	}
+
+	if _, err := c.store.MemoLog.UpdateSelectedFieldsByID(db, existing.ID.String(), log,
+		"title", "url", "description", "tags", "category", "published_at", "source", "file_path",
+		"content_hash", "is_draft", "author_handles", "unresolved_authors", "discord_account_ids",
+		"edited_at", "deleted_at"); err != nil {
+		return err
+	}
+
+	switch {
+	case renamed:
+		ingestion.Renamed++
+	case edited || existing.ContentHash == "" || wasDeleted != m.Draft:
+		ingestion.Updated++
+	}
+
+	log.ID = existing.ID
+	if wasDeleted && !m.Draft {
+		c.rewardMemo(log, authors, ingestion)
+	}
+	return c.index(log, m)
+}
+
+// rewardMemo rewards the resolved authors of a published memo. The memos of the first ingestion
+// and the ones published before memoRewardWindow are the history of the repository, they are
+// not rewarded.
+func (c *controller) rewardMemo(log model.MemoLog, authors memoAuthors, ingestion *model.MemoIngestion) {
+	if ingestion.PreviousCommitSHA == "" || log.PublishedAt == nil || time.Since(*log.PublishedAt) > memoRewardWindow {
+		return
+	}
+
+	for _, id := range log.DiscordAccountIDs {
+		employeeID, ok := authors.employees[id]
+		if !ok {
+			continue
+		}
+		_, _, err := c.icyReward.Trigger(icyreward.TriggerInput{
+			Trigger:    model.IcyRewardTriggerMemoPublished,
+			EmployeeID: employeeID,
+			Reference:  log.ID.String(),
+			OccurredAt: *log.PublishedAt,
+		})
+		if err != nil && !errors.Is(err, icyreward.ErrNoActiveRule) {
+			c.logger.Fields(logger.Fields{
+				"controller": "memologs",
+				"method":     "rewardMemo",
+				"memoID":     log.ID.String(),
+				"employeeID": employeeID,
+			}).Errorf(err, "failed to reward memo")
+		}
+	}
+}
+
+// index indexes the memo with its content for the knowledge base search, a draft is not searchable
+func (c *controller) index(log model.MemoLog, m *memo.Memo) error {
+	if m.Draft {
+		return c.knowledgeBase.Remove(model.KnowledgeSourceMemo, log.ID.String())
+	}
+	return c.knowledgeBase.IndexMemos([]model.MemoLog{log}, map[string]string{log.ID.String(): m.Body})
+}
+
+// existingMemo looks the memo of the file up by its path, the previous one for a rename, then by
+// its URL
+func (c *controller) existingMemo(db *gorm.DB, change git.FileChange, url string) (*model.MemoLog, error) {
+	paths := []string{change.Path}
+	if change.PreviousPath != "" {
+		paths = []string{change.PreviousPath, change.Path}
+	}
+	for _, p := range paths {
+		log, err := c.store.MemoLog.OneByFilePath(db, p)
+		if err == nil {
+			return log, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	// the memos from the RSS feed have a trailing slash in their URL
+	for _, u := range []string{url, url + "/"} {
+		log, err := c.store.MemoLog.OneByURL(db, u)
+		if err == nil {
+			return log, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *controller) deleteMemo(db *gorm.DB, path string, ingestion *model.MemoIngestion) error {
+	log, err := c.store.MemoLog.OneByFilePath(db, path)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if log.DeletedAt != nil && log.DeletedAt.Valid {
+		return nil
+	}
+
+	ingestion.Deleted++
+	if err := c.store.MemoLog.Delete(db, log.ID.String()); err != nil {
+		return err
+	}
+	return c.knowledgeBase.Remove(model.KnowledgeSourceMemo, log.ID.String())
+}
+
+// deleteUnseenMemos deletes the memos of the files a full scan does not find anymore
+func (c *controller) deleteUnseenMemos(db *gorm.DB, seen map[string]bool, ingestion *model.MemoIngestion) error {
+	logs, err := c.store.MemoLog.List(db, memolog.ListFilter{})
+	if err != nil {
+		return err
+	}
+
+	for _, log := range logs {
+		if log.Source != model.MemoLogSourceGit || log.FilePath == "" || seen[log.FilePath] {
+			continue
+		}
+		if err := c.store.MemoLog.Delete(db, log.ID.String()); err != nil {
+			return err
+		}
+		if err := c.knowledgeBase.Remove(model.KnowledgeSourceMemo, log.ID.String()); err != nil {
+			return err
+		}
+		ingestion.Deleted++
+	}
+
+	return nil
+}
+
+// resolveUnresolvedMemos resolves the authors of the memos again, for the GitHub accounts added
+// since, and collects the handles still unresolved
+func (c *controller) resolveUnresolvedMemos(db *gorm.DB, authors memoAuthors, ingestion *model.MemoIngestion) error {
+	logs, err := c.store.MemoLog.ListUnresolved(db)
+	if err != nil {
+		return err
+	}
+
+	handles := make(map[string]bool)
+	for _, log := range logs {
+		discordAccountIDs, unresolved := authors.resolve(log.AuthorHandles)
+		for _, h := range unresolved {
+			handles[h] = true
+		}
+		if len(unresolved) == len(log.UnresolvedAuthors) {
+			continue
+		}
+
+		if _, err := c.store.MemoLog.UpdateSelectedFieldsByID(db, log.ID.String(), model.MemoLog{
+			DiscordAccountIDs: discordAccountIDs,
+			UnresolvedAuthors: unresolved,
+		}, "discord_account_ids", "unresolved_authors"); err != nil {
+			return err
+		}
+
+		if !log.IsDraft {
+			log.DiscordAccountIDs = discordAccountIDs
+			c.rewardMemo(log, authors, ingestion)
+		}
+	}
+
+	ingestion.UnresolvedAuthors = make(model.JSONArrayString, 0, len(handles))
+	for h := range handles {
+		ingestion.UnresolvedAuthors = append(ingestion.UnresolvedAuthors, h)
+	}
+	sort.Strings(ingestion.UnresolvedAuthors)
+
+	return nil
+}
+
+// memoAuthors maps the lowercased GitHub handles of the employees to their Discord accounts, and
+// the Discord accounts back to the employees to reward
+type memoAuthors struct {
+	discordAccounts map[string]string
+	employees       map[string]string
+}
+
+func (c *controller) memoAuthors(db *gorm.DB) (memoAuthors, error) {
+	accounts, err := c.store.SocialAccount.GetByType(db, model.SocialAccountTypeGitHub.String())
+	if err != nil {
+		return memoAuthors{}, err
+	}
+
+	handles := make(map[string]string)
+	employeeIDs := make([]model.UUID, 0, len(accounts))
+	for _, a := range accounts {
+		if strings.TrimSpace(a.AccountID) == "" {
+			continue
+		}
+		handles[a.EmployeeID.String()] = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(a.AccountID), "@"))
+		employeeIDs = append(employeeIDs, a.EmployeeID)
+	}
+
+	employees, err := c.store.Employee.GetByIDs(db, employeeIDs)
+	if err != nil {
+		return memoAuthors{}, err
+	}
+
+	rs := memoAuthors{discordAccounts: make(map[string]string), employees: make(map[string]string)}
+	for _, e := range employees {
+		if e.DiscordAccountID.IsZero() {
+			continue
+		}
+		rs.discordAccounts[handles[e.ID.String()]] = e.DiscordAccountID.String()
+		rs.employees[e.DiscordAccountID.String()] = e.ID.String()
+	}
+
+	return rs, nil
+}
+
+// resolve returns the Discord accounts of the handles, and the handles without one
+func (a memoAuthors) resolve(handles []string) (model.JSONArrayString, model.JSONArrayString) {
+	ids := make(model.JSONArrayString, 0, len(handles))
+	unresolved := make(model.JSONArrayString, 0)
+	for _, h := range handles {
+		id, ok := a.discordAccounts[strings.ToLower(h)]
+		if !ok {
+			unresolved = append(unresolved, h)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, unresolved
+}
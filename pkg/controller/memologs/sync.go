@@ -35,7 +35,8 @@ func (c *controller) Sync() ([]model.MemoLog, error) {
 
 	latestMemosMap := make(map[string]model.MemoLog)
 	for _, memo := range latestMemos {
-		latestMemosMap[memo.URL] = memo
+		// the memos ingested from the memo repository have no trailing slash in their URL
+		latestMemosMap[strings.TrimSuffix(memo.URL, "/")] = memo
 	}
 
 	resp, err := http.Get(dfMemoRssURL)
@@ -73,7 +74,7 @@ func (c *controller) Sync() ([]model.MemoLog, error) {
 					continue
 				}
 
-				if _, ok := latestMemosMap[item.Link]; ok {
+				if _, ok := latestMemosMap[strings.TrimSuffix(item.Link, "/")]; ok {
 					continue
 				}
 
@@ -183,6 +184,10 @@ func (c *controller) Sync() ([]model.MemoLog, error) {
 		return nil, err
 	}
 
+	if err := c.knowledgeBase.IndexMemos(results, nil); err != nil {
+		l.Errorf(err, "failed to index new memos")
+	}
+
 	return results, nil
 }
 
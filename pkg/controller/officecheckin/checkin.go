@@ -0,0 +1,219 @@
+package officecheckin
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/officecheckin"
+	checkinstore "github.com/dwarvesf/fortress-api/pkg/store/officecheckin"
+)
+
+// ScanInput is a scanned QR code with the position of the device scanning it
+type ScanInput struct {
+	Code      string
+	Latitude  *float64
+	Longitude *float64
+}
+
+type ListInput struct {
+	EmployeeID       string
+	OfficeLocationID string
+	From             *time.Time
+	To               *time.Time
+}
+
+// scan is a verified scan of the QR code of an office
+type scan struct {
+	location *model.OfficeLocation
+	distance *float64
+}
+
+// CheckIn records the arrival of the employee at the office of the code, once a day. The code
+// must be the current one of the office and the position within its geofence.
+func (r *controller) CheckIn(employeeID string, input ScanInput, now time.Time) (*model.OfficeCheckin, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "officecheckin",
+		"method":     "CheckIn",
+		"employeeID": employeeID,
+	})
+
+	s, err := r.verify(input, now)
+	if err != nil {
+		return nil, err
+	}
+
+	date := day(now)
+	_, err = r.store.OfficeCheckin.OneByDate(r.repo.DB(), employeeID, date)
+	if err == nil {
+		return nil, ErrAlreadyCheckedIn
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	checkin := &model.OfficeCheckin{
+		BaseModel:        model.BaseModel{ID: model.NewUUID()},
+		OfficeLocationID: s.location.ID,
+		Date:             date,
+		CheckedInAt:      now,
+		CheckInLatitude:  input.Latitude,
+		CheckInLongitude: input.Longitude,
+		CheckInDistance:  s.distance,
+	}
+	checkin.EmployeeID, _ = model.UUIDFromString(employeeID)
+	if _, err := r.store.OfficeCheckin.Create(r.repo.DB(), checkin); err != nil {
+		return nil, err
+	}
+
+	reward, _, err := r.icyReward.Trigger(icyreward.TriggerInput{
+		Trigger:    model.IcyRewardTriggerOfficeCheckIn,
+		EmployeeID: employeeID,
+		Reference:  date.Format("2006-01-02"),
+		OccurredAt: now,
+	})
+	if err != nil && !errors.Is(err, icyreward.ErrNoActiveRule) {
+		l.Error(err, "failed to reward office check-in")
+	}
+
+	rs, err := r.store.OfficeCheckin.One(r.repo.DB(), checkin.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	rs.Reward = reward
+	return rs, nil
+}
+
+// CheckOut records the leave of the employee from the office checked in at today
+func (r *controller) CheckOut(employeeID string, input ScanInput, now time.Time) (*model.OfficeCheckin, error) {
+	checkin, err := r.store.OfficeCheckin.OneByDate(r.repo.DB(), employeeID, day(now))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotCheckedIn
+		}
+		return nil, err
+	}
+	if checkin.CheckedOutAt != nil {
+		return nil, ErrAlreadyCheckedOut
+	}
+
+	s, err := r.verify(input, now)
+	if err != nil {
+		return nil, err
+	}
+	if s.location.ID != checkin.OfficeLocationID {
+		return nil, ErrCheckOutOtherOffice
+	}
+
+	_, err = r.store.OfficeCheckin.UpdateSelectedFieldsByID(r.repo.DB(), checkin.ID.String(), model.OfficeCheckin{
+		CheckedOutAt:      &now,
+		CheckOutLatitude:  input.Latitude,
+		CheckOutLongitude: input.Longitude,
+		CheckOutDistance:  s.distance,
+	}, "checked_out_at", "check_out_latitude", "check_out_longitude", "check_out_distance")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.store.OfficeCheckin.One(r.repo.DB(), checkin.ID.String())
+}
+
+func (r *controller) List(input ListInput, pagination model.Pagination) ([]*model.OfficeCheckin, int64, error) {
+	return r.store.OfficeCheckin.All(r.repo.DB(), checkinstore.Query{
+		EmployeeID:       input.EmployeeID,
+		OfficeLocationID: input.OfficeLocationID,
+		From:             input.From,
+		To:               input.To,
+	}, pagination)
+}
+
+// Attendance reports the days each employee checked in at the offices over the month, with the
+// office perks it earns them
+func (r *controller) Attendance(month time.Time, officeLocationID string) (*model.OfficeAttendanceReport, error) {
+	if officeLocationID != "" {
+		if _, err := r.GetLocation(officeLocationID); err != nil {
+			return nil, err
+		}
+	}
+
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	attendances, err := r.store.OfficeCheckin.GetAttendances(r.repo.DB(), from, from.AddDate(0, 1, 0), officeLocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &model.OfficeAttendanceReport{
+		Year:         from.Year(),
+		Month:        int(from.Month()),
+		PerkPerDay:   decimal.NewFromFloat(r.config.CheckIn.PerkPerDay),
+		PerkCurrency: r.config.CheckIn.PerkCurrency,
+		Perk:         decimal.Zero,
+		Employees:    attendances,
+	}
+	for i := range report.Employees {
+		a := &report.Employees[i]
+		a.Hours = math.Round(a.Hours*100) / 100
+		a.Perk = report.PerkPerDay.Mul(decimal.NewFromInt(a.Days))
+		report.Days += a.Days
+		report.Perk = report.Perk.Add(a.Perk)
+	}
+
+	return report, nil
+}
+
+// verify checks the code is the current one of an active office and the position is within its
+// geofence, the distance to the office is returned whenever both positions are known
+func (r *controller) verify(input ScanInput, now time.Time) (*scan, error) {
+	if (input.Latitude == nil) != (input.Longitude == nil) {
+		return nil, ErrInvalidPosition
+	}
+
+	// the office of the code is not verified until its signature is checked with the secret of
+	// the office: a code of an office which is not one is invalid, not looked up as is
+	officeID, err := officecheckin.OfficeID(input.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !model.IsUUIDFromString(officeID) {
+		return nil, officecheckin.ErrInvalidCode
+	}
+	location, err := r.GetLocation(officeID)
+	if err != nil {
+		if errors.Is(err, ErrLocationNotFound) {
+			return nil, officecheckin.ErrInvalidCode
+		}
+		return nil, err
+	}
+	if err := officecheckin.Verify([]byte(location.QRSecret), location.ID.String(), input.Code, now, rotation(location)); err != nil {
+		return nil, err
+	}
+	if !location.IsActive {
+		return nil, ErrLocationInactive
+	}
+
+	s := &scan{location: location}
+	if input.Latitude != nil && location.Latitude != nil && location.Longitude != nil {
+		d := math.Round(officecheckin.Distance(*location.Latitude, *location.Longitude, *input.Latitude, *input.Longitude))
+		s.distance = &d
+	}
+	if location.HasGeofence() {
+		if s.distance == nil {
+			return nil, ErrPositionRequired
+		}
+		if *s.distance > float64(location.GeofenceRadius) {
+			return nil, ErrOutsideGeofence
+		}
+	}
+
+	return s, nil
+}
+
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,168 @@
+package officecheckin
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/officecheckin"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+const (
+	officeID       = "3c9d1e2f-7a4b-4c5d-8e6f-0a1b2c3d4e5f"
+	formerOfficeID = "4d0e2f3a-8b5c-4d6e-9f7a-1b2c3d4e5f6a"
+
+	employeeID  = "f7c6016b-85b5-47f7-8027-23c2db482197"
+	checkedInID = "dcfee24b-306d-4609-9c24-a4021639a11b"
+)
+
+// fakeReward records the events triggered, without rule to reward them
+type fakeReward struct {
+	icyreward.IController
+	triggered []icyreward.TriggerInput
+}
+
+func (f *fakeReward) Trigger(input icyreward.TriggerInput) (*model.IcyRewardPayout, bool, error) {
+	f.triggered = append(f.triggered, input)
+	return nil, false, icyreward.ErrNoActiveRule
+}
+
+func ptr(f float64) *float64 {
+	return &f
+}
+
+func TestController_CheckIn(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger("info")
+	storeMock := store.New()
+
+	now := time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)
+	latitude, longitude := ptr(10.7769), ptr(106.7009)
+	rotation := time.Minute
+
+	code := func(t *testing.T, secret, officeID string, at time.Time) string {
+		c, _, err := officecheckin.Code([]byte(secret), officeID, at, rotation)
+		require.NoError(t, err)
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		employeeID string
+		input      func(t *testing.T) ScanInput
+		wantErr    error
+	}{
+		{
+			name:       "code_of_a_past_window",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: code(t, "secret", officeID, now.Add(-3*rotation)), Latitude: latitude, Longitude: longitude}
+			},
+			wantErr: officecheckin.ErrExpiredCode,
+		},
+		{
+			name:       "code_not_signed_by_the_office",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: code(t, "forged", officeID, now), Latitude: latitude, Longitude: longitude}
+			},
+			wantErr: officecheckin.ErrInvalidCode,
+		},
+		{
+			name:       "code_of_an_unknown_office",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: code(t, "forged", model.NewUUID().String(), now), Latitude: latitude, Longitude: longitude}
+			},
+			wantErr: officecheckin.ErrInvalidCode,
+		},
+		{
+			name:       "code_of_a_forged_office_id",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: code(t, "forged", "not-an-office", now), Latitude: latitude, Longitude: longitude}
+			},
+			wantErr: officecheckin.ErrInvalidCode,
+		},
+		{
+			name:       "malformed_code",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: "not-a-code"}
+			},
+			wantErr: officecheckin.ErrMalformedCode,
+		},
+		{
+			name:       "no_position_at_an_office_with_a_geofence",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: code(t, "secret", officeID, now)}
+			},
+			wantErr: ErrPositionRequired,
+		},
+		{
+			name:       "outside_the_geofence",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				// about 1km north of the office
+				return ScanInput{Code: code(t, "secret", officeID, now), Latitude: ptr(10.7859), Longitude: longitude}
+			},
+			wantErr: ErrOutsideGeofence,
+		},
+		{
+			name:       "inactive_office",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: code(t, "former", formerOfficeID, now), Latitude: latitude, Longitude: longitude}
+			},
+			wantErr: ErrLocationInactive,
+		},
+		{
+			name:       "already_checked_in_today",
+			employeeID: checkedInID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: code(t, "secret", officeID, now), Latitude: latitude, Longitude: longitude}
+			},
+			wantErr: ErrAlreadyCheckedIn,
+		},
+		{
+			// a code of the previous window is still accepted, for the time between the scan and the request
+			name:       "ok",
+			employeeID: employeeID,
+			input: func(t *testing.T) ScanInput {
+				return ScanInput{Code: code(t, "secret", officeID, now.Add(-rotation)), Latitude: ptr(10.7772), Longitude: longitude}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/checkin/checkin.sql")
+				reward := &fakeReward{}
+
+				c := New(storeMock, txRepo, nil, reward, loggerMock, &cfg)
+				checkin, err := c.CheckIn(tt.employeeID, tt.input(t), now)
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					require.Empty(t, reward.triggered)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, officeID, checkin.OfficeLocationID.String())
+				require.NotNil(t, checkin.CheckInDistance)
+				require.LessOrEqual(t, *checkin.CheckInDistance, float64(100))
+				require.Len(t, reward.triggered, 1)
+				require.Equal(t, model.IcyRewardTriggerOfficeCheckIn, reward.triggered[0].Trigger)
+				require.Equal(t, "2026-10-19", reward.triggered[0].Reference)
+			})
+		})
+	}
+}
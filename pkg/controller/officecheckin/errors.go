@@ -0,0 +1,19 @@
+package officecheckin
+
+import "errors"
+
+var (
+	ErrLocationNotFound    = errors.New("office location not found")
+	ErrLocationInactive    = errors.New("office location is not active")
+	ErrInvalidName         = errors.New("name is required")
+	ErrInvalidPosition     = errors.New("latitude and longitude must be given together and be valid")
+	ErrInvalidRadius       = errors.New("geofence radius must not be negative")
+	ErrGeofenceNoPosition  = errors.New("geofence needs the position of the office")
+	ErrInvalidRotation     = errors.New("qr rotation must be from 10 seconds to an hour")
+	ErrPositionRequired    = errors.New("position is required to check in at this office")
+	ErrOutsideGeofence     = errors.New("position is too far from the office")
+	ErrAlreadyCheckedIn    = errors.New("already checked in today")
+	ErrNotCheckedIn        = errors.New("not checked in today")
+	ErrAlreadyCheckedOut   = errors.New("already checked out today")
+	ErrCheckOutOtherOffice = errors.New("check out at the office checked in at")
+)
@@ -0,0 +1,160 @@
+package officecheckin
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/officecheckin"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+const (
+	defaultRotationSeconds = 60
+	qrSecretLength         = 32
+)
+
+type LocationInput struct {
+	Name              string
+	Address           string
+	Latitude          *float64
+	Longitude         *float64
+	GeofenceRadius    int // meters, 0 for no geofence
+	QRRotationSeconds int // 60 when empty
+	IsActive          bool
+	RotateSecret      bool // invalidates the codes issued so far
+}
+
+// QRCode is the code shown at an office until it rotates
+type QRCode struct {
+	Location  *model.OfficeLocation
+	Code      string
+	ExpiresAt time.Time
+}
+
+func (r *controller) ListLocations(activeOnly bool) ([]*model.OfficeLocation, error) {
+	return r.store.OfficeLocation.All(r.repo.DB(), activeOnly)
+}
+
+func (r *controller) GetLocation(id string) (*model.OfficeLocation, error) {
+	location, err := r.store.OfficeLocation.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLocationNotFound
+		}
+		return nil, err
+	}
+	return location, nil
+}
+
+func (r *controller) CreateLocation(input LocationInput) (*model.OfficeLocation, error) {
+	location := &model.OfficeLocation{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+	}
+	input.RotateSecret = true
+	if err := r.applyInput(location, input); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.OfficeLocation.Create(r.repo.DB(), location); err != nil {
+		return nil, err
+	}
+
+	return r.GetLocation(location.ID.String())
+}
+
+func (r *controller) UpdateLocation(id string, input LocationInput) (*model.OfficeLocation, error) {
+	location, err := r.GetLocation(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyInput(location, input); err != nil {
+		return nil, err
+	}
+
+	_, err = r.store.OfficeLocation.UpdateSelectedFieldsByID(r.repo.DB(), id, model.OfficeLocation{
+		Name:              location.Name,
+		Address:           location.Address,
+		Latitude:          location.Latitude,
+		Longitude:         location.Longitude,
+		GeofenceRadius:    location.GeofenceRadius,
+		QRSecret:          location.QRSecret,
+		QRRotationSeconds: location.QRRotationSeconds,
+		IsActive:          location.IsActive,
+	}, "name", "address", "latitude", "longitude", "geofence_radius", "qr_secret", "qr_rotation_seconds", "is_active")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetLocation(id)
+}
+
+// QRCode returns the code to show at the office now
+func (r *controller) QRCode(locationID string, now time.Time) (*QRCode, error) {
+	location, err := r.GetLocation(locationID)
+	if err != nil {
+		return nil, err
+	}
+	if !location.IsActive {
+		return nil, ErrLocationInactive
+	}
+
+	code, expiresAt, err := officecheckin.Code([]byte(location.QRSecret), location.ID.String(), now, rotation(location))
+	if err != nil {
+		return nil, err
+	}
+
+	return &QRCode{Location: location, Code: code, ExpiresAt: expiresAt}, nil
+}
+
+func (r *controller) applyInput(location *model.OfficeLocation, input LocationInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return ErrInvalidName
+	}
+	if (input.Latitude == nil) != (input.Longitude == nil) {
+		return ErrInvalidPosition
+	}
+	if input.Latitude != nil && (*input.Latitude < -90 || *input.Latitude > 90 || *input.Longitude < -180 || *input.Longitude > 180) {
+		return ErrInvalidPosition
+	}
+	if input.GeofenceRadius < 0 {
+		return ErrInvalidRadius
+	}
+	if input.GeofenceRadius > 0 && input.Latitude == nil {
+		return ErrGeofenceNoPosition
+	}
+	if input.QRRotationSeconds == 0 {
+		input.QRRotationSeconds = defaultRotationSeconds
+	}
+	if input.QRRotationSeconds < 10 || input.QRRotationSeconds > 3600 {
+		return ErrInvalidRotation
+	}
+	if input.RotateSecret || location.QRSecret == "" {
+		secret, err := authutils.GenerateUniqueNanoID(qrSecretLength)
+		if err != nil {
+			return err
+		}
+		location.QRSecret = secret
+	}
+
+	location.Name = name
+	location.Address = strings.TrimSpace(input.Address)
+	location.Latitude = input.Latitude
+	location.Longitude = input.Longitude
+	location.GeofenceRadius = input.GeofenceRadius
+	location.QRRotationSeconds = input.QRRotationSeconds
+	location.IsActive = input.IsActive
+	return nil
+}
+
+func rotation(location *model.OfficeLocation) time.Duration {
+	seconds := location.QRRotationSeconds
+	if seconds <= 0 {
+		seconds = defaultRotationSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,47 @@
+package officecheckin
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store     *store.Store
+	service   *service.Service
+	icyReward icyreward.IController
+	logger    logger.Logger
+	repo      store.DBRepo
+	config    *config.Config
+}
+
+// New returns the office check-in controller, the check-ins are rewarded through the office
+// check-in rule of the icy reward controller
+func New(store *store.Store, repo store.DBRepo, service *service.Service, icyReward icyreward.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:     store,
+		repo:      repo,
+		service:   service,
+		icyReward: icyReward,
+		logger:    logger,
+		config:    cfg,
+	}
+}
+
+type IController interface {
+	ListLocations(activeOnly bool) ([]*model.OfficeLocation, error)
+	GetLocation(id string) (*model.OfficeLocation, error)
+	CreateLocation(input LocationInput) (*model.OfficeLocation, error)
+	UpdateLocation(id string, input LocationInput) (*model.OfficeLocation, error)
+	QRCode(locationID string, now time.Time) (*QRCode, error)
+
+	CheckIn(employeeID string, input ScanInput, now time.Time) (*model.OfficeCheckin, error)
+	CheckOut(employeeID string, input ScanInput, now time.Time) (*model.OfficeCheckin, error)
+	List(input ListInput, pagination model.Pagination) ([]*model.OfficeCheckin, int64, error)
+	Attendance(month time.Time, officeLocationID string) (*model.OfficeAttendanceReport, error)
+}
@@ -0,0 +1,6 @@
+INSERT INTO "public"."office_locations" ("id", "deleted_at", "created_at", "updated_at", "name", "address", "latitude", "longitude", "geofence_radius", "qr_secret", "qr_rotation_seconds", "is_active") VALUES
+('3c9d1e2f-7a4b-4c5d-8e6f-0a1b2c3d4e5f', NULL, '2026-10-01 00:00:00', '2026-10-01 00:00:00', 'Dwarves Office', '1 Nguyen Hue, District 1', 10.7769, 106.7009, 100, 'secret', 60, 't'),
+('4d0e2f3a-8b5c-4d6e-9f7a-1b2c3d4e5f6a', NULL, '2026-10-01 00:00:00', '2026-10-01 00:00:00', 'Former Office', NULL, 10.7769, 106.7009, 100, 'former', 60, 'f');
+
+INSERT INTO "public"."office_checkins" ("id", "deleted_at", "created_at", "updated_at", "employee_id", "office_location_id", "date", "checked_in_at", "check_in_latitude", "check_in_longitude", "check_in_distance") VALUES
+('5e1f3a4b-9c6d-4e7f-8a8b-2c3d4e5f6a7b', NULL, '2026-10-19 08:00:00', '2026-10-19 08:00:00', 'dcfee24b-306d-4609-9c24-a4021639a11b', '3c9d1e2f-7a4b-4c5d-8e6f-0a1b2c3d4e5f', '2026-10-19', '2026-10-19 08:00:00', 10.7769, 106.7009, 0);
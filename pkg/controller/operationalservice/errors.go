@@ -0,0 +1,19 @@
+package operationalservice
+
+import "errors"
+
+var (
+	ErrServiceNotFound      = errors.New("operational service not found")
+	ErrSeatNotFound         = errors.New("seat not found")
+	ErrCurrencyNotFound     = errors.New("currency not found")
+	ErrEmployeeNotFound     = errors.New("employee not found")
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrInvalidBillingCycle  = errors.New("invalid billing cycle")
+	ErrInvalidAmount        = errors.New("amount must not be negative")
+	ErrInvalidSeatCount     = errors.New("seat count must not be negative")
+	ErrInvalidEndDate       = errors.New("end date must not be before the start date")
+	ErrSeatAssigned         = errors.New("employee already holds a seat of the service")
+	ErrSeatReleased         = errors.New("seat is already released")
+	ErrEmployeeLeft         = errors.New("employee has left")
+	ErrInvalidPeriod        = errors.New("invalid report period")
+)
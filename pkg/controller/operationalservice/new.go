@@ -0,0 +1,52 @@
+package operationalservice
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/discord"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	fxRate  fxrate.IController
+	discord discord.IController
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+// New returns the operational service controller, the renewal reminders are posted through the
+// discord log templates and the spend is reported in VND at the rates of the fx rate controller
+func New(store *store.Store, repo store.DBRepo, service *service.Service, fxRate fxrate.IController, discord discord.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		fxRate:  fxRate,
+		discord: discord,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	List(input ListInput, pagination model.Pagination) ([]*model.OperationalService, int64, error)
+	Get(id string) (*model.OperationalService, error)
+	Create(input ServiceInput) (*model.OperationalService, error)
+	Update(id string, input ServiceInput) (*model.OperationalService, error)
+	Delete(id string) error
+
+	AssignSeat(id string, input SeatInput) (*model.OperationalServiceSeat, error)
+	ReleaseSeat(id string, seatID string, releasedAt *time.Time) (*model.OperationalServiceSeat, error)
+	ReconcileSeats() ([]*model.OperationalServiceSeatReconciliation, error)
+
+	RemindRenewals(today time.Time) ([]*model.OperationalService, error)
+	SpendReport(year int, month int) (*model.OperationalServiceSpendReport, error)
+}
@@ -0,0 +1,100 @@
+package operationalservice
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/subscription"
+)
+
+// RemindRenewals rolls the renewal dates already passed to the next billing date, then reminds
+// the owners on Discord of the services renewing within their reminder window. A renewal is
+// reminded of once.
+func (r *controller) RemindRenewals(today time.Time) ([]*model.OperationalService, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "operationalservice",
+		"method":     "RemindRenewals",
+	})
+
+	services, err := r.store.OperationalService.AllActive(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	reminded := make([]*model.OperationalService, 0)
+	for _, s := range services {
+		if s.RenewalDate == nil {
+			continue
+		}
+		if !s.EndAt.IsZero() && s.EndAt.Before(*s.RenewalDate) {
+			// the service stops before it renews
+			continue
+		}
+
+		next := subscription.NextRenewal(*s.RenewalDate, s.BillingCycle.Months(), today)
+		if !next.Equal(*s.RenewalDate) {
+			s.RenewalDate = &next
+			_, err := r.store.OperationalService.UpdateSelectedFieldsByID(r.repo.DB(), s.ID.String(), model.OperationalService{
+				RenewalDate: s.RenewalDate,
+			}, "renewal_date")
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !subscription.ReminderDue(*s.RenewalDate, s.ReminderDays, s.RemindedRenewalDate, today) {
+			continue
+		}
+
+		err := r.discord.Log(model.LogDiscordInput{
+			Type: "operational_service_renewal",
+			Data: map[string]interface{}{
+				"service":      serviceLabel(s),
+				"renewal_date": s.RenewalDate.Format("2006-01-02"),
+				"amount":       s.Amount,
+				"currency":     s.CurrencyName(),
+				"seats":        s.SeatCount,
+				"owner":        ownerMention(s.Owner),
+			},
+		})
+		if err != nil {
+			// the next run tries again
+			l.AddField("serviceID", s.ID).Error(err, "failed to remind the renewal")
+			continue
+		}
+
+		s.RemindedRenewalDate = s.RenewalDate
+		_, err = r.store.OperationalService.UpdateSelectedFieldsByID(r.repo.DB(), s.ID.String(), model.OperationalService{
+			RemindedRenewalDate: s.RemindedRenewalDate,
+		}, "reminded_renewal_date")
+		if err != nil {
+			return nil, err
+		}
+		reminded = append(reminded, s)
+	}
+
+	return reminded, nil
+}
+
+func serviceLabel(s *model.OperationalService) string {
+	label := s.Name
+	if s.Vendor != "" && s.Vendor != s.Name {
+		label = fmt.Sprintf("%s - %s", s.Vendor, label)
+	}
+	if s.Plan != "" {
+		label = fmt.Sprintf("%s (%s)", label, s.Plan)
+	}
+	return label
+}
+
+func ownerMention(owner *model.Employee) string {
+	switch {
+	case owner == nil:
+		return "nobody"
+	case owner.DiscordAccount != nil && owner.DiscordAccount.DiscordID != "":
+		return fmt.Sprintf("<@%s>", owner.DiscordAccount.DiscordID)
+	}
+	return owner.DisplayName
+}
@@ -0,0 +1,120 @@
+package operationalservice
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/subscription"
+)
+
+const (
+	// reportCurrency is the currency the spend is reported in
+	reportCurrency = "VND"
+	// unassignedOrganization groups the services not charged to an organization
+	unassignedOrganization = "Unassigned"
+)
+
+// SpendReport breaks the spend of a month on the operational services down per organization.
+// Charged is what is billed in the month, Monthly spreads the quarterly and yearly bills over
+// the months they cover.
+func (r *controller) SpendReport(year int, month int) (*model.OperationalServiceSpendReport, error) {
+	if year <= 0 || month < 1 || month > 12 {
+		return nil, ErrInvalidPeriod
+	}
+
+	from := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, -1)
+
+	services, err := r.store.OperationalService.AllStartedBy(r.repo.DB(), to)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rate of the end of the month, or the latest one for the month in progress
+	rateDate := to
+	if now := time.Now(); now.Before(rateDate) {
+		rateDate = now
+	}
+
+	report := &model.OperationalServiceSpendReport{
+		Year:     year,
+		Month:    month,
+		Currency: reportCurrency,
+		Warnings: []string{},
+	}
+	rates := map[string]float64{reportCurrency: 1}
+	organizations := map[string]*model.OperationalServiceOrganizationSpend{}
+
+	for _, s := range services {
+		if !s.EndAt.IsZero() && s.EndAt.Before(from) {
+			continue
+		}
+		if s.EndAt.IsZero() && !s.IsActive {
+			// stopped without an end date, it is only counted while active
+			continue
+		}
+
+		currency := s.CurrencyName()
+		rate, ok := rates[currency]
+		if !ok {
+			fxRate, err := r.fxRate.GetRateAt(currency, reportCurrency, rateDate)
+			if err != nil {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("no %s/%s rate, %s is left out", currency, reportCurrency, s.Name))
+				continue
+			}
+			rate = fxRate.Rate
+			rates[currency] = rate
+		}
+
+		anchor := s.StartAt
+		if s.RenewalDate != nil {
+			anchor = *s.RenewalDate
+		}
+		months := s.BillingCycle.Months()
+
+		spend := model.OperationalServiceSpend{
+			ServiceID:    s.ID.String(),
+			Name:         s.Name,
+			Vendor:       s.Vendor,
+			Plan:         s.Plan,
+			CostCenter:   s.CostCenter,
+			BillingCycle: s.BillingCycle,
+			Currency:     currency,
+			Amount:       float64(s.Amount),
+			Monthly:      subscription.MonthlyCost(float64(s.Amount), months) * rate,
+		}
+		if subscription.ChargedIn(anchor, months, year, time.Month(month)) {
+			spend.Charged = float64(s.Amount) * rate
+		}
+
+		key, name := "", unassignedOrganization
+		if s.OrganizationID != nil {
+			key = s.OrganizationID.String()
+			if s.Organization != nil {
+				name = s.Organization.Name
+			}
+		}
+		org, ok := organizations[key]
+		if !ok {
+			org = &model.OperationalServiceOrganizationSpend{OrganizationID: key, OrganizationName: name}
+			organizations[key] = org
+		}
+		org.Services = append(org.Services, spend)
+		org.Charged += spend.Charged
+		org.Monthly += spend.Monthly
+		report.Charged += spend.Charged
+		report.Monthly += spend.Monthly
+	}
+
+	report.Organizations = make([]model.OperationalServiceOrganizationSpend, 0, len(organizations))
+	for _, org := range organizations {
+		report.Organizations = append(report.Organizations, *org)
+	}
+	sort.Slice(report.Organizations, func(i, j int) bool {
+		return report.Organizations[i].Monthly > report.Organizations[j].Monthly
+	})
+
+	return report, nil
+}
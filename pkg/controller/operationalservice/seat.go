@@ -0,0 +1,170 @@
+package operationalservice
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/subscription"
+)
+
+type SeatInput struct {
+	EmployeeID model.UUID
+	AssignedAt *time.Time // today when empty
+	Note       string
+}
+
+// AssignSeat gives an employee a seat of a service
+func (r *controller) AssignSeat(id string, input SeatInput) (*model.OperationalServiceSeat, error) {
+	service, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	employee, err := r.store.Employee.One(r.repo.DB(), input.EmployeeID.String(), false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	if employee.WorkingStatus == model.WorkingStatusLeft {
+		return nil, ErrEmployeeLeft
+	}
+
+	_, err = r.store.OperationalServiceSeat.OneOpen(r.repo.DB(), id, input.EmployeeID.String())
+	if err == nil {
+		return nil, ErrSeatAssigned
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	assignedAt := time.Now()
+	if input.AssignedAt != nil {
+		assignedAt = *input.AssignedAt
+	}
+
+	seat := &model.OperationalServiceSeat{
+		BaseModel:            model.BaseModel{ID: model.NewUUID()},
+		OperationalServiceID: service.ID,
+		EmployeeID:           input.EmployeeID,
+		AssignedAt:           assignedAt,
+		Note:                 input.Note,
+	}
+	if _, err := r.store.OperationalServiceSeat.Create(r.repo.DB(), seat); err != nil {
+		return nil, err
+	}
+	seat.Employee = employee
+
+	return seat, nil
+}
+
+// ReleaseSeat takes a seat of a service back, the seat count paid for is left to be lowered with
+// the vendor
+func (r *controller) ReleaseSeat(id string, seatID string, releasedAt *time.Time) (*model.OperationalServiceSeat, error) {
+	seat, err := r.store.OperationalServiceSeat.One(r.repo.DB(), seatID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSeatNotFound
+		}
+		return nil, err
+	}
+	if seat.OperationalServiceID.String() != id {
+		return nil, ErrSeatNotFound
+	}
+	if seat.ReleasedAt != nil {
+		return nil, ErrSeatReleased
+	}
+
+	now := time.Now()
+	if releasedAt == nil {
+		releasedAt = &now
+	}
+	seat.ReleasedAt = releasedAt
+
+	_, err = r.store.OperationalServiceSeat.UpdateSelectedFieldsByID(r.repo.DB(), seatID, model.OperationalServiceSeat{
+		ReleasedAt: seat.ReleasedAt,
+	}, "released_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return seat, nil
+}
+
+// ReconcileSeats compares the seats paid for each active service with its active holders, or with
+// the active headcount of its organization when the seats are not tracked per employee
+func (r *controller) ReconcileSeats() ([]*model.OperationalServiceSeatReconciliation, error) {
+	services, err := r.store.OperationalService.AllActive(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	headcounts := map[string]int64{}
+	headcount := func(organizationID string) (int64, error) {
+		if count, ok := headcounts[organizationID]; ok {
+			return count, nil
+		}
+		count, err := r.store.Employee.CountActive(r.repo.DB(), organizationID)
+		if err != nil {
+			return 0, err
+		}
+		headcounts[organizationID] = count
+		return count, nil
+	}
+
+	rs := make([]*model.OperationalServiceSeatReconciliation, 0, len(services))
+	for _, s := range services {
+		if s.SeatCount <= 0 {
+			continue
+		}
+
+		organizationID := ""
+		if s.OrganizationID != nil {
+			organizationID = s.OrganizationID.String()
+		}
+		count, err := headcount(organizationID)
+		if err != nil {
+			return nil, err
+		}
+
+		holders := make([]subscription.Holder, 0, len(s.Seats))
+		employees := map[string]*model.Employee{}
+		for _, seat := range s.Seats {
+			active := seat.Employee != nil && seat.Employee.WorkingStatus != model.WorkingStatusLeft
+			holders = append(holders, subscription.Holder{EmployeeID: seat.EmployeeID.String(), Active: active})
+			employees[seat.EmployeeID.String()] = seat.Employee
+		}
+
+		reconciliation := subscription.Reconcile(subscription.Seats{
+			Purchased: s.SeatCount,
+			Holders:   holders,
+			Headcount: int(count),
+		})
+
+		leavers := make([]*model.Employee, 0, len(reconciliation.HeldByLeavers))
+		for _, id := range reconciliation.HeldByLeavers {
+			if e := employees[id]; e != nil {
+				leavers = append(leavers, e)
+			}
+		}
+
+		seatCost := subscription.MonthlyCost(float64(s.Amount), s.BillingCycle.Months()) / float64(s.SeatCount)
+		rs = append(rs, &model.OperationalServiceSeatReconciliation{
+			Service:      s,
+			Purchased:    reconciliation.Purchased,
+			Needed:       reconciliation.Needed,
+			Tracked:      reconciliation.Tracked,
+			Excess:       reconciliation.Excess,
+			Shortage:     reconciliation.Shortage,
+			Leavers:      leavers,
+			SeatCost:     seatCost,
+			MonthlyWaste: seatCost * float64(reconciliation.Excess),
+		})
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,211 @@
+package operationalservice
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	servicestore "github.com/dwarvesf/fortress-api/pkg/store/operationalservice"
+	"github.com/dwarvesf/fortress-api/pkg/subscription"
+)
+
+// defaultReminderDays is how many days before the renewal its owner is reminded of by default
+const defaultReminderDays = 7
+
+type ListInput struct {
+	OrganizationID string
+	OwnerID        string
+	IsActive       *bool
+	Keyword        string
+}
+
+type ServiceInput struct {
+	Name           string
+	Vendor         string
+	Plan           string
+	BillingCycle   model.OperationalServiceBillingCycle // monthly when empty
+	Amount         int                                  // the bill of a cycle
+	SeatCount      int
+	Currency       string // VND when empty
+	StartAt        *time.Time
+	EndAt          *time.Time
+	RenewalDate    *time.Time // the next billing date after the start when empty
+	ReminderDays   int
+	OwnerID        *model.UUID
+	OrganizationID *model.UUID
+	CostCenter     string
+	IsActive       *bool
+	Note           string
+}
+
+func (r *controller) List(input ListInput, pagination model.Pagination) ([]*model.OperationalService, int64, error) {
+	return r.store.OperationalService.All(r.repo.DB(), servicestore.Query{
+		OrganizationID: input.OrganizationID,
+		OwnerID:        input.OwnerID,
+		IsActive:       input.IsActive,
+		Keyword:        strings.TrimSpace(input.Keyword),
+	}, pagination)
+}
+
+func (r *controller) Get(id string) (*model.OperationalService, error) {
+	service, err := r.store.OperationalService.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrServiceNotFound
+		}
+		return nil, err
+	}
+	return service, nil
+}
+
+func (r *controller) Create(input ServiceInput) (*model.OperationalService, error) {
+	now := time.Now()
+	service := &model.OperationalService{
+		BaseModel:    model.BaseModel{ID: model.NewUUID()},
+		RegisterDate: now,
+		IsActive:     true,
+	}
+	if err := r.applyInput(service, input, now); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.OperationalService.Create(r.repo.DB(), service); err != nil {
+		return nil, err
+	}
+
+	return r.Get(service.ID.String())
+}
+
+func (r *controller) Update(id string, input ServiceInput) (*model.OperationalService, error) {
+	service, err := r.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.applyInput(service, input, time.Now()); err != nil {
+		return nil, err
+	}
+
+	_, err = r.store.OperationalService.UpdateSelectedFieldsByID(r.repo.DB(), id, model.OperationalService{
+		Name:           service.Name,
+		Vendor:         service.Vendor,
+		Plan:           service.Plan,
+		BillingCycle:   service.BillingCycle,
+		Amount:         service.Amount,
+		SeatCount:      service.SeatCount,
+		CurrencyID:     service.CurrencyID,
+		StartAt:        service.StartAt,
+		EndAt:          service.EndAt,
+		RenewalDate:    service.RenewalDate,
+		ReminderDays:   service.ReminderDays,
+		OwnerID:        service.OwnerID,
+		OrganizationID: service.OrganizationID,
+		CostCenter:     service.CostCenter,
+		IsActive:       service.IsActive,
+		Note:           service.Note,
+	}, "name", "vendor", "plan", "type", "amount", "seat_count", "currency_id", "start_at", "end_at",
+		"renewal_date", "reminder_days", "owner_id", "organization_id", "cost_center", "is_active", "note")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(id)
+}
+
+func (r *controller) Delete(id string) error {
+	if _, err := r.Get(id); err != nil {
+		return err
+	}
+	return r.store.OperationalService.Delete(r.repo.DB(), id)
+}
+
+func (r *controller) applyInput(service *model.OperationalService, input ServiceInput, now time.Time) error {
+	if input.BillingCycle == "" {
+		input.BillingCycle = model.OperationalServiceBillingCycleMonthly
+	}
+	if !input.BillingCycle.IsValid() {
+		return ErrInvalidBillingCycle
+	}
+	if input.Amount < 0 {
+		return ErrInvalidAmount
+	}
+	if input.SeatCount < 0 {
+		return ErrInvalidSeatCount
+	}
+
+	startAt := now
+	if input.StartAt != nil {
+		startAt = *input.StartAt
+	}
+	endAt := time.Time{}
+	if input.EndAt != nil {
+		if input.EndAt.Before(startAt) {
+			return ErrInvalidEndDate
+		}
+		endAt = *input.EndAt
+	}
+
+	currencyName := strings.ToUpper(strings.TrimSpace(input.Currency))
+	if currencyName == "" {
+		currencyName = "VND"
+	}
+	currency, err := r.store.Currency.GetByName(r.repo.DB(), currencyName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCurrencyNotFound
+		}
+		return err
+	}
+
+	if input.OwnerID != nil {
+		if _, err := r.store.Employee.One(r.repo.DB(), input.OwnerID.String(), false); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrEmployeeNotFound
+			}
+			return err
+		}
+	}
+	if input.OrganizationID != nil {
+		if _, err := r.store.Organization.One(r.repo.DB(), input.OrganizationID.String()); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrOrganizationNotFound
+			}
+			return err
+		}
+	}
+
+	renewal := input.RenewalDate
+	if renewal == nil {
+		next := subscription.NextRenewal(startAt, input.BillingCycle.Months(), now)
+		renewal = &next
+	}
+
+	reminderDays := input.ReminderDays
+	if reminderDays <= 0 {
+		reminderDays = defaultReminderDays
+	}
+
+	service.Name = strings.TrimSpace(input.Name)
+	service.Vendor = strings.TrimSpace(input.Vendor)
+	service.Plan = strings.TrimSpace(input.Plan)
+	service.BillingCycle = input.BillingCycle
+	service.Amount = input.Amount
+	service.SeatCount = input.SeatCount
+	service.CurrencyID = currency.ID
+	service.Currency = currency
+	service.StartAt = startAt
+	service.EndAt = endAt
+	service.RenewalDate = renewal
+	service.ReminderDays = reminderDays
+	service.OwnerID = input.OwnerID
+	service.OrganizationID = input.OrganizationID
+	service.CostCenter = strings.TrimSpace(input.CostCenter)
+	if input.IsActive != nil {
+		service.IsActive = *input.IsActive
+	}
+	service.Note = input.Note
+	return nil
+}
@@ -0,0 +1,288 @@
+package profitability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/profitability"
+)
+
+type costKind int
+
+const (
+	costNone costKind = iota
+	costSalary
+	costContractor
+)
+
+type monthlyCost struct {
+	kind   costKind
+	amount float64
+}
+
+// reportBuilder charges the revenue and the costs of the months of a report to the projects
+type reportBuilder struct {
+	*controller
+	l      logger.Logger
+	months []time.Time
+
+	projects map[string]*model.ProjectProfitability
+	warnings []string
+	warned   map[string]bool
+
+	rates       map[string]float64
+	members     map[string]model.ProfitabilityMember
+	payrolls    map[string]map[int]float64
+	baseSalary  map[string]model.CashFlowSalary
+	costs       map[string]map[int]monthlyCost
+	hourlyRated map[string]bool
+}
+
+func newReportBuilder(r *controller, l logger.Logger, months []time.Time) *reportBuilder {
+	return &reportBuilder{
+		controller:  r,
+		l:           l,
+		months:      months,
+		projects:    map[string]*model.ProjectProfitability{},
+		warned:      map[string]bool{},
+		rates:       map[string]float64{},
+		members:     map[string]model.ProfitabilityMember{},
+		payrolls:    map[string]map[int]float64{},
+		baseSalary:  map[string]model.CashFlowSalary{},
+		costs:       map[string]map[int]monthlyCost{},
+		hourlyRated: map[string]bool{},
+	}
+}
+
+func (b *reportBuilder) build() error {
+	from, to := b.months[0], b.months[len(b.months)-1].AddDate(0, 1, 0)
+	db := b.repo.DB()
+
+	revenues, err := b.store.Profitability.GetRevenues(db, from, to)
+	if err != nil {
+		return err
+	}
+	for _, rev := range revenues {
+		i, ok := b.index(rev.Year, rev.Month)
+		if !ok {
+			continue
+		}
+
+		amount := rev.ConversionAmount
+		if amount <= 0 {
+			if amount, ok = b.convert(rev.Total, rev.Currency, b.months[i]); !ok {
+				continue
+			}
+		}
+		b.project(rev.ProjectID, rev.ProjectName).Months[i].Revenue += amount
+	}
+
+	commissions, err := b.store.Profitability.GetCommissions(db, from, to)
+	if err != nil {
+		return err
+	}
+	for _, c := range commissions {
+		if i, ok := b.index(c.Year, c.Month); ok {
+			b.project(c.ProjectID, c.ProjectName).Months[i].CommissionCost += c.Amount
+		}
+	}
+
+	return b.memberCosts(from, to)
+}
+
+// memberCosts charges the salaries and contractor rates of the members to their projects
+func (b *reportBuilder) memberCosts(from, to time.Time) error {
+	db := b.repo.DB()
+
+	members, err := b.store.Profitability.GetMembers(db, from, to)
+	if err != nil {
+		return err
+	}
+	if len(members) == 0 {
+		return nil
+	}
+
+	salaries, err := b.store.Profitability.GetPayrollSalaries(db, from, to)
+	if err != nil {
+		return err
+	}
+	for _, s := range salaries {
+		i, ok := b.index(s.Year, s.Month)
+		if !ok {
+			continue
+		}
+		if b.payrolls[s.EmployeeID] == nil {
+			b.payrolls[s.EmployeeID] = map[int]float64{}
+		}
+		b.payrolls[s.EmployeeID][i] += s.Amount
+	}
+
+	baseSalaries, err := b.store.CashFlow.GetBaseSalaries(db)
+	if err != nil {
+		return err
+	}
+	for _, s := range baseSalaries {
+		b.baseSalary[s.EmployeeID] = s
+	}
+
+	assignments := make([]profitability.Assignment, 0, len(members))
+	for _, m := range members {
+		b.members[m.EmployeeID] = m
+		b.project(m.ProjectID, m.ProjectName)
+		assignments = append(assignments, profitability.Assignment{
+			ProjectID:      m.ProjectID,
+			EmployeeID:     m.EmployeeID,
+			DeploymentType: m.DeploymentType,
+			StartDate:      m.StartDate,
+			EndDate:        m.EndDate,
+		})
+	}
+
+	weights := profitability.Weights{
+		Official: 1,
+		PartTime: b.config.Profitability.PartTimeAllocation,
+		Shadow:   b.config.Profitability.ShadowAllocation,
+	}
+	for i, month := range b.months {
+		for _, a := range profitability.Allocate(month, assignments, weights) {
+			pm := &b.projects[a.ProjectID].Months[i]
+			pm.AllocatedFTE += a.Share
+			if a.DeploymentType != model.MemberDeploymentTypeShadow {
+				pm.BillableFTE += a.Share
+			}
+
+			cost := b.cost(a.EmployeeID, i)
+			switch cost.kind {
+			case costSalary:
+				pm.SalaryCost += a.Share * cost.amount
+			case costContractor:
+				pm.ContractorCost += a.Share * cost.amount
+			}
+		}
+	}
+
+	if len(b.hourlyRated) > 0 {
+		b.warn(fmt.Sprintf("%d contractors paid by the hour are left out of the contractor cost", len(b.hourlyRated)))
+	}
+
+	return nil
+}
+
+// cost is what an employee costs over a month: the salary of the payroll, the monthly fixed rate
+// of a contractor, or the current base salary for the months not on payroll yet
+func (b *reportBuilder) cost(employeeID string, i int) monthlyCost {
+	if c, ok := b.costs[employeeID][i]; ok {
+		return c
+	}
+
+	c := monthlyCost{kind: costNone}
+	member := b.members[employeeID]
+	if amount, ok := b.payrolls[employeeID][i]; ok {
+		c = monthlyCost{kind: costSalary, amount: amount}
+	} else if member.WorkingStatus == model.WorkingStatusContractor {
+		if amount, ok := b.contractorRate(member, b.months[i]); ok {
+			c = monthlyCost{kind: costContractor, amount: amount}
+		}
+	} else if s, ok := b.baseSalary[employeeID]; ok {
+		if amount, ok := b.convert(s.Amount, s.Currency, b.months[i]); ok {
+			c = monthlyCost{kind: costSalary, amount: amount}
+		}
+	}
+
+	if b.costs[employeeID] == nil {
+		b.costs[employeeID] = map[int]monthlyCost{}
+	}
+	b.costs[employeeID][i] = c
+	return c
+}
+
+func (b *reportBuilder) contractorRate(member model.ProfitabilityMember, month time.Time) (float64, bool) {
+	if b.service.Notion == nil || b.service.Notion.ContractorRates == nil {
+		b.warn("contractor rates are not available, the contractor cost is left out")
+		return 0, false
+	}
+	if member.DiscordUsername == "" {
+		b.warn(fmt.Sprintf("%s has no discord account, their contractor rate cannot be found", member.FullName))
+		return 0, false
+	}
+
+	rate, err := b.service.Notion.ContractorRates.QueryRatesByDiscordAndMonth(context.Background(), member.DiscordUsername, month.Format("2006-01"))
+	if err != nil {
+		b.l.AddField("discord", member.DiscordUsername).Error(err, "failed to get contractor rate")
+		b.warn(fmt.Sprintf("no contractor rate for %s in %s", member.FullName, month.Format("2006-01")))
+		return 0, false
+	}
+	if rate.MonthlyFixed <= 0 {
+		b.hourlyRated[member.EmployeeID] = true
+		return 0, false
+	}
+
+	return b.convert(rate.MonthlyFixed, rate.Currency, month)
+}
+
+// convert turns an amount into the report currency at the rate of the end of the month, or of
+// today for the months not over yet. The amounts whose currency has no rate are left out.
+func (b *reportBuilder) convert(amount float64, currency string, month time.Time) (float64, bool) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" || currency == reportCurrency {
+		return amount, true
+	}
+
+	date := month.AddDate(0, 1, -1)
+	if now := time.Now(); date.After(now) {
+		date = now
+	}
+
+	key := currency + date.Format("2006-01-02")
+	rate, ok := b.rates[key]
+	if !ok {
+		fxRate, err := b.fxRate.GetRateAt(currency, reportCurrency, date)
+		if err != nil {
+			b.l.AddField("currency", currency).Error(err, "failed to get fx rate")
+			b.warn(fmt.Sprintf("no %s/%s rate in %s, the %s amounts are left out", currency, reportCurrency, month.Format("2006-01"), currency))
+		} else {
+			rate = fxRate.Rate
+		}
+		b.rates[key] = rate
+	}
+	if rate == 0 {
+		return 0, false
+	}
+
+	return amount * rate, true
+}
+
+func (b *reportBuilder) project(id, name string) *model.ProjectProfitability {
+	if p, ok := b.projects[id]; ok {
+		return p
+	}
+
+	p := &model.ProjectProfitability{
+		ProjectID:   id,
+		ProjectName: name,
+		Months:      make([]model.ProjectProfitabilityMonth, len(b.months)),
+	}
+	for i, m := range b.months {
+		p.Months[i].Month = m
+	}
+	b.projects[id] = p
+	return p
+}
+
+func (b *reportBuilder) index(year, month int) (int, bool) {
+	first := b.months[0]
+	i := (year-first.Year())*12 + month - int(first.Month())
+	return i, i >= 0 && i < len(b.months)
+}
+
+func (b *reportBuilder) warn(msg string) {
+	if b.warned[msg] {
+		return
+	}
+	b.warned[msg] = true
+	b.warnings = append(b.warnings, msg)
+}
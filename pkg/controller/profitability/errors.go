@@ -0,0 +1,9 @@
+package profitability
+
+import "errors"
+
+var (
+	ErrInvalidRange    = errors.New("invalid range, from must not be after to")
+	ErrRangeTooLong    = errors.New("the range must not be longer than 24 months")
+	ErrProjectNotFound = errors.New("project not found")
+)
@@ -0,0 +1,82 @@
+package profitability
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/profitability"
+)
+
+const (
+	// reportCurrency is the currency the report is made in, the payrolls and commissions are in VND
+	reportCurrency = "VND"
+	maxMonths      = 24
+)
+
+type ReportInput struct {
+	From      time.Time // first month of the report
+	To        time.Time // last month of the report, included
+	ProjectID string    // all the projects when empty
+}
+
+// Report sets the revenue of the projects against the salaries of their members, the
+// contractor payouts and the commissions, month by month
+func (r *controller) Report(input ReportInput) (*model.ProjectProfitabilityReport, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "profitability",
+		"method":     "Report",
+		"projectID":  input.ProjectID,
+	})
+
+	months := profitability.Months(input.From, input.To)
+	if len(months) == 0 {
+		return nil, ErrInvalidRange
+	}
+	if len(months) > maxMonths {
+		return nil, ErrRangeTooLong
+	}
+
+	if input.ProjectID != "" {
+		if _, err := r.store.Project.One(r.repo.DB(), input.ProjectID, false); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrProjectNotFound
+			}
+			return nil, err
+		}
+	}
+
+	b := newReportBuilder(r, l, months)
+	if err := b.build(); err != nil {
+		return nil, err
+	}
+
+	projects := make([]model.ProjectProfitability, 0, len(b.projects))
+	for id, p := range b.projects {
+		if input.ProjectID != "" && id != input.ProjectID {
+			continue
+		}
+
+		for i := range p.Months {
+			p.Months[i] = profitability.Summarize(p.Months[i])
+		}
+		p.Total = profitability.Total(p.Months)
+		p.Trend = profitability.Trend(p.Months)
+		projects = append(projects, *p)
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].ProjectName < projects[j].ProjectName
+	})
+
+	return &model.ProjectProfitabilityReport{
+		From:     months[0],
+		To:       months[len(months)-1],
+		Currency: reportCurrency,
+		Projects: projects,
+		Warnings: b.warnings,
+	}, nil
+}
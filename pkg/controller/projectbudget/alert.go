@@ -0,0 +1,120 @@
+package projectbudget
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/budget"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	budgetstore "github.com/dwarvesf/fortress-api/pkg/store/projectbudget"
+)
+
+// AlertBurns alerts the heads of the projects on Discord of the active budgets whose burn crossed
+// a new threshold. A threshold is alerted on once, the highest one crossed when several are.
+func (r *controller) AlertBurns(today time.Time) ([]*model.ProjectBudgetReport, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "projectbudget",
+		"method":     "AlertBurns",
+	})
+
+	budgets, err := r.store.ProjectBudget.All(r.repo.DB(), budgetstore.Query{Status: model.ProjectBudgetStatusActive})
+	if err != nil {
+		return nil, err
+	}
+
+	alerted := make([]*model.ProjectBudgetReport, 0)
+	for _, b := range budgets {
+		rs, err := r.report(b, today)
+		if err != nil {
+			return nil, err
+		}
+
+		threshold, ok := budget.CrossedThreshold(r.thresholds(b), b.AlertedThreshold, rs.BurnPercent)
+		if !ok {
+			continue
+		}
+
+		heads, err := r.store.ProjectBudget.GetProjectHeads(r.repo.DB(), b.ProjectID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.discord.Log(model.LogDiscordInput{
+			Type: "project_budget_burn",
+			Data: map[string]interface{}{
+				"budget":     budgetLabel(b),
+				"project":    projectName(b),
+				"burn":       math.Round(rs.BurnPercent),
+				"drawn":      fmt.Sprintf("%.2f", rs.Issued),
+				"total":      fmt.Sprintf("%.2f", rs.Total),
+				"currency":   rs.Currency,
+				"threshold":  threshold,
+				"projection": projection(rs),
+				"heads":      mentions(heads),
+			},
+		})
+		if err != nil {
+			// the next run tries again
+			l.AddField("budgetID", b.ID).Error(err, "failed to alert the budget burn")
+			continue
+		}
+
+		b.AlertedThreshold = threshold
+		_, err = r.store.ProjectBudget.UpdateSelectedFieldsByID(r.repo.DB(), b.ID.String(), model.ProjectBudget{
+			AlertedThreshold: b.AlertedThreshold,
+		}, "alerted_threshold")
+		if err != nil {
+			return nil, err
+		}
+		alerted = append(alerted, rs)
+	}
+
+	return alerted, nil
+}
+
+func budgetLabel(b *model.ProjectBudget) string {
+	if b.PONumber == "" {
+		return b.Name
+	}
+	return fmt.Sprintf("%s (PO %s)", b.Name, b.PONumber)
+}
+
+func projectName(b *model.ProjectBudget) string {
+	if b.Project == nil {
+		return "the project"
+	}
+	return b.Project.Name
+}
+
+func projection(rs *model.ProjectBudgetReport) string {
+	switch {
+	case rs.Remaining <= 0:
+		return "The budget is used up."
+	case rs.ProjectedOverrun > 0:
+		return fmt.Sprintf("At the current team cost of %.2f a month it ends %.2f %s over budget.",
+			rs.MonthlyTeamCost, rs.ProjectedOverrun, rs.Currency)
+	case rs.ExhaustedAt != nil:
+		return fmt.Sprintf("At the current team cost of %.2f a month it runs out in %s.",
+			rs.MonthlyTeamCost, rs.ExhaustedAt.Format("Jan 2006"))
+	}
+	return ""
+}
+
+func mentions(employees []*model.Employee) string {
+	if len(employees) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(employees))
+	for _, e := range employees {
+		if e.DiscordAccount != nil && e.DiscordAccount.DiscordID != "" {
+			names = append(names, fmt.Sprintf("<@%s>", e.DiscordAccount.DiscordID))
+			continue
+		}
+		names = append(names, e.DisplayName)
+	}
+	return strings.Join(names, ", ")
+}
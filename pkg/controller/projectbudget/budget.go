@@ -0,0 +1,253 @@
+package projectbudget
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/budget"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	invoicestore "github.com/dwarvesf/fortress-api/pkg/store/invoice"
+	budgetstore "github.com/dwarvesf/fortress-api/pkg/store/projectbudget"
+)
+
+type BudgetInput struct {
+	PONumber        string
+	Name            string
+	TotalValue      decimal.Decimal
+	Currency        string
+	StartDate       time.Time
+	EndDate         *time.Time
+	Status          model.ProjectBudgetStatus // active when empty
+	AlertThresholds []int64                   // the configured thresholds when empty
+	Note            string
+}
+
+type MilestoneInput struct {
+	Name      string
+	Amount    decimal.Decimal
+	DueDate   *time.Time
+	InvoiceID *model.UUID
+}
+
+func (r *controller) List(projectID string, status model.ProjectBudgetStatus) ([]*model.ProjectBudget, error) {
+	if err := r.checkProject(projectID); err != nil {
+		return nil, err
+	}
+	return r.store.ProjectBudget.All(r.repo.DB(), budgetstore.Query{
+		ProjectID: projectID,
+		Status:    status,
+	})
+}
+
+func (r *controller) Get(projectID, id string) (*model.ProjectBudget, error) {
+	b, err := r.store.ProjectBudget.One(r.repo.DB(), projectID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBudgetNotFound
+		}
+		return nil, err
+	}
+	return b, nil
+}
+
+func (r *controller) Create(projectID string, input BudgetInput, createdBy string) (*model.ProjectBudget, error) {
+	if err := r.checkProject(projectID); err != nil {
+		return nil, err
+	}
+
+	b := &model.ProjectBudget{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+	}
+	b.ProjectID, _ = model.UUIDFromString(projectID)
+	if id, err := model.UUIDFromString(createdBy); err == nil {
+		b.CreatedBy = &id
+	}
+	if err := r.applyInput(b, input); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.ProjectBudget.Create(r.repo.DB(), b); err != nil {
+		return nil, err
+	}
+
+	return r.Get(projectID, b.ID.String())
+}
+
+// Update changes a budget, the burn is alerted on again from the start when the total value or
+// the thresholds change
+func (r *controller) Update(projectID, id string, input BudgetInput) (*model.ProjectBudget, error) {
+	b, err := r.Get(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	totalValue, thresholds := b.TotalValue, b.AlertThresholds
+	if err := r.applyInput(b, input); err != nil {
+		return nil, err
+	}
+	if !totalValue.Equal(b.TotalValue) || !sameThresholds(thresholds, b.AlertThresholds) {
+		b.AlertedThreshold = 0
+	}
+
+	_, err = r.store.ProjectBudget.UpdateSelectedFieldsByID(r.repo.DB(), id, model.ProjectBudget{
+		PONumber:         b.PONumber,
+		Name:             b.Name,
+		TotalValue:       b.TotalValue,
+		CurrencyID:       b.CurrencyID,
+		StartDate:        b.StartDate,
+		EndDate:          b.EndDate,
+		Status:           b.Status,
+		AlertThresholds:  b.AlertThresholds,
+		AlertedThreshold: b.AlertedThreshold,
+		Note:             b.Note,
+	}, "po_number", "name", "total_value", "currency_id", "start_date", "end_date", "status",
+		"alert_thresholds", "alerted_threshold", "note")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Get(projectID, id)
+}
+
+// SetMilestones replaces the milestones of the budget
+func (r *controller) SetMilestones(projectID, id string, input []MilestoneInput) (*model.ProjectBudget, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "projectbudget",
+		"method":     "SetMilestones",
+		"id":         id,
+	})
+
+	b, err := r.Get(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	total := decimal.Zero
+	milestones := make([]model.ProjectBudgetMilestone, 0, len(input))
+	for _, in := range input {
+		if !in.Amount.IsPositive() {
+			return nil, ErrInvalidMilestoneAmount
+		}
+		if in.InvoiceID != nil {
+			iv, err := r.store.Invoice.One(r.repo.DB(), &invoicestore.Query{ID: in.InvoiceID.String()})
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, ErrInvoiceNotFound
+				}
+				return nil, err
+			}
+			if iv.ProjectID != b.ProjectID {
+				return nil, ErrInvoiceNotOfProject
+			}
+		}
+
+		total = total.Add(in.Amount)
+		milestones = append(milestones, model.ProjectBudgetMilestone{
+			BaseModel:       model.BaseModel{ID: model.NewUUID()},
+			ProjectBudgetID: b.ID,
+			Name:            strings.TrimSpace(in.Name),
+			Amount:          in.Amount,
+			DueDate:         in.DueDate,
+			InvoiceID:       in.InvoiceID,
+		})
+	}
+	if total.GreaterThan(b.TotalValue) {
+		return nil, ErrMilestonesOverBudget
+	}
+
+	tx, done := r.repo.NewTransaction()
+	if err := r.store.ProjectBudgetMilestone.DeleteByBudgetID(tx.DB(), id); err != nil {
+		l.Error(err, "failed to delete budget milestones")
+		return nil, done(err)
+	}
+	if _, err := r.store.ProjectBudgetMilestone.BatchCreate(tx.DB(), milestones); err != nil {
+		l.Error(err, "failed to create budget milestones")
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.Get(projectID, id)
+}
+
+func (r *controller) checkProject(projectID string) error {
+	exists, err := r.store.Project.IsExist(r.repo.DB(), projectID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrProjectNotFound
+	}
+	return nil
+}
+
+func (r *controller) applyInput(b *model.ProjectBudget, input BudgetInput) error {
+	if input.Status == "" {
+		input.Status = model.ProjectBudgetStatusActive
+	}
+	if !input.Status.IsValid() {
+		return ErrInvalidStatus
+	}
+	if !input.TotalValue.IsPositive() {
+		return ErrInvalidTotalValue
+	}
+	if input.EndDate != nil && input.EndDate.Before(input.StartDate) {
+		return ErrInvalidEndDate
+	}
+	for _, t := range input.AlertThresholds {
+		if t <= 0 {
+			return ErrInvalidThreshold
+		}
+	}
+
+	currency, err := r.store.Currency.GetByName(r.repo.DB(), strings.ToUpper(strings.TrimSpace(input.Currency)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrCurrencyNotFound
+		}
+		return err
+	}
+
+	b.PONumber = strings.TrimSpace(input.PONumber)
+	b.Name = strings.TrimSpace(input.Name)
+	b.TotalValue = input.TotalValue
+	b.CurrencyID = currency.ID
+	b.Currency = currency
+	b.StartDate = input.StartDate
+	b.EndDate = input.EndDate
+	b.Status = input.Status
+	b.AlertThresholds = nil
+	if len(input.AlertThresholds) > 0 {
+		b.AlertThresholds = pq.Int64Array(budget.Normalize(input.AlertThresholds))
+	}
+	b.Note = strings.TrimSpace(input.Note)
+
+	return nil
+}
+
+// thresholds returns the burn percents the budget is alerted on
+func (r *controller) thresholds(b *model.ProjectBudget) []int64 {
+	if len(b.AlertThresholds) > 0 {
+		return budget.Normalize(b.AlertThresholds)
+	}
+	return budget.ParseThresholds(r.config.ProjectBudget.AlertThresholds)
+}
+
+func sameThresholds(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
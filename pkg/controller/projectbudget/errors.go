@@ -0,0 +1,17 @@
+package projectbudget
+
+import "errors"
+
+var (
+	ErrBudgetNotFound         = errors.New("project budget not found")
+	ErrProjectNotFound        = errors.New("project not found")
+	ErrCurrencyNotFound       = errors.New("currency not found")
+	ErrInvoiceNotFound        = errors.New("invoice not found")
+	ErrInvoiceNotOfProject    = errors.New("invoice does not belong to the project")
+	ErrInvalidStatus          = errors.New("invalid budget status")
+	ErrInvalidTotalValue      = errors.New("total value must be positive")
+	ErrInvalidEndDate         = errors.New("end date must not be before the start date")
+	ErrInvalidThreshold       = errors.New("alert thresholds must be positive percents")
+	ErrInvalidMilestoneAmount = errors.New("milestone amount must be positive")
+	ErrMilestonesOverBudget   = errors.New("milestones add up to more than the budget")
+)
@@ -0,0 +1,49 @@
+package projectbudget
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/discord"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	fxRate  fxrate.IController
+	discord discord.IController
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+// New returns the project budget controller, the invoices and the team cost are converted to the
+// currency of the budget at the rates of the fx rate controller and the burn alerts are posted
+// through the discord log templates
+func New(store *store.Store, repo store.DBRepo, service *service.Service, fxRate fxrate.IController, discord discord.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		fxRate:  fxRate,
+		discord: discord,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	List(projectID string, status model.ProjectBudgetStatus) ([]*model.ProjectBudget, error)
+	Get(projectID, id string) (*model.ProjectBudget, error)
+	Create(projectID string, input BudgetInput, createdBy string) (*model.ProjectBudget, error)
+	Update(projectID, id string, input BudgetInput) (*model.ProjectBudget, error)
+	SetMilestones(projectID, id string, milestones []MilestoneInput) (*model.ProjectBudget, error)
+
+	Report(projectID, id string, today time.Time) (*model.ProjectBudgetReport, error)
+	AlertBurns(today time.Time) ([]*model.ProjectBudgetReport, error)
+}
@@ -0,0 +1,206 @@
+package projectbudget
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/budget"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/profitability"
+)
+
+// Report returns how much of the budget the invoices drew and its burn down, projected at the
+// current team cost of the project
+func (r *controller) Report(projectID, id string, today time.Time) (*model.ProjectBudgetReport, error) {
+	b, err := r.Get(projectID, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.report(b, today)
+}
+
+// converter turns amounts into the currency of a budget, the amounts whose currency has no rate
+// are left out with a warning
+type converter struct {
+	*controller
+	l        logger.Logger
+	currency string
+	rates    map[string]float64
+	warnings []string
+	warned   map[string]bool
+}
+
+func (c *converter) convert(amount float64, currency string, date time.Time) (float64, bool) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" || currency == c.currency {
+		return amount, true
+	}
+	if now := time.Now(); date.After(now) {
+		date = now
+	}
+
+	key := currency + date.Format("2006-01-02")
+	rate, ok := c.rates[key]
+	if !ok {
+		fxRate, err := c.fxRate.GetRateAt(currency, c.currency, date)
+		if err != nil {
+			c.l.AddField("currency", currency).Error(err, "failed to get fx rate")
+			c.warn(fmt.Sprintf("no %s/%s rate on %s, the %s amounts are left out", currency, c.currency, date.Format("2006-01-02"), currency))
+		} else {
+			rate = fxRate.Rate
+		}
+		c.rates[key] = rate
+	}
+	if rate == 0 {
+		return 0, false
+	}
+
+	return amount * rate, true
+}
+
+func (c *converter) warn(msg string) {
+	if c.warned[msg] {
+		return
+	}
+	c.warned[msg] = true
+	c.warnings = append(c.warnings, msg)
+}
+
+func (r *controller) report(b *model.ProjectBudget, today time.Time) (*model.ProjectBudgetReport, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "projectbudget",
+		"method":     "report",
+		"id":         b.ID,
+	})
+
+	c := &converter{
+		controller: r,
+		l:          l,
+		rates:      map[string]float64{},
+		warned:     map[string]bool{},
+	}
+	if b.Currency != nil {
+		c.currency = b.Currency.Name
+	}
+
+	total := b.TotalValue.InexactFloat64()
+	rs := &model.ProjectBudgetReport{
+		Budget:   b,
+		Currency: c.currency,
+		Total:    total,
+	}
+
+	invoices, err := r.store.ProjectBudget.GetInvoices(r.repo.DB(), b)
+	if err != nil {
+		return nil, err
+	}
+	draws := make([]budget.Draw, 0, len(invoices))
+	for _, iv := range invoices {
+		month := time.Date(iv.Year, time.Month(iv.Month), 1, 0, 0, 0, 0, time.UTC)
+		date := month.AddDate(0, 1, -1)
+		if iv.InvoicedAt != nil {
+			date = *iv.InvoicedAt
+		}
+
+		amount, ok := c.convert(iv.Total, iv.Currency, date)
+		if !ok {
+			continue
+		}
+		rs.Issued += amount
+		if iv.Status == model.InvoiceStatusPaid {
+			rs.Paid += amount
+		}
+		draws = append(draws, budget.Draw{Month: month, Amount: amount})
+	}
+	rs.Invoices = invoices
+
+	if b.Status == model.ProjectBudgetStatusActive && (b.EndDate == nil || !b.EndDate.Before(today)) {
+		cost, err := r.teamCost(c, b.ProjectID.String(), today)
+		if err != nil {
+			return nil, err
+		}
+		rs.MonthlyTeamCost = cost
+	}
+
+	rs.Remaining = total - rs.Issued
+	rs.BurnPercent = budget.BurnPercent(rs.Issued, total)
+
+	projection := budget.Project(total, rs.Issued, rs.MonthlyTeamCost, today, b.EndDate)
+	rs.MonthsLeft = projection.MonthsLeft
+	rs.ExhaustedAt = projection.ExhaustedAt
+	rs.ProjectedAtEnd = projection.ProjectedAtEnd
+	rs.ProjectedOverrun = projection.ProjectedOverrun
+
+	for _, p := range budget.BurnDown(total, b.StartDate, b.EndDate, draws, rs.MonthlyTeamCost, today) {
+		rs.Months = append(rs.Months, model.ProjectBudgetMonth{
+			Month:     p.Month,
+			Drawn:     p.Drawn,
+			Burned:    p.Burned,
+			Remaining: p.Remaining,
+			Projected: p.Projected,
+		})
+	}
+
+	rs.Warnings = c.warnings
+	return rs, nil
+}
+
+// teamCost is what the members deployed on the project this month cost, their share of the
+// month at their current base salary
+func (r *controller) teamCost(c *converter, projectID string, today time.Time) (float64, error) {
+	db := r.repo.DB()
+	month := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	members, err := r.store.Profitability.GetMembers(db, month, month.AddDate(0, 1, 0))
+	if err != nil {
+		return 0, err
+	}
+	salaries, err := r.store.CashFlow.GetBaseSalaries(db)
+	if err != nil {
+		return 0, err
+	}
+	baseSalary := map[string]model.CashFlowSalary{}
+	for _, s := range salaries {
+		baseSalary[s.EmployeeID] = s
+	}
+
+	names := map[string]string{}
+	assignments := make([]profitability.Assignment, 0, len(members))
+	for _, m := range members {
+		names[m.EmployeeID] = m.FullName
+		assignments = append(assignments, profitability.Assignment{
+			ProjectID:      m.ProjectID,
+			EmployeeID:     m.EmployeeID,
+			DeploymentType: m.DeploymentType,
+			StartDate:      m.StartDate,
+			EndDate:        m.EndDate,
+		})
+	}
+
+	weights := profitability.Weights{
+		Official: 1,
+		PartTime: r.config.Profitability.PartTimeAllocation,
+		Shadow:   r.config.Profitability.ShadowAllocation,
+	}
+
+	var cost float64
+	for _, a := range profitability.Allocate(month, assignments, weights) {
+		if a.ProjectID != projectID {
+			continue
+		}
+		s, ok := baseSalary[a.EmployeeID]
+		if !ok {
+			c.warn(fmt.Sprintf("%s has no base salary and is left out of the team cost", names[a.EmployeeID]))
+			continue
+		}
+		amount, ok := c.convert(s.Amount, s.Currency, today)
+		if !ok {
+			continue
+		}
+		cost += a.Share * amount
+	}
+
+	return cost, nil
+}
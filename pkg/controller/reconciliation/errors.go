@@ -0,0 +1,18 @@
+package reconciliation
+
+import "errors"
+
+var (
+	ErrUnsupportedFormat      = errors.New("unsupported bank statement format, expected csv, ofx or camt053")
+	ErrInvalidStatement       = errors.New("invalid bank statement")
+	ErrTransactionNotFound    = errors.New("bank transaction not found")
+	ErrTransactionMatched     = errors.New("bank transaction is already matched")
+	ErrNoMatchToConfirm       = errors.New("bank transaction has no match to confirm")
+	ErrInvalidMatchType       = errors.New("invalid match type")
+	ErrMatchDirection         = errors.New("invoices are matched with money in, payrolls and payouts with money out")
+	ErrRecordAlreadyMatched   = errors.New("record is already matched with another bank transaction")
+	ErrInvoiceNumberMissing   = errors.New("invoice number is required to confirm an invoice match")
+	ErrInvoiceNotFound        = errors.New("invoice not found")
+	ErrNothingToReject        = errors.New("bank transaction has no suggested match")
+	ErrMatchedCannotBeIgnored = errors.New("a matched bank transaction can't be ignored")
+)
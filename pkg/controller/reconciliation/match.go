@@ -0,0 +1,345 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/bankstatement"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/invoice"
+	"github.com/dwarvesf/fortress-api/pkg/store/payroll"
+)
+
+const (
+	// payrollWindowDays is how far from its due date a payroll transfer is expected
+	payrollWindowDays = 10
+	// payoutWindowDays is how far from its payment date a contractor payout transfer is expected
+	payoutWindowDays = 7
+	// maxOpenInvoices bounds the invoices loaded as candidates
+	maxOpenInvoices = 1000
+)
+
+func (r *controller) matchOptions() bankstatement.Options {
+	return bankstatement.Options{
+		AmountTolerance: r.config.BankReconciliation.AmountTolerance,
+		AutoMatchScore:  r.config.BankReconciliation.AutoMatchScore,
+		ReviewScore:     r.config.BankReconciliation.ReviewScore,
+	}
+}
+
+// matchTransactions finds the best match of each transaction and stores it. The certain matches
+// are confirmed right away, the others are left in the review queue.
+func (r *controller) matchTransactions(l logger.Logger, transactions []*model.BankTransaction, result *model.BankStatementImportResult) {
+	opts := r.matchOptions()
+	pool := r.loadCandidates(l, transactions)
+
+	for _, t := range transactions {
+		candidates := pool.candidatesFor(t)
+		best, ambiguous := bankstatement.Best(t, candidates, opts)
+		status := bankstatement.Status(best, ambiguous, opts)
+
+		t.ClearMatch()
+		if best != nil {
+			t.Status = status
+			t.MatchType = best.Type
+			t.MatchID = best.ID
+			t.MatchReference = best.Reference
+			t.MatchScore = best.Score
+			t.MatchNote = "matched on " + strings.Join(best.Reasons, ", ")
+			if ambiguous {
+				t.MatchNote += "; other candidates have the same score"
+			}
+		}
+
+		if t.Status == model.BankTransactionStatusMatched {
+			if err := r.settle(t); err != nil {
+				l.AddField("bankTransactionID", t.ID).Error(err, "failed to settle the matched record")
+				t.Status = model.BankTransactionStatusReview
+				t.MatchNote = fmt.Sprintf("%s; auto confirmation failed: %v", t.MatchNote, err)
+			} else {
+				now := time.Now()
+				t.MatchedAt = &now
+				pool.remove(best.Candidate)
+			}
+		}
+
+		if _, err := r.store.BankTransaction.UpdateSelectedFieldsByID(r.repo.DB(), t.ID.String(), *t, matchFields...); err != nil {
+			l.AddField("bankTransactionID", t.ID).Error(err, "failed to store bank transaction match")
+			continue
+		}
+
+		switch t.Status {
+		case model.BankTransactionStatusMatched:
+			result.Matched++
+		case model.BankTransactionStatusReview:
+			result.Review++
+		default:
+			result.Unmatched++
+		}
+	}
+}
+
+// matchFields are the columns written when a match changes
+var matchFields = []string{"status", "match_type", "match_id", "match_reference", "match_score", "match_note", "matched_at", "matched_by"}
+
+// settle records the payment on the matched record. The payrolls and contractor payouts
+// are committed before the money is sent, so only the invoices need an update.
+func (r *controller) settle(t *model.BankTransaction) error {
+	if t.MatchType != model.BankMatchTypeInvoice {
+		return nil
+	}
+	if t.MatchReference == "" {
+		return ErrInvoiceNumberMissing
+	}
+
+	_, err := r.invoice.MarkInvoiceAsPaidByNumber(t.MatchReference, false)
+	return err
+}
+
+// candidatePool holds the records the transactions of an import are matched against
+type candidatePool struct {
+	credits []bankstatement.Candidate
+	debits  []bankstatement.Candidate
+}
+
+func (p *candidatePool) candidatesFor(t *model.BankTransaction) []bankstatement.Candidate {
+	if t.Direction == model.BankTransactionDirectionDebit {
+		return p.debits
+	}
+
+	// invoice numbers written in the memo are suggested even when the invoice is not in the
+	// database, MarkInvoiceAsPaidByNumber also looks them up in Notion
+	candidates := p.credits
+	for _, number := range bankstatement.InvoiceNumbers(t.Text()) {
+		if !containsReference(candidates, number) {
+			candidates = append(candidates, bankstatement.Candidate{
+				Type:      model.BankMatchTypeInvoice,
+				Reference: number,
+				Keywords:  []string{number},
+			})
+		}
+	}
+	return candidates
+}
+
+// remove takes a matched record out of the pool, a record is settled by one transaction
+func (p *candidatePool) remove(c bankstatement.Candidate) {
+	p.credits = removeCandidate(p.credits, c)
+	p.debits = removeCandidate(p.debits, c)
+}
+
+func removeCandidate(candidates []bankstatement.Candidate, c bankstatement.Candidate) []bankstatement.Candidate {
+	rs := candidates[:0:0]
+	for _, x := range candidates {
+		if x.Type == c.Type && x.ID == c.ID && x.Reference == c.Reference {
+			continue
+		}
+		rs = append(rs, x)
+	}
+	return rs
+}
+
+func containsReference(candidates []bankstatement.Candidate, reference string) bool {
+	for _, c := range candidates {
+		if c.Type == model.BankMatchTypeInvoice && strings.EqualFold(c.Reference, reference) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadCandidates loads the open invoices for the money in, and the committed payrolls and
+// contractor payouts around the dates of the money out. A source failing to load is logged
+// and skipped so the other matches still go through.
+func (r *controller) loadCandidates(l logger.Logger, transactions []*model.BankTransaction) *candidatePool {
+	pool := &candidatePool{}
+
+	var hasCredit bool
+	var debitFrom, debitTo *time.Time
+	for _, t := range transactions {
+		if t.Direction == model.BankTransactionDirectionCredit {
+			hasCredit = true
+			continue
+		}
+		d := t.BookedAt
+		if debitFrom == nil || d.Before(*debitFrom) {
+			debitFrom = &d
+		}
+		if debitTo == nil || d.After(*debitTo) {
+			debitTo = &d
+		}
+	}
+
+	if hasCredit {
+		invoices, err := r.invoiceCandidates()
+		if err != nil {
+			l.Error(err, "failed to load open invoices")
+		}
+		pool.credits = append(pool.credits, invoices...)
+	}
+
+	if debitFrom != nil {
+		payrolls, err := r.payrollCandidates(*debitFrom, *debitTo)
+		if err != nil {
+			l.Error(err, "failed to load committed payrolls")
+		}
+		pool.debits = append(pool.debits, payrolls...)
+
+		payouts, err := r.payoutCandidates(*debitFrom, *debitTo)
+		if err != nil {
+			l.Error(err, "failed to load contractor payouts")
+		}
+		pool.debits = append(pool.debits, payouts...)
+	}
+
+	pool.credits = r.withoutMatched(l, pool.credits)
+	pool.debits = r.withoutMatched(l, pool.debits)
+
+	return pool
+}
+
+// withoutMatched drops the records already reconciled with a previous statement
+func (r *controller) withoutMatched(l logger.Logger, candidates []bankstatement.Candidate) []bankstatement.Candidate {
+	idsByType := map[model.BankMatchType][]string{}
+	for _, c := range candidates {
+		if c.ID != "" {
+			idsByType[c.Type] = append(idsByType[c.Type], c.ID)
+		}
+	}
+
+	matched := map[string]bool{}
+	for matchType, ids := range idsByType {
+		matchedIDs, err := r.store.BankTransaction.GetMatchedIDs(r.repo.DB(), matchType, ids)
+		if err != nil {
+			l.Error(err, "failed to get matched records")
+			continue
+		}
+		for _, id := range matchedIDs {
+			matched[matchType.String()+id] = true
+		}
+	}
+
+	rs := make([]bankstatement.Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if !matched[c.Type.String()+c.ID] {
+			rs = append(rs, c)
+		}
+	}
+	return rs
+}
+
+func (r *controller) invoiceCandidates() ([]bankstatement.Candidate, error) {
+	invoices, _, err := r.store.Invoice.All(r.repo.DB(), invoice.GetInvoicesFilter{
+		Preload:  true,
+		Statuses: []string{model.InvoiceStatusSent.String(), model.InvoiceStatusOverdue.String()},
+	}, model.Pagination{Page: 1, Size: maxOpenInvoices})
+	if err != nil {
+		return nil, err
+	}
+
+	window := time.Duration(r.config.BankReconciliation.DateWindowDays) * 24 * time.Hour
+	candidates := make([]bankstatement.Candidate, 0, len(invoices))
+	for _, iv := range invoices {
+		c := bankstatement.Candidate{
+			Type:      model.BankMatchTypeInvoice,
+			ID:        iv.ID.String(),
+			Reference: iv.Number,
+			Amount:    iv.Total,
+			Keywords:  []string{iv.Number},
+			From:      iv.InvoicedAt,
+		}
+		if iv.Bank != nil && iv.Bank.Currency != nil {
+			c.Currency = iv.Bank.Currency.Name
+		}
+
+		switch {
+		case iv.DueAt != nil:
+			to := iv.DueAt.Add(window)
+			c.To = &to
+		case iv.InvoicedAt != nil:
+			to := iv.InvoicedAt.Add(window)
+			c.To = &to
+		}
+
+		candidates = append(candidates, c)
+	}
+
+	return candidates, nil
+}
+
+// payrollCandidates loads the paid payrolls of the months of the transfers and the months before,
+// the payroll of a month being sent at its end or early the next month
+func (r *controller) payrollCandidates(from, to time.Time) ([]bankstatement.Candidate, error) {
+	var candidates []bankstatement.Candidate
+
+	start := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+	for month := start; !month.After(to); month = month.AddDate(0, 1, 0) {
+		payrolls, err := r.store.Payroll.GetList(r.repo.DB(), payroll.GetListPayrollInput{
+			Month: int(month.Month()),
+			Year:  month.Year(),
+		})
+		if err != nil {
+			return candidates, err
+		}
+
+		for _, p := range payrolls {
+			if !p.IsPaid || p.Total <= 0 {
+				continue
+			}
+
+			due := month.AddDate(0, 1, -1)
+			if p.DueDate != nil {
+				due = *p.DueDate
+			}
+			windowFrom, windowTo := due.AddDate(0, 0, -payrollWindowDays), due.AddDate(0, 0, payrollWindowDays)
+
+			candidates = append(candidates, bankstatement.Candidate{
+				Type:      model.BankMatchTypePayroll,
+				ID:        p.ID.String(),
+				Reference: fmt.Sprintf("%s - %02d/%d", p.Employee.FullName, p.Month, p.Year),
+				Amount:    float64(p.Total),
+				Currency:  "VND",
+				From:      &windowFrom,
+				To:        &windowTo,
+				Keywords:  []string{p.Employee.FullName, p.Employee.DisplayName},
+			})
+		}
+	}
+
+	return candidates, nil
+}
+
+func (r *controller) payoutCandidates(from, to time.Time) ([]bankstatement.Candidate, error) {
+	if r.service.Notion == nil || r.service.Notion.ContractorPayables == nil {
+		return nil, nil
+	}
+
+	payables, err := r.service.Notion.ContractorPayables.QueryPaidPayablesByPaymentDate(context.Background(),
+		from.AddDate(0, 0, -payoutWindowDays), to.AddDate(0, 0, payoutWindowDays))
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]bankstatement.Candidate, 0, len(payables))
+	for _, p := range payables {
+		c := bankstatement.Candidate{
+			Type:      model.BankMatchTypeContractorPayout,
+			ID:        p.PageID,
+			Reference: p.ContractorName,
+			Amount:    p.Total,
+			Currency:  strings.ToUpper(p.Currency),
+			Keywords:  []string{p.ContractorName, p.Discord},
+		}
+		if paidAt, err := time.Parse("2006-01-02", p.PaymentDate); err == nil {
+			windowFrom, windowTo := paidAt.AddDate(0, 0, -payoutWindowDays), paidAt.AddDate(0, 0, payoutWindowDays)
+			c.From, c.To = &windowFrom, &windowTo
+		}
+
+		candidates = append(candidates, c)
+	}
+
+	return candidates, nil
+}
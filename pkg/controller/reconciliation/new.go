@@ -0,0 +1,46 @@
+package reconciliation
+
+import (
+	"io"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/invoice"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	invoice invoice.IController
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+// New returns the reconciliation controller, confirming an invoice match marks the
+// invoice as paid through the invoice controller
+func New(store *store.Store, repo store.DBRepo, service *service.Service, invoice invoice.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		invoice: invoice,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	Import(input ImportInput, data io.Reader) (*model.BankStatementImportResult, error)
+	ListStatements(pagination model.Pagination) ([]*model.BankStatement, int64, error)
+
+	ListTransactions(input ListTransactionsInput, pagination model.Pagination) ([]*model.BankTransaction, int64, error)
+	GetTransaction(id string) (*model.BankTransaction, error)
+	ConfirmMatch(id string, input ConfirmMatchInput) (*model.BankTransaction, error)
+	RejectMatch(id string) (*model.BankTransaction, error)
+	Ignore(id string, note string) (*model.BankTransaction, error)
+	Rematch() (*model.BankStatementImportResult, error)
+}
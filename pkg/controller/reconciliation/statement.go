@@ -0,0 +1,108 @@
+package reconciliation
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dwarvesf/fortress-api/pkg/bankstatement"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ImportInput struct {
+	FileName   string
+	Format     model.BankStatementFormat
+	ImportedBy *model.UUID
+}
+
+// Import parses a statement, stores its new transactions and matches them. The transactions
+// already imported with a previous statement are skipped.
+func (r *controller) Import(input ImportInput, data io.Reader) (*model.BankStatementImportResult, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "reconciliation",
+		"method":     "Import",
+		"fileName":   input.FileName,
+	})
+
+	format := input.Format
+	if format == "" {
+		format = model.BankStatementFormatFromFileName(input.FileName)
+	}
+	if !format.IsValid() {
+		return nil, ErrUnsupportedFormat
+	}
+
+	parsed, err := bankstatement.Parse(format, data)
+	if err != nil {
+		// every parser error comes from the content of the file
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatement, err)
+	}
+
+	fingerprints := make([]string, 0, len(parsed.Transactions))
+	for _, t := range parsed.Transactions {
+		fingerprints = append(fingerprints, t.Fingerprint)
+	}
+	existing, err := r.store.BankTransaction.GetExistingFingerprints(r.repo.DB(), fingerprints)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, f := range existing {
+		seen[f] = true
+	}
+
+	newTransactions := make([]model.BankTransaction, 0, len(parsed.Transactions))
+	for _, t := range parsed.Transactions {
+		if seen[t.Fingerprint] {
+			continue
+		}
+		seen[t.Fingerprint] = true
+		newTransactions = append(newTransactions, t)
+	}
+
+	periodStart, periodEnd := parsed.Period()
+	result := &model.BankStatementImportResult{
+		Duplicates: len(parsed.Transactions) - len(newTransactions),
+		Imported:   len(newTransactions),
+	}
+
+	tx, done := r.repo.NewTransaction()
+	statement, err := r.store.BankStatement.Create(tx.DB(), &model.BankStatement{
+		FileName:         input.FileName,
+		Format:           format,
+		AccountNumber:    parsed.AccountNumber,
+		Currency:         parsed.Currency,
+		PeriodStart:      periodStart,
+		PeriodEnd:        periodEnd,
+		TransactionCount: len(newTransactions),
+		ImportedBy:       input.ImportedBy,
+	})
+	if err != nil {
+		return nil, done(err)
+	}
+
+	for i := range newTransactions {
+		newTransactions[i].BankStatementID = statement.ID
+	}
+	if err := r.store.BankTransaction.CreateMany(tx.DB(), newTransactions); err != nil {
+		return nil, done(fmt.Errorf("failed to store bank transactions: %w", err))
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+	result.Statement = statement
+
+	// the transactions are stored before being matched, so a failure while marking an
+	// invoice as paid never loses the import
+	toMatch := make([]*model.BankTransaction, 0, len(newTransactions))
+	for i := range newTransactions {
+		toMatch = append(toMatch, &newTransactions[i])
+	}
+	r.matchTransactions(l, toMatch, result)
+
+	return result, nil
+}
+
+func (r *controller) ListStatements(pagination model.Pagination) ([]*model.BankStatement, int64, error) {
+	return r.store.BankStatement.All(r.repo.DB(), pagination)
+}
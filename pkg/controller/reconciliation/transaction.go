@@ -0,0 +1,182 @@
+package reconciliation
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/banktransaction"
+	"github.com/dwarvesf/fortress-api/pkg/store/invoice"
+)
+
+type ListTransactionsInput struct {
+	StatementID string
+	Statuses    []model.BankTransactionStatus
+	Direction   model.BankTransactionDirection
+	MatchType   model.BankMatchType
+}
+
+func (r *controller) ListTransactions(input ListTransactionsInput, pagination model.Pagination) ([]*model.BankTransaction, int64, error) {
+	return r.store.BankTransaction.All(r.repo.DB(), banktransaction.Query{
+		StatementID: input.StatementID,
+		Statuses:    input.Statuses,
+		Direction:   input.Direction,
+		MatchType:   input.MatchType,
+	}, pagination)
+}
+
+func (r *controller) GetTransaction(id string) (*model.BankTransaction, error) {
+	t, err := r.store.BankTransaction.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// ConfirmMatchInput confirms the suggested match when the match fields are empty,
+// otherwise the transaction is matched manually with the given record
+type ConfirmMatchInput struct {
+	MatchType      model.BankMatchType
+	MatchID        string
+	MatchReference string
+	MatchedBy      *model.UUID
+}
+
+// ConfirmMatch reconciles a transaction from the review queue. Confirming an invoice
+// match marks the invoice as paid.
+func (r *controller) ConfirmMatch(id string, input ConfirmMatchInput) (*model.BankTransaction, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "reconciliation",
+		"method":     "ConfirmMatch",
+		"id":         id,
+	})
+
+	t, err := r.GetTransaction(id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status == model.BankTransactionStatusMatched {
+		return nil, ErrTransactionMatched
+	}
+
+	if input.MatchType != "" {
+		if !input.MatchType.IsValid() {
+			return nil, ErrInvalidMatchType
+		}
+		t.MatchType = input.MatchType
+		t.MatchID = input.MatchID
+		t.MatchReference = input.MatchReference
+		t.MatchScore = 0
+		t.MatchNote = "matched manually"
+	}
+	if t.MatchType == "" {
+		return nil, ErrNoMatchToConfirm
+	}
+
+	wantDirection := model.BankTransactionDirectionDebit
+	if t.MatchType == model.BankMatchTypeInvoice {
+		wantDirection = model.BankTransactionDirectionCredit
+	}
+	if t.Direction != wantDirection {
+		return nil, ErrMatchDirection
+	}
+
+	if t.MatchType == model.BankMatchTypeInvoice && t.MatchReference == "" {
+		if t.MatchID == "" {
+			return nil, ErrInvoiceNumberMissing
+		}
+		iv, err := r.store.Invoice.One(r.repo.DB(), &invoice.Query{ID: t.MatchID})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrInvoiceNotFound
+			}
+			return nil, err
+		}
+		t.MatchReference = iv.Number
+	}
+
+	if t.MatchID != "" {
+		matchedIDs, err := r.store.BankTransaction.GetMatchedIDs(r.repo.DB(), t.MatchType, []string{t.MatchID})
+		if err != nil {
+			return nil, err
+		}
+		if len(matchedIDs) > 0 {
+			return nil, ErrRecordAlreadyMatched
+		}
+	}
+
+	if err := r.settle(t); err != nil {
+		l.Error(err, "failed to settle the matched record")
+		return nil, err
+	}
+
+	now := time.Now()
+	t.Status = model.BankTransactionStatusMatched
+	t.MatchedAt = &now
+	t.MatchedBy = input.MatchedBy
+
+	return r.store.BankTransaction.UpdateSelectedFieldsByID(r.repo.DB(), t.ID.String(), *t, matchFields...)
+}
+
+// RejectMatch drops the suggested match, the transaction is left unmatched
+func (r *controller) RejectMatch(id string) (*model.BankTransaction, error) {
+	t, err := r.GetTransaction(id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status == model.BankTransactionStatusMatched {
+		return nil, ErrTransactionMatched
+	}
+	if t.MatchType == "" {
+		return nil, ErrNothingToReject
+	}
+
+	t.ClearMatch()
+	return r.store.BankTransaction.UpdateSelectedFieldsByID(r.repo.DB(), t.ID.String(), *t, matchFields...)
+}
+
+// Ignore takes a transaction out of the reconciliation, e.g. bank fees or internal transfers
+func (r *controller) Ignore(id string, note string) (*model.BankTransaction, error) {
+	t, err := r.GetTransaction(id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status == model.BankTransactionStatusMatched {
+		return nil, ErrMatchedCannotBeIgnored
+	}
+
+	t.ClearMatch()
+	t.Status = model.BankTransactionStatusIgnored
+	t.MatchNote = note
+	return r.store.BankTransaction.UpdateSelectedFieldsByID(r.repo.DB(), t.ID.String(), *t, matchFields...)
+}
+
+// Rematch runs the matching again on the transactions left unmatched, e.g. after the
+// invoices or the payrolls they settle were created
+func (r *controller) Rematch() (*model.BankStatementImportResult, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "reconciliation",
+		"method":     "Rematch",
+	})
+
+	pagination := model.Pagination{Page: 1}
+	pagination.Standardize()
+
+	transactions, _, err := r.store.BankTransaction.All(r.repo.DB(), banktransaction.Query{
+		Statuses: []model.BankTransactionStatus{model.BankTransactionStatusUnmatched},
+	}, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.BankStatementImportResult{}
+	r.matchTransactions(l, transactions, result)
+
+	return result, nil
+}
@@ -0,0 +1,260 @@
+package recruitment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/recruitment"
+	recruitmentstore "github.com/dwarvesf/fortress-api/pkg/store/recruitment"
+	"github.com/dwarvesf/fortress-api/pkg/store/recruitmentstage"
+)
+
+type ListCandidatesInput struct {
+	PositionID string
+	StageID    string
+	Status     model.CandidateStatus
+	Keyword    string
+}
+
+type CandidateInput struct {
+	Name              string
+	Email             string
+	Phone             string
+	Role              string
+	Source            string
+	Type              model.HiringType
+	PositionID        *model.UUID
+	Detail            string
+	CCAT              int
+	EPP               int
+	ReferralInfo      *model.ReferralInfo
+	OfferSalary       int
+	OfferStartDate    *time.Time
+	ProbationDuration int
+	SkipEmail         bool // on creation, do not send the email of the first stage
+}
+
+func (r *controller) ListCandidates(input ListCandidatesInput, pagination model.Pagination) ([]*model.Candidate, int64, error) {
+	return r.store.Recruitment.All(r.repo.DB(), recruitmentstore.Query{
+		PositionID: input.PositionID,
+		StageID:    input.StageID,
+		Status:     input.Status,
+		Keyword:    strings.TrimSpace(input.Keyword),
+	}, pagination)
+}
+
+func (r *controller) GetCandidate(id string) (*model.Candidate, error) {
+	candidate, err := r.store.Recruitment.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCandidateNotFound
+		}
+		return nil, err
+	}
+	return candidate, nil
+}
+
+// CreateCandidate puts a new candidate in the first stage of the pipeline of its position
+func (r *controller) CreateCandidate(input CandidateInput, actorID *model.UUID) (*model.Candidate, error) {
+	if input.PositionID != nil {
+		if err := r.checkPosition(*input.PositionID); err != nil {
+			return nil, err
+		}
+	}
+
+	pipeline, err := r.pipeline(input.PositionID)
+	if err != nil {
+		return nil, err
+	}
+	first := recruitment.FirstStage(pipeline)
+	if first == nil {
+		return nil, ErrEmptyPipeline
+	}
+
+	candidate := &model.Candidate{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		Status:    first.CandidateStatus,
+		StageID:   &first.ID,
+	}
+	if err := applyCandidateInput(candidate, input); err != nil {
+		return nil, err
+	}
+
+	transition := &model.CandidateStageTransition{
+		CandidateID: candidate.ID,
+		ToStageID:   first.ID,
+		ActorID:     actorID,
+		Note:        "candidate created",
+	}
+	if !input.SkipEmail {
+		transition.EmailTemplate = first.EmailTemplate
+	}
+
+	tx, done := r.repo.NewTransaction()
+	if _, err := r.store.Recruitment.Create(tx.DB(), candidate); err != nil {
+		return nil, done(err)
+	}
+	if _, err := r.store.CandidateTransition.Create(tx.DB(), transition); err != nil {
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	r.sendStageMail(candidate, transition)
+	r.syncReferral(candidate)
+
+	return r.GetCandidate(candidate.ID.String())
+}
+
+// UpdateCandidate changes the details of a candidate, the stage is changed by a transition
+func (r *controller) UpdateCandidate(id string, input CandidateInput) (*model.Candidate, error) {
+	candidate, err := r.GetCandidate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !samePosition(candidate.PositionID, input.PositionID) {
+		if input.PositionID != nil {
+			if err := r.checkPosition(*input.PositionID); err != nil {
+				return nil, err
+			}
+		}
+
+		// the candidate has to be in the pipeline of its new position
+		pipeline, err := r.pipeline(input.PositionID)
+		if err != nil {
+			return nil, err
+		}
+		if candidate.Stage != nil && recruitment.CheckTransition(pipeline, nil, candidate.Stage) != nil {
+			return nil, recruitment.ErrStageNotInPipeline
+		}
+	}
+
+	if err := applyCandidateInput(candidate, input); err != nil {
+		return nil, err
+	}
+
+	// the loaded associations are left out of the update
+	update := *candidate
+	update.Position, update.Stage, update.Transitions, update.Interviews = nil, nil, nil, nil
+
+	_, err = r.store.Recruitment.UpdateSelectedFieldsByID(r.repo.DB(), id, update,
+		"name", "email", "phone", "role", "source", "type", "position_id", "detail",
+		"CCAT", "EPP", "is_referral", "referral_info", "offer_salary", "offer_start_date", "probation_duration")
+	if err != nil {
+		return nil, err
+	}
+
+	r.syncReferral(candidate)
+
+	return r.GetCandidate(id)
+}
+
+// UploadCV stores the CV of a candidate on GCS and links it to the candidate
+func (r *controller) UploadCV(id string, file *multipart.FileHeader) (*model.Candidate, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "recruitment",
+		"method":     "UploadCV",
+		"id":         id,
+	})
+
+	if model.ContentExtension(strings.ToLower(filepath.Ext(file.Filename))) != model.ContentExtensionPdf {
+		return nil, ErrInvalidFileExtension
+	}
+	if file.Size > model.MaxFileSizePdf {
+		return nil, ErrInvalidFileSize
+	}
+
+	candidate, err := r.GetCandidate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	gcsPath := fmt.Sprintf("candidates/%s/cv/%d%s", candidate.ID, time.Now().Unix(), model.ContentExtensionPdf)
+	cvURL := fmt.Sprintf("https://storage.googleapis.com/%s/%s", r.config.Google.GCSBucketName, gcsPath)
+
+	content, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	if err := r.service.GoogleStorage.UploadContentGCS(content, gcsPath); err != nil {
+		l.Error(err, "failed to upload cv to gcs")
+		return nil, err
+	}
+
+	if _, err := r.store.Recruitment.UpdateSelectedFieldsByID(r.repo.DB(), id, model.Candidate{CVUrl: cvURL}, "cv_url"); err != nil {
+		return nil, err
+	}
+
+	return r.GetCandidate(id)
+}
+
+// pipeline returns the stages of the position, the default ones when it has none
+func (r *controller) pipeline(positionID *model.UUID) ([]*model.RecruitmentStage, error) {
+	query := recruitmentstage.Query{}
+	if positionID != nil {
+		query.PositionID = positionID.String()
+	}
+
+	stages, err := r.store.RecruitmentStage.All(r.repo.DB(), query)
+	if err != nil {
+		return nil, err
+	}
+	return recruitment.Pipeline(stages, positionID), nil
+}
+
+func (r *controller) checkPosition(id model.UUID) error {
+	if _, err := r.store.Position.One(r.repo.DB(), id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrPositionNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func applyCandidateInput(candidate *model.Candidate, input CandidateInput) error {
+	candidate.Name = strings.TrimSpace(input.Name)
+	candidate.Email = strings.TrimSpace(input.Email)
+	candidate.Phone = strings.TrimSpace(input.Phone)
+	candidate.Role = input.Role
+	candidate.Source = input.Source
+	candidate.Type = input.Type
+	candidate.PositionID = input.PositionID
+	candidate.Detail = input.Detail
+	candidate.CCAT = input.CCAT
+	candidate.EPP = input.EPP
+	candidate.OfferSalary = input.OfferSalary
+	candidate.OfferStartDate = input.OfferStartDate
+	candidate.ProbationDuration = input.ProbationDuration
+
+	candidate.IsReferral = input.ReferralInfo != nil || input.Type == model.HiringTypeReferral
+	candidate.ReferralInfo = nil
+	if input.ReferralInfo != nil {
+		info, err := json.Marshal(input.ReferralInfo)
+		if err != nil {
+			return err
+		}
+		candidate.ReferralInfo = info
+	}
+	return nil
+}
+
+func samePosition(a, b *model.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
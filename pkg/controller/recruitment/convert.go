@@ -0,0 +1,136 @@
+package recruitment
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/employee"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// ConvertInput completes what the candidate record does not know about the new employee,
+// the fields left empty are taken from the candidate
+type ConvertInput struct {
+	TeamEmail   string
+	DisplayName string
+	SeniorityID model.UUID
+	Roles       []model.UUID
+	Positions   []model.UUID
+	Salary      *int64
+	JoinDate    *time.Time
+	Status      model.WorkingStatus
+	ReferredBy  *model.UUID
+	SkipEmail   bool
+}
+
+// ConvertToEmployee creates the employee of a hired candidate along with its invitation,
+// pre-filled with the name, the email, the position and the offer of the candidate
+func (r *controller) ConvertToEmployee(id string, userID string, input ConvertInput) (*model.Employee, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "recruitment",
+		"method":     "ConvertToEmployee",
+		"id":         id,
+	})
+
+	candidate, err := r.GetCandidate(id)
+	if err != nil {
+		return nil, err
+	}
+	if candidate.Status != model.HiredCandidateStatus {
+		return nil, ErrCandidateNotHired
+	}
+	if candidate.EmployeeID != nil {
+		return nil, ErrCandidateAlreadyConvert
+	}
+
+	joinDate := input.JoinDate
+	if joinDate == nil {
+		joinDate = candidate.OfferStartDate
+	}
+	if joinDate == nil {
+		return nil, ErrMissingJoinDate
+	}
+
+	salary := int64(candidate.OfferSalary)
+	if input.Salary != nil {
+		salary = *input.Salary
+	}
+
+	positions := input.Positions
+	if len(positions) == 0 && candidate.PositionID != nil {
+		positions = []model.UUID{*candidate.PositionID}
+	}
+
+	status := input.Status
+	if status == "" {
+		status = model.WorkingStatusProbation
+	}
+
+	displayName := input.DisplayName
+	if displayName == "" {
+		displayName = candidate.Name
+	}
+
+	referredBy := model.UUID{}
+	if input.ReferredBy != nil {
+		referredBy = *input.ReferredBy
+	} else if ref, err := r.store.Referral.OneByCandidateID(r.repo.DB(), id); err == nil {
+		referredBy = ref.ReferrerID
+	} else if referrer := r.referrer(candidate); referrer != nil {
+		referredBy = referrer.ID
+	}
+
+	emp, err := r.employee.Create(userID, employee.CreateEmployeeInput{
+		FullName:      candidate.Name,
+		DisplayName:   displayName,
+		TeamEmail:     strings.TrimSpace(input.TeamEmail),
+		PersonalEmail: candidate.Email,
+		Positions:     positions,
+		Salary:        salary,
+		SeniorityID:   input.SeniorityID,
+		Roles:         input.Roles,
+		Status:        status.String(),
+		ReferredBy:    referredBy,
+		JoinDate:      joinDate,
+		SkipEmail:     input.SkipEmail,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.store.Recruitment.UpdateSelectedFieldsByID(r.repo.DB(), id, model.Candidate{EmployeeID: &emp.ID}, "employee_id"); err != nil {
+		l.Error(err, "failed to link candidate to employee")
+		return nil, err
+	}
+
+	candidate.EmployeeID = &emp.ID
+	r.syncReferral(candidate)
+
+	return emp, nil
+}
+
+// referrer finds the employee who referred the candidate by the email of the referral info
+func (r *controller) referrer(candidate *model.Candidate) *model.Employee {
+	if !candidate.IsReferral || len(candidate.ReferralInfo) == 0 {
+		return nil
+	}
+
+	var info model.ReferralInfo
+	if err := json.Unmarshal(candidate.ReferralInfo, &info); err != nil || info.Email == "" {
+		return nil
+	}
+
+	referrer, err := r.store.Employee.OneByEmail(r.repo.DB(), info.Email)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			r.logger.AddField("email", info.Email).Error(err, "failed to get referrer")
+		}
+		return nil
+	}
+	return referrer
+}
@@ -0,0 +1,25 @@
+package recruitment
+
+import "errors"
+
+var (
+	ErrStageNotFound           = errors.New("recruitment stage not found")
+	ErrStageInUse              = errors.New("recruitment stage still has candidates")
+	ErrStageCodeExisted        = errors.New("recruitment stage code already exists in the pipeline")
+	ErrInvalidCandidateStatus  = errors.New("invalid candidate status")
+	ErrInvalidEmailTemplate    = errors.New("invalid email template")
+	ErrPositionNotFound        = errors.New("position not found")
+	ErrEmptyPipeline           = errors.New("no recruitment stage for the position")
+	ErrCandidateNotFound       = errors.New("candidate not found")
+	ErrInvalidFileExtension    = errors.New("invalid file extension, expected pdf")
+	ErrInvalidFileSize         = errors.New("file size is too large")
+	ErrMissingOffer            = errors.New("offer salary and start date are required to offer the candidate")
+	ErrInterviewNotFound       = errors.New("interview not found")
+	ErrInterviewerNotFound     = errors.New("interviewer not found")
+	ErrInterviewerAssigned     = errors.New("interviewer already assigned to the candidate at this stage")
+	ErrNotInterviewer          = errors.New("only the assigned interviewer can fill the scorecard")
+	ErrInvalidRecommendation   = errors.New("invalid recommendation")
+	ErrCandidateNotHired       = errors.New("candidate is not hired")
+	ErrCandidateAlreadyConvert = errors.New("candidate is already an employee")
+	ErrMissingJoinDate         = errors.New("join date is required when the candidate has no offer start date")
+)
@@ -0,0 +1,117 @@
+package recruitment
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/recruitment"
+)
+
+type InterviewInput struct {
+	InterviewerID model.UUID
+	StageID       *model.UUID // the current stage of the candidate when empty
+	ScheduledAt   *time.Time
+}
+
+type ScorecardInput struct {
+	UserID         string
+	Scores         map[string]int
+	Recommendation model.InterviewRecommendation
+	Feedback       string
+}
+
+// AssignInterviewer assigns an employee to interview a candidate at a stage of its pipeline
+func (r *controller) AssignInterviewer(id string, input InterviewInput) (*model.CandidateInterview, error) {
+	candidate, err := r.GetCandidate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	stageID := input.StageID
+	if stageID == nil {
+		stageID = candidate.StageID
+	}
+	if stageID != nil && (candidate.StageID == nil || *stageID != *candidate.StageID) {
+		stage, err := r.getStage(stageID.String())
+		if err != nil {
+			return nil, err
+		}
+		pipeline, err := r.pipeline(candidate.PositionID)
+		if err != nil {
+			return nil, err
+		}
+		if recruitment.CheckTransition(pipeline, nil, stage) != nil {
+			return nil, recruitment.ErrStageNotInPipeline
+		}
+	}
+
+	interviewer, err := r.store.Employee.One(r.repo.DB(), input.InterviewerID.String(), false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInterviewerNotFound
+		}
+		return nil, err
+	}
+
+	exists, err := r.store.CandidateInterview.IsExist(r.repo.DB(), id, stageID, interviewer.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrInterviewerAssigned
+	}
+
+	interview, err := r.store.CandidateInterview.Create(r.repo.DB(), &model.CandidateInterview{
+		CandidateID:   candidate.ID,
+		StageID:       stageID,
+		InterviewerID: interviewer.ID,
+		ScheduledAt:   input.ScheduledAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	interview.Interviewer = interviewer
+	return interview, nil
+}
+
+// SubmitScorecard fills the scorecard of an interview, only its interviewer can do it. The
+// scorecard can be changed after it is submitted.
+func (r *controller) SubmitScorecard(id string, interviewID string, input ScorecardInput) (*model.CandidateInterview, error) {
+	if !input.Recommendation.IsValid() {
+		return nil, ErrInvalidRecommendation
+	}
+	overall, err := recruitment.OverallScore(input.Scores)
+	if err != nil {
+		return nil, err
+	}
+
+	interview, err := r.store.CandidateInterview.One(r.repo.DB(), id, interviewID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInterviewNotFound
+		}
+		return nil, err
+	}
+	if interview.InterviewerID.String() != input.UserID {
+		return nil, ErrNotInterviewer
+	}
+
+	scores, err := json.Marshal(input.Scores)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	interview.Scores = scores
+	interview.OverallScore = &overall
+	interview.Recommendation = input.Recommendation
+	interview.Feedback = input.Feedback
+	interview.SubmittedAt = &now
+
+	return r.store.CandidateInterview.Update(r.repo.DB(), interview)
+}
@@ -0,0 +1,58 @@
+package recruitment
+
+import (
+	"mime/multipart"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/employee"
+	"github.com/dwarvesf/fortress-api/pkg/controller/referral"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store    *store.Store
+	service  *service.Service
+	employee employee.IController
+	referral referral.IController
+	logger   logger.Logger
+	repo     store.DBRepo
+	config   *config.Config
+}
+
+// New returns the recruitment controller, the hired candidates are turned into employees
+// by the employee controller and the referred ones are followed by the referral controller
+func New(store *store.Store, repo store.DBRepo, service *service.Service, employee employee.IController, referral referral.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:    store,
+		repo:     repo,
+		service:  service,
+		employee: employee,
+		referral: referral,
+		logger:   logger,
+		config:   cfg,
+	}
+}
+
+type IController interface {
+	ListStages(positionID string) ([]*model.RecruitmentStage, error)
+	CreateStage(input StageInput) (*model.RecruitmentStage, error)
+	UpdateStage(id string, input StageInput) (*model.RecruitmentStage, error)
+	DeleteStage(id string) error
+
+	ListCandidates(input ListCandidatesInput, pagination model.Pagination) ([]*model.Candidate, int64, error)
+	GetCandidate(id string) (*model.Candidate, error)
+	CreateCandidate(input CandidateInput, actorID *model.UUID) (*model.Candidate, error)
+	UpdateCandidate(id string, input CandidateInput) (*model.Candidate, error)
+	UploadCV(id string, file *multipart.FileHeader) (*model.Candidate, error)
+
+	MoveCandidate(id string, input TransitionInput) (*model.CandidateStageTransition, error)
+	ListTransitions(id string) ([]*model.CandidateStageTransition, error)
+
+	AssignInterviewer(id string, input InterviewInput) (*model.CandidateInterview, error)
+	SubmitScorecard(id string, interviewID string, input ScorecardInput) (*model.CandidateInterview, error)
+
+	ConvertToEmployee(id string, userID string, input ConvertInput) (*model.Employee, error)
+}
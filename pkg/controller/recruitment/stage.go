@@ -0,0 +1,129 @@
+package recruitment
+
+import (
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/recruitment"
+	"github.com/dwarvesf/fortress-api/pkg/store/recruitmentstage"
+)
+
+type StageInput struct {
+	PositionID      *model.UUID // empty for a stage of the default pipeline
+	Code            string
+	Name            string
+	SortOrder       int
+	CandidateStatus model.CandidateStatus
+	EmailTemplate   string
+	IsTerminal      bool
+}
+
+// ListStages returns the pipeline of a position, or every stage when no position is given
+func (r *controller) ListStages(positionID string) ([]*model.RecruitmentStage, error) {
+	stages, err := r.store.RecruitmentStage.All(r.repo.DB(), recruitmentstage.Query{PositionID: positionID})
+	if err != nil {
+		return nil, err
+	}
+	if positionID == "" {
+		return stages, nil
+	}
+
+	id, err := model.UUIDFromString(positionID)
+	if err != nil {
+		return nil, ErrPositionNotFound
+	}
+	return recruitment.Pipeline(stages, &id), nil
+}
+
+func (r *controller) CreateStage(input StageInput) (*model.RecruitmentStage, error) {
+	stage := &model.RecruitmentStage{}
+	if err := r.applyStageInput(stage, input); err != nil {
+		return nil, err
+	}
+
+	return r.store.RecruitmentStage.Create(r.repo.DB(), stage)
+}
+
+func (r *controller) UpdateStage(id string, input StageInput) (*model.RecruitmentStage, error) {
+	stage, err := r.getStage(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.applyStageInput(stage, input); err != nil {
+		return nil, err
+	}
+
+	return r.store.RecruitmentStage.Update(r.repo.DB(), stage)
+}
+
+// DeleteStage removes a stage no candidate is in anymore
+func (r *controller) DeleteStage(id string) error {
+	if _, err := r.getStage(id); err != nil {
+		return err
+	}
+
+	total, err := r.store.Recruitment.CountByStageID(r.repo.DB(), id)
+	if err != nil {
+		return err
+	}
+	if total > 0 {
+		return ErrStageInUse
+	}
+
+	return r.store.RecruitmentStage.Delete(r.repo.DB(), id)
+}
+
+func (r *controller) getStage(id string) (*model.RecruitmentStage, error) {
+	stage, err := r.store.RecruitmentStage.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStageNotFound
+		}
+		return nil, err
+	}
+	return stage, nil
+}
+
+func (r *controller) applyStageInput(stage *model.RecruitmentStage, input StageInput) error {
+	if !input.CandidateStatus.IsValid() {
+		return ErrInvalidCandidateStatus
+	}
+	if input.EmailTemplate != "" && !recruitment.IsEmailTemplate(input.EmailTemplate) {
+		return ErrInvalidEmailTemplate
+	}
+
+	positionID := ""
+	if input.PositionID != nil {
+		positionID = input.PositionID.String()
+		if _, err := r.store.Position.One(r.repo.DB(), *input.PositionID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPositionNotFound
+			}
+			return err
+		}
+	}
+
+	code := strings.ToLower(strings.TrimSpace(input.Code))
+	stages, err := r.store.RecruitmentStage.All(r.repo.DB(), recruitmentstage.Query{PositionID: positionID})
+	if err != nil {
+		return err
+	}
+	for _, s := range stages {
+		samePipeline := (s.PositionID == nil) == (input.PositionID == nil)
+		if samePipeline && s.Code == code && s.ID != stage.ID {
+			return ErrStageCodeExisted
+		}
+	}
+
+	stage.PositionID = input.PositionID
+	stage.Code = code
+	stage.Name = strings.TrimSpace(input.Name)
+	stage.SortOrder = input.SortOrder
+	stage.CandidateStatus = input.CandidateStatus
+	stage.EmailTemplate = input.EmailTemplate
+	stage.IsTerminal = input.IsTerminal
+	return nil
+}
@@ -0,0 +1,159 @@
+package recruitment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/recruitment"
+)
+
+type TransitionInput struct {
+	StageID   model.UUID
+	Note      string
+	ActorID   *model.UUID
+	SkipEmail bool
+
+	// the offer is set along with the move to an offered stage, its email displays it
+	OfferSalary       *int
+	OfferStartDate    *time.Time
+	ProbationDuration *int
+}
+
+// MoveCandidate moves a candidate to another stage of its pipeline, records the transition
+// and sends the email of the stage. A failing email does not roll the move back, its error
+// is kept on the transition.
+func (r *controller) MoveCandidate(id string, input TransitionInput) (*model.CandidateStageTransition, error) {
+	candidate, err := r.GetCandidate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := r.getStage(input.StageID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := r.pipeline(candidate.PositionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := recruitment.CheckTransition(pipeline, candidate.Stage, to); err != nil {
+		return nil, err
+	}
+
+	if input.OfferSalary != nil {
+		candidate.OfferSalary = *input.OfferSalary
+	}
+	if input.OfferStartDate != nil {
+		candidate.OfferStartDate = input.OfferStartDate
+	}
+	if input.ProbationDuration != nil {
+		candidate.ProbationDuration = *input.ProbationDuration
+	}
+	if to.CandidateStatus == model.OfferedCandidateStatus && (candidate.OfferSalary <= 0 || candidate.OfferStartDate == nil) {
+		return nil, ErrMissingOffer
+	}
+
+	candidate.StageID = &to.ID
+	candidate.Status = to.CandidateStatus
+
+	transition := &model.CandidateStageTransition{
+		CandidateID: candidate.ID,
+		ToStageID:   to.ID,
+		Note:        input.Note,
+		ActorID:     input.ActorID,
+	}
+	if candidate.Stage != nil {
+		transition.FromStageID = &candidate.Stage.ID
+	}
+	if !input.SkipEmail {
+		transition.EmailTemplate = to.EmailTemplate
+	}
+
+	tx, done := r.repo.NewTransaction()
+	update := model.Candidate{
+		StageID:           candidate.StageID,
+		Status:            candidate.Status,
+		OfferSalary:       candidate.OfferSalary,
+		OfferStartDate:    candidate.OfferStartDate,
+		ProbationDuration: candidate.ProbationDuration,
+	}
+	_, err = r.store.Recruitment.UpdateSelectedFieldsByID(tx.DB(), id, update,
+		"stage_id", "status", "offer_salary", "offer_start_date", "probation_duration")
+	if err != nil {
+		return nil, done(err)
+	}
+	if _, err := r.store.CandidateTransition.Create(tx.DB(), transition); err != nil {
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	r.sendStageMail(candidate, transition)
+	r.syncReferral(candidate)
+
+	transition.FromStage = candidate.Stage
+	transition.ToStage = to
+	return transition, nil
+}
+
+func (r *controller) ListTransitions(id string) ([]*model.CandidateStageTransition, error) {
+	if _, err := r.GetCandidate(id); err != nil {
+		return nil, err
+	}
+	return r.store.CandidateTransition.AllByCandidateID(r.repo.DB(), id)
+}
+
+// sendStageMail sends the email template of a transition and records how it went
+func (r *controller) sendStageMail(candidate *model.Candidate, transition *model.CandidateStageTransition) {
+	if transition.EmailTemplate == "" {
+		return
+	}
+
+	l := r.logger.Fields(logger.Fields{
+		"controller": "recruitment",
+		"method":     "sendStageMail",
+		"candidate":  candidate.ID.String(),
+		"template":   transition.EmailTemplate,
+	})
+
+	recruitment.PrepareMail(candidate)
+
+	var err error
+	if r.service.GoogleMail == nil {
+		err = errors.New("google mail service is not configured")
+	} else {
+		err = r.service.GoogleMail.SendCandidateMail(transition.EmailTemplate, candidate)
+	}
+
+	if err != nil {
+		l.Error(err, "failed to send candidate email")
+		transition.EmailError = err.Error()
+	} else {
+		now := time.Now()
+		transition.EmailSentAt = &now
+		candidate.IsEmailSent = true
+		if _, err := r.store.Recruitment.UpdateSelectedFieldsByID(r.repo.DB(), candidate.ID.String(), model.Candidate{IsEmailSent: true}, "is_email_sent"); err != nil {
+			l.Error(err, "failed to flag candidate email as sent")
+		}
+	}
+
+	if _, err := r.store.CandidateTransition.Update(r.repo.DB(), transition); err != nil {
+		l.Error(err, "failed to record candidate email")
+	}
+}
+
+// syncReferral keeps the referral of a referred candidate up to date, a failure is logged
+// without failing the change of the candidate
+func (r *controller) syncReferral(candidate *model.Candidate) {
+	if _, err := r.referral.SyncCandidate(candidate); err != nil {
+		r.logger.Fields(logger.Fields{
+			"controller": "recruitment",
+			"method":     "syncReferral",
+			"candidate":  candidate.ID.String(),
+		}).Error(err, "failed to sync referral")
+	}
+}
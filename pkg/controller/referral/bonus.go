@@ -0,0 +1,166 @@
+package referral
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/referral"
+)
+
+// ProcessMilestones checks the referred hires against the milestones of the program and
+// schedules a bonus in the next payroll of the referrer for each milestone newly reached.
+// The bonuses of a referrer who left are recorded as cancelled.
+func (r *controller) ProcessMilestones() ([]*model.ReferralBonus, error) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "referral",
+		"method":     "ProcessMilestones",
+	})
+
+	milestones, err := r.store.ReferralMilestone.All(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	referrals, err := r.store.Referral.AllInProgress(r.repo.DB())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var scheduled []*model.ReferralBonus
+	for _, ref := range referrals {
+		bonuses, err := r.processReferral(ref, milestones, now)
+		if err != nil {
+			l.AddField("referral", ref.ID.String()).Error(err, "failed to process referral")
+			continue
+		}
+		scheduled = append(scheduled, bonuses...)
+	}
+
+	return scheduled, nil
+}
+
+func (r *controller) processReferral(ref *model.Referral, milestones []*model.ReferralBonusMilestone, now time.Time) ([]*model.ReferralBonus, error) {
+	if ref.Employee == nil {
+		return nil, nil
+	}
+
+	hire := referral.Hire{
+		JoinedDate:        ref.Employee.JoinedDate,
+		LeftDate:          ref.Employee.LeftDate,
+		WorkingStatus:     ref.Employee.WorkingStatus,
+		ProbationPassedAt: ref.ProbationPassedAt,
+	}
+	if ref.Candidate != nil {
+		hire.ProbationMonths = ref.Candidate.ProbationDuration
+	}
+	reached, status := referral.Progress(milestones, hire, now)
+
+	paid := map[model.UUID]bool{}
+	for _, b := range ref.Bonuses {
+		paid[b.MilestoneID] = true
+	}
+
+	referrerLeft := ref.Referrer == nil || ref.Referrer.WorkingStatus == model.WorkingStatusLeft ||
+		(ref.Referrer.LeftDate != nil && !ref.Referrer.LeftDate.After(now))
+
+	tx, done := r.repo.NewTransaction()
+
+	var bonuses []*model.ReferralBonus
+	for _, m := range reached {
+		if paid[m.Milestone.ID] {
+			continue
+		}
+
+		bonus := &model.ReferralBonus{
+			BaseModel:   model.BaseModel{ID: model.NewUUID()},
+			ReferralID:  ref.ID,
+			MilestoneID: m.Milestone.ID,
+			ReferrerID:  ref.ReferrerID,
+			Amount:      m.Milestone.Amount,
+			Status:      model.ReferralBonusStatusCancelled,
+			ReachedAt:   m.At,
+		}
+		if !referrerLeft {
+			employeeBonus, err := r.store.Bonus.Create(tx.DB(), &model.EmployeeBonus{
+				EmployeeID: ref.ReferrerID,
+				Amount:     m.Milestone.Amount,
+				IsActive:   true,
+				Name:       referral.BonusName(ref.CandidateName, m.Milestone),
+			})
+			if err != nil {
+				return nil, done(err)
+			}
+			bonus.Status = model.ReferralBonusStatusScheduled
+			bonus.EmployeeBonusID = &employeeBonus.ID
+		}
+
+		if _, err := r.store.ReferralBonus.Create(tx.DB(), bonus); err != nil {
+			return nil, done(err)
+		}
+		bonus.Milestone = m.Milestone
+		if bonus.Status == model.ReferralBonusStatusScheduled {
+			bonuses = append(bonuses, bonus)
+		}
+	}
+
+	update := model.Referral{
+		Status:            status,
+		ProbationPassedAt: referral.ProbationPassedAt(hire, now),
+	}
+	if status == model.ReferralStatusLeft {
+		update.LeftAt = ref.Employee.LeftDate
+	}
+	_, err := r.store.Referral.UpdateSelectedFieldsByID(tx.DB(), ref.ID.String(), update, "status", "probation_passed_at", "left_at")
+	if err != nil {
+		return nil, done(err)
+	}
+
+	return bonuses, done(nil)
+}
+
+// MarkBonusesPaid marks the referral bonuses paid in a committed payroll, and stops their
+// employee bonuses from being paid again
+func (r *controller) MarkBonusesPaid(payroll *model.Payroll) error {
+	if len(payroll.ProjectBonusExplain) == 0 {
+		return nil
+	}
+
+	var explains []model.ProjectBonusExplain
+	if err := json.Unmarshal(payroll.ProjectBonusExplain, &explains); err != nil {
+		return err
+	}
+	names := make(map[string]bool, len(explains))
+	for _, e := range explains {
+		names[e.Name] = true
+	}
+
+	scheduled, err := r.store.ReferralBonus.AllScheduledByReferrerID(r.repo.DB(), payroll.EmployeeID.String())
+	if err != nil {
+		return err
+	}
+
+	var ids, employeeBonusIDs []model.UUID
+	for _, b := range scheduled {
+		if b.EmployeeBonus == nil || !names[b.EmployeeBonus.Name] {
+			continue
+		}
+		ids = append(ids, b.ID)
+		employeeBonusIDs = append(employeeBonusIDs, b.EmployeeBonus.ID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	tx, done := r.repo.NewTransaction()
+	if err := r.store.ReferralBonus.UpdateStatus(tx.DB(), ids, model.ReferralBonusStatusPaid, &now); err != nil {
+		return done(err)
+	}
+	if err := r.store.Bonus.Deactivate(tx.DB(), employeeBonusIDs); err != nil {
+		return done(err)
+	}
+	return done(nil)
+}
@@ -0,0 +1,15 @@
+package referral
+
+import "errors"
+
+var (
+	ErrMilestoneNotFound     = errors.New("referral bonus milestone not found")
+	ErrMilestoneCodeExisted  = errors.New("referral bonus milestone code already exists")
+	ErrInvalidTrigger        = errors.New("invalid referral bonus milestone trigger")
+	ErrInvalidTenureMonths   = errors.New("tenure months must be positive for a tenure milestone")
+	ErrInvalidAmount         = errors.New("referral bonus amount must be positive")
+	ErrReferralNotFound      = errors.New("referral not found")
+	ErrInvalidReferralStatus = errors.New("invalid referral status")
+	ErrNotReferral           = errors.New("candidate is not a referral")
+	ErrReferrerNotFound      = errors.New("referrer not found")
+)
@@ -0,0 +1,108 @@
+package referral
+
+import (
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type MilestoneInput struct {
+	Code         string
+	Name         string
+	Trigger      model.ReferralMilestoneTrigger
+	TenureMonths int
+	Amount       int64
+	IsActive     bool
+	SortOrder    int
+}
+
+func (i MilestoneInput) validate() error {
+	if !i.Trigger.IsValid() {
+		return ErrInvalidTrigger
+	}
+	if i.Trigger == model.ReferralMilestoneTriggerTenure && i.TenureMonths <= 0 {
+		return ErrInvalidTenureMonths
+	}
+	if i.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+func (r *controller) ListMilestones() ([]*model.ReferralBonusMilestone, error) {
+	return r.store.ReferralMilestone.All(r.repo.DB())
+}
+
+func (r *controller) CreateMilestone(input MilestoneInput) (*model.ReferralBonusMilestone, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(input.Code)
+	if err := r.checkCode(code, ""); err != nil {
+		return nil, err
+	}
+
+	milestone := &model.ReferralBonusMilestone{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		Code:      code,
+	}
+	applyMilestoneInput(milestone, input)
+
+	return r.store.ReferralMilestone.Create(r.repo.DB(), milestone)
+}
+
+// UpdateMilestone changes a milestone of the program, the bonuses already scheduled keep
+// the amount of the time they were reached
+func (r *controller) UpdateMilestone(id string, input MilestoneInput) (*model.ReferralBonusMilestone, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	milestone, err := r.store.ReferralMilestone.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMilestoneNotFound
+		}
+		return nil, err
+	}
+
+	code := strings.TrimSpace(input.Code)
+	if err := r.checkCode(code, id); err != nil {
+		return nil, err
+	}
+
+	milestone.Code = code
+	applyMilestoneInput(milestone, input)
+
+	return r.store.ReferralMilestone.Update(r.repo.DB(), milestone)
+}
+
+func (r *controller) checkCode(code string, id string) error {
+	existing, err := r.store.ReferralMilestone.OneByCode(r.repo.DB(), code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if existing.ID.String() != id {
+		return ErrMilestoneCodeExisted
+	}
+	return nil
+}
+
+func applyMilestoneInput(milestone *model.ReferralBonusMilestone, input MilestoneInput) {
+	milestone.Name = strings.TrimSpace(input.Name)
+	milestone.Trigger = input.Trigger
+	milestone.TenureMonths = 0
+	if input.Trigger == model.ReferralMilestoneTriggerTenure {
+		milestone.TenureMonths = input.TenureMonths
+	}
+	milestone.Amount = model.NewVietnamDong(input.Amount)
+	milestone.IsActive = input.IsActive
+	milestone.SortOrder = input.SortOrder
+}
@@ -0,0 +1,42 @@
+package referral
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	ListMilestones() ([]*model.ReferralBonusMilestone, error)
+	CreateMilestone(input MilestoneInput) (*model.ReferralBonusMilestone, error)
+	UpdateMilestone(id string, input MilestoneInput) (*model.ReferralBonusMilestone, error)
+
+	ListReferrals(input ListReferralsInput, pagination model.Pagination) ([]*model.Referral, int64, error)
+	GetReferral(id string) (*model.Referral, error)
+
+	TrackCandidate(candidate *model.Candidate) (*model.Referral, error)
+	SyncCandidate(candidate *model.Candidate) (*model.Referral, error)
+
+	ProcessMilestones() ([]*model.ReferralBonus, error)
+	MarkBonusesPaid(payroll *model.Payroll) error
+}
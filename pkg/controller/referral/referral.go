@@ -0,0 +1,171 @@
+package referral
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/referral"
+	referralstore "github.com/dwarvesf/fortress-api/pkg/store/referral"
+)
+
+type ListReferralsInput struct {
+	ReferrerID string
+	Status     model.ReferralStatus
+}
+
+func (r *controller) ListReferrals(input ListReferralsInput, pagination model.Pagination) ([]*model.Referral, int64, error) {
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, 0, ErrInvalidReferralStatus
+	}
+	return r.store.Referral.All(r.repo.DB(), referralstore.Query{
+		ReferrerID: input.ReferrerID,
+		Status:     input.Status,
+	}, pagination)
+}
+
+func (r *controller) GetReferral(id string) (*model.Referral, error) {
+	ref, err := r.store.Referral.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReferralNotFound
+		}
+		return nil, err
+	}
+	return ref, nil
+}
+
+// TrackCandidate starts following a referred candidate and thanks the referrer by email.
+// The referrer is the employee whose email is in the referral info of the candidate.
+func (r *controller) TrackCandidate(candidate *model.Candidate) (*model.Referral, error) {
+	if !candidate.IsReferral {
+		return nil, ErrNotReferral
+	}
+
+	ref, err := r.store.Referral.OneByCandidateID(r.repo.DB(), candidate.ID.String())
+	if err == nil {
+		return ref, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	referrer, err := r.referrer(candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	ref = &model.Referral{
+		BaseModel:      model.BaseModel{ID: model.NewUUID()},
+		ReferrerID:     referrer.ID,
+		CandidateID:    &candidate.ID,
+		CandidateName:  candidate.Name,
+		CandidateEmail: candidate.Email,
+		Role:           candidate.Role,
+		Status:         referral.StatusFromCandidate(candidate.Status),
+	}
+	if _, err := r.store.Referral.Create(r.repo.DB(), ref); err != nil {
+		return nil, err
+	}
+
+	r.informReferrer(referrer, candidate)
+
+	return ref, nil
+}
+
+// SyncCandidate follows the candidate of a referral through the pipeline until it is hired,
+// from then on the milestones are tracked on the employee
+func (r *controller) SyncCandidate(candidate *model.Candidate) (*model.Referral, error) {
+	ref, err := r.store.Referral.OneByCandidateID(r.repo.DB(), candidate.ID.String())
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if !candidate.IsReferral {
+			return nil, nil
+		}
+		if ref, err = r.TrackCandidate(candidate); err != nil {
+			return nil, err
+		}
+	}
+	if ref.EmployeeID != nil {
+		return ref, nil
+	}
+
+	update := model.Referral{Status: referral.StatusFromCandidate(candidate.Status)}
+	fields := []string{"status"}
+	if candidate.EmployeeID != nil {
+		emp, err := r.store.Employee.One(r.repo.DB(), candidate.EmployeeID.String(), false)
+		if err != nil {
+			return nil, err
+		}
+		update.Status = model.ReferralStatusHired
+		update.EmployeeID = &emp.ID
+		update.HiredAt = emp.JoinedDate
+		fields = append(fields, "employee_id", "hired_at")
+	}
+
+	if _, err := r.store.Referral.UpdateSelectedFieldsByID(r.repo.DB(), ref.ID.String(), update, fields...); err != nil {
+		return nil, err
+	}
+
+	ref.Status, ref.EmployeeID, ref.HiredAt = update.Status, update.EmployeeID, update.HiredAt
+	return ref, nil
+}
+
+// referrer finds the employee who referred the candidate by the email of the referral info
+func (r *controller) referrer(candidate *model.Candidate) (*model.Employee, error) {
+	if len(candidate.ReferralInfo) == 0 {
+		return nil, ErrReferrerNotFound
+	}
+
+	var info model.ReferralInfo
+	if err := json.Unmarshal(candidate.ReferralInfo, &info); err != nil {
+		return nil, err
+	}
+	email := strings.TrimSpace(info.Email)
+	if email == "" {
+		return nil, ErrReferrerNotFound
+	}
+
+	referrer, err := r.store.Employee.OneByEmail(r.repo.DB(), email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReferrerNotFound
+		}
+		return nil, err
+	}
+	return referrer, nil
+}
+
+// informReferrer sends the referral acknowledgement to the referrer, a failing email does
+// not stop the referral from being tracked
+func (r *controller) informReferrer(referrer *model.Employee, candidate *model.Candidate) {
+	l := r.logger.Fields(logger.Fields{
+		"controller": "referral",
+		"method":     "informReferrer",
+		"candidate":  candidate.ID.String(),
+	})
+
+	if r.service.GoogleMail == nil {
+		l.Info("google mail service is not configured, skip the referral email")
+		return
+	}
+
+	email := referrer.TeamEmail
+	if email == "" {
+		email = referrer.PersonalEmail
+	}
+	err := r.service.GoogleMail.SendCandidateMail("referral_inform.tpl", &model.Candidate{
+		Name:  referrer.FullName,
+		Email: email,
+		Role:  candidate.Role,
+	})
+	if err != nil {
+		l.Error(err, "failed to send referral email")
+	}
+}
@@ -0,0 +1,403 @@
+package reviewcycle
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type CreateCycleInput struct {
+	Name       string
+	PeriodFrom time.Time
+	PeriodTo   time.Time
+	// EmployeeIDs is who is reviewed, every full-time employee with a seniority when empty
+	EmployeeIDs []string
+	CreatedBy   model.UUID
+}
+
+func (c *controller) ListCycles() ([]*model.ReviewCycle, error) {
+	return c.store.ReviewCycle.All(c.repo.DB())
+}
+
+// GetCycle returns a review cycle with its packets
+func (c *controller) GetCycle(id string) (*model.ReviewCycle, error) {
+	cycle, err := c.oneCycle(id)
+	if err != nil {
+		return nil, err
+	}
+
+	cycle.Packets, err = c.store.ReviewPacket.ListByCycleID(c.repo.DB(), id, true)
+	if err != nil {
+		return nil, err
+	}
+	return cycle, nil
+}
+
+// CreateCycle creates a draft review cycle with a packet per reviewed employee, their line manager
+// writes their manager review
+func (c *controller) CreateCycle(input CreateCycleInput) (*model.ReviewCycle, error) {
+	if !input.PeriodTo.After(input.PeriodFrom) {
+		return nil, ErrInvalidPeriod
+	}
+
+	db := c.repo.DB()
+
+	var employees []*model.Employee
+	if len(input.EmployeeIDs) > 0 {
+		ids := make([]model.UUID, 0, len(input.EmployeeIDs))
+		for _, id := range input.EmployeeIDs {
+			ids = append(ids, model.MustGetUUIDFromString(id))
+		}
+		rs, err := c.store.Employee.GetByIDs(db, ids)
+		if err != nil {
+			return nil, err
+		}
+		found := map[model.UUID]*model.Employee{}
+		for _, e := range rs {
+			found[e.ID] = e
+		}
+		seen := map[model.UUID]bool{}
+		for _, id := range ids {
+			e, ok := found[id]
+			if !ok {
+				return nil, fmt.Errorf("%w: %v", ErrEmployeeNotFound, id)
+			}
+			if e.SeniorityID.IsZero() {
+				return nil, fmt.Errorf("%w: %v", ErrEmployeeNoSeniority, e.DisplayName)
+			}
+			if !seen[id] {
+				seen[id] = true
+				employees = append(employees, e)
+			}
+		}
+	} else {
+		rs, err := c.store.Employee.GetByWorkingStatus(db, model.WorkingStatusFullTime)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range rs {
+			if !e.SeniorityID.IsZero() {
+				employees = append(employees, e)
+			}
+		}
+	}
+	if len(employees) == 0 {
+		return nil, ErrNoEmployees
+	}
+
+	cycle := &model.ReviewCycle{
+		BaseModel:  model.BaseModel{ID: model.NewUUID()},
+		Name:       input.Name,
+		PeriodFrom: input.PeriodFrom,
+		PeriodTo:   input.PeriodTo,
+		Status:     model.ReviewCycleStatusDraft,
+		CreatedBy:  input.CreatedBy,
+	}
+
+	packets := make([]*model.ReviewPacket, 0, len(employees))
+	for _, e := range employees {
+		p := &model.ReviewPacket{
+			BaseModel:          model.BaseModel{ID: model.NewUUID()},
+			CycleID:            cycle.ID,
+			EmployeeID:         e.ID,
+			Status:             model.ReviewPacketStatusOpen,
+			CurrentSeniorityID: e.SeniorityID,
+		}
+		if !e.LineManagerID.IsZero() && e.LineManagerID != e.ID {
+			managerID := e.LineManagerID
+			p.ManagerID = &managerID
+		}
+		packets = append(packets, p)
+	}
+
+	tx, done := c.repo.NewTransaction()
+	if _, err := c.store.ReviewCycle.Create(tx.DB(), cycle); err != nil {
+		return nil, done(err)
+	}
+	if _, err := c.store.ReviewPacket.BatchCreate(tx.DB(), packets); err != nil {
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return c.GetCycle(cycle.ID.String())
+}
+
+// StartCycle sends the self and manager review forms of a draft cycle. They are feedback events of
+// the review type, so the employees answer them from their feedback inbox.
+func (c *controller) StartCycle(id string) (*model.ReviewCycle, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "reviewcycle",
+		"method":     "StartCycle",
+		"id":         id,
+	})
+
+	cycle, err := c.oneCycle(id)
+	if err != nil {
+		return nil, err
+	}
+	if cycle.Status != model.ReviewCycleStatusDraft {
+		return nil, ErrCycleNotDraft
+	}
+
+	packets, err := c.store.ReviewPacket.ListByCycleID(c.repo.DB(), id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, done := c.repo.NewTransaction()
+
+	selfEventID, selfTopics, err := c.createReviewForms(tx.DB(), cycle, model.EventSubtypeSelfReview, packets)
+	if err != nil {
+		l.Error(err, "failed to create the self review forms")
+		return nil, done(err)
+	}
+	managerEventID, managerTopics, err := c.createReviewForms(tx.DB(), cycle, model.EventSubtypeManagerReview, packets)
+	if err != nil {
+		l.Error(err, "failed to create the manager review forms")
+		return nil, done(err)
+	}
+
+	for _, p := range packets {
+		_, err := c.store.ReviewPacket.UpdateSelectedFieldsByID(tx.DB(), p.ID.String(), model.ReviewPacket{
+			SelfReviewTopicID:    selfTopics[p.ID],
+			ManagerReviewTopicID: managerTopics[p.ID],
+		}, "self_review_topic_id", "manager_review_topic_id")
+		if err != nil {
+			l.Errorf(err, "failed to update review packet %v", p.ID)
+			return nil, done(err)
+		}
+	}
+
+	cycle.Status = model.ReviewCycleStatusInProgress
+	cycle.SelfReviewEventID = &selfEventID
+	cycle.ManagerReviewEventID = &managerEventID
+	_, err = c.store.ReviewCycle.UpdateSelectedFieldsByID(tx.DB(), id, *cycle, "status", "self_review_event_id", "manager_review_event_id")
+	if err != nil {
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return c.GetCycle(id)
+}
+
+// createReviewForms creates the feedback event of a review form with a topic per packet, reviewed
+// by the employee themselves or by their manager, and returns the topic of each packet. The
+// reviewers are sent the form right away.
+func (c *controller) createReviewForms(db *gorm.DB, cycle *model.ReviewCycle, subtype model.EventSubtype, packets []*model.ReviewPacket) (model.UUID, map[model.UUID]*model.UUID, error) {
+	questions, err := c.store.Question.AllByCategory(db, model.EventTypeReview, subtype)
+	if err != nil {
+		return model.UUID{}, nil, err
+	}
+	if len(questions) == 0 {
+		return model.UUID{}, nil, fmt.Errorf("%w: %v", ErrQuestionsNotFound, subtype)
+	}
+
+	title, relationship := "Self Review", model.RelationshipSelf
+	if subtype == model.EventSubtypeManagerReview {
+		title, relationship = "Manager Review", model.RelationshipLineManager
+	}
+
+	event, err := c.store.FeedbackEvent.Create(db, &model.FeedbackEvent{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		Title:     fmt.Sprintf("%v - %v", title, cycle.Name),
+		Type:      model.EventTypeReview,
+		Subtype:   subtype,
+		Status:    model.EventStatusInProgress,
+		CreatedBy: cycle.CreatedBy,
+		StartDate: &cycle.PeriodFrom,
+		EndDate:   &cycle.PeriodTo,
+	})
+	if err != nil {
+		return model.UUID{}, nil, err
+	}
+
+	topicIDs := map[model.UUID]*model.UUID{}
+	topics := make([]model.EmployeeEventTopic, 0, len(packets))
+	reviewers := make([]model.EmployeeEventReviewer, 0, len(packets))
+	for _, p := range packets {
+		reviewerID := p.EmployeeID
+		if subtype == model.EventSubtypeManagerReview {
+			if p.ManagerID == nil {
+				continue
+			}
+			reviewerID = *p.ManagerID
+		}
+
+		name := p.EmployeeID.String()
+		if p.Employee != nil {
+			name = p.Employee.DisplayName
+		}
+		topic := model.EmployeeEventTopic{
+			BaseModel:  model.BaseModel{ID: model.NewUUID()},
+			Title:      fmt.Sprintf("%v: %v - %v", title, name, cycle.Name),
+			EventID:    event.ID,
+			EmployeeID: p.EmployeeID,
+		}
+		topics = append(topics, topic)
+		topicIDs[p.ID] = &topic.ID
+
+		reviewers = append(reviewers, model.EmployeeEventReviewer{
+			BaseModel:            model.BaseModel{ID: model.NewUUID()},
+			EventID:              event.ID,
+			EmployeeEventTopicID: topic.ID,
+			ReviewerID:           reviewerID,
+			Relationship:         relationship,
+			AuthorStatus:         model.EventAuthorStatusSent,
+			ReviewerStatus:       model.EventReviewerStatusNew,
+		})
+	}
+
+	eventQuestions := make([]model.EmployeeEventQuestion, 0, len(reviewers)*len(questions))
+	for _, r := range reviewers {
+		for _, q := range questions {
+			eventQuestions = append(eventQuestions, model.EmployeeEventQuestion{
+				BaseModel:               model.BaseModel{ID: model.NewUUID()},
+				EmployeeEventReviewerID: r.ID,
+				QuestionID:              q.ID,
+				EventID:                 event.ID,
+				Content:                 q.Content,
+				Type:                    q.Type.String(),
+				Order:                   q.Order,
+			})
+		}
+	}
+
+	for i := 0; i < len(topics); i += batchSize {
+		if _, err := c.store.EmployeeEventTopic.BatchCreate(db, topics[i:min(i+batchSize, len(topics))]); err != nil {
+			return model.UUID{}, nil, err
+		}
+	}
+	for i := 0; i < len(reviewers); i += batchSize {
+		if _, err := c.store.EmployeeEventReviewer.BatchCreate(db, reviewers[i:min(i+batchSize, len(reviewers))]); err != nil {
+			return model.UUID{}, nil, err
+		}
+	}
+	for i := 0; i < len(eventQuestions); i += batchSize {
+		if _, err := c.store.EmployeeEventQuestion.BatchCreate(db, eventQuestions[i:min(i+batchSize, len(eventQuestions))]); err != nil {
+			return model.UUID{}, nil, err
+		}
+	}
+
+	return event.ID, topicIDs, nil
+}
+
+// batchSize is the number of rows inserted at once
+const batchSize = 100
+
+// StartCalibration closes the review forms and collects the evidence of the period into the open
+// packets, with the suggested rating and seniority. It can be run again during the calibration to
+// take in the late reviews, the calibrated packets are left as they are.
+func (c *controller) StartCalibration(id string) (*model.ReviewCycle, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "reviewcycle",
+		"method":     "StartCalibration",
+		"id":         id,
+	})
+
+	cycle, err := c.oneCycle(id)
+	if err != nil {
+		return nil, err
+	}
+	if cycle.Status != model.ReviewCycleStatusInProgress && cycle.Status != model.ReviewCycleStatusCalibration {
+		return nil, ErrCycleNotInProgress
+	}
+
+	db := c.repo.DB()
+	packets, err := c.store.ReviewPacket.ListByCycleID(db, id, false)
+	if err != nil {
+		return nil, err
+	}
+
+	collector, err := c.newCollector(db, cycle)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, done := c.repo.NewTransaction()
+	for _, p := range packets {
+		if p.Status != model.ReviewPacketStatusOpen {
+			continue
+		}
+
+		if err := collector.collect(p); err != nil {
+			l.Errorf(err, "failed to collect the evidence of review packet %v", p.ID)
+			return nil, done(err)
+		}
+		_, err := c.store.ReviewPacket.UpdateSelectedFieldsByID(tx.DB(), p.ID.String(), *p,
+			"evidence", "suggested_rating", "suggested_score", "proposed_seniority_id")
+		if err != nil {
+			l.Errorf(err, "failed to update review packet %v", p.ID)
+			return nil, done(err)
+		}
+	}
+
+	if cycle.Status == model.ReviewCycleStatusInProgress {
+		for _, eventID := range []*model.UUID{cycle.SelfReviewEventID, cycle.ManagerReviewEventID} {
+			if eventID == nil {
+				continue
+			}
+			_, err := c.store.FeedbackEvent.UpdateSelectedFieldsByID(tx.DB(), eventID.String(), model.FeedbackEvent{Status: model.EventStatusDone}, "status")
+			if err != nil {
+				return nil, done(err)
+			}
+		}
+
+		cycle.Status = model.ReviewCycleStatusCalibration
+		if _, err := c.store.ReviewCycle.UpdateSelectedFieldsByID(tx.DB(), id, *cycle, "status"); err != nil {
+			return nil, done(err)
+		}
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return c.GetCycle(id)
+}
+
+// CloseCycle closes a cycle once every packet is approved or rejected
+func (c *controller) CloseCycle(id string) (*model.ReviewCycle, error) {
+	cycle, err := c.oneCycle(id)
+	if err != nil {
+		return nil, err
+	}
+	if cycle.Status != model.ReviewCycleStatusCalibration {
+		return nil, ErrCycleNotCalibrating
+	}
+
+	packets, err := c.store.ReviewPacket.ListByCycleID(c.repo.DB(), id, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range packets {
+		if p.Status != model.ReviewPacketStatusApproved && p.Status != model.ReviewPacketStatusRejected {
+			return nil, ErrUndecidedPackets
+		}
+	}
+
+	cycle.Status = model.ReviewCycleStatusClosed
+	if _, err := c.store.ReviewCycle.UpdateSelectedFieldsByID(c.repo.DB(), id, *cycle, "status"); err != nil {
+		return nil, err
+	}
+
+	return c.GetCycle(id)
+}
+
+func (c *controller) oneCycle(id string) (*model.ReviewCycle, error) {
+	cycle, err := c.store.ReviewCycle.One(c.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCycleNotFound
+		}
+		return nil, err
+	}
+	return cycle, nil
+}
@@ -0,0 +1,23 @@
+package reviewcycle
+
+import "errors"
+
+var (
+	ErrCycleNotFound       = errors.New("review cycle not found")
+	ErrPacketNotFound      = errors.New("review packet not found")
+	ErrInvalidPeriod       = errors.New("invalid period, periodTo must be after periodFrom")
+	ErrEmployeeNotFound    = errors.New("employee not found")
+	ErrEmployeeNoSeniority = errors.New("employee has no seniority")
+	ErrNoEmployees         = errors.New("no employee to review")
+	ErrCycleNotDraft       = errors.New("review cycle has already started")
+	ErrCycleNotInProgress  = errors.New("review cycle is not in progress")
+	ErrCycleNotCalibrating = errors.New("review cycle is not in calibration")
+	ErrQuestionsNotFound   = errors.New("review questions not found")
+	ErrInvalidRating       = errors.New("invalid rating, expected 1 to 5")
+	ErrSeniorityNotFound   = errors.New("seniority not found")
+	ErrPacketApproved      = errors.New("review packet is already approved")
+	ErrPacketNotCalibrated = errors.New("review packet is not calibrated")
+	ErrSelfApproval        = errors.New("could not calibrate or approve your own review packet")
+	ErrCalibratorApproval  = errors.New("could not approve or reject a review packet you calibrated")
+	ErrUndecidedPackets    = errors.New("every review packet must be approved or rejected")
+)
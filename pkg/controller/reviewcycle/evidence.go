@@ -0,0 +1,204 @@
+package reviewcycle
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/performancereview"
+	"github.com/dwarvesf/fortress-api/pkg/store/deliverymetric"
+	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventquestion"
+)
+
+// collector collects the evidence of the packets of a cycle, the delivery of the whole company is
+// summed once to rank the employees on it
+type collector struct {
+	c        *controller
+	db       *gorm.DB
+	from, to time.Time
+
+	delivery  map[model.UUID]*model.DeliveryMetricSum
+	weights   []float64
+	levels    []performancereview.Level
+	seniority map[model.UUID]performancereview.Level
+	now       time.Time
+}
+
+func (c *controller) newCollector(db *gorm.DB, cycle *model.ReviewCycle) (*collector, error) {
+	// The period is given in days, the last one included
+	from, to := cycle.PeriodFrom, cycle.PeriodTo.AddDate(0, 0, 1)
+
+	sums, err := c.store.DeliveryMetric.SumByEmployee(db, deliverymetric.SumFilter{From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+	delivery := map[model.UUID]*model.DeliveryMetricSum{}
+	weights := make([]float64, 0, len(sums))
+	for i, s := range sums {
+		delivery[s.EmployeeID] = &sums[i]
+		weights = append(weights, s.Weight.InexactFloat64())
+	}
+
+	seniorities, err := c.store.Seniority.All(db)
+	if err != nil {
+		return nil, err
+	}
+	levels := make([]performancereview.Level, 0, len(seniorities))
+	seniority := map[model.UUID]performancereview.Level{}
+	for _, s := range seniorities {
+		l := performancereview.Level{ID: s.ID.String(), Name: s.Name, Level: s.Level}
+		levels = append(levels, l)
+		seniority[s.ID] = l
+	}
+
+	return &collector{
+		c:         c,
+		db:        db,
+		from:      from,
+		to:        to,
+		delivery:  delivery,
+		weights:   weights,
+		levels:    levels,
+		seniority: seniority,
+		now:       time.Now(),
+	}, nil
+}
+
+// collect snapshots the evidence of the period into the packet with the suggested rating, and
+// proposes the seniority which goes with it
+func (cl *collector) collect(p *model.ReviewPacket) error {
+	e := &model.ReviewEvidence{CollectedAt: cl.now}
+
+	var err error
+	if p.SelfReviewTopicID != nil {
+		if e.SelfReview, err = cl.feedback(employeeeventquestion.SurveyAnswerFilter{TopicIDs: []string{p.SelfReviewTopicID.String()}}); err != nil {
+			return err
+		}
+	}
+	if p.ManagerReviewTopicID != nil {
+		if e.ManagerReview, err = cl.feedback(employeeeventquestion.SurveyAnswerFilter{TopicIDs: []string{p.ManagerReviewTopicID.String()}}); err != nil {
+			return err
+		}
+	}
+	e.PeerReview, err = cl.feedback(employeeeventquestion.SurveyAnswerFilter{
+		EmployeeID: p.EmployeeID.String(),
+		Subtype:    model.EventSubtypePeerReview,
+		From:       &cl.from,
+		To:         &cl.to,
+	})
+	if err != nil {
+		return err
+	}
+
+	mma, err := cl.c.store.Employee.GetLatestMMAScore(cl.db, p.EmployeeID.String(), cl.to)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil {
+		e.MMA = &model.ReviewMMA{
+			Mastery:  mma.MasteryScore.InexactFloat64(),
+			Autonomy: mma.AutonomyScore.InexactFloat64(),
+			Meaning:  mma.MeaningScore.InexactFloat64(),
+			RatedAt:  mma.RatedAt,
+		}
+	}
+
+	if s, ok := cl.delivery[p.EmployeeID]; ok {
+		e.Delivery = &model.ReviewDelivery{
+			Weight:        s.Weight.InexactFloat64(),
+			Effort:        s.Effort.InexactFloat64(),
+			Effectiveness: s.Effectiveness.Round(2).InexactFloat64(),
+			Percentile:    performancereview.Percentile(s.Weight.InexactFloat64(), cl.weights),
+		}
+	}
+
+	audits, err := cl.c.store.Audit.AllByParticipant(cl.db, p.EmployeeID.String(), cl.from, cl.to)
+	if err != nil {
+		return err
+	}
+	if len(audits) > 0 {
+		var total float64
+		for _, a := range audits {
+			total += a.Score
+		}
+		e.Audits = &model.ReviewAudits{Count: len(audits), AverageScore: total / float64(len(audits))}
+	}
+
+	suggestion := performancereview.Suggest(toEvidence(e), performancereview.DefaultWeights)
+	for _, s := range suggestion.Signals {
+		e.Signals = append(e.Signals, model.ReviewSignal{Name: s.Name, Weight: s.Weight, Score: s.Score, Reason: s.Reason})
+	}
+
+	p.Evidence = e
+	p.SuggestedRating = suggestion.Rating
+	p.SuggestedScore = suggestion.Score
+	p.ProposedSeniorityID = nil
+	if current, ok := cl.seniority[p.CurrentSeniorityID]; ok && suggestion.Rating > 0 {
+		proposed := model.MustGetUUIDFromString(performancereview.ProposeSeniority(current, suggestion.Rating, cl.levels).ID)
+		p.ProposedSeniorityID = &proposed
+	}
+	return nil
+}
+
+// feedback sums up the done reviews on the employee, their own answers to a peer review left out
+func (cl *collector) feedback(filter employeeeventquestion.SurveyAnswerFilter) (*model.ReviewFeedback, error) {
+	answers, err := cl.c.store.EmployeeEventQuestion.ListSurveyAnswers(cl.db, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rs        model.ReviewFeedback
+		total     int
+		reviewers = map[model.UUID]bool{}
+	)
+	for _, a := range answers {
+		if filter.Subtype == model.EventSubtypePeerReview && a.Relationship == model.RelationshipSelf {
+			continue
+		}
+		reviewers[a.ReviewerID] = true
+
+		if a.Type == model.QuestionTypeScale {
+			if v, err := strconv.Atoi(a.Answer); err == nil && v >= 1 && v <= performancereview.RatingScale {
+				total += v
+				rs.Answers++
+			}
+			continue
+		}
+		rs.Comments = append(rs.Comments, model.ReviewComment{Question: a.Content, Answer: a.Answer})
+	}
+	if len(reviewers) == 0 {
+		return nil, nil
+	}
+
+	rs.Reviewers = len(reviewers)
+	if rs.Answers > 0 {
+		rs.Average = float64(total) / float64(rs.Answers)
+	}
+	return &rs, nil
+}
+
+func toEvidence(e *model.ReviewEvidence) performancereview.Evidence {
+	var rs performancereview.Evidence
+	if e.ManagerReview != nil {
+		rs.ManagerReview = &performancereview.Review{Average: e.ManagerReview.Average, Answers: e.ManagerReview.Answers}
+	}
+	if e.PeerReview != nil {
+		rs.PeerReview = &performancereview.Review{Average: e.PeerReview.Average, Answers: e.PeerReview.Answers}
+	}
+	if e.MMA != nil {
+		rs.MMA = &performancereview.MMA{Mastery: e.MMA.Mastery, Autonomy: e.MMA.Autonomy, Meaning: e.MMA.Meaning}
+	}
+	if e.Delivery != nil {
+		rs.Delivery = &performancereview.Delivery{
+			Weight:        e.Delivery.Weight,
+			Effort:        e.Delivery.Effort,
+			Effectiveness: e.Delivery.Effectiveness,
+			Percentile:    e.Delivery.Percentile,
+		}
+	}
+	return rs
+}
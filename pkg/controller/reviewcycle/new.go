@@ -0,0 +1,41 @@
+package reviewcycle
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	ListCycles() ([]*model.ReviewCycle, error)
+	GetCycle(id string) (*model.ReviewCycle, error)
+	CreateCycle(input CreateCycleInput) (*model.ReviewCycle, error)
+	StartCycle(id string) (*model.ReviewCycle, error)
+	StartCalibration(id string) (*model.ReviewCycle, error)
+	CloseCycle(id string) (*model.ReviewCycle, error)
+
+	GetPacket(cycleID string, id string) (*model.ReviewPacket, error)
+	CalibratePacket(cycleID string, id string, input CalibrateInput) (*model.ReviewPacket, error)
+	ApprovePacket(cycleID string, id string, approvedBy model.UUID) (*model.ReviewPacket, error)
+	RejectPacket(cycleID string, id string, input RejectInput) (*model.ReviewPacket, error)
+}
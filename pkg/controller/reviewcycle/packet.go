@@ -0,0 +1,202 @@
+package reviewcycle
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/performancereview"
+)
+
+type CalibrateInput struct {
+	FinalRating int
+	// ProposedSeniorityID is the seniority the employee is proposed, the one which goes with the
+	// final rating when empty
+	ProposedSeniorityID string
+	Note                string
+	CalibratedBy        model.UUID
+}
+
+type RejectInput struct {
+	Note       string
+	RejectedBy model.UUID
+}
+
+func (c *controller) GetPacket(cycleID string, id string) (*model.ReviewPacket, error) {
+	if _, err := c.oneCycle(cycleID); err != nil {
+		return nil, err
+	}
+	return c.onePacket(cycleID, id)
+}
+
+// CalibratePacket sets the final rating of an employee and the seniority they are proposed. A
+// rejected packet can be calibrated again until the cycle is closed.
+func (c *controller) CalibratePacket(cycleID string, id string, input CalibrateInput) (*model.ReviewPacket, error) {
+	if !performancereview.IsValidRating(input.FinalRating) {
+		return nil, ErrInvalidRating
+	}
+
+	packet, err := c.calibratingPacket(cycleID, id)
+	if err != nil {
+		return nil, err
+	}
+	if packet.Status == model.ReviewPacketStatusApproved {
+		return nil, ErrPacketApproved
+	}
+	if packet.EmployeeID == input.CalibratedBy {
+		return nil, ErrSelfApproval
+	}
+
+	db := c.repo.DB()
+	var proposedID model.UUID
+	if input.ProposedSeniorityID != "" {
+		exists, err := c.store.Seniority.IsExist(db, input.ProposedSeniorityID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrSeniorityNotFound
+		}
+		proposedID = model.MustGetUUIDFromString(input.ProposedSeniorityID)
+	} else {
+		seniorities, err := c.store.Seniority.All(db)
+		if err != nil {
+			return nil, err
+		}
+		var current performancereview.Level
+		levels := make([]performancereview.Level, 0, len(seniorities))
+		for _, s := range seniorities {
+			l := performancereview.Level{ID: s.ID.String(), Name: s.Name, Level: s.Level}
+			if s.ID == packet.CurrentSeniorityID {
+				current = l
+			}
+			levels = append(levels, l)
+		}
+		if current.ID == "" {
+			return nil, ErrSeniorityNotFound
+		}
+		proposedID = model.MustGetUUIDFromString(performancereview.ProposeSeniority(current, input.FinalRating, levels).ID)
+	}
+
+	now := time.Now()
+	_, err = c.store.ReviewPacket.UpdateSelectedFieldsByID(db, id, model.ReviewPacket{
+		Status:              model.ReviewPacketStatusCalibrated,
+		FinalRating:         input.FinalRating,
+		ProposedSeniorityID: &proposedID,
+		CalibrationNote:     input.Note,
+		CalibratedBy:        &input.CalibratedBy,
+		CalibratedAt:        &now,
+	}, "status", "final_rating", "proposed_seniority_id", "calibration_note", "calibrated_by", "calibrated_at", "approved_by", "approved_at")
+	if err != nil {
+		return nil, err
+	}
+
+	return c.onePacket(cycleID, id)
+}
+
+// ApprovePacket approves the calibration of an employee and moves them to the proposed seniority.
+// The approver is someone else than the employee and the one who calibrated the packet.
+func (c *controller) ApprovePacket(cycleID string, id string, approvedBy model.UUID) (*model.ReviewPacket, error) {
+	packet, err := c.decidablePacket(cycleID, id, approvedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tx, done := c.repo.NewTransaction()
+	// the packet is approved only while it is still calibrated, a concurrent decision leaves it as is
+	ok, err := c.store.ReviewPacket.UpdateStatus(tx.DB(), id, model.ReviewPacketStatusCalibrated, model.ReviewPacket{
+		Status:     model.ReviewPacketStatusApproved,
+		ApprovedBy: &approvedBy,
+		ApprovedAt: &now,
+	}, "status", "approved_by", "approved_at")
+	if err != nil {
+		return nil, done(err)
+	}
+	if !ok {
+		return nil, done(ErrPacketNotCalibrated)
+	}
+	if packet.ProposedSeniorityID != nil && *packet.ProposedSeniorityID != packet.CurrentSeniorityID {
+		_, err = c.store.Employee.UpdateSelectedFieldsByID(tx.DB(), packet.EmployeeID.String(), model.Employee{SeniorityID: *packet.ProposedSeniorityID}, "seniority_id")
+		if err != nil {
+			return nil, done(err)
+		}
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return c.onePacket(cycleID, id)
+}
+
+// RejectPacket declines the calibration of an employee, their seniority is left as it is
+func (c *controller) RejectPacket(cycleID string, id string, input RejectInput) (*model.ReviewPacket, error) {
+	packet, err := c.decidablePacket(cycleID, id, input.RejectedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	note := packet.CalibrationNote
+	if input.Note != "" {
+		note = input.Note
+	}
+	ok, err := c.store.ReviewPacket.UpdateStatus(c.repo.DB(), id, model.ReviewPacketStatusCalibrated, model.ReviewPacket{
+		Status:          model.ReviewPacketStatusRejected,
+		ApprovedBy:      &input.RejectedBy,
+		ApprovedAt:      &now,
+		CalibrationNote: note,
+	}, "status", "approved_by", "approved_at", "calibration_note")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrPacketNotCalibrated
+	}
+
+	return c.onePacket(cycleID, id)
+}
+
+// decidablePacket returns a calibrated packet the given employee can approve or reject: neither
+// their own packet nor one they calibrated
+func (c *controller) decidablePacket(cycleID string, id string, decidedBy model.UUID) (*model.ReviewPacket, error) {
+	packet, err := c.calibratingPacket(cycleID, id)
+	if err != nil {
+		return nil, err
+	}
+	if packet.Status != model.ReviewPacketStatusCalibrated {
+		return nil, ErrPacketNotCalibrated
+	}
+	if packet.EmployeeID == decidedBy {
+		return nil, ErrSelfApproval
+	}
+	if packet.CalibratedBy != nil && *packet.CalibratedBy == decidedBy {
+		return nil, ErrCalibratorApproval
+	}
+	return packet, nil
+}
+
+// calibratingPacket returns a packet of a cycle in calibration
+func (c *controller) calibratingPacket(cycleID string, id string) (*model.ReviewPacket, error) {
+	cycle, err := c.oneCycle(cycleID)
+	if err != nil {
+		return nil, err
+	}
+	if cycle.Status != model.ReviewCycleStatusCalibration {
+		return nil, ErrCycleNotCalibrating
+	}
+	return c.onePacket(cycleID, id)
+}
+
+func (c *controller) onePacket(cycleID string, id string) (*model.ReviewPacket, error) {
+	packet, err := c.store.ReviewPacket.One(c.repo.DB(), cycleID, id, true)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPacketNotFound
+		}
+		return nil, err
+	}
+	return packet, nil
+}
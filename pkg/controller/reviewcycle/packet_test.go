@@ -0,0 +1,162 @@
+package reviewcycle
+
+import (
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/testhelper"
+)
+
+const (
+	cycleID          = "5b0a0c8e-9a3b-4d2f-8f6e-1c2d3e4f5a6b"
+	calibratedPacket = "8a1f2c3d-4e5f-4a6b-9c7d-0e1f2a3b4c5d"
+	approvedPacket   = "9b2e3d4c-5f6a-4b7c-8d9e-1f2a3b4c5d6e"
+
+	reviewedID   = "f7c6016b-85b5-47f7-8027-23c2db482197"
+	calibratorID = "d42a6fca-d3b8-4a48-80f7-a95772abda56"
+	approverID   = "2655832e-f009-4b73-a535-64c3a22e558f"
+	otherID      = "dcfee24b-306d-4609-9c24-a4021639a11b"
+	midID        = "dac16ce6-9e5a-4ff3-9ea2-fdea4853925e"
+)
+
+func TestController_ApprovePacket(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger("info")
+	storeMock := store.New()
+
+	tests := []struct {
+		name       string
+		packetID   string
+		approvedBy string
+		wantErr    error
+	}{
+		{
+			name:       "own_packet",
+			packetID:   calibratedPacket,
+			approvedBy: reviewedID,
+			wantErr:    ErrSelfApproval,
+		},
+		{
+			name:       "packet_calibrated_by_the_approver",
+			packetID:   calibratedPacket,
+			approvedBy: calibratorID,
+			wantErr:    ErrCalibratorApproval,
+		},
+		{
+			name:       "packet_already_approved",
+			packetID:   approvedPacket,
+			approvedBy: otherID,
+			wantErr:    ErrPacketNotCalibrated,
+		},
+		{
+			name:       "ok",
+			packetID:   calibratedPacket,
+			approvedBy: approverID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/packet/packet.sql")
+
+				c := New(storeMock, txRepo, nil, loggerMock, &cfg)
+				packet, err := c.ApprovePacket(cycleID, tt.packetID, model.MustGetUUIDFromString(tt.approvedBy))
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, model.ReviewPacketStatusApproved, packet.Status)
+
+				employee, err := storeMock.Employee.One(txRepo.DB(), reviewedID, false)
+				require.NoError(t, err)
+				require.Equal(t, midID, employee.SeniorityID.String())
+			})
+		})
+	}
+}
+
+func TestController_RejectPacket(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger("info")
+	storeMock := store.New()
+
+	tests := []struct {
+		name       string
+		rejectedBy string
+		wantErr    error
+	}{
+		{
+			name:       "own_packet",
+			rejectedBy: reviewedID,
+			wantErr:    ErrSelfApproval,
+		},
+		{
+			name:       "packet_calibrated_by_the_rejecter",
+			rejectedBy: calibratorID,
+			wantErr:    ErrCalibratorApproval,
+		},
+		{
+			name:       "ok",
+			rejectedBy: approverID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+				testhelper.LoadTestSQLFile(t, txRepo, "./testdata/packet/packet.sql")
+
+				c := New(storeMock, txRepo, nil, loggerMock, &cfg)
+				packet, err := c.RejectPacket(cycleID, calibratedPacket, RejectInput{RejectedBy: model.MustGetUUIDFromString(tt.rejectedBy)})
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, model.ReviewPacketStatusRejected, packet.Status)
+			})
+		})
+	}
+}
+
+// TestController_ApprovePacket_Concurrently checks a packet is decided once: the decision which
+// comes second finds the packet no longer calibrated, whether it read it before or after the first
+func TestController_ApprovePacket_Concurrently(t *testing.T) {
+	cfg := config.LoadTestConfig()
+	loggerMock := logger.NewLogrusLogger("info")
+	storeMock := store.New()
+
+	testhelper.TestWithTxDB(t, func(txRepo store.DBRepo) {
+		testhelper.LoadTestSQLFile(t, txRepo, "./testdata/packet/packet.sql")
+		c := New(storeMock, txRepo, nil, loggerMock, &cfg)
+
+		// the second approver read the packet while it was calibrated, its update finds it approved
+		_, err := c.ApprovePacket(cycleID, calibratedPacket, model.MustGetUUIDFromString(approverID))
+		require.NoError(t, err)
+		now := time.Now()
+		otherUUID := model.MustGetUUIDFromString(otherID)
+		ok, err := storeMock.ReviewPacket.UpdateStatus(txRepo.DB(), calibratedPacket, model.ReviewPacketStatusCalibrated, model.ReviewPacket{
+			Status:     model.ReviewPacketStatusApproved,
+			ApprovedBy: &otherUUID,
+			ApprovedAt: &now,
+		}, "status", "approved_by", "approved_at")
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		// the second approver read the packet once approved
+		_, err = c.ApprovePacket(cycleID, calibratedPacket, otherUUID)
+		require.ErrorIs(t, err, ErrPacketNotCalibrated)
+
+		packet, err := storeMock.ReviewPacket.One(txRepo.DB(), cycleID, calibratedPacket, false)
+		require.NoError(t, err)
+		require.Equal(t, model.ReviewPacketStatusApproved, packet.Status)
+		require.Equal(t, approverID, packet.ApprovedBy.String())
+	})
+}
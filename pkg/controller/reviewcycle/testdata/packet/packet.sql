@@ -0,0 +1,6 @@
+INSERT INTO public.review_cycles (id, deleted_at, created_at, updated_at, name, period_from, period_to, status, created_by) VALUES
+('5b0a0c8e-9a3b-4d2f-8f6e-1c2d3e4f5a6b', NULL, '2026-10-01 09:00:00', '2026-10-01 09:00:00', 'H2 2026', '2026-07-01', '2026-12-31', 'calibration', '2655832e-f009-4b73-a535-64c3a22e558f');
+
+INSERT INTO public.review_packets (id, deleted_at, created_at, updated_at, cycle_id, employee_id, status, final_rating, current_seniority_id, proposed_seniority_id, calibrated_by, calibrated_at, approved_by, approved_at) VALUES
+('8a1f2c3d-4e5f-4a6b-9c7d-0e1f2a3b4c5d', NULL, '2026-10-01 09:00:00', '2026-10-01 09:00:00', '5b0a0c8e-9a3b-4d2f-8f6e-1c2d3e4f5a6b', 'f7c6016b-85b5-47f7-8027-23c2db482197', 'calibrated', 4, 'd796884d-a8c4-4525-81e7-54a3b6099eac', 'dac16ce6-9e5a-4ff3-9ea2-fdea4853925e', 'd42a6fca-d3b8-4a48-80f7-a95772abda56', '2026-10-10 09:00:00', NULL, NULL),
+('9b2e3d4c-5f6a-4b7c-8d9e-1f2a3b4c5d6e', NULL, '2026-10-01 09:00:00', '2026-10-01 09:00:00', '5b0a0c8e-9a3b-4d2f-8f6e-1c2d3e4f5a6b', 'dcfee24b-306d-4609-9c24-a4021639a11b', 'approved', 3, '01fb6322-d727-47e3-a242-5039ea4732fc', '01fb6322-d727-47e3-a242-5039ea4732fc', 'd42a6fca-d3b8-4a48-80f7-a95772abda56', '2026-10-10 09:00:00', '2655832e-f009-4b73-a535-64c3a22e558f', '2026-10-11 09:00:00');
@@ -0,0 +1,8 @@
+package staffing
+
+import "errors"
+
+var (
+	ErrInvalidWeeks = errors.New("invalid number of weeks")
+	ErrSlotNotFound = errors.New("slot not found")
+)
@@ -0,0 +1,34 @@
+package staffing
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/capacity"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/staffing"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store    *store.Store
+	service  *service.Service
+	capacity capacity.IController
+	logger   logger.Logger
+	repo     store.DBRepo
+	config   *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, capacity capacity.IController, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:    store,
+		repo:     repo,
+		service:  service,
+		capacity: capacity,
+		logger:   logger,
+		config:   cfg,
+	}
+}
+
+type IController interface {
+	Recommend(input RecommendInput) (*staffing.Shortlist, error)
+}
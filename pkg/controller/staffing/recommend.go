@@ -0,0 +1,182 @@
+package staffing
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/capacity"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/staffing"
+)
+
+const (
+	defaultWeeks = 4
+	maxWeeks     = 12
+	// tolerance keeps the rounding of the allocation from hiding someone free
+	tolerance = 0.01
+)
+
+type RecommendInput struct {
+	ProjectID string
+	SlotID    string
+	Weeks     int // the candidates free within the weeks, 4 when empty
+	Limit     int // every candidate when empty
+}
+
+// Recommend ranks the employees free for the slot now or within the weeks, using the capacity
+// plan for their allocation, and explains each score
+func (r *controller) Recommend(input RecommendInput) (*staffing.Shortlist, error) {
+	if input.Weeks == 0 {
+		input.Weeks = defaultWeeks
+	}
+	if input.Weeks < 0 || input.Weeks > maxWeeks {
+		return nil, ErrInvalidWeeks
+	}
+
+	l := r.logger.Fields(logger.Fields{
+		"controller": "staffing",
+		"method":     "Recommend",
+		"input":      input,
+	})
+
+	db := r.repo.DB()
+	slot, err := r.store.Staffing.GetSlot(db, input.ProjectID, input.SlotID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSlotNotFound
+		}
+		l.Error(err, "failed to get slot")
+		return nil, err
+	}
+
+	plan, err := r.capacity.Plan(capacity.PlanInput{From: time.Now(), Weeks: input.Weeks})
+	if err != nil {
+		l.Error(err, "failed to plan capacity")
+		return nil, err
+	}
+
+	type availability struct {
+		utilization float64
+		from        time.Time
+	}
+	need := r.need(slot.DeploymentType)
+	free := map[string]availability{}
+	ids := make([]string, 0, len(plan.Employees))
+	for _, p := range plan.Employees {
+		if p.ID == slot.EmployeeID {
+			continue
+		}
+		utilization, from, ok := p.Availability(need - tolerance)
+		if !ok {
+			continue
+		}
+		free[p.ID] = availability{utilization: utilization, from: from}
+		ids = append(ids, p.ID)
+	}
+
+	profiles, err := r.store.Staffing.GetProfiles(db, ids)
+	if err != nil {
+		l.Error(err, "failed to get staffing profiles")
+		return nil, err
+	}
+	profileOf := map[string]model.StaffingProfile{}
+	for _, p := range profiles {
+		profileOf[p.EmployeeID] = p
+	}
+
+	clientProjects := map[string][]string{}
+	if slot.ClientID != "" {
+		projects, err := r.store.Staffing.GetClientProjects(db, slot.ClientID)
+		if err != nil {
+			l.Error(err, "failed to get client projects")
+			return nil, err
+		}
+		for _, p := range projects {
+			clientProjects[p.EmployeeID] = append(clientProjects[p.EmployeeID], p.ProjectName)
+		}
+	}
+
+	candidates := make([]staffing.Candidate, 0, len(ids))
+	for _, p := range plan.Employees {
+		a, ok := free[p.ID]
+		if !ok {
+			continue
+		}
+		profile := profileOf[p.ID]
+		candidates = append(candidates, staffing.Candidate{
+			EmployeeID:     p.ID,
+			Name:           p.Name,
+			SeniorityName:  p.Seniority,
+			SeniorityLevel: profile.SeniorityLevel,
+			Stacks:         p.Stacks,
+			Positions:      split(profile.Positions),
+			Chapters:       split(profile.Chapters),
+			ClientProjects: clientProjects[p.ID],
+			MMA:            mmaOf(profile),
+			Utilization:    a.utilization,
+			AvailableFrom:  a.from,
+		})
+	}
+
+	s := staffing.Slot{
+		ID:             slot.SlotID,
+		ProjectID:      slot.ProjectID,
+		ProjectName:    slot.ProjectName,
+		DeploymentType: slot.DeploymentType.String(),
+		Status:         slot.Status.String(),
+		EmployeeID:     slot.EmployeeID,
+		SeniorityName:  slot.SeniorityName,
+		SeniorityLevel: slot.SeniorityLevel,
+		Positions:      split(slot.Positions),
+		Stacks:         split(slot.Stacks),
+		ClientName:     slot.ClientName,
+	}
+	ranked := staffing.Rank(s, candidates, staffing.DefaultWeights)
+	if input.Limit > 0 && len(ranked) > input.Limit {
+		ranked = ranked[:input.Limit]
+	}
+
+	return &staffing.Shortlist{
+		Slot:       s,
+		From:       plan.Weeks[0],
+		Weeks:      input.Weeks,
+		Candidates: ranked,
+	}, nil
+}
+
+// need is the share of a week the slot takes, like the capacity plan counts it
+func (r *controller) need(t model.DeploymentType) float64 {
+	need := 1.0
+	switch t {
+	case model.MemberDeploymentTypePartTime:
+		need = r.config.Profitability.PartTimeAllocation
+	case model.MemberDeploymentTypeShadow:
+		need = r.config.Profitability.ShadowAllocation
+	}
+	if need > 1 {
+		need = 1
+	}
+	return need
+}
+
+func mmaOf(p model.StaffingProfile) *staffing.MMA {
+	if !p.MasteryScore.Valid && !p.AutonomyScore.Valid && !p.MeaningScore.Valid {
+		return nil
+	}
+	return &staffing.MMA{
+		Mastery:  p.MasteryScore.Decimal.InexactFloat64(),
+		Autonomy: p.AutonomyScore.Decimal.InexactFloat64(),
+		Meaning:  p.MeaningScore.Decimal.InexactFloat64(),
+	}
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
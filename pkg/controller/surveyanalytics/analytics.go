@@ -0,0 +1,167 @@
+package surveyanalytics
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventquestion"
+	"github.com/dwarvesf/fortress-api/pkg/surveyanalytics"
+)
+
+const (
+	defaultTrendCycles = 6
+	maxTrendCycles     = 24
+)
+
+// Analytics is the aggregated answers of a survey, Comparisons is empty when not grouped by team
+type Analytics struct {
+	Event         *model.FeedbackEvent
+	MinGroupSize  int
+	GroupBy       model.EngagementDashboardFilter
+	Distributions []surveyanalytics.Distribution
+	Comparisons   []surveyanalytics.Comparison
+}
+
+// GetAnalytics returns the distribution of the answers of every likert-scale question of a survey,
+// and their comparison between the teams of the reviewers when groupBy is set
+func (c *controller) GetAnalytics(eventID string, groupBy model.EngagementDashboardFilter) (*Analytics, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "surveyanalytics",
+		"method":     "GetAnalytics",
+		"eventID":    eventID,
+	})
+
+	if groupBy != "" && !isGroupable(groupBy) {
+		return nil, ErrInvalidGroupBy
+	}
+
+	event, err := c.oneSurvey(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := c.repo.DB()
+	answers, err := c.store.EmployeeEventQuestion.ListSurveyAnswers(db, employeeeventquestion.SurveyAnswerFilter{
+		EventIDs: []string{eventID},
+	})
+	if err != nil {
+		l.Error(err, "failed to list survey answers")
+		return nil, err
+	}
+
+	rs := &Analytics{
+		Event:         event,
+		MinGroupSize:  surveyanalytics.DefaultMinGroupSize,
+		GroupBy:       groupBy,
+		Distributions: surveyanalytics.Distributions(toAnswers(answers), surveyanalytics.DefaultMinGroupSize),
+	}
+	if groupBy == "" {
+		return rs, nil
+	}
+
+	grouped, err := c.store.EmployeeEventQuestion.ListSurveyAnswers(db, employeeeventquestion.SurveyAnswerFilter{
+		EventIDs: []string{eventID},
+		GroupBy:  groupBy,
+	})
+	if err != nil {
+		l.Error(err, "failed to list survey answers by team")
+		return nil, err
+	}
+	rs.Comparisons = surveyanalytics.Compare(toAnswers(grouped), surveyanalytics.DefaultMinGroupSize)
+
+	return rs, nil
+}
+
+// GetTrends follows the likert-scale questions over the latest surveys of a subtype
+func (c *controller) GetTrends(subtype model.EventSubtype, cycles int) ([]surveyanalytics.Trend, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "surveyanalytics",
+		"method":     "GetTrends",
+		"subtype":    subtype,
+	})
+
+	if !subtype.IsSurveyValid() {
+		return nil, ErrInvalidSubtype
+	}
+	if cycles <= 0 {
+		cycles = defaultTrendCycles
+	}
+	if cycles > maxTrendCycles {
+		cycles = maxTrendCycles
+	}
+
+	db := c.repo.DB()
+	events, err := c.store.FeedbackEvent.GetLatestEventByType(db, model.EventTypeSurvey, subtype, cycles)
+	if err != nil {
+		l.Error(err, "failed to get latest surveys")
+		return nil, err
+	}
+	if len(events) == 0 {
+		return []surveyanalytics.Trend{}, nil
+	}
+
+	eventIDs := make([]string, 0, len(events))
+	for _, e := range events {
+		eventIDs = append(eventIDs, e.ID.String())
+	}
+
+	answers, err := c.store.EmployeeEventQuestion.ListSurveyAnswers(db, employeeeventquestion.SurveyAnswerFilter{
+		EventIDs: eventIDs,
+	})
+	if err != nil {
+		l.Error(err, "failed to list survey answers")
+		return nil, err
+	}
+
+	return surveyanalytics.Trends(toAnswers(answers), surveyanalytics.DefaultMinGroupSize), nil
+}
+
+func (c *controller) oneSurvey(eventID string) (*model.FeedbackEvent, error) {
+	event, err := c.store.FeedbackEvent.One(c.repo.DB(), eventID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSurveyNotFound
+		}
+		return nil, err
+	}
+	if event.Type != model.EventTypeSurvey {
+		return nil, ErrSurveyNotFound
+	}
+	return event, nil
+}
+
+// isGroupable tells the teams the answers can be compared between, the department of the
+// engagement dashboard is not tracked on the employees
+func isGroupable(groupBy model.EngagementDashboardFilter) bool {
+	switch groupBy {
+	case model.EngagementDashboardFilterChapter,
+		model.EngagementDashboardFilterSeniority,
+		model.EngagementDashboardFilterProject:
+		return true
+	}
+	return false
+}
+
+func toAnswers(answers []*model.SurveyAnswer) []surveyanalytics.Answer {
+	rs := make([]surveyanalytics.Answer, 0, len(answers))
+	for _, a := range answers {
+		answer := surveyanalytics.Answer{
+			EventID:      a.EventID.String(),
+			EventTitle:   a.EventTitle,
+			QuestionID:   a.QuestionID.String(),
+			Content:      a.Content,
+			Order:        a.Order,
+			RespondentID: a.ReviewerID.String(),
+			Group:        a.GroupName,
+			Value:        a.Answer,
+		}
+		if a.EventDate != nil {
+			answer.EventDate = *a.EventDate
+		}
+		rs = append(rs, answer)
+	}
+	return rs
+}
@@ -0,0 +1,12 @@
+package surveyanalytics
+
+import "errors"
+
+var (
+	ErrSurveyNotFound   = errors.New("survey not found")
+	ErrQuestionNotFound = errors.New("free-text question not found in the survey")
+	ErrInvalidGroupBy   = errors.New("invalid groupBy, expected chapter, seniority or project")
+	ErrInvalidSubtype   = errors.New("invalid subtype, expected peer-review, engagement or work")
+	ErrNotEnoughAnswers = errors.New("not enough respondents to summarize the answers anonymously")
+	ErrLLMUnavailable   = errors.New("the LLM service is not configured")
+)
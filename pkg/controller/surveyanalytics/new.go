@@ -0,0 +1,36 @@
+package surveyanalytics
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/surveyanalytics"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	GetAnalytics(eventID string, groupBy model.EngagementDashboardFilter) (*Analytics, error)
+	GetTrends(subtype model.EventSubtype, cycles int) ([]surveyanalytics.Trend, error)
+
+	ListThemeSummaries(eventID string) ([]*model.SurveyThemeSummary, error)
+	SummarizeThemes(eventID string, input SummarizeThemesInput) ([]*model.SurveyThemeSummary, error)
+}
@@ -0,0 +1,148 @@
+package surveyanalytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store/employeeeventquestion"
+	"github.com/dwarvesf/fortress-api/pkg/surveyanalytics"
+)
+
+const (
+	defaultThemeModel = "google/gemini-2.5-flash"
+	themeMaxTokens    = 800
+
+	themeSystemPrompt = `You summarize the anonymous free-text answers of an employee survey for the HR team.
+Group the answers into at most 5 themes, the most frequent first. For each theme write one markdown bullet point: a short bold title, the number of answers sharing it, and one sentence describing it.
+Never quote an answer word for word and never mention a name, a project, a client or any detail which could identify who wrote an answer.
+Output only the bullet points, in English.`
+)
+
+type SummarizeThemesInput struct {
+	// QuestionID is the free-text question to summarize, every free-text question of the survey
+	// when empty
+	QuestionID string
+	CreatedBy  *model.UUID
+}
+
+func (c *controller) ListThemeSummaries(eventID string) ([]*model.SurveyThemeSummary, error) {
+	if _, err := c.oneSurvey(eventID); err != nil {
+		return nil, err
+	}
+	return c.store.SurveyThemeSummary.ListByEventID(c.repo.DB(), eventID)
+}
+
+// SummarizeThemes summarizes the themes of the free-text answers of a survey through the LLM,
+// replacing the previous summary of a question. A question answered by too few respondents is not
+// summarized, so no answer can be traced back to its author.
+func (c *controller) SummarizeThemes(eventID string, input SummarizeThemesInput) ([]*model.SurveyThemeSummary, error) {
+	l := c.logger.Fields(logger.Fields{
+		"controller": "surveyanalytics",
+		"method":     "SummarizeThemes",
+		"eventID":    eventID,
+		"questionID": input.QuestionID,
+	})
+
+	if c.service.OpenRouter == nil || c.config.OpenRouter.APIKey == "" {
+		return nil, ErrLLMUnavailable
+	}
+
+	if _, err := c.oneSurvey(eventID); err != nil {
+		return nil, err
+	}
+
+	db := c.repo.DB()
+	answers, err := c.store.EmployeeEventQuestion.ListSurveyAnswers(db, employeeeventquestion.SurveyAnswerFilter{
+		EventIDs:   []string{eventID},
+		QuestionID: input.QuestionID,
+	})
+	if err != nil {
+		l.Error(err, "failed to list survey answers")
+		return nil, err
+	}
+
+	// The free-text questions, in the order of the survey
+	var questions []*model.SurveyAnswer
+	seen := map[model.UUID]bool{}
+	for _, a := range answers {
+		if a.Type != model.QuestionTypeGeneral || seen[a.QuestionID] {
+			continue
+		}
+		seen[a.QuestionID] = true
+		questions = append(questions, a)
+	}
+	if input.QuestionID != "" && len(questions) == 0 {
+		return nil, ErrQuestionNotFound
+	}
+
+	llmModel := c.config.OpenRouter.Model
+	if llmModel == "" {
+		llmModel = defaultThemeModel
+	}
+
+	all := toAnswers(answers)
+	rs := make([]*model.SurveyThemeSummary, 0, len(questions))
+	for _, q := range questions {
+		texts, ok := surveyanalytics.Texts(all, q.QuestionID.String(), surveyanalytics.DefaultMinGroupSize)
+		if !ok {
+			if input.QuestionID != "" {
+				return nil, ErrNotEnoughAnswers
+			}
+			l.Infof("skip question %v, not enough respondents", q.QuestionID)
+			continue
+		}
+
+		summary, err := c.service.OpenRouter.GenerateText(context.Background(), themeSystemPrompt, themePrompt(q.Content, texts), llmModel, themeMaxTokens, 0.2)
+		if err != nil {
+			l.Errorf(err, "failed to summarize themes of question %v", q.QuestionID)
+			return nil, err
+		}
+
+		saved, err := c.saveThemeSummary(db, model.SurveyThemeSummary{
+			EventID:     q.EventID,
+			QuestionID:  q.QuestionID,
+			Content:     q.Content,
+			Summary:     summary,
+			AnswerCount: len(texts),
+			Model:       llmModel,
+			CreatedBy:   input.CreatedBy,
+		})
+		if err != nil {
+			l.Errorf(err, "failed to save theme summary of question %v", q.QuestionID)
+			return nil, err
+		}
+		rs = append(rs, saved)
+	}
+
+	return rs, nil
+}
+
+func (c *controller) saveThemeSummary(db *gorm.DB, summary model.SurveyThemeSummary) (*model.SurveyThemeSummary, error) {
+	existing, err := c.store.SurveyThemeSummary.OneByEventAndQuestion(db, summary.EventID.String(), summary.QuestionID.String())
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return c.store.SurveyThemeSummary.Create(db, &summary)
+	}
+
+	return c.store.SurveyThemeSummary.UpdateSelectedFieldsByID(db, existing.ID.String(), summary,
+		"content", "summary", "answer_count", "model", "created_by")
+}
+
+func themePrompt(question string, texts []string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Question: %v\n\nAnswers:\n", question))
+	for _, t := range texts {
+		sb.WriteString("- ")
+		sb.WriteString(strings.Join(strings.Fields(t), " "))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
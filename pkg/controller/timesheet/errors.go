@@ -0,0 +1,21 @@
+package timesheet
+
+import "errors"
+
+var (
+	ErrTimesheetNotFound      = errors.New("timesheet not found")
+	ErrTimesheetExists        = errors.New("timesheet of the week already exists")
+	ErrProjectNotFound        = errors.New("project not found")
+	ErrEmployeeNotFound       = errors.New("employee not found")
+	ErrNotProjectMember       = errors.New("employee is not a member of the project")
+	ErrWorkUnitNotFound       = errors.New("work unit not found in the project")
+	ErrTimesheetNotEditable   = errors.New("timesheet can only be changed while draft or rejected")
+	ErrTimesheetNotReviewable = errors.New("timesheet is not waiting for a review")
+	ErrTimesheetEmpty         = errors.New("timesheet has no entry to submit")
+	ErrNotTimesheetOwner      = errors.New("timesheet belongs to another employee")
+	ErrNotProjectLead         = errors.New("only a lead of the project can review its timesheets")
+	ErrOwnTimesheet           = errors.New("cannot review your own timesheet")
+	ErrRejectReasonRequired   = errors.New("reason is required to reject a timesheet")
+	ErrInvalidMonth           = errors.New("invalid month")
+	ErrProjectNotTimeMaterial = errors.New("project is not time and material")
+)
@@ -0,0 +1,99 @@
+package timesheet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/timesheet"
+)
+
+// InvoiceLineItems are the approved hours of a month not invoiced yet and the lines billing them
+type InvoiceLineItems struct {
+	Project *model.Project
+	Month   int
+	Year    int
+	Hours   []model.TimesheetBillableHours
+	Items   []model.InvoiceItem
+}
+
+// InvoiceLineItems bills the approved hours of the month of a time and material project at the
+// rate of each member, nothing is locked until the invoice is sent
+func (r *controller) InvoiceLineItems(projectID string, year, month int) (*InvoiceLineItems, error) {
+	if month < 1 || month > 12 || year < 1 {
+		return nil, ErrInvalidMonth
+	}
+
+	project, err := r.store.Project.One(r.repo.DB(), projectID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+	if project.Type != model.ProjectTypeTimeMaterial {
+		return nil, ErrProjectNotTimeMaterial
+	}
+
+	from := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	hours, err := r.store.Timesheet.GetBillableHours(r.repo.DB(), projectID, from, from.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return &InvoiceLineItems{
+		Project: project,
+		Month:   month,
+		Year:    year,
+		Hours:   hours,
+		Items:   timesheet.LineItems(hours, from.Format("January 2006")),
+	}, nil
+}
+
+// LockInvoiced bills the approved hours of the month of a sent invoice of a time and material
+// project on it and locks their timesheets, it returns the number of timesheets locked
+func (r *controller) LockInvoiced(invoice *model.Invoice) (int64, error) {
+	if invoice == nil || invoice.Status == model.InvoiceStatusDraft {
+		return 0, nil
+	}
+
+	l := r.logger.Fields(logger.Fields{
+		"controller": "timesheet",
+		"method":     "LockInvoiced",
+		"invoice":    invoice.Number,
+	})
+
+	project, err := r.store.Project.One(r.repo.DB(), invoice.ProjectID.String(), false)
+	if err != nil {
+		return 0, err
+	}
+	if project.Type != model.ProjectTypeTimeMaterial {
+		return 0, nil
+	}
+	if invoice.Month < 1 || invoice.Month > 12 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidMonth, invoice.Month)
+	}
+
+	from := time.Date(invoice.Year, time.Month(invoice.Month), 1, 0, 0, 0, 0, time.UTC)
+
+	tx, done := r.repo.NewTransaction()
+	billed, err := r.store.TimesheetEntry.SetInvoiceID(tx.DB(), project.ID.String(), from, from.AddDate(0, 1, 0), invoice.ID.String())
+	if err != nil {
+		l.Error(err, "failed to bill timesheet entries")
+		return 0, done(err)
+	}
+	if billed == 0 {
+		return 0, done(nil)
+	}
+	locked, err := r.store.Timesheet.LockByInvoiceID(tx.DB(), invoice.ID.String(), time.Now())
+	if err != nil {
+		l.Error(err, "failed to lock timesheets")
+		return 0, done(err)
+	}
+
+	return locked, done(nil)
+}
@@ -0,0 +1,40 @@
+package timesheet
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+type controller struct {
+	store   *store.Store
+	service *service.Service
+	logger  logger.Logger
+	repo    store.DBRepo
+	config  *config.Config
+}
+
+func New(store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IController {
+	return &controller{
+		store:   store,
+		repo:    repo,
+		service: service,
+		logger:  logger,
+		config:  cfg,
+	}
+}
+
+type IController interface {
+	List(actor Actor, input ListInput, pagination model.Pagination) ([]*model.Timesheet, int64, error)
+	Get(actor Actor, id string) (*model.Timesheet, error)
+	Create(actor Actor, input CreateInput) (*model.Timesheet, error)
+	Update(actor Actor, id string, input UpdateInput) (*model.Timesheet, error)
+	Submit(actor Actor, id string) (*model.Timesheet, error)
+	Approve(actor Actor, id string) (*model.Timesheet, error)
+	Reject(actor Actor, id string, reason string) (*model.Timesheet, error)
+
+	InvoiceLineItems(projectID string, year, month int) (*InvoiceLineItems, error)
+	LockInvoiced(invoice *model.Invoice) (int64, error)
+}
@@ -0,0 +1,378 @@
+package timesheet
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	timesheetstore "github.com/dwarvesf/fortress-api/pkg/store/timesheet"
+	"github.com/dwarvesf/fortress-api/pkg/timesheet"
+)
+
+// Actor is the logged in employee, FullAccess lets them see, fill and review the timesheets of
+// everyone
+type Actor struct {
+	ID         string
+	FullAccess bool
+}
+
+type ListInput struct {
+	EmployeeID string
+	ProjectID  string
+	Status     model.TimesheetStatus
+	From       *time.Time
+	To         *time.Time
+}
+
+type EntryInput struct {
+	WorkUnitID  string
+	Date        time.Time
+	Hours       decimal.Decimal
+	Description string
+}
+
+type CreateInput struct {
+	EmployeeID string // the actor when empty
+	ProjectID  string
+	WeekStart  time.Time
+	Note       string
+	Entries    []EntryInput
+}
+
+type UpdateInput struct {
+	Note    string
+	Entries []EntryInput
+}
+
+// List returns the timesheets the actor can see, their own and the ones of the projects they lead
+// unless they have full access
+func (r *controller) List(actor Actor, input ListInput, pagination model.Pagination) ([]*model.Timesheet, int64, error) {
+	query := timesheetstore.Query{
+		EmployeeID: input.EmployeeID,
+		ProjectID:  input.ProjectID,
+		Status:     input.Status,
+		From:       input.From,
+		To:         input.To,
+	}
+	if !actor.FullAccess {
+		query.ViewerID = actor.ID
+	}
+
+	return r.store.Timesheet.All(r.repo.DB(), query, pagination)
+}
+
+func (r *controller) Get(actor Actor, id string) (*model.Timesheet, error) {
+	t, err := r.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if actor.FullAccess || t.EmployeeID.String() == actor.ID {
+		return t, nil
+	}
+
+	isLead, err := r.isLead(t.ProjectID.String(), actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isLead {
+		return nil, ErrTimesheetNotFound
+	}
+	return t, nil
+}
+
+// Create opens the timesheet of a week on a project, as a draft
+func (r *controller) Create(actor Actor, input CreateInput) (*model.Timesheet, error) {
+	if input.EmployeeID == "" {
+		input.EmployeeID = actor.ID
+	}
+	if input.EmployeeID != actor.ID && !actor.FullAccess {
+		return nil, ErrNotTimesheetOwner
+	}
+
+	l := r.logger.Fields(logger.Fields{
+		"controller": "timesheet",
+		"method":     "Create",
+		"input":      input,
+	})
+
+	db := r.repo.DB()
+	weekStart := timesheet.WeekStart(input.WeekStart)
+
+	if _, err := r.store.Employee.One(db, input.EmployeeID, false); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+	if _, err := r.store.Project.One(db, input.ProjectID, false); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	isMember, err := r.store.ProjectMember.IsExistsByEmployeeID(db, input.ProjectID, input.EmployeeID)
+	if err != nil {
+		l.Error(err, "failed to check project membership")
+		return nil, err
+	}
+	if !isMember {
+		return nil, ErrNotProjectMember
+	}
+
+	_, err = r.store.Timesheet.OneByWeek(db, input.EmployeeID, input.ProjectID, weekStart)
+	if err == nil {
+		return nil, ErrTimesheetExists
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	t := &model.Timesheet{
+		BaseModel:  model.BaseModel{ID: model.NewUUID()},
+		EmployeeID: model.MustGetUUIDFromString(input.EmployeeID),
+		ProjectID:  model.MustGetUUIDFromString(input.ProjectID),
+		WeekStart:  weekStart,
+		Status:     model.TimesheetStatusDraft,
+		Note:       strings.TrimSpace(input.Note),
+	}
+	entries, err := r.toEntries(t, input.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+	if _, err := r.store.Timesheet.Create(tx.DB(), t); err != nil {
+		l.Error(err, "failed to create timesheet")
+		return nil, done(err)
+	}
+	if err := r.store.TimesheetEntry.Create(tx.DB(), entries...); err != nil {
+		l.Error(err, "failed to create timesheet entries")
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.get(t.ID.String())
+}
+
+// Update replaces the note and the entries of a draft or rejected timesheet
+func (r *controller) Update(actor Actor, id string, input UpdateInput) (*model.Timesheet, error) {
+	t, err := r.owned(actor, id)
+	if err != nil {
+		return nil, err
+	}
+	if !t.Status.IsEditable() {
+		return nil, ErrTimesheetNotEditable
+	}
+
+	entries, err := r.toEntries(t, input.Entries)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, done := r.repo.NewTransaction()
+	if err := r.store.TimesheetEntry.DeleteByTimesheetID(tx.DB(), id); err != nil {
+		return nil, done(err)
+	}
+	if err := r.store.TimesheetEntry.Create(tx.DB(), entries...); err != nil {
+		return nil, done(err)
+	}
+	if _, err := r.store.Timesheet.UpdateSelectedFieldsByID(tx.DB(), id, model.Timesheet{
+		Note: strings.TrimSpace(input.Note),
+	}, "note"); err != nil {
+		return nil, done(err)
+	}
+	if err := done(nil); err != nil {
+		return nil, err
+	}
+
+	return r.get(id)
+}
+
+// Submit sends a draft or rejected timesheet to the leads of the project for approval
+func (r *controller) Submit(actor Actor, id string) (*model.Timesheet, error) {
+	t, err := r.owned(actor, id)
+	if err != nil {
+		return nil, err
+	}
+	if !t.Status.IsEditable() {
+		return nil, ErrTimesheetNotEditable
+	}
+	if len(t.Entries) == 0 {
+		return nil, ErrTimesheetEmpty
+	}
+
+	now := time.Now()
+	if _, err := r.store.Timesheet.UpdateSelectedFieldsByID(r.repo.DB(), id, model.Timesheet{
+		Status:      model.TimesheetStatusSubmitted,
+		SubmittedAt: &now,
+	}, "status", "submitted_at"); err != nil {
+		return nil, err
+	}
+
+	return r.get(id)
+}
+
+// Approve makes the hours of a submitted timesheet billable
+func (r *controller) Approve(actor Actor, id string) (*model.Timesheet, error) {
+	t, err := r.reviewed(actor, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status != model.TimesheetStatusSubmitted {
+		return nil, ErrTimesheetNotReviewable
+	}
+
+	return r.review(t, actor, model.TimesheetStatusApproved, "")
+}
+
+// Reject sends a timesheet back to its owner, an approved one too as long as it is not invoiced
+func (r *controller) Reject(actor Actor, id string, reason string) (*model.Timesheet, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrRejectReasonRequired
+	}
+
+	t, err := r.reviewed(actor, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.review(t, actor, model.TimesheetStatusRejected, reason)
+}
+
+func (r *controller) review(t *model.Timesheet, actor Actor, status model.TimesheetStatus, reason string) (*model.Timesheet, error) {
+	reviewer, err := model.UUIDFromString(actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if _, err := r.store.Timesheet.UpdateSelectedFieldsByID(r.repo.DB(), t.ID.String(), model.Timesheet{
+		Status:         status,
+		ReviewedBy:     &reviewer,
+		ReviewedAt:     &now,
+		RejectedReason: reason,
+	}, "status", "reviewed_by", "reviewed_at", "rejected_reason"); err != nil {
+		return nil, err
+	}
+
+	return r.get(t.ID.String())
+}
+
+func (r *controller) get(id string) (*model.Timesheet, error) {
+	t, err := r.store.Timesheet.One(r.repo.DB(), id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTimesheetNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// owned returns a timesheet the actor can fill
+func (r *controller) owned(actor Actor, id string) (*model.Timesheet, error) {
+	t, err := r.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if t.EmployeeID.String() != actor.ID && !actor.FullAccess {
+		return nil, ErrNotTimesheetOwner
+	}
+	return t, nil
+}
+
+// reviewed returns a timesheet the actor can review, a lead of its project other than its owner
+func (r *controller) reviewed(actor Actor, id string) (*model.Timesheet, error) {
+	t, err := r.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !t.Status.IsReviewable() {
+		return nil, ErrTimesheetNotReviewable
+	}
+	if actor.FullAccess {
+		return t, nil
+	}
+	if t.EmployeeID.String() == actor.ID {
+		return nil, ErrOwnTimesheet
+	}
+
+	isLead, err := r.isLead(t.ProjectID.String(), actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !isLead {
+		return nil, ErrNotProjectLead
+	}
+	return t, nil
+}
+
+// isLead tells whether the employee is an active head of the project approving its timesheets
+func (r *controller) isLead(projectID, employeeID string) (bool, error) {
+	heads, err := r.store.ProjectHead.GetActiveLeadsByProjectID(r.repo.DB(), projectID)
+	if err != nil {
+		return false, err
+	}
+	for _, h := range heads {
+		if h.EmployeeID.String() != employeeID {
+			continue
+		}
+		for _, p := range model.TimesheetApproverPositions {
+			if h.Position == p {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// toEntries checks the entries against the week of the timesheet and the work units of its project
+func (r *controller) toEntries(t *model.Timesheet, inputs []EntryInput) ([]*model.TimesheetEntry, error) {
+	checked := make([]timesheet.Entry, 0, len(inputs))
+	for _, e := range inputs {
+		checked = append(checked, timesheet.Entry{Date: e.Date, Hours: e.Hours})
+	}
+	if err := timesheet.Validate(t.WeekStart, checked); err != nil {
+		return nil, err
+	}
+
+	workUnits := map[string]bool{}
+	entries := make([]*model.TimesheetEntry, 0, len(inputs))
+	for _, e := range inputs {
+		entry := &model.TimesheetEntry{
+			BaseModel:   model.BaseModel{ID: model.NewUUID()},
+			TimesheetID: t.ID,
+			Date:        e.Date,
+			Hours:       e.Hours,
+			Description: strings.TrimSpace(e.Description),
+		}
+
+		if e.WorkUnitID != "" {
+			if _, ok := workUnits[e.WorkUnitID]; !ok {
+				wu, err := r.store.WorkUnit.One(r.repo.DB(), e.WorkUnitID)
+				if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, err
+				}
+				workUnits[e.WorkUnitID] = err == nil && wu.ProjectID == t.ProjectID
+			}
+			if !workUnits[e.WorkUnitID] {
+				return nil, ErrWorkUnitNotFound
+			}
+			workUnitID := model.MustGetUUIDFromString(e.WorkUnitID)
+			entry.WorkUnitID = &workUnitID
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
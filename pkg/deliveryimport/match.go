@@ -0,0 +1,210 @@
+package deliveryimport
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	ErrNoMatch   = errors.New("no match")
+	ErrAmbiguous = errors.New("ambiguous match")
+)
+
+const (
+	// minSimilarity is the similarity from which a name is fuzzily matched
+	minSimilarity = 0.8
+	// ambiguityMargin is how close to the best match another candidate has to be to make the
+	// match ambiguous
+	ambiguityMargin = 0.05
+	// minContainedLength avoids matching a short name found by chance in a longer one
+	minContainedLength = 4
+)
+
+// Candidate is an employee or a project a row may be mapped to
+type Candidate struct {
+	ID    string
+	Label string // what the candidate is displayed as in the validation report
+	// Exact are the keys only matched as is, e.g. the emails
+	Exact []string
+	// Fuzzy are the keys also matched approximately, e.g. the names
+	Fuzzy []string
+}
+
+// Matcher maps the values of the rows to the candidates, the overrides taking precedence
+type Matcher struct {
+	candidates []Candidate
+	overrides  map[string]string
+	exact      map[string][]int
+}
+
+// NewMatcher returns a matcher of the candidates, the overrides map a value to a candidate ID
+func NewMatcher(candidates []Candidate, overrides map[string]string) *Matcher {
+	m := &Matcher{
+		candidates: candidates,
+		overrides:  make(map[string]string, len(overrides)),
+		exact:      map[string][]int{},
+	}
+	for value, id := range overrides {
+		m.overrides[Normalize(value)] = id
+	}
+	for i, c := range candidates {
+		for _, k := range append(append([]string{}, c.Exact...), c.Fuzzy...) {
+			if k = Normalize(k); k != "" && !containsInt(m.exact[k], i) {
+				m.exact[k] = append(m.exact[k], i)
+			}
+		}
+	}
+	return m
+}
+
+// Match returns the ID of the candidate the values identify, they are tried in order: an override,
+// then an exact key, then a fuzzy match of the names. The error explains why nothing is matched.
+func (m *Matcher) Match(values ...string) (string, error) {
+	var keys []string
+	for _, v := range values {
+		if k := Normalize(v); k != "" {
+			keys = append(keys, k)
+		}
+	}
+
+	for _, k := range keys {
+		if id, ok := m.overrides[k]; ok {
+			return id, nil
+		}
+	}
+
+	for _, k := range keys {
+		switch matches := m.exact[k]; len(matches) {
+		case 0:
+			continue
+		case 1:
+			return m.candidates[matches[0]].ID, nil
+		default:
+			return "", m.ambiguous(matches)
+		}
+	}
+
+	type scored struct {
+		idx   int
+		score float64
+	}
+	var best []scored
+	for i, c := range m.candidates {
+		score := 0.0
+		for _, k := range keys {
+			for _, f := range c.Fuzzy {
+				if s := Similarity(k, Normalize(f)); s > score {
+					score = s
+				}
+			}
+		}
+		if score >= minSimilarity {
+			best = append(best, scored{i, score})
+		}
+	}
+	if len(best) == 0 {
+		return "", ErrNoMatch
+	}
+
+	sort.SliceStable(best, func(i, j int) bool { return best[i].score > best[j].score })
+	tied := []int{best[0].idx}
+	for _, b := range best[1:] {
+		if best[0].score-b.score <= ambiguityMargin {
+			tied = append(tied, b.idx)
+		}
+	}
+	if len(tied) > 1 {
+		return "", m.ambiguous(tied)
+	}
+	return m.candidates[best[0].idx].ID, nil
+}
+
+func (m *Matcher) ambiguous(idx []int) error {
+	labels := make([]string, 0, len(idx))
+	for _, i := range idx {
+		labels = append(labels, m.candidates[i].Label)
+	}
+	sort.Strings(labels)
+	return fmt.Errorf("%w between %s", ErrAmbiguous, strings.Join(labels, ", "))
+}
+
+// Similarity scores from 0 to 1 how alike two normalized keys are, a key contained in the other,
+// e.g. "fortress" in "fortressv20", scores as a typo
+func Similarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	shorter, longer := a, b
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	if len(shorter) >= minContainedLength && strings.HasPrefix(longer, shorter) {
+		return minSimilarity
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	maxLen := len(ra)
+	if len(rb) > maxLen {
+		maxLen = len(rb)
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(maxLen)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// Normalize lowercases a value and strips its accents, spaces and punctuation, so "Nguyễn Văn A"
+// maps "nguyen van a" and "Fortress v2.0" maps "fortress v2 0"
+func Normalize(s string) string {
+	s = strings.NewReplacer("đ", "d", "Đ", "D").Replace(s)
+	// the transformer keeps a state, it can't be shared between goroutines
+	accentRemover := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	if out, _, err := transform.String(accentRemover, s); err == nil {
+		s = out
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func containsInt(s []int, v int) bool {
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
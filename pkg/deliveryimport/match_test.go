@@ -0,0 +1,74 @@
+package deliveryimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatcher(t *testing.T) {
+	employees := NewMatcher([]Candidate{
+		{ID: "1", Label: "Nguyễn Văn An", Exact: []string{"an@d.foundation", "annv"}, Fuzzy: []string{"Nguyễn Văn An"}},
+		{ID: "2", Label: "Tran Binh", Exact: []string{"binh@d.foundation"}, Fuzzy: []string{"Tran Binh"}},
+		{ID: "3", Label: "Tran Binh Minh", Exact: []string{"minh@d.foundation"}, Fuzzy: []string{"Tran Binh"}},
+	}, map[string]string{"an.nguyen@gmail.com": "1"})
+
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+		err    error
+	}{
+		{"exact email", []string{"AN@d.foundation", "someone else"}, "1", nil},
+		{"override", []string{"an.nguyen@gmail.com", ""}, "1", nil},
+		{"email falls back to the name", []string{"typo@d.foundation", "nguyen van an"}, "1", nil},
+		{"fuzzy name", []string{"", "Nguyen Van Ann"}, "1", nil},
+		{"same name", []string{"", "Tran Binh"}, "", ErrAmbiguous},
+		{"email is never fuzzy", []string{"bin@d.foundation", ""}, "", ErrNoMatch},
+		{"unknown", []string{"", "Someone Else"}, "", ErrNoMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := employees.Match(tt.values...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+
+	t.Run("ambiguous lists the candidates", func(t *testing.T) {
+		_, err := employees.Match("Tran Binh")
+		assert.EqualError(t, err, "ambiguous match between Tran Binh, Tran Binh Minh")
+	})
+
+	t.Run("project prefix", func(t *testing.T) {
+		projects := NewMatcher([]Candidate{
+			{ID: "p1", Label: "Fortress v2.0", Fuzzy: []string{"Fortress v2.0"}},
+			{ID: "p2", Label: "Kafi", Fuzzy: []string{"Kafi"}},
+		}, nil)
+
+		id, err := projects.Match("fortress")
+		require.NoError(t, err)
+		assert.Equal(t, "p1", id)
+
+		_, err = projects.Match("Kaf")
+		assert.ErrorIs(t, err, ErrNoMatch)
+	})
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, Similarity("kafi", "kafi"))
+	assert.Equal(t, 0.0, Similarity("", "kafi"))
+	assert.InDelta(t, 0.9, Similarity("fortressv2", "fortressv3"), 0.001)
+	assert.Equal(t, minSimilarity, Similarity("fortress", "fortressv20"))
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "nguyenvandung", Normalize("Nguyễn Văn Dũng"))
+	assert.Equal(t, "dang", Normalize("Đặng"))
+	assert.Equal(t, "fortressv20", Normalize("Fortress v2.0"))
+}
@@ -0,0 +1,273 @@
+// Package deliveryimport reads the delivery metrics from the Google Sheet or an uploaded CSV/XLSX
+// file, validates their rows and maps them to the employees and projects
+package deliveryimport
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// Row is a valid row of a delivery metrics file, its people and project are not mapped yet
+type Row struct {
+	Line          int
+	ID            string // the id of the row in the file, empty when the file has no id column
+	Person        string
+	Email         string
+	Project       string
+	RawDate       string
+	Date          time.Time
+	Weight        decimal.Decimal
+	Effort        decimal.Decimal
+	Effectiveness decimal.Decimal
+}
+
+// Rejection returns the rejection of a row for the given reasons
+func (r Row) Rejection(reasons ...string) model.DeliveryMetricRejection {
+	return model.DeliveryMetricRejection{
+		Line:    r.Line,
+		RowID:   r.ID,
+		Person:  r.Person,
+		Email:   r.Email,
+		Project: r.Project,
+		Date:    r.RawDate,
+		Reasons: reasons,
+	}
+}
+
+const (
+	columnPerson        = "person"
+	columnWeight        = "weight"
+	columnEffort        = "effort"
+	columnEffectiveness = "effectiveness"
+	columnDate          = "date"
+	columnProject       = "project"
+	columnEmail         = "email"
+	columnID            = "id"
+)
+
+// sheetLayout is the columns of the "All Data" sheet, used when the header of a file isn't recognized
+var sheetLayout = map[string]int{
+	columnPerson:        0,
+	columnWeight:        1,
+	columnEffort:        2,
+	columnEffectiveness: 3,
+	columnDate:          4,
+	columnProject:       5,
+	columnEmail:         6,
+}
+
+// headerAliases are the header names of each column, compared lowercased without spaces, dashes
+// and underscores
+var headerAliases = map[string][]string{
+	columnPerson:        {"person", "name", "member", "employee", "fullname"},
+	columnWeight:        {"weight", "point", "points"},
+	columnEffort:        {"effort", "hours"},
+	columnEffectiveness: {"effectiveness"},
+	columnDate:          {"date", "week"},
+	columnProject:       {"project", "projectname"},
+	columnEmail:         {"email", "teamemail", "mail"},
+	columnID:            {"id", "rowid", "key"},
+}
+
+// Parse validates the records of an uploaded file whose first record is the header. Every row that
+// can't be imported is returned as a rejection with all its problems, the empty rows are skipped.
+func Parse(records [][]string) ([]Row, []model.DeliveryMetricRejection) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+	return parse(records, columns(records[0]))
+}
+
+// ParseSheet validates the records of the sheet, read with its layout whatever its header says
+func ParseSheet(records [][]string) ([]Row, []model.DeliveryMetricRejection) {
+	return parse(records, sheetLayout)
+}
+
+func parse(records [][]string, cols map[string]int) ([]Row, []model.DeliveryMetricRejection) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	get := func(record []string, column string) string {
+		i, ok := cols[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+	_, hasID := cols[columnID]
+
+	var rows []Row
+	var rejections []model.DeliveryMetricRejection
+	ids := map[string]bool{}
+	for n, record := range records[1:] {
+		if isBlank(record) {
+			continue
+		}
+
+		row := Row{
+			Line:    n + 2,
+			ID:      get(record, columnID),
+			Person:  get(record, columnPerson),
+			Email:   strings.ToLower(get(record, columnEmail)),
+			Project: get(record, columnProject),
+			RawDate: get(record, columnDate),
+		}
+
+		var reasons []string
+		// the id identifies the row across the uploads, each row of a file with an id column has its own
+		switch {
+		case !hasID:
+		case row.ID == "":
+			reasons = append(reasons, "missing id")
+		case ids[row.ID]:
+			reasons = append(reasons, fmt.Sprintf("duplicate id %q", row.ID))
+		default:
+			ids[row.ID] = true
+		}
+		if row.Email == "" && row.Person == "" {
+			reasons = append(reasons, "missing email and person")
+		}
+		if row.Project == "" {
+			reasons = append(reasons, "missing project")
+		}
+
+		var err error
+		if row.RawDate == "" {
+			reasons = append(reasons, "missing date")
+		} else if row.Date, err = parseDate(row.RawDate); err != nil {
+			reasons = append(reasons, err.Error())
+		}
+
+		for _, f := range []struct {
+			column string
+			value  *decimal.Decimal
+		}{
+			{columnWeight, &row.Weight},
+			{columnEffort, &row.Effort},
+			{columnEffectiveness, &row.Effectiveness},
+		} {
+			if *f.value, err = parseNumber(f.column, get(record, f.column)); err != nil {
+				reasons = append(reasons, err.Error())
+			}
+		}
+
+		if len(reasons) > 0 {
+			rejections = append(rejections, row.Rejection(reasons...))
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rejections
+}
+
+// columns maps the columns from the header, a file without the date, project and email or person
+// columns is read with the layout of the sheet
+func columns(header []string) map[string]int {
+	cols := map[string]int{}
+	for i, h := range header {
+		name := normalizeHeader(h)
+		for column, aliases := range headerAliases {
+			if _, ok := cols[column]; ok {
+				continue
+			}
+			for _, a := range aliases {
+				if name == a {
+					cols[column] = i
+					break
+				}
+			}
+		}
+	}
+
+	_, hasDate := cols[columnDate]
+	_, hasProject := cols[columnProject]
+	_, hasEmail := cols[columnEmail]
+	_, hasPerson := cols[columnPerson]
+	if !hasDate || !hasProject || (!hasEmail && !hasPerson) {
+		return sheetLayout
+	}
+
+	// the missing optional columns read as empty, a file without id column has its rows identified
+	// by their line
+	for column := range sheetLayout {
+		if _, ok := cols[column]; !ok {
+			cols[column] = math.MaxInt
+		}
+	}
+	return cols
+}
+
+var headerReplacer = strings.NewReplacer(" ", "", "_", "", "-", "", ".", "")
+
+func normalizeHeader(h string) string {
+	return headerReplacer.Replace(strings.ToLower(strings.TrimSpace(h)))
+}
+
+func isBlank(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// dateLayouts are the formats the weeks are written in, the sheet uses the first one
+var dateLayouts = []string{
+	"01-02-2006",
+	"1-2-2006",
+	"01/02/2006",
+	"1/2/2006",
+	"2006-01-02",
+	"2006/01/02",
+}
+
+// excelEpoch is the day 0 of the serial dates of the spreadsheets
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// serial dates are only read from 2000-01-01 to 9999-12-31, a smaller number is a typo
+const (
+	minSerialDate = 36526
+	maxSerialDate = 2958465
+)
+
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if d, err := time.Parse(layout, s); err == nil {
+			return d, nil
+		}
+	}
+
+	// a date cell of a spreadsheet is read as its serial number, e.g. 45292 for 2024-01-01
+	if serial, err := strconv.ParseFloat(s, 64); err == nil && serial >= minSerialDate && serial <= maxSerialDate {
+		return excelEpoch.AddDate(0, 0, int(serial)), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q, expected MM-DD-YYYY", s)
+}
+
+// parseNumber reads a weight, effort or effectiveness written with a dot or a comma, an empty cell
+// is 0
+func parseNumber(column, s string) (decimal.Decimal, error) {
+	if s == "" {
+		return decimal.Zero, nil
+	}
+
+	d, err := decimal.NewFromString(strings.ReplaceAll(s, ",", "."))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid %s %q", column, s)
+	}
+	if d.IsNegative() {
+		return decimal.Zero, fmt.Errorf("negative %s %q", column, s)
+	}
+	return d, nil
+}
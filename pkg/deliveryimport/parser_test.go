@@ -0,0 +1,147 @@
+package deliveryimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("sheet layout", func(t *testing.T) {
+		records := [][]string{
+			{"Person", "Weight", "Effort", "Effectiveness", "Week", "Project name", "Mail address"},
+			{"An Nguyen", "3,5", "8", "0.44", "09-28-2026", "Fortress", "AN@d.foundation"},
+			{"", "", "", "", "", "", ""},
+			{"Binh Tran", "", "", "", "not a date", "", "binh@d.foundation"},
+			{"Chi Le", "abc", "-1", "1", "", "Kafi", ""},
+		}
+
+		rows, rejections := ParseSheet(records)
+		require.Len(t, rows, 1)
+		assert.Equal(t, 2, rows[0].Line)
+		assert.Equal(t, "an@d.foundation", rows[0].Email)
+		assert.Equal(t, "Fortress", rows[0].Project)
+		assert.Equal(t, time.Date(2026, 9, 28, 0, 0, 0, 0, time.UTC), rows[0].Date)
+		assert.True(t, decimal.RequireFromString("3.5").Equal(rows[0].Weight))
+		assert.True(t, decimal.NewFromInt(8).Equal(rows[0].Effort))
+
+		require.Len(t, rejections, 2)
+		assert.Equal(t, 4, rejections[0].Line)
+		assert.Equal(t, []string{"missing project", `invalid date "not a date", expected MM-DD-YYYY`}, rejections[0].Reasons)
+		assert.Equal(t, 5, rejections[1].Line)
+		assert.Equal(t, []string{"missing date", `invalid weight "abc"`, `negative effort "-1"`}, rejections[1].Reasons)
+	})
+
+	t.Run("unknown header read with the sheet layout", func(t *testing.T) {
+		records := [][]string{
+			{"Who", "W", "E", "Eff", "When", "Where", "Mail"},
+			{"An Nguyen", "1", "2", "0.5", "09-28-2026", "Fortress", "an@d.foundation"},
+		}
+
+		rows, rejections := Parse(records)
+		require.Len(t, rows, 1)
+		assert.Empty(t, rejections)
+		assert.Equal(t, "an@d.foundation", rows[0].Email)
+		assert.Equal(t, "Fortress", rows[0].Project)
+	})
+
+	t.Run("header mapped columns", func(t *testing.T) {
+		records := [][]string{
+			{"Date", "Email", "Project", "Points"},
+			{"45292", "an@d.foundation", "Fortress", "2"},
+			{"2026-09-28", "", "Fortress", "1"},
+		}
+
+		rows, rejections := Parse(records)
+		require.Len(t, rows, 1)
+		assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), rows[0].Date)
+		assert.True(t, decimal.NewFromInt(2).Equal(rows[0].Weight))
+		assert.True(t, rows[0].Effort.IsZero())
+
+		require.Len(t, rejections, 1)
+		assert.Equal(t, 3, rejections[0].Line)
+		assert.Equal(t, []string{"missing email and person"}, rejections[0].Reasons)
+	})
+
+	t.Run("id column", func(t *testing.T) {
+		records := [][]string{
+			{"Row ID", "Date", "Email", "Project"},
+			{"a1", "2026-09-28", "an@d.foundation", "Fortress"},
+			{"", "2026-09-28", "an@d.foundation", "Fortress"},
+			{"a1", "2026-09-28", "binh@d.foundation", "Fortress"},
+		}
+
+		rows, rejections := Parse(records)
+		require.Len(t, rows, 1)
+		assert.Equal(t, "a1", rows[0].ID)
+
+		require.Len(t, rejections, 2)
+		assert.Equal(t, []string{"missing id"}, rejections[0].Reasons)
+		assert.Equal(t, "a1", rejections[1].RowID)
+		assert.Equal(t, []string{`duplicate id "a1"`}, rejections[1].Reasons)
+	})
+}
+
+func TestRead(t *testing.T) {
+	t.Run("semicolon delimited csv", func(t *testing.T) {
+		records, err := Read(model.DeliveryMetricSourceCSV, strings.NewReader("\xef\xbb\xbfDate;Email;Project\n09-28-2026;an@d.foundation;Fortress\n"))
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{{"Date", "Email", "Project"}, {"09-28-2026", "an@d.foundation", "Fortress"}}, records)
+	})
+
+	t.Run("xlsx", func(t *testing.T) {
+		records, err := Read(model.DeliveryMetricSourceXLSX, bytes.NewReader(testWorkbook(t)))
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"Date", "Email", "Project"},
+			nil,
+			{"45292", "an@d.foundation", "", "Fortress v2.0"},
+		}, records)
+	})
+
+	t.Run("unsupported source", func(t *testing.T) {
+		_, err := Read(model.DeliveryMetricSourceSheet, strings.NewReader(""))
+		assert.ErrorIs(t, err, ErrUnsupportedSource)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		_, err := Read(model.DeliveryMetricSourceCSV, strings.NewReader(""))
+		assert.ErrorIs(t, err, ErrEmptyFile)
+	})
+}
+
+// testWorkbook builds a workbook whose first sheet is not named sheet1.xml, with shared, rich and
+// inline strings, a skipped row and a skipped cell
+func testWorkbook(t *testing.T) []byte {
+	files := map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="All Data" sheetId="1" r:id="rId2"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Target="sharedStrings.xml"/><Relationship Id="rId2" Target="worksheets/data.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>Date</t></si><si><t>Email</t></si><si><r><t>Fortress</t></r><r><t> v2.0</t></r></si></sst>`,
+		"xl/worksheets/data.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="inlineStr"><is><t>Project</t></is></c></row>
+<row r="3"><c r="A3"><v>45292</v></c><c r="B3" t="inlineStr"><is><t>an@d.foundation</t></is></c><c r="D3" t="s"><v>2</v></c></row>
+</sheetData></worksheet>`,
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,227 @@
+package deliveryimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+var (
+	ErrUnsupportedSource = errors.New("unsupported delivery metrics file, expected csv or xlsx")
+	ErrEmptyFile         = errors.New("delivery metrics file has no rows")
+)
+
+// Read reads the records of an uploaded file, one per line of the file so the line numbers of the
+// validation report are the ones the uploader sees in their spreadsheet
+func Read(source model.DeliveryMetricSource, r io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var records [][]string
+	switch source {
+	case model.DeliveryMetricSourceCSV:
+		records, err = readCSV(data)
+	case model.DeliveryMetricSourceXLSX:
+		records, err = readXLSX(data)
+	default:
+		return nil, ErrUnsupportedSource
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrEmptyFile
+	}
+
+	return records, nil
+}
+
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	records, err := readDelimited(data, ',')
+	if err == nil && len(records) > 0 && len(records[0]) == 1 {
+		records, err = readDelimited(data, ';')
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv file: %w", err)
+	}
+	return records, nil
+}
+
+func readDelimited(data []byte, delimiter rune) ([][]string, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = delimiter
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+	return r.ReadAll()
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+// xlsxText is a text with either a single run or several formatted runs
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.T)
+	}
+	return b.String()
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R      string   `xml:"r,attr"`
+			T      string   `xml:"t,attr"`
+			V      string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// readXLSX reads the values of the first worksheet of a workbook. The dates are kept as the serial
+// numbers Excel stores them as, parseDate reads them.
+func readXLSX(data []byte) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid xlsx file: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	var shared xlsxSharedStrings
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeXML(f, &shared); err != nil {
+			return nil, fmt.Errorf("invalid xlsx shared strings: %w", err)
+		}
+	}
+
+	sheet, ok := files[firstSheetPath(files)]
+	if !ok {
+		return nil, errors.New("invalid xlsx file: no worksheet")
+	}
+	var ws xlsxWorksheet
+	if err := decodeXML(sheet, &ws); err != nil {
+		return nil, fmt.Errorf("invalid xlsx worksheet: %w", err)
+	}
+
+	records := make([][]string, 0, len(ws.Rows))
+	for _, row := range ws.Rows {
+		// the empty rows are not written, keep them so the line numbers don't shift
+		for row.R > len(records)+1 {
+			records = append(records, nil)
+		}
+
+		record := make([]string, 0, len(row.Cells))
+		for i, c := range row.Cells {
+			col := i
+			if c.R != "" {
+				col = columnIndex(c.R)
+			}
+			for col > len(record) {
+				record = append(record, "")
+			}
+
+			value := c.V
+			switch c.T {
+			case "s":
+				idx, err := strconv.Atoi(c.V)
+				if err != nil || idx < 0 || idx >= len(shared.Items) {
+					return nil, fmt.Errorf("invalid xlsx shared string index %q", c.V)
+				}
+				value = shared.Items[idx].String()
+			case "inlineStr":
+				value = c.Inline.String()
+			}
+			record = append(record, value)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// firstSheetPath finds the file of the first sheet of the workbook, whatever name the spreadsheet
+// application gave it
+func firstSheetPath(files map[string]*zip.File) string {
+	const fallback = "xl/worksheets/sheet1.xml"
+
+	var wb xlsxWorkbook
+	var rels xlsxRelationships
+	wf, ok := files["xl/workbook.xml"]
+	rf, relsOK := files["xl/_rels/workbook.xml.rels"]
+	if !ok || !relsOK || decodeXML(wf, &wb) != nil || decodeXML(rf, &rels) != nil || len(wb.Sheets) == 0 {
+		return fallback
+	}
+
+	for _, r := range rels.Relationships {
+		if r.ID != wb.Sheets[0].RID {
+			continue
+		}
+		if strings.HasPrefix(r.Target, "/") {
+			return strings.TrimPrefix(r.Target, "/")
+		}
+		return path.Join("xl", r.Target)
+	}
+	return fallback
+}
+
+func decodeXML(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(rc).Decode(v)
+}
+
+// columnIndex converts the column letters of a cell reference to a zero based index, e.g. C7 to 2
+func columnIndex(ref string) int {
+	idx := 0
+	for _, r := range strings.ToUpper(ref) {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		idx = idx*26 + int(r-'A'+1)
+	}
+	return idx - 1
+}
@@ -0,0 +1,117 @@
+// Package depreciation spreads the cost of a fixed asset over its useful life, straight-line,
+// one amount per month from the month it was purchased
+package depreciation
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidUsefulLife = errors.New("useful life must be positive")
+	ErrInvalidCost       = errors.New("cost must not be negative")
+	ErrInvalidSalvage    = errors.New("salvage value must be between zero and the cost")
+)
+
+// Asset is what the depreciation of an asset depends on, the amounts are in its currency
+type Asset struct {
+	Cost             int64
+	SalvageValue     int64
+	UsefulLifeMonths int
+	PurchaseDate     time.Time
+}
+
+func (a Asset) Validate() error {
+	if a.UsefulLifeMonths <= 0 {
+		return ErrInvalidUsefulLife
+	}
+	if a.Cost < 0 {
+		return ErrInvalidCost
+	}
+	if a.SalvageValue < 0 || a.SalvageValue > a.Cost {
+		return ErrInvalidSalvage
+	}
+	return nil
+}
+
+// Month is the depreciation of an asset for a month
+type Month struct {
+	Year        int
+	Month       time.Month
+	Amount      int64
+	Accumulated int64 // depreciation up to the end of the month
+	BookValue   int64 // cost less the accumulated depreciation
+}
+
+// For returns the depreciation of the asset for a month, false when the month is outside of
+// its useful life. The rounding is caught up on the last month so the asset ends at its
+// salvage value.
+func For(a Asset, year int, month time.Month) (Month, bool) {
+	if a.Validate() != nil {
+		return Month{}, false
+	}
+
+	index := monthIndex(a.PurchaseDate, year, month)
+	if index < 0 || index >= a.UsefulLifeMonths {
+		return Month{}, false
+	}
+
+	accumulated := accumulatedAt(a, index)
+	previous := int64(0)
+	if index > 0 {
+		previous = accumulatedAt(a, index-1)
+	}
+
+	return Month{
+		Year:        year,
+		Month:       month,
+		Amount:      accumulated - previous,
+		Accumulated: accumulated,
+		BookValue:   a.Cost - accumulated,
+	}, true
+}
+
+// Schedule returns the depreciation of every month of the useful life of the asset
+func Schedule(a Asset) []Month {
+	if a.Validate() != nil {
+		return nil
+	}
+
+	months := make([]Month, 0, a.UsefulLifeMonths)
+	start := time.Date(a.PurchaseDate.Year(), a.PurchaseDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < a.UsefulLifeMonths; i++ {
+		d := start.AddDate(0, i, 0)
+		m, _ := For(a, d.Year(), d.Month())
+		months = append(months, m)
+	}
+	return months
+}
+
+// BookValueAt is the value of the asset at the end of the month of date
+func BookValueAt(a Asset, date time.Time) int64 {
+	if a.Validate() != nil {
+		return a.Cost
+	}
+
+	index := monthIndex(a.PurchaseDate, date.Year(), date.Month())
+	switch {
+	case index < 0:
+		return a.Cost
+	case index >= a.UsefulLifeMonths:
+		return a.SalvageValue
+	}
+	return a.Cost - accumulatedAt(a, index)
+}
+
+// accumulatedAt is the depreciation up to the end of the index-th month of the useful life
+func accumulatedAt(a Asset, index int) int64 {
+	depreciable := a.Cost - a.SalvageValue
+	if index >= a.UsefulLifeMonths-1 {
+		return depreciable
+	}
+	return depreciable / int64(a.UsefulLifeMonths) * int64(index+1)
+}
+
+func monthIndex(purchase time.Time, year int, month time.Month) int {
+	return (year-purchase.Year())*12 + int(month) - int(purchase.Month())
+}
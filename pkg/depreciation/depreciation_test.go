@@ -0,0 +1,121 @@
+package depreciation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFor(t *testing.T) {
+	laptop := Asset{
+		Cost:             36_000_000,
+		SalvageValue:     1_000_000,
+		UsefulLifeMonths: 36,
+		PurchaseDate:     time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name   string
+		asset  Asset
+		year   int
+		month  time.Month
+		want   Month
+		wantOK bool
+	}{
+		{
+			name:   "before the purchase",
+			asset:  laptop,
+			year:   2026,
+			month:  time.February,
+			wantOK: false,
+		},
+		{
+			name:   "month of the purchase",
+			asset:  laptop,
+			year:   2026,
+			month:  time.March,
+			want:   Month{Year: 2026, Month: time.March, Amount: 972_222, Accumulated: 972_222, BookValue: 35_027_778},
+			wantOK: true,
+		},
+		{
+			name:   "next year",
+			asset:  laptop,
+			year:   2027,
+			month:  time.March,
+			want:   Month{Year: 2027, Month: time.March, Amount: 972_222, Accumulated: 12_638_886, BookValue: 23_361_114},
+			wantOK: true,
+		},
+		{
+			name:   "last month catches the rounding up",
+			asset:  laptop,
+			year:   2029,
+			month:  time.February,
+			want:   Month{Year: 2029, Month: time.February, Amount: 972_230, Accumulated: 35_000_000, BookValue: 1_000_000},
+			wantOK: true,
+		},
+		{
+			name:   "after the useful life",
+			asset:  laptop,
+			year:   2029,
+			month:  time.March,
+			wantOK: false,
+		},
+		{
+			name:   "not depreciated",
+			asset:  Asset{Cost: 1_000_000, PurchaseDate: laptop.PurchaseDate},
+			year:   2026,
+			month:  time.March,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := For(tt.asset, tt.year, tt.month)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	a := Asset{
+		Cost:             10_000_000,
+		SalvageValue:     0,
+		UsefulLifeMonths: 3,
+		PurchaseDate:     time.Date(2026, 11, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	months := Schedule(a)
+	require.Len(t, months, 3)
+
+	var total int64
+	for _, m := range months {
+		total += m.Amount
+	}
+	require.Equal(t, a.Cost, total)
+	require.Equal(t, time.January, months[2].Month)
+	require.Equal(t, 2027, months[2].Year)
+	require.Equal(t, int64(0), months[2].BookValue)
+}
+
+func TestBookValueAt(t *testing.T) {
+	a := Asset{
+		Cost:             12_000_000,
+		SalvageValue:     2_000_000,
+		UsefulLifeMonths: 10,
+		PurchaseDate:     time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	require.Equal(t, int64(12_000_000), BookValueAt(a, time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, int64(11_000_000), BookValueAt(a, time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, int64(2_000_000), BookValueAt(a, time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestValidate(t *testing.T) {
+	require.ErrorIs(t, Asset{Cost: 1}.Validate(), ErrInvalidUsefulLife)
+	require.ErrorIs(t, Asset{Cost: -1, UsefulLifeMonths: 1}.Validate(), ErrInvalidCost)
+	require.ErrorIs(t, Asset{Cost: 1, SalvageValue: 2, UsefulLifeMonths: 1}.Validate(), ErrInvalidSalvage)
+	require.NoError(t, Asset{Cost: 1, UsefulLifeMonths: 1}.Validate())
+}
@@ -0,0 +1,309 @@
+// Package engagementanalytics turns the cumulative Discord engagement rollups into daily activity
+// and the weekly participation, channel health and quiet member signals read from it
+package engagementanalytics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+const (
+	// discordEpoch is the first millisecond of 2015, the start of the Discord snowflakes
+	discordEpoch = 1420070400000
+
+	// DecliningRatio is how much of the activity of the weeks before a channel keeps over the
+	// recent weeks before it is declining
+	DecliningRatio = 0.5
+	// ConcentratedShare is the share of the messages of a channel from its most active member
+	// above which the channel hangs on that member
+	ConcentratedShare = 0.6
+	// ConcentratedMinMessages keeps the quiet channels out of the concentration check
+	ConcentratedMinMessages = 20
+)
+
+// Key is a Discord user in a channel, the key of the engagement rollups
+type Key struct {
+	DiscordUserID int64
+	ChannelID     int64
+}
+
+// Member is an employee with a Discord account and the chapters they belong to
+type Member struct {
+	EmployeeID    model.UUID
+	DiscordUserID int64
+	ChapterIDs    []model.UUID
+}
+
+// SnowflakeTime returns the time a Discord snowflake, like a message id, was created at
+func SnowflakeTime(id int64) time.Time {
+	return time.UnixMilli((id >> 22) + discordEpoch).UTC()
+}
+
+// WeekStart returns the Monday of the week of the day
+func WeekStart(t time.Time) time.Time {
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+}
+
+// Deltas returns the activity the rollups gained on the day over the activity seen so far. The
+// baseline records the rollups as they are.
+func Deltas(rollups []*model.EngagementsRollup, seen map[Key]model.EngagementActivity, date time.Time, baseline bool) []model.EngagementActivity {
+	rs := make([]model.EngagementActivity, 0)
+	for _, r := range rollups {
+		k := Key{DiscordUserID: r.DiscordUserID, ChannelID: r.ChannelID}
+		messages, reactions := r.MessageCount, r.ReactionCount
+		if !baseline {
+			messages -= seen[k].MessageCount
+			reactions -= seen[k].ReactionCount
+		}
+		// the rollups only grow, a drop is a reset which is not activity
+		if messages < 0 {
+			messages = 0
+		}
+		if reactions < 0 {
+			reactions = 0
+		}
+		if messages == 0 && reactions == 0 {
+			continue
+		}
+
+		rs = append(rs, model.EngagementActivity{
+			BaseModel:     model.BaseModel{ID: model.NewUUID()},
+			Date:          date,
+			DiscordUserID: r.DiscordUserID,
+			ChannelID:     r.ChannelID,
+			CategoryID:    r.CategoryID,
+			MessageCount:  messages,
+			ReactionCount: reactions,
+			IsBaseline:    baseline,
+		})
+	}
+	return rs
+}
+
+// LastActive returns the time of the last message of each Discord user in the rollups
+func LastActive(rollups []*model.EngagementsRollup) map[int64]time.Time {
+	rs := make(map[int64]time.Time)
+	for _, r := range rollups {
+		if r.LastMessageID <= 0 {
+			continue
+		}
+		t := SnowflakeTime(r.LastMessageID)
+		if t.After(rs[r.DiscordUserID]) {
+			rs[r.DiscordUserID] = t
+		}
+	}
+	return rs
+}
+
+// WeeklyStats returns the participation of the members over the week of the activities, of the
+// organization and of each chapter with members. Only the activity of members counts.
+func WeeklyStats(weekStart time.Time, members []Member, activities []model.EngagementActivity) []*model.EngagementWeeklyStat {
+	type counts struct{ messages, reactions int }
+	byUser := make(map[int64]*counts)
+	for _, a := range activities {
+		c, ok := byUser[a.DiscordUserID]
+		if !ok {
+			c = &counts{}
+			byUser[a.DiscordUserID] = c
+		}
+		c.messages += a.MessageCount
+		c.reactions += a.ReactionCount
+	}
+
+	org := &model.EngagementWeeklyStat{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		WeekStart: weekStart,
+		Scope:     model.EngagementScopeOrganization,
+	}
+	chapters := make(map[model.UUID]*model.EngagementWeeklyStat)
+	add := func(s *model.EngagementWeeklyStat, c *counts) {
+		s.Members++
+		if c == nil {
+			return
+		}
+		s.ActiveMembers++
+		s.MessageCount += c.messages
+		s.ReactionCount += c.reactions
+	}
+
+	for _, m := range members {
+		c := byUser[m.DiscordUserID]
+		add(org, c)
+		for _, id := range m.ChapterIDs {
+			s, ok := chapters[id]
+			if !ok {
+				chapterID := id
+				s = &model.EngagementWeeklyStat{
+					BaseModel: model.BaseModel{ID: model.NewUUID()},
+					WeekStart: weekStart,
+					Scope:     model.EngagementScopeChapter,
+					ChapterID: &chapterID,
+				}
+				chapters[id] = s
+			}
+			add(s, c)
+		}
+	}
+
+	rs := []*model.EngagementWeeklyStat{org}
+	for _, s := range chapters {
+		rs = append(rs, s)
+	}
+	sort.SliceStable(rs[1:], func(i, j int) bool {
+		return rs[1+i].ChapterID.String() < rs[1+j].ChapterID.String()
+	})
+	return rs
+}
+
+// ChannelStats returns the activity of each channel over the week of the activities, of every
+// Discord user in it
+func ChannelStats(weekStart time.Time, activities []model.EngagementActivity, names map[int64]string) []*model.EngagementChannelStat {
+	type channel struct {
+		stat  *model.EngagementChannelStat
+		users map[int64]int
+	}
+	byChannel := make(map[int64]*channel)
+	for _, a := range activities {
+		c, ok := byChannel[a.ChannelID]
+		if !ok {
+			c = &channel{
+				stat: &model.EngagementChannelStat{
+					BaseModel:   model.BaseModel{ID: model.NewUUID()},
+					WeekStart:   weekStart,
+					ChannelID:   a.ChannelID,
+					CategoryID:  a.CategoryID,
+					ChannelName: names[a.ChannelID],
+				},
+				users: make(map[int64]int),
+			}
+			byChannel[a.ChannelID] = c
+		}
+		c.stat.MessageCount += a.MessageCount
+		c.stat.ReactionCount += a.ReactionCount
+		c.users[a.DiscordUserID] += a.MessageCount
+	}
+
+	rs := make([]*model.EngagementChannelStat, 0, len(byChannel))
+	for _, c := range byChannel {
+		c.stat.ActiveMembers = len(c.users)
+		for _, messages := range c.users {
+			if messages > c.stat.TopMemberCount {
+				c.stat.TopMemberCount = messages
+			}
+		}
+		rs = append(rs, c.stat)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		return rs[i].ChannelID < rs[j].ChannelID
+	})
+	return rs
+}
+
+// Change returns the percent change from the previous count, empty from nothing
+func Change(current, previous int) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	c := float64(current-previous) * 100 / float64(previous)
+	return &c
+}
+
+// Trends returns the weekly stats of a scope by week, with their change week over week
+func Trends(stats []*model.EngagementWeeklyStat) []model.EngagementTrend {
+	sorted := make([]*model.EngagementWeeklyStat, len(stats))
+	copy(sorted, stats)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].WeekStart.Before(sorted[j].WeekStart)
+	})
+
+	rs := make([]model.EngagementTrend, 0, len(sorted))
+	for i, s := range sorted {
+		t := model.EngagementTrend{
+			EngagementWeeklyStat: *s,
+			ParticipationRate:    s.ParticipationRate(),
+		}
+		if i > 0 && sorted[i-1].WeekStart.Equal(s.WeekStart.AddDate(0, 0, -7)) {
+			t.MessageChange = Change(s.MessageCount, sorted[i-1].MessageCount)
+			t.ActiveMembersChange = Change(s.ActiveMembers, sorted[i-1].ActiveMembers)
+		}
+		rs = append(rs, t)
+	}
+	return rs
+}
+
+// ChannelHealth compares the activity of each channel over the weeks from recentFrom with the
+// weeks before, the channels in most need of attention first
+func ChannelHealth(stats []*model.EngagementChannelStat, recentFrom time.Time) []model.EngagementChannelHealth {
+	type channel struct {
+		health    model.EngagementChannelHealth
+		topCounts int
+	}
+	byChannel := make(map[int64]*channel)
+	order := make([]int64, 0)
+	for _, s := range stats {
+		c, ok := byChannel[s.ChannelID]
+		if !ok {
+			c = &channel{health: model.EngagementChannelHealth{ChannelID: s.ChannelID}}
+			byChannel[s.ChannelID] = c
+			order = append(order, s.ChannelID)
+		}
+		h := &c.health
+		h.CategoryID = s.CategoryID
+		if s.ChannelName != "" {
+			h.ChannelName = s.ChannelName
+		}
+		if s.MessageCount+s.ReactionCount > 0 && (h.LastActiveWeek == nil || s.WeekStart.After(*h.LastActiveWeek)) {
+			week := s.WeekStart
+			h.LastActiveWeek = &week
+		}
+
+		if s.WeekStart.Before(recentFrom) {
+			h.PreviousMessages += s.MessageCount
+			continue
+		}
+		h.MessageCount += s.MessageCount
+		c.topCounts += s.TopMemberCount
+		if s.ActiveMembers > h.ActiveMembers {
+			h.ActiveMembers = s.ActiveMembers
+		}
+	}
+
+	rs := make([]model.EngagementChannelHealth, 0, len(order))
+	for _, id := range order {
+		c := byChannel[id]
+		h := c.health
+		h.MessageChange = Change(h.MessageCount, h.PreviousMessages)
+		if h.MessageCount > 0 {
+			h.TopMemberShare = float64(c.topCounts) / float64(h.MessageCount)
+		}
+
+		switch {
+		case h.MessageCount == 0:
+			h.Status = model.EngagementChannelStatusDormant
+		case h.PreviousMessages > 0 && float64(h.MessageCount) < float64(h.PreviousMessages)*DecliningRatio:
+			h.Status = model.EngagementChannelStatusDeclining
+		case h.MessageCount >= ConcentratedMinMessages && h.TopMemberShare > ConcentratedShare:
+			h.Status = model.EngagementChannelStatusConcentrated
+		default:
+			h.Status = model.EngagementChannelStatusHealthy
+		}
+		rs = append(rs, h)
+	}
+
+	severity := map[model.EngagementChannelStatus]int{
+		model.EngagementChannelStatusDormant:      0,
+		model.EngagementChannelStatusDeclining:    1,
+		model.EngagementChannelStatusConcentrated: 2,
+		model.EngagementChannelStatusHealthy:      3,
+	}
+	sort.SliceStable(rs, func(i, j int) bool {
+		if severity[rs[i].Status] != severity[rs[j].Status] {
+			return severity[rs[i].Status] < severity[rs[j].Status]
+		}
+		return rs[i].PreviousMessages+rs[i].MessageCount > rs[j].PreviousMessages+rs[j].MessageCount
+	})
+	return rs
+}
@@ -0,0 +1,153 @@
+package engagementanalytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func TestSnowflakeTime(t *testing.T) {
+	// the example of the Discord docs
+	require.Equal(t, time.Date(2016, 4, 30, 11, 18, 25, 796000000, time.UTC), SnowflakeTime(175928847299117063))
+}
+
+func TestWeekStart(t *testing.T) {
+	require.Equal(t, date("2026-10-12"), WeekStart(date("2026-10-12")))
+	require.Equal(t, date("2026-10-12"), WeekStart(date("2026-10-18").Add(23*time.Hour)))
+	require.Equal(t, date("2026-10-19"), WeekStart(date("2026-10-19")))
+}
+
+func TestDeltas(t *testing.T) {
+	rollups := []*model.EngagementsRollup{
+		{DiscordUserID: 1, ChannelID: 10, MessageCount: 5, ReactionCount: 2},
+		{DiscordUserID: 1, ChannelID: 11, MessageCount: 3},
+		{DiscordUserID: 2, ChannelID: 10, MessageCount: 1},
+	}
+	day := date("2026-10-19")
+
+	baseline := Deltas(rollups, nil, day, true)
+	require.Len(t, baseline, 3)
+	require.True(t, baseline[0].IsBaseline)
+
+	seen := map[Key]model.EngagementActivity{
+		{DiscordUserID: 1, ChannelID: 10}: {MessageCount: 4, ReactionCount: 2},
+		{DiscordUserID: 1, ChannelID: 11}: {MessageCount: 3},
+		{DiscordUserID: 2, ChannelID: 10}: {MessageCount: 4},
+	}
+	rs := Deltas(rollups, seen, day, false)
+	require.Len(t, rs, 1)
+	require.Equal(t, int64(1), rs[0].DiscordUserID)
+	require.Equal(t, int64(10), rs[0].ChannelID)
+	require.Equal(t, 1, rs[0].MessageCount)
+	require.Equal(t, 0, rs[0].ReactionCount)
+	require.False(t, rs[0].IsBaseline)
+}
+
+func TestLastActive(t *testing.T) {
+	rs := LastActive([]*model.EngagementsRollup{
+		{DiscordUserID: 1, LastMessageID: 175928847299117063},
+		{DiscordUserID: 1, LastMessageID: 175928847299117063 + 1<<22*1000},
+		{DiscordUserID: 2},
+	})
+	require.Len(t, rs, 1)
+	require.Equal(t, SnowflakeTime(175928847299117063).Add(time.Second), rs[1])
+}
+
+func TestWeeklyStats(t *testing.T) {
+	chapter := model.NewUUID()
+	members := []Member{
+		{EmployeeID: model.NewUUID(), DiscordUserID: 1, ChapterIDs: []model.UUID{chapter}},
+		{EmployeeID: model.NewUUID(), DiscordUserID: 2, ChapterIDs: []model.UUID{chapter}},
+		{EmployeeID: model.NewUUID(), DiscordUserID: 3},
+	}
+	activities := []model.EngagementActivity{
+		{DiscordUserID: 1, ChannelID: 10, MessageCount: 4},
+		{DiscordUserID: 1, ChannelID: 11, ReactionCount: 2},
+		{DiscordUserID: 3, ChannelID: 10, MessageCount: 1},
+		{DiscordUserID: 99, ChannelID: 10, MessageCount: 50},
+	}
+
+	rs := WeeklyStats(date("2026-10-12"), members, activities)
+	require.Len(t, rs, 2)
+
+	org := rs[0]
+	require.Equal(t, model.EngagementScopeOrganization, org.Scope)
+	require.Nil(t, org.ChapterID)
+	require.Equal(t, 3, org.Members)
+	require.Equal(t, 2, org.ActiveMembers)
+	require.Equal(t, 5, org.MessageCount)
+	require.Equal(t, 2, org.ReactionCount)
+
+	ch := rs[1]
+	require.Equal(t, model.EngagementScopeChapter, ch.Scope)
+	require.Equal(t, chapter, *ch.ChapterID)
+	require.Equal(t, 2, ch.Members)
+	require.Equal(t, 1, ch.ActiveMembers)
+	require.Equal(t, 50.0, ch.ParticipationRate())
+}
+
+func TestChannelStats(t *testing.T) {
+	rs := ChannelStats(date("2026-10-12"), []model.EngagementActivity{
+		{DiscordUserID: 1, ChannelID: 10, MessageCount: 4, ReactionCount: 1},
+		{DiscordUserID: 2, ChannelID: 10, MessageCount: 6},
+		{DiscordUserID: 1, ChannelID: 11, ReactionCount: 3},
+	}, map[int64]string{10: "general"})
+	require.Len(t, rs, 2)
+	require.Equal(t, "general", rs[0].ChannelName)
+	require.Equal(t, 10, rs[0].MessageCount)
+	require.Equal(t, 1, rs[0].ReactionCount)
+	require.Equal(t, 2, rs[0].ActiveMembers)
+	require.Equal(t, 6, rs[0].TopMemberCount)
+	require.Equal(t, 1, rs[1].ActiveMembers)
+}
+
+func TestTrends(t *testing.T) {
+	rs := Trends([]*model.EngagementWeeklyStat{
+		{WeekStart: date("2026-10-12"), Members: 10, ActiveMembers: 6, MessageCount: 150},
+		{WeekStart: date("2026-10-05"), Members: 10, ActiveMembers: 8, MessageCount: 100},
+		{WeekStart: date("2026-09-21"), Members: 10, ActiveMembers: 5, MessageCount: 80},
+	})
+	require.Len(t, rs, 3)
+	require.Equal(t, date("2026-09-21"), rs[0].WeekStart)
+	require.Nil(t, rs[0].MessageChange)
+	// a week is missing in between
+	require.Nil(t, rs[1].MessageChange)
+	require.Equal(t, 50.0, *rs[2].MessageChange)
+	require.Equal(t, -25.0, *rs[2].ActiveMembersChange)
+	require.Equal(t, 60.0, rs[2].ParticipationRate)
+}
+
+func TestChannelHealth(t *testing.T) {
+	recent := date("2026-10-12")
+	previous := date("2026-10-05")
+	rs := ChannelHealth([]*model.EngagementChannelStat{
+		{WeekStart: previous, ChannelID: 1, MessageCount: 30, ActiveMembers: 5},
+		{WeekStart: recent, ChannelID: 1, MessageCount: 28, ActiveMembers: 6, TopMemberCount: 5},
+		{WeekStart: previous, ChannelID: 2, MessageCount: 40},
+		{WeekStart: recent, ChannelID: 2, MessageCount: 10, TopMemberCount: 2},
+		{WeekStart: previous, ChannelID: 3, MessageCount: 5},
+		{WeekStart: recent, ChannelID: 4, MessageCount: 30, TopMemberCount: 25},
+	}, recent)
+	require.Len(t, rs, 4)
+
+	status := map[int64]model.EngagementChannelStatus{}
+	for _, h := range rs {
+		status[h.ChannelID] = h.Status
+	}
+	require.Equal(t, model.EngagementChannelStatusHealthy, status[1])
+	require.Equal(t, model.EngagementChannelStatusDeclining, status[2])
+	require.Equal(t, model.EngagementChannelStatusDormant, status[3])
+	require.Equal(t, model.EngagementChannelStatusConcentrated, status[4])
+
+	require.Equal(t, int64(3), rs[0].ChannelID)
+	require.Equal(t, previous, *rs[0].LastActiveWeek)
+	require.Equal(t, -75.0, *rs[1].MessageChange)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/dwarvesf/fortress-api/pkg/extrapayment"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
@@ -30,6 +31,10 @@ func (m *mockWiseService) GetRate(source, target string) (float64, error) {
 	return m.rate, m.err
 }
 
+func (m *mockWiseService) GetRateAt(source, target string, at time.Time) (float64, error) {
+	return m.rate, m.err
+}
+
 func TestResolveAmountUSD(t *testing.T) {
 	ctx := context.Background()
 
@@ -1,6 +1,15 @@
 package errs
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/asset"
+	"github.com/dwarvesf/fortress-api/pkg/depreciation"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
 
 var (
 	ErrEmployeeNotFound     = errors.New("employee not found")
@@ -9,4 +18,47 @@ var (
 	ErrInvalidFileType      = errors.New("invalid file type")
 	ErrInvalidFileSize      = errors.New("invalid file size")
 	ErrFileAlreadyExisted   = errors.New("file already existed")
+	ErrInvalidAssetID       = errors.New("invalid asset id")
+	ErrInvalidEmployeeID    = errors.New("invalid employee id")
+	ErrInvalidExpenseID     = errors.New("invalid expense id")
+	ErrInvalidCategory      = errors.New("invalid asset category")
+	ErrInvalidStatus        = errors.New("invalid asset status")
+	ErrInvalidDate          = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidMonth         = errors.New("invalid month, expected YYYY-MM")
 )
+
+// ConvertControllerErr writes the status of an asset controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, asset.ErrAssetNotFound),
+		errors.Is(err, asset.ErrCurrencyNotFound),
+		errors.Is(err, asset.ErrExpenseNotFound),
+		errors.Is(err, asset.ErrEmployeeNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, asset.ErrSerialNumberExisted),
+		errors.Is(err, asset.ErrInvalidCategory),
+		errors.Is(err, asset.ErrInvalidStatus),
+		errors.Is(err, asset.ErrMissingPurchaseDate),
+		errors.Is(err, asset.ErrAssetNotAvailable),
+		errors.Is(err, asset.ErrAssetNotAssigned),
+		errors.Is(err, asset.ErrInvalidReturnStatus),
+		errors.Is(err, asset.ErrStatusSetByAssignment),
+		errors.Is(err, asset.ErrInvalidPeriod),
+		errors.Is(err, depreciation.ErrInvalidUsefulLife),
+		errors.Is(err, depreciation.ErrInvalidCost),
+		errors.Is(err, depreciation.ErrInvalidSalvage):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -3,5 +3,12 @@ package asset
 import "github.com/gin-gonic/gin"
 
 type IHandler interface {
+	Assign(c *gin.Context)
+	Create(c *gin.Context)
+	Depreciate(c *gin.Context)
+	Get(c *gin.Context)
+	List(c *gin.Context)
+	Return(c *gin.Context)
+	Update(c *gin.Context)
 	Upload(c *gin.Context)
 }
@@ -0,0 +1,396 @@
+package asset
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	ctrlasset "github.com/dwarvesf/fortress-api/pkg/controller/asset"
+	"github.com/dwarvesf/fortress-api/pkg/handler/asset/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/asset/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// List godoc
+// @Summary Get the company assets
+// @Description Get the asset register, latest first
+// @id getListAssets
+// @Tags Asset
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param category query string false "laptop, monitor, phone, accessory, furniture or other"
+// @Param status query string false "in-stock, assigned, in-repair, retired or lost"
+// @Param holderID query string false "Employee holding the assets"
+// @Param keyword query string false "Name or serial number"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} AssetsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /assets [get]
+func (h *handler) List(c *gin.Context) {
+	query := request.ListAssetsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "asset",
+		"method":  "List",
+		"query":   query,
+	})
+
+	assets, total, err := h.controller.Asset.List(ctrlasset.ListInput{
+		Category: model.AssetCategory(query.Category),
+		Status:   model.AssetStatus(query.Status),
+		HolderID: query.HolderID,
+		Keyword:  query.Keyword,
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list assets")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAssets(assets),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// Get godoc
+// @Summary Get an asset
+// @Description Get an asset with its assignment history and its booked depreciation
+// @id getAssetByID
+// @Tags Asset
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Success 200 {object} AssetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /assets/{id} [get]
+func (h *handler) Get(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidAssetID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "asset",
+		"method":  "Get",
+		"id":      id,
+	})
+
+	asset, err := h.controller.Asset.Get(id)
+	if err != nil {
+		l.Error(err, "failed to get asset")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAssetDetail(asset), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Register an asset
+// @Description Add an asset to the register, with its cost and the useful life it is depreciated over
+// @id createAsset
+// @Tags Asset
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body AssetRequest true "Body"
+// @Success 200 {object} AssetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /assets [post]
+func (h *handler) Create(c *gin.Context) {
+	input := request.AssetRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "asset",
+		"method":  "Create",
+		"request": input,
+	})
+
+	asset, err := h.controller.Asset.Create(toAssetInput(input))
+	if err != nil {
+		l.Error(err, "failed to create asset")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAssetDetail(asset), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update an asset
+// @Description Update the details of an asset, its holder is changed by assigning and returning it
+// @id updateAsset
+// @Tags Asset
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param Body body AssetRequest true "Body"
+// @Success 200 {object} AssetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /assets/{id} [put]
+func (h *handler) Update(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidAssetID, nil, ""))
+		return
+	}
+
+	input := request.AssetRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "asset",
+		"method":  "Update",
+		"id":      id,
+		"request": input,
+	})
+
+	asset, err := h.controller.Asset.Update(id, toAssetInput(input))
+	if err != nil {
+		l.Error(err, "failed to update asset")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAssetDetail(asset), nil, nil, nil, ""))
+}
+
+// Assign godoc
+// @Summary Assign an asset
+// @Description Hand an asset in stock over to an employee, with the condition it is in
+// @id assignAsset
+// @Tags Asset
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param Body body AssignAssetRequest true "Body"
+// @Success 200 {object} AssetAssignmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /assets/{id}/assign [post]
+func (h *handler) Assign(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidAssetID, nil, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.AssignRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "asset",
+		"method":  "Assign",
+		"id":      id,
+		"request": input,
+	})
+
+	employeeID, _ := model.UUIDFromString(input.EmployeeID)
+	assignedAt, _ := timeutil.ParseOptionalDate(input.AssignedAt)
+	assignment, err := h.controller.Asset.Assign(id, ctrlasset.AssignInput{
+		EmployeeID: employeeID,
+		AssignedAt: assignedAt,
+		Condition:  input.Condition,
+		Note:       input.Note,
+		ActorID:    toUUIDPtr(userID),
+	})
+	if err != nil {
+		l.Error(err, "failed to assign asset")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAssetAssignment(assignment), nil, nil, nil, ""))
+}
+
+// Return godoc
+// @Summary Return an asset
+// @Description Take an asset back from its holder, with the condition it is returned in
+// @id returnAsset
+// @Tags Asset
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Asset ID"
+// @Param Body body ReturnAssetRequest true "Body"
+// @Success 200 {object} AssetAssignmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /assets/{id}/return [post]
+func (h *handler) Return(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidAssetID, nil, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.ReturnRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "asset",
+		"method":  "Return",
+		"id":      id,
+		"request": input,
+	})
+
+	returnedAt, _ := timeutil.ParseOptionalDate(input.ReturnedAt)
+	assignment, err := h.controller.Asset.Return(id, ctrlasset.ReturnInput{
+		ReturnedAt: returnedAt,
+		Condition:  input.Condition,
+		Status:     model.AssetStatus(input.Status),
+		Note:       input.Note,
+		ActorID:    toUUIDPtr(userID),
+	})
+	if err != nil {
+		l.Error(err, "failed to return asset")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAssetAssignment(assignment), nil, nil, nil, ""))
+}
+
+// Depreciate godoc
+// @Summary Book the monthly depreciation of the assets
+// @Description Book the depreciation of a month of every asset in its useful life as an accounting transaction of the Assets category and a ledger journal entry against the accumulated depreciation, the months already booked are skipped
+// @id depreciateAssets
+// @Tags Asset
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body DepreciateAssetsRequest false "Body"
+// @Success 200 {object} AssetDepreciationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/asset-depreciation [post]
+func (h *handler) Depreciate(c *gin.Context) {
+	input := request.DepreciateRequest{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+	year, month, err := input.Period(time.Now())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "asset",
+		"method":  "Depreciate",
+		"year":    year,
+		"month":   month,
+	})
+
+	depreciations, err := h.controller.Asset.Depreciate(year, month)
+	if err != nil {
+		l.Error(err, "failed to depreciate assets")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	rs := make([]model.AssetDepreciation, 0, len(depreciations))
+	for _, d := range depreciations {
+		rs = append(rs, *d)
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToAssetDepreciations(rs), nil, nil, nil, ""))
+}
+
+func toAssetInput(r request.AssetRequest) ctrlasset.AssetInput {
+	purchaseDate, _ := timeutil.ParseOptionalDate(r.PurchaseDate)
+	return ctrlasset.AssetInput{
+		Name:             r.Name,
+		SerialNumber:     r.SerialNumber,
+		Category:         model.AssetCategory(r.Category),
+		Status:           model.AssetStatus(r.Status),
+		Cost:             r.Cost,
+		Currency:         r.Currency,
+		PurchaseDate:     purchaseDate,
+		UsefulLifeMonths: r.UsefulLifeMonths,
+		SalvageValue:     r.SalvageValue,
+		ExpenseID:        toUUIDPtr(r.ExpenseID),
+		Location:         r.Location,
+		Note:             r.Note,
+	}
+}
+
+func toUUIDPtr(s string) *model.UUID {
+	id, err := model.UUIDFromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,115 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/asset/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+const monthLayout = "2006-01"
+
+type ListAssetsQuery struct {
+	model.Pagination
+
+	Category string `form:"category" json:"category"`
+	Status   string `form:"status" json:"status"`
+	HolderID string `form:"holderID" json:"holderID"` // the employee holding the assets
+	Keyword  string `form:"keyword" json:"keyword"`   // matches the name or the serial number
+} // @name ListAssetsQuery
+
+func (q *ListAssetsQuery) Validate() error {
+	if q.Category != "" && !model.AssetCategory(q.Category).IsValid() {
+		return errs.ErrInvalidCategory
+	}
+	if q.Status != "" && !model.AssetStatus(q.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	if q.HolderID != "" && !model.IsUUIDFromString(q.HolderID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	return nil
+}
+
+type AssetRequest struct {
+	Name             string `json:"name" binding:"required,max=200"`
+	SerialNumber     string `json:"serialNumber"`
+	Category         string `json:"category" binding:"required"`
+	Status           string `json:"status"` // in-stock on creation when empty, unchanged on update
+	Cost             int64  `json:"cost" binding:"gte=0"`
+	Currency         string `json:"currency"`     // VND when empty
+	PurchaseDate     string `json:"purchaseDate"` // YYYY-MM-DD, required to depreciate the asset
+	UsefulLifeMonths int    `json:"usefulLifeMonths" binding:"gte=0"`
+	SalvageValue     int64  `json:"salvageValue" binding:"gte=0"`
+	ExpenseID        string `json:"expenseID"` // the expense the asset was bought with
+	Location         string `json:"location"`
+	Note             string `json:"note"`
+} // @name AssetRequest
+
+func (r *AssetRequest) Validate() error {
+	if !model.AssetCategory(r.Category).IsValid() {
+		return errs.ErrInvalidCategory
+	}
+	if r.Status != "" && !model.AssetStatus(r.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	if r.ExpenseID != "" && !model.IsUUIDFromString(r.ExpenseID) {
+		return errs.ErrInvalidExpenseID
+	}
+	if _, err := timeutil.ParseOptionalDate(r.PurchaseDate); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type AssignRequest struct {
+	EmployeeID string `json:"employeeID" binding:"required"`
+	AssignedAt string `json:"assignedAt"` // YYYY-MM-DD, today when empty
+	Condition  string `json:"condition"`  // the condition the asset is handed over in
+	Note       string `json:"note"`
+} // @name AssignAssetRequest
+
+func (r *AssignRequest) Validate() error {
+	if !model.IsUUIDFromString(r.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if _, err := timeutil.ParseOptionalDate(r.AssignedAt); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type ReturnRequest struct {
+	ReturnedAt string `json:"returnedAt"` // YYYY-MM-DD, today when empty
+	Condition  string `json:"condition"`  // the condition the asset is returned in
+	Status     string `json:"status"`     // in-stock, in-repair or lost, in-stock when empty
+	Note       string `json:"note"`
+} // @name ReturnAssetRequest
+
+func (r *ReturnRequest) Validate() error {
+	if r.Status != "" && !model.AssetStatus(r.Status).IsReturnable() {
+		return errs.ErrInvalidStatus
+	}
+	if _, err := timeutil.ParseOptionalDate(r.ReturnedAt); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type DepreciateRequest struct {
+	Month string `json:"month"` // YYYY-MM, the previous month when empty
+} // @name DepreciateAssetsRequest
+
+// Period returns the year and the month to depreciate
+func (r *DepreciateRequest) Period(now time.Time) (int, int, error) {
+	if r.Month == "" {
+		prev := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+		return prev.Year(), int(prev.Month()), nil
+	}
+	d, err := time.Parse(monthLayout, r.Month)
+	if err != nil {
+		return 0, 0, errs.ErrInvalidMonth
+	}
+	return d.Year(), int(d.Month()), nil
+}
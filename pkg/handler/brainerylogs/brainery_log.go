@@ -358,12 +358,16 @@ func (h *handler) Sync(c *gin.Context) {
 		return
 	}
 
-	_, err = h.store.BraineryLog.Create(h.repo.DB(), braineryLogs)
+	braineryLogs, err = h.store.BraineryLog.Create(h.repo.DB(), braineryLogs)
 	if err != nil {
 		l.Errorf(err, "failed to create brainery logs")
 		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
 		return
 	}
 
+	if err := h.controller.KnowledgeBase.IndexBraineryLogs(braineryLogs); err != nil {
+		l.Errorf(err, "failed to index brainery logs")
+	}
+
 	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
 }
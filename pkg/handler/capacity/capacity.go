@@ -0,0 +1,160 @@
+package capacity
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlcapacity "github.com/dwarvesf/fortress-api/pkg/controller/capacity"
+	"github.com/dwarvesf/fortress-api/pkg/handler/capacity/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/capacity/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// GetPlan godoc
+// @Summary Get the capacity plan
+// @Description Project the weekly allocation of the employees, per employee, stack and seniority, from their deployments, their approved leave and the slots of the running projects, with the upcoming bench and the unstaffed slots
+// @id getCapacityPlan
+// @Tags Dashboard
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param from query string false "YYYY-MM-DD, the plan starts on its week, today when empty"
+// @Param weeks query int false "Number of weeks, 12 when empty"
+// @Success 200 {object} CapacityPlanResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /dashboards/resources/capacity-plan [get]
+func (h *handler) GetPlan(c *gin.Context) {
+	query := request.PlanQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "capacity",
+		"method":  "GetPlan",
+		"query":   query,
+	})
+
+	plan, err := h.controller.Capacity.Plan(ctrlcapacity.PlanInput{
+		From:  fromOrToday(query.From),
+		Weeks: query.Weeks,
+	})
+	if err != nil {
+		l.Error(err, "failed to plan capacity")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCapacityPlan(plan), nil, nil, nil, ""))
+}
+
+// SimulatePlan godoc
+// @Summary Simulate the capacity plan
+// @Description Project the capacity plan with what-if deployments and end date changes, nothing is committed
+// @id simulateCapacityPlan
+// @Tags Dashboard
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body SimulateCapacityPlanRequest true "Body"
+// @Success 200 {object} CapacityPlanResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /dashboards/resources/capacity-plan/what-if [post]
+func (h *handler) SimulatePlan(c *gin.Context) {
+	input := request.SimulatePlanRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "capacity",
+		"method":  "SimulatePlan",
+		"request": input,
+	})
+
+	planInput := ctrlcapacity.PlanInput{
+		From:           fromOrToday(input.From),
+		Weeks:          input.Weeks,
+		WhatIf:         make([]ctrlcapacity.WhatIfDeployment, 0, len(input.WhatIf)),
+		EndDateChanges: make([]ctrlcapacity.EndDateChange, 0, len(input.EndDateChanges)),
+	}
+	for _, w := range input.WhatIf {
+		startDate, _ := timeutil.ParseOptionalDate(w.StartDate)
+		endDate, _ := timeutil.ParseOptionalDate(w.EndDate)
+		planInput.WhatIf = append(planInput.WhatIf, ctrlcapacity.WhatIfDeployment{
+			EmployeeID:     w.EmployeeID,
+			SlotID:         w.SlotID,
+			ProjectID:      w.ProjectID,
+			DeploymentType: model.DeploymentType(w.DeploymentType),
+			StartDate:      startDate,
+			EndDate:        endDate,
+		})
+	}
+	for _, d := range input.EndDateChanges {
+		endDate, _ := timeutil.ParseOptionalDate(d.EndDate)
+		planInput.EndDateChanges = append(planInput.EndDateChanges, ctrlcapacity.EndDateChange{
+			ProjectMemberID: d.ProjectMemberID,
+			EndDate:         endDate,
+		})
+	}
+
+	plan, err := h.controller.Capacity.Plan(planInput)
+	if err != nil {
+		l.Error(err, "failed to simulate capacity plan")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCapacityPlan(plan), nil, nil, nil, ""))
+}
+
+func fromOrToday(s string) time.Time {
+	from, _ := timeutil.ParseOptionalDate(s)
+	if from == nil {
+		return time.Now()
+	}
+	return *from
+}
@@ -0,0 +1,47 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/capacity"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidDate            = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidEmployeeID      = errors.New("invalid employee id")
+	ErrInvalidSlotID          = errors.New("invalid slot id")
+	ErrInvalidProjectID       = errors.New("invalid project id")
+	ErrInvalidProjectMemberID = errors.New("invalid project member id")
+	ErrMissingProject         = errors.New("a what-if deployment needs a slot or a project")
+	ErrInvalidDeploymentType  = errors.New("invalid deployment type")
+)
+
+// ConvertControllerErr writes the status of a capacity controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, capacity.ErrEmployeeNotFound),
+		errors.Is(err, capacity.ErrSlotNotFound),
+		errors.Is(err, capacity.ErrDeploymentNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, capacity.ErrInvalidWeeks),
+		errors.Is(err, capacity.ErrInvalidDeploymentType),
+		errors.Is(err, capacity.ErrInvalidDateRange):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,8 @@
+package capacity
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	GetPlan(c *gin.Context)
+	SimulatePlan(c *gin.Context)
+}
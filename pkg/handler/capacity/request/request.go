@@ -0,0 +1,77 @@
+package request
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/capacity/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+type PlanQuery struct {
+	From  string `form:"from" json:"from"`   // YYYY-MM-DD, today when empty
+	Weeks int    `form:"weeks" json:"weeks"` // 12 when empty, 26 at most
+} // @name CapacityPlanQuery
+
+func (q *PlanQuery) Validate() error {
+	if _, err := timeutil.ParseOptionalDate(q.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type WhatIfDeploymentRequest struct {
+	EmployeeID     string `json:"employeeID" binding:"required"`
+	SlotID         string `json:"slotID"`         // the slot staffed, or
+	ProjectID      string `json:"projectID"`      // the project deployed on
+	DeploymentType string `json:"deploymentType"` // the type of the slot, or official, when empty
+	StartDate      string `json:"startDate"`      // YYYY-MM-DD
+	EndDate        string `json:"endDate"`        // YYYY-MM-DD
+} // @name WhatIfDeploymentRequest
+
+type EndDateChangeRequest struct {
+	ProjectMemberID string `json:"projectMemberID" binding:"required"`
+	EndDate         string `json:"endDate"` // YYYY-MM-DD, open-ended when empty
+} // @name EndDateChangeRequest
+
+type SimulatePlanRequest struct {
+	From           string                    `json:"from"`  // YYYY-MM-DD, today when empty
+	Weeks          int                       `json:"weeks"` // 12 when empty, 26 at most
+	WhatIf         []WhatIfDeploymentRequest `json:"whatIf" binding:"dive"`
+	EndDateChanges []EndDateChangeRequest    `json:"endDateChanges" binding:"dive"`
+} // @name SimulateCapacityPlanRequest
+
+func (r *SimulatePlanRequest) Validate() error {
+	if _, err := timeutil.ParseOptionalDate(r.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	for _, w := range r.WhatIf {
+		if !model.IsUUIDFromString(w.EmployeeID) {
+			return errs.ErrInvalidEmployeeID
+		}
+		if w.SlotID == "" && w.ProjectID == "" {
+			return errs.ErrMissingProject
+		}
+		if w.SlotID != "" && !model.IsUUIDFromString(w.SlotID) {
+			return errs.ErrInvalidSlotID
+		}
+		if w.ProjectID != "" && !model.IsUUIDFromString(w.ProjectID) {
+			return errs.ErrInvalidProjectID
+		}
+		if w.DeploymentType != "" && !model.DeploymentType(w.DeploymentType).IsValid() {
+			return errs.ErrInvalidDeploymentType
+		}
+		for _, d := range []string{w.StartDate, w.EndDate} {
+			if _, err := timeutil.ParseOptionalDate(d); err != nil {
+				return errs.ErrInvalidDate
+			}
+		}
+	}
+	for _, c := range r.EndDateChanges {
+		if !model.IsUUIDFromString(c.ProjectMemberID) {
+			return errs.ErrInvalidProjectMemberID
+		}
+		if _, err := timeutil.ParseOptionalDate(c.EndDate); err != nil {
+			return errs.ErrInvalidDate
+		}
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+package cashflow
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlcashflow "github.com/dwarvesf/fortress-api/pkg/controller/cashflow"
+	"github.com/dwarvesf/fortress-api/pkg/handler/cashflow/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/cashflow/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// Forecast godoc
+// @Summary Get the cash-flow forecast
+// @Description Get the rolling 13-week or 12-month cash-flow forecast from the open invoices, the recurring project revenue, the payroll, the contractor rates and the operational services, with optional what-if scenario
+// @id getCashFlowForecast
+// @Tags Cash Flow
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param horizon query string false "weekly (13 weeks) or monthly (12 months), weekly by default"
+// @Param date query string false "YYYY-MM-DD, the forecast starts with the period containing it, today by default"
+// @Param churnClientIDs query string false "Comma separated IDs of the clients churning"
+// @Param churnDate query string false "YYYY-MM-DD, the date the clients churn from"
+// @Param newHires query int false "Number of new hires"
+// @Param newHireMonthlyCost query number false "Monthly cost of a new hire, in VND"
+// @Param newHireStartDate query string false "YYYY-MM-DD, the date the new hires start"
+// @Param fxShiftPercent query number false "Shift of the foreign currencies against the VND, in percent"
+// @Param openingBalance query number false "Cash at the start of the forecast, in VND"
+// @Success 200 {object} CashFlowForecastResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cash-flow/forecast [get]
+func (h *handler) Forecast(c *gin.Context) {
+	query := request.ForecastRequest{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "cashflow",
+		"method":  "Forecast",
+		"query":   query,
+	})
+
+	report, err := h.controller.CashFlow.Forecast(toForecastInput(query))
+	if err != nil {
+		if errors.Is(err, ctrlcashflow.ErrInvalidHorizon) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+			return
+		}
+
+		l.Error(err, "failed to forecast cash flow")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCashFlowForecast(nil, report), nil, nil, nil, ""))
+}
+
+// SaveForecast godoc
+// @Summary Save a cash-flow forecast
+// @Description Compute a cash-flow forecast and keep it to compare it with the actuals later
+// @id saveCashFlowForecast
+// @Tags Cash Flow
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body ForecastRequest false "Body"
+// @Success 200 {object} CashFlowForecastResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cash-flow/forecasts [post]
+func (h *handler) SaveForecast(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.ForecastRequest{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "cashflow",
+		"method":  "SaveForecast",
+		"request": input,
+	})
+
+	forecast, report, err := h.controller.CashFlow.SaveForecast(toForecastInput(input), toUUIDPtr(userID))
+	if err != nil {
+		if errors.Is(err, ctrlcashflow.ErrInvalidHorizon) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+
+		l.Error(err, "failed to save cash-flow forecast")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCashFlowForecast(forecast, report), nil, nil, nil, ""))
+}
+
+// ListForecasts godoc
+// @Summary Get the saved cash-flow forecasts
+// @Description Get the saved cash-flow forecasts, latest first
+// @id getListCashFlowForecasts
+// @Tags Cash Flow
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param horizon query string false "weekly or monthly"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} CashFlowForecastSummariesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cash-flow/forecasts [get]
+func (h *handler) ListForecasts(c *gin.Context) {
+	query := request.ListForecastsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "cashflow",
+		"method":  "ListForecasts",
+		"query":   query,
+	})
+
+	forecasts, total, err := h.controller.CashFlow.ListForecasts(model.CashFlowHorizon(query.Horizon), query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list cash-flow forecasts")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCashFlowForecastSummaries(forecasts),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// GetForecast godoc
+// @Summary Get a saved cash-flow forecast
+// @Description Get a saved cash-flow forecast as it was computed
+// @id getCashFlowForecastByID
+// @Tags Cash Flow
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Cash-flow forecast ID"
+// @Success 200 {object} CashFlowForecastResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cash-flow/forecasts/{id} [get]
+func (h *handler) GetForecast(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidForecastID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "cashflow",
+		"method":  "GetForecast",
+		"id":      id,
+	})
+
+	forecast, report, err := h.controller.CashFlow.GetForecast(id)
+	if err != nil {
+		if errors.Is(err, ctrlcashflow.ErrForecastNotFound) {
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+
+		l.Error(err, "failed to get cash-flow forecast")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCashFlowForecast(forecast, report), nil, nil, nil, ""))
+}
+
+// CompareActuals godoc
+// @Summary Compare a saved cash-flow forecast with the actuals
+// @Description Set each period of a saved forecast against the cash booked in the accounting transactions
+// @id compareCashFlowForecastActuals
+// @Tags Cash Flow
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Cash-flow forecast ID"
+// @Success 200 {object} CashFlowComparisonResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cash-flow/forecasts/{id}/variance [get]
+func (h *handler) CompareActuals(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidForecastID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "cashflow",
+		"method":  "CompareActuals",
+		"id":      id,
+	})
+
+	forecast, variances, err := h.controller.CashFlow.CompareActuals(id)
+	if err != nil {
+		if errors.Is(err, ctrlcashflow.ErrForecastNotFound) {
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+
+		l.Error(err, "failed to compare cash-flow forecast with actuals")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCashFlowComparison(forecast, variances), nil, nil, nil, ""))
+}
+
+// SnapshotForecasts godoc
+// @Summary Save the weekly and monthly cash-flow forecasts
+// @Description Save the forecasts without scenario, to compare them with the actuals later
+// @id snapshotCashFlowForecasts
+// @Tags Cash Flow
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} CashFlowForecastSummariesListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/snapshot-cash-flow-forecasts [post]
+func (h *handler) SnapshotForecasts(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "cashflow",
+		"method":  "SnapshotForecasts",
+	})
+
+	forecasts, err := h.controller.CashFlow.SnapshotForecasts()
+	if err != nil {
+		l.Error(err, "failed to snapshot cash-flow forecasts")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCashFlowForecastSummaries(forecasts), nil, nil, nil, ""))
+}
+
+func toForecastInput(r request.ForecastRequest) ctrlcashflow.ForecastInput {
+	return ctrlcashflow.ForecastInput{
+		Horizon:  model.CashFlowHorizon(r.Horizon),
+		Date:     r.StartDate(),
+		Scenario: r.Scenario(),
+	}
+}
+
+func toUUIDPtr(s string) *model.UUID {
+	id, err := model.UUIDFromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,14 @@
+package errs
+
+import "errors"
+
+var (
+	ErrInvalidForecastID   = errors.New("invalid cash-flow forecast id")
+	ErrInvalidHorizon      = errors.New("invalid horizon, expected weekly or monthly")
+	ErrInvalidDate         = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidClientID     = errors.New("invalid churn client id")
+	ErrInvalidNewHires     = errors.New("newHires and newHireMonthlyCost must not be negative")
+	ErrMissingNewHireCost  = errors.New("newHireMonthlyCost is required with newHires")
+	ErrInvalidFXShift      = errors.New("fxShiftPercent must be greater than -100")
+	ErrMissingChurnClients = errors.New("churnClientIDs is required with churnDate")
+)
@@ -0,0 +1,12 @@
+package cashflow
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	CompareActuals(c *gin.Context)
+	Forecast(c *gin.Context)
+	GetForecast(c *gin.Context)
+	ListForecasts(c *gin.Context)
+	SaveForecast(c *gin.Context)
+	SnapshotForecasts(c *gin.Context)
+}
@@ -0,0 +1,108 @@
+package request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/cashflow/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+// ForecastRequest is read from the query string of the live forecast and from the body
+// of a saved one. The scenario fields are all optional.
+type ForecastRequest struct {
+	Horizon            string  `form:"horizon" json:"horizon"`
+	Date               string  `form:"date" json:"date"`                     // YYYY-MM-DD, the forecast starts with the period containing it
+	ChurnClientIDs     string  `form:"churnClientIDs" json:"churnClientIDs"` // comma separated
+	ChurnDate          string  `form:"churnDate" json:"churnDate"`           // YYYY-MM-DD, the clients churn right away when empty
+	NewHires           int     `form:"newHires" json:"newHires"`
+	NewHireMonthlyCost float64 `form:"newHireMonthlyCost" json:"newHireMonthlyCost"` // in VND, per hire
+	NewHireStartDate   string  `form:"newHireStartDate" json:"newHireStartDate"`     // YYYY-MM-DD, next month when empty
+	FXShiftPercent     float64 `form:"fxShiftPercent" json:"fxShiftPercent"`         // e.g. 5 makes the USD 5% stronger against the VND
+	OpeningBalance     float64 `form:"openingBalance" json:"openingBalance"`
+} // @name ForecastRequest
+
+func (r *ForecastRequest) Validate() error {
+	if r.Horizon == "" {
+		r.Horizon = model.CashFlowHorizonWeekly.String()
+	}
+	if !model.CashFlowHorizon(r.Horizon).IsValid() {
+		return errs.ErrInvalidHorizon
+	}
+
+	for _, d := range []string{r.Date, r.ChurnDate, r.NewHireStartDate} {
+		if _, err := timeutil.ParseOptionalDate(d); err != nil {
+			return errs.ErrInvalidDate
+		}
+	}
+
+	clientIDs := r.ClientIDs()
+	for _, id := range clientIDs {
+		if !model.IsUUIDFromString(id) {
+			return errs.ErrInvalidClientID
+		}
+	}
+	if r.ChurnDate != "" && len(clientIDs) == 0 {
+		return errs.ErrMissingChurnClients
+	}
+
+	if r.NewHires < 0 || r.NewHireMonthlyCost < 0 {
+		return errs.ErrInvalidNewHires
+	}
+	if r.NewHires > 0 && r.NewHireMonthlyCost == 0 {
+		return errs.ErrMissingNewHireCost
+	}
+	if r.FXShiftPercent <= -100 {
+		return errs.ErrInvalidFXShift
+	}
+
+	return nil
+}
+
+func (r *ForecastRequest) ClientIDs() []string {
+	var ids []string
+	for _, id := range strings.Split(r.ChurnClientIDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// StartDate is the date the forecast starts from, zero for today
+func (r *ForecastRequest) StartDate() time.Time {
+	d, _ := timeutil.ParseOptionalDate(r.Date)
+	if d == nil {
+		return time.Time{}
+	}
+	return *d
+}
+
+func (r *ForecastRequest) Scenario() model.CashFlowScenario {
+	churnDate, _ := timeutil.ParseOptionalDate(r.ChurnDate)
+	newHireStartDate, _ := timeutil.ParseOptionalDate(r.NewHireStartDate)
+
+	return model.CashFlowScenario{
+		ChurnClientIDs:     r.ClientIDs(),
+		ChurnDate:          churnDate,
+		NewHires:           r.NewHires,
+		NewHireMonthlyCost: r.NewHireMonthlyCost,
+		NewHireStartDate:   newHireStartDate,
+		FXShiftPercent:     r.FXShiftPercent,
+		OpeningBalance:     r.OpeningBalance,
+	}
+}
+
+type ListForecastsQuery struct {
+	model.Pagination
+
+	Horizon string `form:"horizon" json:"horizon"`
+} // @name ListForecastsQuery
+
+func (q *ListForecastsQuery) Validate() error {
+	if q.Horizon != "" && !model.CashFlowHorizon(q.Horizon).IsValid() {
+		return errs.ErrInvalidHorizon
+	}
+	return nil
+}
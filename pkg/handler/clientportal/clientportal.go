@@ -0,0 +1,463 @@
+package clientportal
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlclientportal "github.com/dwarvesf/fortress-api/pkg/controller/clientportal"
+	"github.com/dwarvesf/fortress-api/pkg/handler/clientportal/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/clientportal/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// ListAccesses godoc
+// @Summary Get the client portal accesses of a client
+// @Description Get the contacts of a client allowed to log in to the client portal
+// @id getListClientPortalAccesses
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Client ID"
+// @Param includeRevoked query bool false "Include the revoked accesses"
+// @Success 200 {object} ClientPortalAccessesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /clients/{id}/portal-accesses [get]
+func (h *handler) ListAccesses(c *gin.Context) {
+	clientID := c.Param("id")
+	if !model.IsUUIDFromString(clientID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidClientID, nil, ""))
+		return
+	}
+
+	query := request.ListAccessesQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "clientportal",
+		"method":   "ListAccesses",
+		"clientID": clientID,
+	})
+
+	accesses, err := h.controller.ClientPortal.ListAccesses(clientID, query.IncludeRevoked)
+	if err != nil {
+		l.Error(err, "failed to list client portal accesses")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalAccesses(accesses), nil, nil, nil, ""))
+}
+
+// GrantAccess godoc
+// @Summary Grant a client contact access to the client portal
+// @Description Let a contact of the client log in to the client portal with one of their emails. The access key is only returned once.
+// @id grantClientPortalAccess
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Client ID"
+// @Param Body body GrantClientPortalAccessRequest true "body"
+// @Success 200 {object} ClientPortalGrantedAccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /clients/{id}/portal-accesses [post]
+func (h *handler) GrantAccess(c *gin.Context) {
+	clientID := c.Param("id")
+	if !model.IsUUIDFromString(clientID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidClientID, nil, ""))
+		return
+	}
+
+	body := request.GrantAccessRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+	if err := body.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "clientportal",
+		"method":   "GrantAccess",
+		"clientID": clientID,
+		"body":     body,
+	})
+
+	granted, err := h.controller.ClientPortal.GrantAccess(ctrlclientportal.GrantAccessInput{
+		ClientID:        clientID,
+		ClientContactID: body.ClientContactID,
+		Email:           body.Email,
+		GrantedBy:       userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to grant client portal access")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalGrantedAccess(granted.Access, granted.Key), nil, nil, nil, ""))
+}
+
+// RevokeAccess godoc
+// @Summary Revoke a client portal access
+// @Description Stop a client contact from using the client portal, their tokens are rejected from the next request
+// @id revokeClientPortalAccess
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Client ID"
+// @Param accessID path string true "Client portal access ID"
+// @Success 200 {object} ClientPortalAccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /clients/{id}/portal-accesses/{accessID} [delete]
+func (h *handler) RevokeAccess(c *gin.Context) {
+	clientID := c.Param("id")
+	if !model.IsUUIDFromString(clientID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidClientID, nil, ""))
+		return
+	}
+	accessID := c.Param("accessID")
+	if !model.IsUUIDFromString(accessID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidAccessID, nil, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "clientportal",
+		"method":   "RevokeAccess",
+		"clientID": clientID,
+		"accessID": accessID,
+	})
+
+	access, err := h.controller.ClientPortal.RevokeAccess(clientID, accessID, userID)
+	if err != nil {
+		l.Error(err, "failed to revoke client portal access")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalAccess(access), nil, nil, nil, ""))
+}
+
+// Login godoc
+// @Summary Log in to the client portal
+// @Description Exchange the email and access key of a client contact for a client portal token. Employee tokens are not accepted on the client portal.
+// @id loginClientPortal
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Param Body body ClientPortalLoginRequest true "body"
+// @Success 200 {object} ClientPortalLoginResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /client-portal/auth [post]
+func (h *handler) Login(c *gin.Context) {
+	body := request.LoginRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "clientportal",
+		"method":  "Login",
+		"email":   body.Email,
+	})
+
+	res, err := h.controller.ClientPortal.Login(body.Email, body.AccessKey)
+	if err != nil {
+		l.Error(err, "failed to log in to client portal")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalLogin(res.Access, res.Token, res.ExpiresAt), nil, nil, nil, ""))
+}
+
+// Me godoc
+// @Summary Get the logged in client contact
+// @Description Get the client contact and the client of the client portal token
+// @id getClientPortalMe
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} ClientPortalMeResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /client-portal/me [get]
+func (h *handler) Me(c *gin.Context) {
+	access, ok := authutils.GetClientPortalAccess(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, errs.ErrNotLoggedInClient, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalMe(access), nil, nil, nil, ""))
+}
+
+// ListInvoices godoc
+// @Summary Get the invoices of the client
+// @Description Get the invoices sent to the client with their payment status, latest first
+// @id getListClientPortalInvoices
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param status query string false "sent, overdue, paid, uncollectible or credited"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} ClientPortalInvoicesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /client-portal/invoices [get]
+func (h *handler) ListInvoices(c *gin.Context) {
+	access, ok := authutils.GetClientPortalAccess(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, errs.ErrNotLoggedInClient, nil, ""))
+		return
+	}
+
+	query := request.ListInvoicesQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "clientportal",
+		"method":   "ListInvoices",
+		"clientID": access.ClientID,
+		"query":    query,
+	})
+
+	var statuses []model.InvoiceStatus
+	if query.Status != "" {
+		statuses = append(statuses, model.InvoiceStatus(query.Status))
+	}
+
+	invoices, total, err := h.controller.ClientPortal.ListInvoices(access.ClientID.String(), statuses, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list client portal invoices")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalInvoices(invoices),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size}, Total: total}, nil, nil, ""))
+}
+
+// GetInvoice godoc
+// @Summary Get an invoice of the client
+// @Description Get an invoice sent to the client with its payment status
+// @id getClientPortalInvoice
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Invoice ID"
+// @Success 200 {object} ClientPortalInvoiceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /client-portal/invoices/{id} [get]
+func (h *handler) GetInvoice(c *gin.Context) {
+	access, invoiceID, ok := h.clientInvoice(c)
+	if !ok {
+		return
+	}
+
+	iv, err := h.controller.ClientPortal.GetInvoice(access.ClientID.String(), invoiceID)
+	if err != nil {
+		h.logger.Fields(logger.Fields{
+			"handler":   "clientportal",
+			"method":    "GetInvoice",
+			"invoiceID": invoiceID,
+		}).Error(err, "failed to get client portal invoice")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalInvoice(iv), nil, nil, nil, ""))
+}
+
+// DownloadInvoicePDF godoc
+// @Summary Download the PDF of an invoice of the client
+// @Description Download the PDF of an invoice as it was sent to the client
+// @id downloadClientPortalInvoicePDF
+// @Tags Client Portal
+// @Produce  application/pdf
+// @Security BearerAuth
+// @Param id path string true "Invoice ID"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /client-portal/invoices/{id}/pdf [get]
+func (h *handler) DownloadInvoicePDF(c *gin.Context) {
+	access, invoiceID, ok := h.clientInvoice(c)
+	if !ok {
+		return
+	}
+
+	f, err := h.controller.ClientPortal.GetInvoicePDF(access.ClientID.String(), invoiceID)
+	if err != nil {
+		h.logger.Fields(logger.Fields{
+			"handler":   "clientportal",
+			"method":    "DownloadInvoicePDF",
+			"invoiceID": invoiceID,
+		}).Error(err, "failed to download client portal invoice pdf")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+f.Name+`"`)
+	c.Data(http.StatusOK, "application/pdf", f.Content)
+}
+
+// GetReceipt godoc
+// @Summary Get the receipt of a paid invoice of the client
+// @Description Get the payment receipt of a paid invoice of the client
+// @id getClientPortalInvoiceReceipt
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Invoice ID"
+// @Success 200 {object} ClientPortalReceiptResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /client-portal/invoices/{id}/receipt [get]
+func (h *handler) GetReceipt(c *gin.Context) {
+	access, invoiceID, ok := h.clientInvoice(c)
+	if !ok {
+		return
+	}
+
+	iv, err := h.controller.ClientPortal.GetReceipt(access.ClientID.String(), invoiceID)
+	if err != nil {
+		h.logger.Fields(logger.Fields{
+			"handler":   "clientportal",
+			"method":    "GetReceipt",
+			"invoiceID": invoiceID,
+		}).Error(err, "failed to get client portal receipt")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalReceipt(iv, access.Client), nil, nil, nil, ""))
+}
+
+// GetTeam godoc
+// @Summary Get the team on the projects of the client
+// @Description Get the members currently deployed on the active projects of the client, by project
+// @id getClientPortalTeam
+// @Tags Client Portal
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} ClientPortalTeamResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /client-portal/team [get]
+func (h *handler) GetTeam(c *gin.Context) {
+	access, ok := authutils.GetClientPortalAccess(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, errs.ErrNotLoggedInClient, nil, ""))
+		return
+	}
+
+	members, err := h.controller.ClientPortal.GetTeam(access.ClientID.String())
+	if err != nil {
+		h.logger.Fields(logger.Fields{
+			"handler":  "clientportal",
+			"method":   "GetTeam",
+			"clientID": access.ClientID,
+		}).Error(err, "failed to get client portal team")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToClientPortalTeams(members), nil, nil, nil, ""))
+}
+
+// clientInvoice returns the logged in client access and the invoice id of the path, it writes the
+// error response when one is missing
+func (h *handler) clientInvoice(c *gin.Context) (*model.ClientPortalAccess, string, bool) {
+	access, ok := authutils.GetClientPortalAccess(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, errs.ErrNotLoggedInClient, nil, ""))
+		return nil, "", false
+	}
+
+	invoiceID := c.Param("id")
+	if !model.IsUUIDFromString(invoiceID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidInvoiceID, nil, ""))
+		return nil, "", false
+	}
+
+	return access, invoiceID, true
+}
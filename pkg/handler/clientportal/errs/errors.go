@@ -0,0 +1,58 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/clientportal"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidClientID        = errors.New("invalid client id")
+	ErrInvalidClientContactID = errors.New("invalid client contact id")
+	ErrInvalidAccessID        = errors.New("invalid access id")
+	ErrInvalidInvoiceID       = errors.New("invalid invoice id")
+	ErrNotLoggedInClient      = errors.New("not logged in as a client")
+)
+
+// ConvertControllerErr writes the status of a client portal controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, clientportal.ErrClientNotFound),
+		errors.Is(err, clientportal.ErrContactNotFound),
+		errors.Is(err, clientportal.ErrAccessNotFound),
+		errors.Is(err, clientportal.ErrInvoiceNotFound),
+		errors.Is(err, clientportal.ErrInvoiceFileNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, clientportal.ErrInvalidCredentials):
+		status = http.StatusUnauthorized
+
+	case errors.Is(err, clientportal.ErrAccessExists),
+		errors.Is(err, clientportal.ErrAccessRevoked),
+		errors.Is(err, clientportal.ErrInvoiceNotPaid):
+		status = http.StatusConflict
+
+	case errors.Is(err, clientportal.ErrEmailNotOfContact),
+		errors.Is(err, clientportal.ErrContactHasNoEmail),
+		errors.Is(err, clientportal.ErrInvalidInvoiceStatus):
+		status = http.StatusBadRequest
+
+	case errors.Is(err, clientportal.ErrClientPortalDisabled):
+		status = http.StatusServiceUnavailable
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,16 @@
+package clientportal
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	DownloadInvoicePDF(c *gin.Context)
+	GetInvoice(c *gin.Context)
+	GetReceipt(c *gin.Context)
+	GetTeam(c *gin.Context)
+	GrantAccess(c *gin.Context)
+	ListAccesses(c *gin.Context)
+	ListInvoices(c *gin.Context)
+	Login(c *gin.Context)
+	Me(c *gin.Context)
+	RevokeAccess(c *gin.Context)
+}
@@ -0,0 +1,33 @@
+package request
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/clientportal/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type LoginRequest struct {
+	Email     string `json:"email" binding:"required,email"`
+	AccessKey string `json:"accessKey" binding:"required"`
+} // @name ClientPortalLoginRequest
+
+type GrantAccessRequest struct {
+	ClientContactID string `json:"clientContactID" binding:"required"`
+	Email           string `json:"email" binding:"omitempty,email"` // one of the emails of the contact, the first one when empty
+} // @name GrantClientPortalAccessRequest
+
+func (r *GrantAccessRequest) Validate() error {
+	if !model.IsUUIDFromString(r.ClientContactID) {
+		return errs.ErrInvalidClientContactID
+	}
+	return nil
+}
+
+type ListAccessesQuery struct {
+	IncludeRevoked bool `form:"includeRevoked" json:"includeRevoked"`
+} // @name ListClientPortalAccessesQuery
+
+type ListInvoicesQuery struct {
+	model.Pagination
+
+	Status string `form:"status" json:"status"` // sent, overdue, paid, uncollectible or credited
+} // @name ListClientPortalInvoicesQuery
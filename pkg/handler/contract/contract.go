@@ -0,0 +1,422 @@
+package contract
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlcontract "github.com/dwarvesf/fortress-api/pkg/controller/contract"
+	"github.com/dwarvesf/fortress-api/pkg/handler/contract/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/contract/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// List godoc
+// @Summary Get the contracts
+// @Description Get the MSAs and SOWs signed with the clients, latest started first
+// @id getListContracts
+// @Tags Contract
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param clientID query string false "Client ID"
+// @Param projectID query string false "Project ID"
+// @Param type query string false "msa or sow"
+// @Param status query string false "draft, active or terminated"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} ContractsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /contracts [get]
+func (h *handler) List(c *gin.Context) {
+	query := request.ListContractsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "contract",
+		"method":  "List",
+		"query":   query,
+	})
+
+	contracts, total, err := h.controller.Contract.List(ctrlcontract.ListInput{
+		ClientID:  query.ClientID,
+		ProjectID: query.ProjectID,
+		Type:      model.ContractType(query.Type),
+		Status:    model.ContractStatus(query.Status),
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list contracts")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToContracts(contracts),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// Get godoc
+// @Summary Get a contract
+// @Description Get a contract with its rate card and attachments
+// @id getContractByID
+// @Tags Contract
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Contract ID"
+// @Success 200 {object} ContractDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /contracts/{id} [get]
+func (h *handler) Get(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidContractID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "contract",
+		"method":  "Get",
+		"id":      id,
+	})
+
+	contract, err := h.controller.Contract.Get(id)
+	if err != nil {
+		l.Error(err, "failed to get contract")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToContractDetail(contract), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create a contract
+// @Description Create a MSA of a client or a SOW of one of its projects with its effective period, payment terms and value cap
+// @id createContract
+// @Tags Contract
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body ContractRequest true "Body"
+// @Success 200 {object} ContractDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /contracts [post]
+func (h *handler) Create(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.ContractRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "contract",
+		"method":  "Create",
+		"request": input,
+	})
+
+	contract, err := h.controller.Contract.Create(toContractInput(input), userID)
+	if err != nil {
+		l.Error(err, "failed to create contract")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToContractDetail(contract), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update a contract
+// @Description Update a contract, a contract whose end date moved is announced again before it expires
+// @id updateContract
+// @Tags Contract
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Contract ID"
+// @Param Body body ContractRequest true "Body"
+// @Success 200 {object} ContractDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /contracts/{id} [put]
+func (h *handler) Update(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidContractID, nil, ""))
+		return
+	}
+
+	input := request.ContractRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "contract",
+		"method":  "Update",
+		"id":      id,
+		"request": input,
+	})
+
+	contract, err := h.controller.Contract.Update(id, toContractInput(input))
+	if err != nil {
+		l.Error(err, "failed to update contract")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToContractDetail(contract), nil, nil, nil, ""))
+}
+
+// SetRates godoc
+// @Summary Set the rate card of a contract
+// @Description Replace the rates of the contract per seniority and position, the invoices of its projects are checked against them
+// @id setContractRates
+// @Tags Contract
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Contract ID"
+// @Param Body body SetContractRatesRequest true "Body"
+// @Success 200 {object} ContractDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /contracts/{id}/rates [put]
+func (h *handler) SetRates(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidContractID, nil, ""))
+		return
+	}
+
+	input := request.SetContractRatesRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "contract",
+		"method":  "SetRates",
+		"id":      id,
+	})
+
+	rates := make([]ctrlcontract.RateInput, 0, len(input.Rates))
+	for _, r := range input.Rates {
+		rates = append(rates, ctrlcontract.RateInput{
+			SeniorityID: toUUIDPtr(r.SeniorityID),
+			PositionID:  toUUIDPtr(r.PositionID),
+			Rate:        r.Rate,
+			Unit:        model.ContractRateUnit(r.Unit),
+		})
+	}
+
+	contract, err := h.controller.Contract.SetRates(id, rates)
+	if err != nil {
+		l.Error(err, "failed to set contract rates")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToContractDetail(contract), nil, nil, nil, ""))
+}
+
+// UploadAttachment godoc
+// @Summary Upload a contract attachment
+// @Description Keep a signed copy or an annex of the contract in the Google Drive folder of the client
+// @id uploadContractAttachment
+// @Tags Contract
+// @Accept  multipart/form-data
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Contract ID"
+// @Param file formData file true "Contract file"
+// @Success 200 {object} ContractAttachmentResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /contracts/{id}/attachments [post]
+func (h *handler) UploadAttachment(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidContractID, nil, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	if file.Size > model.MaxFileSizePdf {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidFileSize, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "contract",
+		"method":   "UploadAttachment",
+		"id":       id,
+		"fileName": file.Filename,
+	})
+
+	f, err := file.Open()
+	if err != nil {
+		l.Error(err, "failed to open contract file")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		l.Error(err, "failed to read contract file")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	attachment, err := h.controller.Contract.UploadAttachment(id, ctrlcontract.AttachmentInput{
+		Name:        file.Filename,
+		ContentType: file.Header.Get("Content-Type"),
+		Content:     content,
+		UploadedBy:  userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to upload contract attachment")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToContractAttachment(attachment), nil, nil, nil, ""))
+}
+
+// NotifyExpiring godoc
+// @Summary Announce the contract expiries
+// @Description Announce on Discord to the account managers the active contracts ending within 30 days
+// @id notifyContractExpiries
+// @Tags Contract
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} ContractsListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/contract-expiries [post]
+func (h *handler) NotifyExpiring(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "contract",
+		"method":  "NotifyExpiring",
+	})
+
+	contracts, err := h.controller.Contract.NotifyExpiring(time.Now())
+	if err != nil {
+		l.Error(err, "failed to announce contract expiries")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToContracts(contracts), nil, nil, nil, ""))
+}
+
+func toContractInput(r request.ContractRequest) ctrlcontract.ContractInput {
+	startDate, _ := timeutil.ParseOptionalDate(r.StartDate)
+	endDate, _ := timeutil.ParseOptionalDate(r.EndDate)
+	signedAt, _ := timeutil.ParseOptionalDate(r.SignedAt)
+	clientID, _ := model.UUIDFromString(r.ClientID)
+
+	input := ctrlcontract.ContractInput{
+		ClientID:        clientID,
+		ProjectID:       toUUIDPtr(r.ProjectID),
+		Code:            r.Code,
+		Name:            r.Name,
+		Type:            model.ContractType(r.Type),
+		Status:          model.ContractStatus(r.Status),
+		EndDate:         endDate,
+		SignedAt:        signedAt,
+		Currency:        r.Currency,
+		PaymentTermDays: r.PaymentTermDays,
+		ValueCap:        r.ValueCap,
+		Note:            r.Note,
+	}
+	if startDate != nil {
+		input.StartDate = *startDate
+	}
+	return input
+}
+
+func toUUIDPtr(s string) *model.UUID {
+	id, err := model.UUIDFromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,64 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/contract"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidContractID  = errors.New("invalid contract id")
+	ErrInvalidClientID    = errors.New("invalid client id")
+	ErrInvalidProjectID   = errors.New("invalid project id")
+	ErrInvalidSeniorityID = errors.New("invalid seniority id")
+	ErrInvalidPositionID  = errors.New("invalid position id")
+	ErrInvalidType        = errors.New("invalid contract type, expected msa or sow")
+	ErrInvalidStatus      = errors.New("invalid contract status, expected draft, active or terminated")
+	ErrInvalidPaymentTerm = errors.New("invalid payment term, expected 15, 30, 45 or 60 days")
+	ErrInvalidRateUnit    = errors.New("invalid rate unit, expected hour or month")
+	ErrInvalidDate        = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidFileSize    = errors.New("invalid file size")
+)
+
+// ConvertControllerErr writes the status of a contract controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, contract.ErrContractNotFound),
+		errors.Is(err, contract.ErrClientNotFound),
+		errors.Is(err, contract.ErrProjectNotFound),
+		errors.Is(err, contract.ErrCurrencyNotFound),
+		errors.Is(err, contract.ErrSeniorityNotFound),
+		errors.Is(err, contract.ErrPositionNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, contract.ErrProjectNotOfClient),
+		errors.Is(err, contract.ErrInvalidType),
+		errors.Is(err, contract.ErrInvalidStatus),
+		errors.Is(err, contract.ErrInvalidPaymentTerm),
+		errors.Is(err, contract.ErrInvalidEndDate),
+		errors.Is(err, contract.ErrInvalidValueCap),
+		errors.Is(err, contract.ErrInvalidRate),
+		errors.Is(err, contract.ErrInvalidRateUnit),
+		errors.Is(err, contract.ErrSOWWithoutProject),
+		errors.Is(err, contract.ErrMSAWithProject),
+		errors.Is(err, contract.ErrCodeExists),
+		errors.Is(err, contract.ErrEmptyAttachment),
+		errors.Is(err, contract.ErrInvoiceViolatesContract):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,13 @@
+package contract
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Create(c *gin.Context)
+	Get(c *gin.Context)
+	List(c *gin.Context)
+	NotifyExpiring(c *gin.Context)
+	SetRates(c *gin.Context)
+	Update(c *gin.Context)
+	UploadAttachment(c *gin.Context)
+}
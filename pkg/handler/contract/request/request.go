@@ -0,0 +1,100 @@
+package request
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/contract/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+type ListContractsQuery struct {
+	model.Pagination
+
+	ClientID  string `form:"clientID" json:"clientID"`
+	ProjectID string `form:"projectID" json:"projectID"`
+	Type      string `form:"type" json:"type"`     // msa or sow
+	Status    string `form:"status" json:"status"` // draft, active or terminated
+} // @name ListContractsQuery
+
+func (q *ListContractsQuery) Validate() error {
+	if q.ClientID != "" && !model.IsUUIDFromString(q.ClientID) {
+		return errs.ErrInvalidClientID
+	}
+	if q.ProjectID != "" && !model.IsUUIDFromString(q.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+	if q.Type != "" && !model.ContractType(q.Type).IsValid() {
+		return errs.ErrInvalidType
+	}
+	if q.Status != "" && !model.ContractStatus(q.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	return nil
+}
+
+type ContractRequest struct {
+	ClientID        string           `json:"clientID" binding:"required"`
+	ProjectID       string           `json:"projectID"` // required for a SOW, empty for a MSA
+	Code            string           `json:"code" binding:"required,max=100"`
+	Name            string           `json:"name" binding:"required,max=200"`
+	Type            string           `json:"type"`                         // msa or sow, sow when empty
+	Status          string           `json:"status"`                       // draft, active or terminated, draft when empty
+	StartDate       string           `json:"startDate" binding:"required"` // YYYY-MM-DD
+	EndDate         string           `json:"endDate"`                      // YYYY-MM-DD, open ended when empty
+	SignedAt        string           `json:"signedAt"`                     // YYYY-MM-DD
+	Currency        string           `json:"currency"`
+	PaymentTermDays int              `json:"paymentTermDays"` // 15, 30, 45 or 60, net-30 when empty
+	ValueCap        *decimal.Decimal `json:"valueCap"`        // the most that can be invoiced under the contract
+	Note            string           `json:"note"`
+} // @name ContractRequest
+
+func (r *ContractRequest) Validate() error {
+	if !model.IsUUIDFromString(r.ClientID) {
+		return errs.ErrInvalidClientID
+	}
+	if r.ProjectID != "" && !model.IsUUIDFromString(r.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+	if r.Type != "" && !model.ContractType(r.Type).IsValid() {
+		return errs.ErrInvalidType
+	}
+	if r.Status != "" && !model.ContractStatus(r.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	if r.PaymentTermDays != 0 && !model.IsValidContractPaymentTerm(r.PaymentTermDays) {
+		return errs.ErrInvalidPaymentTerm
+	}
+	for _, d := range []string{r.StartDate, r.EndDate, r.SignedAt} {
+		if _, err := timeutil.ParseOptionalDate(d); err != nil {
+			return errs.ErrInvalidDate
+		}
+	}
+	return nil
+}
+
+type ContractRateRequest struct {
+	SeniorityID string          `json:"seniorityID"` // all the seniorities when empty
+	PositionID  string          `json:"positionID"`  // all the positions when empty
+	Rate        decimal.Decimal `json:"rate"`
+	Unit        string          `json:"unit"` // hour or month, month when empty
+} // @name ContractRateRequest
+
+type SetContractRatesRequest struct {
+	Rates []ContractRateRequest `json:"rates"`
+} // @name SetContractRatesRequest
+
+func (r *SetContractRatesRequest) Validate() error {
+	for _, rate := range r.Rates {
+		if rate.SeniorityID != "" && !model.IsUUIDFromString(rate.SeniorityID) {
+			return errs.ErrInvalidSeniorityID
+		}
+		if rate.PositionID != "" && !model.IsUUIDFromString(rate.PositionID) {
+			return errs.ErrInvalidPositionID
+		}
+		if rate.Unit != "" && !model.ContractRateUnit(rate.Unit).IsValid() {
+			return errs.ErrInvalidRateUnit
+		}
+	}
+	return nil
+}
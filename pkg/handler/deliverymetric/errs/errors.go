@@ -0,0 +1,53 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/deliverymetrics"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidSource    = errors.New("invalid source, expected csv or xlsx")
+	ErrInvalidImportID  = errors.New("invalid import id")
+	ErrInvalidMappingID = errors.New("invalid mapping id")
+	ErrInvalidBoardID   = errors.New("invalid leaderboard id")
+)
+
+// ConvertControllerErr writes the status of a delivery metrics controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, deliverymetrics.ErrImportNotFound),
+		errors.Is(err, deliverymetrics.ErrMappingNotFound),
+		errors.Is(err, deliverymetrics.ErrMappingTargetNotFound),
+		errors.Is(err, deliverymetrics.ErrLeaderBoardNotFound),
+		errors.Is(err, deliverymetrics.ErrScopeNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, deliverymetrics.ErrUnsupportedSource),
+		errors.Is(err, deliverymetrics.ErrInvalidFile),
+		errors.Is(err, deliverymetrics.ErrInvalidMappingKind),
+		errors.Is(err, deliverymetrics.ErrInvalidMappingValue),
+		errors.Is(err, deliverymetrics.ErrInvalidFormula),
+		errors.Is(err, deliverymetrics.ErrInvalidScope),
+		errors.Is(err, deliverymetrics.ErrScopeIDRequired),
+		errors.Is(err, deliverymetrics.ErrInvalidPeriod),
+		errors.Is(err, deliverymetrics.ErrInvalidSize),
+		errors.Is(err, deliverymetrics.ErrInvalidMinEffort):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,132 @@
+package deliverymetric
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	ctrldeliverymetrics "github.com/dwarvesf/fortress-api/pkg/controller/deliverymetrics"
+	"github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric/errs"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// Import reads the delivery metrics of an uploaded CSV or XLSX file, the "source" form field is
+// guessed from the file extension when empty, "dataset" names the metrics the file replaces, its
+// name when empty, and "dryRun" only validates the rows
+func (h *handler) Import(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	source := model.DeliveryMetricSource(c.PostForm("source"))
+	if source != "" && source != model.DeliveryMetricSourceCSV && source != model.DeliveryMetricSourceXLSX {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSource, nil, ""))
+		return
+	}
+
+	dryRun := false
+	if v := c.PostForm("dryRun"); v != "" {
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "deliverymetric",
+		"method":   "Import",
+		"fileName": file.Filename,
+	})
+
+	data, err := file.Open()
+	if err != nil {
+		l.Error(err, "failed to open delivery metrics file")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	defer data.Close()
+
+	result, err := h.controller.DeliveryMetric.Import(ctrldeliverymetrics.ImportInput{
+		Source:     source,
+		FileName:   file.Filename,
+		Dataset:    c.PostForm("dataset"),
+		DryRun:     dryRun,
+		ImportedBy: toUUIDPtr(userID),
+	}, data)
+	if err != nil {
+		l.Error(err, "failed to import delivery metrics")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryMetricImport(result), nil, nil, nil, ""))
+}
+
+// ListImports returns the imports latest first, without their rejected rows
+func (h *handler) ListImports(c *gin.Context) {
+	query := model.Pagination{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "ListImports",
+	})
+
+	imports, total, err := h.controller.DeliveryMetric.ListImports(query)
+	if err != nil {
+		l.Error(err, "failed to list delivery metric imports")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryMetricImports(imports),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// GetImport returns an import with the rows it rejected and why
+func (h *handler) GetImport(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidImportID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "GetImport",
+		"id":      id,
+	})
+
+	result, err := h.controller.DeliveryMetric.GetImport(id)
+	if err != nil {
+		l.Error(err, "failed to get delivery metric import")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryMetricImport(result), nil, nil, nil, ""))
+}
+
+func toUUIDPtr(s string) *model.UUID {
+	id, err := model.UUIDFromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -12,4 +12,18 @@ type IHandler interface {
 	GetMonthlyReportDiscordMsg(c *gin.Context)
 
 	Sync(c *gin.Context)
+	Import(c *gin.Context)
+	ListImports(c *gin.Context)
+	GetImport(c *gin.Context)
+
+	ListMappings(c *gin.Context)
+	SaveMapping(c *gin.Context)
+	DeleteMapping(c *gin.Context)
+
+	ListLeaderBoards(c *gin.Context)
+	CreateLeaderBoard(c *gin.Context)
+	UpdateLeaderBoard(c *gin.Context)
+	DeleteLeaderBoard(c *gin.Context)
+	GetLeaderBoard(c *gin.Context)
+	GetLeaderBoardDiscordMsg(c *gin.Context)
 }
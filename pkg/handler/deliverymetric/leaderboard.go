@@ -0,0 +1,178 @@
+package deliverymetric
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	ctrldeliverymetrics "github.com/dwarvesf/fortress-api/pkg/controller/deliverymetrics"
+	"github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service/discord"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// ListLeaderBoards returns the configured leaderboard definitions
+func (h *handler) ListLeaderBoards(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "ListLeaderBoards",
+	})
+
+	boards, err := h.controller.DeliveryMetric.ListLeaderBoards()
+	if err != nil {
+		l.Error(err, "failed to list leaderboards")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryLeaderBoards(boards), nil, nil, nil, ""))
+}
+
+func (h *handler) CreateLeaderBoard(c *gin.Context) {
+	req := request.LeaderBoardRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "CreateLeaderBoard",
+		"request": req,
+	})
+
+	board, err := h.controller.DeliveryMetric.CreateLeaderBoard(toLeaderBoardInput(req))
+	if err != nil {
+		l.Error(err, "failed to create leaderboard")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryLeaderBoard(board), nil, nil, nil, ""))
+}
+
+// UpdateLeaderBoard replaces the definition of a leaderboard
+func (h *handler) UpdateLeaderBoard(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidBoardID, nil, ""))
+		return
+	}
+
+	req := request.LeaderBoardRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "UpdateLeaderBoard",
+		"id":      id,
+		"request": req,
+	})
+
+	board, err := h.controller.DeliveryMetric.UpdateLeaderBoard(id, toLeaderBoardInput(req))
+	if err != nil {
+		l.Error(err, "failed to update leaderboard")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryLeaderBoard(board), nil, nil, nil, ""))
+}
+
+func (h *handler) DeleteLeaderBoard(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidBoardID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "DeleteLeaderBoard",
+		"id":      id,
+	})
+
+	if err := h.controller.DeliveryMetric.DeleteLeaderBoard(id); err != nil {
+		l.Error(err, "failed to delete leaderboard")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// GetLeaderBoard ranks the employees of a leaderboard on the period of the date
+func (h *handler) GetLeaderBoard(c *gin.Context) {
+	board, ok := h.evaluateLeaderBoard(c, "GetLeaderBoard")
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryMetricLeaderBoard(board), nil, nil, nil, ""))
+}
+
+// GetLeaderBoardDiscordMsg returns the Discord embed of a leaderboard, for fortress-discord
+func (h *handler) GetLeaderBoardDiscordMsg(c *gin.Context) {
+	board, ok := h.evaluateLeaderBoard(c, "GetLeaderBoardDiscordMsg")
+	if !ok {
+		return
+	}
+
+	msg := discord.CreateDeliveryLeaderBoardMessage(view.ToDeliveryMetricLeaderBoard(board))
+	c.JSON(http.StatusOK, view.CreateResponse[any](msg, nil, nil, nil, ""))
+}
+
+func (h *handler) evaluateLeaderBoard(c *gin.Context, method string) (*model.LeaderBoard, bool) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidBoardID, nil, ""))
+		return nil, false
+	}
+
+	query := request.GetLeaderBoardQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return nil, false
+	}
+	date, err := query.GetDate()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return nil, false
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  method,
+		"id":      id,
+	})
+
+	board, err := h.controller.DeliveryMetric.GetLeaderBoard(id, date)
+	if err != nil {
+		l.Error(err, "failed to get leaderboard")
+		errs.ConvertControllerErr(c, err)
+		return nil, false
+	}
+
+	return board, true
+}
+
+func toLeaderBoardInput(req request.LeaderBoardRequest) ctrldeliverymetrics.LeaderBoardInput {
+	return ctrldeliverymetrics.LeaderBoardInput{
+		Name:             req.Name,
+		Formula:          req.Formula,
+		Scope:            model.LeaderBoardScope(req.Scope),
+		ScopeID:          req.ScopeID,
+		Period:           model.LeaderBoardPeriod(req.Period),
+		ExcludeLeads:     req.ExcludeLeads,
+		MinEffort:        req.MinEffort,
+		Size:             req.Size,
+		DiscordChannelID: req.DiscordChannelID,
+		IsActive:         req.IsActive,
+	}
+}
@@ -0,0 +1,100 @@
+package deliverymetric
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	ctrldeliverymetrics "github.com/dwarvesf/fortress-api/pkg/controller/deliverymetrics"
+	"github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// ListMappings returns the manual mappings of the rows to the employees and projects
+func (h *handler) ListMappings(c *gin.Context) {
+	query := request.ListMappingsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "ListMappings",
+	})
+
+	mappings, err := h.controller.DeliveryMetric.ListMappings(model.DeliveryMetricMappingKind(query.Kind))
+	if err != nil {
+		l.Error(err, "failed to list delivery metric mappings")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryMetricMappings(mappings), nil, nil, nil, ""))
+}
+
+// SaveMapping maps an email, person or project as written in the rows to an employee or a project,
+// replacing the mapping of the same value
+func (h *handler) SaveMapping(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	req := request.SaveMappingRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "SaveMapping",
+		"request": req,
+	})
+
+	mapping, err := h.controller.DeliveryMetric.SaveMapping(ctrldeliverymetrics.SaveMappingInput{
+		Kind:      model.DeliveryMetricMappingKind(req.Kind),
+		Value:     req.Value,
+		TargetID:  req.TargetID,
+		CreatedBy: toUUIDPtr(userID),
+	})
+	if err != nil {
+		l.Error(err, "failed to save delivery metric mapping")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryMetricMapping(mapping), nil, nil, nil, ""))
+}
+
+func (h *handler) DeleteMapping(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMappingID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "deliverymetric",
+		"method":  "DeleteMapping",
+		"id":      id,
+	})
+
+	if err := h.controller.DeliveryMetric.DeleteMapping(id); err != nil {
+		l.Error(err, "failed to delete delivery metric mapping")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
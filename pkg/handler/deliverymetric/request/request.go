@@ -0,0 +1,62 @@
+package request
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+var (
+	ErrInvalidKind     = errors.New("invalid kind, expected employee or project")
+	ErrInvalidTargetID = errors.New("invalid target id")
+	ErrInvalidDate     = errors.New("invalid date, expected YYYY-MM-DD")
+)
+
+type ListMappingsQuery struct {
+	Kind string `form:"kind" json:"kind"` // employee or project, every mapping when empty
+} // @name ListDeliveryMetricMappingsQuery
+
+type SaveMappingRequest struct {
+	Kind     string `json:"kind" binding:"required"`     // employee or project
+	Value    string `json:"value" binding:"required"`    // the email, person or project as written in the rows
+	TargetID string `json:"targetID" binding:"required"` // the employee or project the value is mapped to
+} // @name SaveDeliveryMetricMappingRequest
+
+func (r *SaveMappingRequest) Validate() error {
+	if !model.DeliveryMetricMappingKind(r.Kind).IsValid() {
+		return ErrInvalidKind
+	}
+	if !model.IsUUIDFromString(r.TargetID) {
+		return ErrInvalidTargetID
+	}
+	return nil
+}
+
+type LeaderBoardRequest struct {
+	Name             string  `json:"name" binding:"required"`
+	Formula          string  `json:"formula" binding:"required"` // e.g. weight * 0.7 + effectiveness * 30
+	Scope            string  `json:"scope" binding:"required"`   // company, chapter or project
+	ScopeID          string  `json:"scopeID"`                    // the chapter or project of the board
+	Period           string  `json:"period" binding:"required"`  // weekly, monthly or quarterly
+	ExcludeLeads     bool    `json:"excludeLeads"`
+	MinEffort        float64 `json:"minEffort"`
+	Size             int     `json:"size"` // every eligible employee when 0
+	DiscordChannelID string  `json:"discordChannelID"`
+	IsActive         bool    `json:"isActive"`
+} // @name DeliveryLeaderBoardRequest
+
+type GetLeaderBoardQuery struct {
+	Date string `form:"date" json:"date"` // YYYY-MM-DD, the latest week when empty
+} // @name GetDeliveryLeaderBoardQuery
+
+func (q *GetLeaderBoardQuery) GetDate() (*time.Time, error) {
+	if q.Date == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", q.Date)
+	if err != nil {
+		return nil, ErrInvalidDate
+	}
+	return &date, nil
+}
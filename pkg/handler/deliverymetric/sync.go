@@ -1,10 +1,12 @@
 package deliverymetric
 
 import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/view"
-	"github.com/gin-gonic/gin"
-	"net/http"
 )
 
 func (h *handler) Sync(c *gin.Context) {
@@ -15,12 +17,12 @@ func (h *handler) Sync(c *gin.Context) {
 		},
 	)
 
-	err := h.controller.DeliveryMetric.Sync()
+	result, err := h.controller.DeliveryMetric.Sync()
 	if err != nil {
 		l.Errorf(err, "failed to create delivery metric")
 		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
 		return
 	}
 
-	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDeliveryMetricImport(result), nil, nil, nil, "ok"))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
 	"os"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
 	"github.com/dwarvesf/fortress-api/pkg/handler/discord/request"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -306,7 +308,7 @@ func (h *handler) DeliveryMetricsReport(c *gin.Context) {
 	}
 
 	if in.Sync {
-		err := h.controller.DeliveryMetric.Sync()
+		_, err := h.controller.DeliveryMetric.Sync()
 		if err != nil {
 			l.Errorf(err, "failed sync latest data", "body", in)
 			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, in, ""))
@@ -377,6 +379,81 @@ func (h *handler) DeliveryMetricsReport(c *gin.Context) {
 	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
 }
 
+// DeliveryLeaderBoardsReport posts every active leaderboard with a Discord channel, on the latest
+// period with metrics
+func (h *handler) DeliveryLeaderBoardsReport(c *gin.Context) {
+	l := h.logger.Fields(
+		logger.Fields{
+			"handler": "discord",
+			"method":  "DeliveryLeaderBoardsReport",
+		},
+	)
+
+	in := request.DeliveryLeaderBoardsReportInput{}
+	if err := c.ShouldBindJSON(&in); err != nil && !errors.Is(err, io.EOF) {
+		l.Error(err, "failed to decode body")
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, in, ""))
+		return
+	}
+
+	boards, err := h.controller.DeliveryMetric.ListLeaderBoards()
+	if err != nil {
+		l.Error(err, "failed to list leaderboards")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, in, ""))
+		return
+	}
+
+	posted := 0
+	for _, b := range boards {
+		if !b.IsActive || b.DiscordChannelID == "" {
+			continue
+		}
+		if in.Period != "" && b.Period.String() != in.Period {
+			continue
+		}
+
+		leaderBoard, err := h.controller.DeliveryMetric.GetLeaderBoard(b.ID.String(), nil)
+		if err != nil {
+			l.Errorf(err, "failed to get leaderboard %v", b.ID)
+			continue
+		}
+
+		if _, err := h.service.Discord.DeliveryLeaderBoard(view.ToDeliveryMetricLeaderBoard(leaderBoard), b.DiscordChannelID); err != nil {
+			l.Errorf(err, "failed to post leaderboard %v", b.ID)
+			continue
+		}
+		posted++
+
+		// the top of a board is rewarded once per period, ties included
+		occurredAt := time.Now()
+		if leaderBoard.Date != nil {
+			occurredAt = *leaderBoard.Date
+		}
+		for _, item := range leaderBoard.Items {
+			if item.Rank != 1 {
+				continue
+			}
+			h.reward(l, icyreward.TriggerInput{
+				Trigger:    model.IcyRewardTriggerDeliveryLeaderboard,
+				EmployeeID: item.EmployeeID,
+				Reference:  fmt.Sprintf("%v:%v", b.ID, occurredAt.Format("2006-01-02")),
+				OccurredAt: occurredAt,
+			})
+		}
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, fmt.Sprintf("posted %v leaderboards", posted)))
+}
+
+// reward rewards an event by the icy reward engine, the events without an active rule and the
+// people who are not employees are not rewarded
+func (h *handler) reward(l logger.Logger, input icyreward.TriggerInput) {
+	_, _, err := h.controller.IcyReward.Trigger(input)
+	if err != nil && !errors.Is(err, icyreward.ErrNoActiveRule) && !errors.Is(err, icyreward.ErrEmployeeNotFound) {
+		l.Errorf(err, "failed to reward %v of %v%v", input.Trigger, input.EmployeeID, input.DiscordID)
+	}
+}
+
 // SyncMemo syncs memologs from the source memo.d.foundation
 func (h *handler) SyncMemo(c *gin.Context) {
 	targetChannelID := discordPlayGroundReadingChannel
@@ -927,8 +1004,13 @@ func (h *handler) getNewAuthorsSimple(currentMemos []model.MemoLog) ([]string, e
 	return newAuthors, nil
 }
 
-// resolveAuthorsFromParquetByTitle queries parquet file to find actual authors for a given post title
+// resolveAuthorsFromParquetByTitle queries parquet file to find actual authors for a given post title,
+// the authors of the memos ingested from the memo repository are taken first
 func (h *handler) resolveAuthorsFromParquetByTitle(title string) ([]string, error) {
+	if memo, err := h.store.MemoLog.OneByTitle(h.repo.DB(), strings.TrimSpace(title), model.MemoLogSourceGit); err == nil && len(memo.AuthorHandles) > 0 {
+		return memo.AuthorHandles, nil
+	}
+
 	// Check if parquet querying is disabled
 	if os.Getenv("DISABLE_PARQUET_QUERY") == "true" {
 		h.logger.Debug("Parquet querying disabled via environment variable, skipping author resolution by title")
@@ -1245,6 +1327,17 @@ func (h *handler) SetScheduledEventSpeakers(c *gin.Context) {
 		return
 	}
 
+	if event.EventType == model.DiscordScheduledEventTypeOGIF {
+		for _, i := range in {
+			h.reward(l, icyreward.TriggerInput{
+				Trigger:    model.IcyRewardTriggerOGIFTalk,
+				DiscordID:  i.ID,
+				Reference:  event.ID.String(),
+				OccurredAt: event.Date,
+			})
+		}
+	}
+
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToDiscordEvent(*event), nil, nil, nil, ""))
 }
 
@@ -8,6 +8,7 @@ type IHandler interface {
 	OnLeaveMessage(c *gin.Context)
 	ReportBraineryMetrics(c *gin.Context)
 	DeliveryMetricsReport(c *gin.Context)
+	DeliveryLeaderBoardsReport(c *gin.Context)
 	SyncMemo(c *gin.Context)
 	SweepMemo(c *gin.Context)
 	NotifyWeeklyMemos(c *gin.Context)
@@ -43,6 +43,10 @@ func (input DeliveryMetricReportInput) Validate() error {
 	return nil
 }
 
+type DeliveryLeaderBoardsReportInput struct {
+	Period string `json:"period"` // only the boards of the period, every active board when empty
+}
+
 type DiscordEventInput struct {
 	ID               string    `json:"id"`
 	Name             string    `json:"name"`
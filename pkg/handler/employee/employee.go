@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -13,6 +14,7 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
 	"github.com/dwarvesf/fortress-api/pkg/controller/employee"
+	"github.com/dwarvesf/fortress-api/pkg/controller/officecheckin"
 	"github.com/dwarvesf/fortress-api/pkg/handler/employee/errs"
 	"github.com/dwarvesf/fortress-api/pkg/handler/employee/request"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
@@ -266,9 +268,46 @@ func (h *handler) UpdateEmployeeStatus(c *gin.Context) {
 		l.Error(err, "failed to logs to discord")
 	}
 
+	if emp.WorkingStatus == model.WorkingStatusLeft {
+		h.warnUnreturnedAssets(l, emp)
+	}
+
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEmployeeData(emp), nil, nil, nil, ""))
 }
 
+// warnUnreturnedAssets reminds the offboarding checklist of the assets a leaving employee still holds
+func (h *handler) warnUnreturnedAssets(l logger.Logger, emp *model.Employee) {
+	assets, err := h.controller.Asset.ListUnreturned(emp.ID.String())
+	if err != nil {
+		l.Error(err, "failed to list unreturned assets")
+		return
+	}
+	if len(assets) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(assets))
+	for _, a := range assets {
+		if a.SerialNumber != "" {
+			names = append(names, fmt.Sprintf("%s (%s)", a.Name, a.SerialNumber))
+			continue
+		}
+		names = append(names, a.Name)
+	}
+
+	err = h.controller.Discord.Log(model.LogDiscordInput{
+		Type: "employee_unreturned_assets",
+		Data: map[string]interface{}{
+			"updated_employee_id": emp.ID.String(),
+			"asset_count":         len(assets),
+			"assets":              strings.Join(names, ", "),
+		},
+	})
+	if err != nil {
+		l.Error(err, "failed to logs unreturned assets to discord")
+	}
+}
+
 // UpdateGeneralInfo godoc
 // @Summary Update general info of the employee by id
 // @Description Update general info of the employee by id
@@ -1167,7 +1206,7 @@ func (h *handler) GetTotalEarn(c *gin.Context) {
 
 // OfficeCheckIn
 // @Summary OfficeCheckIn for employee
-// @Description OfficeCheckIn for employee
+// @Description OfficeCheckIn for employee, each check-in carries the QR code scanned at the office and the position of the device, verified like the office check-in API. The ICY is paid by the office check-in reward rule. Until CHECKIN_REQUIRE_CODE is on, a check-in without code is recorded and paid as before, unverified.
 // @id OfficeCheckIn
 // @Tags Employee
 // @Accept  json
@@ -1267,7 +1306,42 @@ func (h *handler) OfficeCheckIn(c *gin.Context) {
 			continue
 		}
 
-		r, err := h.controller.Employee.CheckIn(v.DiscordID, v.Time, float64(icyAmount))
+		// the check-ins of the Discord bot not sending the code yet are paid as before, unverified
+		if v.Code == "" {
+			if h.config.CheckIn.RequireCode {
+				data.Err = "check-in code required"
+				resp = append(resp, data)
+				continue
+			}
+
+			r, err := h.controller.Employee.CheckIn(v.DiscordID, v.Time, float64(icyAmount))
+			if err != nil {
+				l.Error(err, "failed to checkin")
+				data.Err = err.Error()
+				resp = append(resp, data)
+				continue
+			}
+
+			data.IcyAmount = r.IcyAmount
+			data.TransactionID = r.TransactionID
+			data.TransactionHash = r.TransactionHash
+			resp = append(resp, data)
+			continue
+		}
+
+		emp, err := h.store.Employee.GetByDiscordID(h.repo.DB(), v.DiscordID, false)
+		if err != nil {
+			l.Error(err, "failed to get employee by discord id")
+			data.Err = "employee not found"
+			resp = append(resp, data)
+			continue
+		}
+
+		checkin, err := h.controller.OfficeCheckin.CheckIn(emp.ID.String(), officecheckin.ScanInput{
+			Code:      v.Code,
+			Latitude:  v.Latitude,
+			Longitude: v.Longitude,
+		}, now)
 		if err != nil {
 			l.Error(err, "failed to checkin")
 			data.Err = err.Error()
@@ -1275,9 +1349,10 @@ func (h *handler) OfficeCheckIn(c *gin.Context) {
 			continue
 		}
 
-		data.IcyAmount = r.IcyAmount
-		data.TransactionID = r.TransactionID
-		data.TransactionHash = r.TransactionHash
+		if r := checkin.Reward; r != nil && r.Status == model.IcyRewardPayoutStatusPaid {
+			data.IcyAmount = r.Amount.InexactFloat64()
+			data.TransactionID = strconv.FormatInt(r.MochiTxID, 10)
+		}
 		resp = append(resp, data)
 	}
 
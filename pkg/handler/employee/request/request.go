@@ -276,4 +276,7 @@ type CheckInRequest struct {
 type CheckIn struct {
 	DiscordID string    `json:"discord_id" binding:"required"`
 	Time      time.Time `json:"time" binding:"required"`
+	Code      string    `json:"code"` // the QR code scanned at the office, required once CHECKIN_REQUIRE_CODE is on
+	Latitude  *float64  `json:"latitude"`
+	Longitude *float64  `json:"longitude"`
 }
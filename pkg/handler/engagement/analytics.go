@@ -0,0 +1,183 @@
+package engagement
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	ctrlengagement "github.com/dwarvesf/fortress-api/pkg/controller/engagement"
+	"github.com/dwarvesf/fortress-api/pkg/handler/engagement/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/engagement/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// AggregateAnalytics godoc
+// @Summary Aggregate the engagement analytics
+// @Description Record the activity the engagement rollups gained since the last run, then compute the weekly participation, channel and member stats of this week and the last one. The first run records the rollups as a baseline.
+// @id aggregateEngagementAnalytics
+// @Tags Engagement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} EngagementAggregationResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/engagement-analytics [post]
+func (h *handler) AggregateAnalytics(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "engagement",
+		"method":  "AggregateAnalytics",
+	})
+
+	res, err := h.controller.Engagement.Aggregate(time.Now())
+	if err != nil {
+		l.Error(err, "failed to aggregate engagement analytics")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEngagementAggregation(res), nil, nil, nil, ""))
+}
+
+// Trends godoc
+// @Summary Get the engagement trends
+// @Description Get the participation of the organization or of the chapters by week, with the change from the week before
+// @id getEngagementTrends
+// @Tags Engagement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param scope query string false "organization or chapter"
+// @Param chapterID query string false "Chapter ID"
+// @Param weeks query int false "Weeks, 12 when empty"
+// @Success 200 {object} EngagementTrendsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /engagements/analytics/trends [get]
+func (h *handler) Trends(c *gin.Context) {
+	query := request.EngagementTrendsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "engagement",
+		"method":  "Trends",
+		"query":   query,
+	})
+
+	trends, err := h.controller.Engagement.Trends(ctrlengagement.TrendsInput{
+		Scope:     model.EngagementScope(query.Scope),
+		ChapterID: query.ChapterID,
+		Weeks:     query.Weeks,
+	}, time.Now())
+	if err != nil {
+		l.Error(err, "failed to get engagement trends")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEngagementTrends(trends), nil, nil, nil, ""))
+}
+
+// QuietMembers godoc
+// @Summary Get the quiet members
+// @Description Get the employees with no message on Discord for the weeks, an early churn signal. Without the engagement analytics read permission, only the ones the logged in employee is the line manager of.
+// @id getEngagementQuietMembers
+// @Tags Engagement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param weeks query int false "Weeks, 2 when empty"
+// @Param lineManagerID query string false "Line manager ID"
+// @Param chapterID query string false "Chapter ID"
+// @Success 200 {object} EngagementQuietMembersResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /engagements/analytics/quiet-members [get]
+func (h *handler) QuietMembers(c *gin.Context) {
+	query := request.EngagementQuietMembersQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	if !authutils.HasPermission(userInfo.Permissions, model.PermissionEngagementAnalyticsRead) {
+		query.LineManagerID = userInfo.UserID
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "engagement",
+		"method":  "QuietMembers",
+		"query":   query,
+	})
+
+	now := time.Now()
+	members, err := h.controller.Engagement.QuietMembers(ctrlengagement.QuietMembersInput{
+		Weeks:         query.Weeks,
+		LineManagerID: query.LineManagerID,
+		ChapterID:     query.ChapterID,
+	}, now)
+	if err != nil {
+		l.Error(err, "failed to get quiet members")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEngagementQuietMembers(members, now), nil, nil, nil, ""))
+}
+
+// ChannelHealth godoc
+// @Summary Get the health of the channels
+// @Description Get the activity of the channels over the last weeks against as many weeks before, the dormant and declining ones first
+// @id getEngagementChannelHealth
+// @Tags Engagement
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param weeks query int false "Weeks, 4 when empty"
+// @Success 200 {object} EngagementChannelHealthResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /engagements/analytics/channels [get]
+func (h *handler) ChannelHealth(c *gin.Context) {
+	query := request.EngagementChannelHealthQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "engagement",
+		"method":  "ChannelHealth",
+		"weeks":   query.Weeks,
+	})
+
+	channels, err := h.controller.Engagement.ChannelHealth(query.Weeks, time.Now())
+	if err != nil {
+		l.Error(err, "failed to get channel health")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEngagementChannelHealth(channels), nil, nil, nil, ""))
+}
@@ -0,0 +1,39 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/engagement"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidChapterID     = errors.New("invalid chapter id")
+	ErrInvalidLineManagerID = errors.New("invalid line manager id")
+)
+
+// ConvertControllerErr writes the status of an engagement controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, engagement.ErrChapterNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, engagement.ErrInvalidScope),
+		errors.Is(err, engagement.ErrInvalidWeeks):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -6,4 +6,8 @@ type IHandler interface {
 	UpsertRollup(c *gin.Context)
 	GetLastMessageID(c *gin.Context)
 	IndexMessages(c *gin.Context)
+	AggregateAnalytics(c *gin.Context)
+	ChannelHealth(c *gin.Context)
+	QuietMembers(c *gin.Context)
+	Trends(c *gin.Context)
 }
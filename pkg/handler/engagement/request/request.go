@@ -1,6 +1,11 @@
 package request
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/engagement/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
 
 var ErrInvalidCount = errors.New("message count or reaction count should be >0")
 
@@ -19,3 +24,36 @@ func (r UpsertRollupRequest) Validate() error {
 	}
 	return nil
 }
+
+type EngagementTrendsQuery struct {
+	Scope     string `form:"scope" json:"scope"`         // organization or chapter, chapter with a chapter id
+	ChapterID string `form:"chapterID" json:"chapterID"` // every chapter when empty
+	Weeks     int    `form:"weeks" json:"weeks"`         // 12 when empty
+} // @name EngagementTrendsQuery
+
+func (q EngagementTrendsQuery) Validate() error {
+	if q.ChapterID != "" && !model.IsUUIDFromString(q.ChapterID) {
+		return errs.ErrInvalidChapterID
+	}
+	return nil
+}
+
+type EngagementQuietMembersQuery struct {
+	Weeks         int    `form:"weeks" json:"weeks"`                 // 2 when empty
+	LineManagerID string `form:"lineManagerID" json:"lineManagerID"` // the logged in employee without the read permission
+	ChapterID     string `form:"chapterID" json:"chapterID"`
+} // @name EngagementQuietMembersQuery
+
+func (q EngagementQuietMembersQuery) Validate() error {
+	if q.LineManagerID != "" && !model.IsUUIDFromString(q.LineManagerID) {
+		return errs.ErrInvalidLineManagerID
+	}
+	if q.ChapterID != "" && !model.IsUUIDFromString(q.ChapterID) {
+		return errs.ErrInvalidChapterID
+	}
+	return nil
+}
+
+type EngagementChannelHealthQuery struct {
+	Weeks int `form:"weeks" json:"weeks"` // 4 when empty
+} // @name EngagementChannelHealthQuery
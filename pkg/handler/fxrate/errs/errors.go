@@ -0,0 +1,11 @@
+package errs
+
+import "errors"
+
+var (
+	ErrInvalidDate            = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidCurrency        = errors.New("invalid currency")
+	ErrInvalidSource          = errors.New("invalid rate source")
+	ErrInvalidTransactionType = errors.New("invalid transaction type")
+	ErrInvalidTransactionID   = errors.New("invalid transaction id")
+)
@@ -0,0 +1,347 @@
+package fxrate
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlfxrate "github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
+	"github.com/dwarvesf/fortress-api/pkg/handler/fxrate/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/fxrate/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// List godoc
+// @Summary Get the fx rate history
+// @Description Get the recorded fx rates, filtered by pair, source and date range
+// @id getListFxRates
+// @Tags FxRate
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param sourceCurrency query string false "Source currency"
+// @Param targetCurrency query string false "Target currency"
+// @Param source query string false "Rate source: wise, currencylayer, manual"
+// @Param from query string false "From date (YYYY-MM-DD)"
+// @Param to query string false "To date (YYYY-MM-DD)"
+// @Success 200 {object} FxRatesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /fx-rates [get]
+func (h *handler) List(c *gin.Context) {
+	query := request.ListFxRatesQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "fxrate",
+		"method":  "List",
+		"query":   query,
+	})
+
+	rates, err := h.controller.FxRate.List(ctrlfxrate.ListInput{
+		SourceCurrency: query.SourceCurrency,
+		TargetCurrency: query.TargetCurrency,
+		Source:         model.FxRateSource(strings.ToLower(query.Source)),
+		From:           query.FromDate(),
+		To:             query.ToDate(),
+	})
+	if err != nil {
+		l.Error(err, "failed to list fx rates")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToFxRates(rates), nil, nil, nil, ""))
+}
+
+// GetRateAt godoc
+// @Summary Get the fx rate in effect on a date
+// @Description Get the fx rate of a pair in effect on a date, following the configured source priority
+// @id getFxRateAt
+// @Tags FxRate
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param sourceCurrency query string true "Source currency"
+// @Param targetCurrency query string true "Target currency"
+// @Param date query string true "Date (YYYY-MM-DD)"
+// @Success 200 {object} FxRateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /fx-rates/at [get]
+func (h *handler) GetRateAt(c *gin.Context) {
+	query := request.GetFxRateAtQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "fxrate",
+		"method":  "GetRateAt",
+		"query":   query,
+	})
+
+	rate, err := h.controller.FxRate.GetRateAt(query.SourceCurrency, query.TargetCurrency, query.ParsedDate())
+	if err != nil {
+		if errors.Is(err, ctrlfxrate.ErrRateNotFound) {
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, query, ""))
+			return
+		}
+
+		l.Error(err, "failed to get fx rate")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToFxRate(rate), nil, nil, nil, ""))
+}
+
+// Backfill godoc
+// @Summary Backfill the fx rate history
+// @Description Fetch the missing daily rates of a date range from the providers in the background, the result is logged once done
+// @id backfillFxRates
+// @Tags FxRate
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body BackfillFxRatesRequest true "Body"
+// @Success 202 {object} MessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /fx-rates/backfill [post]
+func (h *handler) Backfill(c *gin.Context) {
+	input := request.BackfillFxRatesRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "fxrate",
+		"method":  "Backfill",
+		"request": input,
+	})
+
+	pairs := make([]ctrlfxrate.Pair, 0, len(input.Pairs))
+	for _, p := range input.Pairs {
+		pair, err := ctrlfxrate.ParsePair(p)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+		pairs = append(pairs, pair)
+	}
+
+	err := h.controller.FxRate.StartBackfill(ctrlfxrate.BackfillInput{
+		From:    input.FromDate(),
+		To:      input.ToDate(),
+		Pairs:   pairs,
+		Sources: model.ToFxRateSources(input.Sources),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ctrlfxrate.ErrInvalidDateRange), errors.Is(err, ctrlfxrate.ErrDateRangeTooLarge):
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		case errors.Is(err, ctrlfxrate.ErrBackfillRunning):
+			c.JSON(http.StatusConflict, view.CreateResponse[any](nil, nil, err, input, ""))
+		default:
+			l.Error(err, "failed to start fx rates backfill")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, view.CreateResponse[any](nil, nil, nil, nil, "backfill started"))
+}
+
+// SetManualRate godoc
+// @Summary Set a manual fx rate
+// @Description Record a rate entered by hand for a pair and a date
+// @id setManualFxRate
+// @Tags FxRate
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body SetManualFxRateRequest true "Body"
+// @Success 200 {object} FxRateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /fx-rates/manual [post]
+func (h *handler) SetManualRate(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.SetManualFxRateRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "fxrate",
+		"method":  "SetManualRate",
+		"request": input,
+	})
+
+	var createdBy *model.UUID
+	if id, err := model.UUIDFromString(userID); err == nil {
+		createdBy = &id
+	}
+
+	rate, err := h.controller.FxRate.SetManualRate(ctrlfxrate.ManualRateInput{
+		Date:           input.ParsedDate(),
+		SourceCurrency: input.SourceCurrency,
+		TargetCurrency: input.TargetCurrency,
+		Rate:           input.Rate,
+		Note:           input.Note,
+		CreatedBy:      createdBy,
+	})
+	if err != nil {
+		if errors.Is(err, ctrlfxrate.ErrInvalidRate) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+
+		l.Error(err, "failed to set manual fx rate")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToFxRate(rate), nil, nil, nil, ""))
+}
+
+// GetTransactionRate godoc
+// @Summary Audit the rate applied on a transaction
+// @Description Compare the rate applied on a transaction with the historical rate of its date
+// @id getFxTransactionRate
+// @Tags FxRate
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param type path string true "Transaction type: invoice, accounting-transaction, inbound-fund-transaction"
+// @Param id path string true "Transaction ID"
+// @Success 200 {object} FxTransactionRateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /fx-rates/transactions/{type}/{id} [get]
+func (h *handler) GetTransactionRate(c *gin.Context) {
+	txType := model.FxTransactionType(c.Param("type"))
+	if !txType.IsValid() {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTransactionType, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTransactionID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "fxrate",
+		"method":  "GetTransactionRate",
+		"type":    txType,
+		"id":      id,
+	})
+
+	rate, err := h.controller.FxRate.GetTransactionRate(txType, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, ctrlfxrate.ErrTransactionNotFound), errors.Is(err, ctrlfxrate.ErrRateNotFound):
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, nil, ""))
+		case errors.Is(err, ctrlfxrate.ErrTransactionMissingRate), errors.Is(err, ctrlfxrate.ErrTransactionMissingDates):
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		default:
+			l.Error(err, "failed to get transaction rate")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToFxTransactionRate(rate), nil, nil, nil, ""))
+}
+
+// Sync godoc
+// @Summary Snapshot the daily fx rates
+// @Description Snapshot today's rates of every tracked pair from every provider
+// @id syncFxRates
+// @Tags FxRate
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} MessageResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/sync-fx-rates [post]
+func (h *handler) Sync(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "fxrate",
+		"method":  "Sync",
+	})
+
+	stored, err := h.controller.FxRate.Sync(time.Now())
+	if err != nil {
+		l.Error(err, "failed to sync fx rates")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l.Infof("synced %d fx rates", stored)
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
@@ -0,0 +1,12 @@
+package fxrate
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Backfill(c *gin.Context)
+	GetRateAt(c *gin.Context)
+	GetTransactionRate(c *gin.Context)
+	List(c *gin.Context)
+	SetManualRate(c *gin.Context)
+	Sync(c *gin.Context)
+}
@@ -0,0 +1,124 @@
+package request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/fxrate/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+const dateLayout = "2006-01-02"
+
+type ListFxRatesQuery struct {
+	SourceCurrency string `form:"sourceCurrency" json:"sourceCurrency"`
+	TargetCurrency string `form:"targetCurrency" json:"targetCurrency"`
+	Source         string `form:"source" json:"source"`
+	From           string `form:"from" json:"from"`
+	To             string `form:"to" json:"to"`
+} // @name ListFxRatesQuery
+
+func (q *ListFxRatesQuery) Validate() error {
+	if q.Source != "" && !model.FxRateSource(strings.ToLower(q.Source)).IsValid() {
+		return errs.ErrInvalidSource
+	}
+	if _, err := timeutil.ParseOptionalDate(q.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	if _, err := timeutil.ParseOptionalDate(q.To); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+func (q *ListFxRatesQuery) FromDate() *time.Time {
+	d, _ := timeutil.ParseOptionalDate(q.From)
+	return d
+}
+
+func (q *ListFxRatesQuery) ToDate() *time.Time {
+	d, _ := timeutil.ParseOptionalDate(q.To)
+	return d
+}
+
+type GetFxRateAtQuery struct {
+	SourceCurrency string `form:"sourceCurrency" json:"sourceCurrency" binding:"required"`
+	TargetCurrency string `form:"targetCurrency" json:"targetCurrency" binding:"required"`
+	Date           string `form:"date" json:"date" binding:"required"`
+} // @name GetFxRateAtQuery
+
+func (q *GetFxRateAtQuery) Validate() error {
+	if !isCurrencyCode(q.SourceCurrency) || !isCurrencyCode(q.TargetCurrency) {
+		return errs.ErrInvalidCurrency
+	}
+	if _, err := time.Parse(dateLayout, q.Date); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+func (q *GetFxRateAtQuery) ParsedDate() time.Time {
+	d, _ := time.Parse(dateLayout, q.Date)
+	return d
+}
+
+type BackfillFxRatesRequest struct {
+	From    string   `json:"from" binding:"required"`
+	To      string   `json:"to" binding:"required"`
+	Pairs   []string `json:"pairs"`
+	Sources []string `json:"sources"`
+} // @name BackfillFxRatesRequest
+
+func (r *BackfillFxRatesRequest) Validate() error {
+	if _, err := time.Parse(dateLayout, r.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	if _, err := time.Parse(dateLayout, r.To); err != nil {
+		return errs.ErrInvalidDate
+	}
+	for _, s := range r.Sources {
+		if !model.FxRateSource(strings.ToLower(strings.TrimSpace(s))).IsValid() {
+			return errs.ErrInvalidSource
+		}
+	}
+	return nil
+}
+
+func (r *BackfillFxRatesRequest) FromDate() time.Time {
+	d, _ := time.Parse(dateLayout, r.From)
+	return d
+}
+
+func (r *BackfillFxRatesRequest) ToDate() time.Time {
+	d, _ := time.Parse(dateLayout, r.To)
+	return d
+}
+
+type SetManualFxRateRequest struct {
+	Date           string  `json:"date" binding:"required"`
+	SourceCurrency string  `json:"sourceCurrency" binding:"required"`
+	TargetCurrency string  `json:"targetCurrency" binding:"required"`
+	Rate           float64 `json:"rate" binding:"required"`
+	Note           string  `json:"note"`
+} // @name SetManualFxRateRequest
+
+func (r *SetManualFxRateRequest) Validate() error {
+	if !isCurrencyCode(r.SourceCurrency) || !isCurrencyCode(r.TargetCurrency) ||
+		strings.EqualFold(r.SourceCurrency, r.TargetCurrency) {
+		return errs.ErrInvalidCurrency
+	}
+	if _, err := time.Parse(dateLayout, r.Date); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+func (r *SetManualFxRateRequest) ParsedDate() time.Time {
+	d, _ := time.Parse(dateLayout, r.Date)
+	return d
+}
+
+func isCurrencyCode(s string) bool {
+	return len(strings.TrimSpace(s)) == 3
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/handler/auth"
 	"github.com/dwarvesf/fortress-api/pkg/handler/bankaccount"
 	"github.com/dwarvesf/fortress-api/pkg/handler/brainerylogs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/capacity"
+	"github.com/dwarvesf/fortress-api/pkg/handler/cashflow"
 	"github.com/dwarvesf/fortress-api/pkg/handler/client"
+	"github.com/dwarvesf/fortress-api/pkg/handler/clientportal"
 	"github.com/dwarvesf/fortress-api/pkg/handler/communitynft"
 	"github.com/dwarvesf/fortress-api/pkg/handler/companyinfo"
+	"github.com/dwarvesf/fortress-api/pkg/handler/contract"
 	"github.com/dwarvesf/fortress-api/pkg/handler/contractorpayables"
 	"github.com/dwarvesf/fortress-api/pkg/handler/conversionrate"
 	"github.com/dwarvesf/fortress-api/pkg/handler/dashboard"
@@ -23,20 +27,35 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/handler/employee"
 	"github.com/dwarvesf/fortress-api/pkg/handler/engagement"
 	"github.com/dwarvesf/fortress-api/pkg/handler/feedback"
+	"github.com/dwarvesf/fortress-api/pkg/handler/fxrate"
 	"github.com/dwarvesf/fortress-api/pkg/handler/healthz"
 	"github.com/dwarvesf/fortress-api/pkg/handler/icy"
+	"github.com/dwarvesf/fortress-api/pkg/handler/icyreward"
 	"github.com/dwarvesf/fortress-api/pkg/handler/invoice"
 	handlerinvoiceemail "github.com/dwarvesf/fortress-api/pkg/handler/invoiceemail"
+	"github.com/dwarvesf/fortress-api/pkg/handler/knowledgebase"
+	"github.com/dwarvesf/fortress-api/pkg/handler/ledger"
 	"github.com/dwarvesf/fortress-api/pkg/handler/memologs"
 	"github.com/dwarvesf/fortress-api/pkg/handler/metadata"
 	"github.com/dwarvesf/fortress-api/pkg/handler/metrics"
 	"github.com/dwarvesf/fortress-api/pkg/handler/news"
 	"github.com/dwarvesf/fortress-api/pkg/handler/notion"
 	"github.com/dwarvesf/fortress-api/pkg/handler/notify"
+	"github.com/dwarvesf/fortress-api/pkg/handler/officecheckin"
+	"github.com/dwarvesf/fortress-api/pkg/handler/operationalservice"
 	"github.com/dwarvesf/fortress-api/pkg/handler/payroll"
 	"github.com/dwarvesf/fortress-api/pkg/handler/profile"
+	"github.com/dwarvesf/fortress-api/pkg/handler/profitability"
 	"github.com/dwarvesf/fortress-api/pkg/handler/project"
+	"github.com/dwarvesf/fortress-api/pkg/handler/projectbudget"
+	"github.com/dwarvesf/fortress-api/pkg/handler/reconciliation"
+	"github.com/dwarvesf/fortress-api/pkg/handler/recruitment"
+	"github.com/dwarvesf/fortress-api/pkg/handler/referral"
+	"github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle"
+	"github.com/dwarvesf/fortress-api/pkg/handler/staffing"
 	"github.com/dwarvesf/fortress-api/pkg/handler/survey"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveyanalytics"
+	"github.com/dwarvesf/fortress-api/pkg/handler/timesheet"
 	"github.com/dwarvesf/fortress-api/pkg/handler/valuation"
 	"github.com/dwarvesf/fortress-api/pkg/handler/vault"
 	"github.com/dwarvesf/fortress-api/pkg/handler/webhook"
@@ -55,8 +74,12 @@ type Handler struct {
 	Auth               auth.IHandler
 	BankAccount        bankaccount.IHandler
 	BraineryLog        brainerylogs.IHandler
+	Capacity           capacity.IHandler
+	CashFlow           cashflow.IHandler
 	Client             client.IHandler
+	ClientPortal       clientportal.IHandler
 	CompanyInfo        companyinfo.IHandler
+	Contract           contract.IHandler
 	ContractorPayables contractorpayables.IHandler
 	ConversionRate     conversionrate.IHandler
 	Dashboard          dashboard.IHandler
@@ -65,20 +88,35 @@ type Handler struct {
 	Employee           employee.IHandler
 	Engagement         engagement.IHandler
 	Feedback           feedback.IHandler
+	FxRate             fxrate.IHandler
 	Healthcheck        healthz.IHandler
 	Invoice            invoice.IHandler
+	KnowledgeBase      knowledgebase.IHandler
+	Ledger             ledger.IHandler
 	MemoLog            memologs.IHandler
 	Metadata           metadata.IHandler
 	Metrics            metrics.IMetricsHandler
 	Notion             notion.IHandler
+	OperationalService operationalservice.IHandler
 	Payroll            payroll.IHandler
 	Profile            profile.IHandler
+	Profitability      profitability.IHandler
 	Project            project.IHandler
+	ProjectBudget      projectbudget.IHandler
+	Reconciliation     reconciliation.IHandler
+	Recruitment        recruitment.IHandler
+	Referral           referral.IHandler
+	ReviewCycle        reviewcycle.IHandler
+	Staffing           staffing.IHandler
 	Survey             survey.IHandler
+	SurveyAnalytics    surveyanalytics.IHandler
+	Timesheet          timesheet.IHandler
 	Valuation          valuation.IHandler
 	Webhook            webhook.IHandler
 	Vault              vault.IHandler
 	Icy                icy.IHandler
+	IcyReward          icyreward.IHandler
+	OfficeCheckin      officecheckin.IHandler
 	CommunityNft       communitynft.IHandler
 	Earn               earn.IHandler
 	News               news.IHandler
@@ -92,13 +130,17 @@ type Handler struct {
 func New(store *store.Store, repo store.DBRepo, service *service.Service, ctrl *controller.Controller, worker *worker.Worker, logger logger.Logger, cfg *config.Config) *Handler {
 	return &Handler{
 		Accounting:         accounting.New(store, repo, service, logger, cfg),
-		Asset:              asset.New(store, repo, service, logger, cfg),
+		Asset:              asset.New(ctrl, store, repo, service, logger, cfg),
 		Audit:              audit.New(store, repo, service, logger, cfg),
 		Auth:               auth.New(ctrl, logger, cfg),
 		BankAccount:        bankaccount.New(store, repo, service, logger, cfg),
 		BraineryLog:        brainerylogs.New(ctrl, store, repo, service, logger, cfg),
+		Capacity:           capacity.New(ctrl, store, repo, service, logger, cfg),
+		CashFlow:           cashflow.New(ctrl, store, repo, service, logger, cfg),
 		Client:             client.New(ctrl, store, repo, service, logger, cfg),
+		ClientPortal:       clientportal.New(ctrl, store, repo, service, logger, cfg),
 		CompanyInfo:        companyinfo.New(ctrl, store, repo, service, logger, cfg),
+		Contract:           contract.New(ctrl, store, repo, service, logger, cfg),
 		ContractorPayables: contractorpayables.New(ctrl.ContractorPayables, service, logger, cfg),
 		ConversionRate:     conversionrate.New(ctrl, store, repo, service, logger, cfg),
 		Dashboard:          dashboard.New(store, repo, service, logger, cfg, util.New()),
@@ -107,20 +149,35 @@ func New(store *store.Store, repo store.DBRepo, service *service.Service, ctrl *
 		Employee:           employee.New(ctrl, store, repo, service, logger, cfg),
 		Engagement:         engagement.New(ctrl, store, repo, service, logger, cfg),
 		Feedback:           feedback.New(store, repo, service, logger, cfg),
+		FxRate:             fxrate.New(ctrl, store, repo, service, logger, cfg),
 		Healthcheck:        healthz.New(),
 		Invoice:            invoice.New(ctrl, store, repo, service, worker, logger, cfg),
+		KnowledgeBase:      knowledgebase.New(ctrl, store, repo, service, logger, cfg),
+		Ledger:             ledger.New(ctrl, store, repo, service, logger, cfg),
 		MemoLog:            memologs.New(ctrl, store, repo, service, logger, cfg),
 		Metadata:           metadata.New(store, repo, service, logger, cfg),
 		Metrics:            metrics.New(),
 		Notion:             notion.New(store, repo, service, logger, cfg),
+		OperationalService: operationalservice.New(ctrl, store, repo, service, logger, cfg),
 		Payroll:            payroll.New(ctrl, store, repo, service, worker, logger, cfg),
 		Profile:            profile.New(ctrl, store, repo, service, logger, cfg),
+		Profitability:      profitability.New(ctrl, store, repo, service, logger, cfg),
 		Project:            project.New(ctrl, store, repo, service, logger, cfg),
+		ProjectBudget:      projectbudget.New(ctrl, store, repo, service, logger, cfg),
+		Reconciliation:     reconciliation.New(ctrl, store, repo, service, logger, cfg),
+		Recruitment:        recruitment.New(ctrl, store, repo, service, logger, cfg),
+		Referral:           referral.New(ctrl, store, repo, service, logger, cfg),
+		ReviewCycle:        reviewcycle.New(ctrl, store, repo, service, logger, cfg),
+		Staffing:           staffing.New(ctrl, store, repo, service, logger, cfg),
 		Survey:             survey.New(store, repo, service, logger, cfg),
+		SurveyAnalytics:    surveyanalytics.New(ctrl, store, repo, service, logger, cfg),
+		Timesheet:          timesheet.New(ctrl, store, repo, service, logger, cfg),
 		Valuation:          valuation.New(store, repo, service, logger, cfg),
 		Webhook:            webhook.New(ctrl, store, repo, service, logger, cfg, worker),
-		Vault:              vault.New(store, repo, service, logger, cfg),
+		Vault:              vault.New(ctrl, store, repo, service, logger, cfg),
 		Icy:                icy.New(ctrl, logger),
+		IcyReward:          icyreward.New(ctrl, store, repo, service, logger, cfg),
+		OfficeCheckin:      officecheckin.New(ctrl, store, repo, service, logger, cfg),
 		CommunityNft:       communitynft.New(ctrl, store, repo, service, logger, cfg),
 		Earn:               earn.New(ctrl, store, repo, service, logger, cfg),
 		News:               news.New(store, repo, ctrl, logger, cfg),
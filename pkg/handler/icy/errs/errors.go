@@ -0,0 +1,38 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/icy"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidSnapshotID = errors.New("invalid snapshot id")
+	ErrInvalidDate       = errors.New("invalid date, expected YYYY-MM-DD")
+)
+
+// ConvertControllerErr writes the status of an icy controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, icy.ErrSnapshotNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, icy.ErrInvalidRange):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -2,11 +2,16 @@ package icy
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/dwarvesf/fortress-api/pkg/controller"
+	"github.com/dwarvesf/fortress-api/pkg/handler/icy/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/icy/request"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
 	"github.com/dwarvesf/fortress-api/pkg/view"
 )
 
@@ -40,3 +45,110 @@ func (h *handler) Accounting(c *gin.Context) {
 
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyAccounting(accounting), nil, nil, nil, ""))
 }
+
+// SnapshotTreasury godoc
+// @Summary Snapshot the ICY treasury
+// @Description Store the ICY supply, the locked ICY, the icyswap fund and the Mochi vault balances of the day, and reconcile the Mochi ledger with the custody balance since the previous snapshot. A day is snapshotted once.
+// @id snapshotIcyTreasury
+// @Tags Icy
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} IcyTreasurySnapshotResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/icy-treasury-snapshots [post]
+func (h *handler) SnapshotTreasury(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icy",
+		"method":  "SnapshotTreasury",
+	})
+
+	snapshot, err := h.controller.Icy.Snapshot(time.Now())
+	if err != nil {
+		l.Error(err, "failed to snapshot icy treasury")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyTreasurySnapshotDetail(snapshot), nil, nil, nil, ""))
+}
+
+// GetTreasurySnapshot godoc
+// @Summary Get an ICY treasury snapshot
+// @Description Get a snapshot of the ICY treasury with the balances of the Mochi vaults
+// @id getIcyTreasurySnapshot
+// @Tags Icy
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Snapshot ID"
+// @Success 200 {object} IcyTreasurySnapshotResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-treasury/snapshots/{id} [get]
+func (h *handler) GetTreasurySnapshot(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSnapshotID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icy",
+		"method":  "GetTreasurySnapshot",
+		"id":      id,
+	})
+
+	snapshot, err := h.controller.Icy.GetSnapshot(id)
+	if err != nil {
+		l.Error(err, "failed to get icy treasury snapshot")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyTreasurySnapshotDetail(snapshot), nil, nil, nil, ""))
+}
+
+// TreasuryReconciliation godoc
+// @Summary Get the ICY treasury reconciliation
+// @Description Get the daily snapshots of the ICY treasury in a range, oldest first, with the drift between the Mochi ledger and the on-chain custody balance
+// @id getIcyTreasuryReconciliation
+// @Tags Icy
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param from query string false "YYYY-MM-DD"
+// @Param to query string false "YYYY-MM-DD"
+// @Success 200 {object} IcyTreasuryReconciliationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-treasury/reconciliation [get]
+func (h *handler) TreasuryReconciliation(c *gin.Context) {
+	query := request.IcyTreasuryReconciliationQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icy",
+		"method":  "TreasuryReconciliation",
+		"query":   query,
+	})
+
+	from, _ := timeutil.ParseOptionalDate(query.From)
+	to, _ := timeutil.ParseOptionalDate(query.To)
+	rs, err := h.controller.Icy.Reconciliation(from, to)
+	if err != nil {
+		l.Error(err, "failed to get icy treasury reconciliation")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyTreasuryReconciliation(rs), nil, nil, nil, ""))
+}
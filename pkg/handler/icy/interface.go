@@ -4,4 +4,7 @@ import "github.com/gin-gonic/gin"
 
 type IHandler interface {
 	Accounting(c *gin.Context)
+	GetTreasurySnapshot(c *gin.Context)
+	SnapshotTreasury(c *gin.Context)
+	TreasuryReconciliation(c *gin.Context)
 }
@@ -0,0 +1,20 @@
+package request
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/icy/errs"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+type IcyTreasuryReconciliationQuery struct {
+	From string `form:"from" json:"from"` // YYYY-MM-DD
+	To   string `form:"to" json:"to"`     // YYYY-MM-DD
+} // @name IcyTreasuryReconciliationQuery
+
+func (q *IcyTreasuryReconciliationQuery) Validate() error {
+	for _, d := range []string{q.From, q.To} {
+		if _, err := timeutil.ParseOptionalDate(d); err != nil {
+			return errs.ErrInvalidDate
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidRuleID     = errors.New("invalid rule id")
+	ErrInvalidPayoutID   = errors.New("invalid payout id")
+	ErrInvalidEmployeeID = errors.New("invalid employee id")
+	ErrInvalidStatus     = errors.New("invalid payout status")
+	ErrInvalidTime       = errors.New("invalid time, expected RFC3339")
+	ErrInvalidMonth      = errors.New("invalid month, expected YYYY-MM")
+)
+
+// ConvertControllerErr writes the status of an icy reward controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, icyreward.ErrRuleNotFound),
+		errors.Is(err, icyreward.ErrNoActiveRule),
+		errors.Is(err, icyreward.ErrPayoutNotFound),
+		errors.Is(err, icyreward.ErrEmployeeNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, icyreward.ErrInvalidTrigger),
+		errors.Is(err, icyreward.ErrTriggerExists),
+		errors.Is(err, icyreward.ErrInvalidCategory),
+		errors.Is(err, icyreward.ErrInvalidAmount),
+		errors.Is(err, icyreward.ErrInvalidCap),
+		errors.Is(err, icyreward.ErrInvalidCapPeriod),
+		errors.Is(err, icyreward.ErrInvalidBudget),
+		errors.Is(err, icyreward.ErrInvalidThreshold),
+		errors.Is(err, icyreward.ErrEmployeeRequired),
+		errors.Is(err, icyreward.ErrPayoutNotReviewable),
+		errors.Is(err, icyreward.ErrRejectReasonRequired),
+		errors.Is(err, icyreward.ErrOwnPayout),
+		errors.Is(err, icyreward.ErrInvalidStatus):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
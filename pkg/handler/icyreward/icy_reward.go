@@ -0,0 +1,396 @@
+package icyreward
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlicyreward "github.com/dwarvesf/fortress-api/pkg/controller/icyreward"
+	"github.com/dwarvesf/fortress-api/pkg/handler/icyreward/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/icyreward/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// ListRules godoc
+// @Summary Get the ICY reward rules
+// @Description Get the rules paying ICY for the events, with their caps and budgets
+// @id getListIcyRewardRules
+// @Tags IcyReward
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} IcyRewardRulesResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-rewards/rules [get]
+func (h *handler) ListRules(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icyreward",
+		"method":  "ListRules",
+	})
+
+	rules, err := h.controller.IcyReward.ListRules()
+	if err != nil {
+		l.Error(err, "failed to list icy reward rules")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyRewardRules(rules), nil, nil, nil, ""))
+}
+
+// CreateRule godoc
+// @Summary Create an ICY reward rule
+// @Description Create the rule paying ICY for a trigger, a trigger has one rule
+// @id createIcyRewardRule
+// @Tags IcyReward
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body IcyRewardRuleRequest true "Body"
+// @Success 200 {object} IcyRewardRuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-rewards/rules [post]
+func (h *handler) CreateRule(c *gin.Context) {
+	body := request.IcyRewardRuleRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icyreward",
+		"method":  "CreateRule",
+		"body":    body,
+	})
+
+	rule, err := h.controller.IcyReward.CreateRule(toRuleInput(body))
+	if err != nil {
+		l.Error(err, "failed to create icy reward rule")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyRewardRule(rule), nil, nil, nil, ""))
+}
+
+// UpdateRule godoc
+// @Summary Update an ICY reward rule
+// @Description Update a rule, the payouts made keep their amount
+// @id updateIcyRewardRule
+// @Tags IcyReward
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Param Body body IcyRewardRuleRequest true "Body"
+// @Success 200 {object} IcyRewardRuleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-rewards/rules/{id} [put]
+func (h *handler) UpdateRule(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidRuleID, nil, ""))
+		return
+	}
+
+	body := request.IcyRewardRuleRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icyreward",
+		"method":  "UpdateRule",
+		"id":      id,
+		"body":    body,
+	})
+
+	rule, err := h.controller.IcyReward.UpdateRule(id, toRuleInput(body))
+	if err != nil {
+		l.Error(err, "failed to update icy reward rule")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyRewardRule(rule), nil, nil, nil, ""))
+}
+
+// Trigger godoc
+// @Summary Reward an event
+// @Description Reward an event by the active rule of its trigger. An event is rewarded once, sending it again returns its payout. The payout is skipped over the cap or the budgets and waits for a review from the review threshold.
+// @id triggerIcyReward
+// @Tags IcyReward
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body TriggerIcyRewardRequest true "Body"
+// @Success 200 {object} IcyRewardTriggeredResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-rewards/events [post]
+func (h *handler) Trigger(c *gin.Context) {
+	body := request.TriggerIcyRewardRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+	if err := body.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icyreward",
+		"method":  "Trigger",
+		"body":    body,
+	})
+
+	occurredAt, _ := request.ParseTime(body.OccurredAt)
+	payout, created, err := h.controller.IcyReward.Trigger(ctrlicyreward.TriggerInput{
+		Trigger:    model.IcyRewardTrigger(body.Trigger),
+		EmployeeID: body.EmployeeID,
+		DiscordID:  body.DiscordID,
+		Reference:  body.Reference,
+		OccurredAt: occurredAt,
+	})
+	if err != nil {
+		l.Error(err, "failed to trigger icy reward")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyRewardTriggered(payout, created), nil, nil, nil, ""))
+}
+
+// ListPayouts godoc
+// @Summary Get the ICY reward payouts
+// @Description Get the payouts of the rewards, latest event first. The ones pending-review make the review queue.
+// @id getListIcyRewardPayouts
+// @Tags IcyReward
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param status query string false "pending-review, processing, paid, rejected, skipped or failed"
+// @Param ruleID query string false "Rule ID"
+// @Param employeeID query string false "Employee ID"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} IcyRewardPayoutsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-rewards/payouts [get]
+func (h *handler) ListPayouts(c *gin.Context) {
+	query := request.ListIcyRewardPayoutsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icyreward",
+		"method":  "ListPayouts",
+		"query":   query,
+	})
+
+	payouts, total, err := h.controller.IcyReward.ListPayouts(ctrlicyreward.ListPayoutsInput{
+		Status:     model.IcyRewardPayoutStatus(query.Status),
+		RuleID:     query.RuleID,
+		EmployeeID: query.EmployeeID,
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list icy reward payouts")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyRewardPayouts(payouts),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// Approve godoc
+// @Summary Approve an ICY reward payout
+// @Description Send a payout waiting for a review, or send a failed one again
+// @id approveIcyRewardPayout
+// @Tags IcyReward
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Payout ID"
+// @Success 200 {object} IcyRewardPayoutResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-rewards/payouts/{id}/approve [post]
+func (h *handler) Approve(c *gin.Context) {
+	id, reviewerID, ok := h.reviewParams(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icyreward",
+		"method":  "Approve",
+		"id":      id,
+	})
+
+	payout, err := h.controller.IcyReward.Approve(id, reviewerID)
+	if err != nil {
+		l.Error(err, "failed to approve icy reward payout")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyRewardPayout(payout), nil, nil, nil, ""))
+}
+
+// Reject godoc
+// @Summary Reject an ICY reward payout
+// @Description Drop a payout waiting for a review or a retry
+// @id rejectIcyRewardPayout
+// @Tags IcyReward
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Payout ID"
+// @Param Body body RejectIcyRewardPayoutRequest true "Body"
+// @Success 200 {object} IcyRewardPayoutResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-rewards/payouts/{id}/reject [post]
+func (h *handler) Reject(c *gin.Context) {
+	id, reviewerID, ok := h.reviewParams(c)
+	if !ok {
+		return
+	}
+
+	body := request.RejectIcyRewardPayoutRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icyreward",
+		"method":  "Reject",
+		"id":      id,
+	})
+
+	payout, err := h.controller.IcyReward.Reject(id, reviewerID, body.Reason)
+	if err != nil {
+		l.Error(err, "failed to reject icy reward payout")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyRewardPayout(payout), nil, nil, nil, ""))
+}
+
+// Budget godoc
+// @Summary Get the ICY reward budget of a month
+// @Description Get what each rule paid and holds in review over a month against the budgets
+// @id getIcyRewardBudget
+// @Tags IcyReward
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param month query string false "YYYY-MM, the current month when empty"
+// @Success 200 {object} IcyRewardBudgetResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /icy-rewards/budget [get]
+func (h *handler) Budget(c *gin.Context) {
+	query := request.IcyRewardBudgetQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	month, err := query.ParseMonth()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "icyreward",
+		"method":  "Budget",
+		"month":   query.Month,
+	})
+
+	report, err := h.controller.IcyReward.Budget(month)
+	if err != nil {
+		l.Error(err, "failed to get icy reward budget")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToIcyRewardBudget(report), nil, nil, nil, ""))
+}
+
+func (h *handler) reviewParams(c *gin.Context) (string, string, bool) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidPayoutID, nil, ""))
+		return "", "", false
+	}
+
+	reviewerID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return "", "", false
+	}
+
+	return id, reviewerID, true
+}
+
+func toRuleInput(body request.IcyRewardRuleRequest) ctrlicyreward.RuleInput {
+	return ctrlicyreward.RuleInput{
+		Trigger:         model.IcyRewardTrigger(body.Trigger),
+		Name:            body.Name,
+		Category:        body.Category,
+		Amount:          body.Amount,
+		CapPerPeriod:    body.CapPerPeriod,
+		CapPeriod:       model.IcyRewardPeriod(body.CapPeriod),
+		MonthlyBudget:   body.MonthlyBudget,
+		ReviewThreshold: body.ReviewThreshold,
+		IsActive:        body.IsActive,
+	}
+}
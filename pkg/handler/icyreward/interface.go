@@ -0,0 +1,14 @@
+package icyreward
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Approve(c *gin.Context)
+	Budget(c *gin.Context)
+	CreateRule(c *gin.Context)
+	ListPayouts(c *gin.Context)
+	ListRules(c *gin.Context)
+	Reject(c *gin.Context)
+	Trigger(c *gin.Context)
+	UpdateRule(c *gin.Context)
+}
@@ -0,0 +1,91 @@
+package request
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/icyreward/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+const monthLayout = "2006-01"
+
+type IcyRewardRuleRequest struct {
+	Trigger         string           `json:"trigger" binding:"required"` // office-check-in, memo-published, ogif-talk, brainery-log or delivery-leaderboard
+	Name            string           `json:"name" binding:"max=200"`
+	Category        string           `json:"category" binding:"required"` // learning, community, delivery or tooling
+	Amount          decimal.Decimal  `json:"amount"`
+	CapPerPeriod    int              `json:"capPerPeriod"`    // payouts per employee over the cap period, 0 for no cap
+	CapPeriod       string           `json:"capPeriod"`       // day, week or month, month when empty
+	MonthlyBudget   *decimal.Decimal `json:"monthlyBudget"`   // no budget when empty
+	ReviewThreshold *decimal.Decimal `json:"reviewThreshold"` // the configured one when empty
+	IsActive        bool             `json:"isActive"`
+} // @name IcyRewardRuleRequest
+
+type TriggerIcyRewardRequest struct {
+	Trigger    string `json:"trigger" binding:"required"`
+	EmployeeID string `json:"employeeID"`
+	DiscordID  string `json:"discordID"`  // when the employee id is not known
+	Reference  string `json:"reference"`  // the event among the ones of the employee, the day when empty
+	OccurredAt string `json:"occurredAt"` // RFC3339, now when empty
+} // @name TriggerIcyRewardRequest
+
+func (r *TriggerIcyRewardRequest) Validate() error {
+	if r.EmployeeID != "" && !model.IsUUIDFromString(r.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if _, err := ParseTime(r.OccurredAt); err != nil {
+		return errs.ErrInvalidTime
+	}
+	return nil
+}
+
+type ListIcyRewardPayoutsQuery struct {
+	model.Pagination
+
+	Status     string `form:"status" json:"status"` // pending-review, processing, paid, rejected, skipped or failed
+	RuleID     string `form:"ruleID" json:"ruleID"`
+	EmployeeID string `form:"employeeID" json:"employeeID"`
+} // @name ListIcyRewardPayoutsQuery
+
+func (q *ListIcyRewardPayoutsQuery) Validate() error {
+	if q.Status != "" && !model.IcyRewardPayoutStatus(q.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	if q.RuleID != "" && !model.IsUUIDFromString(q.RuleID) {
+		return errs.ErrInvalidRuleID
+	}
+	if q.EmployeeID != "" && !model.IsUUIDFromString(q.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	return nil
+}
+
+type RejectIcyRewardPayoutRequest struct {
+	Reason string `json:"reason" binding:"required"`
+} // @name RejectIcyRewardPayoutRequest
+
+type IcyRewardBudgetQuery struct {
+	Month string `form:"month" json:"month"` // YYYY-MM, the current month when empty
+} // @name IcyRewardBudgetQuery
+
+// ParseMonth returns the first day of the month, of the current one when empty
+func (q *IcyRewardBudgetQuery) ParseMonth() (time.Time, error) {
+	if q.Month == "" {
+		return time.Now(), nil
+	}
+	m, err := time.Parse(monthLayout, q.Month)
+	if err != nil {
+		return time.Time{}, errs.ErrInvalidMonth
+	}
+	return m, nil
+}
+
+// ParseTime parses an RFC3339 time, zero when empty
+func ParseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
 	"github.com/dwarvesf/fortress-api/pkg/controller"
+	contractCtrl "github.com/dwarvesf/fortress-api/pkg/controller/contract"
 	invoiceCtrl "github.com/dwarvesf/fortress-api/pkg/controller/invoice"
 	"github.com/dwarvesf/fortress-api/pkg/handler/invoice/errs"
 	"github.com/dwarvesf/fortress-api/pkg/handler/invoice/request"
@@ -284,6 +285,17 @@ func (h *handler) Send(c *gin.Context) {
 	l.Debugf("Invoice totals - Server-calculated: SubTotal=%.2f Total=%.2f | Expected Total=%.2f",
 		iv.SubTotal, iv.Total, iv.SubTotal+iv.Tax-iv.Discount)
 
+	// the line items must follow the rate card and the terms of the contract of the project
+	if err := h.controller.Contract.ValidateInvoice(iv); err != nil {
+		l.Error(err, "failed to validate invoice against the contract")
+		if errors.Is(err, contractCtrl.ErrInvoiceViolatesContract) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
 	_, err = h.controller.Invoice.Send(iv)
 	if err != nil {
 		l.Error(err, "failed to send invoice")
@@ -303,6 +315,11 @@ func (h *handler) Send(c *gin.Context) {
 		l.Error(err, "failed to log to discord")
 	}
 
+	// bill the approved timesheet hours of the month on the invoice and lock them
+	if _, err := h.controller.Timesheet.LockInvoiced(iv); err != nil {
+		l.Error(err, "failed to lock invoiced timesheets")
+	}
+
 	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
 }
 
@@ -0,0 +1,36 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/knowledgebase"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidDate = errors.New("invalid date, expected YYYY-MM-DD")
+)
+
+// ConvertControllerErr writes the status of a knowledge base controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, knowledgebase.ErrEmptyKeyword),
+		errors.Is(err, knowledgebase.ErrInvalidSource),
+		errors.Is(err, knowledgebase.ErrInvalidDateRange):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,8 @@
+package knowledgebase
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Reindex(c *gin.Context)
+	Search(c *gin.Context)
+}
@@ -0,0 +1,125 @@
+package knowledgebase
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlknowledgebase "github.com/dwarvesf/fortress-api/pkg/controller/knowledgebase"
+	"github.com/dwarvesf/fortress-api/pkg/handler/knowledgebase/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/knowledgebase/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// Search godoc
+// @Summary Search the knowledge base
+// @Description Full-text search over the memos, brainery logs and OGIF summaries, ranked with the matches highlighted
+// @id searchKnowledgeBase
+// @Tags KnowledgeBase
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param keyword query string true "Search query, quoted phrases, OR and - to exclude a word"
+// @Param source query string false "Source: memo, brainery or ogif"
+// @Param author query string false "GitHub, Discord or memo username of an author"
+// @Param tag query string false "Tag"
+// @Param from query string false "Published from, YYYY-MM-DD"
+// @Param to query string false "Published to, YYYY-MM-DD"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} KnowledgeSearchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /knowledge-base/search [get]
+func (h *handler) Search(c *gin.Context) {
+	query := request.SearchKnowledgeBaseQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "knowledgebase",
+		"method":  "Search",
+		"query":   query,
+	})
+
+	from, _ := timeutil.ParseOptionalDate(query.From)
+	to, _ := timeutil.ParseOptionalDate(query.To)
+	results, total, err := h.controller.KnowledgeBase.Search(ctrlknowledgebase.SearchInput{
+		Keyword: query.Keyword,
+		Source:  model.KnowledgeSource(query.Source),
+		Author:  query.Author,
+		Tag:     query.Tag,
+		From:    from,
+		To:      to,
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to search the knowledge base")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToKnowledgeSearchResults(results),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// Reindex godoc
+// @Summary Reindex the knowledge base
+// @Description Index the memos and brainery logs missing from the knowledge base, and remove the deleted ones
+// @id reindexKnowledgeBase
+// @Tags KnowledgeBase
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} KnowledgeIndexResultsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /knowledge-base/reindex [post]
+func (h *handler) Reindex(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "knowledgebase",
+		"method":  "Reindex",
+	})
+
+	results, err := h.controller.KnowledgeBase.Reindex()
+	if err != nil {
+		l.Error(err, "failed to reindex the knowledge base")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToKnowledgeIndexResults(results), nil, nil, nil, ""))
+}
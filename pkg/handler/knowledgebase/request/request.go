@@ -0,0 +1,28 @@
+package request
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/knowledgebase/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+type SearchKnowledgeBaseQuery struct {
+	model.Pagination
+
+	Keyword string `form:"keyword" json:"keyword" binding:"required"` // quoted phrases, OR and - to exclude a word
+	Source  string `form:"source" json:"source"`                      // memo, brainery or ogif
+	Author  string `form:"author" json:"author"`                      // a GitHub, Discord or memo username
+	Tag     string `form:"tag" json:"tag"`
+	From    string `form:"from" json:"from"` // YYYY-MM-DD
+	To      string `form:"to" json:"to"`     // YYYY-MM-DD
+} // @name SearchKnowledgeBaseQuery
+
+func (q *SearchKnowledgeBaseQuery) Validate() error {
+	if _, err := timeutil.ParseOptionalDate(q.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	if _, err := timeutil.ParseOptionalDate(q.To); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
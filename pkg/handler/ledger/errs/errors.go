@@ -0,0 +1,13 @@
+package errs
+
+import "errors"
+
+var (
+	ErrInvalidDate        = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidAccountID   = errors.New("invalid account id")
+	ErrInvalidAccountType = errors.New("invalid account type")
+	ErrInvalidEntryID     = errors.New("invalid journal entry id")
+	ErrInvalidSourceType  = errors.New("invalid journal source type")
+	ErrInvalidAccountCode = errors.New("invalid account code")
+	ErrTooFewLines        = errors.New("journal entry needs at least two lines")
+)
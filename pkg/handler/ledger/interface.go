@@ -0,0 +1,16 @@
+package ledger
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	CreateAccount(c *gin.Context)
+	CreateEntry(c *gin.Context)
+	GetEntry(c *gin.Context)
+	ListAccounts(c *gin.Context)
+	ListEntries(c *gin.Context)
+	PostUnposted(c *gin.Context)
+	ProfitAndLoss(c *gin.Context)
+	ReverseEntry(c *gin.Context)
+	TrialBalance(c *gin.Context)
+	UpdateAccount(c *gin.Context)
+}
@@ -0,0 +1,545 @@
+package ledger
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlledger "github.com/dwarvesf/fortress-api/pkg/controller/ledger"
+	"github.com/dwarvesf/fortress-api/pkg/handler/ledger/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/ledger/request"
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// postUnpostedBatchSize is the number of accounting transactions posted by one cronjob run
+const postUnpostedBatchSize = 500
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// ListAccounts godoc
+// @Summary Get the chart of accounts
+// @Description Get the accounts of the general ledger
+// @id getListLedgerAccounts
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param activeOnly query bool false "Only the active accounts"
+// @Success 200 {object} LedgerAccountsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/accounts [get]
+func (h *handler) ListAccounts(c *gin.Context) {
+	query := request.ListAccountsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "ListAccounts",
+	})
+
+	accounts, err := h.controller.Ledger.ListAccounts(query.ActiveOnly)
+	if err != nil {
+		l.Error(err, "failed to list ledger accounts")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToLedgerAccounts(accounts), nil, nil, nil, ""))
+}
+
+// CreateAccount godoc
+// @Summary Create a ledger account
+// @Description Add an account to the chart of accounts
+// @id createLedgerAccount
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body CreateAccountRequest true "Body"
+// @Success 200 {object} LedgerAccountResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/accounts [post]
+func (h *handler) CreateAccount(c *gin.Context) {
+	input := request.CreateAccountRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "CreateAccount",
+		"request": input,
+	})
+
+	account, err := h.controller.Ledger.CreateAccount(ctrlledger.AccountInput{
+		Code:        input.Code,
+		Name:        input.Name,
+		Type:        model.LedgerAccountType(input.Type),
+		Description: input.Description,
+	})
+	if err != nil {
+		if errors.Is(err, ctrlledger.ErrAccountCodeExists) || errors.Is(err, ctrlledger.ErrInvalidAccountType) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+
+		l.Error(err, "failed to create ledger account")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToLedgerAccount(account), nil, nil, nil, ""))
+}
+
+// UpdateAccount godoc
+// @Summary Update a ledger account
+// @Description Update the name, description or status of a ledger account
+// @id updateLedgerAccount
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Account ID"
+// @Param Body body UpdateAccountRequest true "Body"
+// @Success 200 {object} LedgerAccountResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/accounts/{id} [put]
+func (h *handler) UpdateAccount(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidAccountID, nil, ""))
+		return
+	}
+
+	input := request.UpdateAccountRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "UpdateAccount",
+		"id":      id,
+		"request": input,
+	})
+
+	account, err := h.controller.Ledger.UpdateAccount(id, ctrlledger.UpdateAccountInput{
+		Name:        input.Name,
+		Description: input.Description,
+		IsActive:    input.IsActive,
+	})
+	if err != nil {
+		if errors.Is(err, ctrlledger.ErrAccountNotFound) {
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+
+		l.Error(err, "failed to update ledger account")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToLedgerAccount(account), nil, nil, nil, ""))
+}
+
+// ListEntries godoc
+// @Summary Get the journal entries
+// @Description Get the journal entries, filtered by source, account and date range
+// @id getListJournalEntries
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param sourceType query string false "Source: manual, reversal, payroll, invoice, expense, operation, icy, inbound_fund"
+// @Param accountID query string false "Account ID"
+// @Param from query string false "From date (YYYY-MM-DD)"
+// @Param to query string false "To date (YYYY-MM-DD)"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} JournalEntriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/entries [get]
+func (h *handler) ListEntries(c *gin.Context) {
+	query := request.ListEntriesQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "ListEntries",
+		"query":   query,
+	})
+
+	entries, total, err := h.controller.Ledger.ListEntries(ctrlledger.ListEntriesInput{
+		SourceType: model.JournalSource(query.SourceType),
+		AccountID:  query.AccountID,
+		From:       query.FromDate(),
+		To:         query.ToDate(),
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list journal entries")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToJournalEntries(entries),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// GetEntry godoc
+// @Summary Get a journal entry
+// @Description Get a journal entry with its lines
+// @id getJournalEntry
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Journal entry ID"
+// @Success 200 {object} JournalEntryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/entries/{id} [get]
+func (h *handler) GetEntry(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEntryID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "GetEntry",
+		"id":      id,
+	})
+
+	entry, err := h.controller.Ledger.GetEntry(id)
+	if err != nil {
+		if errors.Is(err, ctrlledger.ErrEntryNotFound) {
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+
+		l.Error(err, "failed to get journal entry")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToJournalEntry(entry), nil, nil, nil, ""))
+}
+
+// CreateEntry godoc
+// @Summary Create a manual journal entry
+// @Description Post a balanced adjustment entry, amounts are in VND
+// @id createJournalEntry
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body CreateEntryRequest true "Body"
+// @Success 200 {object} JournalEntryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/entries [post]
+func (h *handler) CreateEntry(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.CreateEntryRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "CreateEntry",
+		"request": input,
+	})
+
+	lines := make([]ctrlledger.LineInput, 0, len(input.Lines))
+	for _, line := range input.Lines {
+		accountID, _ := model.UUIDFromString(line.AccountID)
+		lines = append(lines, ctrlledger.LineInput{
+			AccountID: accountID,
+			Debit:     line.Debit,
+			Credit:    line.Credit,
+			Memo:      line.Memo,
+		})
+	}
+
+	entry, err := h.controller.Ledger.CreateManualEntry(ctrlledger.ManualEntryInput{
+		Date:        input.ParsedDate(),
+		Description: input.Description,
+		Lines:       lines,
+		CreatedBy:   toUUIDPtr(userID),
+	})
+	if err != nil {
+		if isEntryValidationError(err) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+
+		l.Error(err, "failed to create journal entry")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToJournalEntry(entry), nil, nil, nil, ""))
+}
+
+// ReverseEntry godoc
+// @Summary Reverse a journal entry
+// @Description Post the entry cancelling a journal entry, posted entries are never edited
+// @id reverseJournalEntry
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Journal entry ID"
+// @Param Body body ReverseEntryRequest false "Body"
+// @Success 200 {object} JournalEntryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/entries/{id}/reverse [post]
+func (h *handler) ReverseEntry(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidEntryID, nil, ""))
+		return
+	}
+
+	input := request.ReverseEntryRequest{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "ReverseEntry",
+		"id":      id,
+		"request": input,
+	})
+
+	entry, err := h.controller.Ledger.ReverseEntry(id, ctrlledger.ReverseEntryInput{
+		Date:        input.ParsedDate(),
+		Description: input.Description,
+		CreatedBy:   toUUIDPtr(userID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ctrlledger.ErrEntryNotFound):
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, input, ""))
+		case errors.Is(err, ctrlledger.ErrEntryAlreadyReversed):
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		default:
+			l.Error(err, "failed to reverse journal entry")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToJournalEntry(entry), nil, nil, nil, ""))
+}
+
+// TrialBalance godoc
+// @Summary Get the trial balance
+// @Description Get the balance of every account as of a date, the debit and credit totals must match
+// @id getLedgerTrialBalance
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param asOf query string false "As of date (YYYY-MM-DD), today by default"
+// @Success 200 {object} TrialBalanceResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/trial-balance [get]
+func (h *handler) TrialBalance(c *gin.Context) {
+	query := request.TrialBalanceQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "TrialBalance",
+		"query":   query,
+	})
+
+	tb, err := h.controller.Ledger.TrialBalance(query.AsOfDate())
+	if err != nil {
+		l.Error(err, "failed to get trial balance")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTrialBalance(tb), nil, nil, nil, ""))
+}
+
+// ProfitAndLoss godoc
+// @Summary Get the profit and loss statement
+// @Description Get the income and expenses posted in a period
+// @id getLedgerProfitAndLoss
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param from query string true "From date (YYYY-MM-DD)"
+// @Param to query string true "To date (YYYY-MM-DD)"
+// @Success 200 {object} ProfitAndLossResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /ledger/profit-and-loss [get]
+func (h *handler) ProfitAndLoss(c *gin.Context) {
+	query := request.ProfitAndLossQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "ProfitAndLoss",
+		"query":   query,
+	})
+
+	pl, err := h.controller.Ledger.ProfitAndLoss(query.FromDate(), query.ToDate())
+	if err != nil {
+		if errors.Is(err, ctrlledger.ErrInvalidDateRange) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+			return
+		}
+
+		l.Error(err, "failed to get profit and loss")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProfitAndLoss(pl), nil, nil, nil, ""))
+}
+
+// PostUnposted godoc
+// @Summary Post the accounting transactions missing from the ledger
+// @Description Post the accounting transactions recorded without a journal entry
+// @id postUnpostedLedgerEntries
+// @Tags Ledger
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} MessageResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/post-ledger-entries [post]
+func (h *handler) PostUnposted(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "ledger",
+		"method":  "PostUnposted",
+	})
+
+	posted, err := h.controller.Ledger.PostUnposted(postUnpostedBatchSize)
+	if err != nil {
+		l.Error(err, "failed to post unposted accounting transactions")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l.Infof("posted %d journal entries", posted)
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+func isEntryValidationError(err error) bool {
+	return errors.Is(err, model.ErrJournalEntryTooFewLines) ||
+		errors.Is(err, model.ErrJournalEntryUnbalanced) ||
+		errors.Is(err, model.ErrJournalLineInvalidAmount) ||
+		errors.Is(err, model.ErrJournalLineMissingAccount) ||
+		errors.Is(err, ctrlledger.ErrAccountNotFound) ||
+		errors.Is(err, ctrlledger.ErrAccountInactive) ||
+		errors.Is(err, ledger.ErrUnknownAccount)
+}
+
+func toUUIDPtr(s string) *model.UUID {
+	id, err := model.UUIDFromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
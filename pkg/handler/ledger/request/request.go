@@ -0,0 +1,173 @@
+package request
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/ledger/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+const dateLayout = "2006-01-02"
+
+type ListAccountsQuery struct {
+	ActiveOnly bool `form:"activeOnly" json:"activeOnly"`
+} // @name ListAccountsQuery
+
+type CreateAccountRequest struct {
+	Code        string `json:"code" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Type        string `json:"type" binding:"required"`
+	Description string `json:"description"`
+} // @name CreateAccountRequest
+
+func (r *CreateAccountRequest) Validate() error {
+	if strings.TrimSpace(r.Code) == "" {
+		return errs.ErrInvalidAccountCode
+	}
+	if !model.LedgerAccountType(r.Type).IsValid() {
+		return errs.ErrInvalidAccountType
+	}
+	return nil
+}
+
+type UpdateAccountRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsActive    *bool  `json:"isActive"`
+} // @name UpdateAccountRequest
+
+type ListEntriesQuery struct {
+	model.Pagination
+
+	SourceType string `form:"sourceType" json:"sourceType"`
+	AccountID  string `form:"accountID" json:"accountID"`
+	From       string `form:"from" json:"from"`
+	To         string `form:"to" json:"to"`
+} // @name ListEntriesQuery
+
+func (q *ListEntriesQuery) Validate() error {
+	if q.SourceType != "" && !model.JournalSource(q.SourceType).IsValid() {
+		return errs.ErrInvalidSourceType
+	}
+	if q.AccountID != "" && !model.IsUUIDFromString(q.AccountID) {
+		return errs.ErrInvalidAccountID
+	}
+	if _, err := timeutil.ParseOptionalDate(q.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	if _, err := timeutil.ParseOptionalDate(q.To); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+func (q *ListEntriesQuery) FromDate() *time.Time {
+	d, _ := timeutil.ParseOptionalDate(q.From)
+	return d
+}
+
+func (q *ListEntriesQuery) ToDate() *time.Time {
+	d, _ := timeutil.ParseOptionalDate(q.To)
+	return d
+}
+
+type JournalLineInput struct {
+	AccountID string `json:"accountID" binding:"required"`
+	Debit     int64  `json:"debit"`
+	Credit    int64  `json:"credit"`
+	Memo      string `json:"memo"`
+} // @name JournalLineInput
+
+type CreateEntryRequest struct {
+	Date        string             `json:"date" binding:"required"`
+	Description string             `json:"description" binding:"required"`
+	Lines       []JournalLineInput `json:"lines" binding:"required"`
+} // @name CreateEntryRequest
+
+func (r *CreateEntryRequest) Validate() error {
+	if _, err := time.Parse(dateLayout, r.Date); err != nil {
+		return errs.ErrInvalidDate
+	}
+	if len(r.Lines) < 2 {
+		return errs.ErrTooFewLines
+	}
+	for _, l := range r.Lines {
+		if !model.IsUUIDFromString(l.AccountID) {
+			return errs.ErrInvalidAccountID
+		}
+	}
+	return nil
+}
+
+func (r *CreateEntryRequest) ParsedDate() time.Time {
+	d, _ := time.Parse(dateLayout, r.Date)
+	return d
+}
+
+type ReverseEntryRequest struct {
+	Date        string `json:"date"`
+	Description string `json:"description"`
+} // @name ReverseEntryRequest
+
+func (r *ReverseEntryRequest) Validate() error {
+	if _, err := timeutil.ParseOptionalDate(r.Date); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+// ParsedDate is the reversal date, today by default
+func (r *ReverseEntryRequest) ParsedDate() time.Time {
+	d, _ := timeutil.ParseOptionalDate(r.Date)
+	if d == nil {
+		return time.Now()
+	}
+	return *d
+}
+
+type TrialBalanceQuery struct {
+	AsOf string `form:"asOf" json:"asOf"`
+} // @name TrialBalanceQuery
+
+func (q *TrialBalanceQuery) Validate() error {
+	if _, err := timeutil.ParseOptionalDate(q.AsOf); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+// AsOfDate is the date of the trial balance, today by default
+func (q *TrialBalanceQuery) AsOfDate() time.Time {
+	d, _ := timeutil.ParseOptionalDate(q.AsOf)
+	if d == nil {
+		return time.Now()
+	}
+	return *d
+}
+
+type ProfitAndLossQuery struct {
+	From string `form:"from" json:"from" binding:"required"`
+	To   string `form:"to" json:"to" binding:"required"`
+} // @name ProfitAndLossQuery
+
+func (q *ProfitAndLossQuery) Validate() error {
+	if _, err := time.Parse(dateLayout, q.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	if _, err := time.Parse(dateLayout, q.To); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+func (q *ProfitAndLossQuery) FromDate() time.Time {
+	d, _ := time.Parse(dateLayout, q.From)
+	return d
+}
+
+func (q *ProfitAndLossQuery) ToDate() time.Time {
+	d, _ := time.Parse(dateLayout, q.To)
+	return d
+}
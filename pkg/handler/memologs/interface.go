@@ -6,6 +6,7 @@ type IHandler interface {
 	Create(c *gin.Context)
 	List(c *gin.Context)
 	Sync(c *gin.Context)
+	Ingest(c *gin.Context)
 	ListOpenPullRequest(c *gin.Context)
 	ListByDiscordID(c *gin.Context)
 	GetTopAuthors(c *gin.Context)
@@ -157,6 +157,10 @@ func (h *handler) Create(c *gin.Context) {
 		return
 	}
 
+	if err := h.controller.KnowledgeBase.IndexMemos(logs, nil); err != nil {
+		l.Errorf(err, "[memologs.Create] failed to index new memo logs")
+	}
+
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToMemoLog(logs), nil, nil, body, ""))
 }
 
@@ -227,6 +231,25 @@ func (h *handler) Sync(c *gin.Context) {
 	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToMemoLog(results), nil, nil, nil, "ok"))
 }
 
+// Ingest ingests the memos changed in the memo repository since the previous ingestion
+func (h *handler) Ingest(c *gin.Context) {
+	l := h.logger.Fields(
+		logger.Fields{
+			"handler": "memologs",
+			"method":  "Ingest",
+		},
+	)
+
+	ingestion, err := h.controller.MemoLog.Ingest()
+	if err != nil {
+		l.Error(err, "failed to ingest memos")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToMemoIngestion(ingestion), nil, nil, nil, "ok"))
+}
+
 // ListOpenPullRequest list open pull request
 func (h *handler) ListOpenPullRequest(c *gin.Context) {
 	l := h.logger.Fields(
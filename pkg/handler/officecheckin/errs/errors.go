@@ -0,0 +1,56 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/officecheckin"
+	codes "github.com/dwarvesf/fortress-api/pkg/officecheckin"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidLocationID = errors.New("invalid office location id")
+	ErrInvalidEmployeeID = errors.New("invalid employee id")
+	ErrInvalidDate       = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidMonth      = errors.New("invalid month, expected YYYY-MM")
+)
+
+// ConvertControllerErr writes the status of an office check-in controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, officecheckin.ErrLocationNotFound),
+		errors.Is(err, officecheckin.ErrNotCheckedIn):
+		status = http.StatusNotFound
+
+	case errors.Is(err, officecheckin.ErrLocationInactive),
+		errors.Is(err, officecheckin.ErrInvalidName),
+		errors.Is(err, officecheckin.ErrInvalidPosition),
+		errors.Is(err, officecheckin.ErrInvalidRadius),
+		errors.Is(err, officecheckin.ErrGeofenceNoPosition),
+		errors.Is(err, officecheckin.ErrInvalidRotation),
+		errors.Is(err, officecheckin.ErrPositionRequired),
+		errors.Is(err, officecheckin.ErrOutsideGeofence),
+		errors.Is(err, officecheckin.ErrAlreadyCheckedIn),
+		errors.Is(err, officecheckin.ErrAlreadyCheckedOut),
+		errors.Is(err, officecheckin.ErrCheckOutOtherOffice),
+		errors.Is(err, codes.ErrMalformedCode),
+		errors.Is(err, codes.ErrInvalidCode),
+		errors.Is(err, codes.ErrExpiredCode),
+		errors.Is(err, codes.ErrWrongOffice):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,15 @@
+package officecheckin
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Attendance(c *gin.Context)
+	CheckIn(c *gin.Context)
+	CheckOut(c *gin.Context)
+	CreateLocation(c *gin.Context)
+	GetLocation(c *gin.Context)
+	List(c *gin.Context)
+	ListLocations(c *gin.Context)
+	QRCode(c *gin.Context)
+	UpdateLocation(c *gin.Context)
+}
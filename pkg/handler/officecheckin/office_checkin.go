@@ -0,0 +1,430 @@
+package officecheckin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlofficecheckin "github.com/dwarvesf/fortress-api/pkg/controller/officecheckin"
+	"github.com/dwarvesf/fortress-api/pkg/handler/officecheckin/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/officecheckin/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// ListLocations godoc
+// @Summary Get the office locations
+// @Description Get the offices employees check in at
+// @id getListOfficeLocations
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param activeOnly query bool false "Only the active offices"
+// @Success 200 {object} OfficeLocationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-locations [get]
+func (h *handler) ListLocations(c *gin.Context) {
+	query := request.ListOfficeLocationsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "officecheckin",
+		"method":  "ListLocations",
+	})
+
+	locations, err := h.controller.OfficeCheckin.ListLocations(query.ActiveOnly)
+	if err != nil {
+		l.Error(err, "failed to list office locations")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOfficeLocations(locations), nil, nil, nil, ""))
+}
+
+// GetLocation godoc
+// @Summary Get an office location
+// @Description Get an office employees check in at
+// @id getOfficeLocation
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Office location ID"
+// @Success 200 {object} OfficeLocationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-locations/{id} [get]
+func (h *handler) GetLocation(c *gin.Context) {
+	id, ok := locationID(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "officecheckin",
+		"method":  "GetLocation",
+		"id":      id,
+	})
+
+	location, err := h.controller.OfficeCheckin.GetLocation(id)
+	if err != nil {
+		l.Error(err, "failed to get office location")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOfficeLocation(location), nil, nil, nil, ""))
+}
+
+// CreateLocation godoc
+// @Summary Create an office location
+// @Description Create an office with its position and geofence, its QR secret is generated
+// @id createOfficeLocation
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body OfficeLocationRequest true "Body"
+// @Success 200 {object} OfficeLocationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-locations [post]
+func (h *handler) CreateLocation(c *gin.Context) {
+	body := request.OfficeLocationRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "officecheckin",
+		"method":  "CreateLocation",
+		"name":    body.Name,
+	})
+
+	location, err := h.controller.OfficeCheckin.CreateLocation(toLocationInput(body))
+	if err != nil {
+		l.Error(err, "failed to create office location")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOfficeLocation(location), nil, nil, nil, ""))
+}
+
+// UpdateLocation godoc
+// @Summary Update an office location
+// @Description Update an office, rotating its QR secret invalidates the codes issued so far
+// @id updateOfficeLocation
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Office location ID"
+// @Param Body body OfficeLocationRequest true "Body"
+// @Success 200 {object} OfficeLocationResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-locations/{id} [put]
+func (h *handler) UpdateLocation(c *gin.Context) {
+	id, ok := locationID(c)
+	if !ok {
+		return
+	}
+
+	body := request.OfficeLocationRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "officecheckin",
+		"method":  "UpdateLocation",
+		"id":      id,
+	})
+
+	location, err := h.controller.OfficeCheckin.UpdateLocation(id, toLocationInput(body))
+	if err != nil {
+		l.Error(err, "failed to update office location")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOfficeLocation(location), nil, nil, nil, ""))
+}
+
+// QRCode godoc
+// @Summary Get the current QR code of an office
+// @Description Get the signed code to show at the office, it rotates at its expiry
+// @id getOfficeLocationQRCode
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Office location ID"
+// @Success 200 {object} OfficeQRCodeResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-locations/{id}/qr-code [get]
+func (h *handler) QRCode(c *gin.Context) {
+	id, ok := locationID(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "officecheckin",
+		"method":  "QRCode",
+		"id":      id,
+	})
+
+	code, err := h.controller.OfficeCheckin.QRCode(id, time.Now())
+	if err != nil {
+		l.Error(err, "failed to get office qr code")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOfficeQRCode(code.Location, code.Code, code.ExpiresAt), nil, nil, nil, ""))
+}
+
+// CheckIn godoc
+// @Summary Check in at an office
+// @Description Check the logged in employee in with the QR code shown at the office, once a day
+// @id officeCheckIn
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body OfficeScanRequest true "Body"
+// @Success 200 {object} OfficeCheckinResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-checkins/check-in [post]
+func (h *handler) CheckIn(c *gin.Context) {
+	h.scan(c, "CheckIn", h.controller.OfficeCheckin.CheckIn)
+}
+
+// CheckOut godoc
+// @Summary Check out of an office
+// @Description Check the logged in employee out with the QR code shown at the office checked in at
+// @id officeCheckOut
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body OfficeScanRequest true "Body"
+// @Success 200 {object} OfficeCheckinResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-checkins/check-out [post]
+func (h *handler) CheckOut(c *gin.Context) {
+	h.scan(c, "CheckOut", h.controller.OfficeCheckin.CheckOut)
+}
+
+// List godoc
+// @Summary Get the office check-ins
+// @Description Get the check-ins, of the logged in employee only without the read permission
+// @id getListOfficeCheckins
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param employeeID query string false "Employee ID"
+// @Param officeLocationID query string false "Office location ID"
+// @Param from query string false "From date, YYYY-MM-DD"
+// @Param to query string false "To date, YYYY-MM-DD"
+// @Param page query string false "Page"
+// @Param size query string false "Size"
+// @Success 200 {object} OfficeCheckinsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-checkins [get]
+func (h *handler) List(c *gin.Context) {
+	query := request.ListOfficeCheckinsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	if !authutils.HasPermission(userInfo.Permissions, model.PermissionOfficeCheckinsRead) {
+		query.EmployeeID = userInfo.UserID
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "officecheckin",
+		"method":  "List",
+		"query":   query,
+	})
+
+	from, _ := timeutil.ParseOptionalDate(query.From)
+	to, _ := timeutil.ParseOptionalDate(query.To)
+	checkins, total, err := h.controller.OfficeCheckin.List(ctrlofficecheckin.ListInput{
+		EmployeeID:       query.EmployeeID,
+		OfficeLocationID: query.OfficeLocationID,
+		From:             from,
+		To:               to,
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list office check-ins")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOfficeCheckins(checkins),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// Attendance godoc
+// @Summary Get the office attendance report
+// @Description Get the days each employee checked in at the offices over a month, with the office perks it earns them
+// @id getOfficeAttendanceReport
+// @Tags OfficeCheckin
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param month query string false "Month, YYYY-MM"
+// @Param officeLocationID query string false "Office location ID"
+// @Success 200 {object} OfficeAttendanceReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /office-checkins/attendance [get]
+func (h *handler) Attendance(c *gin.Context) {
+	query := request.OfficeAttendanceQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	month, err := query.ParseMonth()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "officecheckin",
+		"method":  "Attendance",
+		"query":   query,
+	})
+
+	report, err := h.controller.OfficeCheckin.Attendance(month, query.OfficeLocationID)
+	if err != nil {
+		l.Error(err, "failed to get office attendance report")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOfficeAttendanceReport(report), nil, nil, nil, ""))
+}
+
+// scan checks the logged in employee in or out with the scanned code
+func (h *handler) scan(c *gin.Context, method string, fn func(string, ctrlofficecheckin.ScanInput, time.Time) (*model.OfficeCheckin, error)) {
+	body := request.OfficeScanRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	employeeID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":    "officecheckin",
+		"method":     method,
+		"employeeID": employeeID,
+	})
+
+	checkin, err := fn(employeeID, ctrlofficecheckin.ScanInput{
+		Code:      body.Code,
+		Latitude:  body.Latitude,
+		Longitude: body.Longitude,
+	}, time.Now())
+	if err != nil {
+		l.Error(err, "failed to scan office qr code")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOfficeCheckin(checkin), nil, nil, nil, ""))
+}
+
+func locationID(c *gin.Context) (string, bool) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidLocationID, nil, ""))
+		return "", false
+	}
+	return id, true
+}
+
+func toLocationInput(body request.OfficeLocationRequest) ctrlofficecheckin.LocationInput {
+	return ctrlofficecheckin.LocationInput{
+		Name:              body.Name,
+		Address:           body.Address,
+		Latitude:          body.Latitude,
+		Longitude:         body.Longitude,
+		GeofenceRadius:    body.GeofenceRadius,
+		QRRotationSeconds: body.QRRotationSeconds,
+		IsActive:          body.IsActive,
+		RotateSecret:      body.RotateSecret,
+	}
+}
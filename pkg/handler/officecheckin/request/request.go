@@ -0,0 +1,81 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/officecheckin/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+const monthLayout = "2006-01"
+
+type ListOfficeLocationsQuery struct {
+	ActiveOnly bool `form:"activeOnly" json:"activeOnly"`
+} // @name ListOfficeLocationsQuery
+
+type OfficeLocationRequest struct {
+	Name              string   `json:"name" binding:"required,max=200"`
+	Address           string   `json:"address"`
+	Latitude          *float64 `json:"latitude"`
+	Longitude         *float64 `json:"longitude"`
+	GeofenceRadius    int      `json:"geofenceRadius"`    // meters around the office, 0 for no geofence
+	QRRotationSeconds int      `json:"qrRotationSeconds"` // 60 when empty
+	IsActive          bool     `json:"isActive"`
+	RotateSecret      bool     `json:"rotateSecret"` // invalidates the codes issued so far
+} // @name OfficeLocationRequest
+
+type OfficeScanRequest struct {
+	Code      string   `json:"code" binding:"required"` // the QR code shown at the office
+	Latitude  *float64 `json:"latitude"`                // required at an office with a geofence
+	Longitude *float64 `json:"longitude"`
+} // @name OfficeScanRequest
+
+type ListOfficeCheckinsQuery struct {
+	model.Pagination
+
+	EmployeeID       string `form:"employeeID" json:"employeeID"` // the logged in employee without the read permission
+	OfficeLocationID string `form:"officeLocationID" json:"officeLocationID"`
+	From             string `form:"from" json:"from"` // YYYY-MM-DD
+	To               string `form:"to" json:"to"`     // YYYY-MM-DD
+} // @name ListOfficeCheckinsQuery
+
+func (q *ListOfficeCheckinsQuery) Validate() error {
+	if q.EmployeeID != "" && !model.IsUUIDFromString(q.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if q.OfficeLocationID != "" && !model.IsUUIDFromString(q.OfficeLocationID) {
+		return errs.ErrInvalidLocationID
+	}
+	if _, err := timeutil.ParseOptionalDate(q.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	if _, err := timeutil.ParseOptionalDate(q.To); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type OfficeAttendanceQuery struct {
+	Month            string `form:"month" json:"month"` // YYYY-MM, the current month when empty
+	OfficeLocationID string `form:"officeLocationID" json:"officeLocationID"`
+} // @name OfficeAttendanceQuery
+
+func (q *OfficeAttendanceQuery) Validate() error {
+	if q.OfficeLocationID != "" && !model.IsUUIDFromString(q.OfficeLocationID) {
+		return errs.ErrInvalidLocationID
+	}
+	return nil
+}
+
+// ParseMonth returns the first day of the month, of the current one when empty
+func (q *OfficeAttendanceQuery) ParseMonth() (time.Time, error) {
+	if q.Month == "" {
+		return time.Now(), nil
+	}
+	m, err := time.Parse(monthLayout, q.Month)
+	if err != nil {
+		return time.Time{}, errs.ErrInvalidMonth
+	}
+	return m, nil
+}
@@ -0,0 +1,55 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/operationalservice"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidServiceID      = errors.New("invalid operational service id")
+	ErrInvalidSeatID         = errors.New("invalid seat id")
+	ErrInvalidEmployeeID     = errors.New("invalid employee id")
+	ErrInvalidOwnerID        = errors.New("invalid owner id")
+	ErrInvalidOrganizationID = errors.New("invalid organization id")
+	ErrInvalidBillingCycle   = errors.New("invalid billing cycle, expected monthly, quarterly or yearly")
+	ErrInvalidDate           = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidMonth          = errors.New("invalid month, expected YYYY-MM")
+)
+
+// ConvertControllerErr writes the status of an operational service controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, operationalservice.ErrServiceNotFound),
+		errors.Is(err, operationalservice.ErrSeatNotFound),
+		errors.Is(err, operationalservice.ErrCurrencyNotFound),
+		errors.Is(err, operationalservice.ErrEmployeeNotFound),
+		errors.Is(err, operationalservice.ErrOrganizationNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, operationalservice.ErrInvalidBillingCycle),
+		errors.Is(err, operationalservice.ErrInvalidAmount),
+		errors.Is(err, operationalservice.ErrInvalidSeatCount),
+		errors.Is(err, operationalservice.ErrInvalidEndDate),
+		errors.Is(err, operationalservice.ErrSeatAssigned),
+		errors.Is(err, operationalservice.ErrSeatReleased),
+		errors.Is(err, operationalservice.ErrEmployeeLeft),
+		errors.Is(err, operationalservice.ErrInvalidPeriod):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
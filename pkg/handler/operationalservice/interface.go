@@ -0,0 +1,16 @@
+package operationalservice
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	AssignSeat(c *gin.Context)
+	Create(c *gin.Context)
+	Delete(c *gin.Context)
+	Get(c *gin.Context)
+	List(c *gin.Context)
+	ReconcileSeats(c *gin.Context)
+	ReleaseSeat(c *gin.Context)
+	RemindRenewals(c *gin.Context)
+	SpendReport(c *gin.Context)
+	Update(c *gin.Context)
+}
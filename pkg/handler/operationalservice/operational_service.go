@@ -0,0 +1,498 @@
+package operationalservice
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlservice "github.com/dwarvesf/fortress-api/pkg/controller/operationalservice"
+	"github.com/dwarvesf/fortress-api/pkg/handler/operationalservice/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/operationalservice/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// List godoc
+// @Summary Get the operational services
+// @Description Get the SaaS subscriptions and the other operational services, next renewal first
+// @id getListOperationalServices
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param organizationID query string false "Organization the services are charged to"
+// @Param ownerID query string false "Owner of the services"
+// @Param isActive query bool false "Still paid for"
+// @Param keyword query string false "Name, vendor or plan"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} OperationalServicesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services [get]
+func (h *handler) List(c *gin.Context) {
+	query := request.ListOperationalServicesQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "List",
+		"query":   query,
+	})
+
+	services, total, err := h.controller.OperationalService.List(ctrlservice.ListInput{
+		OrganizationID: query.OrganizationID,
+		OwnerID:        query.OwnerID,
+		IsActive:       query.IsActive,
+		Keyword:        query.Keyword,
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list operational services")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServices(services),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// Get godoc
+// @Summary Get an operational service
+// @Description Get an operational service with the seats currently held
+// @id getOperationalServiceByID
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Operational service ID"
+// @Success 200 {object} OperationalServiceDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services/{id} [get]
+func (h *handler) Get(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidServiceID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "Get",
+		"id":      id,
+	})
+
+	s, err := h.controller.OperationalService.Get(id)
+	if err != nil {
+		l.Error(err, "failed to get operational service")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServiceDetail(s), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create an operational service
+// @Description Create a subscription with its vendor, plan, seats, billing cycle and the organization it is charged to
+// @id createOperationalService
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body OperationalServiceRequest true "Body"
+// @Success 200 {object} OperationalServiceDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services [post]
+func (h *handler) Create(c *gin.Context) {
+	input := request.OperationalServiceRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "Create",
+		"request": input,
+	})
+
+	s, err := h.controller.OperationalService.Create(toServiceInput(input))
+	if err != nil {
+		l.Error(err, "failed to create operational service")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServiceDetail(s), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update an operational service
+// @Description Update an operational service, a renewal date moved is reminded of again
+// @id updateOperationalService
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Operational service ID"
+// @Param Body body OperationalServiceRequest true "Body"
+// @Success 200 {object} OperationalServiceDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services/{id} [put]
+func (h *handler) Update(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidServiceID, nil, ""))
+		return
+	}
+
+	input := request.OperationalServiceRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "Update",
+		"id":      id,
+		"request": input,
+	})
+
+	s, err := h.controller.OperationalService.Update(id, toServiceInput(input))
+	if err != nil {
+		l.Error(err, "failed to update operational service")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServiceDetail(s), nil, nil, nil, ""))
+}
+
+// Delete godoc
+// @Summary Delete an operational service
+// @Description Delete an operational service registered by mistake, a cancelled one is set inactive instead
+// @id deleteOperationalService
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Operational service ID"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services/{id} [delete]
+func (h *handler) Delete(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidServiceID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "Delete",
+		"id":      id,
+	})
+
+	if err := h.controller.OperationalService.Delete(id); err != nil {
+		l.Error(err, "failed to delete operational service")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+// AssignSeat godoc
+// @Summary Assign a seat of an operational service
+// @Description Give an employee a seat of an operational service
+// @id assignOperationalServiceSeat
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Operational service ID"
+// @Param Body body AssignSeatRequest true "Body"
+// @Success 200 {object} OperationalServiceSeatResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services/{id}/seats [post]
+func (h *handler) AssignSeat(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidServiceID, nil, ""))
+		return
+	}
+
+	input := request.AssignSeatRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "AssignSeat",
+		"id":      id,
+		"request": input,
+	})
+
+	employeeID, _ := model.UUIDFromString(input.EmployeeID)
+	assignedAt, _ := timeutil.ParseOptionalDate(input.AssignedAt)
+	seat, err := h.controller.OperationalService.AssignSeat(id, ctrlservice.SeatInput{
+		EmployeeID: employeeID,
+		AssignedAt: assignedAt,
+		Note:       input.Note,
+	})
+	if err != nil {
+		l.Error(err, "failed to assign seat")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServiceSeat(seat), nil, nil, nil, ""))
+}
+
+// ReleaseSeat godoc
+// @Summary Release a seat of an operational service
+// @Description Take a seat of an operational service back from its holder
+// @id releaseOperationalServiceSeat
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Operational service ID"
+// @Param seatID path string true "Seat ID"
+// @Param Body body ReleaseSeatRequest false "Body"
+// @Success 200 {object} OperationalServiceSeatResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services/{id}/seats/{seatID}/release [post]
+func (h *handler) ReleaseSeat(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidServiceID, nil, ""))
+		return
+	}
+	seatID := c.Param("seatID")
+	if seatID == "" || !model.IsUUIDFromString(seatID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSeatID, nil, ""))
+		return
+	}
+
+	input := request.ReleaseSeatRequest{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "ReleaseSeat",
+		"id":      id,
+		"seatID":  seatID,
+	})
+
+	releasedAt, _ := timeutil.ParseOptionalDate(input.ReleasedAt)
+	seat, err := h.controller.OperationalService.ReleaseSeat(id, seatID, releasedAt)
+	if err != nil {
+		l.Error(err, "failed to release seat")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServiceSeat(seat), nil, nil, nil, ""))
+}
+
+// ReconcileSeats godoc
+// @Summary Reconcile the seats of the operational services
+// @Description Compare the seats paid for each active service with its active holders, or with the active headcount when the seats are not tracked per employee, and list the employees who left still holding a seat
+// @id reconcileOperationalServiceSeats
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} OperationalServiceSeatReconciliationsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services/seat-reconciliation [get]
+func (h *handler) ReconcileSeats(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "ReconcileSeats",
+	})
+
+	rs, err := h.controller.OperationalService.ReconcileSeats()
+	if err != nil {
+		l.Error(err, "failed to reconcile seats")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServiceSeatReconciliations(rs), nil, nil, nil, ""))
+}
+
+// SpendReport godoc
+// @Summary Get the monthly spend on the operational services
+// @Description Break the spend of a month on the operational services down per organization, in VND. Charged is what is billed in the month, monthly spreads the quarterly and yearly bills over the months they cover
+// @id getOperationalServiceSpendReport
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param month query string false "YYYY-MM, the current month when empty"
+// @Success 200 {object} OperationalServiceSpendReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /operational-services/spend-report [get]
+func (h *handler) SpendReport(c *gin.Context) {
+	query := request.SpendReportQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	year, month, err := query.Period(time.Now())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "SpendReport",
+		"year":    year,
+		"month":   month,
+	})
+
+	report, err := h.controller.OperationalService.SpendReport(year, month)
+	if err != nil {
+		l.Error(err, "failed to report operational service spend")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServiceSpendReport(report), nil, nil, nil, ""))
+}
+
+// RemindRenewals godoc
+// @Summary Remind the renewals of the operational services
+// @Description Roll the passed renewal dates forward and remind the owners on Discord of the services renewing within their reminder window
+// @id remindOperationalServiceRenewals
+// @Tags OperationalService
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} OperationalServicesListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/operational-service-renewals [post]
+func (h *handler) RemindRenewals(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "operationalservice",
+		"method":  "RemindRenewals",
+	})
+
+	services, err := h.controller.OperationalService.RemindRenewals(time.Now())
+	if err != nil {
+		l.Error(err, "failed to remind renewals")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToOperationalServices(services), nil, nil, nil, ""))
+}
+
+func toServiceInput(r request.OperationalServiceRequest) ctrlservice.ServiceInput {
+	startAt, _ := timeutil.ParseOptionalDate(r.StartAt)
+	endAt, _ := timeutil.ParseOptionalDate(r.EndAt)
+	renewalDate, _ := timeutil.ParseOptionalDate(r.RenewalDate)
+
+	return ctrlservice.ServiceInput{
+		Name:           r.Name,
+		Vendor:         r.Vendor,
+		Plan:           r.Plan,
+		BillingCycle:   model.OperationalServiceBillingCycle(r.BillingCycle),
+		Amount:         r.Amount,
+		SeatCount:      r.SeatCount,
+		Currency:       r.Currency,
+		StartAt:        startAt,
+		EndAt:          endAt,
+		RenewalDate:    renewalDate,
+		ReminderDays:   r.ReminderDays,
+		OwnerID:        toUUIDPtr(r.OwnerID),
+		OrganizationID: toUUIDPtr(r.OrganizationID),
+		CostCenter:     r.CostCenter,
+		IsActive:       r.IsActive,
+		Note:           r.Note,
+	}
+}
+
+func toUUIDPtr(s string) *model.UUID {
+	id, err := model.UUIDFromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,110 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/operationalservice/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+const monthLayout = "2006-01"
+
+type ListOperationalServicesQuery struct {
+	model.Pagination
+
+	OrganizationID string `form:"organizationID" json:"organizationID"`
+	OwnerID        string `form:"ownerID" json:"ownerID"`
+	IsActive       *bool  `form:"isActive" json:"isActive"`
+	Keyword        string `form:"keyword" json:"keyword"` // matches the name, the vendor or the plan
+} // @name ListOperationalServicesQuery
+
+func (q *ListOperationalServicesQuery) Validate() error {
+	if q.OrganizationID != "" && !model.IsUUIDFromString(q.OrganizationID) {
+		return errs.ErrInvalidOrganizationID
+	}
+	if q.OwnerID != "" && !model.IsUUIDFromString(q.OwnerID) {
+		return errs.ErrInvalidOwnerID
+	}
+	return nil
+}
+
+type OperationalServiceRequest struct {
+	Name           string `json:"name" binding:"required,max=200"`
+	Vendor         string `json:"vendor"`
+	Plan           string `json:"plan"`
+	BillingCycle   string `json:"billingCycle"` // monthly, quarterly or yearly, monthly when empty
+	Amount         int    `json:"amount" binding:"gte=0"`
+	SeatCount      int    `json:"seatCount" binding:"gte=0"` // 0 for a service billed flat
+	Currency       string `json:"currency"`                  // VND when empty
+	StartAt        string `json:"startAt"`                   // YYYY-MM-DD, today when empty
+	EndAt          string `json:"endAt"`                     // YYYY-MM-DD
+	RenewalDate    string `json:"renewalDate"`               // YYYY-MM-DD, the next billing date when empty
+	ReminderDays   int    `json:"reminderDays" binding:"gte=0"`
+	OwnerID        string `json:"ownerID"`
+	OrganizationID string `json:"organizationID"` // the organization the service is charged to
+	CostCenter     string `json:"costCenter"`
+	IsActive       *bool  `json:"isActive"`
+	Note           string `json:"note"`
+} // @name OperationalServiceRequest
+
+func (r *OperationalServiceRequest) Validate() error {
+	if r.BillingCycle != "" && !model.OperationalServiceBillingCycle(r.BillingCycle).IsValid() {
+		return errs.ErrInvalidBillingCycle
+	}
+	if r.OwnerID != "" && !model.IsUUIDFromString(r.OwnerID) {
+		return errs.ErrInvalidOwnerID
+	}
+	if r.OrganizationID != "" && !model.IsUUIDFromString(r.OrganizationID) {
+		return errs.ErrInvalidOrganizationID
+	}
+	for _, d := range []string{r.StartAt, r.EndAt, r.RenewalDate} {
+		if _, err := timeutil.ParseOptionalDate(d); err != nil {
+			return errs.ErrInvalidDate
+		}
+	}
+	return nil
+}
+
+type AssignSeatRequest struct {
+	EmployeeID string `json:"employeeID" binding:"required"`
+	AssignedAt string `json:"assignedAt"` // YYYY-MM-DD, today when empty
+	Note       string `json:"note"`
+} // @name AssignSeatRequest
+
+func (r *AssignSeatRequest) Validate() error {
+	if !model.IsUUIDFromString(r.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if _, err := timeutil.ParseOptionalDate(r.AssignedAt); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type ReleaseSeatRequest struct {
+	ReleasedAt string `json:"releasedAt"` // YYYY-MM-DD, today when empty
+} // @name ReleaseSeatRequest
+
+func (r *ReleaseSeatRequest) Validate() error {
+	if _, err := timeutil.ParseOptionalDate(r.ReleasedAt); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type SpendReportQuery struct {
+	Month string `form:"month" json:"month"` // YYYY-MM, the current month when empty
+} // @name OperationalServiceSpendReportQuery
+
+// Period returns the year and the month of the report
+func (q *SpendReportQuery) Period(now time.Time) (int, int, error) {
+	if q.Month == "" {
+		return now.Year(), int(now.Month()), nil
+	}
+	d, err := time.Parse(monthLayout, q.Month)
+	if err != nil {
+		return 0, 0, errs.ErrInvalidMonth
+	}
+	return d.Year(), int(d.Month()), nil
+}
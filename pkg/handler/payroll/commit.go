@@ -14,6 +14,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/handler/payroll/errs"
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service/basecamp/consts"
@@ -186,6 +187,10 @@ func (h *handler) commitPayrollHandler(month, year, batch int, email string) err
 				return err
 			}
 
+			if err := h.controller.Referral.MarkBonusesPaid(&payrolls[i]); err != nil {
+				return err
+			}
+
 			// hacky way to mark done commission
 			if _, err := h.getCommissionExplains(&payrolls[i], true); err != nil {
 				return err
@@ -397,6 +402,12 @@ func (h *handler) storeMultipleTransaction(transactions []*model.AccountingTrans
 	if err := h.store.Accounting.CreateMultipleTransaction(h.repo.DB(), transactions); err != nil {
 		return err
 	}
+
+	// the transactions are committed already, one failing to post is left to the
+	// post-ledger-entries cronjob
+	if err := ledger.New(h.store).PostAccountingTransactions(h.repo.DB(), model.JournalSourcePayroll, transactions); err != nil {
+		h.logger.Error(err, "failed to post payroll transactions to the ledger")
+	}
 	return nil
 }
 
@@ -622,6 +633,11 @@ func (h *handler) storeExpenseSubmissions(expenses []ExpenseSubmissionData, batc
 
 		h.logger.Debug(fmt.Sprintf("Created AccountingTransaction %s for expense submission %s", transaction.ID, expense.RecordID))
 
+		// left to the post-ledger-entries cronjob when it fails, the transaction is committed already
+		if _, err := ledger.New(h.store).PostAccountingTransaction(h.repo.DB(), model.JournalSourceExpense, transaction); err != nil {
+			h.logger.Error(err, fmt.Sprintf("failed to post journal entry for expense submission %s", expense.RecordID))
+		}
+
 		// Create Expense record linked to transaction
 		emptyJSON := []byte("[]")
 		expenseRecord := &model.Expense{
@@ -717,6 +733,11 @@ func (h *handler) storeAccountingTodoTransactions(todos []AccountingTodoData, ba
 		}
 
 		h.logger.Debug(fmt.Sprintf("Created AccountingTransaction %s for accounting todo %d", transaction.ID, todo.TodoID))
+
+		// left to the post-ledger-entries cronjob when it fails, the transaction is committed already
+		if _, err := ledger.New(h.store).PostAccountingTransaction(h.repo.DB(), model.JournalSourceOperation, transaction); err != nil {
+			h.logger.Error(err, fmt.Sprintf("failed to post journal entry for accounting todo %d", todo.TodoID))
+		}
 	}
 
 	h.logger.Debug(fmt.Sprintf("Successfully stored %d accounting todo transactions", len(todos)))
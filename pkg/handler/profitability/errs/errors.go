@@ -0,0 +1,8 @@
+package errs
+
+import "errors"
+
+var (
+	ErrInvalidProjectID = errors.New("invalid project id")
+	ErrInvalidMonth     = errors.New("invalid month, expected YYYY-MM")
+)
@@ -0,0 +1,8 @@
+package profitability
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	ProjectReport(c *gin.Context)
+	Report(c *gin.Context)
+}
@@ -0,0 +1,125 @@
+package profitability
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlprofitability "github.com/dwarvesf/fortress-api/pkg/controller/profitability"
+	"github.com/dwarvesf/fortress-api/pkg/handler/profitability/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/profitability/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// Report godoc
+// @Summary Get the profitability of the projects
+// @Description Get the revenue, the salary, contractor and commission costs, the gross margin, the effective billing rate and the margin trend of each project month by month
+// @id getProjectsProfitability
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param from query string false "First month, YYYY-MM, 5 months before to by default"
+// @Param to query string false "Last month, YYYY-MM, the current month by default"
+// @Success 200 {object} ProjectProfitabilityReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/profitability [get]
+func (h *handler) Report(c *gin.Context) {
+	h.report(c, "")
+}
+
+// ProjectReport godoc
+// @Summary Get the profitability of a project
+// @Description Get the revenue, the salary, contractor and commission costs, the gross margin, the effective billing rate and the margin trend of a project month by month
+// @id getProjectProfitability
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param from query string false "First month, YYYY-MM, 5 months before to by default"
+// @Param to query string false "Last month, YYYY-MM, the current month by default"
+// @Success 200 {object} ProjectProfitabilityReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/profitability [get]
+func (h *handler) ProjectReport(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return
+	}
+
+	h.report(c, id)
+}
+
+func (h *handler) report(c *gin.Context, projectID string) {
+	query := request.ReportQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "profitability",
+		"method":    "Report",
+		"projectID": projectID,
+		"query":     query,
+	})
+
+	from, to := query.Range()
+	report, err := h.controller.Profitability.Report(ctrlprofitability.ReportInput{
+		From:      from,
+		To:        to,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ctrlprofitability.ErrProjectNotFound):
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, query, ""))
+		case errors.Is(err, ctrlprofitability.ErrInvalidRange),
+			errors.Is(err, ctrlprofitability.ErrRangeTooLong):
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		default:
+			l.Error(err, "failed to get project profitability")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectProfitabilityReport(report), nil, nil, nil, ""))
+}
@@ -0,0 +1,45 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/profitability/errs"
+)
+
+const (
+	monthLayout   = "2006-01"
+	defaultMonths = 6
+)
+
+type ReportQuery struct {
+	From string `form:"from" json:"from"` // YYYY-MM, 5 months before to by default
+	To   string `form:"to" json:"to"`     // YYYY-MM, the current month by default
+} // @name ProfitabilityReportQuery
+
+func (q *ReportQuery) Validate() error {
+	for _, m := range []string{q.From, q.To} {
+		if m == "" {
+			continue
+		}
+		if _, err := time.Parse(monthLayout, m); err != nil {
+			return errs.ErrInvalidMonth
+		}
+	}
+	return nil
+}
+
+// Range returns the first and last months of the report
+func (q *ReportQuery) Range() (time.Time, time.Time) {
+	to := time.Now()
+	if q.To != "" {
+		to, _ = time.Parse(monthLayout, q.To)
+	}
+	to = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	from := to.AddDate(0, 1-defaultMonths, 0)
+	if q.From != "" {
+		from, _ = time.Parse(monthLayout, q.From)
+	}
+
+	return from, to
+}
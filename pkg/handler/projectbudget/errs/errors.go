@@ -0,0 +1,50 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/projectbudget"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidProjectID = errors.New("invalid project id")
+	ErrInvalidBudgetID  = errors.New("invalid budget id")
+	ErrInvalidInvoiceID = errors.New("invalid invoice id")
+	ErrInvalidStatus    = errors.New("invalid budget status, expected active or closed")
+	ErrInvalidDate      = errors.New("invalid date, expected YYYY-MM-DD")
+)
+
+// ConvertControllerErr writes the status of a project budget controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, projectbudget.ErrBudgetNotFound),
+		errors.Is(err, projectbudget.ErrProjectNotFound),
+		errors.Is(err, projectbudget.ErrCurrencyNotFound),
+		errors.Is(err, projectbudget.ErrInvoiceNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, projectbudget.ErrInvoiceNotOfProject),
+		errors.Is(err, projectbudget.ErrInvalidStatus),
+		errors.Is(err, projectbudget.ErrInvalidTotalValue),
+		errors.Is(err, projectbudget.ErrInvalidEndDate),
+		errors.Is(err, projectbudget.ErrInvalidThreshold),
+		errors.Is(err, projectbudget.ErrInvalidMilestoneAmount),
+		errors.Is(err, projectbudget.ErrMilestonesOverBudget):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,13 @@
+package projectbudget
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	AlertBurns(c *gin.Context)
+	Create(c *gin.Context)
+	Get(c *gin.Context)
+	List(c *gin.Context)
+	Report(c *gin.Context)
+	SetMilestones(c *gin.Context)
+	Update(c *gin.Context)
+}
@@ -0,0 +1,396 @@
+package projectbudget
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlbudget "github.com/dwarvesf/fortress-api/pkg/controller/projectbudget"
+	"github.com/dwarvesf/fortress-api/pkg/handler/projectbudget/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/projectbudget/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// List godoc
+// @Summary Get the budgets of a project
+// @Description Get the purchase orders of a project, latest started first
+// @id getListProjectBudgets
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param status query string false "active or closed"
+// @Success 200 {object} ProjectBudgetsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/budgets [get]
+func (h *handler) List(c *gin.Context) {
+	projectID := c.Param("id")
+	if projectID == "" || !model.IsUUIDFromString(projectID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return
+	}
+
+	query := request.ListProjectBudgetsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "projectbudget",
+		"method":    "List",
+		"projectID": projectID,
+	})
+
+	budgets, err := h.controller.ProjectBudget.List(projectID, model.ProjectBudgetStatus(query.Status))
+	if err != nil {
+		l.Error(err, "failed to list project budgets")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgets(budgets), nil, nil, nil, ""))
+}
+
+// Get godoc
+// @Summary Get a budget of a project
+// @Description Get a purchase order of a project with its milestones
+// @id getProjectBudgetByID
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param budgetID path string true "Budget ID"
+// @Success 200 {object} ProjectBudgetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/budgets/{budgetID} [get]
+func (h *handler) Get(c *gin.Context) {
+	projectID, budgetID, ok := budgetParams(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "projectbudget",
+		"method":    "Get",
+		"projectID": projectID,
+		"budgetID":  budgetID,
+	})
+
+	b, err := h.controller.ProjectBudget.Get(projectID, budgetID)
+	if err != nil {
+		l.Error(err, "failed to get project budget")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgetDetail(b), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create a budget of a project
+// @Description Create a purchase order of a project with its total value, currency and burn alert thresholds
+// @id createProjectBudget
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param Body body ProjectBudgetRequest true "Body"
+// @Success 200 {object} ProjectBudgetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/budgets [post]
+func (h *handler) Create(c *gin.Context) {
+	projectID := c.Param("id")
+	if projectID == "" || !model.IsUUIDFromString(projectID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.ProjectBudgetRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "projectbudget",
+		"method":    "Create",
+		"projectID": projectID,
+		"request":   input,
+	})
+
+	b, err := h.controller.ProjectBudget.Create(projectID, toBudgetInput(input), userID)
+	if err != nil {
+		l.Error(err, "failed to create project budget")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgetDetail(b), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update a budget of a project
+// @Description Update a purchase order, its burn is alerted on again when its total value or thresholds change
+// @id updateProjectBudget
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param budgetID path string true "Budget ID"
+// @Param Body body ProjectBudgetRequest true "Body"
+// @Success 200 {object} ProjectBudgetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/budgets/{budgetID} [put]
+func (h *handler) Update(c *gin.Context) {
+	projectID, budgetID, ok := budgetParams(c)
+	if !ok {
+		return
+	}
+
+	input := request.ProjectBudgetRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "projectbudget",
+		"method":    "Update",
+		"projectID": projectID,
+		"budgetID":  budgetID,
+		"request":   input,
+	})
+
+	b, err := h.controller.ProjectBudget.Update(projectID, budgetID, toBudgetInput(input))
+	if err != nil {
+		l.Error(err, "failed to update project budget")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgetDetail(b), nil, nil, nil, ""))
+}
+
+// SetMilestones godoc
+// @Summary Set the milestones of a budget
+// @Description Replace the planned payments of a purchase order, each can be linked to the invoice billing it
+// @id setProjectBudgetMilestones
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param budgetID path string true "Budget ID"
+// @Param Body body SetProjectBudgetMilestonesRequest true "Body"
+// @Success 200 {object} ProjectBudgetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/budgets/{budgetID}/milestones [put]
+func (h *handler) SetMilestones(c *gin.Context) {
+	projectID, budgetID, ok := budgetParams(c)
+	if !ok {
+		return
+	}
+
+	input := request.SetProjectBudgetMilestonesRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "projectbudget",
+		"method":    "SetMilestones",
+		"projectID": projectID,
+		"budgetID":  budgetID,
+	})
+
+	milestones := make([]ctrlbudget.MilestoneInput, 0, len(input.Milestones))
+	for _, m := range input.Milestones {
+		dueDate, _ := timeutil.ParseOptionalDate(m.DueDate)
+		milestone := ctrlbudget.MilestoneInput{
+			Name:    m.Name,
+			Amount:  m.Amount,
+			DueDate: dueDate,
+		}
+		if id, err := model.UUIDFromString(m.InvoiceID); err == nil {
+			milestone.InvoiceID = &id
+		}
+		milestones = append(milestones, milestone)
+	}
+
+	b, err := h.controller.ProjectBudget.SetMilestones(projectID, budgetID, milestones)
+	if err != nil {
+		l.Error(err, "failed to set project budget milestones")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgetDetail(b), nil, nil, nil, ""))
+}
+
+// Report godoc
+// @Summary Get the burn down of a budget
+// @Description Get how much of the purchase order the issued and paid invoices drew month by month, projected at the current team cost until its end
+// @id getProjectBudgetBurnDown
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param budgetID path string true "Budget ID"
+// @Success 200 {object} ProjectBudgetReportResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/budgets/{budgetID}/burn-down [get]
+func (h *handler) Report(c *gin.Context) {
+	projectID, budgetID, ok := budgetParams(c)
+	if !ok {
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "projectbudget",
+		"method":    "Report",
+		"projectID": projectID,
+		"budgetID":  budgetID,
+	})
+
+	report, err := h.controller.ProjectBudget.Report(projectID, budgetID, time.Now())
+	if err != nil {
+		l.Error(err, "failed to report project budget burn")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgetReport(report), nil, nil, nil, ""))
+}
+
+// AlertBurns godoc
+// @Summary Alert the budget burns
+// @Description Alert the project heads on Discord of the active budgets whose burn crossed a new threshold
+// @id alertProjectBudgetBurns
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} ProjectBudgetReportsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/project-budget-burns [post]
+func (h *handler) AlertBurns(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "projectbudget",
+		"method":  "AlertBurns",
+	})
+
+	reports, err := h.controller.ProjectBudget.AlertBurns(time.Now())
+	if err != nil {
+		l.Error(err, "failed to alert budget burns")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToProjectBudgetReports(reports), nil, nil, nil, ""))
+}
+
+// budgetParams reads the project and budget ids of the path, writing the error when one is invalid
+func budgetParams(c *gin.Context) (string, string, bool) {
+	projectID := c.Param("id")
+	if projectID == "" || !model.IsUUIDFromString(projectID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidProjectID, nil, ""))
+		return "", "", false
+	}
+	budgetID := c.Param("budgetID")
+	if budgetID == "" || !model.IsUUIDFromString(budgetID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidBudgetID, nil, ""))
+		return "", "", false
+	}
+	return projectID, budgetID, true
+}
+
+func toBudgetInput(r request.ProjectBudgetRequest) ctrlbudget.BudgetInput {
+	startDate, _ := timeutil.ParseOptionalDate(r.StartDate)
+	endDate, _ := timeutil.ParseOptionalDate(r.EndDate)
+
+	input := ctrlbudget.BudgetInput{
+		PONumber:        r.PONumber,
+		Name:            r.Name,
+		TotalValue:      r.TotalValue,
+		Currency:        r.Currency,
+		EndDate:         endDate,
+		Status:          model.ProjectBudgetStatus(r.Status),
+		AlertThresholds: r.AlertThresholds,
+		Note:            r.Note,
+	}
+	if startDate != nil {
+		input.StartDate = *startDate
+	}
+	return input
+}
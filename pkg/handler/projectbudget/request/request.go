@@ -0,0 +1,67 @@
+package request
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/projectbudget/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+type ListProjectBudgetsQuery struct {
+	Status string `form:"status" json:"status"` // active or closed
+} // @name ListProjectBudgetsQuery
+
+func (q *ListProjectBudgetsQuery) Validate() error {
+	if q.Status != "" && !model.ProjectBudgetStatus(q.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	return nil
+}
+
+type ProjectBudgetRequest struct {
+	PONumber        string          `json:"poNumber"`
+	Name            string          `json:"name" binding:"required,max=200"`
+	TotalValue      decimal.Decimal `json:"totalValue"`
+	Currency        string          `json:"currency" binding:"required"`
+	StartDate       string          `json:"startDate" binding:"required"` // YYYY-MM-DD
+	EndDate         string          `json:"endDate"`                      // YYYY-MM-DD, open ended when empty
+	Status          string          `json:"status"`                       // active or closed, active when empty
+	AlertThresholds []int64         `json:"alertThresholds"`              // burn percents, the configured ones when empty
+	Note            string          `json:"note"`
+} // @name ProjectBudgetRequest
+
+func (r *ProjectBudgetRequest) Validate() error {
+	if r.Status != "" && !model.ProjectBudgetStatus(r.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	for _, d := range []string{r.StartDate, r.EndDate} {
+		if _, err := timeutil.ParseOptionalDate(d); err != nil {
+			return errs.ErrInvalidDate
+		}
+	}
+	return nil
+}
+
+type ProjectBudgetMilestoneRequest struct {
+	Name      string          `json:"name" binding:"required"`
+	Amount    decimal.Decimal `json:"amount"`
+	DueDate   string          `json:"dueDate"`   // YYYY-MM-DD
+	InvoiceID string          `json:"invoiceID"` // the invoice billing the milestone
+} // @name ProjectBudgetMilestoneRequest
+
+type SetProjectBudgetMilestonesRequest struct {
+	Milestones []ProjectBudgetMilestoneRequest `json:"milestones" binding:"dive"`
+} // @name SetProjectBudgetMilestonesRequest
+
+func (r *SetProjectBudgetMilestonesRequest) Validate() error {
+	for _, m := range r.Milestones {
+		if _, err := timeutil.ParseOptionalDate(m.DueDate); err != nil {
+			return errs.ErrInvalidDate
+		}
+		if m.InvoiceID != "" && !model.IsUUIDFromString(m.InvoiceID) {
+			return errs.ErrInvalidInvoiceID
+		}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package errs
+
+import "errors"
+
+var (
+	ErrInvalidTransactionID = errors.New("invalid bank transaction id")
+	ErrInvalidStatementID   = errors.New("invalid bank statement id")
+	ErrInvalidFormat        = errors.New("invalid format, expected csv, ofx or camt053")
+	ErrInvalidStatus        = errors.New("invalid bank transaction status")
+	ErrInvalidDirection     = errors.New("invalid direction, expected credit or debit")
+	ErrInvalidMatchType     = errors.New("invalid match type, expected invoice, payroll or contractor_payout")
+	ErrMissingMatchRecord   = errors.New("matchID or matchReference is required with matchType")
+)
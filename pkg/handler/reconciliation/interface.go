@@ -0,0 +1,14 @@
+package reconciliation
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	ConfirmMatch(c *gin.Context)
+	GetTransaction(c *gin.Context)
+	IgnoreTransaction(c *gin.Context)
+	ImportStatement(c *gin.Context)
+	ListStatements(c *gin.Context)
+	ListTransactions(c *gin.Context)
+	RejectMatch(c *gin.Context)
+	Rematch(c *gin.Context)
+}
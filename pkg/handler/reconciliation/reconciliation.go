@@ -0,0 +1,447 @@
+package reconciliation
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlreconciliation "github.com/dwarvesf/fortress-api/pkg/controller/reconciliation"
+	"github.com/dwarvesf/fortress-api/pkg/handler/reconciliation/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/reconciliation/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// ImportStatement godoc
+// @Summary Import a bank statement
+// @Description Import a CSV, OFX or CAMT.053 statement and match its transactions with the open invoices, payrolls and contractor payouts
+// @id importBankStatement
+// @Tags Bank Reconciliation
+// @Accept  multipart/form-data
+// @Produce  json
+// @Security BearerAuth
+// @Param file formData file true "Statement file"
+// @Param format formData string false "csv, ofx or camt053, guessed from the file extension when empty"
+// @Success 200 {object} BankStatementImportResultResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bank-statements/import [post]
+func (h *handler) ImportStatement(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	format := model.BankStatementFormat(c.PostForm("format"))
+	if format != "" && !format.IsValid() {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidFormat, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "reconciliation",
+		"method":   "ImportStatement",
+		"fileName": file.Filename,
+	})
+
+	data, err := file.Open()
+	if err != nil {
+		l.Error(err, "failed to open statement file")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+	defer data.Close()
+
+	result, err := h.controller.Reconciliation.Import(ctrlreconciliation.ImportInput{
+		FileName:   file.Filename,
+		Format:     format,
+		ImportedBy: toUUIDPtr(userID),
+	}, data)
+	if err != nil {
+		if errors.Is(err, ctrlreconciliation.ErrUnsupportedFormat) || errors.Is(err, ctrlreconciliation.ErrInvalidStatement) {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+
+		l.Error(err, "failed to import bank statement")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBankStatementImportResult(result), nil, nil, nil, ""))
+}
+
+// ListStatements godoc
+// @Summary Get the imported bank statements
+// @Description Get the imported bank statements, latest first
+// @id getListBankStatements
+// @Tags Bank Reconciliation
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} BankStatementsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bank-statements [get]
+func (h *handler) ListStatements(c *gin.Context) {
+	query := model.Pagination{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reconciliation",
+		"method":  "ListStatements",
+	})
+
+	statements, total, err := h.controller.Reconciliation.ListStatements(query)
+	if err != nil {
+		l.Error(err, "failed to list bank statements")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBankStatements(statements),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// ListTransactions godoc
+// @Summary Get the bank transactions
+// @Description Get the imported bank transactions, status=review gives the review queue
+// @id getListBankTransactions
+// @Tags Bank Reconciliation
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param statementID query string false "Bank statement ID"
+// @Param status query string false "Comma separated statuses: unmatched, review, matched, ignored"
+// @Param direction query string false "credit or debit"
+// @Param matchType query string false "invoice, payroll or contractor_payout"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} BankTransactionsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bank-transactions [get]
+func (h *handler) ListTransactions(c *gin.Context) {
+	query := request.ListTransactionsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reconciliation",
+		"method":  "ListTransactions",
+		"query":   query,
+	})
+
+	transactions, total, err := h.controller.Reconciliation.ListTransactions(ctrlreconciliation.ListTransactionsInput{
+		StatementID: query.StatementID,
+		Statuses:    query.Statuses(),
+		Direction:   model.BankTransactionDirection(query.Direction),
+		MatchType:   model.BankMatchType(query.MatchType),
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list bank transactions")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBankTransactions(transactions),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// GetTransaction godoc
+// @Summary Get a bank transaction
+// @Description Get a bank transaction with its match
+// @id getBankTransaction
+// @Tags Bank Reconciliation
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Bank transaction ID"
+// @Success 200 {object} BankTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bank-transactions/{id} [get]
+func (h *handler) GetTransaction(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTransactionID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reconciliation",
+		"method":  "GetTransaction",
+		"id":      id,
+	})
+
+	transaction, err := h.controller.Reconciliation.GetTransaction(id)
+	if err != nil {
+		if errors.Is(err, ctrlreconciliation.ErrTransactionNotFound) {
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, nil, ""))
+			return
+		}
+
+		l.Error(err, "failed to get bank transaction")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBankTransaction(transaction), nil, nil, nil, ""))
+}
+
+// ConfirmMatch godoc
+// @Summary Confirm the match of a bank transaction
+// @Description Confirm the suggested match, or match the transaction manually. Confirming an invoice match marks the invoice as paid.
+// @id confirmBankTransactionMatch
+// @Tags Bank Reconciliation
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Bank transaction ID"
+// @Param Body body ConfirmMatchRequest false "Body"
+// @Success 200 {object} BankTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bank-transactions/{id}/confirm [post]
+func (h *handler) ConfirmMatch(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTransactionID, nil, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.ConfirmMatchRequest{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reconciliation",
+		"method":  "ConfirmMatch",
+		"id":      id,
+		"request": input,
+	})
+
+	transaction, err := h.controller.Reconciliation.ConfirmMatch(id, ctrlreconciliation.ConfirmMatchInput{
+		MatchType:      model.BankMatchType(input.MatchType),
+		MatchID:        input.MatchID,
+		MatchReference: input.MatchReference,
+		MatchedBy:      toUUIDPtr(userID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ctrlreconciliation.ErrTransactionNotFound),
+			errors.Is(err, ctrlreconciliation.ErrInvoiceNotFound):
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, input, ""))
+		case errors.Is(err, ctrlreconciliation.ErrTransactionMatched),
+			errors.Is(err, ctrlreconciliation.ErrNoMatchToConfirm),
+			errors.Is(err, ctrlreconciliation.ErrInvalidMatchType),
+			errors.Is(err, ctrlreconciliation.ErrMatchDirection),
+			errors.Is(err, ctrlreconciliation.ErrRecordAlreadyMatched),
+			errors.Is(err, ctrlreconciliation.ErrInvoiceNumberMissing):
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		default:
+			l.Error(err, "failed to confirm bank transaction match")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBankTransaction(transaction), nil, nil, nil, ""))
+}
+
+// RejectMatch godoc
+// @Summary Reject the suggested match of a bank transaction
+// @Description Drop the suggested match, the transaction goes back to the unmatched list
+// @id rejectBankTransactionMatch
+// @Tags Bank Reconciliation
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Bank transaction ID"
+// @Success 200 {object} BankTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bank-transactions/{id}/reject [post]
+func (h *handler) RejectMatch(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTransactionID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reconciliation",
+		"method":  "RejectMatch",
+		"id":      id,
+	})
+
+	transaction, err := h.controller.Reconciliation.RejectMatch(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, ctrlreconciliation.ErrTransactionNotFound):
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, nil, ""))
+		case errors.Is(err, ctrlreconciliation.ErrTransactionMatched),
+			errors.Is(err, ctrlreconciliation.ErrNothingToReject):
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		default:
+			l.Error(err, "failed to reject bank transaction match")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBankTransaction(transaction), nil, nil, nil, ""))
+}
+
+// IgnoreTransaction godoc
+// @Summary Ignore a bank transaction
+// @Description Take a transaction out of the reconciliation, e.g. bank fees or internal transfers
+// @id ignoreBankTransaction
+// @Tags Bank Reconciliation
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Bank transaction ID"
+// @Param Body body IgnoreTransactionRequest false "Body"
+// @Success 200 {object} BankTransactionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bank-transactions/{id}/ignore [post]
+func (h *handler) IgnoreTransaction(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTransactionID, nil, ""))
+		return
+	}
+
+	input := request.IgnoreTransactionRequest{}
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&input); err != nil {
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+			return
+		}
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reconciliation",
+		"method":  "IgnoreTransaction",
+		"id":      id,
+	})
+
+	transaction, err := h.controller.Reconciliation.Ignore(id, input.Note)
+	if err != nil {
+		switch {
+		case errors.Is(err, ctrlreconciliation.ErrTransactionNotFound):
+			c.JSON(http.StatusNotFound, view.CreateResponse[any](nil, nil, err, input, ""))
+		case errors.Is(err, ctrlreconciliation.ErrMatchedCannotBeIgnored):
+			c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		default:
+			l.Error(err, "failed to ignore bank transaction")
+			c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, input, ""))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBankTransaction(transaction), nil, nil, nil, ""))
+}
+
+// Rematch godoc
+// @Summary Match the unmatched bank transactions again
+// @Description Run the matching on the unmatched transactions, e.g. after the invoices they pay were created
+// @id rematchBankTransactions
+// @Tags Bank Reconciliation
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} BankStatementImportResultResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /bank-transactions/rematch [post]
+func (h *handler) Rematch(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reconciliation",
+		"method":  "Rematch",
+	})
+
+	result, err := h.controller.Reconciliation.Rematch()
+	if err != nil {
+		l.Error(err, "failed to rematch bank transactions")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToBankStatementImportResult(result), nil, nil, nil, ""))
+}
+
+func toUUIDPtr(s string) *model.UUID {
+	id, err := model.UUIDFromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,70 @@
+package request
+
+import (
+	"strings"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/reconciliation/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ListTransactionsQuery struct {
+	model.Pagination
+
+	StatementID string `form:"statementID" json:"statementID"`
+	Status      string `form:"status" json:"status"` // comma separated, e.g. review,unmatched
+	Direction   string `form:"direction" json:"direction"`
+	MatchType   string `form:"matchType" json:"matchType"`
+} // @name ListTransactionsQuery
+
+func (q *ListTransactionsQuery) Validate() error {
+	if q.StatementID != "" && !model.IsUUIDFromString(q.StatementID) {
+		return errs.ErrInvalidStatementID
+	}
+	for _, s := range q.Statuses() {
+		if !s.IsValid() {
+			return errs.ErrInvalidStatus
+		}
+	}
+	if q.Direction != "" && !model.BankTransactionDirection(q.Direction).IsValid() {
+		return errs.ErrInvalidDirection
+	}
+	if q.MatchType != "" && !model.BankMatchType(q.MatchType).IsValid() {
+		return errs.ErrInvalidMatchType
+	}
+	return nil
+}
+
+func (q *ListTransactionsQuery) Statuses() []model.BankTransactionStatus {
+	var statuses []model.BankTransactionStatus
+	for _, s := range strings.Split(q.Status, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			statuses = append(statuses, model.BankTransactionStatus(s))
+		}
+	}
+	return statuses
+}
+
+// ConfirmMatchRequest confirms the suggested match when empty, otherwise matches
+// the transaction with the given record
+type ConfirmMatchRequest struct {
+	MatchType      string `json:"matchType"`
+	MatchID        string `json:"matchID"`
+	MatchReference string `json:"matchReference"` // invoice number for an invoice match
+} // @name ConfirmMatchRequest
+
+func (r *ConfirmMatchRequest) Validate() error {
+	if r.MatchType == "" {
+		return nil
+	}
+	if !model.BankMatchType(r.MatchType).IsValid() {
+		return errs.ErrInvalidMatchType
+	}
+	if strings.TrimSpace(r.MatchID) == "" && strings.TrimSpace(r.MatchReference) == "" {
+		return errs.ErrMissingMatchRecord
+	}
+	return nil
+}
+
+type IgnoreTransactionRequest struct {
+	Note string `json:"note"`
+} // @name IgnoreTransactionRequest
@@ -0,0 +1,556 @@
+package recruitment
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	ctrlrecruitment "github.com/dwarvesf/fortress-api/pkg/controller/recruitment"
+	"github.com/dwarvesf/fortress-api/pkg/handler/recruitment/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/recruitment/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+// ListCandidates godoc
+// @Summary Get the candidates
+// @Description Get the candidates of the hiring pipeline, latest first
+// @id getListCandidates
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param positionID query string false "Position ID"
+// @Param stageID query string false "Recruitment stage ID"
+// @Param status query string false "approach, offered, failed, hired or reject"
+// @Param keyword query string false "Name, email or phone"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} CandidatesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates [get]
+func (h *handler) ListCandidates(c *gin.Context) {
+	query := request.ListCandidatesQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "ListCandidates",
+		"query":   query,
+	})
+
+	candidates, total, err := h.controller.Recruitment.ListCandidates(ctrlrecruitment.ListCandidatesInput{
+		PositionID: query.PositionID,
+		StageID:    query.StageID,
+		Status:     model.CandidateStatus(query.Status),
+		Keyword:    query.Keyword,
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list candidates")
+		c.JSON(http.StatusInternalServerError, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidates(candidates),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// GetCandidate godoc
+// @Summary Get a candidate
+// @Description Get a candidate with its stage transitions, its interviews and the summary of its scorecards
+// @id getCandidateByID
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Candidate ID"
+// @Success 200 {object} CandidateDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id} [get]
+func (h *handler) GetCandidate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "GetCandidate",
+		"id":      id,
+	})
+
+	candidate, err := h.controller.Recruitment.GetCandidate(id)
+	if err != nil {
+		l.Error(err, "failed to get candidate")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidateDetail(candidate), nil, nil, nil, ""))
+}
+
+// CreateCandidate godoc
+// @Summary Create a candidate
+// @Description Put a candidate in the first stage of the pipeline of its position and send the email of the stage
+// @id createCandidate
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body CandidateRequest true "Body"
+// @Success 200 {object} CandidateDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates [post]
+func (h *handler) CreateCandidate(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	input := request.CandidateRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "CreateCandidate",
+		"request": input,
+	})
+
+	candidate, err := h.controller.Recruitment.CreateCandidate(toCandidateInput(input), toUUIDPtr(userID))
+	if err != nil {
+		l.Error(err, "failed to create candidate")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidateDetail(candidate), nil, nil, nil, ""))
+}
+
+// UpdateCandidate godoc
+// @Summary Update a candidate
+// @Description Update the details of a candidate, its stage is changed by a transition
+// @id updateCandidate
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Candidate ID"
+// @Param Body body CandidateRequest true "Body"
+// @Success 200 {object} CandidateDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id} [put]
+func (h *handler) UpdateCandidate(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+
+	input := request.CandidateRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "UpdateCandidate",
+		"id":      id,
+		"request": input,
+	})
+
+	candidate, err := h.controller.Recruitment.UpdateCandidate(id, toCandidateInput(input))
+	if err != nil {
+		l.Error(err, "failed to update candidate")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidateDetail(candidate), nil, nil, nil, ""))
+}
+
+// UploadCV godoc
+// @Summary Upload the CV of a candidate
+// @Description Upload the CV of a candidate to Google Cloud Storage, as a pdf
+// @id uploadCandidateCV
+// @Tags Recruitment
+// @Accept  multipart/form-data
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Candidate ID"
+// @Param file formData file true "CV, pdf"
+// @Success 200 {object} CandidateDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id}/cv [post]
+func (h *handler) UploadCV(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrMissingFile, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "UploadCV",
+		"id":      id,
+		"file":    file.Filename,
+	})
+
+	candidate, err := h.controller.Recruitment.UploadCV(id, file)
+	if err != nil {
+		l.Error(err, "failed to upload candidate cv")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidateDetail(candidate), nil, nil, nil, ""))
+}
+
+// MoveCandidate godoc
+// @Summary Move a candidate to another stage
+// @Description Move a candidate to another stage of its pipeline, record the transition and send the email of the stage
+// @id moveCandidate
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Candidate ID"
+// @Param Body body TransitionRequest true "Body"
+// @Success 200 {object} CandidateStageTransitionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id}/transitions [post]
+func (h *handler) MoveCandidate(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+
+	input := request.TransitionRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "MoveCandidate",
+		"id":      id,
+		"request": input,
+	})
+
+	offerStartDate, _ := timeutil.ParseOptionalDate(input.OfferStartDate)
+	transition, err := h.controller.Recruitment.MoveCandidate(id, ctrlrecruitment.TransitionInput{
+		StageID:           model.MustGetUUIDFromString(input.StageID),
+		Note:              input.Note,
+		ActorID:           toUUIDPtr(userID),
+		SkipEmail:         input.SkipEmail,
+		OfferSalary:       input.OfferSalary,
+		OfferStartDate:    offerStartDate,
+		ProbationDuration: input.ProbationDuration,
+	})
+	if err != nil {
+		l.Error(err, "failed to move candidate")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidateStageTransition(transition), nil, nil, nil, ""))
+}
+
+// ListTransitions godoc
+// @Summary Get the stage transitions of a candidate
+// @Description Get the audit trail of a candidate moving through the pipeline, oldest first
+// @id getListCandidateTransitions
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Candidate ID"
+// @Success 200 {object} CandidateStageTransitionsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id}/transitions [get]
+func (h *handler) ListTransitions(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "ListTransitions",
+		"id":      id,
+	})
+
+	transitions, err := h.controller.Recruitment.ListTransitions(id)
+	if err != nil {
+		l.Error(err, "failed to list candidate transitions")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidateStageTransitions(transitions), nil, nil, nil, ""))
+}
+
+// AssignInterviewer godoc
+// @Summary Assign an interviewer to a candidate
+// @Description Assign an employee to interview a candidate at a stage, the current one by default
+// @id assignCandidateInterviewer
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Candidate ID"
+// @Param Body body InterviewRequest true "Body"
+// @Success 200 {object} CandidateInterviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id}/interviews [post]
+func (h *handler) AssignInterviewer(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+
+	input := request.InterviewRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "AssignInterviewer",
+		"id":      id,
+		"request": input,
+	})
+
+	interview, err := h.controller.Recruitment.AssignInterviewer(id, ctrlrecruitment.InterviewInput{
+		InterviewerID: model.MustGetUUIDFromString(input.InterviewerID),
+		StageID:       toUUIDPtr(input.StageID),
+		ScheduledAt:   input.ScheduledAt,
+	})
+	if err != nil {
+		l.Error(err, "failed to assign interviewer")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidateInterview(interview), nil, nil, nil, ""))
+}
+
+// SubmitScorecard godoc
+// @Summary Submit the scorecard of an interview
+// @Description Fill the scorecard of an interview, only the assigned interviewer can do it
+// @id submitCandidateScorecard
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Candidate ID"
+// @Param interviewID path string true "Interview ID"
+// @Param Body body ScorecardRequest true "Body"
+// @Success 200 {object} CandidateInterviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id}/interviews/{interviewID}/scorecard [put]
+func (h *handler) SubmitScorecard(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+	interviewID := c.Param("interviewID")
+	if interviewID == "" || !model.IsUUIDFromString(interviewID) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidInterviewID, nil, ""))
+		return
+	}
+
+	input := request.ScorecardRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":     "recruitment",
+		"method":      "SubmitScorecard",
+		"id":          id,
+		"interviewID": interviewID,
+	})
+
+	interview, err := h.controller.Recruitment.SubmitScorecard(id, interviewID, ctrlrecruitment.ScorecardInput{
+		UserID:         userID,
+		Scores:         input.Scores,
+		Recommendation: model.InterviewRecommendation(input.Recommendation),
+		Feedback:       input.Feedback,
+	})
+	if err != nil {
+		l.Error(err, "failed to submit scorecard")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToCandidateInterview(interview), nil, nil, nil, ""))
+}
+
+// ConvertToEmployee godoc
+// @Summary Convert a hired candidate to an employee
+// @Description Create the employee and the invitation of a hired candidate, pre-filled with the name, the email, the position and the offer of the candidate
+// @id convertCandidateToEmployee
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Candidate ID"
+// @Param Body body ConvertRequest true "Body"
+// @Success 200 {object} EmployeeDataResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /candidates/{id}/convert [post]
+func (h *handler) ConvertToEmployee(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCandidateID, nil, ""))
+		return
+	}
+
+	input := request.ConvertRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "ConvertToEmployee",
+		"id":      id,
+		"request": input,
+	})
+
+	roles := make([]model.UUID, 0, len(input.Roles))
+	for _, r := range input.Roles {
+		roles = append(roles, model.MustGetUUIDFromString(r))
+	}
+	joinDate, _ := timeutil.ParseOptionalDate(input.JoinedDate)
+
+	emp, err := h.controller.Recruitment.ConvertToEmployee(id, userID, ctrlrecruitment.ConvertInput{
+		TeamEmail:   input.TeamEmail,
+		DisplayName: input.DisplayName,
+		SeniorityID: model.MustGetUUIDFromString(input.SeniorityID),
+		Roles:       roles,
+		Positions:   input.Positions,
+		Salary:      input.Salary,
+		JoinDate:    joinDate,
+		Status:      model.WorkingStatus(input.Status),
+		ReferredBy:  toUUIDPtr(input.ReferredBy),
+		SkipEmail:   input.SkipEmail,
+	})
+	if err != nil {
+		l.Error(err, "failed to convert candidate to employee")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToEmployeeData(emp), nil, nil, nil, ""))
+}
+
+func toCandidateInput(r request.CandidateRequest) ctrlrecruitment.CandidateInput {
+	offerStartDate, _ := timeutil.ParseOptionalDate(r.OfferStartDate)
+	return ctrlrecruitment.CandidateInput{
+		Name:              r.Name,
+		Email:             r.Email,
+		Phone:             r.Phone,
+		Role:              r.Role,
+		Source:            r.Source,
+		Type:              model.HiringType(r.Type),
+		PositionID:        toUUIDPtr(r.PositionID),
+		Detail:            r.Detail,
+		CCAT:              r.CCAT,
+		EPP:               r.EPP,
+		ReferralInfo:      r.ReferralInfo,
+		OfferSalary:       r.OfferSalary,
+		OfferStartDate:    offerStartDate,
+		ProbationDuration: r.ProbationDuration,
+		SkipEmail:         r.SkipEmail,
+	}
+}
@@ -0,0 +1,79 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/recruitment"
+	employeeerrs "github.com/dwarvesf/fortress-api/pkg/handler/employee/errs"
+	pipeline "github.com/dwarvesf/fortress-api/pkg/recruitment"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidStageID       = errors.New("invalid recruitment stage id")
+	ErrInvalidCandidateID   = errors.New("invalid candidate id")
+	ErrInvalidInterviewID   = errors.New("invalid interview id")
+	ErrInvalidPositionID    = errors.New("invalid position id")
+	ErrInvalidInterviewerID = errors.New("invalid interviewer id")
+	ErrInvalidSeniorityID   = errors.New("invalid seniority id")
+	ErrInvalidRoleID        = errors.New("invalid role id")
+	ErrInvalidReferrerID    = errors.New("invalid referrer id")
+	ErrInvalidStatus        = errors.New("invalid candidate status")
+	ErrInvalidHiringType    = errors.New("invalid hiring type, expected direct, referral or internship")
+	ErrInvalidWorkingStatus = errors.New("invalid working status")
+	ErrInvalidDate          = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidOffer         = errors.New("offer salary and probation duration must not be negative")
+	ErrMissingFile          = errors.New("missing file")
+)
+
+// ConvertControllerErr writes the status of a recruitment controller error, the errors of the
+// employee creation are left to the employee handler
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, recruitment.ErrStageNotFound),
+		errors.Is(err, recruitment.ErrPositionNotFound),
+		errors.Is(err, recruitment.ErrCandidateNotFound),
+		errors.Is(err, recruitment.ErrInterviewNotFound),
+		errors.Is(err, recruitment.ErrInterviewerNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, recruitment.ErrNotInterviewer):
+		status = http.StatusForbidden
+
+	case errors.Is(err, recruitment.ErrStageInUse),
+		errors.Is(err, recruitment.ErrStageCodeExisted),
+		errors.Is(err, recruitment.ErrInvalidCandidateStatus),
+		errors.Is(err, recruitment.ErrInvalidEmailTemplate),
+		errors.Is(err, recruitment.ErrEmptyPipeline),
+		errors.Is(err, recruitment.ErrInvalidFileExtension),
+		errors.Is(err, recruitment.ErrInvalidFileSize),
+		errors.Is(err, recruitment.ErrMissingOffer),
+		errors.Is(err, recruitment.ErrInterviewerAssigned),
+		errors.Is(err, recruitment.ErrInvalidRecommendation),
+		errors.Is(err, recruitment.ErrCandidateNotHired),
+		errors.Is(err, recruitment.ErrCandidateAlreadyConvert),
+		errors.Is(err, recruitment.ErrMissingJoinDate),
+		errors.Is(err, pipeline.ErrStageNotInPipeline),
+		errors.Is(err, pipeline.ErrSameStage),
+		errors.Is(err, pipeline.ErrTerminalStage),
+		errors.Is(err, pipeline.ErrNotOffered),
+		errors.Is(err, pipeline.ErrEmptyScorecard),
+		errors.Is(err, pipeline.ErrInvalidScore):
+		status = http.StatusBadRequest
+
+	default:
+		employeeerrs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
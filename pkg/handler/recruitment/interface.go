@@ -0,0 +1,20 @@
+package recruitment
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	AssignInterviewer(c *gin.Context)
+	ConvertToEmployee(c *gin.Context)
+	CreateCandidate(c *gin.Context)
+	CreateStage(c *gin.Context)
+	DeleteStage(c *gin.Context)
+	GetCandidate(c *gin.Context)
+	ListCandidates(c *gin.Context)
+	ListStages(c *gin.Context)
+	ListTransitions(c *gin.Context)
+	MoveCandidate(c *gin.Context)
+	SubmitScorecard(c *gin.Context)
+	UpdateCandidate(c *gin.Context)
+	UpdateStage(c *gin.Context)
+	UploadCV(c *gin.Context)
+}
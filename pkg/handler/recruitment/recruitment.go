@@ -0,0 +1,225 @@
+package recruitment
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlrecruitment "github.com/dwarvesf/fortress-api/pkg/controller/recruitment"
+	"github.com/dwarvesf/fortress-api/pkg/handler/recruitment/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/recruitment/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// ListStages godoc
+// @Summary Get the recruitment stages
+// @Description Get the stages of the hiring pipeline of a position, the default pipeline when the position has no stage of its own, or every stage when no position is given
+// @id getListRecruitmentStages
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param positionID query string false "Position ID"
+// @Success 200 {object} RecruitmentStagesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /recruitment/stages [get]
+func (h *handler) ListStages(c *gin.Context) {
+	query := request.ListStagesQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "ListStages",
+		"query":   query,
+	})
+
+	stages, err := h.controller.Recruitment.ListStages(query.PositionID)
+	if err != nil {
+		l.Error(err, "failed to list recruitment stages")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToRecruitmentStages(stages), nil, nil, nil, ""))
+}
+
+// CreateStage godoc
+// @Summary Create a recruitment stage
+// @Description Add a stage to the pipeline of a position, or to the default pipeline when no position is given
+// @id createRecruitmentStage
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body StageRequest true "Body"
+// @Success 200 {object} RecruitmentStageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /recruitment/stages [post]
+func (h *handler) CreateStage(c *gin.Context) {
+	input := request.StageRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "CreateStage",
+		"request": input,
+	})
+
+	stage, err := h.controller.Recruitment.CreateStage(toStageInput(input))
+	if err != nil {
+		l.Error(err, "failed to create recruitment stage")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToRecruitmentStage(stage), nil, nil, nil, ""))
+}
+
+// UpdateStage godoc
+// @Summary Update a recruitment stage
+// @Description Update a stage of a hiring pipeline
+// @id updateRecruitmentStage
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Recruitment stage ID"
+// @Param Body body StageRequest true "Body"
+// @Success 200 {object} RecruitmentStageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /recruitment/stages/{id} [put]
+func (h *handler) UpdateStage(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidStageID, nil, ""))
+		return
+	}
+
+	input := request.StageRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "UpdateStage",
+		"id":      id,
+		"request": input,
+	})
+
+	stage, err := h.controller.Recruitment.UpdateStage(id, toStageInput(input))
+	if err != nil {
+		l.Error(err, "failed to update recruitment stage")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToRecruitmentStage(stage), nil, nil, nil, ""))
+}
+
+// DeleteStage godoc
+// @Summary Delete a recruitment stage
+// @Description Delete a stage no candidate is in
+// @id deleteRecruitmentStage
+// @Tags Recruitment
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Recruitment stage ID"
+// @Success 200 {object} MessageResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /recruitment/stages/{id} [delete]
+func (h *handler) DeleteStage(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidStageID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "recruitment",
+		"method":  "DeleteStage",
+		"id":      id,
+	})
+
+	if err := h.controller.Recruitment.DeleteStage(id); err != nil {
+		l.Error(err, "failed to delete recruitment stage")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](nil, nil, nil, nil, "ok"))
+}
+
+func toStageInput(r request.StageRequest) ctrlrecruitment.StageInput {
+	return ctrlrecruitment.StageInput{
+		PositionID:      toUUIDPtr(r.PositionID),
+		Code:            r.Code,
+		Name:            r.Name,
+		SortOrder:       r.SortOrder,
+		CandidateStatus: model.CandidateStatus(r.CandidateStatus),
+		EmailTemplate:   r.EmailTemplate,
+		IsTerminal:      r.IsTerminal,
+	}
+}
+
+func toUUIDPtr(s string) *model.UUID {
+	id, err := model.UUIDFromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,178 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/recruitment/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+type ListStagesQuery struct {
+	PositionID string `form:"positionID" json:"positionID"` // the pipeline of the position, every stage when empty
+} // @name ListStagesQuery
+
+func (q *ListStagesQuery) Validate() error {
+	if q.PositionID != "" && !model.IsUUIDFromString(q.PositionID) {
+		return errs.ErrInvalidPositionID
+	}
+	return nil
+}
+
+type StageRequest struct {
+	PositionID      string `json:"positionID"` // empty for a stage of the default pipeline
+	Code            string `json:"code" binding:"required,max=50"`
+	Name            string `json:"name" binding:"required,max=100"`
+	SortOrder       int    `json:"sortOrder"`
+	CandidateStatus string `json:"candidateStatus" binding:"required"`
+	EmailTemplate   string `json:"emailTemplate"` // sent to the candidates entering the stage
+	IsTerminal      bool   `json:"isTerminal"`
+} // @name StageRequest
+
+func (r *StageRequest) Validate() error {
+	if r.PositionID != "" && !model.IsUUIDFromString(r.PositionID) {
+		return errs.ErrInvalidPositionID
+	}
+	if !model.CandidateStatus(r.CandidateStatus).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	return nil
+}
+
+type ListCandidatesQuery struct {
+	model.Pagination
+
+	PositionID string `form:"positionID" json:"positionID"`
+	StageID    string `form:"stageID" json:"stageID"`
+	Status     string `form:"status" json:"status"`
+	Keyword    string `form:"keyword" json:"keyword"` // matches the name, the email or the phone
+} // @name ListCandidatesQuery
+
+func (q *ListCandidatesQuery) Validate() error {
+	if q.PositionID != "" && !model.IsUUIDFromString(q.PositionID) {
+		return errs.ErrInvalidPositionID
+	}
+	if q.StageID != "" && !model.IsUUIDFromString(q.StageID) {
+		return errs.ErrInvalidStageID
+	}
+	if q.Status != "" && !model.CandidateStatus(q.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	return nil
+}
+
+type CandidateRequest struct {
+	Name              string              `json:"name" binding:"required,max=100"`
+	Email             string              `json:"email" binding:"required,email"`
+	Phone             string              `json:"phone"`
+	Role              string              `json:"role"`
+	Source            string              `json:"source"`
+	Type              string              `json:"type"`
+	PositionID        string              `json:"positionID"`
+	Detail            string              `json:"detail"`
+	CCAT              int                 `json:"ccat"`
+	EPP               int                 `json:"epp"`
+	ReferralInfo      *model.ReferralInfo `json:"referralInfo"`
+	OfferSalary       int                 `json:"offerSalary"`
+	OfferStartDate    string              `json:"offerStartDate"` // YYYY-MM-DD
+	ProbationDuration int                 `json:"probationDuration"`
+	SkipEmail         bool                `json:"skipEmail"` // on creation, do not send the email of the first stage
+} // @name CandidateRequest
+
+func (r *CandidateRequest) Validate() error {
+	switch model.HiringType(r.Type) {
+	case "", model.HiringTypeDirect, model.HiringTypeReferral, model.HiringTypeInternship:
+	default:
+		return errs.ErrInvalidHiringType
+	}
+	if r.PositionID != "" && !model.IsUUIDFromString(r.PositionID) {
+		return errs.ErrInvalidPositionID
+	}
+	if r.OfferSalary < 0 || r.ProbationDuration < 0 {
+		return errs.ErrInvalidOffer
+	}
+	if _, err := timeutil.ParseOptionalDate(r.OfferStartDate); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type TransitionRequest struct {
+	StageID   string `json:"stageID" binding:"required"`
+	Note      string `json:"note"`
+	SkipEmail bool   `json:"skipEmail"` // do not send the email of the stage
+
+	// the offer, required to move the candidate to an offered stage when not set yet
+	OfferSalary       *int   `json:"offerSalary"`
+	OfferStartDate    string `json:"offerStartDate"` // YYYY-MM-DD
+	ProbationDuration *int   `json:"probationDuration"`
+} // @name TransitionRequest
+
+func (r *TransitionRequest) Validate() error {
+	if !model.IsUUIDFromString(r.StageID) {
+		return errs.ErrInvalidStageID
+	}
+	if (r.OfferSalary != nil && *r.OfferSalary < 0) || (r.ProbationDuration != nil && *r.ProbationDuration < 0) {
+		return errs.ErrInvalidOffer
+	}
+	if _, err := timeutil.ParseOptionalDate(r.OfferStartDate); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type InterviewRequest struct {
+	InterviewerID string     `json:"interviewerID" binding:"required"`
+	StageID       string     `json:"stageID"` // the current stage of the candidate when empty
+	ScheduledAt   *time.Time `json:"scheduledAt"`
+} // @name InterviewRequest
+
+func (r *InterviewRequest) Validate() error {
+	if !model.IsUUIDFromString(r.InterviewerID) {
+		return errs.ErrInvalidInterviewerID
+	}
+	if r.StageID != "" && !model.IsUUIDFromString(r.StageID) {
+		return errs.ErrInvalidStageID
+	}
+	return nil
+}
+
+type ScorecardRequest struct {
+	Scores         map[string]int `json:"scores" binding:"required"` // criterion to score, from 1 to 5
+	Recommendation string         `json:"recommendation" binding:"required"`
+	Feedback       string         `json:"feedback"`
+} // @name ScorecardRequest
+
+type ConvertRequest struct {
+	TeamEmail   string       `json:"teamEmail" binding:"required,email"`
+	DisplayName string       `json:"displayName"`
+	SeniorityID string       `json:"seniorityID" binding:"required"`
+	Roles       []string     `json:"roles" binding:"required"`
+	Positions   []model.UUID `json:"positions"`  // the position of the candidate when empty
+	Salary      *int64       `json:"salary"`     // the offer salary when empty
+	JoinedDate  string       `json:"joinedDate"` // YYYY-MM-DD, the offer start date when empty
+	Status      string       `json:"status"`     // probation when empty
+	ReferredBy  string       `json:"referredBy"` // looked up by the referral info email when empty
+	SkipEmail   bool         `json:"skipEmail"`
+} // @name ConvertRequest
+
+func (r *ConvertRequest) Validate() error {
+	if !model.IsUUIDFromString(r.SeniorityID) {
+		return errs.ErrInvalidSeniorityID
+	}
+	for _, id := range r.Roles {
+		if !model.IsUUIDFromString(id) {
+			return errs.ErrInvalidRoleID
+		}
+	}
+	if r.ReferredBy != "" && !model.IsUUIDFromString(r.ReferredBy) {
+		return errs.ErrInvalidReferrerID
+	}
+	if r.Status != "" && !model.WorkingStatus(r.Status).IsValid() {
+		return errs.ErrInvalidWorkingStatus
+	}
+	if _, err := timeutil.ParseOptionalDate(r.JoinedDate); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
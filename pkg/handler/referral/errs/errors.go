@@ -0,0 +1,46 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/referral"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidReferralID  = errors.New("invalid referral id")
+	ErrInvalidMilestoneID = errors.New("invalid referral bonus milestone id")
+	ErrInvalidReferrerID  = errors.New("invalid referrer id")
+	ErrInvalidStatus      = errors.New("invalid referral status")
+	ErrInvalidTrigger     = errors.New("invalid trigger, expected hired, probation or tenure")
+)
+
+// ConvertControllerErr writes the status of a referral controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, referral.ErrMilestoneNotFound),
+		errors.Is(err, referral.ErrReferralNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, referral.ErrMilestoneCodeExisted),
+		errors.Is(err, referral.ErrInvalidTrigger),
+		errors.Is(err, referral.ErrInvalidTenureMonths),
+		errors.Is(err, referral.ErrInvalidAmount),
+		errors.Is(err, referral.ErrInvalidReferralStatus):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
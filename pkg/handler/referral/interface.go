@@ -0,0 +1,13 @@
+package referral
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	CreateMilestone(c *gin.Context)
+	GetReferral(c *gin.Context)
+	ListMilestones(c *gin.Context)
+	ListMyReferrals(c *gin.Context)
+	ListReferrals(c *gin.Context)
+	ProcessMilestones(c *gin.Context)
+	UpdateMilestone(c *gin.Context)
+}
@@ -0,0 +1,333 @@
+package referral
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlreferral "github.com/dwarvesf/fortress-api/pkg/controller/referral"
+	"github.com/dwarvesf/fortress-api/pkg/handler/referral/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/referral/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// ListReferrals godoc
+// @Summary Get the referrals
+// @Description Get the referred candidates with their progress and their bonuses, latest first
+// @id getListReferrals
+// @Tags Referral
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param referrerID query string false "Referrer ID"
+// @Param status query string false "referred, offered, hired, probation-passed, completed, failed or left"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} ReferralsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /referrals [get]
+func (h *handler) ListReferrals(c *gin.Context) {
+	query := request.ListReferralsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "referral",
+		"method":  "ListReferrals",
+		"query":   query,
+	})
+
+	referrals, total, err := h.controller.Referral.ListReferrals(ctrlreferral.ListReferralsInput{
+		ReferrerID: query.ReferrerID,
+		Status:     model.ReferralStatus(query.Status),
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list referrals")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReferrals(referrals),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// ListMyReferrals godoc
+// @Summary Get my referrals
+// @Description Get the candidates referred by the current user, with their progress and the bonuses scheduled or paid
+// @id getListMyReferrals
+// @Tags Profile
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param status query string false "referred, offered, hired, probation-passed, completed, failed or left"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} ReferralsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /profile/referrals [get]
+func (h *handler) ListMyReferrals(c *gin.Context) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	query := request.ListMyReferralsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "referral",
+		"method":  "ListMyReferrals",
+		"userID":  userID,
+	})
+
+	referrals, total, err := h.controller.Referral.ListReferrals(ctrlreferral.ListReferralsInput{
+		ReferrerID: userID,
+		Status:     model.ReferralStatus(query.Status),
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list referrals of user")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReferrals(referrals),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// GetReferral godoc
+// @Summary Get a referral
+// @Description Get a referral with its progress and its bonuses
+// @id getReferralByID
+// @Tags Referral
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Referral ID"
+// @Success 200 {object} ReferralResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /referrals/{id} [get]
+func (h *handler) GetReferral(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidReferralID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "referral",
+		"method":  "GetReferral",
+		"id":      id,
+	})
+
+	ref, err := h.controller.Referral.GetReferral(id)
+	if err != nil {
+		l.Error(err, "failed to get referral")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReferral(ref), nil, nil, nil, ""))
+}
+
+// ListMilestones godoc
+// @Summary Get the referral bonus milestones
+// @Description Get the milestones of the referral program and the bonus each of them pays
+// @id getListReferralBonusMilestones
+// @Tags Referral
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} ReferralBonusMilestonesResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /referrals/milestones [get]
+func (h *handler) ListMilestones(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "referral",
+		"method":  "ListMilestones",
+	})
+
+	milestones, err := h.controller.Referral.ListMilestones()
+	if err != nil {
+		l.Error(err, "failed to list referral bonus milestones")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReferralBonusMilestones(milestones), nil, nil, nil, ""))
+}
+
+// CreateMilestone godoc
+// @Summary Create a referral bonus milestone
+// @Description Add a milestone to the referral program, the referrals reaching it from then on are paid its bonus
+// @id createReferralBonusMilestone
+// @Tags Referral
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body ReferralBonusMilestoneRequest true "Body"
+// @Success 200 {object} ReferralBonusMilestoneResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /referrals/milestones [post]
+func (h *handler) CreateMilestone(c *gin.Context) {
+	input := request.MilestoneRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "referral",
+		"method":  "CreateMilestone",
+		"request": input,
+	})
+
+	milestone, err := h.controller.Referral.CreateMilestone(toMilestoneInput(input))
+	if err != nil {
+		l.Error(err, "failed to create referral bonus milestone")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReferralBonusMilestone(milestone), nil, nil, nil, ""))
+}
+
+// UpdateMilestone godoc
+// @Summary Update a referral bonus milestone
+// @Description Update a milestone of the referral program, the bonuses already scheduled are left unchanged
+// @id updateReferralBonusMilestone
+// @Tags Referral
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Referral bonus milestone ID"
+// @Param Body body ReferralBonusMilestoneRequest true "Body"
+// @Success 200 {object} ReferralBonusMilestoneResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /referrals/milestones/{id} [put]
+func (h *handler) UpdateMilestone(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidMilestoneID, nil, ""))
+		return
+	}
+
+	input := request.MilestoneRequest{}
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+	if err := input.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, input, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "referral",
+		"method":  "UpdateMilestone",
+		"id":      id,
+		"request": input,
+	})
+
+	milestone, err := h.controller.Referral.UpdateMilestone(id, toMilestoneInput(input))
+	if err != nil {
+		l.Error(err, "failed to update referral bonus milestone")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReferralBonusMilestone(milestone), nil, nil, nil, ""))
+}
+
+// ProcessMilestones godoc
+// @Summary Schedule the referral bonuses
+// @Description Check the referred hires against the milestones and schedule a bonus in the next payroll of the referrer for each milestone newly reached
+// @id processReferralMilestones
+// @Tags Referral
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} ReferralBonusesResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cronjobs/referral-bonuses [post]
+func (h *handler) ProcessMilestones(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "referral",
+		"method":  "ProcessMilestones",
+	})
+
+	bonuses, err := h.controller.Referral.ProcessMilestones()
+	if err != nil {
+		l.Error(err, "failed to process referral milestones")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReferralBonuses(bonuses), nil, nil, nil, ""))
+}
+
+func toMilestoneInput(r request.MilestoneRequest) ctrlreferral.MilestoneInput {
+	return ctrlreferral.MilestoneInput{
+		Code:         r.Code,
+		Name:         r.Name,
+		Trigger:      model.ReferralMilestoneTrigger(r.Trigger),
+		TenureMonths: r.TenureMonths,
+		Amount:       r.Amount,
+		IsActive:     r.IsActive,
+		SortOrder:    r.SortOrder,
+	}
+}
@@ -0,0 +1,53 @@
+package request
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/referral/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ListReferralsQuery struct {
+	model.Pagination
+
+	ReferrerID string `form:"referrerID" json:"referrerID"`
+	Status     string `form:"status" json:"status"`
+} // @name ListReferralsQuery
+
+func (q *ListReferralsQuery) Validate() error {
+	if q.ReferrerID != "" && !model.IsUUIDFromString(q.ReferrerID) {
+		return errs.ErrInvalidReferrerID
+	}
+	if q.Status != "" && !model.ReferralStatus(q.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	return nil
+}
+
+type ListMyReferralsQuery struct {
+	model.Pagination
+
+	Status string `form:"status" json:"status"`
+} // @name ListMyReferralsQuery
+
+func (q *ListMyReferralsQuery) Validate() error {
+	if q.Status != "" && !model.ReferralStatus(q.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	return nil
+}
+
+type MilestoneRequest struct {
+	Code         string `json:"code" binding:"required,max=50"`
+	Name         string `json:"name" binding:"required,max=100"`
+	Trigger      string `json:"trigger" binding:"required"`     // hired, probation or tenure
+	TenureMonths int    `json:"tenureMonths"`                   // months after the join date, for a tenure milestone
+	Amount       int64  `json:"amount" binding:"required,gt=0"` // in VND
+	IsActive     bool   `json:"isActive"`
+	SortOrder    int    `json:"sortOrder"`
+} // @name ReferralBonusMilestoneRequest
+
+func (r *MilestoneRequest) Validate() error {
+	if !model.ReferralMilestoneTrigger(r.Trigger).IsValid() {
+		return errs.ErrInvalidTrigger
+	}
+	return nil
+}
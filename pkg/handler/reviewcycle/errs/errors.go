@@ -0,0 +1,60 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/reviewcycle"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidCycleID     = errors.New("invalid review cycle id")
+	ErrInvalidPacketID    = errors.New("invalid review packet id")
+	ErrInvalidEmployeeID  = errors.New("invalid employee id")
+	ErrInvalidSeniorityID = errors.New("invalid seniority id")
+	ErrInvalidDate        = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidUserID      = errors.New("invalid user id, the review cycles are run by an employee")
+)
+
+// ConvertControllerErr writes the status of a review cycle controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, reviewcycle.ErrCycleNotFound),
+		errors.Is(err, reviewcycle.ErrPacketNotFound),
+		errors.Is(err, reviewcycle.ErrEmployeeNotFound),
+		errors.Is(err, reviewcycle.ErrSeniorityNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, reviewcycle.ErrInvalidPeriod),
+		errors.Is(err, reviewcycle.ErrInvalidRating),
+		errors.Is(err, reviewcycle.ErrEmployeeNoSeniority),
+		errors.Is(err, reviewcycle.ErrNoEmployees):
+		status = http.StatusBadRequest
+
+	case errors.Is(err, reviewcycle.ErrCycleNotDraft),
+		errors.Is(err, reviewcycle.ErrCycleNotInProgress),
+		errors.Is(err, reviewcycle.ErrCycleNotCalibrating),
+		errors.Is(err, reviewcycle.ErrPacketApproved),
+		errors.Is(err, reviewcycle.ErrPacketNotCalibrated),
+		errors.Is(err, reviewcycle.ErrUndecidedPackets):
+		status = http.StatusConflict
+
+	case errors.Is(err, reviewcycle.ErrSelfApproval),
+		errors.Is(err, reviewcycle.ErrCalibratorApproval):
+		status = http.StatusForbidden
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,17 @@
+package reviewcycle
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	List(c *gin.Context)
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Start(c *gin.Context)
+	StartCalibration(c *gin.Context)
+	Close(c *gin.Context)
+
+	GetPacket(c *gin.Context)
+	CalibratePacket(c *gin.Context)
+	ApprovePacket(c *gin.Context)
+	RejectPacket(c *gin.Context)
+}
@@ -0,0 +1,55 @@
+package request
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type CreateCycleRequest struct {
+	Name        string   `json:"name" binding:"required"`
+	PeriodFrom  string   `json:"periodFrom" binding:"required"` // YYYY-MM-DD
+	PeriodTo    string   `json:"periodTo" binding:"required"`   // YYYY-MM-DD, included
+	EmployeeIDs []string `json:"employeeIDs"`                   // every full-time employee when empty
+} // @name CreateReviewCycleRequest
+
+// Period parses the period of the cycle
+func (r *CreateCycleRequest) Period() (from, to time.Time, err error) {
+	if from, err = time.Parse("2006-01-02", r.PeriodFrom); err != nil {
+		return from, to, errs.ErrInvalidDate
+	}
+	if to, err = time.Parse("2006-01-02", r.PeriodTo); err != nil {
+		return from, to, errs.ErrInvalidDate
+	}
+	return from, to, nil
+}
+
+func (r *CreateCycleRequest) Validate() error {
+	if _, _, err := r.Period(); err != nil {
+		return err
+	}
+	for _, id := range r.EmployeeIDs {
+		if !model.IsUUIDFromString(id) {
+			return errs.ErrInvalidEmployeeID
+		}
+	}
+	return nil
+}
+
+type CalibratePacketRequest struct {
+	FinalRating         int    `json:"finalRating" binding:"required"` // 1 to 5
+	ProposedSeniorityID string `json:"proposedSeniorityID"`            // the seniority which goes with the final rating when empty
+	Note                string `json:"note"`
+} // @name CalibrateReviewPacketRequest
+
+func (r *CalibratePacketRequest) Validate() error {
+	if r.ProposedSeniorityID != "" && !model.IsUUIDFromString(r.ProposedSeniorityID) {
+		return errs.ErrInvalidSeniorityID
+	}
+	return nil
+}
+
+type RejectPacketRequest struct {
+	Note string `json:"note"`
+} // @name RejectReviewPacketRequest
@@ -0,0 +1,503 @@
+package reviewcycle
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlreviewcycle "github.com/dwarvesf/fortress-api/pkg/controller/reviewcycle"
+	"github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// List godoc
+// @Summary List the review cycles
+// @Description List the performance review cycles, latest period first
+// @id listReviewCycles
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Success 200 {object} ReviewCyclesResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles [get]
+func (h *handler) List(c *gin.Context) {
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reviewcycle",
+		"method":  "List",
+	})
+
+	cycles, err := h.controller.ReviewCycle.ListCycles()
+	if err != nil {
+		l.Error(err, "failed to list review cycles")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewCycles(cycles), nil, nil, nil, ""))
+}
+
+// Get godoc
+// @Summary Get a review cycle
+// @Description Get a review cycle with the review packet of every employee
+// @id getReviewCycle
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Review Cycle ID"
+// @Success 200 {object} ReviewCycleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles/{id} [get]
+func (h *handler) Get(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCycleID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reviewcycle",
+		"method":  "Get",
+		"id":      id,
+	})
+
+	cycle, err := h.controller.ReviewCycle.GetCycle(id)
+	if err != nil {
+		l.Error(err, "failed to get review cycle")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewCycle(cycle), nil, nil, nil, ""))
+}
+
+// Create godoc
+// @Summary Create a review cycle
+// @Description Create a draft review cycle with a review packet per employee, reviewed by their line manager
+// @id createReviewCycle
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body CreateReviewCycleRequest true "Body"
+// @Success 200 {object} ReviewCycleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles [post]
+func (h *handler) Create(c *gin.Context) {
+	userID, err := h.userID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	req := request.CreateCycleRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reviewcycle",
+		"method":  "Create",
+		"request": req,
+	})
+
+	from, to, _ := req.Period()
+	cycle, err := h.controller.ReviewCycle.CreateCycle(ctrlreviewcycle.CreateCycleInput{
+		Name:        req.Name,
+		PeriodFrom:  from,
+		PeriodTo:    to,
+		EmployeeIDs: req.EmployeeIDs,
+		CreatedBy:   userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to create review cycle")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewCycle(cycle), nil, nil, nil, ""))
+}
+
+// Start godoc
+// @Summary Start a review cycle
+// @Description Send the self and manager review forms of a draft cycle, answered from the feedback inbox
+// @id startReviewCycle
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Review Cycle ID"
+// @Success 200 {object} ReviewCycleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles/{id}/start [post]
+func (h *handler) Start(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCycleID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reviewcycle",
+		"method":  "Start",
+		"id":      id,
+	})
+
+	cycle, err := h.controller.ReviewCycle.StartCycle(id)
+	if err != nil {
+		l.Error(err, "failed to start review cycle")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewCycle(cycle), nil, nil, nil, ""))
+}
+
+// StartCalibration godoc
+// @Summary Start the calibration of a review cycle
+// @Description Close the review forms and collect the peer feedback, MMA score, delivery and audits of the period into the open packets with a suggested rating. Run it again to take in the late reviews.
+// @id startReviewCycleCalibration
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Review Cycle ID"
+// @Success 200 {object} ReviewCycleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles/{id}/calibration [post]
+func (h *handler) StartCalibration(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCycleID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reviewcycle",
+		"method":  "StartCalibration",
+		"id":      id,
+	})
+
+	cycle, err := h.controller.ReviewCycle.StartCalibration(id)
+	if err != nil {
+		l.Error(err, "failed to start the calibration of review cycle")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewCycle(cycle), nil, nil, nil, ""))
+}
+
+// Close godoc
+// @Summary Close a review cycle
+// @Description Close a review cycle once every packet is approved or rejected
+// @id closeReviewCycle
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Review Cycle ID"
+// @Success 200 {object} ReviewCycleResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles/{id}/close [post]
+func (h *handler) Close(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidCycleID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "reviewcycle",
+		"method":  "Close",
+		"id":      id,
+	})
+
+	cycle, err := h.controller.ReviewCycle.CloseCycle(id)
+	if err != nil {
+		l.Error(err, "failed to close review cycle")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewCycle(cycle), nil, nil, nil, ""))
+}
+
+// GetPacket godoc
+// @Summary Get a review packet
+// @Description Get the review packet of an employee with the evidence of the period
+// @id getReviewPacket
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Review Cycle ID"
+// @Param packetID path string true "Review Packet ID"
+// @Success 200 {object} ReviewPacketResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles/{id}/packets/{packetID} [get]
+func (h *handler) GetPacket(c *gin.Context) {
+	id, packetID, err := packetParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "reviewcycle",
+		"method":   "GetPacket",
+		"id":       id,
+		"packetID": packetID,
+	})
+
+	packet, err := h.controller.ReviewCycle.GetPacket(id, packetID)
+	if err != nil {
+		l.Error(err, "failed to get review packet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewPacket(packet), nil, nil, nil, ""))
+}
+
+// CalibratePacket godoc
+// @Summary Calibrate a review packet
+// @Description Set the final rating of an employee and the seniority they are proposed, the next seniority from a rating of 4 when not given
+// @id calibrateReviewPacket
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Review Cycle ID"
+// @Param packetID path string true "Review Packet ID"
+// @Param Body body CalibrateReviewPacketRequest true "Body"
+// @Success 200 {object} ReviewPacketResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles/{id}/packets/{packetID}/calibration [put]
+func (h *handler) CalibratePacket(c *gin.Context) {
+	id, packetID, err := packetParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	req := request.CalibratePacketRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "reviewcycle",
+		"method":   "CalibratePacket",
+		"id":       id,
+		"packetID": packetID,
+		"request":  req,
+	})
+
+	packet, err := h.controller.ReviewCycle.CalibratePacket(id, packetID, ctrlreviewcycle.CalibrateInput{
+		FinalRating:         req.FinalRating,
+		ProposedSeniorityID: req.ProposedSeniorityID,
+		Note:                req.Note,
+		CalibratedBy:        userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to calibrate review packet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewPacket(packet), nil, nil, nil, ""))
+}
+
+// ApprovePacket godoc
+// @Summary Approve a review packet
+// @Description Approve the calibration of an employee, who is moved to the proposed seniority
+// @id approveReviewPacket
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Review Cycle ID"
+// @Param packetID path string true "Review Packet ID"
+// @Success 200 {object} ReviewPacketResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles/{id}/packets/{packetID}/approve [post]
+func (h *handler) ApprovePacket(c *gin.Context) {
+	id, packetID, err := packetParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "reviewcycle",
+		"method":   "ApprovePacket",
+		"id":       id,
+		"packetID": packetID,
+	})
+
+	packet, err := h.controller.ReviewCycle.ApprovePacket(id, packetID, userID)
+	if err != nil {
+		l.Error(err, "failed to approve review packet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewPacket(packet), nil, nil, nil, ""))
+}
+
+// RejectPacket godoc
+// @Summary Reject a review packet
+// @Description Reject the calibration of an employee, their seniority is left as it is. The packet can be calibrated again.
+// @id rejectReviewPacket
+// @Tags Review Cycle
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Review Cycle ID"
+// @Param packetID path string true "Review Packet ID"
+// @Param Body body RejectReviewPacketRequest true "Body"
+// @Success 200 {object} ReviewPacketResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /review-cycles/{id}/packets/{packetID}/reject [post]
+func (h *handler) RejectPacket(c *gin.Context) {
+	id, packetID, err := packetParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	req := request.RejectPacketRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":  "reviewcycle",
+		"method":   "RejectPacket",
+		"id":       id,
+		"packetID": packetID,
+	})
+
+	packet, err := h.controller.ReviewCycle.RejectPacket(id, packetID, ctrlreviewcycle.RejectInput{
+		Note:       req.Note,
+		RejectedBy: userID,
+	})
+	if err != nil {
+		l.Error(err, "failed to reject review packet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToReviewPacket(packet), nil, nil, nil, ""))
+}
+
+// userID returns the employee running the review cycle
+func (h *handler) userID(c *gin.Context) (model.UUID, error) {
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		return model.UUID{}, err
+	}
+	if !model.IsUUIDFromString(userID) {
+		return model.UUID{}, errs.ErrInvalidUserID
+	}
+	return model.MustGetUUIDFromString(userID), nil
+}
+
+func packetParams(c *gin.Context) (string, string, error) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		return "", "", errs.ErrInvalidCycleID
+	}
+	packetID := c.Param("packetID")
+	if packetID == "" || !model.IsUUIDFromString(packetID) {
+		return "", "", errs.ErrInvalidPacketID
+	}
+	return id, packetID, nil
+}
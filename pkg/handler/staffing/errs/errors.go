@@ -0,0 +1,39 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/staffing"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidProjectID = errors.New("invalid project id")
+	ErrInvalidSlotID    = errors.New("invalid slot id")
+	ErrInvalidLimit     = errors.New("invalid limit")
+)
+
+// ConvertControllerErr writes the status of a staffing controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, staffing.ErrSlotNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, staffing.ErrInvalidWeeks):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,7 @@
+package staffing
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Recommend(c *gin.Context)
+}
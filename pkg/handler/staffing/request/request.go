@@ -0,0 +1,29 @@
+package request
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/staffing/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type RecommendQuery struct {
+	Weeks int `form:"weeks" json:"weeks"` // the candidates free within the weeks, 4 when empty, 12 at most
+	Limit int `form:"limit" json:"limit"` // every candidate when empty
+} // @name SlotRecommendationsQuery
+
+func (q *RecommendQuery) Validate() error {
+	if q.Limit < 0 {
+		return errs.ErrInvalidLimit
+	}
+	return nil
+}
+
+// ValidateIDs checks the project and slot ids of the path
+func ValidateIDs(projectID, slotID string) error {
+	if !model.IsUUIDFromString(projectID) {
+		return errs.ErrInvalidProjectID
+	}
+	if !model.IsUUIDFromString(slotID) {
+		return errs.ErrInvalidSlotID
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package staffing
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlstaffing "github.com/dwarvesf/fortress-api/pkg/controller/staffing"
+	"github.com/dwarvesf/fortress-api/pkg/handler/staffing/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/staffing/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// Recommend godoc
+// @Summary Recommend candidates for a project slot
+// @Description Rank the employees free for the slot now or within the weeks by stack overlap, position, seniority fit, prior work for the client, MMA score and current utilization, with the reason of each score
+// @id getSlotRecommendations
+// @Tags Project
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Project ID"
+// @Param slotID path string true "Slot ID"
+// @Param weeks query int false "The candidates free within the weeks, 4 by default"
+// @Param limit query int false "Number of candidates, every one by default"
+// @Success 200 {object} SlotRecommendationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/{id}/slots/{slotID}/recommendations [get]
+func (h *handler) Recommend(c *gin.Context) {
+	projectID, slotID := c.Param("id"), c.Param("slotID")
+	if err := request.ValidateIDs(projectID, slotID); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	query := request.RecommendQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler":   "staffing",
+		"method":    "Recommend",
+		"projectID": projectID,
+		"slotID":    slotID,
+		"query":     query,
+	})
+
+	shortlist, err := h.controller.Staffing.Recommend(ctrlstaffing.RecommendInput{
+		ProjectID: projectID,
+		SlotID:    slotID,
+		Weeks:     query.Weeks,
+		Limit:     query.Limit,
+	})
+	if err != nil {
+		l.Error(err, "failed to recommend candidates")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSlotRecommendations(shortlist), nil, nil, nil, ""))
+}
@@ -0,0 +1,44 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/surveyanalytics"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidSurveyID   = errors.New("invalid survey id")
+	ErrInvalidQuestionID = errors.New("invalid question id")
+)
+
+// ConvertControllerErr writes the status of a survey analytics controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, surveyanalytics.ErrSurveyNotFound),
+		errors.Is(err, surveyanalytics.ErrQuestionNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, surveyanalytics.ErrInvalidGroupBy),
+		errors.Is(err, surveyanalytics.ErrInvalidSubtype),
+		errors.Is(err, surveyanalytics.ErrNotEnoughAnswers):
+		status = http.StatusBadRequest
+
+	case errors.Is(err, surveyanalytics.ErrLLMUnavailable):
+		status = http.StatusServiceUnavailable
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
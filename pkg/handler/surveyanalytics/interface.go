@@ -0,0 +1,10 @@
+package surveyanalytics
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	GetAnalytics(c *gin.Context)
+	GetTrends(c *gin.Context)
+	ListThemeSummaries(c *gin.Context)
+	SummarizeThemes(c *gin.Context)
+}
@@ -0,0 +1,26 @@
+package request
+
+import (
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveyanalytics/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type GetAnalyticsQuery struct {
+	GroupBy string `form:"groupBy" json:"groupBy"` // chapter, seniority or project, no team comparison when empty
+} // @name GetSurveyAnalyticsQuery
+
+type GetTrendsQuery struct {
+	Subtype string `form:"subtype" json:"subtype" binding:"required"` // peer-review, engagement or work
+	Cycles  int    `form:"cycles" json:"cycles"`                      // the latest surveys followed, 6 when empty
+} // @name GetSurveyTrendsQuery
+
+type SummarizeThemesRequest struct {
+	QuestionID string `json:"questionID"` // every free-text question of the survey when empty
+} // @name SummarizeSurveyThemesRequest
+
+func (r *SummarizeThemesRequest) Validate() error {
+	if r.QuestionID != "" && !model.IsUUIDFromString(r.QuestionID) {
+		return errs.ErrInvalidQuestionID
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package surveyanalytics
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrlsurveyanalytics "github.com/dwarvesf/fortress-api/pkg/controller/surveyanalytics"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveyanalytics/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/surveyanalytics/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// GetAnalytics godoc
+// @Summary Get the analytics of a survey
+// @Description Distribution of the likert-scale answers per question, compared between teams with groupBy. The questions and teams answered by too few respondents are suppressed.
+// @id getSurveyAnalytics
+// @Tags Survey
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Survey ID"
+// @Param groupBy query string false "chapter, seniority or project"
+// @Success 200 {object} SurveyAnalyticsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /surveys/{id}/analytics [get]
+func (h *handler) GetAnalytics(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyID, nil, ""))
+		return
+	}
+
+	query := request.GetAnalyticsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveyanalytics",
+		"method":  "GetAnalytics",
+		"id":      id,
+		"query":   query,
+	})
+
+	rs, err := h.controller.SurveyAnalytics.GetAnalytics(id, model.EngagementDashboardFilter(query.GroupBy))
+	if err != nil {
+		l.Error(err, "failed to get survey analytics")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyAnalytics(rs.Event, rs.MinGroupSize, rs.GroupBy.String(), rs.Distributions, rs.Comparisons), nil, nil, nil, ""))
+}
+
+// GetTrends godoc
+// @Summary Get the trends of the surveys
+// @Description Average of the likert-scale questions over the latest surveys of a subtype, the cycles answered by too few respondents are suppressed
+// @id getSurveyTrends
+// @Tags Survey
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param subtype query string true "peer-review, engagement or work"
+// @Param cycles query int false "Number of latest surveys, 6 by default"
+// @Success 200 {object} SurveyTrendsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /surveys/trends [get]
+func (h *handler) GetTrends(c *gin.Context) {
+	query := request.GetTrendsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveyanalytics",
+		"method":  "GetTrends",
+		"query":   query,
+	})
+
+	trends, err := h.controller.SurveyAnalytics.GetTrends(model.EventSubtype(query.Subtype), query.Cycles)
+	if err != nil {
+		l.Error(err, "failed to get survey trends")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyTrends(trends), nil, nil, nil, ""))
+}
+
+// ListThemeSummaries godoc
+// @Summary List the theme summaries of a survey
+// @Description The themes of the free-text answers of a survey, summarized by an LLM
+// @id listSurveyThemeSummaries
+// @Tags Survey
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Survey ID"
+// @Success 200 {object} SurveyThemeSummariesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /surveys/{id}/theme-summaries [get]
+func (h *handler) ListThemeSummaries(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyID, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveyanalytics",
+		"method":  "ListThemeSummaries",
+		"id":      id,
+	})
+
+	summaries, err := h.controller.SurveyAnalytics.ListThemeSummaries(id)
+	if err != nil {
+		l.Error(err, "failed to list survey theme summaries")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyThemeSummaries(summaries), nil, nil, nil, ""))
+}
+
+// SummarizeThemes godoc
+// @Summary Summarize the themes of a survey
+// @Description Summarize the free-text answers of a survey into themes through an LLM, the questions answered by too few respondents are skipped
+// @id summarizeSurveyThemes
+// @Tags Survey
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Survey ID"
+// @Param Body body SummarizeSurveyThemesRequest true "Body"
+// @Success 200 {object} SurveyThemeSummariesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /surveys/{id}/theme-summaries [post]
+func (h *handler) SummarizeThemes(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" || !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidSurveyID, nil, ""))
+		return
+	}
+
+	userID, err := authutils.GetUserIDFromContext(c, h.config)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	req := request.SummarizeThemesRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, req, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "surveyanalytics",
+		"method":  "SummarizeThemes",
+		"id":      id,
+		"request": req,
+	})
+
+	input := ctrlsurveyanalytics.SummarizeThemesInput{QuestionID: req.QuestionID}
+	if model.IsUUIDFromString(userID) {
+		createdBy := model.MustGetUUIDFromString(userID)
+		input.CreatedBy = &createdBy
+	}
+
+	summaries, err := h.controller.SurveyAnalytics.SummarizeThemes(id, input)
+	if err != nil {
+		l.Error(err, "failed to summarize survey themes")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToSurveyThemeSummaries(summaries), nil, nil, nil, ""))
+}
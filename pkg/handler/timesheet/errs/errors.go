@@ -0,0 +1,66 @@
+package errs
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/controller/timesheet"
+	timesheetcheck "github.com/dwarvesf/fortress-api/pkg/timesheet"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+var (
+	ErrInvalidTimesheetID = errors.New("invalid timesheet id")
+	ErrInvalidEmployeeID  = errors.New("invalid employee id")
+	ErrInvalidProjectID   = errors.New("invalid project id")
+	ErrInvalidWorkUnitID  = errors.New("invalid work unit id")
+	ErrInvalidStatus      = errors.New("invalid timesheet status")
+	ErrInvalidDate        = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidMonth       = errors.New("invalid month, expected YYYY-MM")
+	ErrInvalidHours       = errors.New("invalid hours")
+)
+
+// ConvertControllerErr writes the status of a timesheet controller error
+func ConvertControllerErr(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	var status int
+
+	switch {
+	case errors.Is(err, timesheet.ErrTimesheetNotFound),
+		errors.Is(err, timesheet.ErrProjectNotFound),
+		errors.Is(err, timesheet.ErrEmployeeNotFound),
+		errors.Is(err, timesheet.ErrWorkUnitNotFound):
+		status = http.StatusNotFound
+
+	case errors.Is(err, timesheet.ErrNotTimesheetOwner),
+		errors.Is(err, timesheet.ErrNotProjectLead),
+		errors.Is(err, timesheet.ErrOwnTimesheet):
+		status = http.StatusForbidden
+
+	case errors.Is(err, timesheet.ErrTimesheetExists),
+		errors.Is(err, timesheet.ErrTimesheetNotEditable),
+		errors.Is(err, timesheet.ErrTimesheetNotReviewable):
+		status = http.StatusConflict
+
+	case errors.Is(err, timesheet.ErrNotProjectMember),
+		errors.Is(err, timesheet.ErrTimesheetEmpty),
+		errors.Is(err, timesheet.ErrRejectReasonRequired),
+		errors.Is(err, timesheet.ErrInvalidMonth),
+		errors.Is(err, timesheet.ErrProjectNotTimeMaterial),
+		errors.Is(err, timesheetcheck.ErrWeekStartNotMonday),
+		errors.Is(err, timesheetcheck.ErrEntryOutsideWeek),
+		errors.Is(err, timesheetcheck.ErrInvalidHours),
+		errors.Is(err, timesheetcheck.ErrDayOverbooked):
+		status = http.StatusBadRequest
+
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	c.JSON(status, view.CreateResponse[any](nil, nil, err, nil, ""))
+}
@@ -0,0 +1,14 @@
+package timesheet
+
+import "github.com/gin-gonic/gin"
+
+type IHandler interface {
+	Approve(c *gin.Context)
+	Create(c *gin.Context)
+	Get(c *gin.Context)
+	GetInvoiceLineItems(c *gin.Context)
+	List(c *gin.Context)
+	Reject(c *gin.Context)
+	Submit(c *gin.Context)
+	Update(c *gin.Context)
+}
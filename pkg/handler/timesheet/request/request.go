@@ -0,0 +1,119 @@
+package request
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/handler/timesheet/errs"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+)
+
+const monthLayout = "2006-01"
+
+type ListTimesheetsQuery struct {
+	model.Pagination
+
+	EmployeeID string `form:"employeeID" json:"employeeID"`
+	ProjectID  string `form:"projectID" json:"projectID"`
+	Status     string `form:"status" json:"status"` // draft, submitted, approved, rejected or locked
+	From       string `form:"from" json:"from"`     // YYYY-MM-DD, the weeks starting from
+	To         string `form:"to" json:"to"`         // YYYY-MM-DD, the weeks starting until
+} // @name ListTimesheetsQuery
+
+func (q *ListTimesheetsQuery) Validate() error {
+	if q.EmployeeID != "" && !model.IsUUIDFromString(q.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if q.ProjectID != "" && !model.IsUUIDFromString(q.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+	if q.Status != "" && !model.TimesheetStatus(q.Status).IsValid() {
+		return errs.ErrInvalidStatus
+	}
+	if _, err := timeutil.ParseOptionalDate(q.From); err != nil {
+		return errs.ErrInvalidDate
+	}
+	if _, err := timeutil.ParseOptionalDate(q.To); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return nil
+}
+
+type TimesheetEntryRequest struct {
+	Date        string          `json:"date" binding:"required"` // YYYY-MM-DD, within the week
+	Hours       decimal.Decimal `json:"hours" swaggertype:"number"`
+	WorkUnitID  string          `json:"workUnitID"` // a work unit of the project
+	Description string          `json:"description"`
+} // @name TimesheetEntryRequest
+
+type CreateTimesheetRequest struct {
+	EmployeeID string                  `json:"employeeID"` // the logged in employee when empty
+	ProjectID  string                  `json:"projectID" binding:"required"`
+	WeekStart  string                  `json:"weekStart" binding:"required"` // YYYY-MM-DD, any day of the week
+	Note       string                  `json:"note"`
+	Entries    []TimesheetEntryRequest `json:"entries" binding:"dive"`
+} // @name CreateTimesheetRequest
+
+func (r *CreateTimesheetRequest) Validate() error {
+	if r.EmployeeID != "" && !model.IsUUIDFromString(r.EmployeeID) {
+		return errs.ErrInvalidEmployeeID
+	}
+	if !model.IsUUIDFromString(r.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+	if _, err := timeutil.ParseOptionalDate(r.WeekStart); err != nil {
+		return errs.ErrInvalidDate
+	}
+	return validateEntries(r.Entries)
+}
+
+type UpdateTimesheetRequest struct {
+	Note    string                  `json:"note"`
+	Entries []TimesheetEntryRequest `json:"entries" binding:"dive"`
+} // @name UpdateTimesheetRequest
+
+func (r *UpdateTimesheetRequest) Validate() error {
+	return validateEntries(r.Entries)
+}
+
+type RejectTimesheetRequest struct {
+	Reason string `json:"reason" binding:"required"`
+} // @name RejectTimesheetRequest
+
+type InvoiceLineItemsQuery struct {
+	ProjectID string `form:"projectID" json:"projectID" binding:"required"`
+	Month     string `form:"month" json:"month" binding:"required"` // YYYY-MM
+} // @name TimesheetInvoiceLineItemsQuery
+
+func (q *InvoiceLineItemsQuery) Validate() error {
+	if !model.IsUUIDFromString(q.ProjectID) {
+		return errs.ErrInvalidProjectID
+	}
+	if _, err := time.Parse(monthLayout, q.Month); err != nil {
+		return errs.ErrInvalidMonth
+	}
+	return nil
+}
+
+// YearMonth returns the year and the month of the query
+func (q *InvoiceLineItemsQuery) YearMonth() (int, int) {
+	m, _ := time.Parse(monthLayout, q.Month)
+	return m.Year(), int(m.Month())
+}
+
+func validateEntries(entries []TimesheetEntryRequest) error {
+	for _, e := range entries {
+		if _, err := timeutil.ParseOptionalDate(e.Date); err != nil {
+			return errs.ErrInvalidDate
+		}
+		if e.WorkUnitID != "" && !model.IsUUIDFromString(e.WorkUnitID) {
+			return errs.ErrInvalidWorkUnitID
+		}
+		if e.Hours.IsNegative() {
+			return errs.ErrInvalidHours
+		}
+	}
+	return nil
+}
@@ -0,0 +1,385 @@
+package timesheet
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller"
+	ctrltimesheet "github.com/dwarvesf/fortress-api/pkg/controller/timesheet"
+	"github.com/dwarvesf/fortress-api/pkg/handler/timesheet/errs"
+	"github.com/dwarvesf/fortress-api/pkg/handler/timesheet/request"
+	"github.com/dwarvesf/fortress-api/pkg/logger"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/service"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+type handler struct {
+	store      *store.Store
+	service    *service.Service
+	logger     logger.Logger
+	repo       store.DBRepo
+	config     *config.Config
+	controller *controller.Controller
+}
+
+// New returns a handler
+func New(controller *controller.Controller, store *store.Store, repo store.DBRepo, service *service.Service, logger logger.Logger, cfg *config.Config) IHandler {
+	return &handler{
+		store:      store,
+		repo:       repo,
+		service:    service,
+		logger:     logger,
+		config:     cfg,
+		controller: controller,
+	}
+}
+
+// List godoc
+// @Summary Get the timesheets
+// @Description Get the weekly timesheets, latest week first. Without full access only the own timesheets and the ones of the projects led are listed.
+// @id getListTimesheets
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param employeeID query string false "Employee ID"
+// @Param projectID query string false "Project ID"
+// @Param status query string false "draft, submitted, approved, rejected or locked"
+// @Param from query string false "YYYY-MM-DD, the weeks starting from"
+// @Param to query string false "YYYY-MM-DD, the weeks starting until"
+// @Param page query int false "Page"
+// @Param size query int false "Size"
+// @Success 200 {object} TimesheetsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /timesheets [get]
+func (h *handler) List(c *gin.Context) {
+	actor, err := h.actor(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	query := request.ListTimesheetsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	query.Standardize()
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  "List",
+		"query":   query,
+	})
+
+	from, _ := timeutil.ParseOptionalDate(query.From)
+	to, _ := timeutil.ParseOptionalDate(query.To)
+	timesheets, total, err := h.controller.Timesheet.List(actor, ctrltimesheet.ListInput{
+		EmployeeID: query.EmployeeID,
+		ProjectID:  query.ProjectID,
+		Status:     model.TimesheetStatus(query.Status),
+		From:       from,
+		To:         to,
+	}, query.Pagination)
+	if err != nil {
+		l.Error(err, "failed to list timesheets")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheets(timesheets),
+		&view.PaginationResponse{Pagination: view.Pagination{Page: query.Page, Size: query.Size, Sort: query.Sort}, Total: total}, nil, nil, ""))
+}
+
+// Get godoc
+// @Summary Get a timesheet
+// @Description Get a timesheet with its entries by day
+// @id getTimesheetByID
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Timesheet ID"
+// @Success 200 {object} TimesheetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /timesheets/{id} [get]
+func (h *handler) Get(c *gin.Context) {
+	h.withTimesheet(c, "Get", func(actor ctrltimesheet.Actor, id string) (*model.Timesheet, error) {
+		return h.controller.Timesheet.Get(actor, id)
+	})
+}
+
+// Create godoc
+// @Summary Create a timesheet
+// @Description Open the timesheet of a week on a project as a draft, with the hours of each day by work unit
+// @id createTimesheet
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param Body body CreateTimesheetRequest true "Body"
+// @Success 200 {object} TimesheetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /timesheets [post]
+func (h *handler) Create(c *gin.Context) {
+	actor, err := h.actor(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	body := request.CreateTimesheetRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+	if err := body.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  "Create",
+		"body":    body,
+	})
+
+	weekStart, _ := timeutil.ParseOptionalDate(body.WeekStart)
+	t, err := h.controller.Timesheet.Create(actor, ctrltimesheet.CreateInput{
+		EmployeeID: body.EmployeeID,
+		ProjectID:  body.ProjectID,
+		WeekStart:  *weekStart,
+		Note:       body.Note,
+		Entries:    toEntryInputs(body.Entries),
+	})
+	if err != nil {
+		l.Error(err, "failed to create timesheet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheetDetail(t), nil, nil, nil, ""))
+}
+
+// Update godoc
+// @Summary Update a timesheet
+// @Description Replace the note and the entries of a draft or rejected timesheet
+// @id updateTimesheet
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Timesheet ID"
+// @Param Body body UpdateTimesheetRequest true "Body"
+// @Success 200 {object} TimesheetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /timesheets/{id} [put]
+func (h *handler) Update(c *gin.Context) {
+	body := request.UpdateTimesheetRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+	if err := body.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	h.withTimesheet(c, "Update", func(actor ctrltimesheet.Actor, id string) (*model.Timesheet, error) {
+		return h.controller.Timesheet.Update(actor, id, ctrltimesheet.UpdateInput{
+			Note:    body.Note,
+			Entries: toEntryInputs(body.Entries),
+		})
+	})
+}
+
+// Submit godoc
+// @Summary Submit a timesheet
+// @Description Send a draft or rejected timesheet to the leads of the project for approval
+// @id submitTimesheet
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Timesheet ID"
+// @Success 200 {object} TimesheetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /timesheets/{id}/submit [post]
+func (h *handler) Submit(c *gin.Context) {
+	h.withTimesheet(c, "Submit", h.controller.Timesheet.Submit)
+}
+
+// Approve godoc
+// @Summary Approve a timesheet
+// @Description Approve a submitted timesheet as a technical lead or delivery manager of the project, its hours become billable
+// @id approveTimesheet
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Timesheet ID"
+// @Success 200 {object} TimesheetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /timesheets/{id}/approve [post]
+func (h *handler) Approve(c *gin.Context) {
+	h.withTimesheet(c, "Approve", h.controller.Timesheet.Approve)
+}
+
+// Reject godoc
+// @Summary Reject a timesheet
+// @Description Send a submitted timesheet, or an approved one not invoiced yet, back to its owner with the reason
+// @id rejectTimesheet
+// @Tags Timesheet
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param id path string true "Timesheet ID"
+// @Param Body body RejectTimesheetRequest true "Body"
+// @Success 200 {object} TimesheetDetailResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /timesheets/{id}/reject [post]
+func (h *handler) Reject(c *gin.Context) {
+	body := request.RejectTimesheetRequest{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, body, ""))
+		return
+	}
+
+	h.withTimesheet(c, "Reject", func(actor ctrltimesheet.Actor, id string) (*model.Timesheet, error) {
+		return h.controller.Timesheet.Reject(actor, id, body.Reason)
+	})
+}
+
+// GetInvoiceLineItems godoc
+// @Summary Get the invoice line items of the approved hours
+// @Description Bill the approved hours of a month of a time and material project not invoiced yet, one line per member at the rate of their membership. The hours are locked when the invoice is sent.
+// @id getTimesheetInvoiceLineItems
+// @Tags Invoice
+// @Accept  json
+// @Produce  json
+// @Security BearerAuth
+// @Param projectID query string true "Project ID"
+// @Param month query string true "YYYY-MM"
+// @Success 200 {object} TimesheetInvoiceLineItemsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /invoices/timesheet-line-items [get]
+func (h *handler) GetInvoiceLineItems(c *gin.Context) {
+	query := request.InvoiceLineItemsQuery{}
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+	if err := query.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, err, query, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  "GetInvoiceLineItems",
+		"query":   query,
+	})
+
+	year, month := query.YearMonth()
+	rs, err := h.controller.Timesheet.InvoiceLineItems(query.ProjectID, year, month)
+	if err != nil {
+		l.Error(err, "failed to get timesheet invoice line items")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](
+		view.ToTimesheetInvoiceLineItems(rs.Project, rs.Year, rs.Month, rs.Hours, rs.Items), nil, nil, nil, ""))
+}
+
+// withTimesheet runs an action of the logged in employee on the timesheet of the path
+func (h *handler) withTimesheet(c *gin.Context, method string, action func(actor ctrltimesheet.Actor, id string) (*model.Timesheet, error)) {
+	id := c.Param("id")
+	if !model.IsUUIDFromString(id) {
+		c.JSON(http.StatusBadRequest, view.CreateResponse[any](nil, nil, errs.ErrInvalidTimesheetID, nil, ""))
+		return
+	}
+
+	actor, err := h.actor(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, view.CreateResponse[any](nil, nil, err, nil, ""))
+		return
+	}
+
+	l := h.logger.Fields(logger.Fields{
+		"handler": "timesheet",
+		"method":  method,
+		"id":      id,
+	})
+
+	t, err := action(actor, id)
+	if err != nil {
+		l.Error(err, "failed to "+method+" timesheet")
+		errs.ConvertControllerErr(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.CreateResponse[any](view.ToTimesheetDetail(t), nil, nil, nil, ""))
+}
+
+// actor returns the logged in employee and whether they have full access to the timesheets
+func (h *handler) actor(c *gin.Context) (ctrltimesheet.Actor, error) {
+	userInfo, err := authutils.GetLoggedInUserInfo(c, h.store, h.repo.DB(), h.config)
+	if err != nil {
+		return ctrltimesheet.Actor{}, err
+	}
+
+	return ctrltimesheet.Actor{
+		ID:         userInfo.UserID,
+		FullAccess: authutils.HasPermission(userInfo.Permissions, model.PermissionTimesheetsFullAccess),
+	}, nil
+}
+
+func toEntryInputs(entries []request.TimesheetEntryRequest) []ctrltimesheet.EntryInput {
+	rs := make([]ctrltimesheet.EntryInput, 0, len(entries))
+	for _, e := range entries {
+		date, _ := timeutil.ParseOptionalDate(e.Date)
+		rs = append(rs, ctrltimesheet.EntryInput{
+			WorkUnitID:  e.WorkUnitID,
+			Date:        *date,
+			Hours:       e.Hours,
+			Description: e.Description,
+		})
+	}
+	return rs
+}
@@ -11,6 +11,7 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service/basecamp/consts"
 	"github.com/dwarvesf/fortress-api/pkg/service/currency"
@@ -154,6 +155,12 @@ func (h *handler) StoreOperationAccountingTransaction(t *model.AccountingTransac
 	if err := h.store.Accounting.CreateTransaction(h.repo.DB(), t); err != nil {
 		return err
 	}
+
+	// the transaction is committed already, one failing to post is left to the
+	// post-ledger-entries cronjob
+	if _, err := ledger.New(h.store).PostAccountingTransaction(h.repo.DB(), model.JournalSourceOperation, t); err != nil {
+		h.logger.Error(err, "failed to post operation transaction to the ledger")
+	}
 	return nil
 }
 
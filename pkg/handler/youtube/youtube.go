@@ -73,6 +73,14 @@ func (h *handler) TranscribeBroadcast(c *gin.Context) {
 		return
 	}
 
+	publishedAt, err := time.Parse(time.RFC3339, broadcast.Snippet.ActualStartTime)
+	if err != nil {
+		publishedAt = time.Now()
+	}
+	if err := h.controller.KnowledgeBase.IndexOGIF(broadcast.Id, broadcast.Snippet.Title, link, content, publishedAt); err != nil {
+		h.logger.Error(err, "failed to index OGIF memo summary")
+	}
+
 	gitSvc := git.New("https://github.com/dwarvesf/brainery", "lmquang", h.config.Github.Token)
 	branch := fmt.Sprintf("docs/ogif-memo-summary-%v", time.Now().Format("20060102"))
 	if err := gitSvc.CreateBranch(branch); err != nil {
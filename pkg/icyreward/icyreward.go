@@ -0,0 +1,100 @@
+// Package icyreward decides whether an event is paid by its reward rule: once per key, within the
+// cap of the employee and the budgets, and after a review for the large amounts
+package icyreward
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// Key is the idempotency key of the reward of an event, the reference identifies the event among
+// the ones of the employee, the day of the event when there is none
+func Key(trigger model.IcyRewardTrigger, employeeID string, reference string, occurredAt time.Time) string {
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		reference = occurredAt.Format("2006-01-02")
+	}
+	return fmt.Sprintf("%s:%s:%s", trigger, employeeID, reference)
+}
+
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// PeriodStart returns the start of the period the time falls in, weeks start on Monday
+func PeriodStart(p model.IcyRewardPeriod, t time.Time) time.Time {
+	switch p {
+	case model.IcyRewardPeriodDay:
+		return dayOf(t)
+	case model.IcyRewardPeriodWeek:
+		offset := (int(t.Weekday()) + 6) % 7
+		return dayOf(t).AddDate(0, 0, -offset)
+	default:
+		return MonthStart(t)
+	}
+}
+
+// MonthStart returns the first day of the month of the time
+func MonthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// Usage is what was committed before the event: the payouts of the employee by the rule in the
+// cap period, and the spend of the rule and of all the rules in the month
+type Usage struct {
+	PeriodPayouts   int
+	RuleMonthSpent  decimal.Decimal
+	TotalMonthSpent decimal.Decimal
+}
+
+// Limits are the limits configured for all the rules, empty for none
+type Limits struct {
+	MonthlyBudget   decimal.NullDecimal
+	ReviewThreshold decimal.NullDecimal
+}
+
+// Decision is the status of the payout of an event, Reason tells why it is not paid right away
+type Decision struct {
+	Status model.IcyRewardPayoutStatus
+	Reason string
+}
+
+// Decide returns whether the event is paid, skipped or waits for a review
+func Decide(rule *model.IcyRewardRule, usage Usage, limits Limits) Decision {
+	if rule.CapPerPeriod > 0 && usage.PeriodPayouts >= rule.CapPerPeriod {
+		return Decision{
+			Status: model.IcyRewardPayoutStatusSkipped,
+			Reason: fmt.Sprintf("cap of %d per %s reached", rule.CapPerPeriod, rule.CapPeriod),
+		}
+	}
+	if rule.MonthlyBudget.Valid && usage.RuleMonthSpent.Add(rule.Amount).GreaterThan(rule.MonthlyBudget.Decimal) {
+		return Decision{
+			Status: model.IcyRewardPayoutStatusSkipped,
+			Reason: fmt.Sprintf("monthly budget of %s ICY of the rule is used up", rule.MonthlyBudget.Decimal.String()),
+		}
+	}
+	if limits.MonthlyBudget.Valid && usage.TotalMonthSpent.Add(rule.Amount).GreaterThan(limits.MonthlyBudget.Decimal) {
+		return Decision{
+			Status: model.IcyRewardPayoutStatusSkipped,
+			Reason: fmt.Sprintf("monthly reward budget of %s ICY is used up", limits.MonthlyBudget.Decimal.String()),
+		}
+	}
+
+	threshold := limits.ReviewThreshold
+	if rule.ReviewThreshold.Valid {
+		threshold = rule.ReviewThreshold
+	}
+	if threshold.Valid && threshold.Decimal.IsPositive() && rule.Amount.GreaterThanOrEqual(threshold.Decimal) {
+		return Decision{
+			Status: model.IcyRewardPayoutStatusPendingReview,
+			Reason: fmt.Sprintf("%s ICY is from the review threshold of %s ICY", rule.Amount.String(), threshold.Decimal.String()),
+		}
+	}
+
+	return Decision{Status: model.IcyRewardPayoutStatusPaid}
+}
@@ -0,0 +1,65 @@
+package icyreward
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func TestKey(t *testing.T) {
+	at := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	require.Equal(t, "office-check-in:e1:2026-10-19", Key(model.IcyRewardTriggerOfficeCheckIn, "e1", " ", at))
+	require.Equal(t, "memo-published:e1:memo-42", Key(model.IcyRewardTriggerMemoPublished, "e1", "memo-42", at))
+}
+
+func TestPeriodStart(t *testing.T) {
+	// a Sunday
+	at := time.Date(2026, 10, 18, 21, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), PeriodStart(model.IcyRewardPeriodDay, at))
+	require.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), PeriodStart(model.IcyRewardPeriodWeek, at))
+	require.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), PeriodStart(model.IcyRewardPeriodMonth, at))
+	// a Monday starts its week
+	monday := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), PeriodStart(model.IcyRewardPeriodWeek, monday))
+}
+
+func TestDecide(t *testing.T) {
+	rule := &model.IcyRewardRule{
+		Amount:        decimal.NewFromInt(25),
+		CapPerPeriod:  2,
+		CapPeriod:     model.IcyRewardPeriodWeek,
+		MonthlyBudget: decimal.NewNullDecimal(decimal.NewFromInt(100)),
+	}
+	limits := Limits{
+		MonthlyBudget:   decimal.NewNullDecimal(decimal.NewFromInt(1000)),
+		ReviewThreshold: decimal.NewNullDecimal(decimal.NewFromInt(200)),
+	}
+
+	d := Decide(rule, Usage{PeriodPayouts: 1, RuleMonthSpent: decimal.NewFromInt(50), TotalMonthSpent: decimal.NewFromInt(500)}, limits)
+	require.Equal(t, model.IcyRewardPayoutStatusPaid, d.Status)
+
+	d = Decide(rule, Usage{PeriodPayouts: 2}, limits)
+	require.Equal(t, model.IcyRewardPayoutStatusSkipped, d.Status)
+	require.Equal(t, "cap of 2 per week reached", d.Reason)
+
+	d = Decide(rule, Usage{RuleMonthSpent: decimal.NewFromInt(80)}, limits)
+	require.Equal(t, model.IcyRewardPayoutStatusSkipped, d.Status)
+	require.Contains(t, d.Reason, "budget of 100 ICY of the rule")
+
+	d = Decide(rule, Usage{TotalMonthSpent: decimal.NewFromInt(990)}, limits)
+	require.Equal(t, model.IcyRewardPayoutStatusSkipped, d.Status)
+	require.Contains(t, d.Reason, "reward budget of 1000 ICY")
+
+	// the threshold of the rule wins over the configured one
+	rule.ReviewThreshold = decimal.NewNullDecimal(decimal.NewFromInt(25))
+	d = Decide(rule, Usage{}, limits)
+	require.Equal(t, model.IcyRewardPayoutStatusPendingReview, d.Status)
+
+	// unlimited rule without review
+	d = Decide(&model.IcyRewardRule{Amount: decimal.NewFromInt(5000)}, Usage{PeriodPayouts: 99}, Limits{})
+	require.Equal(t, model.IcyRewardPayoutStatusPaid, d.Status)
+}
@@ -0,0 +1,91 @@
+// Package icytreasury reconciles the ICY the Mochi ledger moved into custody with the balance the
+// custody addresses hold on-chain
+package icytreasury
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// ToUnits converts an amount in the smallest unit of a token to the token
+func ToUnits(amount *big.Int, decimals int) decimal.Decimal {
+	if amount == nil {
+		return decimal.Zero
+	}
+	return decimal.NewFromBigInt(amount, -int32(decimals))
+}
+
+// ParseUnits converts a string amount in the smallest unit of a token to the token
+func ParseUnits(amount string, decimals int) (decimal.Decimal, error) {
+	if amount == "" {
+		return decimal.Zero, nil
+	}
+	d, err := decimal.NewFromString(amount)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return d.Shift(-int32(decimals)), nil
+}
+
+// ParseAddresses splits comma separated addresses, dropping the blank ones
+func ParseAddresses(s string) []string {
+	var addrs []string
+	for _, a := range strings.Split(s, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			addrs = append(addrs, a)
+		}
+	}
+	return addrs
+}
+
+// Ledger sums the moves of the Mochi ledger in and out of custody
+type Ledger struct {
+	Deposits    decimal.Decimal
+	Withdrawals decimal.Decimal
+	Count       int
+}
+
+func (l *Ledger) Deposit(amount decimal.Decimal) {
+	l.Deposits = l.Deposits.Add(amount)
+	l.Count++
+}
+
+func (l *Ledger) Withdraw(amount decimal.Decimal) {
+	l.Withdrawals = l.Withdrawals.Add(amount)
+	l.Count++
+}
+
+// Reconcile sets the drift of the snapshot against the previous one. The first snapshot with a
+// custody balance is the baseline and has no drift.
+func Reconcile(prev, cur *model.IcyTreasurySnapshot, tolerance decimal.Decimal) {
+	cur.Drift = decimal.Zero
+	cur.DriftFlagged = false
+	cur.CumulativeDrift = decimal.Zero
+	if prev != nil {
+		cur.CumulativeDrift = prev.CumulativeDrift
+	}
+	if !cur.CustodyBalance.Valid || prev == nil || !prev.CustodyBalance.Valid {
+		return
+	}
+
+	onchainDelta := cur.CustodyBalance.Decimal.Sub(prev.CustodyBalance.Decimal)
+	cur.Drift = onchainDelta.Sub(cur.LedgerNet())
+	cur.CumulativeDrift = prev.CumulativeDrift.Add(cur.Drift)
+	cur.DriftFlagged = cur.Drift.Abs().GreaterThan(tolerance)
+}
+
+// Summarize returns the drift over the snapshots and the number of days flagged
+func Summarize(snapshots []*model.IcyTreasurySnapshot) (decimal.Decimal, int) {
+	drift, flagged := decimal.Zero, 0
+	for _, s := range snapshots {
+		drift = drift.Add(s.Drift)
+		if s.DriftFlagged {
+			flagged++
+		}
+	}
+	return drift, flagged
+}
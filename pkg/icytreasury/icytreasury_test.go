@@ -0,0 +1,74 @@
+package icytreasury
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func custody(v int64) decimal.NullDecimal {
+	return decimal.NewNullDecimal(decimal.NewFromInt(v))
+}
+
+func TestUnits(t *testing.T) {
+	wei, _ := new(big.Int).SetString("1500000000000000000", 10)
+	require.Equal(t, "1.5", ToUnits(wei, 18).String())
+	require.True(t, ToUnits(nil, 18).IsZero())
+
+	d, err := ParseUnits("2500000", 6)
+	require.NoError(t, err)
+	require.Equal(t, "2.5", d.String())
+
+	_, err = ParseUnits("abc", 6)
+	require.Error(t, err)
+}
+
+func TestParseAddresses(t *testing.T) {
+	require.Equal(t, []string{"0xa", "0xb"}, ParseAddresses(" 0xa, ,0xb "))
+	require.Empty(t, ParseAddresses(""))
+}
+
+func TestReconcile(t *testing.T) {
+	tolerance := decimal.NewFromInt(1)
+
+	// the first snapshot is the baseline
+	first := &model.IcyTreasurySnapshot{CustodyBalance: custody(1000)}
+	Reconcile(nil, first, tolerance)
+	require.True(t, first.Drift.IsZero())
+	require.False(t, first.DriftFlagged)
+
+	// the custody moved by the ledger net
+	var l Ledger
+	l.Deposit(decimal.NewFromInt(300))
+	l.Withdraw(decimal.NewFromInt(100))
+	require.Equal(t, 2, l.Count)
+	second := &model.IcyTreasurySnapshot{
+		CustodyBalance:    custody(1200),
+		LedgerDeposits:    l.Deposits,
+		LedgerWithdrawals: l.Withdrawals,
+	}
+	Reconcile(first, second, tolerance)
+	require.True(t, second.Drift.IsZero())
+	require.False(t, second.DriftFlagged)
+
+	// 50 ICY left custody without a withdrawal in the ledger
+	third := &model.IcyTreasurySnapshot{CustodyBalance: custody(1150)}
+	Reconcile(second, third, tolerance)
+	require.Equal(t, "-50", third.Drift.String())
+	require.Equal(t, "-50", third.CumulativeDrift.String())
+	require.True(t, third.DriftFlagged)
+
+	// without a custody balance the drift is unknown, the cumulative one carries over
+	fourth := &model.IcyTreasurySnapshot{}
+	Reconcile(third, fourth, tolerance)
+	require.True(t, fourth.Drift.IsZero())
+	require.Equal(t, "-50", fourth.CumulativeDrift.String())
+
+	drift, flagged := Summarize([]*model.IcyTreasurySnapshot{first, second, third, fourth})
+	require.Equal(t, "-50", drift.String())
+	require.Equal(t, 1, flagged)
+}
@@ -0,0 +1,76 @@
+package leaderboard
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Entry is the metrics of an employee over the period and scope of a leaderboard
+type Entry struct {
+	EmployeeID string
+	Vars
+	// IsLead tells the employee leads a chapter or a project
+	IsLead bool
+}
+
+// Rules are how a leaderboard scores and filters the employees
+type Rules struct {
+	Formula *Formula
+	// ExcludeLeads leaves the chapter and project leads out of the board
+	ExcludeLeads bool
+	// MinEffort is the effort from which an employee is ranked
+	MinEffort decimal.Decimal
+	// Size is the number of employees kept on the board, every employee when 0
+	Size int
+}
+
+// Ranked is an employee on a leaderboard
+type Ranked struct {
+	Entry
+	Score decimal.Decimal
+	Rank  int
+}
+
+// Rank scores the eligible employees, best first. The employees with the same score and
+// effectiveness share their rank, between equal scores the least effort comes first.
+func Rank(entries []Entry, rules Rules) []Ranked {
+	ranked := make([]Ranked, 0, len(entries))
+	for _, e := range entries {
+		if rules.ExcludeLeads && e.IsLead {
+			continue
+		}
+		if e.Effort.LessThan(rules.MinEffort) {
+			continue
+		}
+		ranked = append(ranked, Ranked{Entry: e, Score: rules.Formula.Eval(e.Vars)})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		switch {
+		case !a.Score.Equal(b.Score):
+			return a.Score.GreaterThan(b.Score)
+		case !a.Effort.Equal(b.Effort):
+			return a.Effort.LessThan(b.Effort)
+		case !a.Effectiveness.Equal(b.Effectiveness):
+			return a.Effectiveness.GreaterThan(b.Effectiveness)
+		default:
+			return a.EmployeeID < b.EmployeeID
+		}
+	})
+
+	if rules.Size > 0 && len(ranked) > rules.Size {
+		ranked = ranked[:rules.Size]
+	}
+
+	for i := range ranked {
+		if i > 0 && ranked[i].Score.Equal(ranked[i-1].Score) && ranked[i].Effectiveness.Equal(ranked[i-1].Effectiveness) {
+			ranked[i].Rank = ranked[i-1].Rank
+		} else {
+			ranked[i].Rank = i + 1
+		}
+	}
+
+	return ranked
+}
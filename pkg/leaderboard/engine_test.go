@@ -0,0 +1,76 @@
+package leaderboard
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func entry(id string, weight, effort int64, effectiveness string, isLead bool) Entry {
+	return Entry{
+		EmployeeID: id,
+		Vars: Vars{
+			Weight:        decimal.NewFromInt(weight),
+			Effort:        decimal.NewFromInt(effort),
+			Effectiveness: decimal.RequireFromString(effectiveness),
+		},
+		IsLead: isLead,
+	}
+}
+
+func ids(ranked []Ranked) []string {
+	rs := make([]string, 0, len(ranked))
+	for _, r := range ranked {
+		rs = append(rs, r.EmployeeID)
+	}
+	return rs
+}
+
+func ranks(ranked []Ranked) []int {
+	rs := make([]int, 0, len(ranked))
+	for _, r := range ranked {
+		rs = append(rs, r.Rank)
+	}
+	return rs
+}
+
+func TestRank(t *testing.T) {
+	weight, err := ParseFormula("weight")
+	require.NoError(t, err)
+
+	entries := []Entry{
+		entry("a", 10, 40, "0.25", false),
+		entry("b", 12, 40, "0.3", true),
+		entry("c", 10, 20, "0.5", false),
+		entry("d", 10, 20, "0.5", false),
+		entry("e", 2, 4, "0.5", false),
+	}
+
+	t.Run("summed weight", func(t *testing.T) {
+		ranked := Rank(entries, Rules{Formula: weight})
+		assert.Equal(t, []string{"b", "c", "d", "a", "e"}, ids(ranked))
+		assert.Equal(t, []int{1, 2, 2, 4, 5}, ranks(ranked))
+	})
+
+	t.Run("eligibility and size", func(t *testing.T) {
+		ranked := Rank(entries, Rules{
+			Formula:      weight,
+			ExcludeLeads: true,
+			MinEffort:    decimal.NewFromInt(8),
+			Size:         2,
+		})
+		assert.Equal(t, []string{"c", "d"}, ids(ranked))
+		assert.Equal(t, []int{1, 1}, ranks(ranked))
+	})
+
+	t.Run("effectiveness formula", func(t *testing.T) {
+		f, err := ParseFormula("weight / effort * 100")
+		require.NoError(t, err)
+
+		ranked := Rank(entries, Rules{Formula: f, Size: 3})
+		assert.Equal(t, []string{"e", "c", "d"}, ids(ranked))
+		assert.Equal(t, "50", ranked[0].Score.String())
+	})
+}
@@ -0,0 +1,244 @@
+// Package leaderboard ranks the employees on their delivery metrics with the formula and the
+// eligibility rules of a leaderboard definition
+package leaderboard
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+)
+
+// The variables a formula is written with, summed or averaged over the period of the leaderboard
+const (
+	VariableWeight        = "weight"        // sum of the weights
+	VariableEffort        = "effort"        // sum of the efforts, in hours
+	VariableEffectiveness = "effectiveness" // average of the effectiveness
+)
+
+var ErrInvalidFormula = errors.New("invalid formula")
+
+// Vars are the values of the variables of an employee
+type Vars struct {
+	Weight        decimal.Decimal
+	Effort        decimal.Decimal
+	Effectiveness decimal.Decimal
+}
+
+func (v Vars) get(name string) decimal.Decimal {
+	switch name {
+	case VariableWeight:
+		return v.Weight
+	case VariableEffort:
+		return v.Effort
+	default:
+		return v.Effectiveness
+	}
+}
+
+// Formula is a parsed score formula, an arithmetic expression of numbers and variables with
+// + - * / and parentheses, e.g. "weight * 0.8 + effectiveness * 2"
+type Formula struct {
+	src  string
+	root node
+}
+
+func (f *Formula) String() string {
+	return f.src
+}
+
+// Eval computes the score of an employee, a division by zero counts as 0 so an employee without
+// effort scores 0 on "weight / effort"
+func (f *Formula) Eval(v Vars) decimal.Decimal {
+	return f.root.eval(v)
+}
+
+type node interface {
+	eval(v Vars) decimal.Decimal
+}
+
+type number decimal.Decimal
+
+func (n number) eval(Vars) decimal.Decimal { return decimal.Decimal(n) }
+
+type variable string
+
+func (n variable) eval(v Vars) decimal.Decimal { return v.get(string(n)) }
+
+type negation struct{ x node }
+
+func (n negation) eval(v Vars) decimal.Decimal { return n.x.eval(v).Neg() }
+
+type binary struct {
+	op          rune
+	left, right node
+}
+
+func (n binary) eval(v Vars) decimal.Decimal {
+	l, r := n.left.eval(v), n.right.eval(v)
+	switch n.op {
+	case '+':
+		return l.Add(r)
+	case '-':
+		return l.Sub(r)
+	case '*':
+		return l.Mul(r)
+	default:
+		if r.IsZero() {
+			return decimal.Zero
+		}
+		return l.DivRound(r, 8)
+	}
+}
+
+// ParseFormula parses a score formula, the error tells where it is invalid
+func ParseFormula(s string) (*Formula, error) {
+	p := &parser{src: s}
+	p.next()
+	root, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEnd {
+		return nil, p.errorf("unexpected %q", p.tok.text)
+	}
+	return &Formula{src: strings.TrimSpace(s), root: root}, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// parser is a recursive descent parser of:
+//
+//	expression = term { ("+" | "-") term }
+//	term       = factor { ("*" | "/") factor }
+//	factor     = number | variable | "(" expression ")" | "-" factor
+type parser struct {
+	src string
+	pos int
+	tok token
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w at %d: %s", ErrInvalidFormula, p.tok.pos+1, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) next() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos >= len(p.src) {
+		p.tok = token{kind: tokenEnd, pos: start}
+		return
+	}
+
+	c := rune(p.src[p.pos])
+	switch {
+	case unicode.IsDigit(c) || c == '.':
+		for p.pos < len(p.src) && (unicode.IsDigit(rune(p.src[p.pos])) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		p.tok = token{kind: tokenNumber, text: p.src[start:p.pos], pos: start}
+	case unicode.IsLetter(c) || c == '_':
+		for p.pos < len(p.src) && (unicode.IsLetter(rune(p.src[p.pos])) || p.src[p.pos] == '_') {
+			p.pos++
+		}
+		p.tok = token{kind: tokenIdent, text: strings.ToLower(p.src[start:p.pos]), pos: start}
+	default:
+		p.pos++
+		p.tok = token{kind: tokenOp, text: string(c), pos: start}
+	}
+}
+
+func (p *parser) expression() (node, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokenOp && (p.tok.text == "+" || p.tok.text == "-") {
+		op := rune(p.tok.text[0])
+		p.next()
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) term() (node, error) {
+	left, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokenOp && (p.tok.text == "*" || p.tok.text == "/") {
+		op := rune(p.tok.text[0])
+		p.next()
+		right, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) factor() (node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokenEnd:
+		return nil, p.errorf("unexpected end of formula")
+	case tokenNumber:
+		d, err := decimal.NewFromString(tok.text)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", tok.text)
+		}
+		p.next()
+		return number(d), nil
+	case tokenIdent:
+		switch tok.text {
+		case VariableWeight, VariableEffort, VariableEffectiveness:
+			p.next()
+			return variable(tok.text), nil
+		}
+		return nil, p.errorf("unknown variable %q, expected weight, effort or effectiveness", tok.text)
+	}
+
+	switch tok.text {
+	case "-":
+		p.next()
+		x, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		return negation{x: x}, nil
+	case "(":
+		p.next()
+		x, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokenOp || p.tok.text != ")" {
+			return nil, p.errorf("missing )")
+		}
+		p.next()
+		return x, nil
+	}
+	return nil, p.errorf("unexpected %q", tok.text)
+}
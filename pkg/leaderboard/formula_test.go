@@ -0,0 +1,58 @@
+package leaderboard
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormula(t *testing.T) {
+	vars := Vars{
+		Weight:        decimal.NewFromInt(12),
+		Effort:        decimal.NewFromInt(40),
+		Effectiveness: decimal.RequireFromString("0.3"),
+	}
+
+	tests := []struct {
+		formula string
+		want    string
+	}{
+		{"weight", "12"},
+		{"Weight * 0.5 + effectiveness * 10", "9"},
+		{"weight - effort / 4", "2"},
+		{"(weight - effort) / 4", "-7"},
+		{"-weight + 2 * (effort - 30)", "8"},
+		{"weight / (effort - 40)", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			f, err := ParseFormula(tt.formula)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Eval(vars).String())
+		})
+	}
+}
+
+func TestParseFormulaErrors(t *testing.T) {
+	tests := []struct {
+		formula string
+		err     string
+	}{
+		{"", "invalid formula at 1: unexpected end of formula"},
+		{"weight +", "invalid formula at 9: unexpected end of formula"},
+		{"points * 2", `invalid formula at 1: unknown variable "points", expected weight, effort or effectiveness`},
+		{"(weight + effort", "invalid formula at 17: missing )"},
+		{"weight effort", `invalid formula at 8: unexpected "effort"`},
+		{"1.2.3", `invalid formula at 1: invalid number "1.2.3"`},
+		{"weight % 2", `invalid formula at 8: unexpected "%"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			_, err := ParseFormula(tt.formula)
+			require.ErrorIs(t, err, ErrInvalidFormula)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
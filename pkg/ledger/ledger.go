@@ -0,0 +1,200 @@
+// Package ledger posts the business events to the double-entry general ledger
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+)
+
+var ErrAlreadyReversed = errors.New("journal entry is already reversed")
+
+// Poster writes journal entries, resolving the account codes of their lines
+type Poster struct {
+	store *store.Store
+}
+
+func New(store *store.Store) *Poster {
+	return &Poster{store: store}
+}
+
+// Post validates and stores a journal entry. Posting the same source twice
+// returns the entry already posted, so the automatic postings can be retried.
+func (p *Poster) Post(db *gorm.DB, entry *model.JournalEntry) (*model.JournalEntry, error) {
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	if entry.SourceID != nil {
+		existing, err := p.store.JournalEntry.GetBySource(db, entry.SourceType, *entry.SourceID)
+		if err == nil {
+			return existing, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	if err := p.resolveAccounts(db, entry); err != nil {
+		return nil, err
+	}
+
+	return p.store.JournalEntry.Create(db, entry)
+}
+
+// PostAccountingTransaction posts an accounting transaction, a zero amount transaction is skipped
+// as is the depreciation, which is posted from the asset depreciation
+func (p *Poster) PostAccountingTransaction(db *gorm.DB, source model.JournalSource, t *model.AccountingTransaction) (*model.JournalEntry, error) {
+	entry, err := EntryFromAccountingTransaction(source, t)
+	if errors.Is(err, ErrZeroAmount) || errors.Is(err, ErrNotPosted) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Post(db, entry)
+}
+
+// PostAccountingTransactions posts a batch of accounting transactions from the same source
+func (p *Poster) PostAccountingTransactions(db *gorm.DB, source model.JournalSource, transactions []*model.AccountingTransaction) error {
+	for _, t := range transactions {
+		if _, err := p.PostAccountingTransaction(db, source, t); err != nil {
+			return fmt.Errorf("failed to post accounting transaction %s: %w", t.ID, err)
+		}
+	}
+	return nil
+}
+
+// PostIcyTransactions posts the ICY rewards valued at icyVNDRate, returning the number of entries posted
+func (p *Poster) PostIcyTransactions(db *gorm.DB, transactions []model.IcyTransaction, icyVNDRate float64) (int, error) {
+	posted := 0
+	for i := range transactions {
+		entry, err := EntryFromIcyTransaction(&transactions[i], icyVNDRate)
+		if errors.Is(err, ErrZeroAmount) {
+			continue
+		}
+		if err != nil {
+			return posted, err
+		}
+
+		if _, err := p.Post(db, entry); err != nil {
+			return posted, fmt.Errorf("failed to post icy transaction %s: %w", transactions[i].ID, err)
+		}
+		posted++
+	}
+	return posted, nil
+}
+
+// PostInboundFundTransaction posts a commission kept in the inbound fund
+func (p *Poster) PostInboundFundTransaction(db *gorm.DB, t *model.InboundFundTransaction) (*model.JournalEntry, error) {
+	entry, err := EntryFromInboundFundTransaction(t)
+	if errors.Is(err, ErrZeroAmount) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Post(db, entry)
+}
+
+// PostAssetDepreciation posts the depreciation of an asset for a month, valued in VND at rate
+func (p *Poster) PostAssetDepreciation(db *gorm.DB, d *model.AssetDepreciation, description string, date time.Time, rate float64) (*model.JournalEntry, error) {
+	entry, err := EntryFromAssetDepreciation(d, description, date, rate)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Post(db, entry)
+}
+
+// Reverse posts the entry cancelling the given one, an entry can only be reversed once
+func (p *Poster) Reverse(db *gorm.DB, entry *model.JournalEntry, date time.Time, description string, createdBy *model.UUID) (*model.JournalEntry, error) {
+	if entry.SourceType == model.JournalSourceReversal {
+		return nil, ErrAlreadyReversed
+	}
+
+	_, err := p.store.JournalEntry.GetBySource(db, model.JournalSourceReversal, entry.ID.String())
+	if err == nil {
+		return nil, ErrAlreadyReversed
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	reversal := entry.Reverse(date, description)
+	reversal.AccountingTransactionID = entry.AccountingTransactionID
+	reversal.CreatedBy = createdBy
+
+	return p.Post(db, reversal)
+}
+
+// ReverseAccountingTransaction reverses the entry of an accounting transaction being deleted,
+// nothing is done when the transaction was never posted
+func (p *Poster) ReverseAccountingTransaction(db *gorm.DB, accountingTransactionID string) error {
+	entry, err := p.store.JournalEntry.GetByAccountingTransactionID(db, accountingTransactionID)
+	return p.reverseIfPosted(db, entry, err)
+}
+
+// ReverseSource reverses the entry posted for a source record being deleted,
+// nothing is done when the record was never posted
+func (p *Poster) ReverseSource(db *gorm.DB, sourceType model.JournalSource, sourceID string) error {
+	entry, err := p.store.JournalEntry.GetBySource(db, sourceType, sourceID)
+	return p.reverseIfPosted(db, entry, err)
+}
+
+func (p *Poster) reverseIfPosted(db *gorm.DB, entry *model.JournalEntry, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = p.Reverse(db, entry, time.Now(), "Reversal - "+entry.Description, nil)
+	if errors.Is(err, ErrAlreadyReversed) {
+		return nil
+	}
+	return err
+}
+
+func (p *Poster) resolveAccounts(db *gorm.DB, entry *model.JournalEntry) error {
+	codes := make([]string, 0, len(entry.Lines))
+	for _, l := range entry.Lines {
+		if l.AccountID.IsZero() {
+			codes = append(codes, l.AccountCode)
+		}
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+
+	accounts, err := p.store.LedgerAccount.GetByCodes(db, codes)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]model.UUID, len(accounts))
+	for _, a := range accounts {
+		ids[a.Code] = a.ID
+	}
+
+	for i := range entry.Lines {
+		if !entry.Lines[i].AccountID.IsZero() {
+			continue
+		}
+		id, ok := ids[entry.Lines[i].AccountCode]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownAccount, entry.Lines[i].AccountCode)
+		}
+		entry.Lines[i].AccountID = id
+	}
+
+	return nil
+}
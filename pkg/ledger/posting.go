@@ -0,0 +1,221 @@
+package ledger
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+var (
+	ErrZeroAmount     = errors.New("transaction amount is zero")
+	ErrUnknownAccount = errors.New("unknown ledger account")
+	ErrNotPosted      = errors.New("transaction is not posted to the ledger")
+)
+
+// categoryAccounts maps the accounting transaction categories to the expense accounts
+var categoryAccounts = map[string]string{
+	model.AccountingOfficeSupply:   model.LedgerAccountOfficeSupply,
+	model.AccountingOfficeServices: model.LedgerAccountOfficeServices,
+	model.AccountingOfficeSpace:    model.LedgerAccountOfficeSpace,
+	model.AccountingTools:          model.LedgerAccountTools,
+	model.AccountingAssets:         model.LedgerAccountFixedAssets,
+}
+
+// AccountFor returns the account an accounting transaction is debited to (expenses)
+// or credited to (income)
+func AccountFor(t *model.AccountingTransaction) string {
+	if t.Type == model.AccountingIncome {
+		if t.Category == model.AccountingIn {
+			return model.LedgerAccountServiceRevenue
+		}
+		return model.LedgerAccountOtherIncome
+	}
+
+	switch {
+	case strings.HasPrefix(t.Category, "Payroll for"):
+		return model.LedgerAccountPayrollExpense
+	case strings.HasPrefix(t.Category, "Commission for"):
+		return model.LedgerAccountCommissionExpense
+	}
+
+	if code, ok := categoryAccounts[t.Category]; ok {
+		return code
+	}
+	return model.LedgerAccountOtherOperatingCost
+}
+
+// SourceOf guesses the business event an accounting transaction comes from, using its metadata
+func SourceOf(t *model.AccountingTransaction) model.JournalSource {
+	var meta model.AccountingMetadata
+	_ = json.Unmarshal(t.Metadata, &meta)
+
+	switch {
+	case meta.Source == "payroll":
+		return model.JournalSourcePayroll
+	case meta.Source == "invoice":
+		return model.JournalSourceInvoice
+	case strings.HasPrefix(meta.Source, "expense"):
+		return model.JournalSourceExpense
+	case strings.HasPrefix(t.Category, "Payroll for"), strings.HasPrefix(t.Category, "Commission for"):
+		return model.JournalSourcePayroll
+	case t.Type == model.AccountingIncome && t.Category == model.AccountingIn:
+		return model.JournalSourceInvoice
+	}
+	return model.JournalSourceOperation
+}
+
+// isDepreciation reports whether the accounting transaction records the depreciation of an asset
+func isDepreciation(t *model.AccountingTransaction) bool {
+	var meta model.AccountingMetadata
+	_ = json.Unmarshal(t.Metadata, &meta)
+	return meta.Source == model.AssetDepreciationSource
+}
+
+// vndAmount is the VND value of an accounting transaction
+func vndAmount(t *model.AccountingTransaction) int64 {
+	if t.ConversionAmount != 0 {
+		return int64(t.ConversionAmount)
+	}
+
+	rate := t.ConversionRate
+	if rate == 0 && (t.Currency == "" || strings.EqualFold(t.Currency, "VND")) {
+		rate = 1
+	}
+	return int64(math.Round(t.Amount * rate))
+}
+
+// EntryFromAccountingTransaction builds the journal entry of an accounting transaction:
+// income is debited to cash and credited to revenue, everything else is debited
+// to its expense account and credited to cash. The depreciation recorded in the Assets category
+// moves no cash, it is not posted: the asset depreciation is posted on its own.
+func EntryFromAccountingTransaction(source model.JournalSource, t *model.AccountingTransaction) (*model.JournalEntry, error) {
+	if isDepreciation(t) {
+		return nil, ErrNotPosted
+	}
+
+	amount := vndAmount(t)
+	if amount == 0 {
+		return nil, ErrZeroAmount
+	}
+
+	// refunds and corrections are recorded with a negative amount, post them on the opposite side
+	debit, credit := model.LedgerAccountCash, AccountFor(t)
+	if t.Type != model.AccountingIncome {
+		debit, credit = credit, debit
+	}
+	if amount < 0 {
+		amount = -amount
+		debit, credit = credit, debit
+	}
+
+	date := time.Now()
+	if t.Date != nil {
+		date = *t.Date
+	}
+
+	sourceID := t.ID.String()
+	return &model.JournalEntry{
+		Date:                    date,
+		Description:             t.Name,
+		SourceType:              source,
+		SourceID:                &sourceID,
+		AccountingTransactionID: &t.ID,
+		Lines: []model.JournalLine{
+			{AccountCode: debit, Debit: amount, Currency: t.Currency, OriginalAmount: t.Amount, Memo: t.Category},
+			{AccountCode: credit, Credit: amount, Currency: t.Currency, OriginalAmount: t.Amount, Memo: t.Category},
+		},
+	}, nil
+}
+
+// EntryFromIcyTransaction builds the journal entry of an ICY reward: the reward is
+// an expense paid out of the ICY treasury, valued at icyVNDRate
+func EntryFromIcyTransaction(t *model.IcyTransaction, icyVNDRate float64) (*model.JournalEntry, error) {
+	icy, err := decimal.NewFromString(t.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("invalid icy amount %q: %w", t.Amount, err)
+	}
+
+	amount := icy.Mul(decimal.NewFromFloat(icyVNDRate)).Round(0).IntPart()
+	if amount <= 0 {
+		return nil, ErrZeroAmount
+	}
+
+	sourceID := t.ID.String()
+	memo := fmt.Sprintf("%s: %s -> %s", t.Category, t.Sender, t.Target)
+	icyAmount, _ := icy.Float64()
+
+	return &model.JournalEntry{
+		Date:        t.TxnTime,
+		Description: fmt.Sprintf("ICY reward - %s", t.Category),
+		SourceType:  model.JournalSourceIcy,
+		SourceID:    &sourceID,
+		Lines: []model.JournalLine{
+			{AccountCode: model.LedgerAccountIcyRewardExpense, Debit: amount, Currency: "ICY", OriginalAmount: icyAmount, Memo: memo},
+			{AccountCode: model.LedgerAccountIcyTreasury, Credit: amount, Currency: "ICY", OriginalAmount: icyAmount, Memo: memo},
+		},
+	}, nil
+}
+
+// EntryFromAssetDepreciation builds the journal entry of the depreciation of an asset for a
+// month, valued in VND at rate. Depreciation moves no cash, it is debited to the depreciation
+// expense and credited to the accumulated depreciation.
+func EntryFromAssetDepreciation(d *model.AssetDepreciation, description string, date time.Time, rate float64) (*model.JournalEntry, error) {
+	amount := int64(math.Round(float64(d.Amount) * rate))
+	if amount <= 0 {
+		return nil, ErrZeroAmount
+	}
+
+	sourceID := d.ID.String()
+	return &model.JournalEntry{
+		Date:        date,
+		Description: description,
+		SourceType:  model.JournalSourceDepreciation,
+		SourceID:    &sourceID,
+		Lines: []model.JournalLine{
+			{AccountCode: model.LedgerAccountDepreciation, Debit: amount, Currency: d.Currency, OriginalAmount: float64(d.Amount), Memo: description},
+			{AccountCode: model.LedgerAccountAccumulatedDepreciation, Credit: amount, Currency: d.Currency, OriginalAmount: float64(d.Amount), Memo: description},
+		},
+	}, nil
+}
+
+// EntryFromInboundFundTransaction builds the journal entry of a commission kept in the
+// inbound fund: it is a commission expense the company owes to the fund
+func EntryFromInboundFundTransaction(t *model.InboundFundTransaction) (*model.JournalEntry, error) {
+	amount := int64(t.Amount)
+	if amount == 0 {
+		return nil, ErrZeroAmount
+	}
+
+	debit, credit := model.LedgerAccountCommissionExpense, model.LedgerAccountInboundFund
+	if amount < 0 {
+		amount = -amount
+		debit, credit = credit, debit
+	}
+
+	date := t.CreatedAt
+	if t.PaidAt != nil {
+		date = *t.PaidAt
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	sourceID := t.ID.String()
+	return &model.JournalEntry{
+		Date:        date,
+		Description: "Inbound fund commission - " + t.Notes,
+		SourceType:  model.JournalSourceInboundFund,
+		SourceID:    &sourceID,
+		Lines: []model.JournalLine{
+			{AccountCode: debit, Debit: amount, Currency: "VND", OriginalAmount: float64(t.Amount), Memo: t.Notes},
+			{AccountCode: credit, Credit: amount, Currency: "VND", OriginalAmount: float64(t.Amount), Memo: t.Notes},
+		},
+	}, nil
+}
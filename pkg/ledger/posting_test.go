@@ -0,0 +1,216 @@
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func TestAccountFor(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   model.AccountingTransaction
+		want string
+	}{
+		{
+			name: "invoice income",
+			tx:   model.AccountingTransaction{Type: model.AccountingIncome, Category: model.AccountingIn},
+			want: model.LedgerAccountServiceRevenue,
+		},
+		{
+			name: "other income",
+			tx:   model.AccountingTransaction{Type: model.AccountingIncome, Category: "Refund"},
+			want: model.LedgerAccountOtherIncome,
+		},
+		{
+			name: "payroll",
+			tx:   model.AccountingTransaction{Type: model.AccountingSE, Category: model.AccountingEng},
+			want: model.LedgerAccountPayrollExpense,
+		},
+		{
+			name: "commission",
+			tx:   model.AccountingTransaction{Type: model.AccountingSE, Category: model.AccountingCommLead},
+			want: model.LedgerAccountCommissionExpense,
+		},
+		{
+			name: "office space",
+			tx:   model.AccountingTransaction{Type: model.AccountingOP, Category: model.AccountingOfficeSpace},
+			want: model.LedgerAccountOfficeSpace,
+		},
+		{
+			name: "unknown category",
+			tx:   model.AccountingTransaction{Type: model.AccountingOP, Category: "Team building"},
+			want: model.LedgerAccountOtherOperatingCost,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AccountFor(&tt.tx))
+		})
+	}
+}
+
+func TestSourceOf(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   model.AccountingTransaction
+		want model.JournalSource
+	}{
+		{
+			name: "payroll metadata",
+			tx:   model.AccountingTransaction{Metadata: datatypes.JSON(`{"source":"payroll"}`)},
+			want: model.JournalSourcePayroll,
+		},
+		{
+			name: "expense metadata",
+			tx:   model.AccountingTransaction{Metadata: datatypes.JSON(`{"source":"expense_basecamp"}`)},
+			want: model.JournalSourceExpense,
+		},
+		{
+			name: "invoice without metadata",
+			tx:   model.AccountingTransaction{Type: model.AccountingIncome, Category: model.AccountingIn},
+			want: model.JournalSourceInvoice,
+		},
+		{
+			name: "operation",
+			tx:   model.AccountingTransaction{Type: model.AccountingOP, Category: model.AccountingTools},
+			want: model.JournalSourceOperation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SourceOf(&tt.tx))
+		})
+	}
+}
+
+func TestEntryFromAccountingTransaction(t *testing.T) {
+	date := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+
+	t.Run("income", func(t *testing.T) {
+		tx := &model.AccountingTransaction{
+			BaseModel:        model.BaseModel{ID: model.NewUUID()},
+			Date:             &date,
+			Amount:           1000,
+			ConversionAmount: 25000000,
+			Currency:         "USD",
+			Type:             model.AccountingIncome,
+			Category:         model.AccountingIn,
+		}
+
+		e, err := EntryFromAccountingTransaction(model.JournalSourceInvoice, tx)
+		require.NoError(t, err)
+		assert.NoError(t, e.Validate())
+		assert.Equal(t, date, e.Date)
+		assert.Equal(t, tx.ID, *e.AccountingTransactionID)
+		assert.Equal(t, model.LedgerAccountCash, e.Lines[0].AccountCode)
+		assert.Equal(t, int64(25000000), e.Lines[0].Debit)
+		assert.Equal(t, model.LedgerAccountServiceRevenue, e.Lines[1].AccountCode)
+		assert.Equal(t, int64(25000000), e.Lines[1].Credit)
+	})
+
+	t.Run("expense", func(t *testing.T) {
+		tx := &model.AccountingTransaction{
+			BaseModel: model.BaseModel{ID: model.NewUUID()},
+			Amount:    500000,
+			Currency:  "VND",
+			Type:      model.AccountingOP,
+			Category:  model.AccountingTools,
+		}
+
+		e, err := EntryFromAccountingTransaction(model.JournalSourceOperation, tx)
+		require.NoError(t, err)
+		assert.Equal(t, model.LedgerAccountTools, e.Lines[0].AccountCode)
+		assert.Equal(t, int64(500000), e.Lines[0].Debit)
+		assert.Equal(t, model.LedgerAccountCash, e.Lines[1].AccountCode)
+	})
+
+	t.Run("negative expense is posted on the opposite side", func(t *testing.T) {
+		tx := &model.AccountingTransaction{
+			BaseModel: model.BaseModel{ID: model.NewUUID()},
+			Amount:    -500000,
+			Currency:  "VND",
+			Type:      model.AccountingOP,
+			Category:  model.AccountingTools,
+		}
+
+		e, err := EntryFromAccountingTransaction(model.JournalSourceOperation, tx)
+		require.NoError(t, err)
+		assert.Equal(t, model.LedgerAccountCash, e.Lines[0].AccountCode)
+		assert.Equal(t, int64(500000), e.Lines[0].Debit)
+		assert.Equal(t, model.LedgerAccountTools, e.Lines[1].AccountCode)
+	})
+
+	t.Run("zero amount", func(t *testing.T) {
+		_, err := EntryFromAccountingTransaction(model.JournalSourceOperation, &model.AccountingTransaction{Currency: "VND"})
+		assert.ErrorIs(t, err, ErrZeroAmount)
+	})
+
+	t.Run("depreciation is left to the asset depreciation", func(t *testing.T) {
+		tx := &model.AccountingTransaction{
+			BaseModel: model.BaseModel{ID: model.NewUUID()},
+			Amount:    500000,
+			Currency:  "VND",
+			Type:      model.AccountingCA,
+			Category:  model.AccountingAssets,
+			Metadata:  []byte(`{"source":"asset_depreciation","id":"` + model.NewUUID().String() + `"}`),
+		}
+
+		_, err := EntryFromAccountingTransaction(model.JournalSourceOperation, tx)
+		assert.ErrorIs(t, err, ErrNotPosted)
+	})
+}
+
+func TestEntryFromAssetDepreciation(t *testing.T) {
+	date := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("depreciation moves no cash", func(t *testing.T) {
+		d := &model.AssetDepreciation{
+			BaseModel: model.BaseModel{ID: model.NewUUID()},
+			Amount:    40,
+			Currency:  "USD",
+		}
+
+		e, err := EntryFromAssetDepreciation(d, "Depreciation - MacBook - 10/2026", date, 25000)
+		require.NoError(t, err)
+		assert.NoError(t, e.Validate())
+		assert.Equal(t, model.JournalSourceDepreciation, e.SourceType)
+		assert.Equal(t, d.ID.String(), *e.SourceID)
+		assert.Nil(t, e.AccountingTransactionID)
+		assert.Equal(t, model.LedgerAccountDepreciation, e.Lines[0].AccountCode)
+		assert.Equal(t, int64(1000000), e.Lines[0].Debit)
+		assert.Equal(t, model.LedgerAccountAccumulatedDepreciation, e.Lines[1].AccountCode)
+		assert.Equal(t, int64(1000000), e.Lines[1].Credit)
+	})
+
+	t.Run("zero amount", func(t *testing.T) {
+		_, err := EntryFromAssetDepreciation(&model.AssetDepreciation{Currency: "VND"}, "", date, 1)
+		assert.ErrorIs(t, err, ErrZeroAmount)
+	})
+}
+
+func TestEntryFromIcyTransaction(t *testing.T) {
+	tx := &model.IcyTransaction{
+		BaseModel: model.BaseModel{ID: model.NewUUID()},
+		Category:  "learning",
+		Amount:    "12.5",
+		TxnTime:   time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	e, err := EntryFromIcyTransaction(tx, 37500)
+	require.NoError(t, err)
+	assert.NoError(t, e.Validate())
+	assert.Equal(t, model.LedgerAccountIcyRewardExpense, e.Lines[0].AccountCode)
+	assert.Equal(t, int64(468750), e.Lines[0].Debit)
+	assert.Equal(t, model.LedgerAccountIcyTreasury, e.Lines[1].AccountCode)
+
+	_, err = EntryFromIcyTransaction(&model.IcyTransaction{Amount: "abc"}, 37500)
+	assert.Error(t, err)
+}
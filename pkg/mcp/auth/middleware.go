@@ -288,6 +288,8 @@ func getToolCategory(toolName string) string {
 		return "invoice"
 	case strings.Contains(toolName, "payroll"):
 		return "payroll"
+	case strings.Contains(toolName, "knowledge"):
+		return "knowledge"
 	default:
 		return "unknown"
 	}
@@ -428,6 +428,11 @@ func TestGetToolCategory(t *testing.T) {
 			toolName:         "calculate_monthly_payroll",
 			expectedCategory: "payroll",
 		},
+		{
+			name:             "Knowledge base tool",
+			toolName:         "search_knowledge_base",
+			expectedCategory: "knowledge",
+		},
 		{
 			name:             "Unknown tool",
 			toolName:         "unknown_tool",
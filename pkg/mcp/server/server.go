@@ -11,10 +11,12 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/mcp/auth"
 	"github.com/dwarvesf/fortress-api/pkg/mcp/tools/employee"
 	"github.com/dwarvesf/fortress-api/pkg/mcp/tools/invoice"
+	"github.com/dwarvesf/fortress-api/pkg/mcp/tools/knowledgebase"
 	"github.com/dwarvesf/fortress-api/pkg/mcp/tools/payroll"
 	"github.com/dwarvesf/fortress-api/pkg/mcp/tools/project"
 	"github.com/dwarvesf/fortress-api/pkg/mcp/tools/workflow"
@@ -103,6 +105,11 @@ func (s *MCPServer) RegisterTools() error {
 		return fmt.Errorf("failed to register workflow tools: %w", err)
 	}
 
+	// Register knowledge base tools
+	if err := s.registerKnowledgeBaseTools(); err != nil {
+		return fmt.Errorf("failed to register knowledge base tools: %w", err)
+	}
+
 	return nil
 }
 
@@ -372,7 +379,7 @@ func (s *MCPServer) registerPayrollTools() error {
 
 func (s *MCPServer) registerWorkflowTools() error {
 	// Create workflow tools instance
-	workflowTools := workflow.New(s.cfg, s.store, s.repo, s.services.Wise)
+	workflowTools := workflow.New(s.cfg, s.store, s.repo, s.services.Wise, fxrate.New(s.store, s.repo, s.services, s.logger, s.cfg))
 	
 	// Register calculate_monthly_payroll tool
 	calculateMonthlyPayrollTool := workflowTools.CalculateMonthlyPayrollTool()
@@ -387,3 +394,16 @@ func (s *MCPServer) registerWorkflowTools() error {
 	s.logger.Info("Workflow tools registered successfully")
 	return nil
 }
+
+func (s *MCPServer) registerKnowledgeBaseTools() error {
+	// Create knowledge base tools instance
+	knowledgeBaseTools := knowledgebase.New(s.store, s.repo)
+
+	// Register search_knowledge_base tool
+	searchTool := knowledgeBaseTools.SearchKnowledgeBaseTool()
+	searchHandler := s.wrapToolWithAuth("search_knowledge_base", knowledgeBaseTools.SearchKnowledgeBaseHandler)
+	s.server.AddTool(searchTool, searchHandler)
+
+	s.logger.Info("Knowledge base tools registered successfully")
+	return nil
+}
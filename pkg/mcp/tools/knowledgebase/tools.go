@@ -0,0 +1,95 @@
+package knowledgebase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"github.com/dwarvesf/fortress-api/pkg/mcp/errors"
+	"github.com/dwarvesf/fortress-api/pkg/mcp/validation"
+	mcpview "github.com/dwarvesf/fortress-api/pkg/mcp/view"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/store/knowledgedocument"
+	"github.com/dwarvesf/fortress-api/pkg/utils/timeutil"
+	"github.com/dwarvesf/fortress-api/pkg/view"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 50
+)
+
+// Tools represents knowledge base MCP tools
+type Tools struct {
+	store *store.Store
+	repo  store.DBRepo
+}
+
+// New creates a new knowledge base tools instance
+func New(store *store.Store, repo store.DBRepo) *Tools {
+	return &Tools{
+		store: store,
+		repo:  repo,
+	}
+}
+
+// SearchKnowledgeBaseTool returns the MCP tool for searching the knowledge base
+func (t *Tools) SearchKnowledgeBaseTool() mcp.Tool {
+	return mcp.NewTool(
+		"search_knowledge_base",
+		mcp.WithDescription("Full-text search over the memos, brainery logs and OGIF summaries, ranked with the matches highlighted in <mark>"),
+		mcp.WithString("query", mcp.Required(), mcp.Description("Search query, supports quoted phrases, OR and - to exclude a word")),
+		mcp.WithString("source", mcp.Description("Source to search (optional): memo, brainery or ogif")),
+		mcp.WithString("author", mcp.Description("GitHub, Discord or memo username of an author (optional)")),
+		mcp.WithString("tag", mcp.Description("Tag (optional)")),
+		mcp.WithString("from", mcp.Description("Published from (optional, YYYY-MM-DD format)")),
+		mcp.WithString("to", mcp.Description("Published to (optional, YYYY-MM-DD format)")),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of results to return (optional, default: 10, max: 50)")),
+	)
+}
+
+// SearchKnowledgeBaseHandler handles the search_knowledge_base tool execution
+func (t *Tools) SearchKnowledgeBaseHandler(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	keyword, err := req.RequireString("query")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err := validation.ValidateRequired(strings.TrimSpace(keyword), "query"); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	source := req.GetString("source", "")
+	if source != "" && !model.KnowledgeSource(source).IsValid() {
+		return errors.NewValidationError("invalid source", "expected memo, brainery or ogif"), nil
+	}
+
+	from, err := timeutil.ParseOptionalDate(req.GetString("from", ""))
+	if err != nil {
+		return errors.NewValidationError("invalid from date", "expected YYYY-MM-DD"), nil
+	}
+	to, err := timeutil.ParseOptionalDate(req.GetString("to", ""))
+	if err != nil {
+		return errors.NewValidationError("invalid to date", "expected YYYY-MM-DD"), nil
+	}
+
+	limit := int(req.GetFloat("limit", defaultLimit))
+	if limit <= 0 || limit > maxLimit {
+		limit = defaultLimit
+	}
+
+	results, _, err := t.store.KnowledgeDocument.Search(t.repo.DB(), knowledgedocument.Query{
+		Keyword: strings.TrimSpace(keyword),
+		Source:  model.KnowledgeSource(source),
+		Author:  strings.TrimPrefix(strings.TrimSpace(req.GetString("author", "")), "@"),
+		Tag:     strings.TrimPrefix(strings.TrimSpace(req.GetString("tag", "")), "#"),
+		From:    from,
+		To:      to,
+	}, model.Pagination{Page: 1, Size: int64(limit)})
+	if err != nil {
+		return errors.NewDatabaseError("search knowledge base", err), nil
+	}
+
+	return mcpview.FormatJSONResponse(view.ToKnowledgeSearchResults(results))
+}
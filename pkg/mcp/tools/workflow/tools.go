@@ -2,6 +2,7 @@ package workflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"gorm.io/datatypes"
 
 	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/controller/fxrate"
 	"github.com/dwarvesf/fortress-api/pkg/mcp/auth"
 	"github.com/dwarvesf/fortress-api/pkg/mcp/view"
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -25,15 +27,17 @@ type Tools struct {
 	store           *store.Store
 	repo            store.DBRepo
 	workflowService *workflow.Service
+	fxRate          fxrate.IController
 }
 
 // New creates a new workflow tools instance
-func New(cfg *config.Config, store *store.Store, repo store.DBRepo, wiseService wise.IService) *Tools {
+func New(cfg *config.Config, store *store.Store, repo store.DBRepo, wiseService wise.IService, fxRate fxrate.IController) *Tools {
 	return &Tools{
 		cfg:             cfg,
 		store:           store,
 		repo:            repo,
 		workflowService: workflow.New(store, repo, wiseService),
+		fxRate:          fxRate,
 	}
 }
 
@@ -303,6 +307,20 @@ func (t *Tools) getActiveEmployees(ctx context.Context, params *workflow.Monthly
 	return filteredEmployees, nil
 }
 
+// usdToVNDRateAt gets the USD to VND exchange rate in effect on the given date from the fx rate
+// history, the same rate the API uses, falling back to the Wise historical rate when the history
+// has nothing
+func (t *Tools) usdToVNDRateAt(date time.Time) (float64, error) {
+	rate, err := t.fxRate.GetRateAt("USD", "VND", date)
+	if err == nil {
+		return rate.Rate, nil
+	}
+	if !errors.Is(err, fxrate.ErrRateNotFound) {
+		return 0, fmt.Errorf("failed to get USD to VND exchange rate history: %w", err)
+	}
+	return t.workflowService.GetUSDToVNDRateAt(date)
+}
+
 // calculateEmployeePayroll calculates payroll for a single employee using real database data
 func (t *Tools) calculateEmployeePayroll(ctx context.Context, emp *model.Employee, params *workflow.MonthlyPayrollParams) (*workflow.EmployeeCalculation, error) {
 	// 1. Calculate base salary
@@ -311,7 +329,17 @@ func (t *Tools) calculateEmployeePayroll(ctx context.Context, emp *model.Employe
 	// Get USD to VND conversion rate from Wise API
 	var conversionRate float64 = 25000
 
-	if t.cfg.Env != "prod" {
+	switch {
+	case params.CurrencyDate != "":
+		currencyDate, err := time.Parse("2006-01-02", params.CurrencyDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid currency date %s: %w", params.CurrencyDate, err)
+		}
+		conversionRate, err = t.usdToVNDRateAt(currencyDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get USD to VND conversion rate: %w", err)
+		}
+	case t.cfg.Env != "prod":
 		var err error
 		conversionRate, err = t.workflowService.GetUSDToVNDRate()
 		if err != nil {
@@ -0,0 +1,263 @@
+// Package memo parses the memos of the memo repository, markdown files with a YAML front matter
+// carrying the authors, tags, date and draft status of the memo
+package memo
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+const frontMatterDelimiter = "---"
+
+var (
+	ErrInvalidFrontMatter = errors.New("invalid front matter")
+	ErrOutsideContentDir  = errors.New("file is outside the content directory")
+)
+
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	"2006/01/02",
+}
+
+// Memo is a memo parsed from its file
+type Memo struct {
+	Path        string
+	URL         string
+	Title       string
+	Description string
+	Authors     []string // GitHub handles, without the @
+	Tags        []string
+	Category    []string // the directories of the memo
+	Date        *time.Time
+	Draft       bool
+	Hash        string // of the whole file, to tell an edit from a rename
+	Body        string // the markdown after the front matter
+}
+
+type frontMatter struct {
+	Title       string     `yaml:"title"`
+	Description string     `yaml:"description"`
+	Authors     stringList `yaml:"authors"`
+	Author      stringList `yaml:"author"`
+	Tags        stringList `yaml:"tags"`
+	Date        string     `yaml:"date"`
+	Draft       bool       `yaml:"draft"`
+}
+
+// stringList accepts both a single value and a list
+type stringList []string
+
+func (s *stringList) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" || strings.TrimSpace(node.Value) == "" {
+			*s = nil
+			return nil
+		}
+		// "a, b" lists a few values in one string
+		*s = nil
+		for _, v := range strings.Split(node.Value, ",") {
+			*s = append(*s, v)
+		}
+		return nil
+	case yaml.SequenceNode:
+		var values []string
+		if err := node.Decode(&values); err != nil {
+			return err
+		}
+		*s = values
+		return nil
+	default:
+		return ErrInvalidFrontMatter
+	}
+}
+
+// Parse parses the memo at the path, relative to the repository root. The URL of the memo is the
+// path relative to the content directory under the site URL.
+func Parse(filePath string, content []byte, contentDir, siteURL string) (*Memo, error) {
+	rel, err := relativePath(filePath, contentDir)
+	if err != nil {
+		return nil, err
+	}
+
+	fm, body, err := splitFrontMatter(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta frontMatter
+	if len(fm) > 0 {
+		if err := yaml.Unmarshal(fm, &meta); err != nil {
+			return nil, ErrInvalidFrontMatter
+		}
+	}
+
+	sum := sha256.Sum256(content)
+	m := &Memo{
+		Path:        filePath,
+		URL:         URL(filePath, contentDir, siteURL),
+		Title:       strings.TrimSpace(meta.Title),
+		Description: strings.TrimSpace(meta.Description),
+		Authors:     Handles(append(meta.Authors, meta.Author...)),
+		Tags:        clean(meta.Tags),
+		Category:    category(rel),
+		Draft:       meta.Draft,
+		Hash:        hex.EncodeToString(sum[:]),
+		Body:        strings.TrimSpace(string(body)),
+	}
+	if m.Title == "" {
+		m.Title = heading(body)
+	}
+	if m.Title == "" {
+		m.Title = titleFromName(rel)
+	}
+	if m.Description == "" {
+		m.Description = firstParagraph(body)
+	}
+	if date, ok := parseDate(meta.Date); ok {
+		m.Date = &date
+	}
+
+	return m, nil
+}
+
+// URL returns the URL the memo at the path is published at
+func URL(filePath, contentDir, siteURL string) string {
+	rel, err := relativePath(filePath, contentDir)
+	if err != nil {
+		return ""
+	}
+
+	slug := strings.TrimSuffix(rel, path.Ext(rel))
+	if base := path.Base(slug); base == "_index" || base == "index" || strings.EqualFold(base, "readme") {
+		slug = path.Dir(slug)
+	}
+	if slug == "." {
+		slug = ""
+	}
+	slug = strings.ToLower(strings.ReplaceAll(slug, " ", "-"))
+
+	return strings.TrimSuffix(siteURL, "/") + "/" + slug
+}
+
+// Handles normalizes GitHub handles: trimmed, without the @ and the duplicates
+func Handles(handles []string) []string {
+	rs := make([]string, 0, len(handles))
+	seen := make(map[string]bool)
+	for _, h := range handles {
+		h = strings.TrimPrefix(strings.TrimSpace(h), "@")
+		if h == "" || seen[strings.ToLower(h)] {
+			continue
+		}
+		seen[strings.ToLower(h)] = true
+		rs = append(rs, h)
+	}
+	return rs
+}
+
+func relativePath(filePath, contentDir string) (string, error) {
+	filePath = path.Clean(filePath)
+	dir := strings.Trim(contentDir, "/")
+	if dir == "" || dir == "." {
+		return filePath, nil
+	}
+	if !strings.HasPrefix(filePath, dir+"/") {
+		return "", ErrOutsideContentDir
+	}
+	return strings.TrimPrefix(filePath, dir+"/"), nil
+}
+
+// splitFrontMatter splits the front matter from the body, a file without one is all body
+func splitFrontMatter(content []byte) ([]byte, []byte, error) {
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	if !bytes.HasPrefix(content, []byte(frontMatterDelimiter+"\n")) {
+		return nil, content, nil
+	}
+
+	rest := content[len(frontMatterDelimiter)+1:]
+	if bytes.HasPrefix(rest, []byte(frontMatterDelimiter+"\n")) || bytes.Equal(rest, []byte(frontMatterDelimiter)) {
+		return nil, bytes.TrimPrefix(rest, []byte(frontMatterDelimiter)), nil
+	}
+
+	end := bytes.Index(rest, []byte("\n"+frontMatterDelimiter))
+	if end < 0 {
+		return nil, nil, ErrInvalidFrontMatter
+	}
+	body := rest[end+len(frontMatterDelimiter)+1:]
+	if i := bytes.IndexByte(body, '\n'); i >= 0 {
+		body = body[i+1:]
+	} else {
+		body = nil
+	}
+
+	return rest[:end], body, nil
+}
+
+func clean(values []string) []string {
+	rs := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			rs = append(rs, v)
+		}
+	}
+	return rs
+}
+
+func category(rel string) []string {
+	dir := path.Dir(rel)
+	if dir == "." {
+		return []string{}
+	}
+	return strings.Split(strings.ToLower(strings.ReplaceAll(dir, " ", "-")), "/")
+}
+
+func heading(body []byte) string {
+	for _, line := range strings.Split(string(body), "\n") {
+		if strings.HasPrefix(line, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "# "))
+		}
+	}
+	return ""
+}
+
+func firstParagraph(body []byte) string {
+	for _, line := range strings.Split(string(body), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "!") {
+			return line
+		}
+	}
+	return ""
+}
+
+func titleFromName(rel string) string {
+	name := strings.TrimSuffix(path.Base(rel), path.Ext(rel))
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '-' || r == '_' || r == ' ' })
+	for i, w := range words {
+		words[i] = cases.Title(language.English).String(w)
+	}
+	return strings.Join(words, " ")
+}
+
+func parseDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,84 @@
+package memo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	content := []byte(`---
+title: "Go concurrency patterns"
+authors:
+  - "@huynguyenh"
+  - minhth
+  - HuyNguyenH
+tags: [go, concurrency]
+date: 2026-10-01
+draft: false
+---
+
+# Ignored heading
+
+Channels and goroutines.
+`)
+
+	m, err := Parse("vault/engineering/Go Concurrency.md", content, "vault", "https://memo.d.foundation/")
+	require.NoError(t, err)
+	require.Equal(t, "Go concurrency patterns", m.Title)
+	require.Equal(t, "https://memo.d.foundation/engineering/go-concurrency", m.URL)
+	require.Equal(t, []string{"huynguyenh", "minhth"}, m.Authors)
+	require.Equal(t, []string{"go", "concurrency"}, m.Tags)
+	require.Equal(t, []string{"engineering"}, m.Category)
+	require.Equal(t, "Channels and goroutines.", m.Description)
+	require.False(t, m.Draft)
+	require.NotNil(t, m.Date)
+	require.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), *m.Date)
+	require.Len(t, m.Hash, 64)
+}
+
+func TestParseSingleValues(t *testing.T) {
+	content := []byte("---\nauthor: \"@minhth, lmquang\"\ntags: ops\ndraft: true\ndate: \"2026-10-02 08:30:00\"\n---\nBody\n")
+
+	m, err := Parse("vault/ops/on-call.md", content, "vault", "https://memo.d.foundation")
+	require.NoError(t, err)
+	require.Equal(t, "On Call", m.Title)
+	require.Equal(t, []string{"minhth", "lmquang"}, m.Authors)
+	require.Equal(t, []string{"ops"}, m.Tags)
+	require.True(t, m.Draft)
+	require.Equal(t, time.Date(2026, 10, 2, 8, 30, 0, 0, time.UTC), *m.Date)
+}
+
+func TestParseWithoutFrontMatter(t *testing.T) {
+	m, err := Parse("vault/readme.md", []byte("# Memo\n\nThe memos of the foundation.\n"), "vault", "https://memo.d.foundation")
+	require.NoError(t, err)
+	require.Equal(t, "Memo", m.Title)
+	require.Equal(t, "https://memo.d.foundation/", m.URL)
+	require.Empty(t, m.Authors)
+	require.Empty(t, m.Category)
+	require.Nil(t, m.Date)
+}
+
+func TestParseErrors(t *testing.T) {
+	_, err := Parse("vault/a.md", []byte("---\ntitle: a\n"), "vault", "https://memo.d.foundation")
+	require.ErrorIs(t, err, ErrInvalidFrontMatter)
+
+	_, err = Parse("vault/a.md", []byte("---\ntags: {a: b}\n---\n"), "vault", "https://memo.d.foundation")
+	require.ErrorIs(t, err, ErrInvalidFrontMatter)
+
+	_, err = Parse("docs/a.md", []byte("# A"), "vault", "https://memo.d.foundation")
+	require.ErrorIs(t, err, ErrOutsideContentDir)
+}
+
+func TestHashChangesWithContent(t *testing.T) {
+	a, err := Parse("vault/a.md", []byte("# A\n"), "vault", "https://memo.d.foundation")
+	require.NoError(t, err)
+	b, err := Parse("vault/b.md", []byte("# A\n"), "vault", "https://memo.d.foundation")
+	require.NoError(t, err)
+	c, err := Parse("vault/a.md", []byte("# A edited\n"), "vault", "https://memo.d.foundation")
+	require.NoError(t, err)
+
+	require.Equal(t, a.Hash, b.Hash)
+	require.NotEqual(t, a.Hash, c.Hash)
+}
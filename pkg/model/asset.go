@@ -2,13 +2,131 @@ package model
 
 import "time"
 
+type AssetCategory string
+
+const (
+	AssetCategoryLaptop    AssetCategory = "laptop"
+	AssetCategoryMonitor   AssetCategory = "monitor"
+	AssetCategoryPhone     AssetCategory = "phone"
+	AssetCategoryAccessory AssetCategory = "accessory"
+	AssetCategoryFurniture AssetCategory = "furniture"
+	AssetCategoryOther     AssetCategory = "other"
+)
+
+func (c AssetCategory) IsValid() bool {
+	switch c {
+	case AssetCategoryLaptop,
+		AssetCategoryMonitor,
+		AssetCategoryPhone,
+		AssetCategoryAccessory,
+		AssetCategoryFurniture,
+		AssetCategoryOther:
+		return true
+	}
+	return false
+}
+
+func (c AssetCategory) String() string {
+	return string(c)
+}
+
+type AssetStatus string
+
+const (
+	AssetStatusInStock  AssetStatus = "in-stock"
+	AssetStatusAssigned AssetStatus = "assigned"
+	AssetStatusInRepair AssetStatus = "in-repair"
+	AssetStatusRetired  AssetStatus = "retired"
+	AssetStatusLost     AssetStatus = "lost"
+)
+
+func (s AssetStatus) IsValid() bool {
+	switch s {
+	case AssetStatusInStock,
+		AssetStatusAssigned,
+		AssetStatusInRepair,
+		AssetStatusRetired,
+		AssetStatusLost:
+		return true
+	}
+	return false
+}
+
+func (s AssetStatus) String() string {
+	return string(s)
+}
+
+// IsReturnable tells whether an asset can be given back in this status
+func (s AssetStatus) IsReturnable() bool {
+	return s == AssetStatusInStock || s == AssetStatusInRepair || s == AssetStatusLost
+}
+
 type Asset struct {
 	BaseModel
 
-	Name         string
-	Price        int64
-	Quantity     string
-	Note         string
-	Location     string
-	PurchaseDate *time.Time
+	Name             string
+	SerialNumber     string
+	Category         AssetCategory
+	Status           AssetStatus
+	Price            int64 // purchase cost, in the currency of the asset
+	CurrencyID       *UUID
+	Quantity         string
+	Note             string
+	Location         string
+	UsedBy           *UUID
+	PurchaseDate     *time.Time `gorm:"column:purchased_at"`
+	UsefulLifeMonths int        // the asset is depreciated over it, not depreciated when zero
+	SalvageValue     int64      // value left at the end of the useful life
+	ExpenseID        *UUID
+
+	Currency      *Currency           `gorm:"foreignKey:CurrencyID"`
+	Holder        *Employee           `gorm:"foreignKey:UsedBy"`
+	Expense       *Expense            `gorm:"foreignKey:ExpenseID"`
+	Assignments   []AssetAssignment   `gorm:"foreignKey:AssetID"`
+	Depreciations []AssetDepreciation `gorm:"foreignKey:AssetID"`
+}
+
+// CurrencyName is the currency of the cost, VND when it is not set
+func (a *Asset) CurrencyName() string {
+	if a.Currency == nil || a.Currency.Name == "" {
+		return "VND"
+	}
+	return a.Currency.Name
+}
+
+// AssetAssignment is an asset handed over to an employee, open until it is returned
+type AssetAssignment struct {
+	BaseModel
+
+	AssetID           UUID
+	EmployeeID        UUID
+	AssignedAt        time.Time
+	AssignedCondition string
+	AssignedBy        *UUID
+	ReturnedAt        *time.Time
+	ReturnedCondition string
+	ReceivedBy        *UUID
+	Note              string
+
+	Asset    *Asset    `gorm:"foreignKey:AssetID"`
+	Employee *Employee `gorm:"foreignKey:EmployeeID"`
+}
+
+// AssetDepreciationSource is the metadata source of the accounting transactions recording
+// depreciation, the ledger leaves them out as the depreciation is posted on its own
+const AssetDepreciationSource = "asset_depreciation"
+
+// AssetDepreciation is the depreciation of an asset booked for a month, in the currency of the asset
+type AssetDepreciation struct {
+	BaseModel
+
+	AssetID                 UUID
+	Month                   int
+	Year                    int
+	Amount                  int64
+	Accumulated             int64
+	BookValue               int64
+	Currency                string
+	AccountingTransactionID *UUID
+	JournalEntryID          *UUID
 }
@@ -0,0 +1,186 @@
+package model
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type BankStatementFormat string
+
+const (
+	BankStatementFormatCSV     BankStatementFormat = "csv"
+	BankStatementFormatOFX     BankStatementFormat = "ofx"
+	BankStatementFormatCAMT053 BankStatementFormat = "camt053"
+)
+
+func (f BankStatementFormat) IsValid() bool {
+	switch f {
+	case BankStatementFormatCSV,
+		BankStatementFormatOFX,
+		BankStatementFormatCAMT053:
+		return true
+	}
+	return false
+}
+
+func (f BankStatementFormat) String() string {
+	return string(f)
+}
+
+// BankStatementFormatFromFileName guesses the format of a statement from its extension
+func BankStatementFormatFromFileName(name string) BankStatementFormat {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return BankStatementFormatCSV
+	case ".ofx", ".qfx":
+		return BankStatementFormatOFX
+	case ".xml", ".camt", ".053":
+		return BankStatementFormatCAMT053
+	}
+	return ""
+}
+
+// BankStatement is an imported bank statement file
+type BankStatement struct {
+	BaseModel
+
+	FileName         string
+	Format           BankStatementFormat
+	AccountNumber    string
+	Currency         string
+	PeriodStart      *time.Time
+	PeriodEnd        *time.Time
+	TransactionCount int
+	ImportedBy       *UUID
+}
+
+type BankTransactionDirection string
+
+const (
+	BankTransactionDirectionCredit BankTransactionDirection = "credit" // money in
+	BankTransactionDirectionDebit  BankTransactionDirection = "debit"  // money out
+)
+
+func (d BankTransactionDirection) IsValid() bool {
+	return d == BankTransactionDirectionCredit || d == BankTransactionDirectionDebit
+}
+
+func (d BankTransactionDirection) String() string {
+	return string(d)
+}
+
+type BankTransactionStatus string
+
+const (
+	BankTransactionStatusUnmatched BankTransactionStatus = "unmatched"
+	BankTransactionStatusReview    BankTransactionStatus = "review"
+	BankTransactionStatusMatched   BankTransactionStatus = "matched"
+	BankTransactionStatusIgnored   BankTransactionStatus = "ignored"
+)
+
+func (s BankTransactionStatus) IsValid() bool {
+	switch s {
+	case BankTransactionStatusUnmatched,
+		BankTransactionStatusReview,
+		BankTransactionStatusMatched,
+		BankTransactionStatusIgnored:
+		return true
+	}
+	return false
+}
+
+func (s BankTransactionStatus) String() string {
+	return string(s)
+}
+
+type BankMatchType string
+
+const (
+	BankMatchTypeInvoice          BankMatchType = "invoice"
+	BankMatchTypePayroll          BankMatchType = "payroll"
+	BankMatchTypeContractorPayout BankMatchType = "contractor_payout"
+)
+
+func (t BankMatchType) IsValid() bool {
+	switch t {
+	case BankMatchTypeInvoice,
+		BankMatchTypePayroll,
+		BankMatchTypeContractorPayout:
+		return true
+	}
+	return false
+}
+
+func (t BankMatchType) String() string {
+	return string(t)
+}
+
+// BankTransaction is a line of a bank statement and the record it was reconciled with.
+// MatchID is the invoice or payroll ID, or the Notion page ID of a contractor payable.
+type BankTransaction struct {
+	BaseModel
+
+	BankStatementID UUID
+	Fingerprint     string
+	ExternalID      string
+	BookedAt        time.Time
+	Direction       BankTransactionDirection
+	Amount          float64
+	Currency        string
+	Counterparty    string
+	Memo            string
+	Reference       string
+
+	Status         BankTransactionStatus
+	MatchType      BankMatchType
+	MatchID        string
+	MatchReference string
+	MatchScore     float64
+	MatchNote      string
+	MatchedAt      *time.Time
+	MatchedBy      *UUID
+}
+
+// ComputeFingerprint identifies the transaction across statements: the bank reference when
+// the statement has one, otherwise its date, amount and description
+func (t *BankTransaction) ComputeFingerprint(accountNumber string) string {
+	key := t.ExternalID
+	if key == "" {
+		key = fmt.Sprintf("%s|%s|%.2f|%s|%s|%s",
+			t.BookedAt.Format("2006-01-02"), t.Direction, t.Amount, t.Counterparty, t.Memo, t.Reference)
+	}
+
+	sum := sha1.Sum([]byte(accountNumber + "|" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Text is everything the bank wrote about the transaction, used to find the references in it
+func (t *BankTransaction) Text() string {
+	return strings.Join([]string{t.Counterparty, t.Memo, t.Reference}, " ")
+}
+
+// ClearMatch drops the suggested match, sending the transaction back to the unmatched list
+func (t *BankTransaction) ClearMatch() {
+	t.Status = BankTransactionStatusUnmatched
+	t.MatchType = ""
+	t.MatchID = ""
+	t.MatchReference = ""
+	t.MatchScore = 0
+	t.MatchNote = ""
+	t.MatchedAt = nil
+	t.MatchedBy = nil
+}
+
+// BankStatementImportResult sums up what an import did
+type BankStatementImportResult struct {
+	Statement  *BankStatement
+	Imported   int
+	Duplicates int
+	Matched    int
+	Review     int
+	Unmatched  int
+}
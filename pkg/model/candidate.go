@@ -17,6 +17,22 @@ const (
 	RejectCandidateStatus   CandidateStatus = "reject"
 )
 
+func (s CandidateStatus) IsValid() bool {
+	switch s {
+	case ApproachCandidateStatus,
+		OfferedCandidateStatus,
+		FailedCandidateStatus,
+		HiredCandidateStatus,
+		RejectCandidateStatus:
+		return true
+	}
+	return false
+}
+
+func (s CandidateStatus) String() string {
+	return string(s)
+}
+
 type Candidate struct {
 	BaseModel
 
@@ -40,6 +56,14 @@ type Candidate struct {
 	ProbationDuration int             `json:"probation_duration"`
 	IsEmailSent       bool            `json:"is_email_sent"`
 	OnboardTodoID     int             `json:"onboard_todo_id"`
+	PositionID        *UUID           `json:"position_id"`
+	StageID           *UUID           `json:"stage_id"`
+	EmployeeID        *UUID           `json:"employee_id"`
+
+	Position    *Position                  `json:"position,omitempty"`
+	Stage       *RecruitmentStage          `json:"stage,omitempty" gorm:"foreignKey:StageID"`
+	Transitions []CandidateStageTransition `json:"transitions,omitempty"`
+	Interviews  []CandidateInterview       `json:"interviews,omitempty"`
 
 	PdfFile          []byte `json:"-" gorm:"-"`
 	GroupRole        string `json:"-" gorm:"-"`
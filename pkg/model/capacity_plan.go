@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// CapacityEmployee is an employee whose time is planned, Stacks is the comma separated names
+// of their stacks
+type CapacityEmployee struct {
+	EmployeeID    string
+	FullName      string
+	DisplayName   string
+	SeniorityName string
+	Stacks        string
+}
+
+// CapacityDeployment is an employee deployed on a client project
+type CapacityDeployment struct {
+	ProjectMemberID string
+	ProjectID       string
+	ProjectName     string
+	ProjectSlotID   string
+	EmployeeID      string
+	DeploymentType  DeploymentType
+	StartDate       *time.Time
+	EndDate         *time.Time
+}
+
+// CapacitySlot is a seat of a client project to staff until the project ends
+type CapacitySlot struct {
+	SlotID         string
+	ProjectID      string
+	ProjectName    string
+	ProjectEndDate *time.Time
+	SeniorityName  string
+	DeploymentType DeploymentType
+}
+
+// CapacityLeave is an approved day off of an employee
+type CapacityLeave struct {
+	EmployeeID string
+	StartDate  time.Time
+	EndDate    time.Time
+	Shift      string
+}
@@ -0,0 +1,219 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type CashFlowHorizon string
+
+const (
+	CashFlowHorizonWeekly  CashFlowHorizon = "weekly"
+	CashFlowHorizonMonthly CashFlowHorizon = "monthly"
+)
+
+func (h CashFlowHorizon) IsValid() bool {
+	return h == CashFlowHorizonWeekly || h == CashFlowHorizonMonthly
+}
+
+func (h CashFlowHorizon) String() string {
+	return string(h)
+}
+
+// PeriodCount is the length of the rolling forecast: 13 weeks or 12 months
+func (h CashFlowHorizon) PeriodCount() int {
+	if h == CashFlowHorizonWeekly {
+		return 13
+	}
+	return 12
+}
+
+type CashFlowCategory string
+
+const (
+	CashFlowCategoryReceivable         CashFlowCategory = "receivable"
+	CashFlowCategoryRecurringRevenue   CashFlowCategory = "recurring_revenue"
+	CashFlowCategoryPayroll            CashFlowCategory = "payroll"
+	CashFlowCategorySalaryAdvance      CashFlowCategory = "salary_advance"
+	CashFlowCategoryContractor         CashFlowCategory = "contractor"
+	CashFlowCategoryOperationalService CashFlowCategory = "operational_service"
+	CashFlowCategoryNewHire            CashFlowCategory = "new_hire"
+)
+
+func (c CashFlowCategory) String() string {
+	return string(c)
+}
+
+// IsInflow tells whether the category is money in, the others are money out
+func (c CashFlowCategory) IsInflow() bool {
+	return c == CashFlowCategoryReceivable || c == CashFlowCategoryRecurringRevenue
+}
+
+// CashFlowCategories lists the categories in the order they are reported
+var CashFlowCategories = []CashFlowCategory{
+	CashFlowCategoryReceivable,
+	CashFlowCategoryRecurringRevenue,
+	CashFlowCategoryPayroll,
+	CashFlowCategorySalaryAdvance,
+	CashFlowCategoryContractor,
+	CashFlowCategoryOperationalService,
+	CashFlowCategoryNewHire,
+}
+
+// CashFlowLine is the total of a category in a period, positive for money in
+type CashFlowLine struct {
+	Category CashFlowCategory `json:"category"`
+	Amount   float64          `json:"amount"`
+}
+
+// CashFlowPeriod is a week or a month of the forecast, amounts are in the forecast currency
+type CashFlowPeriod struct {
+	Start          time.Time      `json:"start"`
+	End            time.Time      `json:"end"`
+	Inflow         float64        `json:"inflow"`
+	Outflow        float64        `json:"outflow"`
+	Net            float64        `json:"net"`
+	OpeningBalance float64        `json:"openingBalance"`
+	ClosingBalance float64        `json:"closingBalance"`
+	Lines          []CashFlowLine `json:"lines"`
+}
+
+// CashFlowScenario is the what-if applied on top of the known receivables and payables
+type CashFlowScenario struct {
+	// ChurnClientIDs are the clients whose recurring revenue stops from ChurnDate
+	ChurnClientIDs []string   `json:"churnClientIDs,omitempty"`
+	ChurnDate      *time.Time `json:"churnDate,omitempty"`
+	// NewHires are paid NewHireMonthlyCost each month from NewHireStartDate
+	NewHires           int        `json:"newHires,omitempty"`
+	NewHireMonthlyCost float64    `json:"newHireMonthlyCost,omitempty"`
+	NewHireStartDate   *time.Time `json:"newHireStartDate,omitempty"`
+	// FXShiftPercent moves the value of every foreign currency against the forecast currency
+	FXShiftPercent float64 `json:"fxShiftPercent,omitempty"`
+	OpeningBalance float64 `json:"openingBalance,omitempty"`
+}
+
+// CashFlowReport is a computed forecast
+type CashFlowReport struct {
+	Horizon   CashFlowHorizon
+	StartDate time.Time
+	Currency  string
+	Scenario  CashFlowScenario
+	Periods   []CashFlowPeriod
+	Warnings  []string
+}
+
+// CashFlowForecast is a saved forecast, kept to be compared with the actuals once its periods are over
+type CashFlowForecast struct {
+	BaseModel
+
+	Horizon   CashFlowHorizon
+	StartDate time.Time
+	Currency  string
+	Scenario  JSON
+	Periods   JSON
+	Warnings  JSON
+	CreatedBy *UUID
+}
+
+// ToCashFlowForecast turns a report into the snapshot to store
+func (r *CashFlowReport) ToCashFlowForecast(createdBy *UUID) (*CashFlowForecast, error) {
+	scenario, err := json.Marshal(r.Scenario)
+	if err != nil {
+		return nil, err
+	}
+	periods, err := json.Marshal(r.Periods)
+	if err != nil {
+		return nil, err
+	}
+	warnings, err := json.Marshal(r.Warnings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CashFlowForecast{
+		Horizon:   r.Horizon,
+		StartDate: r.StartDate,
+		Currency:  r.Currency,
+		Scenario:  scenario,
+		Periods:   periods,
+		Warnings:  warnings,
+		CreatedBy: createdBy,
+	}, nil
+}
+
+// Report decodes a stored snapshot
+func (f *CashFlowForecast) Report() (*CashFlowReport, error) {
+	r := &CashFlowReport{
+		Horizon:   f.Horizon,
+		StartDate: f.StartDate,
+		Currency:  f.Currency,
+	}
+	if len(f.Scenario) > 0 {
+		if err := json.Unmarshal(f.Scenario, &r.Scenario); err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal(f.Periods, &r.Periods); err != nil {
+		return nil, err
+	}
+	if len(f.Warnings) > 0 {
+		if err := json.Unmarshal(f.Warnings, &r.Warnings); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// CashFlowActual is the cash booked on a day, from the accounting transactions
+type CashFlowActual struct {
+	Date    time.Time
+	Inflow  float64
+	Outflow float64
+}
+
+// CashFlowVariance compares a forecast period with what actually happened
+type CashFlowVariance struct {
+	Start           time.Time
+	End             time.Time
+	Complete        bool // the period is over, its actuals are final
+	ForecastInflow  float64
+	ActualInflow    float64
+	ForecastOutflow float64
+	ActualOutflow   float64
+	ForecastNet     float64
+	ActualNet       float64
+	NetVariance     float64
+}
+
+// CashFlowReceivable is an open invoice
+type CashFlowReceivable struct {
+	InvoiceID  string
+	Number     string
+	ProjectID  string
+	ClientID   string
+	InvoicedAt *time.Time
+	DueAt      *time.Time
+	Total      float64
+	Currency   string
+}
+
+// CashFlowRecurringRevenue is the monthly billing of an active project
+type CashFlowRecurringRevenue struct {
+	ProjectID         string
+	ProjectName       string
+	ClientID          string
+	Currency          string
+	MonthlyAmount     float64
+	LastInvoicedYear  int
+	LastInvoicedMonth int
+}
+
+// CashFlowSalary is the monthly base salary of an employee, paid on the day of its batch
+type CashFlowSalary struct {
+	EmployeeID string
+	FullName   string
+	Batch      int
+	Amount     float64
+	Currency   string
+	LeftDate   *time.Time
+}
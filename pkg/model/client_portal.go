@@ -0,0 +1,108 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ClientPortalAudience is the audience of the tokens of the client portal, an employee token is
+// never accepted there and the other way around
+const ClientPortalAudience = "client-portal"
+
+// ClientPortalAccessContextKey is the gin context key of the access of the logged in client
+const ClientPortalAccessContextKey = "client_portal_access"
+
+// ClientPortalInvoiceStatuses are the statuses of the invoices a client can see, drafts and the
+// ones not sent yet stay internal
+var ClientPortalInvoiceStatuses = []InvoiceStatus{
+	InvoiceStatusSent,
+	InvoiceStatusOverdue,
+	InvoiceStatusPaid,
+	InvoiceStatusUncollectible,
+	InvoiceStatusCredited,
+}
+
+// IsClientPortalVisible tells whether a client can see an invoice in this status
+func (i InvoiceStatus) IsClientPortalVisible() bool {
+	for _, s := range ClientPortalInvoiceStatuses {
+		if s == i {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientPortalAccess lets a contact of a client log in to the client portal with one of their
+// emails. The key is only shown once when the access is granted, it stops working once revoked.
+type ClientPortalAccess struct {
+	BaseModel
+
+	ClientID        UUID
+	ClientContactID UUID
+	Email           string
+	HashedKey       string
+	CreatedBy       *UUID
+	LastLoginAt     *time.Time
+	RevokedAt       *time.Time
+	RevokedBy       *UUID
+
+	Client        *Client        `gorm:"foreignKey:client_id"`
+	ClientContact *ClientContact `gorm:"foreignKey:client_contact_id"`
+}
+
+// IsRevoked tells whether the access was revoked
+func (a ClientPortalAccess) IsRevoked() bool {
+	return a.RevokedAt != nil
+}
+
+// ClientPortalClaims are the claims of a client portal token
+type ClientPortalClaims struct {
+	jwt.StandardClaims
+
+	AccessID  string `json:"accessId"`
+	ContactID string `json:"contactId"`
+	ClientID  string `json:"clientId"`
+}
+
+// ClientPortalTeamMember is a member of a project of a client as shown on the client portal, it
+// carries no rate nor salary
+type ClientPortalTeamMember struct {
+	ProjectID      UUID
+	ProjectName    string
+	EmployeeID     UUID
+	FullName       string
+	DisplayName    string
+	Avatar         string
+	TeamEmail      string
+	SeniorityName  string
+	Positions      string
+	DeploymentType DeploymentType
+	StartDate      *time.Time
+	IsLead         bool
+}
+
+// EmailList returns the emails of the contact, stored either as a list or as a ClientEmail
+func (c ClientContact) EmailList() []string {
+	var emails []string
+	if err := json.Unmarshal(c.Emails, &emails); err != nil {
+		var ce ClientEmail
+		if err := json.Unmarshal(c.Emails, &ce); err != nil {
+			return nil
+		}
+		emails = ce.Emails
+	}
+	return emails
+}
+
+// HasEmail tells whether the email is one of the contact, regardless of its case
+func (c ClientContact) HasEmail(email string) bool {
+	for _, e := range c.EmailList() {
+		if strings.EqualFold(strings.TrimSpace(e), strings.TrimSpace(email)) {
+			return true
+		}
+	}
+	return false
+}
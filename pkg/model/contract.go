@@ -0,0 +1,143 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ContractType tells whether a contract is the master agreement with a client or a statement of
+// work of one of its projects
+type ContractType string
+
+const (
+	ContractTypeMSA ContractType = "msa"
+	ContractTypeSOW ContractType = "sow"
+)
+
+func (t ContractType) IsValid() bool {
+	switch t {
+	case ContractTypeMSA, ContractTypeSOW:
+		return true
+	}
+	return false
+}
+
+func (t ContractType) String() string {
+	return string(t)
+}
+
+type ContractStatus string
+
+const (
+	ContractStatusDraft      ContractStatus = "draft"
+	ContractStatusActive     ContractStatus = "active"
+	ContractStatusTerminated ContractStatus = "terminated"
+)
+
+func (s ContractStatus) IsValid() bool {
+	switch s {
+	case ContractStatusDraft, ContractStatusActive, ContractStatusTerminated:
+		return true
+	}
+	return false
+}
+
+func (s ContractStatus) String() string {
+	return string(s)
+}
+
+// ContractRateUnit is what a rate of a rate card is charged per
+type ContractRateUnit string
+
+const (
+	ContractRateUnitHour  ContractRateUnit = "hour"
+	ContractRateUnitMonth ContractRateUnit = "month"
+)
+
+func (u ContractRateUnit) IsValid() bool {
+	switch u {
+	case ContractRateUnitHour, ContractRateUnitMonth:
+		return true
+	}
+	return false
+}
+
+func (u ContractRateUnit) String() string {
+	return string(u)
+}
+
+// ContractPaymentTerms are the net days a client can be given to pay an invoice
+var ContractPaymentTerms = []int{15, 30, 45, 60}
+
+// IsValidContractPaymentTerm tells whether the days are one of the payment terms
+func IsValidContractPaymentTerm(days int) bool {
+	for _, d := range ContractPaymentTerms {
+		if d == days {
+			return true
+		}
+	}
+	return false
+}
+
+// ContractInvoiceStatuses are the statuses of the invoices counted toward the value cap of a contract
+var ContractInvoiceStatuses = []InvoiceStatus{
+	InvoiceStatusSent,
+	InvoiceStatusOverdue,
+	InvoiceStatusPaid,
+	InvoiceStatusScheduled,
+}
+
+// Contract is a signed agreement with a client. A contract without project covers all the
+// projects of the client, an open ended one has no end date.
+type Contract struct {
+	BaseModel
+
+	ClientID         UUID
+	ProjectID        *UUID
+	Code             string
+	Name             string
+	Type             ContractType
+	Status           ContractStatus
+	StartDate        time.Time
+	EndDate          *time.Time
+	SignedAt         *time.Time
+	CurrencyID       *UUID
+	PaymentTermDays  int
+	ValueCap         decimal.NullDecimal
+	Note             string
+	ExpiryNotifiedAt *time.Time
+	CreatedBy        *UUID
+
+	Client      *Client              `gorm:"foreignKey:ClientID"`
+	Project     *Project             `gorm:"foreignKey:ProjectID"`
+	Currency    *Currency            `gorm:"foreignKey:CurrencyID"`
+	Rates       []ContractRate       `gorm:"foreignKey:ContractID"`
+	Attachments []ContractAttachment `gorm:"foreignKey:ContractID"`
+}
+
+// ContractRate is a line of the rate card of a contract, a rate without seniority or position
+// applies to all of them
+type ContractRate struct {
+	BaseModel
+
+	ContractID  UUID
+	SeniorityID *UUID
+	PositionID  *UUID
+	Rate        decimal.Decimal
+	Unit        ContractRateUnit
+
+	Seniority *Seniority `gorm:"foreignKey:SeniorityID"`
+	Position  *Position  `gorm:"foreignKey:PositionID"`
+}
+
+// ContractAttachment is a signed copy or an annex of a contract kept in Google Drive
+type ContractAttachment struct {
+	BaseModel
+
+	ContractID  UUID
+	Name        string
+	ContentType string
+	URL         string
+	UploadedBy  *UUID
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LeaderBoardScope is the employees a leaderboard ranks
+type LeaderBoardScope string
+
+const (
+	LeaderBoardScopeCompany LeaderBoardScope = "company"
+	LeaderBoardScopeChapter LeaderBoardScope = "chapter" // the members of a chapter, on every project
+	LeaderBoardScopeProject LeaderBoardScope = "project" // the metrics of a project only
+)
+
+func (s LeaderBoardScope) IsValid() bool {
+	switch s {
+	case LeaderBoardScopeCompany,
+		LeaderBoardScopeChapter,
+		LeaderBoardScopeProject:
+		return true
+	}
+	return false
+}
+
+func (s LeaderBoardScope) String() string {
+	return string(s)
+}
+
+// LeaderBoardPeriod is the span of metrics a leaderboard is computed on
+type LeaderBoardPeriod string
+
+const (
+	LeaderBoardPeriodWeekly    LeaderBoardPeriod = "weekly"
+	LeaderBoardPeriodMonthly   LeaderBoardPeriod = "monthly"
+	LeaderBoardPeriodQuarterly LeaderBoardPeriod = "quarterly"
+)
+
+func (p LeaderBoardPeriod) IsValid() bool {
+	switch p {
+	case LeaderBoardPeriodWeekly,
+		LeaderBoardPeriodMonthly,
+		LeaderBoardPeriodQuarterly:
+		return true
+	}
+	return false
+}
+
+func (p LeaderBoardPeriod) String() string {
+	return string(p)
+}
+
+// Range returns the start of the period the date falls in and the start of the next one. The
+// metrics are dated on their week, so a weekly period is the week of the date.
+func (p LeaderBoardPeriod) Range(date time.Time) (from, to time.Time) {
+	y, m, d := date.Date()
+	switch p {
+	case LeaderBoardPeriodMonthly:
+		from = time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(0, 1, 0)
+	case LeaderBoardPeriodQuarterly:
+		from = time.Date(y, m-(m-1)%3, 1, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(0, 3, 0)
+	default:
+		from = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return from, from.AddDate(0, 0, 7)
+	}
+}
+
+// DeliveryLeaderBoard is the definition of a delivery metrics leaderboard: the formula scoring the
+// employees, who is ranked and on which period. An active board with a Discord channel is posted
+// by the leaderboards cronjob.
+type DeliveryLeaderBoard struct {
+	BaseModel
+
+	Name             string
+	Formula          string
+	Scope            LeaderBoardScope
+	ScopeID          *UUID
+	Period           LeaderBoardPeriod
+	ExcludeLeads     bool
+	MinEffort        decimal.Decimal
+	Size             int
+	DiscordChannelID string
+	IsActive         bool
+}
+
+// DeliveryMetricSum is the metrics of an employee summed over a period
+type DeliveryMetricSum struct {
+	EmployeeID    UUID
+	Weight        decimal.Decimal
+	Effort        decimal.Decimal
+	Effectiveness decimal.Decimal
+}
@@ -16,6 +16,11 @@ type DeliveryMetric struct {
 	ProjectID     UUID
 	Date          *time.Time
 	Ref           int
+	Source        DeliveryMetricSource
+	// SourceKey identifies the row the metric is imported from, empty for the metrics synced
+	// before the imports were idempotent
+	SourceKey string
+	ImportID  *UUID
 }
 
 type TopWeightMetric struct {
@@ -27,8 +32,11 @@ type TopWeightMetric struct {
 type DeliveryMetrics []DeliveryMetric
 
 type LeaderBoard struct {
-	Date  *time.Time
-	Items []LeaderBoardItem
+	Name    string
+	Formula string
+	Period  LeaderBoardPeriod
+	Date    *time.Time
+	Items   []LeaderBoardItem
 }
 
 type MonthlyLeaderBoard struct {
@@ -39,7 +47,9 @@ type MonthlyLeaderBoard struct {
 type LeaderBoardItem struct {
 	EmployeeID      string
 	EmployeeName    string
-	Points          decimal.Decimal
+	Points          decimal.Decimal // the score of the leaderboard formula
+	Weight          decimal.Decimal
+	Effort          decimal.Decimal
 	Effectiveness   decimal.Decimal
 	DiscordID       string
 	DiscordUsername string
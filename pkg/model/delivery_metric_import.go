@@ -0,0 +1,126 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// DeliveryMetricSource is where the delivery metrics are imported from
+type DeliveryMetricSource string
+
+const (
+	DeliveryMetricSourceSheet DeliveryMetricSource = "sheet"
+	DeliveryMetricSourceCSV   DeliveryMetricSource = "csv"
+	DeliveryMetricSourceXLSX  DeliveryMetricSource = "xlsx"
+)
+
+func (s DeliveryMetricSource) IsValid() bool {
+	switch s {
+	case DeliveryMetricSourceSheet,
+		DeliveryMetricSourceCSV,
+		DeliveryMetricSourceXLSX:
+		return true
+	}
+	return false
+}
+
+func (s DeliveryMetricSource) String() string {
+	return string(s)
+}
+
+// DeliveryMetricSourceFromFileName guesses the source of an uploaded file from its extension
+func DeliveryMetricSourceFromFileName(name string) DeliveryMetricSource {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return DeliveryMetricSourceCSV
+	case ".xlsx":
+		return DeliveryMetricSourceXLSX
+	}
+	return ""
+}
+
+// DeliveryMetricImport is a run of the delivery metrics ingestion with its validation report.
+// A dry run validates the rows without storing them. An upload is the whole dataset it is
+// imported as, the metrics of its rows no longer uploaded are deleted.
+type DeliveryMetricImport struct {
+	BaseModel
+
+	Source        DeliveryMetricSource
+	FileName      string
+	Dataset       string
+	DryRun        bool
+	TotalRows     int
+	CreatedRows   int
+	UpdatedRows   int
+	UnchangedRows int
+	RejectedRows  int
+	DeletedRows   int
+	Rejections    DeliveryMetricRejections `gorm:"type:jsonb"`
+	ImportedBy    *UUID
+}
+
+// DeliveryMetricRejection is a row left out of an import, with the values read from it and
+// every reason it was rejected for
+type DeliveryMetricRejection struct {
+	Line    int      `json:"line"`
+	RowID   string   `json:"rowId,omitempty"`
+	Person  string   `json:"person"`
+	Email   string   `json:"email"`
+	Project string   `json:"project"`
+	Date    string   `json:"date"`
+	Reasons []string `json:"reasons"`
+}
+
+type DeliveryMetricRejections []DeliveryMetricRejection
+
+func (j DeliveryMetricRejections) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+func (j *DeliveryMetricRejections) Scan(value interface{}) error {
+	if value == nil {
+		*j = nil
+		return nil
+	}
+	switch t := value.(type) {
+	case []uint8:
+		jsonData := value.([]uint8)
+		if string(jsonData) == "null" {
+			return nil
+		}
+		return json.Unmarshal(jsonData, j)
+	default:
+		return fmt.Errorf("could not scan type %T into json", t)
+	}
+}
+
+// DeliveryMetricMappingKind is what a mapping of the delivery metrics resolves to
+type DeliveryMetricMappingKind string
+
+const (
+	DeliveryMetricMappingKindEmployee DeliveryMetricMappingKind = "employee"
+	DeliveryMetricMappingKindProject  DeliveryMetricMappingKind = "project"
+)
+
+func (k DeliveryMetricMappingKind) IsValid() bool {
+	return k == DeliveryMetricMappingKindEmployee || k == DeliveryMetricMappingKindProject
+}
+
+func (k DeliveryMetricMappingKind) String() string {
+	return string(k)
+}
+
+// DeliveryMetricMapping is a manual override of the fuzzy mapping: the rows whose email, person or
+// project reads as the value are mapped to the target employee or project. The value is stored
+// normalized, so the mapping of "internal" also maps "Internal ".
+type DeliveryMetricMapping struct {
+	BaseModel
+
+	Kind      DeliveryMetricMappingKind
+	Value     string
+	TargetID  UUID
+	CreatedBy *UUID
+}
@@ -0,0 +1,140 @@
+package model
+
+import "time"
+
+// EngagementScope is what a weekly engagement stat is aggregated over
+type EngagementScope string
+
+const (
+	EngagementScopeOrganization EngagementScope = "organization"
+	EngagementScopeChapter      EngagementScope = "chapter"
+)
+
+func (s EngagementScope) IsValid() bool {
+	switch s {
+	case EngagementScopeOrganization, EngagementScopeChapter:
+		return true
+	}
+	return false
+}
+
+func (s EngagementScope) String() string {
+	return string(s)
+}
+
+// EngagementChannelStatus is the health of a channel over the recent weeks
+type EngagementChannelStatus string
+
+const (
+	EngagementChannelStatusHealthy      EngagementChannelStatus = "healthy"
+	EngagementChannelStatusConcentrated EngagementChannelStatus = "concentrated"
+	EngagementChannelStatusDeclining    EngagementChannelStatus = "declining"
+	EngagementChannelStatusDormant      EngagementChannelStatus = "dormant"
+)
+
+func (s EngagementChannelStatus) String() string {
+	return string(s)
+}
+
+// EngagementActivity is the messages and reactions of a Discord user in a channel the engagement
+// rollup gained over a day. The first aggregation records the rollup as is as a baseline, which
+// is left out of the analytics since its activity has no date.
+type EngagementActivity struct {
+	BaseModel
+
+	Date          time.Time
+	DiscordUserID int64
+	ChannelID     int64
+	CategoryID    int64
+	MessageCount  int
+	ReactionCount int
+	IsBaseline    bool
+}
+
+// EngagementWeeklyStat is the participation of the employees of the organization or of a
+// chapter over a week, members are the employees with a Discord account
+type EngagementWeeklyStat struct {
+	BaseModel
+
+	WeekStart     time.Time
+	Scope         EngagementScope
+	ChapterID     *UUID
+	Members       int
+	ActiveMembers int
+	MessageCount  int
+	ReactionCount int
+
+	Chapter *Chapter `gorm:"foreignKey:ChapterID"`
+}
+
+// ParticipationRate returns the percent of the members who were active over the week
+func (s *EngagementWeeklyStat) ParticipationRate() float64 {
+	if s.Members == 0 {
+		return 0
+	}
+	return float64(s.ActiveMembers) * 100 / float64(s.Members)
+}
+
+// EngagementChannelStat is the activity of a channel over a week, the top member count is the
+// messages of its most active member
+type EngagementChannelStat struct {
+	BaseModel
+
+	WeekStart      time.Time
+	ChannelID      int64
+	CategoryID     int64
+	ChannelName    string
+	ActiveMembers  int
+	MessageCount   int
+	ReactionCount  int
+	TopMemberCount int
+}
+
+// EngagementMemberStat is the latest activity of an employee on Discord as of the last
+// aggregation, the recent counts are over the last four weeks
+type EngagementMemberStat struct {
+	BaseModel
+
+	EmployeeID      UUID
+	DiscordUserID   int64
+	LastActiveAt    *time.Time
+	RecentMessages  int
+	RecentReactions int
+	ComputedOn      time.Time
+
+	Employee *Employee `gorm:"foreignKey:EmployeeID"`
+}
+
+// EngagementTrend is a weekly stat with its change from the week before, changes are empty
+// without a week before or from nothing
+type EngagementTrend struct {
+	EngagementWeeklyStat
+
+	ParticipationRate   float64
+	MessageChange       *float64
+	ActiveMembersChange *float64
+}
+
+// EngagementChannelHealth is the activity of a channel over the recent weeks against the weeks
+// before them
+type EngagementChannelHealth struct {
+	ChannelID        int64
+	CategoryID       int64
+	ChannelName      string
+	Status           EngagementChannelStatus
+	MessageCount     int
+	PreviousMessages int
+	MessageChange    *float64
+	ActiveMembers    int // the most active members of a week of the recent weeks
+	TopMemberShare   float64
+	LastActiveWeek   *time.Time
+}
+
+// EngagementAggregation is the result of an engagement aggregation
+type EngagementAggregation struct {
+	Date       time.Time
+	IsBaseline bool
+	Activities int
+	WeekStarts []time.Time
+	Members    int
+}
@@ -12,6 +12,7 @@ type EventStatus string
 const (
 	EventTypeFeedback EventType = "feedback"
 	EventTypeSurvey   EventType = "survey"
+	EventTypeReview   EventType = "review"
 )
 
 const (
@@ -37,7 +38,8 @@ func (e EventType) IsValid() bool {
 	switch e {
 	case
 		EventTypeFeedback,
-		EventTypeSurvey:
+		EventTypeSurvey,
+		EventTypeReview:
 		return true
 	}
 	return false
@@ -63,6 +65,9 @@ const (
 	EventSubtypeWork         EventSubtype = "work"
 	EventSubtypeAppreciation EventSubtype = "appreciation"
 	EventSubtypeComment      EventSubtype = "comment"
+	// the forms of the review cycles
+	EventSubtypeSelfReview    EventSubtype = "self-review"
+	EventSubtypeManagerReview EventSubtype = "manager-review"
 )
 
 // IsValid validation for EventSubtype
@@ -73,7 +78,9 @@ func (e EventSubtype) IsValid() bool {
 		EventSubtypeEngagement,
 		EventSubtypeWork,
 		EventSubtypeAppreciation,
-		EventSubtypeComment:
+		EventSubtypeComment,
+		EventSubtypeSelfReview,
+		EventSubtypeManagerReview:
 		return true
 	}
 	return false
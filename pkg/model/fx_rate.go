@@ -0,0 +1,125 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+type FxRateSource string
+
+const (
+	FxRateSourceWise          FxRateSource = "wise"
+	FxRateSourceCurrencyLayer FxRateSource = "currencylayer"
+	FxRateSourceManual        FxRateSource = "manual"
+)
+
+func (s FxRateSource) IsValid() bool {
+	switch s {
+	case FxRateSourceWise,
+		FxRateSourceCurrencyLayer,
+		FxRateSourceManual:
+		return true
+	}
+	return false
+}
+
+func (s FxRateSource) String() string {
+	return string(s)
+}
+
+// FxRate is the daily snapshot of the rate between two currencies from one source
+type FxRate struct {
+	BaseModel
+
+	Date           time.Time
+	SourceCurrency string
+	TargetCurrency string
+	Rate           float64
+	Source         FxRateSource
+	Note           string
+	CreatedBy      *UUID
+}
+
+type FxTransactionType string
+
+const (
+	FxTransactionTypeInvoice                FxTransactionType = "invoice"
+	FxTransactionTypeAccountingTransaction  FxTransactionType = "accounting-transaction"
+	FxTransactionTypeInboundFundTransaction FxTransactionType = "inbound-fund-transaction"
+)
+
+func (t FxTransactionType) IsValid() bool {
+	switch t {
+	case FxTransactionTypeInvoice,
+		FxTransactionTypeAccountingTransaction,
+		FxTransactionTypeInboundFundTransaction:
+		return true
+	}
+	return false
+}
+
+// FxTransactionRate compares the rate applied on a transaction with the historical rate of its date
+type FxTransactionRate struct {
+	Type             FxTransactionType
+	ID               string
+	Date             time.Time
+	SourceCurrency   string
+	TargetCurrency   string
+	AppliedRate      float64
+	HistoricalRate   *FxRate
+	DeviationPercent float64
+}
+
+// FxBackfillResult sums up a backfill run of the rate history
+type FxBackfillResult struct {
+	Inserted int
+	Skipped  int
+	Failed   []string
+}
+
+// ToFxRateSources parses a list of source names, dropping the unknown ones
+func ToFxRateSources(sources []string) []FxRateSource {
+	rs := make([]FxRateSource, 0, len(sources))
+	for _, s := range sources {
+		src := FxRateSource(strings.ToLower(strings.TrimSpace(s)))
+		if src.IsValid() {
+			rs = append(rs, src)
+		}
+	}
+	return rs
+}
+
+// SelectFxRate picks the rate of the most recent date in rates, using the
+// source priority to break ties between sources of that date.
+// Sources not listed in priority are ignored.
+func SelectFxRate(rates []FxRate, priority []FxRateSource) *FxRate {
+	rank := make(map[FxRateSource]int, len(priority))
+	for i, s := range priority {
+		if _, ok := rank[s]; !ok {
+			rank[s] = i
+		}
+	}
+
+	var selected *FxRate
+	for i := range rates {
+		r := &rates[i]
+		if r.Rate <= 0 {
+			continue
+		}
+		rk, ok := rank[r.Source]
+		if !ok {
+			continue
+		}
+		if selected == nil {
+			selected = r
+			continue
+		}
+
+		rDate, sDate := r.Date.Truncate(24*time.Hour), selected.Date.Truncate(24*time.Hour)
+		if rDate.After(sDate) || (rDate.Equal(sDate) && rk < rank[selected.Source]) {
+			selected = r
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SelectFxRate(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+	}
+	priority := []FxRateSource{FxRateSourceWise, FxRateSourceCurrencyLayer, FxRateSourceManual}
+
+	tests := []struct {
+		name     string
+		rates    []FxRate
+		priority []FxRateSource
+		want     *FxRate
+	}{
+		{
+			name:     "empty",
+			rates:    nil,
+			priority: priority,
+			want:     nil,
+		},
+		{
+			name: "latest date wins over priority",
+			rates: []FxRate{
+				{Date: day(10), Source: FxRateSourceWise, Rate: 25000},
+				{Date: day(11), Source: FxRateSourceManual, Rate: 25100},
+			},
+			priority: priority,
+			want:     &FxRate{Date: day(11), Source: FxRateSourceManual, Rate: 25100},
+		},
+		{
+			name: "priority breaks ties on the same date",
+			rates: []FxRate{
+				{Date: day(11), Source: FxRateSourceManual, Rate: 25100},
+				{Date: day(11), Source: FxRateSourceCurrencyLayer, Rate: 25050},
+				{Date: day(11), Source: FxRateSourceWise, Rate: 25000},
+			},
+			priority: priority,
+			want:     &FxRate{Date: day(11), Source: FxRateSourceWise, Rate: 25000},
+		},
+		{
+			name: "custom priority",
+			rates: []FxRate{
+				{Date: day(11), Source: FxRateSourceWise, Rate: 25000},
+				{Date: day(11), Source: FxRateSourceManual, Rate: 25100},
+			},
+			priority: []FxRateSource{FxRateSourceManual, FxRateSourceWise},
+			want:     &FxRate{Date: day(11), Source: FxRateSourceManual, Rate: 25100},
+		},
+		{
+			name: "ignore sources not in priority and invalid rates",
+			rates: []FxRate{
+				{Date: day(12), Source: FxRateSourceManual, Rate: 25100},
+				{Date: day(12), Source: FxRateSourceWise, Rate: 0},
+				{Date: day(10), Source: FxRateSourceCurrencyLayer, Rate: 25050},
+			},
+			priority: []FxRateSource{FxRateSourceWise, FxRateSourceCurrencyLayer},
+			want:     &FxRate{Date: day(10), Source: FxRateSourceCurrencyLayer, Rate: 25050},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SelectFxRate(tt.rates, tt.priority))
+		})
+	}
+}
+
+func Test_ToFxRateSources(t *testing.T) {
+	assert.Equal(t,
+		[]FxRateSource{FxRateSourceWise, FxRateSourceManual},
+		ToFxRateSources([]string{" Wise", "unknown", "MANUAL "}),
+	)
+}
@@ -0,0 +1,174 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// IcyRewardTrigger is the event a reward rule pays for
+type IcyRewardTrigger string
+
+const (
+	IcyRewardTriggerOfficeCheckIn       IcyRewardTrigger = "office-check-in"
+	IcyRewardTriggerMemoPublished       IcyRewardTrigger = "memo-published"
+	IcyRewardTriggerOGIFTalk            IcyRewardTrigger = "ogif-talk"
+	IcyRewardTriggerBraineryLog         IcyRewardTrigger = "brainery-log"
+	IcyRewardTriggerDeliveryLeaderboard IcyRewardTrigger = "delivery-leaderboard"
+)
+
+func (t IcyRewardTrigger) IsValid() bool {
+	switch t {
+	case IcyRewardTriggerOfficeCheckIn,
+		IcyRewardTriggerMemoPublished,
+		IcyRewardTriggerOGIFTalk,
+		IcyRewardTriggerBraineryLog,
+		IcyRewardTriggerDeliveryLeaderboard:
+		return true
+	}
+	return false
+}
+
+func (t IcyRewardTrigger) String() string {
+	return string(t)
+}
+
+// IcyRewardPeriod is the period the cap of a rule counts the payouts of an employee over
+type IcyRewardPeriod string
+
+const (
+	IcyRewardPeriodDay   IcyRewardPeriod = "day"
+	IcyRewardPeriodWeek  IcyRewardPeriod = "week"
+	IcyRewardPeriodMonth IcyRewardPeriod = "month"
+)
+
+func (p IcyRewardPeriod) IsValid() bool {
+	switch p {
+	case IcyRewardPeriodDay, IcyRewardPeriodWeek, IcyRewardPeriodMonth:
+		return true
+	}
+	return false
+}
+
+func (p IcyRewardPeriod) String() string {
+	return string(p)
+}
+
+// IcyTxnCategories are the categories of the ICY transactions
+var IcyTxnCategories = []string{"learning", "community", "delivery", "tooling"}
+
+// IsValidIcyTxnCategory tells whether the category is one of the ICY transaction categories
+func IsValidIcyTxnCategory(category string) bool {
+	for _, c := range IcyTxnCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+type IcyRewardPayoutStatus string
+
+const (
+	IcyRewardPayoutStatusPendingReview IcyRewardPayoutStatus = "pending-review"
+	IcyRewardPayoutStatusProcessing    IcyRewardPayoutStatus = "processing"
+	IcyRewardPayoutStatusPaid          IcyRewardPayoutStatus = "paid"
+	IcyRewardPayoutStatusRejected      IcyRewardPayoutStatus = "rejected"
+	IcyRewardPayoutStatusSkipped       IcyRewardPayoutStatus = "skipped"
+	IcyRewardPayoutStatusFailed        IcyRewardPayoutStatus = "failed"
+)
+
+func (s IcyRewardPayoutStatus) IsValid() bool {
+	switch s {
+	case IcyRewardPayoutStatusPendingReview,
+		IcyRewardPayoutStatusProcessing,
+		IcyRewardPayoutStatusPaid,
+		IcyRewardPayoutStatusRejected,
+		IcyRewardPayoutStatusSkipped,
+		IcyRewardPayoutStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (s IcyRewardPayoutStatus) String() string {
+	return string(s)
+}
+
+// IcyRewardCommittedStatuses are the statuses of the payouts counted toward the caps and the
+// budgets, the ones in review or being sent included so that they cannot be overspent
+var IcyRewardCommittedStatuses = []IcyRewardPayoutStatus{
+	IcyRewardPayoutStatusPendingReview,
+	IcyRewardPayoutStatusProcessing,
+	IcyRewardPayoutStatusPaid,
+}
+
+// IcyRewardPayableStatuses are the statuses of the payouts a reviewer can send
+var IcyRewardPayableStatuses = []IcyRewardPayoutStatus{
+	IcyRewardPayoutStatusPendingReview,
+	IcyRewardPayoutStatusFailed,
+}
+
+// IcyRewardRule pays a fixed ICY amount for an event, at most CapPerPeriod times per employee
+// over the cap period and within its monthly budget. A cap of 0 and an empty budget are unlimited.
+// The payouts from the review threshold wait for a review.
+type IcyRewardRule struct {
+	BaseModel
+
+	Trigger         IcyRewardTrigger
+	Name            string
+	Category        string
+	Amount          decimal.Decimal
+	CapPerPeriod    int
+	CapPeriod       IcyRewardPeriod
+	MonthlyBudget   decimal.NullDecimal
+	ReviewThreshold decimal.NullDecimal // the configured threshold when empty
+	IsActive        bool
+}
+
+// IcyRewardPayout is a reward of a rule for an event, once per idempotency key
+type IcyRewardPayout struct {
+	BaseModel
+
+	RuleID           UUID
+	EmployeeID       UUID
+	IdempotencyKey   string
+	Reference        string
+	Amount           decimal.Decimal
+	Status           IcyRewardPayoutStatus
+	Reason           string
+	OccurredAt       time.Time
+	ReviewedBy       *UUID
+	ReviewedAt       *time.Time
+	MochiTxID        int64
+	IcyTransactionID *UUID
+
+	Rule     *IcyRewardRule `gorm:"foreignKey:RuleID"`
+	Employee *Employee      `gorm:"foreignKey:EmployeeID"`
+	Reviewer *Employee      `gorm:"foreignKey:ReviewedBy"`
+}
+
+// IcyRewardRuleSpend is what a rule paid and holds in review over a month
+type IcyRewardRuleSpend struct {
+	RuleID    string
+	Paid      decimal.Decimal
+	InReview  decimal.Decimal
+	Payouts   int64
+	Skipped   int64
+	Employees int64
+}
+
+// IcyRewardBudgetReport is the spend of the rules over a month against their budgets
+type IcyRewardBudgetReport struct {
+	Year          int
+	Month         int
+	MonthlyBudget decimal.NullDecimal
+	Paid          decimal.Decimal
+	InReview      decimal.Decimal
+	Rules         []IcyRewardRuleBudget
+}
+
+type IcyRewardRuleBudget struct {
+	Rule IcyRewardRule
+	IcyRewardRuleSpend
+}
@@ -15,4 +15,5 @@ type IcyTransaction struct {
 	DestEmployeeID UUID
 	Sender         string
 	Target         string
+	RewardRuleID   *UUID // the reward rule that paid it
 }
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// IcyTreasurySnapshot is the state of the ICY treasury at the end of a day, the ICY amounts are in
+// ICY and the USDC ones in USDC.
+//
+// The Mochi custody addresses hold on-chain the ICY of the Mochi wallets, so their balance moves by
+// the deposits less the withdrawals of the Mochi ledger. Drift is how much it moved otherwise since
+// the previous snapshot.
+type IcyTreasurySnapshot struct {
+	BaseModel
+
+	SnapshotDate      time.Time
+	TakenAt           time.Time
+	TotalSupply       decimal.Decimal
+	OnchainLocked     decimal.Decimal
+	OffchainLocked    decimal.Decimal
+	Circulating       decimal.Decimal
+	UsdcFund          decimal.Decimal
+	ConversionRate    decimal.Decimal     // USDC paid for one ICY by icyswap
+	OffsetUsdc        decimal.Decimal     // USDC missing to redeem the circulating ICY
+	CustodyBalance    decimal.NullDecimal // empty without custody addresses configured
+	LedgerDeposits    decimal.Decimal
+	LedgerWithdrawals decimal.Decimal
+	LedgerTxCount     int
+	Drift             decimal.Decimal
+	CumulativeDrift   decimal.Decimal
+	DriftFlagged      bool
+	Note              string
+
+	VaultBalances []IcyTreasuryVaultBalance `gorm:"foreignKey:SnapshotID"`
+}
+
+// IcyTreasuryVaultBalance is the ICY a Mochi vault or application profile held off-chain at a snapshot
+type IcyTreasuryVaultBalance struct {
+	BaseModel
+
+	SnapshotID  UUID
+	ProfileID   string
+	ProfileName string
+	ProfileType string
+	Amount      decimal.Decimal
+}
+
+// LedgerNet is the ICY the Mochi ledger moved into custody since the previous snapshot
+func (s *IcyTreasurySnapshot) LedgerNet() decimal.Decimal {
+	return s.LedgerDeposits.Sub(s.LedgerWithdrawals)
+}
+
+// IcyTreasuryReconciliation is the drift of the snapshots over a range of days
+type IcyTreasuryReconciliation struct {
+	From        time.Time
+	To          time.Time
+	Snapshots   []*IcyTreasurySnapshot
+	Drift       decimal.Decimal // over the range
+	FlaggedDays int
+}
@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+// KnowledgeSource is where a knowledge document is indexed from
+type KnowledgeSource string
+
+const (
+	KnowledgeSourceMemo     KnowledgeSource = "memo"
+	KnowledgeSourceBrainery KnowledgeSource = "brainery"
+	KnowledgeSourceOGIF     KnowledgeSource = "ogif"
+)
+
+func (s KnowledgeSource) IsValid() bool {
+	switch s {
+	case KnowledgeSourceMemo, KnowledgeSourceBrainery, KnowledgeSourceOGIF:
+		return true
+	}
+	return false
+}
+
+func (s KnowledgeSource) String() string {
+	return string(s)
+}
+
+// KnowledgeDocument is a memo, brainery log or OGIF summary indexed for the full-text search, the
+// search vector of its title, tags and content is generated by Postgres. The authors are the
+// lowercased handles the authors go by: GitHub, Discord and memo usernames.
+type KnowledgeDocument struct {
+	BaseModel
+
+	Source      KnowledgeSource
+	SourceID    string
+	Title       string
+	URL         string
+	Content     string
+	Authors     JSONArrayString `gorm:"type:jsonb"`
+	Tags        JSONArrayString `gorm:"type:jsonb"`
+	PublishedAt *time.Time
+}
+
+// KnowledgeSearchResult is a document matching a search, with its rank and the fragments of its
+// content matching the search highlighted
+type KnowledgeSearchResult struct {
+	KnowledgeDocument
+
+	Rank    float64
+	Snippet string
+}
+
+// KnowledgeIndexResult is what a reindex of a source indexes and removes
+type KnowledgeIndexResult struct {
+	Source  KnowledgeSource
+	Indexed int
+	Removed int
+}
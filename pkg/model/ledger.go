@@ -0,0 +1,286 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+type LedgerAccountType string
+
+const (
+	LedgerAccountTypeAsset     LedgerAccountType = "asset"
+	LedgerAccountTypeLiability LedgerAccountType = "liability"
+	LedgerAccountTypeEquity    LedgerAccountType = "equity"
+	LedgerAccountTypeIncome    LedgerAccountType = "income"
+	LedgerAccountTypeExpense   LedgerAccountType = "expense"
+)
+
+func (t LedgerAccountType) IsValid() bool {
+	switch t {
+	case LedgerAccountTypeAsset,
+		LedgerAccountTypeLiability,
+		LedgerAccountTypeEquity,
+		LedgerAccountTypeIncome,
+		LedgerAccountTypeExpense:
+		return true
+	}
+	return false
+}
+
+func (t LedgerAccountType) String() string {
+	return string(t)
+}
+
+// IsDebitNormal tells whether the account grows on the debit side
+func (t LedgerAccountType) IsDebitNormal() bool {
+	return t == LedgerAccountTypeAsset || t == LedgerAccountTypeExpense
+}
+
+// Chart of accounts codes used by the automatic postings
+const (
+	LedgerAccountCash                    = "1000"
+	LedgerAccountReceivable              = "1100"
+	LedgerAccountIcyTreasury             = "1200"
+	LedgerAccountFixedAssets             = "1500"
+	LedgerAccountAccumulatedDepreciation = "1590"
+	LedgerAccountPayable                 = "2000"
+	LedgerAccountInboundFund             = "2100"
+	LedgerAccountEquity                  = "3000"
+	LedgerAccountServiceRevenue          = "4000"
+	LedgerAccountOtherIncome             = "4900"
+	LedgerAccountPayrollExpense          = "5000"
+	LedgerAccountCommissionExpense       = "5100"
+	LedgerAccountIcyRewardExpense        = "5200"
+	LedgerAccountOfficeSupply            = "5300"
+	LedgerAccountOfficeServices          = "5400"
+	LedgerAccountOfficeSpace             = "5500"
+	LedgerAccountTools                   = "5600"
+	LedgerAccountDepreciation            = "5700"
+	LedgerAccountOtherOperatingCost      = "5900"
+)
+
+// LedgerAccount is an account of the chart of accounts
+type LedgerAccount struct {
+	BaseModel
+
+	Code        string
+	Name        string
+	Type        LedgerAccountType
+	Description string
+	IsActive    bool
+}
+
+type JournalSource string
+
+const (
+	JournalSourceManual       JournalSource = "manual"
+	JournalSourceReversal     JournalSource = "reversal"
+	JournalSourcePayroll      JournalSource = "payroll"
+	JournalSourceInvoice      JournalSource = "invoice"
+	JournalSourceExpense      JournalSource = "expense"
+	JournalSourceOperation    JournalSource = "operation"
+	JournalSourceIcy          JournalSource = "icy"
+	JournalSourceInboundFund  JournalSource = "inbound_fund"
+	JournalSourceDepreciation JournalSource = "depreciation"
+)
+
+func (s JournalSource) IsValid() bool {
+	switch s {
+	case JournalSourceManual,
+		JournalSourceReversal,
+		JournalSourcePayroll,
+		JournalSourceInvoice,
+		JournalSourceExpense,
+		JournalSourceOperation,
+		JournalSourceIcy,
+		JournalSourceInboundFund,
+		JournalSourceDepreciation:
+		return true
+	}
+	return false
+}
+
+func (s JournalSource) String() string {
+	return string(s)
+}
+
+var (
+	ErrJournalEntryTooFewLines   = errors.New("journal entry needs at least two lines")
+	ErrJournalEntryUnbalanced    = errors.New("journal entry debits and credits are not balanced")
+	ErrJournalLineInvalidAmount  = errors.New("journal line must have either a debit or a credit greater than zero")
+	ErrJournalLineMissingAccount = errors.New("journal line has no account")
+)
+
+// JournalEntry is a balanced set of debit and credit lines, amounts are in VND
+type JournalEntry struct {
+	BaseModel
+
+	Date                    time.Time
+	Description             string
+	SourceType              JournalSource
+	SourceID                *string
+	AccountingTransactionID *UUID
+	ReversalOfID            *UUID
+	CreatedBy               *UUID
+
+	Lines []JournalLine `gorm:"foreignKey:JournalEntryID"`
+}
+
+// JournalLine is one side of a journal entry
+type JournalLine struct {
+	BaseModel
+
+	JournalEntryID UUID
+	AccountID      UUID
+	Debit          int64
+	Credit         int64
+	Currency       string
+	OriginalAmount float64
+	Memo           string
+
+	// AccountCode lets the postings reference an account before its ID is resolved
+	AccountCode string         `gorm:"-"`
+	Account     *LedgerAccount `gorm:"foreignKey:AccountID"`
+}
+
+// Totals returns the sum of the debits and the credits of the entry
+func (e *JournalEntry) Totals() (debit, credit int64) {
+	for _, l := range e.Lines {
+		debit += l.Debit
+		credit += l.Credit
+	}
+	return debit, credit
+}
+
+// Validate checks the entry is balanced and every line is one-sided
+func (e *JournalEntry) Validate() error {
+	if len(e.Lines) < 2 {
+		return ErrJournalEntryTooFewLines
+	}
+
+	for _, l := range e.Lines {
+		if l.AccountID.IsZero() && l.AccountCode == "" {
+			return ErrJournalLineMissingAccount
+		}
+		if l.Debit < 0 || l.Credit < 0 || (l.Debit == 0) == (l.Credit == 0) {
+			return ErrJournalLineInvalidAmount
+		}
+	}
+
+	if debit, credit := e.Totals(); debit != credit {
+		return ErrJournalEntryUnbalanced
+	}
+
+	return nil
+}
+
+// Reverse returns an entry cancelling e, with every line on the opposite side
+func (e *JournalEntry) Reverse(date time.Time, description string) *JournalEntry {
+	id := e.ID.String()
+	reversal := &JournalEntry{
+		Date:         date,
+		Description:  description,
+		SourceType:   JournalSourceReversal,
+		SourceID:     &id,
+		ReversalOfID: &e.ID,
+		Lines:        make([]JournalLine, 0, len(e.Lines)),
+	}
+
+	for _, l := range e.Lines {
+		reversal.Lines = append(reversal.Lines, JournalLine{
+			AccountID:      l.AccountID,
+			AccountCode:    l.AccountCode,
+			Debit:          l.Credit,
+			Credit:         l.Debit,
+			Currency:       l.Currency,
+			OriginalAmount: l.OriginalAmount,
+			Memo:           l.Memo,
+		})
+	}
+
+	return reversal
+}
+
+// LedgerAccountBalance is the sum of the lines posted on an account
+type LedgerAccountBalance struct {
+	AccountID UUID
+	Code      string
+	Name      string
+	Type      LedgerAccountType
+	Debit     int64
+	Credit    int64
+}
+
+// Balance is the balance of the account on its normal side
+func (b LedgerAccountBalance) Balance() int64 {
+	if b.Type.IsDebitNormal() {
+		return b.Debit - b.Credit
+	}
+	return b.Credit - b.Debit
+}
+
+type TrialBalance struct {
+	AsOf        time.Time
+	Accounts    []LedgerAccountBalance
+	TotalDebit  int64
+	TotalCredit int64
+}
+
+// IsBalanced tells whether the books reconcile
+func (t *TrialBalance) IsBalanced() bool {
+	return t.TotalDebit == t.TotalCredit
+}
+
+// NewTrialBalance sums up the account balances, netting each account on one side
+func NewTrialBalance(asOf time.Time, balances []LedgerAccountBalance) *TrialBalance {
+	tb := &TrialBalance{AsOf: asOf, Accounts: make([]LedgerAccountBalance, 0, len(balances))}
+	for _, b := range balances {
+		net := b.Debit - b.Credit
+		row := b
+		row.Debit, row.Credit = 0, 0
+		if net > 0 {
+			row.Debit = net
+		} else {
+			row.Credit = -net
+		}
+
+		tb.TotalDebit += row.Debit
+		tb.TotalCredit += row.Credit
+		tb.Accounts = append(tb.Accounts, row)
+	}
+	return tb
+}
+
+type ProfitAndLoss struct {
+	From         time.Time
+	To           time.Time
+	Income       []LedgerAccountBalance
+	Expenses     []LedgerAccountBalance
+	TotalIncome  int64
+	TotalExpense int64
+	NetIncome    int64
+}
+
+// NewProfitAndLoss builds the income statement from the balances of a period
+func NewProfitAndLoss(from, to time.Time, balances []LedgerAccountBalance) *ProfitAndLoss {
+	pl := &ProfitAndLoss{
+		From:     from,
+		To:       to,
+		Income:   make([]LedgerAccountBalance, 0),
+		Expenses: make([]LedgerAccountBalance, 0),
+	}
+
+	for _, b := range balances {
+		switch b.Type {
+		case LedgerAccountTypeIncome:
+			pl.Income = append(pl.Income, b)
+			pl.TotalIncome += b.Balance()
+		case LedgerAccountTypeExpense:
+			pl.Expenses = append(pl.Expenses, b)
+			pl.TotalExpense += b.Balance()
+		}
+	}
+	pl.NetIncome = pl.TotalIncome - pl.TotalExpense
+
+	return pl
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournalEntry_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []JournalLine
+		want  error
+	}{
+		{
+			name: "balanced",
+			lines: []JournalLine{
+				{AccountCode: LedgerAccountCash, Debit: 1000},
+				{AccountCode: LedgerAccountServiceRevenue, Credit: 600},
+				{AccountCode: LedgerAccountOtherIncome, Credit: 400},
+			},
+			want: nil,
+		},
+		{
+			name:  "single line",
+			lines: []JournalLine{{AccountCode: LedgerAccountCash, Debit: 1000}},
+			want:  ErrJournalEntryTooFewLines,
+		},
+		{
+			name: "unbalanced",
+			lines: []JournalLine{
+				{AccountCode: LedgerAccountCash, Debit: 1000},
+				{AccountCode: LedgerAccountServiceRevenue, Credit: 900},
+			},
+			want: ErrJournalEntryUnbalanced,
+		},
+		{
+			name: "line on both sides",
+			lines: []JournalLine{
+				{AccountCode: LedgerAccountCash, Debit: 1000, Credit: 1000},
+				{AccountCode: LedgerAccountServiceRevenue},
+			},
+			want: ErrJournalLineInvalidAmount,
+		},
+		{
+			name: "negative amount",
+			lines: []JournalLine{
+				{AccountCode: LedgerAccountCash, Debit: -1000},
+				{AccountCode: LedgerAccountServiceRevenue, Credit: -1000},
+			},
+			want: ErrJournalLineInvalidAmount,
+		},
+		{
+			name: "missing account",
+			lines: []JournalLine{
+				{Debit: 1000},
+				{AccountCode: LedgerAccountServiceRevenue, Credit: 1000},
+			},
+			want: ErrJournalLineMissingAccount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &JournalEntry{Lines: tt.lines}
+			assert.Equal(t, tt.want, e.Validate())
+		})
+	}
+}
+
+func TestJournalEntry_Reverse(t *testing.T) {
+	id := NewUUID()
+	e := &JournalEntry{
+		BaseModel:  BaseModel{ID: id},
+		SourceType: JournalSourceInvoice,
+		Lines: []JournalLine{
+			{AccountCode: LedgerAccountCash, Debit: 1000, Memo: "in"},
+			{AccountCode: LedgerAccountServiceRevenue, Credit: 1000, Memo: "in"},
+		},
+	}
+
+	date := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	r := e.Reverse(date, "reversal")
+
+	assert.Equal(t, JournalSourceReversal, r.SourceType)
+	assert.Equal(t, id.String(), *r.SourceID)
+	assert.Equal(t, id, *r.ReversalOfID)
+	assert.Equal(t, date, r.Date)
+	assert.NoError(t, r.Validate())
+	assert.Equal(t, int64(1000), r.Lines[0].Credit)
+	assert.Equal(t, int64(1000), r.Lines[1].Debit)
+}
+
+func TestNewTrialBalance(t *testing.T) {
+	asOf := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	tb := NewTrialBalance(asOf, []LedgerAccountBalance{
+		{Code: LedgerAccountCash, Type: LedgerAccountTypeAsset, Debit: 5000, Credit: 2000},
+		{Code: LedgerAccountServiceRevenue, Type: LedgerAccountTypeIncome, Credit: 5000},
+		{Code: LedgerAccountPayrollExpense, Type: LedgerAccountTypeExpense, Debit: 2000},
+	})
+
+	assert.True(t, tb.IsBalanced())
+	assert.Equal(t, int64(5000), tb.TotalDebit)
+	assert.Equal(t, int64(3000), tb.Accounts[0].Debit)
+	assert.Equal(t, int64(0), tb.Accounts[0].Credit)
+	assert.Equal(t, int64(5000), tb.Accounts[1].Credit)
+}
+
+func TestNewProfitAndLoss(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+	pl := NewProfitAndLoss(from, to, []LedgerAccountBalance{
+		{Code: LedgerAccountCash, Type: LedgerAccountTypeAsset, Debit: 5000, Credit: 2500},
+		{Code: LedgerAccountServiceRevenue, Type: LedgerAccountTypeIncome, Credit: 5000},
+		{Code: LedgerAccountPayrollExpense, Type: LedgerAccountTypeExpense, Debit: 2000},
+		{Code: LedgerAccountTools, Type: LedgerAccountTypeExpense, Debit: 600, Credit: 100},
+	})
+
+	assert.Len(t, pl.Income, 1)
+	assert.Len(t, pl.Expenses, 2)
+	assert.Equal(t, int64(5000), pl.TotalIncome)
+	assert.Equal(t, int64(2500), pl.TotalExpense)
+	assert.Equal(t, int64(2500), pl.NetIncome)
+}
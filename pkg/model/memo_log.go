@@ -7,6 +7,18 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// MemoLogSource is where a memo log is ingested from
+type MemoLogSource string
+
+const (
+	MemoLogSourceRSS MemoLogSource = "rss"
+	MemoLogSourceGit MemoLogSource = "git"
+)
+
+func (s MemoLogSource) String() string {
+	return string(s)
+}
+
 type MemoLog struct {
 	BaseModel
 
@@ -20,6 +32,16 @@ type MemoLog struct {
 
 	DiscordAccountIDs JSONArrayString `json:"discord_account_ids" gorm:"type:jsonb;column:discord_account_ids"`
 
+	// The memos ingested from the memo repository keep the file they are read from, and the GitHub
+	// handles of the front matter the authors are resolved from
+	Source            MemoLogSource `gorm:"default:rss"`
+	FilePath          string        `gorm:"default:null"`
+	ContentHash       string
+	IsDraft           bool
+	AuthorHandles     JSONArrayString `gorm:"type:jsonb"`
+	UnresolvedAuthors JSONArrayString `gorm:"type:jsonb"` // the handles without a GitHub social account
+	EditedAt          *time.Time
+
 	// This field is used to make sure response always contains authors
 	AuthorMemoUsernames []string `json:"-" gorm:"-"`
 }
@@ -33,3 +55,20 @@ type DiscordAccountMemoRank struct {
 }
 
 // Remove BeforeCreate method as we no longer use many-to-many join table
+
+// MemoIngestion is a run of the memo ingestion from the memo repository, it diffs the repository
+// from the commit of the previous run
+type MemoIngestion struct {
+	BaseModel
+
+	CommitSHA         string
+	PreviousCommitSHA string `gorm:"default:null"`
+	IsFullScan        bool
+	Created           int
+	Updated           int
+	Renamed           int
+	Deleted           int
+	Drafts            int
+	Skipped           int             // the files that fail to parse
+	UnresolvedAuthors JSONArrayString `gorm:"type:jsonb"`
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// OfficeLocation is an office employees check in at by scanning its rotating QR code. With a
+// geofence radius, in meters, the check-ins must also be sent from around its position.
+type OfficeLocation struct {
+	BaseModel
+
+	Name              string
+	Address           string
+	Latitude          *float64
+	Longitude         *float64
+	GeofenceRadius    int
+	QRSecret          string
+	QRRotationSeconds int
+	IsActive          bool
+}
+
+// HasGeofence tells whether the check-ins at the office must be sent from around it
+func (o *OfficeLocation) HasGeofence() bool {
+	return o.GeofenceRadius > 0 && o.Latitude != nil && o.Longitude != nil
+}
+
+// OfficeCheckin is the day of an employee at an office, an employee checks in once a day. The
+// distances are in meters from the office, empty without a position sent.
+type OfficeCheckin struct {
+	BaseModel
+
+	EmployeeID        UUID
+	OfficeLocationID  UUID
+	Date              time.Time
+	CheckedInAt       time.Time
+	CheckInLatitude   *float64
+	CheckInLongitude  *float64
+	CheckInDistance   *float64
+	CheckedOutAt      *time.Time
+	CheckOutLatitude  *float64
+	CheckOutLongitude *float64
+	CheckOutDistance  *float64
+
+	Employee       *Employee       `gorm:"foreignKey:EmployeeID"`
+	OfficeLocation *OfficeLocation `gorm:"foreignKey:OfficeLocationID"`
+
+	// Reward is the payout of the check-in by the reward engine, only set on check-in
+	Reward *IcyRewardPayout `gorm:"-"`
+}
+
+// OfficeAttendance is the attendance of an employee at the offices over a month
+type OfficeAttendance struct {
+	EmployeeID string
+	FullName   string
+	Username   string
+	Days       int64
+	CheckedOut int64
+	Hours      float64
+	Perk       decimal.Decimal
+}
+
+// OfficeAttendanceReport is the attendance of the employees over a month, with the perks it
+// earns them at the daily perk of the config
+type OfficeAttendanceReport struct {
+	Year         int
+	Month        int
+	PerkPerDay   decimal.Decimal
+	PerkCurrency string
+	Days         int64
+	Perk         decimal.Decimal
+	Employees    []OfficeAttendance
+}
@@ -2,16 +2,132 @@ package model
 
 import "time"
 
+// OperationalServiceBillingCycle tells how often a service is billed
+type OperationalServiceBillingCycle string
+
+const (
+	OperationalServiceBillingCycleMonthly   OperationalServiceBillingCycle = "monthly"
+	OperationalServiceBillingCycleQuarterly OperationalServiceBillingCycle = "quarterly"
+	OperationalServiceBillingCycleYearly    OperationalServiceBillingCycle = "yearly"
+)
+
+func (c OperationalServiceBillingCycle) IsValid() bool {
+	switch c {
+	case OperationalServiceBillingCycleMonthly,
+		OperationalServiceBillingCycleQuarterly,
+		OperationalServiceBillingCycleYearly:
+		return true
+	}
+	return false
+}
+
+func (c OperationalServiceBillingCycle) String() string {
+	return string(c)
+}
+
+// Months returns the number of months a bill covers
+func (c OperationalServiceBillingCycle) Months() int {
+	switch c {
+	case OperationalServiceBillingCycleQuarterly:
+		return 3
+	case OperationalServiceBillingCycleYearly:
+		return 12
+	}
+	return 1
+}
+
 type OperationalService struct {
 	BaseModel
 
+	Name                string
+	Vendor              string
+	Plan                string
+	BillingCycle        OperationalServiceBillingCycle `gorm:"column:type"`
+	Amount              int
+	SeatCount           int
+	Note                string
+	RegisterDate        time.Time
+	StartAt             time.Time
+	EndAt               time.Time
+	RenewalDate         *time.Time
+	ReminderDays        int
+	RemindedRenewalDate *time.Time
+	IsActive            bool
+	CurrencyID          UUID
+	OwnerID             *UUID
+	OrganizationID      *UUID
+	CostCenter          string
+
+	Currency     *Currency                `gorm:"foreignKey:CurrencyID;references:ID"`
+	Owner        *Employee                `gorm:"foreignKey:OwnerID;references:ID"`
+	Organization *Organization            `gorm:"foreignKey:OrganizationID;references:ID"`
+	Seats        []OperationalServiceSeat `gorm:"foreignKey:OperationalServiceID"`
+}
+
+// CurrencyName returns the currency the service is billed in
+func (s *OperationalService) CurrencyName() string {
+	if s.Currency == nil || s.Currency.Name == "" {
+		return "VND"
+	}
+	return s.Currency.Name
+}
+
+// OperationalServiceSeat is a seat of a service held by an employee
+type OperationalServiceSeat struct {
+	BaseModel
+
+	OperationalServiceID UUID
+	EmployeeID           UUID
+	AssignedAt           time.Time
+	ReleasedAt           *time.Time
+	Note                 string
+
+	Employee *Employee `gorm:"foreignKey:EmployeeID;references:ID"`
+}
+
+// OperationalServiceSeatReconciliation compares the seats paid for a service with the seats needed,
+// the costs are monthly and in the currency of the service
+type OperationalServiceSeatReconciliation struct {
+	Service   *OperationalService
+	Purchased int
+	Needed    int
+	// Tracked tells the needed seats are the active holders rather than the headcount
+	Tracked      bool
+	Excess       int
+	Shortage     int
+	Leavers      []*Employee // employees who left still holding a seat
+	SeatCost     float64
+	MonthlyWaste float64 // cost of the excess seats
+}
+
+// OperationalServiceSpend is the cost of a service over a month, in VND
+type OperationalServiceSpend struct {
+	ServiceID    string
 	Name         string
-	Amount       int
-	Note         string
-	RegisterDate time.Time
-	StartAt      time.Time
-	EndAt        time.Time
-	IsActive     bool
-	CurrencyID   UUID
-	Currency     *Currency `gorm:"foreignKey:CurrencyID;references:ID"`
+	Vendor       string
+	Plan         string
+	CostCenter   string
+	BillingCycle OperationalServiceBillingCycle
+	Currency     string
+	Amount       float64 // the bill in the currency of the service
+	Charged      float64 // billed in the month
+	Monthly      float64 // the bill spread over the months it covers
+}
+
+type OperationalServiceOrganizationSpend struct {
+	OrganizationID   string
+	OrganizationName string
+	Charged          float64
+	Monthly          float64
+	Services         []OperationalServiceSpend
+}
+
+type OperationalServiceSpendReport struct {
+	Year          int
+	Month         int
+	Currency      string
+	Charged       float64
+	Monthly       float64
+	Organizations []OperationalServiceOrganizationSpend
+	Warnings      []string
 }
@@ -119,6 +119,50 @@ const (
 	PermissionDeliveryMetricsLeaderBoardRead      PermissionCode = "deliveryMetrics.leaderBoard.read"
 	PermissionDeliveryMetricsSync                 PermissionCode = "deliveryMetrics.sync"
 	PermissionTransferCheckinIcy                  PermissionCode = "employees.transferCheckinIcy.fullAccess"
+	PermissionFxRatesRead                         PermissionCode = "fxRates.read"
+	PermissionFxRatesEdit                         PermissionCode = "fxRates.edit"
+	PermissionLedgerRead                          PermissionCode = "ledger.read"
+	PermissionLedgerEdit                          PermissionCode = "ledger.edit"
+	PermissionBankReconciliationRead              PermissionCode = "bankReconciliation.read"
+	PermissionBankReconciliationEdit              PermissionCode = "bankReconciliation.edit"
+	PermissionCashFlowRead                        PermissionCode = "cashFlow.read"
+	PermissionCashFlowEdit                        PermissionCode = "cashFlow.edit"
+	PermissionProjectsProfitabilityRead           PermissionCode = "projects.profitability.read"
+	PermissionRecruitmentRead                     PermissionCode = "recruitment.read"
+	PermissionRecruitmentEdit                     PermissionCode = "recruitment.edit"
+	PermissionReferralsRead                       PermissionCode = "referrals.read"
+	PermissionReferralsEdit                       PermissionCode = "referrals.edit"
+	PermissionAssetsRead                          PermissionCode = "assets.read"
+	PermissionAssetsEdit                          PermissionCode = "assets.edit"
+	PermissionOperationalServicesRead             PermissionCode = "operationalServices.read"
+	PermissionOperationalServicesEdit             PermissionCode = "operationalServices.edit"
+	PermissionProjectsStaffingRead                PermissionCode = "projects.staffing.read"
+	PermissionTimesheetsRead                      PermissionCode = "timesheets.read"
+	PermissionTimesheetsCreate                    PermissionCode = "timesheets.create"
+	PermissionTimesheetsApprove                   PermissionCode = "timesheets.approve"
+	PermissionTimesheetsFullAccess                PermissionCode = "timesheets.fullAccess"
+	PermissionClientPortalRead                    PermissionCode = "clientPortal.read"
+	PermissionClientPortalEdit                    PermissionCode = "clientPortal.edit"
+	PermissionContractsRead                       PermissionCode = "contracts.read"
+	PermissionContractsEdit                       PermissionCode = "contracts.edit"
+	PermissionProjectsBudgetsRead                 PermissionCode = "projects.budgets.read"
+	PermissionProjectsBudgetsEdit                 PermissionCode = "projects.budgets.edit"
+	PermissionIcyRewardsRead                      PermissionCode = "icyRewards.read"
+	PermissionIcyRewardsEdit                      PermissionCode = "icyRewards.edit"
+	PermissionIcyRewardsTrigger                   PermissionCode = "icyRewards.trigger"
+	PermissionIcyTreasuryRead                     PermissionCode = "icyTreasury.read"
+	PermissionOfficeLocationsRead                 PermissionCode = "officeLocations.read"
+	PermissionOfficeLocationsEdit                 PermissionCode = "officeLocations.edit"
+	PermissionOfficeLocationsQRCode               PermissionCode = "officeLocations.qrCode"
+	PermissionOfficeCheckinsCreate                PermissionCode = "officeCheckins.create"
+	PermissionOfficeCheckinsRead                  PermissionCode = "officeCheckins.read"
+	PermissionEngagementAnalyticsRead             PermissionCode = "engagementAnalytics.read"
+	PermissionEngagementAnalyticsQuietMembersRead PermissionCode = "engagementAnalytics.quietMembers.read"
+	PermissionKnowledgeBaseRead                   PermissionCode = "knowledgeBase.read"
+	PermissionKnowledgeBaseEdit                   PermissionCode = "knowledgeBase.edit"
+	PermissionReviewCyclesRead                    PermissionCode = "reviewCycles.read"
+	PermissionReviewCyclesEdit                    PermissionCode = "reviewCycles.edit"
+	PermissionReviewCyclesApprove                 PermissionCode = "reviewCycles.approve"
 )
 
 func (p PermissionCode) String() string {
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+type ProjectBudgetStatus string
+
+const (
+	ProjectBudgetStatusActive ProjectBudgetStatus = "active"
+	ProjectBudgetStatusClosed ProjectBudgetStatus = "closed"
+)
+
+func (s ProjectBudgetStatus) IsValid() bool {
+	switch s {
+	case ProjectBudgetStatusActive, ProjectBudgetStatusClosed:
+		return true
+	}
+	return false
+}
+
+func (s ProjectBudgetStatus) String() string {
+	return string(s)
+}
+
+// ProjectBudgetInvoiceStatuses are the statuses of the issued and paid invoices drawing a budget down
+var ProjectBudgetInvoiceStatuses = []InvoiceStatus{
+	InvoiceStatusSent,
+	InvoiceStatusOverdue,
+	InvoiceStatusPaid,
+}
+
+// ProjectBudget is the purchase order of a project, drawn down by the invoices of the months it
+// covers. AlertedThreshold is the highest burn percent alerted on so far.
+type ProjectBudget struct {
+	BaseModel
+
+	ProjectID        UUID
+	PONumber         string `gorm:"column:po_number"`
+	Name             string
+	TotalValue       decimal.Decimal
+	CurrencyID       UUID
+	StartDate        time.Time
+	EndDate          *time.Time
+	Status           ProjectBudgetStatus
+	AlertThresholds  pq.Int64Array `gorm:"type:integer[]"` // the configured thresholds when empty
+	AlertedThreshold int64
+	Note             string
+	CreatedBy        *UUID
+
+	Project    *Project                 `gorm:"foreignKey:ProjectID"`
+	Currency   *Currency                `gorm:"foreignKey:CurrencyID"`
+	Milestones []ProjectBudgetMilestone `gorm:"foreignKey:ProjectBudgetID"`
+}
+
+// ProjectBudgetMilestone is a planned payment of a budget, linked to its invoice once billed
+type ProjectBudgetMilestone struct {
+	BaseModel
+
+	ProjectBudgetID UUID
+	Name            string
+	Amount          decimal.Decimal
+	DueDate         *time.Time
+	InvoiceID       *UUID
+
+	Invoice *Invoice `gorm:"foreignKey:InvoiceID"`
+}
+
+// ProjectBudgetInvoice is an invoice drawing a budget down
+type ProjectBudgetInvoice struct {
+	ID         string
+	Number     string
+	Year       int
+	Month      int
+	Status     InvoiceStatus
+	Total      float64
+	Currency   string
+	InvoicedAt *time.Time
+	PaidAt     *time.Time
+}
+
+// ProjectBudgetMonth is a month of the burn down of a budget, in its currency
+type ProjectBudgetMonth struct {
+	Month     time.Time
+	Drawn     float64
+	Burned    float64
+	Remaining float64
+	Projected bool
+}
+
+// ProjectBudgetReport is how much of a budget the invoices drew and when it runs out at the
+// current team cost, the amounts are in the currency of the budget
+type ProjectBudgetReport struct {
+	Budget           *ProjectBudget
+	Currency         string
+	Total            float64
+	Issued           float64
+	Paid             float64
+	Remaining        float64
+	BurnPercent      float64
+	MonthlyTeamCost  float64
+	MonthsLeft       float64
+	ExhaustedAt      *time.Time
+	ProjectedAtEnd   float64
+	ProjectedOverrun float64
+	Months           []ProjectBudgetMonth
+	Invoices         []ProjectBudgetInvoice
+	Warnings         []string
+}
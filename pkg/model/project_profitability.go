@@ -0,0 +1,99 @@
+package model
+
+import "time"
+
+type ProfitabilityTrendDirection string
+
+const (
+	ProfitabilityTrendUp   ProfitabilityTrendDirection = "up"
+	ProfitabilityTrendDown ProfitabilityTrendDirection = "down"
+	ProfitabilityTrendFlat ProfitabilityTrendDirection = "flat"
+)
+
+func (d ProfitabilityTrendDirection) String() string {
+	return string(d)
+}
+
+// ProjectProfitabilityMonth is the margin of a project over a month, amounts are in VND
+type ProjectProfitabilityMonth struct {
+	Month              time.Time // first day of the month
+	Revenue            float64
+	SalaryCost         float64
+	ContractorCost     float64
+	CommissionCost     float64
+	TotalCost          float64
+	GrossMargin        float64
+	GrossMarginPercent float64
+	// AllocatedFTE is the headcount charged to the project, BillableFTE leaves the shadow members out
+	AllocatedFTE float64
+	BillableFTE  float64
+	// EffectiveBillingRate is the revenue per billable FTE
+	EffectiveBillingRate float64
+}
+
+// ProjectProfitabilityTrend tells how the margin of a project moved over the months of the report
+type ProjectProfitabilityTrend struct {
+	Direction ProfitabilityTrendDirection
+	// MarginSlope is the change of the gross margin percent per month, fitted on the months with revenue
+	MarginSlope float64
+	// MarginChange is the gross margin percent of the last month with revenue minus the one of the first
+	MarginChange float64
+}
+
+type ProjectProfitability struct {
+	ProjectID   string
+	ProjectName string
+	Months      []ProjectProfitabilityMonth
+	Total       ProjectProfitabilityMonth
+	Trend       ProjectProfitabilityTrend
+}
+
+type ProjectProfitabilityReport struct {
+	From     time.Time
+	To       time.Time
+	Currency string
+	Projects []ProjectProfitability
+	Warnings []string
+}
+
+// ProfitabilityRevenue is an issued or paid invoice of a project
+type ProfitabilityRevenue struct {
+	ProjectID        string
+	ProjectName      string
+	Year             int
+	Month            int
+	InvoicedAt       *time.Time
+	Total            float64
+	ConversionAmount float64 // in VND, empty until the invoice is paid
+	Currency         string
+}
+
+// ProfitabilityMember is an employee deployed on a project
+type ProfitabilityMember struct {
+	ProjectID       string
+	ProjectName     string
+	EmployeeID      string
+	FullName        string
+	WorkingStatus   WorkingStatus
+	DiscordUsername string
+	DeploymentType  DeploymentType
+	StartDate       *time.Time
+	EndDate         *time.Time
+}
+
+// ProfitabilitySalary is the salary cost of an employee on a payroll, in VND
+type ProfitabilitySalary struct {
+	EmployeeID string
+	Year       int
+	Month      int
+	Amount     float64
+}
+
+// ProfitabilityCommission is the commission paid on the invoices of a project for a month, in VND
+type ProfitabilityCommission struct {
+	ProjectID   string
+	ProjectName string
+	Year        int
+	Month       int
+	Amount      float64
+}
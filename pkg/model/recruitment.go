@@ -0,0 +1,82 @@
+package model
+
+import "time"
+
+// RecruitmentStage is a step of the hiring pipeline of a position. The stages without
+// position make the default pipeline, used by the positions without their own stages.
+type RecruitmentStage struct {
+	BaseModel
+
+	PositionID      *UUID           `json:"positionID"`
+	Code            string          `json:"code"`
+	Name            string          `json:"name"`
+	SortOrder       int             `json:"sortOrder"`
+	CandidateStatus CandidateStatus `json:"candidateStatus"`
+	EmailTemplate   string          `json:"emailTemplate"`
+	IsTerminal      bool            `json:"isTerminal"`
+}
+
+// CandidateStageTransition is the audit trail of a candidate moving through the pipeline
+type CandidateStageTransition struct {
+	BaseModel
+
+	CandidateID   UUID       `json:"candidateID"`
+	FromStageID   *UUID      `json:"fromStageID"`
+	ToStageID     UUID       `json:"toStageID"`
+	Note          string     `json:"note"`
+	ActorID       *UUID      `json:"actorID"`
+	EmailTemplate string     `json:"emailTemplate"`
+	EmailSentAt   *time.Time `json:"emailSentAt"`
+	EmailError    string     `json:"emailError"`
+
+	FromStage *RecruitmentStage `json:"fromStage,omitempty" gorm:"foreignKey:FromStageID"`
+	ToStage   *RecruitmentStage `json:"toStage,omitempty" gorm:"foreignKey:ToStageID"`
+	Actor     *Employee         `json:"actor,omitempty" gorm:"foreignKey:ActorID"`
+}
+
+type InterviewRecommendation string
+
+const (
+	InterviewRecommendationStrongYes InterviewRecommendation = "strong_yes"
+	InterviewRecommendationYes       InterviewRecommendation = "yes"
+	InterviewRecommendationNo        InterviewRecommendation = "no"
+	InterviewRecommendationStrongNo  InterviewRecommendation = "strong_no"
+)
+
+func (r InterviewRecommendation) IsValid() bool {
+	switch r {
+	case InterviewRecommendationStrongYes,
+		InterviewRecommendationYes,
+		InterviewRecommendationNo,
+		InterviewRecommendationStrongNo:
+		return true
+	}
+	return false
+}
+
+func (r InterviewRecommendation) String() string {
+	return string(r)
+}
+
+// CandidateInterview is an interviewer assigned to a candidate, the scorecard is filled
+// once the interview is done
+type CandidateInterview struct {
+	BaseModel
+
+	CandidateID    UUID                    `json:"candidateID"`
+	StageID        *UUID                   `json:"stageID"`
+	InterviewerID  UUID                    `json:"interviewerID"`
+	ScheduledAt    *time.Time              `json:"scheduledAt"`
+	Scores         JSON                    `json:"scores"`
+	OverallScore   *float64                `json:"overallScore"`
+	Recommendation InterviewRecommendation `json:"recommendation"`
+	Feedback       string                  `json:"feedback"`
+	SubmittedAt    *time.Time              `json:"submittedAt"`
+
+	Interviewer *Employee `json:"interviewer,omitempty" gorm:"foreignKey:InterviewerID"`
+}
+
+// IsSubmitted tells whether the interviewer has filled the scorecard
+func (i CandidateInterview) IsSubmitted() bool {
+	return i.SubmittedAt != nil
+}
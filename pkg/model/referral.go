@@ -0,0 +1,127 @@
+package model
+
+import "time"
+
+type ReferralStatus string
+
+const (
+	ReferralStatusReferred        ReferralStatus = "referred"
+	ReferralStatusOffered         ReferralStatus = "offered"
+	ReferralStatusHired           ReferralStatus = "hired"
+	ReferralStatusProbationPassed ReferralStatus = "probation-passed"
+	ReferralStatusCompleted       ReferralStatus = "completed" // every milestone is reached
+	ReferralStatusFailed          ReferralStatus = "failed"    // the candidate was not hired
+	ReferralStatusLeft            ReferralStatus = "left"      // the hire left before the last milestone
+)
+
+func (s ReferralStatus) IsValid() bool {
+	switch s {
+	case ReferralStatusReferred,
+		ReferralStatusOffered,
+		ReferralStatusHired,
+		ReferralStatusProbationPassed,
+		ReferralStatusCompleted,
+		ReferralStatusFailed,
+		ReferralStatusLeft:
+		return true
+	}
+	return false
+}
+
+func (s ReferralStatus) String() string {
+	return string(s)
+}
+
+// IsClosed tells whether the referral can not reach another milestone
+func (s ReferralStatus) IsClosed() bool {
+	return s == ReferralStatusCompleted || s == ReferralStatusFailed || s == ReferralStatusLeft
+}
+
+// Referral follows a candidate referred by an employee from the application to the end
+// of the tenure the referral bonuses are paid for
+type Referral struct {
+	BaseModel
+
+	ReferrerID        UUID           `json:"referrerID"`
+	CandidateID       *UUID          `json:"candidateID"`
+	EmployeeID        *UUID          `json:"employeeID"`
+	CandidateName     string         `json:"candidateName"`
+	CandidateEmail    string         `json:"candidateEmail"`
+	Role              string         `json:"role"`
+	Status            ReferralStatus `json:"status"`
+	HiredAt           *time.Time     `json:"hiredAt"`
+	ProbationPassedAt *time.Time     `json:"probationPassedAt"`
+	LeftAt            *time.Time     `json:"leftAt"`
+
+	Referrer  *Employee       `json:"referrer,omitempty" gorm:"foreignKey:ReferrerID"`
+	Candidate *Candidate      `json:"candidate,omitempty" gorm:"foreignKey:CandidateID"`
+	Employee  *Employee       `json:"employee,omitempty" gorm:"foreignKey:EmployeeID"`
+	Bonuses   []ReferralBonus `json:"bonuses,omitempty"`
+}
+
+type ReferralMilestoneTrigger string
+
+const (
+	// ReferralMilestoneTriggerHired is reached on the join date of the hire
+	ReferralMilestoneTriggerHired ReferralMilestoneTrigger = "hired"
+	// ReferralMilestoneTriggerProbation is reached once the hire is full-time
+	ReferralMilestoneTriggerProbation ReferralMilestoneTrigger = "probation"
+	// ReferralMilestoneTriggerTenure is reached TenureMonths after the join date
+	ReferralMilestoneTriggerTenure ReferralMilestoneTrigger = "tenure"
+)
+
+func (t ReferralMilestoneTrigger) IsValid() bool {
+	switch t {
+	case ReferralMilestoneTriggerHired,
+		ReferralMilestoneTriggerProbation,
+		ReferralMilestoneTriggerTenure:
+		return true
+	}
+	return false
+}
+
+func (t ReferralMilestoneTrigger) String() string {
+	return string(t)
+}
+
+// ReferralBonusMilestone is a step of the referral program paying the referrer a bonus
+type ReferralBonusMilestone struct {
+	BaseModel
+
+	Code         string                   `json:"code"`
+	Name         string                   `json:"name"`
+	Trigger      ReferralMilestoneTrigger `json:"trigger"`
+	TenureMonths int                      `json:"tenureMonths"`
+	Amount       VietnamDong              `json:"amount"`
+	IsActive     bool                     `json:"isActive"`
+	SortOrder    int                      `json:"sortOrder"`
+}
+
+type ReferralBonusStatus string
+
+const (
+	ReferralBonusStatusScheduled ReferralBonusStatus = "scheduled" // in the next payroll of the referrer
+	ReferralBonusStatusPaid      ReferralBonusStatus = "paid"
+	ReferralBonusStatusCancelled ReferralBonusStatus = "cancelled" // the referrer left before it was paid
+)
+
+func (s ReferralBonusStatus) String() string {
+	return string(s)
+}
+
+// ReferralBonus is a milestone reached by a referral, paid through an employee bonus of the referrer
+type ReferralBonus struct {
+	BaseModel
+
+	ReferralID      UUID                `json:"referralID"`
+	MilestoneID     UUID                `json:"milestoneID"`
+	ReferrerID      UUID                `json:"referrerID"`
+	Amount          VietnamDong         `json:"amount"`
+	Status          ReferralBonusStatus `json:"status"`
+	ReachedAt       time.Time           `json:"reachedAt"`
+	EmployeeBonusID *UUID               `json:"employeeBonusID"`
+	PaidAt          *time.Time          `json:"paidAt"`
+
+	Milestone     *ReferralBonusMilestone `json:"milestone,omitempty" gorm:"foreignKey:MilestoneID"`
+	EmployeeBonus *EmployeeBonus          `json:"employeeBonus,omitempty" gorm:"foreignKey:EmployeeBonusID"`
+}
@@ -0,0 +1,179 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ReviewCycleStatus is the step of a review cycle: the reviews are written while it is in
+// progress, the packets are calibrated then approved before it is closed
+type ReviewCycleStatus string
+
+const (
+	ReviewCycleStatusDraft       ReviewCycleStatus = "draft"
+	ReviewCycleStatusInProgress  ReviewCycleStatus = "in-progress"
+	ReviewCycleStatusCalibration ReviewCycleStatus = "calibration"
+	ReviewCycleStatusClosed      ReviewCycleStatus = "closed"
+)
+
+func (s ReviewCycleStatus) IsValid() bool {
+	switch s {
+	case ReviewCycleStatusDraft,
+		ReviewCycleStatusInProgress,
+		ReviewCycleStatusCalibration,
+		ReviewCycleStatusClosed:
+		return true
+	}
+	return false
+}
+
+func (s ReviewCycleStatus) String() string {
+	return string(s)
+}
+
+// ReviewCycle is a performance review of the employees over a period. The self and manager reviews
+// are feedback events of the cycle, answered from the feedback inbox.
+type ReviewCycle struct {
+	BaseModel
+
+	Name                 string
+	PeriodFrom           time.Time
+	PeriodTo             time.Time
+	Status               ReviewCycleStatus
+	SelfReviewEventID    *UUID
+	ManagerReviewEventID *UUID
+	CreatedBy            UUID
+
+	Packets []*ReviewPacket `gorm:"foreignKey:CycleID"`
+}
+
+// ReviewPacketStatus is the step of the review of an employee
+type ReviewPacketStatus string
+
+const (
+	ReviewPacketStatusOpen       ReviewPacketStatus = "open"
+	ReviewPacketStatusCalibrated ReviewPacketStatus = "calibrated"
+	ReviewPacketStatusApproved   ReviewPacketStatus = "approved"
+	ReviewPacketStatusRejected   ReviewPacketStatus = "rejected"
+)
+
+func (s ReviewPacketStatus) IsValid() bool {
+	switch s {
+	case ReviewPacketStatusOpen,
+		ReviewPacketStatusCalibrated,
+		ReviewPacketStatusApproved,
+		ReviewPacketStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (s ReviewPacketStatus) String() string {
+	return string(s)
+}
+
+// ReviewPacket is the review of an employee in a cycle: their self and manager review topics, the
+// evidence of the period collected for the calibration, the final rating and the seniority they
+// are proposed. The proposed seniority is applied to the employee when the packet is approved.
+type ReviewPacket struct {
+	BaseModel
+
+	CycleID              UUID
+	EmployeeID           UUID
+	ManagerID            *UUID
+	SelfReviewTopicID    *UUID
+	ManagerReviewTopicID *UUID
+	Status               ReviewPacketStatus
+	Evidence             *ReviewEvidence `gorm:"type:jsonb"`
+	SuggestedRating      int
+	SuggestedScore       float64
+	FinalRating          int
+	CurrentSeniorityID   UUID
+	ProposedSeniorityID  *UUID
+	CalibrationNote      string
+	CalibratedBy         *UUID
+	CalibratedAt         *time.Time
+	ApprovedBy           *UUID
+	ApprovedAt           *time.Time
+
+	Employee          *Employee  `gorm:"foreignKey:EmployeeID"`
+	Manager           *Employee  `gorm:"foreignKey:ManagerID"`
+	CurrentSeniority  *Seniority `gorm:"foreignKey:CurrentSeniorityID"`
+	ProposedSeniority *Seniority `gorm:"foreignKey:ProposedSeniorityID"`
+}
+
+// ReviewFeedback is the feedback on the employee from a kind of reviewers: the average of their
+// likert-scale answers and their written answers, without who wrote them
+type ReviewFeedback struct {
+	Average   float64         `json:"average"`
+	Answers   int             `json:"answers"`
+	Reviewers int             `json:"reviewers"`
+	Comments  []ReviewComment `json:"comments"`
+}
+
+type ReviewComment struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
+
+type ReviewMMA struct {
+	Mastery  float64    `json:"mastery"`
+	Autonomy float64    `json:"autonomy"`
+	Meaning  float64    `json:"meaning"`
+	RatedAt  *time.Time `json:"ratedAt"`
+}
+
+type ReviewDelivery struct {
+	Weight        float64 `json:"weight"`
+	Effort        float64 `json:"effort"`
+	Effectiveness float64 `json:"effectiveness"`
+	Percentile    float64 `json:"percentile"`
+}
+
+type ReviewAudits struct {
+	Count        int     `json:"count"`
+	AverageScore float64 `json:"averageScore"`
+}
+
+// ReviewSignal is a piece of evidence weighed into the suggested rating and why
+type ReviewSignal struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+	Score  float64 `json:"score"`
+	Reason string  `json:"reason"`
+}
+
+// ReviewEvidence is the snapshot of what is known of an employee over the period of the cycle,
+// taken when the cycle goes to calibration. A missing piece of evidence is nil.
+type ReviewEvidence struct {
+	SelfReview    *ReviewFeedback `json:"selfReview"`
+	ManagerReview *ReviewFeedback `json:"managerReview"`
+	PeerReview    *ReviewFeedback `json:"peerReview"`
+	MMA           *ReviewMMA      `json:"mma"`
+	Delivery      *ReviewDelivery `json:"delivery"`
+	Audits        *ReviewAudits   `json:"audits"`
+	Signals       []ReviewSignal  `json:"signals"`
+	CollectedAt   time.Time       `json:"collectedAt"`
+}
+
+func (j ReviewEvidence) Value() (driver.Value, error) {
+	return json.Marshal(j)
+}
+
+func (j *ReviewEvidence) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	switch t := value.(type) {
+	case []uint8:
+		jsonData := value.([]uint8)
+		if string(jsonData) == "null" {
+			return nil
+		}
+		return json.Unmarshal(jsonData, j)
+	default:
+		return fmt.Errorf("could not scan type %T into json", t)
+	}
+}
@@ -0,0 +1,39 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// StaffingSlot is a project slot to staff with what it asks for, Positions and Stacks are the
+// comma separated names of the positions of the slot and the stacks of its project, EmployeeID
+// the member on the slot, empty while it is open
+type StaffingSlot struct {
+	SlotID         string
+	ProjectID      string
+	ProjectName    string
+	ClientID       string
+	ClientName     string
+	SeniorityName  string
+	SeniorityLevel int
+	DeploymentType DeploymentType
+	Status         ProjectMemberStatus
+	Positions      string
+	Stacks         string
+	EmployeeID     string
+}
+
+// StaffingProfile is what an employee brings to a slot besides their stacks, Positions and
+// Chapters are comma separated names, the MMA scores are the latest ones
+type StaffingProfile struct {
+	EmployeeID     string
+	SeniorityLevel int
+	Positions      string
+	Chapters       string
+	MasteryScore   decimal.NullDecimal
+	AutonomyScore  decimal.NullDecimal
+	MeaningScore   decimal.NullDecimal
+}
+
+// StaffingClientProject is a project of a client an employee was a member of
+type StaffingClientProject struct {
+	EmployeeID  string
+	ProjectName string
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// SurveyAnswer is an answer of a done reviewer with its survey, GroupName is the team of the
+// reviewer when the answers are compared between teams
+type SurveyAnswer struct {
+	EventID      UUID
+	EventTitle   string
+	EventDate    *time.Time
+	QuestionID   UUID
+	Content      string
+	Type         QuestionType
+	Order        int64
+	ReviewerID   UUID
+	Relationship Relationship
+	GroupName    string
+	Answer       string
+}
+
+// SurveyThemeSummary is the themes of the free-text answers of a question, summarized by an LLM
+type SurveyThemeSummary struct {
+	BaseModel
+
+	EventID     UUID
+	QuestionID  UUID
+	Content     string
+	Summary     string
+	AnswerCount int
+	Model       string
+	CreatedBy   *UUID
+}
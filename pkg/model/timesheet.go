@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type TimesheetStatus string
+
+const (
+	TimesheetStatusDraft     TimesheetStatus = "draft"
+	TimesheetStatusSubmitted TimesheetStatus = "submitted"
+	TimesheetStatusApproved  TimesheetStatus = "approved"
+	TimesheetStatusRejected  TimesheetStatus = "rejected"
+	TimesheetStatusLocked    TimesheetStatus = "locked"
+)
+
+func (s TimesheetStatus) IsValid() bool {
+	switch s {
+	case TimesheetStatusDraft,
+		TimesheetStatusSubmitted,
+		TimesheetStatusApproved,
+		TimesheetStatusRejected,
+		TimesheetStatusLocked:
+		return true
+	}
+	return false
+}
+
+func (s TimesheetStatus) String() string {
+	return string(s)
+}
+
+// IsEditable tells whether the entries of a timesheet in this status can be changed, a rejected
+// timesheet goes back to its owner to fix
+func (s TimesheetStatus) IsEditable() bool {
+	return s == TimesheetStatusDraft || s == TimesheetStatusRejected
+}
+
+// IsReviewable tells whether a lead can approve or reject a timesheet in this status, an approved
+// timesheet can still be rejected until it is invoiced
+func (s TimesheetStatus) IsReviewable() bool {
+	return s == TimesheetStatusSubmitted || s == TimesheetStatusApproved
+}
+
+// TimesheetBillableStatuses are the statuses of the timesheets with hours to invoice
+var TimesheetBillableStatuses = []TimesheetStatus{TimesheetStatusApproved, TimesheetStatusLocked}
+
+// TimesheetApproverPositions are the heads of a project who approve the timesheets of its members
+var TimesheetApproverPositions = []HeadPosition{HeadPositionTechnicalLead, HeadPositionDeliveryManager}
+
+// Timesheet is the week of an employee on a project, WeekStart is its Monday. It is locked once
+// its hours are invoiced.
+type Timesheet struct {
+	BaseModel
+
+	EmployeeID     UUID
+	ProjectID      UUID
+	WeekStart      time.Time
+	Status         TimesheetStatus
+	Note           string
+	SubmittedAt    *time.Time
+	ReviewedBy     *UUID
+	ReviewedAt     *time.Time
+	RejectedReason string
+	LockedAt       *time.Time
+
+	Employee *Employee         `gorm:"foreignKey:EmployeeID"`
+	Project  *Project          `gorm:"foreignKey:ProjectID"`
+	Reviewer *Employee         `gorm:"foreignKey:ReviewedBy"`
+	Entries  []*TimesheetEntry `gorm:"foreignKey:TimesheetID"`
+}
+
+// TotalHours sums the hours of the entries
+func (t *Timesheet) TotalHours() decimal.Decimal {
+	total := decimal.Zero
+	for _, e := range t.Entries {
+		total = total.Add(e.Hours)
+	}
+	return total
+}
+
+// TimesheetEntry is the hours of a day on a work unit of the project, InvoiceID the invoice they
+// are billed on
+type TimesheetEntry struct {
+	BaseModel
+
+	TimesheetID UUID
+	WorkUnitID  *UUID
+	Date        time.Time
+	Hours       decimal.Decimal
+	Description string
+	InvoiceID   *UUID
+
+	WorkUnit *WorkUnit `gorm:"foreignKey:WorkUnitID"`
+}
+
+// TimesheetBillableHours is the approved hours of a member not invoiced yet, at the rate of their
+// membership
+type TimesheetBillableHours struct {
+	EmployeeID      string
+	FullName        string
+	ProjectMemberID string
+	Rate            decimal.Decimal
+	Hours           decimal.Decimal
+}
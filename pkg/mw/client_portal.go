@@ -0,0 +1,69 @@
+package mw
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/config"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/store"
+	"github.com/dwarvesf/fortress-api/pkg/utils/authutils"
+)
+
+type ClientPortalMiddleware struct {
+	cfg   *config.Config
+	store *store.Store
+	repo  store.DBRepo
+}
+
+func NewClientPortalMiddleware(cfg *config.Config, s *store.Store, r store.DBRepo) *ClientPortalMiddleware {
+	return &ClientPortalMiddleware{
+		cfg:   cfg,
+		store: s,
+		repo:  r,
+	}
+}
+
+// WithClientAuth a middleware to check the client portal token, the access behind it is loaded on
+// every request so a revoked access is locked out straight away
+func (m *ClientPortalMiddleware) WithClientAuth(c *gin.Context) {
+	access, err := m.authenticate(c)
+	if err != nil {
+		c.AbortWithStatusJSON(401, map[string]string{"message": err.Error()})
+		return
+	}
+
+	c.Set(model.ClientPortalAccessContextKey, access)
+	c.Next()
+}
+
+func (m *ClientPortalMiddleware) authenticate(c *gin.Context) (*model.ClientPortalAccess, error) {
+	headers := strings.Split(c.Request.Header.Get("Authorization"), " ")
+	if len(headers) != 2 {
+		return nil, ErrUnexpectedAuthorizationHeader
+	}
+	if headers[0] != "Bearer" {
+		return nil, ErrAuthenticationTypeHeaderInvalid
+	}
+
+	claims, err := authutils.ParseClientPortalToken(headers[1], m.cfg.ClientPortal.JWTSecretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := m.store.ClientPortal.OneActiveAccess(m.repo.DB(), claims.AccessID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrClientPortalAccessRevoked
+		}
+		return nil, err
+	}
+	if access.ClientID.String() != claims.ClientID || access.ClientContactID.String() != claims.ContactID {
+		return nil, ErrUnauthorized
+	}
+
+	return access, nil
+}
@@ -11,6 +11,7 @@ var (
 	ErrAuthenticationTypeHeaderInvalid = errors.New("authentication type header is invalid")
 	ErrUnexpectedAuthorizationHeader   = errors.New("unexpected authorization headers")
 	ErrInvalidAPIKey                   = errors.New("invalid API key")
+	ErrClientPortalAccessRevoked       = errors.New("client portal access is revoked")
 )
 
 // errUnauthorized returns unauthorized custom error
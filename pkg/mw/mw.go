@@ -102,6 +102,10 @@ func (amw *AuthMiddleware) validateToken(accessToken string) error {
 	if err != nil {
 		return err
 	}
+	// client portal tokens are only good for the client portal
+	if claims.VerifyAudience(model.ClientPortalAudience, true) {
+		return ErrUnauthorized
+	}
 
 	return claims.Valid()
 }
@@ -0,0 +1,126 @@
+// Package officecheckin signs the rotating QR codes shown at the offices and checks the position
+// of a check-in against the geofence of the office
+package officecheckin
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// GraceWindows is how many windows before the current one a code is still accepted, to cover the
+	// time between the scan and the request
+	GraceWindows = 1
+
+	earthRadiusMeters = 6371000
+	signatureBytes    = 16
+)
+
+var (
+	ErrMalformedCode   = errors.New("malformed check-in code")
+	ErrInvalidCode     = errors.New("invalid check-in code")
+	ErrExpiredCode     = errors.New("check-in code expired, scan the current one")
+	ErrWrongOffice     = errors.New("check-in code belongs to another office")
+	ErrInvalidRotation = errors.New("rotation must be positive")
+)
+
+// Window returns the rotation window of the time
+func Window(t time.Time, rotation time.Duration) int64 {
+	return t.Unix() / int64(rotation/time.Second)
+}
+
+// Code returns the QR code of the office for the window of the time, and when it rotates
+func Code(secret []byte, officeID string, t time.Time, rotation time.Duration) (string, time.Time, error) {
+	if rotation < time.Second {
+		return "", time.Time{}, ErrInvalidRotation
+	}
+	window := Window(t, rotation)
+	payload := fmt.Sprintf("%s:%d", officeID, window)
+	code := base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + sign(secret, payload)
+	expiresAt := time.Unix((window+1)*int64(rotation/time.Second), 0).In(t.Location())
+	return code, expiresAt, nil
+}
+
+// Verify checks the code was issued for the office in the current window, or in the grace ones
+func Verify(secret []byte, officeID, code string, t time.Time, rotation time.Duration) error {
+	if rotation < time.Second {
+		return ErrInvalidRotation
+	}
+	encoded, signature, ok := strings.Cut(code, ".")
+	if !ok {
+		return ErrMalformedCode
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrMalformedCode
+	}
+	payload := string(raw)
+	if !hmac.Equal([]byte(signature), []byte(sign(secret, payload))) {
+		return ErrInvalidCode
+	}
+
+	id, windowStr, ok := strings.Cut(payload, ":")
+	if !ok {
+		return ErrMalformedCode
+	}
+	window, err := strconv.ParseInt(windowStr, 10, 64)
+	if err != nil {
+		return ErrMalformedCode
+	}
+	if id != officeID {
+		return ErrWrongOffice
+	}
+	current := Window(t, rotation)
+	if window > current || window < current-GraceWindows {
+		return ErrExpiredCode
+	}
+	return nil
+}
+
+// OfficeID returns the office a code claims to be issued for, without verifying it
+func OfficeID(code string) (string, error) {
+	encoded, _, ok := strings.Cut(code, ".")
+	if !ok {
+		return "", ErrMalformedCode
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrMalformedCode
+	}
+	id, _, ok := strings.Cut(string(raw), ":")
+	if !ok || id == "" {
+		return "", ErrMalformedCode
+	}
+	return id, nil
+}
+
+func sign(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:signatureBytes])
+}
+
+// Distance returns the great-circle distance in meters between two positions
+func Distance(lat1, long1, lat2, long2 float64) float64 {
+	toRad := func(d float64) float64 { return d * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLong := toRad(long2 - long1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return earthRadiusMeters * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// WorkedHours returns the hours between the check-in and the check-out, 0 while checked in
+func WorkedHours(in time.Time, out *time.Time) float64 {
+	if out == nil || !out.After(in) {
+		return 0
+	}
+	return math.Round(out.Sub(in).Hours()*100) / 100
+}
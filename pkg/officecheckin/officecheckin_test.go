@@ -0,0 +1,51 @@
+package officecheckin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCode(t *testing.T) {
+	secret := []byte("secret")
+	rotation := time.Minute
+	at := time.Date(2026, 10, 19, 9, 0, 30, 0, time.UTC)
+
+	code, expiresAt, err := Code(secret, "office-1", at, rotation)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 10, 19, 9, 1, 0, 0, time.UTC), expiresAt)
+
+	id, err := OfficeID(code)
+	require.NoError(t, err)
+	require.Equal(t, "office-1", id)
+
+	require.NoError(t, Verify(secret, "office-1", code, at, rotation))
+	// still accepted in the next window
+	require.NoError(t, Verify(secret, "office-1", code, at.Add(time.Minute), rotation))
+	require.ErrorIs(t, Verify(secret, "office-1", code, at.Add(2*time.Minute), rotation), ErrExpiredCode)
+	// a code from the future is not accepted either
+	require.ErrorIs(t, Verify(secret, "office-1", code, at.Add(-time.Minute), rotation), ErrExpiredCode)
+
+	require.ErrorIs(t, Verify(secret, "office-2", code, at, rotation), ErrWrongOffice)
+	require.ErrorIs(t, Verify([]byte("other"), "office-1", code, at, rotation), ErrInvalidCode)
+	require.ErrorIs(t, Verify(secret, "office-1", "garbage", at, rotation), ErrMalformedCode)
+
+	_, _, err = Code(secret, "office-1", at, 0)
+	require.ErrorIs(t, err, ErrInvalidRotation)
+}
+
+func TestDistance(t *testing.T) {
+	require.InDelta(t, 0, Distance(10.7769, 106.7009, 10.7769, 106.7009), 0.001)
+	// 0.001 degree of latitude is about 111 m
+	require.InDelta(t, 111.2, Distance(10.7769, 106.7009, 10.7779, 106.7009), 0.5)
+}
+
+func TestWorkedHours(t *testing.T) {
+	in := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	out := in.Add(8*time.Hour + 20*time.Minute)
+	require.Equal(t, 8.33, WorkedHours(in, &out))
+	require.Zero(t, WorkedHours(in, nil))
+	before := in.Add(-time.Hour)
+	require.Zero(t, WorkedHours(in, &before))
+}
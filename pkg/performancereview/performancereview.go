@@ -0,0 +1,192 @@
+// Package performancereview suggests the rating of an employee at the end of a review cycle from
+// the evidence of the period: the reviews of their manager and peers, their MMA score and their
+// delivery. The suggestion is where the calibration starts from, every signal comes with the
+// reason behind it.
+package performancereview
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// RatingScale is the highest rating, the reviews are answered on the same 1 to 5 likert scale
+const RatingScale = 5
+
+// MMAScale is the highest mastery, autonomy and meaning score
+const MMAScale = 5
+
+// PromotionRating is the final rating from which the next seniority is proposed
+const PromotionRating = 4
+
+const (
+	SignalManagerReview = "manager-review"
+	SignalPeerReview    = "peer-review"
+	SignalMMA           = "mma"
+	SignalDelivery      = "delivery"
+)
+
+// Weights is the share of each signal in the suggested rating, the missing signals are left out
+type Weights struct {
+	ManagerReview float64
+	PeerReview    float64
+	MMA           float64
+	Delivery      float64
+}
+
+// DefaultWeights favours the review of the manager, who sees the whole period
+var DefaultWeights = Weights{
+	ManagerReview: 0.4,
+	PeerReview:    0.25,
+	MMA:           0.15,
+	Delivery:      0.2,
+}
+
+// Review is the average of the likert-scale answers of a review
+type Review struct {
+	Average float64
+	Answers int
+}
+
+type MMA struct {
+	Mastery  float64
+	Autonomy float64
+	Meaning  float64
+}
+
+// Delivery is the delivery metrics of the period, Percentile the share of the employees who
+// delivered less weight
+type Delivery struct {
+	Weight        float64
+	Effort        float64
+	Effectiveness float64
+	Percentile    float64
+}
+
+// Evidence is what is known of an employee over the period, nil when missing
+type Evidence struct {
+	ManagerReview *Review
+	PeerReview    *Review
+	MMA           *MMA
+	Delivery      *Delivery
+}
+
+// Signal is the score of the employee on one piece of evidence, from 1 to RatingScale, and why
+type Signal struct {
+	Name   string
+	Weight float64
+	Score  float64
+	Reason string
+}
+
+// Suggestion is the suggested rating, 0 when there is no evidence at all
+type Suggestion struct {
+	Rating  int
+	Score   float64
+	Signals []Signal
+}
+
+// Suggest weighs the evidence into a rating
+func Suggest(e Evidence, w Weights) Suggestion {
+	var signals []Signal
+	if e.ManagerReview != nil && e.ManagerReview.Answers > 0 {
+		signals = append(signals, Signal{
+			Name:   SignalManagerReview,
+			Weight: w.ManagerReview,
+			Score:  clamp(e.ManagerReview.Average),
+			Reason: fmt.Sprintf("rated %.1f/%d by their manager", e.ManagerReview.Average, RatingScale),
+		})
+	}
+	if e.PeerReview != nil && e.PeerReview.Answers > 0 {
+		signals = append(signals, Signal{
+			Name:   SignalPeerReview,
+			Weight: w.PeerReview,
+			Score:  clamp(e.PeerReview.Average),
+			Reason: fmt.Sprintf("rated %.1f/%d by their peers over %d answers", e.PeerReview.Average, RatingScale, e.PeerReview.Answers),
+		})
+	}
+	if e.MMA != nil {
+		avg := (e.MMA.Mastery + e.MMA.Autonomy + e.MMA.Meaning) / 3
+		signals = append(signals, Signal{
+			Name:   SignalMMA,
+			Weight: w.MMA,
+			Score:  clamp(avg / MMAScale * RatingScale),
+			Reason: fmt.Sprintf("scores %.1f/%d on MMA (mastery %.1f, autonomy %.1f, meaning %.1f)",
+				avg, MMAScale, e.MMA.Mastery, e.MMA.Autonomy, e.MMA.Meaning),
+		})
+	}
+	if e.Delivery != nil {
+		signals = append(signals, Signal{
+			Name:   SignalDelivery,
+			Weight: w.Delivery,
+			Score:  clamp(1 + e.Delivery.Percentile*(RatingScale-1)),
+			Reason: fmt.Sprintf("delivered %.1f points in %.1f hours, more than %d%% of the team",
+				e.Delivery.Weight, e.Delivery.Effort, int(math.Round(e.Delivery.Percentile*100))),
+		})
+	}
+
+	var total, weights float64
+	for _, s := range signals {
+		total += s.Score * s.Weight
+		weights += s.Weight
+	}
+	if weights == 0 {
+		return Suggestion{Signals: signals}
+	}
+
+	score := math.Round(total/weights*100) / 100
+	return Suggestion{
+		Rating:  int(math.Round(score)),
+		Score:   score,
+		Signals: signals,
+	}
+}
+
+// Percentile returns the share of the values below the value
+func Percentile(value float64, values []float64) float64 {
+	if len(values) <= 1 {
+		return 1
+	}
+	below := 0
+	for _, v := range values {
+		if v < value {
+			below++
+		}
+	}
+	return float64(below) / float64(len(values)-1)
+}
+
+// Level is a seniority
+type Level struct {
+	ID    string
+	Name  string
+	Level int
+}
+
+// ProposeSeniority proposes the next seniority to the employees rated PromotionRating and up,
+// the current one to the others and to the employees already on the highest
+func ProposeSeniority(current Level, rating int, levels []Level) Level {
+	if rating < PromotionRating {
+		return current
+	}
+
+	sorted := append([]Level{}, levels...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Level < sorted[j].Level
+	})
+	for _, l := range sorted {
+		if l.Level > current.Level {
+			return l
+		}
+	}
+	return current
+}
+
+// IsValidRating tells the rating is on the scale
+func IsValidRating(rating int) bool {
+	return rating >= 1 && rating <= RatingScale
+}
+
+func clamp(score float64) float64 {
+	return math.Max(1, math.Min(RatingScale, score))
+}
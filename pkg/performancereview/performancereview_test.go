@@ -0,0 +1,65 @@
+package performancereview
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggest(t *testing.T) {
+	t.Run("every signal", func(t *testing.T) {
+		s := Suggest(Evidence{
+			ManagerReview: &Review{Average: 4.5, Answers: 8},
+			PeerReview:    &Review{Average: 4, Answers: 24},
+			MMA:           &MMA{Mastery: 4, Autonomy: 3, Meaning: 5},
+			Delivery:      &Delivery{Weight: 40, Effort: 160, Percentile: 0.75},
+		}, DefaultWeights)
+
+		require.Len(t, s.Signals, 4)
+		// 4.5*0.4 + 4*0.25 + 4*0.15 + 4*0.2
+		assert.Equal(t, 4.2, s.Score)
+		assert.Equal(t, 4, s.Rating)
+		assert.Equal(t, "delivered 40.0 points in 160.0 hours, more than 75% of the team", s.Signals[3].Reason)
+	})
+
+	t.Run("the missing signals are left out", func(t *testing.T) {
+		s := Suggest(Evidence{
+			ManagerReview: &Review{Average: 3, Answers: 8},
+			PeerReview:    &Review{},
+			Delivery:      &Delivery{Percentile: 0},
+		}, DefaultWeights)
+
+		require.Len(t, s.Signals, 2)
+		// (3*0.4 + 1*0.2) / 0.6
+		assert.Equal(t, 2.33, s.Score)
+		assert.Equal(t, 2, s.Rating)
+	})
+
+	t.Run("no evidence", func(t *testing.T) {
+		s := Suggest(Evidence{}, DefaultWeights)
+		assert.Zero(t, s.Rating)
+		assert.Empty(t, s.Signals)
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{10, 20, 30, 40, 50}
+	assert.Equal(t, 0.0, Percentile(10, values))
+	assert.Equal(t, 0.5, Percentile(30, values))
+	assert.Equal(t, 1.0, Percentile(50, values))
+	assert.Equal(t, 1.0, Percentile(50, []float64{50}))
+}
+
+func TestProposeSeniority(t *testing.T) {
+	levels := []Level{
+		{ID: "senior", Name: "Senior", Level: 5},
+		{ID: "junior", Name: "Junior", Level: 3},
+		{ID: "mid", Name: "Mid", Level: 4},
+	}
+
+	assert.Equal(t, "mid", ProposeSeniority(levels[1], 4, levels).ID)
+	assert.Equal(t, "mid", ProposeSeniority(levels[1], 5, levels).ID)
+	assert.Equal(t, "junior", ProposeSeniority(levels[1], 3, levels).ID)
+	assert.Equal(t, "senior", ProposeSeniority(levels[0], 5, levels).ID)
+}
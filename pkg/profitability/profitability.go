@@ -0,0 +1,197 @@
+// Package profitability charges the salaries to the projects the employees are deployed on
+// and turns the revenue and costs of a project into its margin and trend
+package profitability
+
+import (
+	"math"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// flatSlope is the change of the margin percent per month under which the trend is flat
+const flatSlope = 1.0
+
+// Weights is the share of the month a member spends on the project, by deployment type
+type Weights struct {
+	Official float64
+	PartTime float64
+	Shadow   float64
+}
+
+func (w Weights) of(t model.DeploymentType) float64 {
+	switch t {
+	case model.MemberDeploymentTypePartTime:
+		return w.PartTime
+	case model.MemberDeploymentTypeShadow:
+		return w.Shadow
+	default:
+		return w.Official
+	}
+}
+
+// Assignment is an employee deployed on a project
+type Assignment struct {
+	ProjectID      string
+	EmployeeID     string
+	DeploymentType model.DeploymentType
+	StartDate      *time.Time
+	EndDate        *time.Time
+}
+
+// Allocation is the share of the month of an employee charged to a project
+type Allocation struct {
+	Assignment
+	Share float64
+}
+
+// Months returns the first day of the months from the month of from to the month of to
+func Months(from, to time.Time) []time.Time {
+	var months []time.Time
+	last := monthOf(to)
+	for m := monthOf(from); !m.After(last); m = m.AddDate(0, 1, 0) {
+		months = append(months, m)
+	}
+	return months
+}
+
+// Allocate splits the month of each employee between the projects they are deployed on. A
+// deployment weighs the share of its type, pro-rated by the days of the month it covers.
+// The shares of an employee are scaled down to a full month when they add up to more, the
+// remainder of a month not fully deployed is bench and charged to no project.
+func Allocate(month time.Time, assignments []Assignment, w Weights) []Allocation {
+	start := monthOf(month)
+	end := start.AddDate(0, 1, -1)
+	days := float64(end.Day())
+
+	var (
+		rs    []Allocation
+		total = map[string]float64{}
+	)
+	for _, a := range assignments {
+		from, to := start, end
+		if a.StartDate != nil && dayOf(*a.StartDate).After(from) {
+			from = dayOf(*a.StartDate)
+		}
+		if a.EndDate != nil && dayOf(*a.EndDate).Before(to) {
+			to = dayOf(*a.EndDate)
+		}
+		if to.Before(from) {
+			continue
+		}
+
+		share := w.of(a.DeploymentType) * (to.Sub(from).Hours()/24 + 1) / days
+		if share <= 0 {
+			continue
+		}
+		rs = append(rs, Allocation{Assignment: a, Share: share})
+		total[a.EmployeeID] += share
+	}
+
+	for i := range rs {
+		if t := total[rs[i].EmployeeID]; t > 1 {
+			rs[i].Share /= t
+		}
+	}
+	return rs
+}
+
+// Summarize fills the totals, margin and billing rate of a month from its revenue and costs
+func Summarize(m model.ProjectProfitabilityMonth) model.ProjectProfitabilityMonth {
+	m.Revenue = round(m.Revenue)
+	m.SalaryCost = round(m.SalaryCost)
+	m.ContractorCost = round(m.ContractorCost)
+	m.CommissionCost = round(m.CommissionCost)
+	m.AllocatedFTE = round(m.AllocatedFTE)
+	m.BillableFTE = round(m.BillableFTE)
+
+	m.TotalCost = m.SalaryCost + m.ContractorCost + m.CommissionCost
+	m.GrossMargin = m.Revenue - m.TotalCost
+	m.GrossMarginPercent, m.EffectiveBillingRate = 0, 0
+	if m.Revenue != 0 {
+		m.GrossMarginPercent = round(m.GrossMargin / m.Revenue * 100)
+	}
+	if m.BillableFTE > 0 {
+		m.EffectiveBillingRate = round(m.Revenue / m.BillableFTE)
+	}
+	return m
+}
+
+// Total sums the months up, the FTEs are averaged over the months
+func Total(months []model.ProjectProfitabilityMonth) model.ProjectProfitabilityMonth {
+	var t model.ProjectProfitabilityMonth
+	if len(months) == 0 {
+		return t
+	}
+
+	for _, m := range months {
+		t.Revenue += m.Revenue
+		t.SalaryCost += m.SalaryCost
+		t.ContractorCost += m.ContractorCost
+		t.CommissionCost += m.CommissionCost
+		t.AllocatedFTE += m.AllocatedFTE
+		t.BillableFTE += m.BillableFTE
+	}
+	t.Month = months[0].Month
+	t.AllocatedFTE /= float64(len(months))
+	t.BillableFTE /= float64(len(months))
+
+	t = Summarize(t)
+	// the rate is per FTE and month, not over the whole range
+	t.EffectiveBillingRate = round(t.EffectiveBillingRate / float64(len(months)))
+	return t
+}
+
+// Trend fits a line on the gross margin percent of the months with revenue. The months
+// without revenue have no margin percent and are left out.
+func Trend(months []model.ProjectProfitabilityMonth) model.ProjectProfitabilityTrend {
+	var xs, ys []float64
+	for i, m := range months {
+		if m.Revenue == 0 {
+			continue
+		}
+		xs = append(xs, float64(i))
+		ys = append(ys, m.GrossMarginPercent)
+	}
+
+	t := model.ProjectProfitabilityTrend{Direction: model.ProfitabilityTrendFlat}
+	if len(xs) < 2 {
+		return t
+	}
+
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(len(xs))
+	meanY /= float64(len(ys))
+
+	var num, den float64
+	for i := range xs {
+		num += (xs[i] - meanX) * (ys[i] - meanY)
+		den += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+
+	t.MarginSlope = round(num / den)
+	t.MarginChange = round(ys[len(ys)-1] - ys[0])
+	switch {
+	case t.MarginSlope >= flatSlope:
+		t.Direction = model.ProfitabilityTrendUp
+	case t.MarginSlope <= -flatSlope:
+		t.Direction = model.ProfitabilityTrendDown
+	}
+	return t
+}
+
+func monthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,133 @@
+package profitability
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+var testWeights = Weights{Official: 1, PartTime: 0.5, Shadow: 1}
+
+func date(y int, m time.Month, d int) *time.Time {
+	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestMonths(t *testing.T) {
+	months := Months(*date(2026, 11, 20), *date(2027, 2, 3))
+	assert.Equal(t, []time.Time{*date(2026, 11, 1), *date(2026, 12, 1), *date(2027, 1, 1), *date(2027, 2, 1)}, months)
+	assert.Empty(t, Months(*date(2026, 11, 1), *date(2026, 10, 1)))
+}
+
+func TestAllocate(t *testing.T) {
+	month := *date(2026, 9, 1) // 30 days
+
+	shares := func(allocations []Allocation) map[string]float64 {
+		rs := map[string]float64{}
+		for _, a := range allocations {
+			rs[a.EmployeeID+"/"+a.ProjectID] = round(a.Share)
+		}
+		return rs
+	}
+
+	t.Run("full time on one project", func(t *testing.T) {
+		rs := Allocate(month, []Assignment{
+			{ProjectID: "p1", EmployeeID: "e1", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 1, 1)},
+		}, testWeights)
+		assert.Equal(t, map[string]float64{"e1/p1": 1}, shares(rs))
+	})
+
+	t.Run("part time leaves bench", func(t *testing.T) {
+		rs := Allocate(month, []Assignment{
+			{ProjectID: "p1", EmployeeID: "e1", DeploymentType: model.MemberDeploymentTypePartTime, StartDate: date(2026, 1, 1)},
+		}, testWeights)
+		assert.Equal(t, map[string]float64{"e1/p1": 0.5}, shares(rs))
+	})
+
+	t.Run("pro-rated by the days on the project", func(t *testing.T) {
+		rs := Allocate(month, []Assignment{
+			{ProjectID: "p1", EmployeeID: "e1", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 1, 1), EndDate: date(2026, 9, 15)},
+			{ProjectID: "p2", EmployeeID: "e1", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 9, 16)},
+		}, testWeights)
+		assert.Equal(t, map[string]float64{"e1/p1": 0.5, "e1/p2": 0.5}, shares(rs))
+	})
+
+	t.Run("overbooked scaled down to a month", func(t *testing.T) {
+		rs := Allocate(month, []Assignment{
+			{ProjectID: "p1", EmployeeID: "e1", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 1, 1)},
+			{ProjectID: "p2", EmployeeID: "e1", DeploymentType: model.MemberDeploymentTypePartTime, StartDate: date(2026, 1, 1)},
+			{ProjectID: "p1", EmployeeID: "e2", DeploymentType: model.MemberDeploymentTypeShadow, StartDate: date(2026, 1, 1)},
+		}, testWeights)
+		assert.Equal(t, map[string]float64{"e1/p1": 0.67, "e1/p2": 0.33, "e2/p1": 1}, shares(rs))
+	})
+
+	t.Run("outside of the month", func(t *testing.T) {
+		rs := Allocate(month, []Assignment{
+			{ProjectID: "p1", EmployeeID: "e1", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 1, 1), EndDate: date(2026, 8, 31)},
+			{ProjectID: "p2", EmployeeID: "e1", DeploymentType: model.MemberDeploymentTypeOfficial, StartDate: date(2026, 10, 1)},
+		}, testWeights)
+		assert.Empty(t, rs)
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	m := Summarize(model.ProjectProfitabilityMonth{
+		Revenue:        100_000_000,
+		SalaryCost:     50_000_000,
+		ContractorCost: 10_000_000,
+		CommissionCost: 5_000_000,
+		BillableFTE:    2,
+	})
+	assert.Equal(t, 65_000_000.0, m.TotalCost)
+	assert.Equal(t, 35_000_000.0, m.GrossMargin)
+	assert.Equal(t, 35.0, m.GrossMarginPercent)
+	assert.Equal(t, 50_000_000.0, m.EffectiveBillingRate)
+
+	empty := Summarize(model.ProjectProfitabilityMonth{SalaryCost: 10})
+	assert.Equal(t, -10.0, empty.GrossMargin)
+	assert.Equal(t, 0.0, empty.GrossMarginPercent)
+	assert.Equal(t, 0.0, empty.EffectiveBillingRate)
+}
+
+func TestTotal(t *testing.T) {
+	total := Total([]model.ProjectProfitabilityMonth{
+		Summarize(model.ProjectProfitabilityMonth{Month: *date(2026, 8, 1), Revenue: 100, SalaryCost: 60, BillableFTE: 1}),
+		Summarize(model.ProjectProfitabilityMonth{Month: *date(2026, 9, 1), Revenue: 300, SalaryCost: 120, BillableFTE: 3}),
+	})
+	assert.Equal(t, *date(2026, 8, 1), total.Month)
+	assert.Equal(t, 400.0, total.Revenue)
+	assert.Equal(t, 220.0, total.GrossMargin)
+	assert.Equal(t, 55.0, total.GrossMarginPercent)
+	assert.Equal(t, 2.0, total.BillableFTE)
+	assert.Equal(t, 100.0, total.EffectiveBillingRate)
+}
+
+func TestTrend(t *testing.T) {
+	months := func(percents ...float64) []model.ProjectProfitabilityMonth {
+		rs := make([]model.ProjectProfitabilityMonth, 0, len(percents))
+		for _, p := range percents {
+			m := model.ProjectProfitabilityMonth{GrossMarginPercent: p}
+			if p != 0 {
+				m.Revenue = 1
+			}
+			rs = append(rs, m)
+		}
+		return rs
+	}
+
+	up := Trend(months(20, 25, 30))
+	require.Equal(t, model.ProfitabilityTrendUp, up.Direction)
+	assert.Equal(t, 5.0, up.MarginSlope)
+	assert.Equal(t, 10.0, up.MarginChange)
+
+	down := Trend(months(40, 0, 30, 20)) // the month without revenue is left out
+	assert.Equal(t, model.ProfitabilityTrendDown, down.Direction)
+	assert.Equal(t, -20.0, down.MarginChange)
+
+	assert.Equal(t, model.ProfitabilityTrendFlat, Trend(months(30, 30.5, 30)).Direction)
+	assert.Equal(t, model.ProfitabilityTrendFlat, Trend(months(30)).Direction)
+}
@@ -0,0 +1,180 @@
+// Package recruitment holds the rules of the hiring pipeline: which stages a candidate goes
+// through, which moves are allowed between them, and how the interview scorecards add up
+package recruitment
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils"
+)
+
+const (
+	// MinScore and MaxScore bound the score of each criterion of a scorecard
+	MinScore = 1
+	MaxScore = 5
+
+	startDateLayout = "02 Jan 2006"
+)
+
+// EmailTemplates are the templates a stage can send to the candidates entering it
+var EmailTemplates = []string{
+	"candidate_inform.tpl",
+	"hiring_offered.tpl",
+	"hiring_failed.tpl",
+}
+
+var (
+	ErrStageNotInPipeline = errors.New("stage is not in the pipeline of the candidate position")
+	ErrSameStage          = errors.New("candidate is already in this stage")
+	ErrTerminalStage      = errors.New("candidate is in a final stage and can not be moved")
+	ErrNotOffered         = errors.New("candidate must be offered before being hired")
+	ErrEmptyScorecard     = errors.New("scorecard has no score")
+	ErrInvalidScore       = errors.New("score must be between 1 and 5")
+)
+
+// IsEmailTemplate tells whether a stage can send the template
+func IsEmailTemplate(name string) bool {
+	for _, t := range EmailTemplates {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Pipeline returns the stages a candidate of the position goes through, in order: the stages
+// of the position when it has some, the default ones otherwise
+func Pipeline(stages []*model.RecruitmentStage, positionID *model.UUID) []*model.RecruitmentStage {
+	var own, defaults []*model.RecruitmentStage
+	for _, s := range stages {
+		switch {
+		case s.PositionID == nil:
+			defaults = append(defaults, s)
+		case positionID != nil && *s.PositionID == *positionID:
+			own = append(own, s)
+		}
+	}
+
+	rs := defaults
+	if len(own) > 0 {
+		rs = own
+	}
+	sort.SliceStable(rs, func(i, j int) bool {
+		return rs[i].SortOrder < rs[j].SortOrder
+	})
+	return rs
+}
+
+// FirstStage is the stage the new candidates start in, the first one not final
+func FirstStage(pipeline []*model.RecruitmentStage) *model.RecruitmentStage {
+	for _, s := range pipeline {
+		if !s.IsTerminal {
+			return s
+		}
+	}
+	return nil
+}
+
+// CheckTransition tells whether a candidate can move from a stage to another of its pipeline.
+// The candidates can go back and forth between the open stages, but the final ones, hired,
+// failed or rejected, are kept, and only an offered candidate can be hired.
+func CheckTransition(pipeline []*model.RecruitmentStage, from *model.RecruitmentStage, to *model.RecruitmentStage) error {
+	if !contains(pipeline, to) {
+		return ErrStageNotInPipeline
+	}
+	if from == nil {
+		return nil
+	}
+	if from.ID == to.ID {
+		return ErrSameStage
+	}
+	if from.IsTerminal {
+		return ErrTerminalStage
+	}
+	if to.CandidateStatus == model.HiredCandidateStatus && from.CandidateStatus != model.OfferedCandidateStatus {
+		return ErrNotOffered
+	}
+	return nil
+}
+
+// OverallScore is the average of the criteria scores of a scorecard, rounded to 2 decimals
+func OverallScore(scores map[string]int) (float64, error) {
+	if len(scores) == 0 {
+		return 0, ErrEmptyScorecard
+	}
+
+	var total int
+	for criterion, score := range scores {
+		if strings.TrimSpace(criterion) == "" {
+			return 0, ErrEmptyScorecard
+		}
+		if score < MinScore || score > MaxScore {
+			return 0, ErrInvalidScore
+		}
+		total += score
+	}
+
+	return math.Round(float64(total)/float64(len(scores))*100) / 100, nil
+}
+
+// Summary sums the scorecards of a candidate up
+type Summary struct {
+	Interviews      int
+	Submitted       int
+	AverageScore    *float64
+	Recommendations map[model.InterviewRecommendation]int
+}
+
+// Summarize averages the submitted scorecards, the pending interviews are only counted
+func Summarize(interviews []model.CandidateInterview) Summary {
+	rs := Summary{
+		Interviews:      len(interviews),
+		Recommendations: map[model.InterviewRecommendation]int{},
+	}
+
+	var total float64
+	for _, i := range interviews {
+		if !i.IsSubmitted() || i.OverallScore == nil {
+			continue
+		}
+		rs.Submitted++
+		total += *i.OverallScore
+		if i.Recommendation != "" {
+			rs.Recommendations[i.Recommendation]++
+		}
+	}
+
+	if rs.Submitted > 0 {
+		avg := math.Round(total/float64(rs.Submitted)*100) / 100
+		rs.AverageScore = &avg
+	}
+	return rs
+}
+
+// PrepareMail fills the fields the hiring templates display
+func PrepareMail(c *model.Candidate) {
+	c.GroupRole = model.GroupRole(c.Role)
+	c.DisplayName = model.DisplayName(strings.TrimSpace(c.Name))
+	if c.OfferSalary > 0 {
+		c.DisplaySalary = utils.FormatNumber(int64(c.OfferSalary))
+	}
+	if c.OfferStartDate != nil {
+		c.DisplayStartDate = c.OfferStartDate.Format(startDateLayout)
+	}
+}
+
+func contains(pipeline []*model.RecruitmentStage, stage *model.RecruitmentStage) bool {
+	if stage == nil {
+		return false
+	}
+	for _, s := range pipeline {
+		if s.ID == stage.ID {
+			return true
+		}
+	}
+	return false
+}
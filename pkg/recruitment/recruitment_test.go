@@ -0,0 +1,132 @@
+package recruitment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func stage(code string, order int, status model.CandidateStatus, terminal bool, positionID *model.UUID) *model.RecruitmentStage {
+	return &model.RecruitmentStage{
+		BaseModel:       model.BaseModel{ID: model.NewUUID()},
+		PositionID:      positionID,
+		Code:            code,
+		SortOrder:       order,
+		CandidateStatus: status,
+		IsTerminal:      terminal,
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	backend, frontend := model.NewUUID(), model.NewUUID()
+
+	applied := stage("applied", 1, model.ApproachCandidateStatus, false, nil)
+	hired := stage("hired", 3, model.HiredCandidateStatus, true, nil)
+	offered := stage("offered", 2, model.OfferedCandidateStatus, false, nil)
+	challenge := stage("challenge", 2, model.ApproachCandidateStatus, false, &backend)
+	screening := stage("screening", 1, model.ApproachCandidateStatus, false, &backend)
+	stages := []*model.RecruitmentStage{applied, hired, offered, challenge, screening}
+
+	assert.Equal(t, []*model.RecruitmentStage{screening, challenge}, Pipeline(stages, &backend))
+	assert.Equal(t, []*model.RecruitmentStage{applied, offered, hired}, Pipeline(stages, &frontend))
+	assert.Equal(t, []*model.RecruitmentStage{applied, offered, hired}, Pipeline(stages, nil))
+}
+
+func TestFirstStage(t *testing.T) {
+	failed := stage("failed", 0, model.FailedCandidateStatus, true, nil)
+	applied := stage("applied", 1, model.ApproachCandidateStatus, false, nil)
+
+	assert.Equal(t, applied, FirstStage([]*model.RecruitmentStage{failed, applied}))
+	assert.Nil(t, FirstStage([]*model.RecruitmentStage{failed}))
+}
+
+func TestCheckTransition(t *testing.T) {
+	applied := stage("applied", 1, model.ApproachCandidateStatus, false, nil)
+	interview := stage("interview", 2, model.ApproachCandidateStatus, false, nil)
+	offered := stage("offered", 3, model.OfferedCandidateStatus, false, nil)
+	hired := stage("hired", 4, model.HiredCandidateStatus, true, nil)
+	failed := stage("failed", 5, model.FailedCandidateStatus, true, nil)
+	pipeline := []*model.RecruitmentStage{applied, interview, offered, hired, failed}
+
+	other := model.NewUUID()
+	elsewhere := stage("screening", 1, model.ApproachCandidateStatus, false, &other)
+
+	tests := []struct {
+		name     string
+		from, to *model.RecruitmentStage
+		err      error
+	}{
+		{"enter the pipeline", nil, applied, nil},
+		{"move forward", applied, interview, nil},
+		{"move back", offered, interview, nil},
+		{"fail from any open stage", applied, failed, nil},
+		{"hire an offered candidate", offered, hired, nil},
+		{"hire without offer", interview, hired, ErrNotOffered},
+		{"same stage", interview, interview, ErrSameStage},
+		{"leave a final stage", failed, applied, ErrTerminalStage},
+		{"stage of another position", applied, elsewhere, ErrStageNotInPipeline},
+		{"no stage", applied, nil, ErrStageNotInPipeline},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, CheckTransition(pipeline, tt.from, tt.to))
+		})
+	}
+}
+
+func TestOverallScore(t *testing.T) {
+	score, err := OverallScore(map[string]int{"coding": 4, "communication": 3, "culture": 4})
+	require.NoError(t, err)
+	assert.Equal(t, 3.67, score)
+
+	_, err = OverallScore(nil)
+	assert.Equal(t, ErrEmptyScorecard, err)
+
+	_, err = OverallScore(map[string]int{" ": 3})
+	assert.Equal(t, ErrEmptyScorecard, err)
+
+	_, err = OverallScore(map[string]int{"coding": 6})
+	assert.Equal(t, ErrInvalidScore, err)
+
+	_, err = OverallScore(map[string]int{"coding": 0})
+	assert.Equal(t, ErrInvalidScore, err)
+}
+
+func TestSummarize(t *testing.T) {
+	now := time.Now()
+	score := func(v float64) *float64 { return &v }
+
+	s := Summarize([]model.CandidateInterview{
+		{OverallScore: score(4), Recommendation: model.InterviewRecommendationYes, SubmittedAt: &now},
+		{OverallScore: score(3.5), Recommendation: model.InterviewRecommendationYes, SubmittedAt: &now},
+		{OverallScore: score(2), Recommendation: model.InterviewRecommendationStrongNo, SubmittedAt: &now},
+		{},
+	})
+
+	assert.Equal(t, 4, s.Interviews)
+	assert.Equal(t, 3, s.Submitted)
+	require.NotNil(t, s.AverageScore)
+	assert.Equal(t, 3.17, *s.AverageScore)
+	assert.Equal(t, map[model.InterviewRecommendation]int{
+		model.InterviewRecommendationYes:      2,
+		model.InterviewRecommendationStrongNo: 1,
+	}, s.Recommendations)
+
+	assert.Nil(t, Summarize([]model.CandidateInterview{{}}).AverageScore)
+}
+
+func TestPrepareMail(t *testing.T) {
+	start := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	c := &model.Candidate{Name: "Nguyen Van An ", Role: "Golang", OfferSalary: 15000000, OfferStartDate: &start}
+
+	PrepareMail(c)
+
+	assert.Equal(t, "Backend", c.GroupRole)
+	assert.Equal(t, "An", c.DisplayName)
+	assert.Equal(t, "15,000,000", c.DisplaySalary)
+	assert.Equal(t, "02 Nov 2026", c.DisplayStartDate)
+}
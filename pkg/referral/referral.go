@@ -0,0 +1,139 @@
+// Package referral tells which bonus milestones of the referral program a referred hire has
+// reached, from the join date, the probation and the tenure of the hire
+package referral
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// DefaultProbationMonths is the probation of the hires whose offer does not tell it
+const DefaultProbationMonths = 2
+
+// Hire is the employment of a referred candidate
+type Hire struct {
+	JoinedDate      *time.Time
+	LeftDate        *time.Time
+	WorkingStatus   model.WorkingStatus
+	ProbationMonths int
+	// ProbationPassedAt is kept once the probation is passed, the working status of a hire
+	// who left no longer tells it
+	ProbationPassedAt *time.Time
+}
+
+// Reached is a milestone reached on a date
+type Reached struct {
+	Milestone *model.ReferralBonusMilestone
+	At        time.Time
+}
+
+// Progress returns the active milestones the hire has reached at now, in order, and the status
+// of the referral. A hire who left keeps the milestones reached before leaving.
+func Progress(milestones []*model.ReferralBonusMilestone, hire Hire, now time.Time) ([]Reached, model.ReferralStatus) {
+	if hire.JoinedDate == nil {
+		return nil, model.ReferralStatusOffered
+	}
+
+	today := truncateDay(now)
+	joined := truncateDay(*hire.JoinedDate)
+	var left *time.Time
+	if hire.LeftDate != nil {
+		d := truncateDay(*hire.LeftDate)
+		left = &d
+	}
+	stayedUntil := func(d time.Time) bool {
+		return !d.After(today) && (left == nil || !left.Before(d))
+	}
+
+	probationPassedAt := ProbationPassedAt(hire, now)
+
+	active := make([]*model.ReferralBonusMilestone, 0, len(milestones))
+	for _, m := range milestones {
+		if m.IsActive {
+			active = append(active, m)
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].SortOrder < active[j].SortOrder
+	})
+
+	var reached []Reached
+	for _, m := range active {
+		switch m.Trigger {
+		case model.ReferralMilestoneTriggerHired:
+			if stayedUntil(joined) {
+				reached = append(reached, Reached{Milestone: m, At: joined})
+			}
+		case model.ReferralMilestoneTriggerProbation:
+			if probationPassedAt != nil {
+				reached = append(reached, Reached{Milestone: m, At: *probationPassedAt})
+			}
+		case model.ReferralMilestoneTriggerTenure:
+			d := joined.AddDate(0, m.TenureMonths, 0)
+			if stayedUntil(d) {
+				reached = append(reached, Reached{Milestone: m, At: d})
+			}
+		}
+	}
+
+	switch {
+	case len(active) > 0 && len(reached) == len(active):
+		return reached, model.ReferralStatusCompleted
+	case left != nil && !left.After(today):
+		return reached, model.ReferralStatusLeft
+	case probationPassedAt != nil:
+		return reached, model.ReferralStatusProbationPassed
+	case !joined.After(today):
+		return reached, model.ReferralStatusHired
+	}
+	return reached, model.ReferralStatusOffered
+}
+
+// ProbationPassedAt is the day the hire passed the probation, nil while on probation. A hire
+// made full-time before the end of the probation passed it on the day it is noticed.
+func ProbationPassedAt(hire Hire, now time.Time) *time.Time {
+	if hire.ProbationPassedAt != nil {
+		d := truncateDay(*hire.ProbationPassedAt)
+		return &d
+	}
+	if hire.JoinedDate == nil || hire.WorkingStatus != model.WorkingStatusFullTime {
+		return nil
+	}
+
+	months := hire.ProbationMonths
+	if months <= 0 {
+		months = DefaultProbationMonths
+	}
+
+	d := truncateDay(hire.JoinedDate.AddDate(0, months, 0))
+	if today := truncateDay(now); d.After(today) {
+		d = today
+	}
+	return &d
+}
+
+// StatusFromCandidate is the status of the referral of a candidate not hired yet
+func StatusFromCandidate(status model.CandidateStatus) model.ReferralStatus {
+	switch status {
+	case model.OfferedCandidateStatus:
+		return model.ReferralStatusOffered
+	case model.HiredCandidateStatus:
+		return model.ReferralStatusHired
+	case model.FailedCandidateStatus, model.RejectCandidateStatus:
+		return model.ReferralStatusFailed
+	}
+	return model.ReferralStatusReferred
+}
+
+// BonusName is the name of the employee bonus paying a milestone, shown on the payslip.
+// It tells the bonuses of a payroll apart, it has to be unique per referral and milestone.
+func BonusName(candidateName string, milestone *model.ReferralBonusMilestone) string {
+	return fmt.Sprintf("Referral bonus - %s - %s", candidateName, milestone.Name)
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,158 @@
+package referral
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func date(y int, m time.Month, d int) *time.Time {
+	t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func milestone(code string, trigger model.ReferralMilestoneTrigger, tenure int, order int) *model.ReferralBonusMilestone {
+	return &model.ReferralBonusMilestone{
+		Code:         code,
+		Name:         code,
+		Trigger:      trigger,
+		TenureMonths: tenure,
+		Amount:       5000000,
+		IsActive:     true,
+		SortOrder:    order,
+	}
+}
+
+func codes(reached []Reached) map[string]time.Time {
+	rs := map[string]time.Time{}
+	for _, r := range reached {
+		rs[r.Milestone.Code] = r.At
+	}
+	return rs
+}
+
+func TestProgress(t *testing.T) {
+	hired := milestone("hired", model.ReferralMilestoneTriggerHired, 0, 1)
+	probation := milestone("probation", model.ReferralMilestoneTriggerProbation, 0, 2)
+	tenure := milestone("tenure", model.ReferralMilestoneTriggerTenure, 6, 3)
+	inactive := milestone("inactive", model.ReferralMilestoneTriggerTenure, 12, 4)
+	inactive.IsActive = false
+	milestones := []*model.ReferralBonusMilestone{tenure, probation, hired, inactive}
+
+	tests := []struct {
+		name     string
+		hire     Hire
+		now      time.Time
+		expected map[string]time.Time
+		status   model.ReferralStatus
+	}{
+		{
+			name:     "not joined yet",
+			hire:     Hire{JoinedDate: date(2026, 11, 2), WorkingStatus: model.WorkingStatusOnBoarding},
+			now:      *date(2026, 10, 20),
+			expected: map[string]time.Time{},
+			status:   model.ReferralStatusOffered,
+		},
+		{
+			name:     "on probation",
+			hire:     Hire{JoinedDate: date(2026, 3, 1), WorkingStatus: model.WorkingStatusProbation},
+			now:      *date(2026, 6, 1),
+			expected: map[string]time.Time{"hired": *date(2026, 3, 1)},
+			status:   model.ReferralStatusHired,
+		},
+		{
+			name: "passed probation",
+			hire: Hire{JoinedDate: date(2026, 3, 1), WorkingStatus: model.WorkingStatusFullTime, ProbationMonths: 2},
+			now:  *date(2026, 6, 1),
+			expected: map[string]time.Time{
+				"hired":     *date(2026, 3, 1),
+				"probation": *date(2026, 5, 1),
+			},
+			status: model.ReferralStatusProbationPassed,
+		},
+		{
+			name: "made full-time early",
+			hire: Hire{JoinedDate: date(2026, 3, 1), WorkingStatus: model.WorkingStatusFullTime, ProbationMonths: 3},
+			now:  *date(2026, 4, 15),
+			expected: map[string]time.Time{
+				"hired":     *date(2026, 3, 1),
+				"probation": *date(2026, 4, 15),
+			},
+			status: model.ReferralStatusProbationPassed,
+		},
+		{
+			name: "every milestone reached",
+			hire: Hire{JoinedDate: date(2026, 1, 31), WorkingStatus: model.WorkingStatusFullTime},
+			now:  *date(2026, 10, 1),
+			expected: map[string]time.Time{
+				"hired":     *date(2026, 1, 31),
+				"probation": *date(2026, 3, 31),
+				"tenure":    *date(2026, 7, 31),
+			},
+			status: model.ReferralStatusCompleted,
+		},
+		{
+			name: "left before the tenure",
+			hire: Hire{
+				JoinedDate:        date(2026, 1, 5),
+				LeftDate:          date(2026, 5, 20),
+				WorkingStatus:     model.WorkingStatusLeft,
+				ProbationPassedAt: date(2026, 3, 5),
+			},
+			now: *date(2026, 10, 1),
+			expected: map[string]time.Time{
+				"hired":     *date(2026, 1, 5),
+				"probation": *date(2026, 3, 5),
+			},
+			status: model.ReferralStatusLeft,
+		},
+		{
+			name:     "left during probation",
+			hire:     Hire{JoinedDate: date(2026, 1, 5), LeftDate: date(2026, 2, 1), WorkingStatus: model.WorkingStatusLeft},
+			now:      *date(2026, 10, 1),
+			expected: map[string]time.Time{"hired": *date(2026, 1, 5)},
+			status:   model.ReferralStatusLeft,
+		},
+		{
+			name: "leaving on the tenure day",
+			hire: Hire{
+				JoinedDate:        date(2026, 1, 5),
+				LeftDate:          date(2026, 7, 5),
+				WorkingStatus:     model.WorkingStatusLeft,
+				ProbationPassedAt: date(2026, 3, 5),
+			},
+			now: *date(2026, 10, 1),
+			expected: map[string]time.Time{
+				"hired":     *date(2026, 1, 5),
+				"probation": *date(2026, 3, 5),
+				"tenure":    *date(2026, 7, 5),
+			},
+			status: model.ReferralStatusCompleted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reached, status := Progress(milestones, tt.hire, tt.now)
+			assert.Equal(t, tt.expected, codes(reached))
+			assert.Equal(t, tt.status, status)
+		})
+	}
+
+	t.Run("milestones in order", func(t *testing.T) {
+		reached, _ := Progress(milestones, Hire{JoinedDate: date(2025, 1, 1), WorkingStatus: model.WorkingStatusFullTime}, *date(2026, 1, 1))
+		require.Len(t, reached, 3)
+		assert.Equal(t, []string{"hired", "probation", "tenure"}, []string{reached[0].Milestone.Code, reached[1].Milestone.Code, reached[2].Milestone.Code})
+	})
+}
+
+func TestStatusFromCandidate(t *testing.T) {
+	assert.Equal(t, model.ReferralStatusReferred, StatusFromCandidate(model.ApproachCandidateStatus))
+	assert.Equal(t, model.ReferralStatusOffered, StatusFromCandidate(model.OfferedCandidateStatus))
+	assert.Equal(t, model.ReferralStatusHired, StatusFromCandidate(model.HiredCandidateStatus))
+	assert.Equal(t, model.ReferralStatusFailed, StatusFromCandidate(model.FailedCandidateStatus))
+	assert.Equal(t, model.ReferralStatusFailed, StatusFromCandidate(model.RejectCandidateStatus))
+}
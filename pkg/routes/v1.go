@@ -17,6 +17,7 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 
 	pmw := mw.NewPermissionMiddleware(s, repo, cfg)
 	amw := mw.NewAuthMiddleware(cfg, s, repo)
+	cpmw := mw.NewClientPortalMiddleware(cfg, s, repo)
 
 	// Define conditional middleware based on environment
 	var conditionalAuthMW gin.HandlerFunc
@@ -47,12 +48,24 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		cronjob.POST("/sync-project-member-status", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Project.SyncProjectMemberStatus)
 		cronjob.POST("/store-vault-transaction", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Vault.StoreVaultTransaction)
 		cronjob.POST("/index-engagement-messages", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Engagement.IndexMessages)
+		cronjob.POST("/engagement-analytics", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Engagement.AggregateAnalytics)
 		cronjob.POST("/brainery-reports", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Discord.ReportBraineryMetrics)
 		cronjob.POST("/delivery-metric-reports", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Discord.DeliveryMetricsReport)
+		cronjob.POST("/delivery-leader-boards", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Discord.DeliveryLeaderBoardsReport)
 		cronjob.POST("/sync-delivery-metrics", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsSync), h.DeliveryMetric.Sync)
 		cronjob.POST("/sync-conversion-rates", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.ConversionRate.Sync)
+		cronjob.POST("/sync-fx-rates", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.FxRate.Sync)
+		cronjob.POST("/post-ledger-entries", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Ledger.PostUnposted)
+		cronjob.POST("/snapshot-cash-flow-forecasts", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.CashFlow.SnapshotForecasts)
+		cronjob.POST("/referral-bonuses", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Referral.ProcessMilestones)
+		cronjob.POST("/asset-depreciation", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Asset.Depreciate)
+		cronjob.POST("/operational-service-renewals", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.OperationalService.RemindRenewals)
+		cronjob.POST("/contract-expiries", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Contract.NotifyExpiring)
+		cronjob.POST("/project-budget-burns", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.ProjectBudget.AlertBurns)
+		cronjob.POST("/icy-treasury-snapshots", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Icy.SnapshotTreasury)
 		cronjob.POST("/sync-memo", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Discord.SyncMemo)
 		cronjob.POST("/sweep-memo", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Discord.SweepMemo)
+		cronjob.POST("/ingest-memos", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.MemoLog.Ingest)
 		cronjob.POST("/notify-weekly-memos", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Discord.NotifyWeeklyMemos)
 		cronjob.POST("/notify-monthly-memos", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Discord.NotifyMonthlyMemos)
 		cronjob.POST("/notify-top-memo-authors", conditionalAuthMW, conditionalPermMW(model.PermissionCronjobExecute), h.Discord.NotifyTopMemoAuthors)
@@ -119,6 +132,12 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 	assetGroup := v1.Group("/assets")
 	{
 		assetGroup.POST("/upload", conditionalAuthMW, h.Asset.Upload)
+		assetGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionAssetsRead), h.Asset.List)
+		assetGroup.POST("", conditionalAuthMW, conditionalPermMW(model.PermissionAssetsEdit), h.Asset.Create)
+		assetGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionAssetsRead), h.Asset.Get)
+		assetGroup.PUT("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionAssetsEdit), h.Asset.Update)
+		assetGroup.POST("/:id/assign", conditionalAuthMW, conditionalPermMW(model.PermissionAssetsEdit), h.Asset.Assign)
+		assetGroup.POST("/:id/return", conditionalAuthMW, conditionalPermMW(model.PermissionAssetsEdit), h.Asset.Return)
 	}
 
 	lineManagerGroup := v1.Group("/line-managers")
@@ -141,6 +160,7 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		profileGroup.PUT("", conditionalAuthMW, h.Profile.UpdateInfo)
 		profileGroup.POST("/upload-avatar", conditionalAuthMW, h.Profile.UploadAvatar)
 		profileGroup.POST("/upload", conditionalAuthMW, h.Profile.Upload)
+		profileGroup.GET("/referrals", conditionalAuthMW, h.Referral.ListMyReferrals)
 	}
 
 	// employees
@@ -196,6 +216,7 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		projectGroup.PUT("/:id/members", conditionalAuthMW, conditionalPermMW(model.PermissionProjectMembersEdit), h.Project.UpdateMember)
 		projectGroup.DELETE("/:id/members/:memberID", conditionalAuthMW, conditionalPermMW(model.PermissionProjectMembersDelete), h.Project.DeleteMember)
 		projectGroup.DELETE("/:id/slots/:slotID", conditionalAuthMW, conditionalPermMW(model.PermissionProjectMembersDelete), h.Project.DeleteSlot)
+		projectGroup.GET("/:id/slots/:slotID/recommendations", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsStaffingRead), h.Staffing.Recommend)
 		projectGroup.PUT("/:id/general-info", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsEdit), h.Project.UpdateGeneralInfo)
 		projectGroup.PUT("/:id/contact-info", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsEdit), h.Project.UpdateContactInfo)
 		projectGroup.GET("/:id/work-units", conditionalAuthMW, conditionalPermMW(model.PermissionProjectWorkUnitsRead), h.Project.GetWorkUnits)
@@ -205,6 +226,26 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		projectGroup.PUT("/:id/work-units/:workUnitID/unarchive", conditionalAuthMW, conditionalPermMW(model.PermissionProjectWorkUnitsEdit), h.Project.UnarchiveWorkUnit)
 		projectGroup.GET("/:id/commission-models", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsCommissionModelsRead), h.Project.CommissionModels)
 		projectGroup.GET("/icy-distribution/weekly", conditionalAuthMW, conditionalPermMW(model.PermissionIcyDistributionRead), h.Project.IcyWeeklyDistribution)
+		projectGroup.GET("/profitability", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsProfitabilityRead), h.Profitability.Report)
+		projectGroup.GET("/:id/profitability", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsProfitabilityRead), h.Profitability.ProjectReport)
+		projectGroup.GET("/:id/budgets", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsBudgetsRead), h.ProjectBudget.List)
+		projectGroup.POST("/:id/budgets", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsBudgetsEdit), h.ProjectBudget.Create)
+		projectGroup.GET("/:id/budgets/:budgetID", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsBudgetsRead), h.ProjectBudget.Get)
+		projectGroup.PUT("/:id/budgets/:budgetID", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsBudgetsEdit), h.ProjectBudget.Update)
+		projectGroup.PUT("/:id/budgets/:budgetID/milestones", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsBudgetsEdit), h.ProjectBudget.SetMilestones)
+		projectGroup.GET("/:id/budgets/:budgetID/burn-down", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsBudgetsRead), h.ProjectBudget.Report)
+	}
+
+	// client portal, logged in with client contact tokens instead of employee ones
+	clientPortalGroup := v1.Group("/client-portal")
+	{
+		clientPortalGroup.POST("/auth", h.ClientPortal.Login)
+		clientPortalGroup.GET("/me", cpmw.WithClientAuth, h.ClientPortal.Me)
+		clientPortalGroup.GET("/invoices", cpmw.WithClientAuth, h.ClientPortal.ListInvoices)
+		clientPortalGroup.GET("/invoices/:id", cpmw.WithClientAuth, h.ClientPortal.GetInvoice)
+		clientPortalGroup.GET("/invoices/:id/pdf", cpmw.WithClientAuth, h.ClientPortal.DownloadInvoicePDF)
+		clientPortalGroup.GET("/invoices/:id/receipt", cpmw.WithClientAuth, h.ClientPortal.GetReceipt)
+		clientPortalGroup.GET("/team", cpmw.WithClientAuth, h.ClientPortal.GetTeam)
 	}
 
 	clientGroup := v1.Group("/clients")
@@ -214,6 +255,54 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		clientGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionClientEdit), h.Client.Detail)
 		clientGroup.PUT("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionClientRead), h.Client.Update)
 		clientGroup.DELETE("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionClientDelete), h.Client.Delete)
+		clientGroup.GET("/:id/portal-accesses", conditionalAuthMW, conditionalPermMW(model.PermissionClientPortalRead), h.ClientPortal.ListAccesses)
+		clientGroup.POST("/:id/portal-accesses", conditionalAuthMW, conditionalPermMW(model.PermissionClientPortalEdit), h.ClientPortal.GrantAccess)
+		clientGroup.DELETE("/:id/portal-accesses/:accessID", conditionalAuthMW, conditionalPermMW(model.PermissionClientPortalEdit), h.ClientPortal.RevokeAccess)
+	}
+
+	contractGroup := v1.Group("/contracts")
+	{
+		contractGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionContractsRead), h.Contract.List)
+		contractGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionContractsRead), h.Contract.Get)
+		contractGroup.POST("", conditionalAuthMW, conditionalPermMW(model.PermissionContractsEdit), h.Contract.Create)
+		contractGroup.PUT("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionContractsEdit), h.Contract.Update)
+		contractGroup.PUT("/:id/rates", conditionalAuthMW, conditionalPermMW(model.PermissionContractsEdit), h.Contract.SetRates)
+		contractGroup.POST("/:id/attachments", conditionalAuthMW, conditionalPermMW(model.PermissionContractsEdit), h.Contract.UploadAttachment)
+	}
+
+	icyRewardGroup := v1.Group("/icy-rewards")
+	{
+		icyRewardGroup.GET("/rules", conditionalAuthMW, conditionalPermMW(model.PermissionIcyRewardsRead), h.IcyReward.ListRules)
+		icyRewardGroup.POST("/rules", conditionalAuthMW, conditionalPermMW(model.PermissionIcyRewardsEdit), h.IcyReward.CreateRule)
+		icyRewardGroup.PUT("/rules/:id", conditionalAuthMW, conditionalPermMW(model.PermissionIcyRewardsEdit), h.IcyReward.UpdateRule)
+		icyRewardGroup.POST("/events", conditionalAuthMW, conditionalPermMW(model.PermissionIcyRewardsTrigger), h.IcyReward.Trigger)
+		icyRewardGroup.GET("/payouts", conditionalAuthMW, conditionalPermMW(model.PermissionIcyRewardsRead), h.IcyReward.ListPayouts)
+		icyRewardGroup.POST("/payouts/:id/approve", conditionalAuthMW, conditionalPermMW(model.PermissionIcyRewardsEdit), h.IcyReward.Approve)
+		icyRewardGroup.POST("/payouts/:id/reject", conditionalAuthMW, conditionalPermMW(model.PermissionIcyRewardsEdit), h.IcyReward.Reject)
+		icyRewardGroup.GET("/budget", conditionalAuthMW, conditionalPermMW(model.PermissionIcyRewardsRead), h.IcyReward.Budget)
+	}
+
+	icyTreasuryGroup := v1.Group("/icy-treasury")
+	{
+		icyTreasuryGroup.GET("/reconciliation", conditionalAuthMW, conditionalPermMW(model.PermissionIcyTreasuryRead), h.Icy.TreasuryReconciliation)
+		icyTreasuryGroup.GET("/snapshots/:id", conditionalAuthMW, conditionalPermMW(model.PermissionIcyTreasuryRead), h.Icy.GetTreasurySnapshot)
+	}
+
+	officeLocationGroup := v1.Group("/office-locations")
+	{
+		officeLocationGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeLocationsRead), h.OfficeCheckin.ListLocations)
+		officeLocationGroup.POST("", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeLocationsEdit), h.OfficeCheckin.CreateLocation)
+		officeLocationGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeLocationsRead), h.OfficeCheckin.GetLocation)
+		officeLocationGroup.PUT("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeLocationsEdit), h.OfficeCheckin.UpdateLocation)
+		officeLocationGroup.GET("/:id/qr-code", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeLocationsQRCode), h.OfficeCheckin.QRCode)
+	}
+
+	officeCheckinGroup := v1.Group("/office-checkins")
+	{
+		officeCheckinGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeCheckinsCreate), h.OfficeCheckin.List)
+		officeCheckinGroup.POST("/check-in", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeCheckinsCreate), h.OfficeCheckin.CheckIn)
+		officeCheckinGroup.POST("/check-out", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeCheckinsCreate), h.OfficeCheckin.CheckOut)
+		officeCheckinGroup.GET("/attendance", conditionalAuthMW, conditionalPermMW(model.PermissionOfficeCheckinsRead), h.OfficeCheckin.Attendance)
 	}
 
 	feedbackGroup := v1.Group("/feedbacks")
@@ -228,7 +317,11 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 	{
 		surveyGroup.POST("", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysCreate), h.Survey.CreateSurvey)
 		surveyGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysRead), h.Survey.ListSurvey)
+		surveyGroup.GET("/trends", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysRead), h.SurveyAnalytics.GetTrends)
 		surveyGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysRead), h.Survey.GetSurveyDetail)
+		surveyGroup.GET("/:id/analytics", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysRead), h.SurveyAnalytics.GetAnalytics)
+		surveyGroup.GET("/:id/theme-summaries", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysRead), h.SurveyAnalytics.ListThemeSummaries)
+		surveyGroup.POST("/:id/theme-summaries", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysEdit), h.SurveyAnalytics.SummarizeThemes)
 		surveyGroup.DELETE("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysDelete), h.Survey.DeleteSurvey)
 		surveyGroup.POST("/:id/send", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysCreate), h.Survey.SendSurvey)
 		surveyGroup.GET("/:id/topics/:topicID/reviews/:reviewID", conditionalAuthMW, conditionalPermMW(model.PermissionEmployeeEventQuestionsRead), h.Survey.GetSurveyReviewDetail)
@@ -239,6 +332,20 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		surveyGroup.DELETE("/:id/topics/:topicID/employees", conditionalAuthMW, conditionalPermMW(model.PermissionSurveysEdit), h.Survey.DeleteTopicReviewers)
 	}
 
+	reviewCycleGroup := v1.Group("/review-cycles")
+	{
+		reviewCycleGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesRead), h.ReviewCycle.List)
+		reviewCycleGroup.POST("", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesEdit), h.ReviewCycle.Create)
+		reviewCycleGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesRead), h.ReviewCycle.Get)
+		reviewCycleGroup.POST("/:id/start", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesEdit), h.ReviewCycle.Start)
+		reviewCycleGroup.POST("/:id/calibration", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesEdit), h.ReviewCycle.StartCalibration)
+		reviewCycleGroup.POST("/:id/close", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesApprove), h.ReviewCycle.Close)
+		reviewCycleGroup.GET("/:id/packets/:packetID", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesRead), h.ReviewCycle.GetPacket)
+		reviewCycleGroup.PUT("/:id/packets/:packetID/calibration", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesEdit), h.ReviewCycle.CalibratePacket)
+		reviewCycleGroup.POST("/:id/packets/:packetID/approve", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesApprove), h.ReviewCycle.ApprovePacket)
+		reviewCycleGroup.POST("/:id/packets/:packetID/reject", conditionalAuthMW, conditionalPermMW(model.PermissionReviewCyclesApprove), h.ReviewCycle.RejectPacket)
+	}
+
 	bankGroup := v1.Group("/bank-accounts")
 	{
 		bankGroup.GET("", conditionalPermMW(model.PermissionBankAccountRead), h.BankAccount.List)
@@ -255,6 +362,7 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		invoiceGroup.PUT("/:id/status", conditionalAuthMW, conditionalPermMW(model.PermissionInvoiceEdit), h.Invoice.UpdateStatus)
 		invoiceGroup.POST("/:id/calculate-commissions", conditionalAuthMW, conditionalPermMW(model.PermissionProjectsCommissionRateEdit), h.Invoice.CalculateCommissions)
 		invoiceGroup.GET("/template", conditionalAuthMW, conditionalPermMW(model.PermissionInvoiceRead), h.Invoice.GetTemplate)
+		invoiceGroup.GET("/timesheet-line-items", conditionalAuthMW, conditionalPermMW(model.PermissionInvoiceRead), h.Timesheet.GetInvoiceLineItems)
 		invoiceGroup.POST("/send", conditionalAuthMW, conditionalPermMW(model.PermissionInvoiceRead), h.Invoice.Send)
 		invoiceGroup.POST("/contractor/generate", conditionalAuthMW, conditionalPermMW(model.PermissionInvoiceCreate), h.Invoice.GenerateContractorInvoice)
 		invoiceGroup.POST("/mark-paid", conditionalAuthMW, conditionalPermMW(model.PermissionInvoiceEdit), h.Invoice.MarkPaid)
@@ -358,6 +466,8 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 			resourceDashboardGroup.GET("/work-unit-distribution", h.Dashboard.GetWorkUnitDistribution)
 			resourceDashboardGroup.GET("/work-unit-distribution-summary", h.Dashboard.GetWorkUnitDistributionSummary)
 			resourceDashboardGroup.GET("/work-survey-summaries", h.Dashboard.GetResourceWorkSurveySummaries)
+			resourceDashboardGroup.GET("/capacity-plan", h.Capacity.GetPlan)
+			resourceDashboardGroup.POST("/capacity-plan/what-if", h.Capacity.SimulatePlan)
 		}
 	}
 
@@ -396,6 +506,15 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 			conditionalPermMW(model.PermissionEngagementMetricsRead),
 			h.Engagement.GetLastMessageID,
 		)
+		engagementsGroup.GET("/analytics/trends", conditionalAuthMW, conditionalPermMW(model.PermissionEngagementAnalyticsRead), h.Engagement.Trends)
+		engagementsGroup.GET("/analytics/channels", conditionalAuthMW, conditionalPermMW(model.PermissionEngagementAnalyticsRead), h.Engagement.ChannelHealth)
+		engagementsGroup.GET("/analytics/quiet-members", conditionalAuthMW, conditionalPermMW(model.PermissionEngagementAnalyticsQuietMembersRead), h.Engagement.QuietMembers)
+	}
+
+	knowledgeBaseGroup := v1.Group("/knowledge-base")
+	{
+		knowledgeBaseGroup.GET("/search", conditionalAuthMW, conditionalPermMW(model.PermissionKnowledgeBaseRead), h.KnowledgeBase.Search)
+		knowledgeBaseGroup.POST("/reindex", conditionalAuthMW, conditionalPermMW(model.PermissionKnowledgeBaseEdit), h.KnowledgeBase.Reindex)
 	}
 
 	braineryGroup := v1.Group("/brainery-logs")
@@ -424,6 +543,17 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 	{
 		deliveryGroup := v1.Group("/delivery-metrics")
 		deliveryGroup.POST("/report/sync", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsSync), h.DeliveryMetric.Sync)
+		deliveryGroup.POST("/imports", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsSync), h.DeliveryMetric.Import)
+		deliveryGroup.GET("/imports", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsRead), h.DeliveryMetric.ListImports)
+		deliveryGroup.GET("/imports/:id", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsRead), h.DeliveryMetric.GetImport)
+		deliveryGroup.GET("/mappings", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsRead), h.DeliveryMetric.ListMappings)
+		deliveryGroup.POST("/mappings", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsSync), h.DeliveryMetric.SaveMapping)
+		deliveryGroup.DELETE("/mappings/:id", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsSync), h.DeliveryMetric.DeleteMapping)
+		deliveryGroup.GET("/leader-boards", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsLeaderBoardRead), h.DeliveryMetric.ListLeaderBoards)
+		deliveryGroup.POST("/leader-boards", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsSync), h.DeliveryMetric.CreateLeaderBoard)
+		deliveryGroup.GET("/leader-boards/:id", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsLeaderBoardRead), h.DeliveryMetric.GetLeaderBoard)
+		deliveryGroup.PUT("/leader-boards/:id", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsSync), h.DeliveryMetric.UpdateLeaderBoard)
+		deliveryGroup.DELETE("/leader-boards/:id", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsSync), h.DeliveryMetric.DeleteLeaderBoard)
 
 		deliveryGroup.GET("/report/weekly", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsRead), h.DeliveryMetric.GetWeeklyReport)
 		deliveryGroup.GET("/report/monthly", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsRead), h.DeliveryMetric.GetMonthlyReport)
@@ -433,6 +563,7 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		// API for fortress-discord
 		deliveryGroup.GET("/report/weekly/discord-msg", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsRead), h.DeliveryMetric.GetWeeklyReportDiscordMsg)
 		deliveryGroup.GET("/report/monthly/discord-msg", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsRead), h.DeliveryMetric.GetMonthlyReportDiscordMsg)
+		deliveryGroup.GET("/leader-boards/:id/discord-msg", conditionalAuthMW, conditionalPermMW(model.PermissionDeliveryMetricsLeaderBoardRead), h.DeliveryMetric.GetLeaderBoardDiscordMsg)
 	}
 
 	discordGroup := v1.Group("/discords")
@@ -465,6 +596,108 @@ func loadV1Routes(r *gin.Engine, h *handler.Handler, repo store.DBRepo, s *store
 		conversionRateGroup.GET("", conditionalAuthMW, h.ConversionRate.List)
 	}
 
+	fxRateGroup := v1.Group("/fx-rates")
+	{
+		fxRateGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionFxRatesRead), h.FxRate.List)
+		fxRateGroup.GET("/at", conditionalAuthMW, conditionalPermMW(model.PermissionFxRatesRead), h.FxRate.GetRateAt)
+		fxRateGroup.GET("/transactions/:type/:id", conditionalAuthMW, conditionalPermMW(model.PermissionFxRatesRead), h.FxRate.GetTransactionRate)
+		fxRateGroup.POST("/backfill", conditionalAuthMW, conditionalPermMW(model.PermissionFxRatesEdit), h.FxRate.Backfill)
+		fxRateGroup.POST("/manual", conditionalAuthMW, conditionalPermMW(model.PermissionFxRatesEdit), h.FxRate.SetManualRate)
+	}
+
+	ledgerGroup := v1.Group("/ledger")
+	{
+		ledgerGroup.GET("/accounts", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerRead), h.Ledger.ListAccounts)
+		ledgerGroup.POST("/accounts", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerEdit), h.Ledger.CreateAccount)
+		ledgerGroup.PUT("/accounts/:id", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerEdit), h.Ledger.UpdateAccount)
+		ledgerGroup.GET("/entries", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerRead), h.Ledger.ListEntries)
+		ledgerGroup.POST("/entries", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerEdit), h.Ledger.CreateEntry)
+		ledgerGroup.GET("/entries/:id", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerRead), h.Ledger.GetEntry)
+		ledgerGroup.POST("/entries/:id/reverse", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerEdit), h.Ledger.ReverseEntry)
+		ledgerGroup.GET("/trial-balance", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerRead), h.Ledger.TrialBalance)
+		ledgerGroup.GET("/profit-and-loss", conditionalAuthMW, conditionalPermMW(model.PermissionLedgerRead), h.Ledger.ProfitAndLoss)
+	}
+
+	bankStatementGroup := v1.Group("/bank-statements")
+	{
+		bankStatementGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionBankReconciliationRead), h.Reconciliation.ListStatements)
+		bankStatementGroup.POST("/import", conditionalAuthMW, conditionalPermMW(model.PermissionBankReconciliationEdit), h.Reconciliation.ImportStatement)
+	}
+
+	bankTransactionGroup := v1.Group("/bank-transactions")
+	{
+		bankTransactionGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionBankReconciliationRead), h.Reconciliation.ListTransactions)
+		bankTransactionGroup.POST("/rematch", conditionalAuthMW, conditionalPermMW(model.PermissionBankReconciliationEdit), h.Reconciliation.Rematch)
+		bankTransactionGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionBankReconciliationRead), h.Reconciliation.GetTransaction)
+		bankTransactionGroup.POST("/:id/confirm", conditionalAuthMW, conditionalPermMW(model.PermissionBankReconciliationEdit), h.Reconciliation.ConfirmMatch)
+		bankTransactionGroup.POST("/:id/reject", conditionalAuthMW, conditionalPermMW(model.PermissionBankReconciliationEdit), h.Reconciliation.RejectMatch)
+		bankTransactionGroup.POST("/:id/ignore", conditionalAuthMW, conditionalPermMW(model.PermissionBankReconciliationEdit), h.Reconciliation.IgnoreTransaction)
+	}
+
+	cashFlowGroup := v1.Group("/cash-flow")
+	{
+		cashFlowGroup.GET("/forecast", conditionalAuthMW, conditionalPermMW(model.PermissionCashFlowRead), h.CashFlow.Forecast)
+		cashFlowGroup.GET("/forecasts", conditionalAuthMW, conditionalPermMW(model.PermissionCashFlowRead), h.CashFlow.ListForecasts)
+		cashFlowGroup.POST("/forecasts", conditionalAuthMW, conditionalPermMW(model.PermissionCashFlowEdit), h.CashFlow.SaveForecast)
+		cashFlowGroup.GET("/forecasts/:id", conditionalAuthMW, conditionalPermMW(model.PermissionCashFlowRead), h.CashFlow.GetForecast)
+		cashFlowGroup.GET("/forecasts/:id/variance", conditionalAuthMW, conditionalPermMW(model.PermissionCashFlowRead), h.CashFlow.CompareActuals)
+	}
+
+	recruitmentGroup := v1.Group("/recruitment")
+	{
+		recruitmentGroup.GET("/stages", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentRead), h.Recruitment.ListStages)
+		recruitmentGroup.POST("/stages", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.CreateStage)
+		recruitmentGroup.PUT("/stages/:id", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.UpdateStage)
+		recruitmentGroup.DELETE("/stages/:id", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.DeleteStage)
+	}
+
+	candidateGroup := v1.Group("/candidates")
+	{
+		candidateGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentRead), h.Recruitment.ListCandidates)
+		candidateGroup.POST("", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.CreateCandidate)
+		candidateGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentRead), h.Recruitment.GetCandidate)
+		candidateGroup.PUT("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.UpdateCandidate)
+		candidateGroup.POST("/:id/cv", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.UploadCV)
+		candidateGroup.GET("/:id/transitions", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentRead), h.Recruitment.ListTransitions)
+		candidateGroup.POST("/:id/transitions", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.MoveCandidate)
+		candidateGroup.POST("/:id/interviews", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.AssignInterviewer)
+		candidateGroup.PUT("/:id/interviews/:interviewID/scorecard", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentRead), h.Recruitment.SubmitScorecard)
+		candidateGroup.POST("/:id/convert", conditionalAuthMW, conditionalPermMW(model.PermissionRecruitmentEdit), h.Recruitment.ConvertToEmployee)
+	}
+
+	operationalServiceGroup := v1.Group("/operational-services")
+	{
+		operationalServiceGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesRead), h.OperationalService.List)
+		operationalServiceGroup.POST("", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesEdit), h.OperationalService.Create)
+		operationalServiceGroup.GET("/seat-reconciliation", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesRead), h.OperationalService.ReconcileSeats)
+		operationalServiceGroup.GET("/spend-report", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesRead), h.OperationalService.SpendReport)
+		operationalServiceGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesRead), h.OperationalService.Get)
+		operationalServiceGroup.PUT("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesEdit), h.OperationalService.Update)
+		operationalServiceGroup.DELETE("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesEdit), h.OperationalService.Delete)
+		operationalServiceGroup.POST("/:id/seats", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesEdit), h.OperationalService.AssignSeat)
+		operationalServiceGroup.POST("/:id/seats/:seatID/release", conditionalAuthMW, conditionalPermMW(model.PermissionOperationalServicesEdit), h.OperationalService.ReleaseSeat)
+	}
+
+	timesheetGroup := v1.Group("/timesheets")
+	{
+		timesheetGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionTimesheetsRead), h.Timesheet.List)
+		timesheetGroup.POST("", conditionalAuthMW, conditionalPermMW(model.PermissionTimesheetsCreate), h.Timesheet.Create)
+		timesheetGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionTimesheetsRead), h.Timesheet.Get)
+		timesheetGroup.PUT("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionTimesheetsCreate), h.Timesheet.Update)
+		timesheetGroup.POST("/:id/submit", conditionalAuthMW, conditionalPermMW(model.PermissionTimesheetsCreate), h.Timesheet.Submit)
+		timesheetGroup.POST("/:id/approve", conditionalAuthMW, conditionalPermMW(model.PermissionTimesheetsApprove), h.Timesheet.Approve)
+		timesheetGroup.POST("/:id/reject", conditionalAuthMW, conditionalPermMW(model.PermissionTimesheetsApprove), h.Timesheet.Reject)
+	}
+
+	referralGroup := v1.Group("/referrals")
+	{
+		referralGroup.GET("", conditionalAuthMW, conditionalPermMW(model.PermissionReferralsRead), h.Referral.ListReferrals)
+		referralGroup.GET("/milestones", conditionalAuthMW, conditionalPermMW(model.PermissionReferralsRead), h.Referral.ListMilestones)
+		referralGroup.POST("/milestones", conditionalAuthMW, conditionalPermMW(model.PermissionReferralsEdit), h.Referral.CreateMilestone)
+		referralGroup.PUT("/milestones/:id", conditionalAuthMW, conditionalPermMW(model.PermissionReferralsEdit), h.Referral.UpdateMilestone)
+		referralGroup.GET("/:id", conditionalAuthMW, conditionalPermMW(model.PermissionReferralsRead), h.Referral.GetReferral)
+	}
+
 	newsGroup := v1.Group("/news")
 	{
 		newsGroup.GET("", conditionalAuthMW, h.News.Fetch)
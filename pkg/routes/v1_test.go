@@ -234,6 +234,18 @@ func Test_loadV1Routes(t *testing.T) {
 				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/project.IHandler.IcyWeeklyDistribution-fm",
 			},
 		},
+		"/api/v1/projects/profitability": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/profitability.IHandler.Report-fm",
+			},
+		},
+		"/api/v1/projects/:id/profitability": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/profitability.IHandler.ProjectReport-fm",
+			},
+		},
 		"/api/v1/projects/:id": {
 			"GET": {
 				Method:  "GET",
@@ -430,6 +442,86 @@ func Test_loadV1Routes(t *testing.T) {
 				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/survey.IHandler.MarkDone-fm",
 			},
 		},
+		"/api/v1/review-cycles": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.List-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.Create-fm",
+			},
+		},
+		"/api/v1/review-cycles/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.Get-fm",
+			},
+		},
+		"/api/v1/review-cycles/:id/start": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.Start-fm",
+			},
+		},
+		"/api/v1/review-cycles/:id/calibration": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.StartCalibration-fm",
+			},
+		},
+		"/api/v1/review-cycles/:id/close": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.Close-fm",
+			},
+		},
+		"/api/v1/review-cycles/:id/packets/:packetID": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.GetPacket-fm",
+			},
+		},
+		"/api/v1/review-cycles/:id/packets/:packetID/calibration": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.CalibratePacket-fm",
+			},
+		},
+		"/api/v1/review-cycles/:id/packets/:packetID/approve": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.ApprovePacket-fm",
+			},
+		},
+		"/api/v1/review-cycles/:id/packets/:packetID/reject": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reviewcycle.IHandler.RejectPacket-fm",
+			},
+		},
+		"/api/v1/surveys/trends": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveyanalytics.IHandler.GetTrends-fm",
+			},
+		},
+		"/api/v1/surveys/:id/analytics": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveyanalytics.IHandler.GetAnalytics-fm",
+			},
+		},
+		"/api/v1/surveys/:id/theme-summaries": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveyanalytics.IHandler.ListThemeSummaries-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/surveyanalytics.IHandler.SummarizeThemes-fm",
+			},
+		},
 		"/api/v1/surveys/:id/topics/:topicID": {
 			"DELETE": {
 				Method:  "DELETE",
@@ -672,6 +764,12 @@ func Test_loadV1Routes(t *testing.T) {
 				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.DeliveryMetricsReport-fm",
 			},
 		},
+		"/cronjobs/delivery-leader-boards": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.DeliveryLeaderBoardsReport-fm",
+			},
+		},
 		"/cronjobs/sync-delivery-metrics": {
 			"POST": {
 				Method:  "POST",
@@ -684,6 +782,24 @@ func Test_loadV1Routes(t *testing.T) {
 				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/conversionrate.IHandler.Sync-fm",
 			},
 		},
+		"/cronjobs/sync-fx-rates": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/fxrate.IHandler.Sync-fm",
+			},
+		},
+		"/cronjobs/post-ledger-entries": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.PostUnposted-fm",
+			},
+		},
+		"/cronjobs/snapshot-cash-flow-forecasts": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/cashflow.IHandler.SnapshotForecasts-fm",
+			},
+		},
 		"/cronjobs/sync-memo": {
 			"POST": {
 				Method:  "POST",
@@ -1018,6 +1134,68 @@ func Test_loadV1Routes(t *testing.T) {
 				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.Sync-fm",
 			},
 		},
+		"/api/v1/delivery-metrics/imports": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.Import-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.ListImports-fm",
+			},
+		},
+		"/api/v1/delivery-metrics/imports/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.GetImport-fm",
+			},
+		},
+		"/api/v1/delivery-metrics/mappings": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.ListMappings-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.SaveMapping-fm",
+			},
+		},
+		"/api/v1/delivery-metrics/mappings/:id": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.DeleteMapping-fm",
+			},
+		},
+		"/api/v1/delivery-metrics/leader-boards": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.ListLeaderBoards-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.CreateLeaderBoard-fm",
+			},
+		},
+		"/api/v1/delivery-metrics/leader-boards/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.GetLeaderBoard-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.UpdateLeaderBoard-fm",
+			},
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.DeleteLeaderBoard-fm",
+			},
+		},
+		"/api/v1/delivery-metrics/leader-boards/:id/discord-msg": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/deliverymetric.IHandler.GetLeaderBoardDiscordMsg-fm",
+			},
+		},
 		"/api/v1/delivery-metrics/report/weekly": {
 			"GET": {
 				Method:  "GET",
@@ -1079,144 +1257,858 @@ func Test_loadV1Routes(t *testing.T) {
 				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/conversionrate.IHandler.List-fm",
 			},
 		},
-		"/api/v1/discords/advance-salary": {
+		"/api/v1/fx-rates": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/fxrate.IHandler.List-fm",
+			},
+		},
+		"/api/v1/fx-rates/at": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/fxrate.IHandler.GetRateAt-fm",
+			},
+		},
+		"/api/v1/fx-rates/transactions/:type/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/fxrate.IHandler.GetTransactionRate-fm",
+			},
+		},
+		"/api/v1/fx-rates/backfill": {
 			"POST": {
 				Method:  "POST",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.SalaryAdvance-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/fxrate.IHandler.Backfill-fm",
 			},
 		},
-		"/api/v1/discords/check-advance-salary": {
+		"/api/v1/fx-rates/manual": {
 			"POST": {
 				Method:  "POST",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.CheckSalaryAdvance-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/fxrate.IHandler.SetManualRate-fm",
 			},
 		},
-		"/api/v1/discords/salary-advance-report": {
+		"/api/v1/ledger/accounts": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.SalaryAdvanceReport-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.ListAccounts-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.CreateAccount-fm",
 			},
 		},
-		"/api/v1/discords/:discord_id/earns/transactions": {
+		"/api/v1/ledger/accounts/:id": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.UpdateAccount-fm",
+			},
+		},
+		"/api/v1/ledger/entries": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.GetEmployeeEarnTransactions-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.ListEntries-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.CreateEntry-fm",
 			},
 		},
-		"/api/v1/discords/:discord_id/earns/total": {
+		"/api/v1/ledger/entries/:id": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.GetEmployeeTotalEarn-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.GetEntry-fm",
 			},
 		},
-		"/api/v1/discords/icy-accounting": {
+		"/api/v1/ledger/entries/:id/reverse": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.ReverseEntry-fm",
+			},
+		},
+		"/api/v1/ledger/trial-balance": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icy.IHandler.Accounting-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.TrialBalance-fm",
 			},
 		},
-		"/api/v1/discords/earns/total": {
+		"/api/v1/ledger/profit-and-loss": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.GetTotalEarn-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/ledger.IHandler.ProfitAndLoss-fm",
 			},
 		},
-		"/api/v1/discords/office-checkin": {
+		"/api/v1/bank-statements": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reconciliation.IHandler.ListStatements-fm",
+			},
+		},
+		"/api/v1/bank-statements/import": {
 			"POST": {
 				Method:  "POST",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.OfficeCheckIn-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reconciliation.IHandler.ImportStatement-fm",
 			},
 		},
-		"/api/v1/discords/scheduled-events": {
+		"/api/v1/bank-transactions": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.ListScheduledEvent-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reconciliation.IHandler.ListTransactions-fm",
 			},
+		},
+		"/api/v1/bank-transactions/rematch": {
 			"POST": {
 				Method:  "POST",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.CreateScheduledEvent-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reconciliation.IHandler.Rematch-fm",
 			},
 		},
-		"/api/v1/discords/scheduled-events/:id/speakers": {
-			"PUT": {
-				Method:  "PUT",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.SetScheduledEventSpeakers-fm",
+		"/api/v1/bank-transactions/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reconciliation.IHandler.GetTransaction-fm",
 			},
 		},
-		"/api/v1/memos": {
+		"/api/v1/bank-transactions/:id/confirm": {
 			"POST": {
 				Method:  "POST",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.Create-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reconciliation.IHandler.ConfirmMatch-fm",
 			},
-			"GET": {
-				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.List-fm",
+		},
+		"/api/v1/bank-transactions/:id/reject": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reconciliation.IHandler.RejectMatch-fm",
 			},
 		},
-		"/api/v1/memos/sync": {
+		"/api/v1/bank-transactions/:id/ignore": {
 			"POST": {
 				Method:  "POST",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.Sync-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/reconciliation.IHandler.IgnoreTransaction-fm",
 			},
 		},
-		"/api/v1/memos/prs": {
+		"/api/v1/cash-flow/forecast": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.ListOpenPullRequest-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/cashflow.IHandler.Forecast-fm",
 			},
 		},
-		"/api/v1/memos/discords": {
+		"/api/v1/cash-flow/forecasts": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.ListByDiscordID-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/cashflow.IHandler.ListForecasts-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/cashflow.IHandler.SaveForecast-fm",
 			},
 		},
-		"/api/v1/memos/top-authors": {
+		"/api/v1/cash-flow/forecasts/:id": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.GetTopAuthors-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/cashflow.IHandler.GetForecast-fm",
 			},
 		},
-		"/api/v1/public/community-nfts/:id": {
+		"/api/v1/cash-flow/forecasts/:id/variance": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/communitynft.IHandler.GetNftMetadata-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/cashflow.IHandler.CompareActuals-fm",
 			},
 		},
-		"/api/v1/earns": {
+		"/api/v1/recruitment/stages": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/earn.IHandler.ListEarn-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.ListStages-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.CreateStage-fm",
 			},
 		},
-		"/api/v1/discords/research-topics": {
+		"/api/v1/recruitment/stages/:id": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.UpdateStage-fm",
+			},
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.DeleteStage-fm",
+			},
+		},
+		"/api/v1/candidates": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.ListDiscordResearchTopics-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.ListCandidates-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.CreateCandidate-fm",
 			},
 		},
-		"/api/v1/news": {
+		"/api/v1/candidates/:id": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/news.IHandler.Fetch-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.GetCandidate-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.UpdateCandidate-fm",
 			},
 		},
-		"/api/v1/public/youtube/broadcast": {
+		"/api/v1/candidates/:id/cv": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.UploadCV-fm",
+			},
+		},
+		"/api/v1/candidates/:id/transitions": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/youtube.IHandler.LatestBroadcast-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.ListTransitions-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.MoveCandidate-fm",
 			},
 		},
-		"/api/v1/ogif": {
+		"/api/v1/candidates/:id/interviews": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.AssignInterviewer-fm",
+			},
+		},
+		"/api/v1/candidates/:id/interviews/:interviewID/scorecard": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.SubmitScorecard-fm",
+			},
+		},
+		"/api/v1/candidates/:id/convert": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/recruitment.IHandler.ConvertToEmployee-fm",
+			},
+		},
+		"/api/v1/referrals": {
 			"GET": {
 				Method:  "GET",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.UserOgifStats-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/referral.IHandler.ListReferrals-fm",
 			},
 		},
-		"/cronjobs/sweep-memo": {
+		"/api/v1/referrals/milestones": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/referral.IHandler.ListMilestones-fm",
+			},
 			"POST": {
 				Method:  "POST",
-				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.SweepMemo-fm",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/referral.IHandler.CreateMilestone-fm",
+			},
+		},
+		"/api/v1/referrals/milestones/:id": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/referral.IHandler.UpdateMilestone-fm",
+			},
+		},
+		"/api/v1/referrals/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/referral.IHandler.GetReferral-fm",
+			},
+		},
+		"/api/v1/profile/referrals": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/referral.IHandler.ListMyReferrals-fm",
+			},
+		},
+		"/api/v1/assets": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/asset.IHandler.List-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/asset.IHandler.Create-fm",
+			},
+		},
+		"/api/v1/assets/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/asset.IHandler.Get-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/asset.IHandler.Update-fm",
+			},
+		},
+		"/api/v1/assets/:id/assign": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/asset.IHandler.Assign-fm",
+			},
+		},
+		"/api/v1/assets/:id/return": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/asset.IHandler.Return-fm",
+			},
+		},
+		"/cronjobs/asset-depreciation": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/asset.IHandler.Depreciate-fm",
+			},
+		},
+		"/cronjobs/operational-service-renewals": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.RemindRenewals-fm",
+			},
+		},
+		"/api/v1/operational-services": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.List-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.Create-fm",
+			},
+		},
+		"/api/v1/operational-services/seat-reconciliation": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.ReconcileSeats-fm",
+			},
+		},
+		"/api/v1/operational-services/spend-report": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.SpendReport-fm",
+			},
+		},
+		"/api/v1/operational-services/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.Get-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.Update-fm",
+			},
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.Delete-fm",
+			},
+		},
+		"/api/v1/operational-services/:id/seats": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.AssignSeat-fm",
+			},
+		},
+		"/api/v1/operational-services/:id/seats/:seatID/release": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/operationalservice.IHandler.ReleaseSeat-fm",
+			},
+		},
+		"/api/v1/dashboards/resources/capacity-plan": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/capacity.IHandler.GetPlan-fm",
+			},
+		},
+		"/api/v1/dashboards/resources/capacity-plan/what-if": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/capacity.IHandler.SimulatePlan-fm",
+			},
+		},
+		"/api/v1/projects/:id/slots/:slotID/recommendations": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/staffing.IHandler.Recommend-fm",
+			},
+		},
+		"/api/v1/timesheets": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.List-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Create-fm",
+			},
+		},
+		"/api/v1/timesheets/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Get-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Update-fm",
+			},
+		},
+		"/api/v1/timesheets/:id/submit": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Submit-fm",
+			},
+		},
+		"/api/v1/timesheets/:id/approve": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Approve-fm",
+			},
+		},
+		"/api/v1/timesheets/:id/reject": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.Reject-fm",
+			},
+		},
+		"/api/v1/invoices/timesheet-line-items": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/timesheet.IHandler.GetInvoiceLineItems-fm",
+			},
+		},
+		"/api/v1/client-portal/auth": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.Login-fm",
+			},
+		},
+		"/api/v1/client-portal/me": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.Me-fm",
+			},
+		},
+		"/api/v1/client-portal/invoices": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.ListInvoices-fm",
+			},
+		},
+		"/api/v1/client-portal/invoices/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.GetInvoice-fm",
+			},
+		},
+		"/api/v1/client-portal/invoices/:id/pdf": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.DownloadInvoicePDF-fm",
+			},
+		},
+		"/api/v1/client-portal/invoices/:id/receipt": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.GetReceipt-fm",
+			},
+		},
+		"/api/v1/client-portal/team": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.GetTeam-fm",
+			},
+		},
+		"/api/v1/clients/:id/portal-accesses": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.ListAccesses-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.GrantAccess-fm",
+			},
+		},
+		"/api/v1/clients/:id/portal-accesses/:accessID": {
+			"DELETE": {
+				Method:  "DELETE",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/clientportal.IHandler.RevokeAccess-fm",
+			},
+		},
+		"/api/v1/contracts": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/contract.IHandler.List-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/contract.IHandler.Create-fm",
+			},
+		},
+		"/api/v1/contracts/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/contract.IHandler.Get-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/contract.IHandler.Update-fm",
+			},
+		},
+		"/api/v1/contracts/:id/rates": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/contract.IHandler.SetRates-fm",
+			},
+		},
+		"/api/v1/contracts/:id/attachments": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/contract.IHandler.UploadAttachment-fm",
+			},
+		},
+		"/cronjobs/contract-expiries": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/contract.IHandler.NotifyExpiring-fm",
+			},
+		},
+		"/api/v1/projects/:id/budgets": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projectbudget.IHandler.List-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projectbudget.IHandler.Create-fm",
+			},
+		},
+		"/api/v1/projects/:id/budgets/:budgetID": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projectbudget.IHandler.Get-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projectbudget.IHandler.Update-fm",
+			},
+		},
+		"/api/v1/projects/:id/budgets/:budgetID/milestones": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projectbudget.IHandler.SetMilestones-fm",
+			},
+		},
+		"/api/v1/projects/:id/budgets/:budgetID/burn-down": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projectbudget.IHandler.Report-fm",
+			},
+		},
+		"/cronjobs/project-budget-burns": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/projectbudget.IHandler.AlertBurns-fm",
+			},
+		},
+		"/cronjobs/engagement-analytics": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/engagement.IHandler.AggregateAnalytics-fm",
+			},
+		},
+		"/api/v1/engagements/analytics/trends": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/engagement.IHandler.Trends-fm",
+			},
+		},
+		"/api/v1/engagements/analytics/channels": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/engagement.IHandler.ChannelHealth-fm",
+			},
+		},
+		"/api/v1/engagements/analytics/quiet-members": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/engagement.IHandler.QuietMembers-fm",
+			},
+		},
+		"/api/v1/knowledge-base/search": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/knowledgebase.IHandler.Search-fm",
+			},
+		},
+		"/api/v1/knowledge-base/reindex": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/knowledgebase.IHandler.Reindex-fm",
+			},
+		},
+		"/api/v1/office-locations": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.ListLocations-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.CreateLocation-fm",
+			},
+		},
+		"/api/v1/office-locations/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.GetLocation-fm",
+			},
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.UpdateLocation-fm",
+			},
+		},
+		"/api/v1/office-locations/:id/qr-code": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.QRCode-fm",
+			},
+		},
+		"/api/v1/office-checkins": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.List-fm",
+			},
+		},
+		"/api/v1/office-checkins/check-in": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.CheckIn-fm",
+			},
+		},
+		"/api/v1/office-checkins/check-out": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.CheckOut-fm",
+			},
+		},
+		"/api/v1/office-checkins/attendance": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/officecheckin.IHandler.Attendance-fm",
+			},
+		},
+		"/cronjobs/icy-treasury-snapshots": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icy.IHandler.SnapshotTreasury-fm",
+			},
+		},
+		"/api/v1/icy-treasury/reconciliation": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icy.IHandler.TreasuryReconciliation-fm",
+			},
+		},
+		"/api/v1/icy-treasury/snapshots/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icy.IHandler.GetTreasurySnapshot-fm",
+			},
+		},
+		"/api/v1/icy-rewards/rules": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icyreward.IHandler.ListRules-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icyreward.IHandler.CreateRule-fm",
+			},
+		},
+		"/api/v1/icy-rewards/rules/:id": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icyreward.IHandler.UpdateRule-fm",
+			},
+		},
+		"/api/v1/icy-rewards/events": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icyreward.IHandler.Trigger-fm",
+			},
+		},
+		"/api/v1/icy-rewards/payouts": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icyreward.IHandler.ListPayouts-fm",
+			},
+		},
+		"/api/v1/icy-rewards/payouts/:id/approve": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icyreward.IHandler.Approve-fm",
+			},
+		},
+		"/api/v1/icy-rewards/payouts/:id/reject": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icyreward.IHandler.Reject-fm",
+			},
+		},
+		"/api/v1/icy-rewards/budget": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icyreward.IHandler.Budget-fm",
+			},
+		},
+		"/cronjobs/referral-bonuses": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/referral.IHandler.ProcessMilestones-fm",
+			},
+		},
+		"/api/v1/discords/advance-salary": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.SalaryAdvance-fm",
+			},
+		},
+		"/api/v1/discords/check-advance-salary": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.CheckSalaryAdvance-fm",
+			},
+		},
+		"/api/v1/discords/salary-advance-report": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.SalaryAdvanceReport-fm",
+			},
+		},
+		"/api/v1/discords/:discord_id/earns/transactions": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.GetEmployeeEarnTransactions-fm",
+			},
+		},
+		"/api/v1/discords/:discord_id/earns/total": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.GetEmployeeTotalEarn-fm",
+			},
+		},
+		"/api/v1/discords/icy-accounting": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/icy.IHandler.Accounting-fm",
+			},
+		},
+		"/api/v1/discords/earns/total": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.GetTotalEarn-fm",
+			},
+		},
+		"/api/v1/discords/office-checkin": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/employee.IHandler.OfficeCheckIn-fm",
+			},
+		},
+		"/api/v1/discords/scheduled-events": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.ListScheduledEvent-fm",
+			},
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.CreateScheduledEvent-fm",
+			},
+		},
+		"/api/v1/discords/scheduled-events/:id/speakers": {
+			"PUT": {
+				Method:  "PUT",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.SetScheduledEventSpeakers-fm",
+			},
+		},
+		"/api/v1/memos": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.Create-fm",
+			},
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.List-fm",
+			},
+		},
+		"/api/v1/memos/sync": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.Sync-fm",
+			},
+		},
+		"/api/v1/memos/prs": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.ListOpenPullRequest-fm",
+			},
+		},
+		"/api/v1/memos/discords": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.ListByDiscordID-fm",
+			},
+		},
+		"/api/v1/memos/top-authors": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.GetTopAuthors-fm",
+			},
+		},
+		"/api/v1/public/community-nfts/:id": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/communitynft.IHandler.GetNftMetadata-fm",
+			},
+		},
+		"/api/v1/earns": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/earn.IHandler.ListEarn-fm",
+			},
+		},
+		"/api/v1/discords/research-topics": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.ListDiscordResearchTopics-fm",
+			},
+		},
+		"/api/v1/news": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/news.IHandler.Fetch-fm",
+			},
+		},
+		"/api/v1/public/youtube/broadcast": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/youtube.IHandler.LatestBroadcast-fm",
+			},
+		},
+		"/api/v1/ogif": {
+			"GET": {
+				Method:  "GET",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.UserOgifStats-fm",
+			},
+		},
+		"/cronjobs/sweep-memo": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/discord.IHandler.SweepMemo-fm",
+			},
+		},
+		"/cronjobs/ingest-memos": {
+			"POST": {
+				Method:  "POST",
+				Handler: "github.com/dwarvesf/fortress-api/pkg/handler/memologs.IHandler.Ingest-fm",
 			},
 		},
 		"/api/v1/ogif/leaderboard": {
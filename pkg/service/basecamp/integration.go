@@ -13,6 +13,7 @@ import (
 	"github.com/thoas/go-funk"
 	"gorm.io/datatypes"
 
+	"github.com/dwarvesf/fortress-api/pkg/ledger"
 	"github.com/dwarvesf/fortress-api/pkg/logger"
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"github.com/dwarvesf/fortress-api/pkg/service/currency"
@@ -177,6 +178,15 @@ func (s *Service) CreateBasecampExpense(
 		return err
 	}
 
+	// the transaction is committed already, one failing to post is left to the
+	// post-ledger-entries cronjob
+	if _, err := ledger.New(s.store).PostAccountingTransaction(s.repo.DB(), model.JournalSourceExpense, transaction); err != nil {
+		l.Fields(logger.Fields{
+			"expenseID":               e.ID,
+			"accountingTransactionID": transaction.ID,
+		}).Error(err, "failed to post journal entry")
+	}
+
 	e.AccountingTransactionID = &transaction.ID
 
 	if _, err = s.store.Expense.Update(s.repo.DB(), e); err != nil {
@@ -207,6 +217,12 @@ func (s *Service) UncheckBasecampExpenseHandler(
 		return err
 	}
 
+	if e.AccountingTransactionID != nil {
+		if err = ledger.New(s.store).ReverseAccountingTransaction(s.repo.DB(), e.AccountingTransactionID.String()); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
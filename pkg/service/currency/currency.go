@@ -2,8 +2,10 @@ package currency
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/patrickmn/go-cache"
@@ -179,6 +181,93 @@ func (s *service) getRate(target string) (float64, error) {
 	return 0, nil
 }
 
+// GetRateAt returns the rate between src and target on the given date,
+// using the historical quotes of currency layer
+func (s *service) GetRateAt(src, target string, date time.Time) (float64, error) {
+	if src == target {
+		return 1, nil
+	}
+
+	quotes, err := s.getHistoricalQuotes(date)
+	if err != nil {
+		return 0, err
+	}
+
+	x, ok := quotes[src]
+	if !ok || x == 0 {
+		return 0, fmt.Errorf("missing %s quote on %s", src, date.Format("2006-01-02"))
+	}
+	y, ok := quotes[target]
+	if !ok || y == 0 {
+		return 0, fmt.Errorf("missing %s quote on %s", target, date.Format("2006-01-02"))
+	}
+
+	return y / x, nil
+}
+
+// getHistoricalQuotes returns the USD based quotes of a date, keyed by currency name
+func (s *service) getHistoricalQuotes(date time.Time) (map[string]float64, error) {
+	day := date.Format("2006-01-02")
+	cacheKey := "historical_" + day
+
+	if q, ok := s.cacheMap.Get(cacheKey); ok {
+		if quotes, ok := q.(map[string]float64); ok {
+			return quotes, nil
+		}
+	}
+
+	// same as getRate, do fixed rate outside of prod to reduce the cost
+	if s.cfg.Env != "prod" {
+		return map[string]float64{
+			"USD": 1,
+			"CAD": 1.34275,
+			"GBP": 0.79185,
+			"EUR": 0.89795,
+			"VND": 25900,
+			"SGD": 1.3845,
+		}, nil
+	}
+
+	var client http.Client
+	endpoint := "http://apilayer.net/api/historical?currencies=USD,CAD,GBP,EUR,VND,SGD&date=" + day + "&access_key=" + s.token
+
+	req, err := http.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	type out struct {
+		Success bool               `json:"success"`
+		Quotes  map[string]float64 `json:"quotes"`
+	}
+
+	o := out{}
+	if err := json.NewDecoder(resp.Body).Decode(&o); err != nil {
+		return nil, err
+	}
+	if !o.Success || len(o.Quotes) == 0 {
+		return nil, fmt.Errorf("cannot get historical quotes on %s", day)
+	}
+
+	// quotes are keyed like USDVND, strip the USD prefix
+	quotes := make(map[string]float64, len(o.Quotes))
+	for k, v := range o.Quotes {
+		quotes[strings.TrimPrefix(k, "USD")] = v
+	}
+	quotes["USD"] = 1
+
+	// historical quotes never change, keep them for a while
+	s.cacheMap.Set(cacheKey, quotes, 7*24*time.Hour)
+
+	return quotes, nil
+}
+
 func (s *service) GetCurrencyOption(db *gorm.DB) ([]model.Currency, error) {
 	res := []model.Currency{}
 	return res, db.Find(&res).Error
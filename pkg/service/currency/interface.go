@@ -1,6 +1,8 @@
 package currency
 
 import (
+	"time"
+
 	"github.com/dwarvesf/fortress-api/pkg/model"
 	"gorm.io/gorm"
 )
@@ -16,6 +18,7 @@ type IService interface {
 	GetCurrencyOption(db *gorm.DB) ([]model.Currency, error)
 
 	GetRate(target string) (float64, error)
+	GetRateAt(src, target string, date time.Time) (float64, error)
 }
 
 const (
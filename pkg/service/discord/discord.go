@@ -680,6 +680,44 @@ func (d *discordClient) DeliveryMetricMonthlyReport(deliveryMetric *view.Deliver
 	return d.SendEmbeddedMessageWithChannel(nil, msg, channelID)
 }
 
+// CreateDeliveryLeaderBoardMessage lists every employee of a configured leaderboard with their score
+func CreateDeliveryLeaderBoardMessage(leaderBoard *view.WeeklyLeaderBoard) *discordgo.MessageEmbed {
+	content := fmt.Sprintf("*Ranked by `%v` over the %v period.*\n\n", leaderBoard.Formula, leaderBoard.Period)
+
+	if len(leaderBoard.Items) == 0 {
+		content += "No delivery metrics for this period."
+	}
+
+	for _, item := range leaderBoard.Items {
+		mention := item.EmployeeName
+		if item.DiscordID != "" {
+			mention = fmt.Sprintf("<@%v>", item.DiscordID)
+		}
+		content += fmt.Sprintf("`#%-2v` %v `%v pts` (%v hrs)\n", item.Rank, mention, item.Points.String(), item.Effort.String())
+	}
+
+	date := ""
+	if leaderBoard.Date != nil {
+		date = leaderBoard.Date.Format("02 Jan 2006")
+	}
+
+	msg := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("**🏆 %v 🏆** - %v", strings.ToUpper(leaderBoard.Name), strings.ToUpper(date)),
+		Description: content,
+		Footer: &discordgo.MessageEmbedFooter{
+			IconURL: "https://cdn.discordapp.com/avatars/564764617545482251/9c9bd4aaba164fc0b92f13f052405b4d.webp?size=160",
+			Text:    "?help to see all commands",
+		},
+	}
+
+	return msg
+}
+
+func (d *discordClient) DeliveryLeaderBoard(leaderBoard *view.WeeklyLeaderBoard, channelID string) (*discordgo.Message, error) {
+	msg := CreateDeliveryLeaderBoardMessage(leaderBoard)
+	return d.SendEmbeddedMessageWithChannel(nil, msg, channelID)
+}
+
 func getLeaderBoardAsString(data []view.LeaderBoardItem) string {
 	emojiMap := map[int]string{
 		1: getEmoji("BADGE1"),
@@ -96,6 +96,36 @@ func (m *mockMemoLogStore) GetTopAuthors(db *gorm.DB, limit int, from, to *time.
 	return args.Get(0).([]model.DiscordAccountMemoRank), args.Error(1)
 }
 
+func (m *mockMemoLogStore) OneByFilePath(db *gorm.DB, filePath string) (*model.MemoLog, error) {
+	args := m.Called(db, filePath)
+	return args.Get(0).(*model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStore) OneByURL(db *gorm.DB, url string) (*model.MemoLog, error) {
+	args := m.Called(db, url)
+	return args.Get(0).(*model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStore) OneByTitle(db *gorm.DB, title string, source model.MemoLogSource) (*model.MemoLog, error) {
+	args := m.Called(db, title, source)
+	return args.Get(0).(*model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStore) ListUnresolved(db *gorm.DB) ([]model.MemoLog, error) {
+	args := m.Called(db)
+	return args.Get(0).([]model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStore) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.MemoLog, updatedFields ...string) (*model.MemoLog, error) {
+	args := m.Called(db, id, updateModel, updatedFields)
+	return args.Get(0).(*model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStore) Delete(db *gorm.DB, id string) error {
+	args := m.Called(db, id)
+	return args.Error(0)
+}
+
 type mockStore struct {
 	DiscordAccount *mockDiscordAccountStore
 	MemoLog        *mockMemoLogStore
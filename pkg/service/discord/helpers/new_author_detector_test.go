@@ -53,6 +53,36 @@ func (m *mockMemoLogStoreForDetector) GetTopAuthors(db *gorm.DB, limit int, from
 	return args.Get(0).([]model.DiscordAccountMemoRank), args.Error(1)
 }
 
+func (m *mockMemoLogStoreForDetector) OneByFilePath(db *gorm.DB, filePath string) (*model.MemoLog, error) {
+	args := m.Called(db, filePath)
+	return args.Get(0).(*model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStoreForDetector) OneByURL(db *gorm.DB, url string) (*model.MemoLog, error) {
+	args := m.Called(db, url)
+	return args.Get(0).(*model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStoreForDetector) OneByTitle(db *gorm.DB, title string, source model.MemoLogSource) (*model.MemoLog, error) {
+	args := m.Called(db, title, source)
+	return args.Get(0).(*model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStoreForDetector) ListUnresolved(db *gorm.DB) ([]model.MemoLog, error) {
+	args := m.Called(db)
+	return args.Get(0).([]model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStoreForDetector) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.MemoLog, updatedFields ...string) (*model.MemoLog, error) {
+	args := m.Called(db, id, updateModel, updatedFields)
+	return args.Get(0).(*model.MemoLog), args.Error(1)
+}
+
+func (m *mockMemoLogStoreForDetector) Delete(db *gorm.DB, id string) error {
+	args := m.Called(db, id)
+	return args.Error(0)
+}
+
 type mockDBRepoForDetector struct {
 	mock.Mock
 }
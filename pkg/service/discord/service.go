@@ -30,6 +30,7 @@ type IService interface {
 	ReportBraineryMetrics(queryView string, braineryMetric *view.BraineryMetric, channelID string) (*discordgo.Message, error)
 	DeliveryMetricWeeklyReport(deliveryMetrics *view.DeliveryMetricWeeklyReport, leaderBoard *view.WeeklyLeaderBoard, channelID string) (*discordgo.Message, error)
 	DeliveryMetricMonthlyReport(deliveryMetrics *view.DeliveryMetricMonthlyReport, leaderBoard *view.WeeklyLeaderBoard, channelID string) (*discordgo.Message, error)
+	DeliveryLeaderBoard(leaderBoard *view.WeeklyLeaderBoard, channelID string) (*discordgo.Message, error)
 	SendNewMemoMessage(
 		guildID string,
 		memos []model.MemoLog,
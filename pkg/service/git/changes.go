@@ -0,0 +1,133 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ErrCommitNotFound is returned when the commit to diff from is not in the repository, e.g. after a
+// force push
+var ErrCommitNotFound = errors.New("commit not found")
+
+type ChangeAction string
+
+const (
+	ChangeActionAdded    ChangeAction = "added"
+	ChangeActionModified ChangeAction = "modified"
+	ChangeActionDeleted  ChangeAction = "deleted"
+	ChangeActionRenamed  ChangeAction = "renamed"
+)
+
+// FileChange is a file changed between two commits, the paths are relative to the repository root
+type FileChange struct {
+	Action       ChangeAction
+	Path         string
+	PreviousPath string // the path before a rename
+}
+
+// HeadCommit returns the hash of the commit checked out
+func (g *gitService) HeadCommit() (string, error) {
+	if g.repo == nil {
+		return "", errors.New("repository is not initialized")
+	}
+
+	ref, err := g.repo.Head()
+	if err != nil {
+		return "", err
+	}
+
+	return ref.Hash().String(), nil
+}
+
+// Changes lists the files under dir with the extension ext changed from the commit to HEAD, with
+// the renames detected. Without a commit to diff from, every file is listed as added.
+func (g *gitService) Changes(from, dir, ext string) ([]FileChange, error) {
+	if g.repo == nil {
+		return nil, errors.New("repository is not initialized")
+	}
+
+	ref, err := g.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	head, err := g.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, err
+	}
+	headTree, err := head.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	match := func(path string) bool {
+		return path != "" && strings.HasSuffix(path, ext) && (dir == "" || strings.HasPrefix(path, strings.TrimSuffix(dir, "/")+"/"))
+	}
+
+	var changes []FileChange
+	if from == "" {
+		err := headTree.Files().ForEach(func(f *object.File) error {
+			if match(f.Name) {
+				changes = append(changes, FileChange{Action: ChangeActionAdded, Path: f.Name})
+			}
+			return nil
+		})
+		return changes, err
+	}
+
+	fromCommit, err := g.repo.CommitObject(plumbing.NewHash(from))
+	if err != nil {
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			return nil, ErrCommitNotFound
+		}
+		return nil, err
+	}
+	fromTree, err := fromCommit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := object.DiffTreeWithOptions(context.Background(), fromTree, headTree, &object.DiffTreeOptions{DetectRenames: true})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range diff {
+		switch {
+		case c.From.Name == "":
+			if match(c.To.Name) {
+				changes = append(changes, FileChange{Action: ChangeActionAdded, Path: c.To.Name})
+			}
+		case c.To.Name == "":
+			if match(c.From.Name) {
+				changes = append(changes, FileChange{Action: ChangeActionDeleted, Path: c.From.Name})
+			}
+		case c.From.Name != c.To.Name:
+			// a file moved in or out of dir is an addition or a deletion
+			switch {
+			case match(c.From.Name) && match(c.To.Name):
+				changes = append(changes, FileChange{Action: ChangeActionRenamed, Path: c.To.Name, PreviousPath: c.From.Name})
+			case match(c.To.Name):
+				changes = append(changes, FileChange{Action: ChangeActionAdded, Path: c.To.Name})
+			case match(c.From.Name):
+				changes = append(changes, FileChange{Action: ChangeActionDeleted, Path: c.From.Name})
+			}
+		default:
+			if match(c.To.Name) {
+				changes = append(changes, FileChange{Action: ChangeActionModified, Path: c.To.Name})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// ReadFile reads a file of the working tree, the path is relative to the repository root
+func (g *gitService) ReadFile(path string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(g.dest, filepath.Clean("/"+path)))
+}
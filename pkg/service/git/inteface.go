@@ -7,4 +7,7 @@ type IService interface {
 	Push() (err error)
 	CreatePullRequest(owner, repo, head, base, title, body string) (*int, error)
 	RequestReview(owner, repo string, pullRequestNumber int, reviewers []string) error
+	HeadCommit() (string, error)
+	Changes(from, dir, ext string) ([]FileChange, error)
+	ReadFile(path string) ([]byte, error)
 }
@@ -486,3 +486,30 @@ func (g *googleService) UploadContractorInvoicePDF(contractorName, fileName stri
 	// Return the Google Drive file URL
 	return fmt.Sprintf("https://drive.google.com/file/d/%s/view", file.Id), nil
 }
+
+// UploadContractFile uploads a contract file to the folder of the client under the contract directory
+func (g *googleService) UploadContractFile(clientName, fileName, contentType string, content []byte) (string, error) {
+	if g.appConfig.Invoice.ContractDirID == "" {
+		return "", errors.New("contract directory is not configured")
+	}
+
+	if err := g.ensureToken(g.appConfig.Google.AccountingGoogleRefreshToken); err != nil {
+		return "", err
+	}
+
+	if err := g.prepareService(); err != nil {
+		return "", err
+	}
+
+	clientDir, err := g.getDirID(slugContractorName(clientName), g.appConfig.Invoice.ContractDirID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get client contract directory: %w", err)
+	}
+
+	file, err := g.newFile(fileName, contentType, bytes.NewReader(content), clientDir.Id)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload contract file: %w", err)
+	}
+
+	return fmt.Sprintf("https://drive.google.com/file/d/%s/view", file.Id), nil
+}
@@ -27,4 +27,7 @@ type IService interface {
 	ShareFileWithEmail(fileID, email string) error
 
 	DownloadFileFromYearDir(parentDirID, year, fileName string) ([]byte, error)
+
+	// UploadContractFile uploads a signed contract or annex to the folder of the client and returns its URL
+	UploadContractFile(clientName, fileName, contentType string, content []byte) (string, error)
 }
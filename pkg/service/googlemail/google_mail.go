@@ -685,6 +685,42 @@ func (g *googleService) SendOffboardingMail(offboarding *model.OffboardingEmail)
 	return err
 }
 
+// SendCandidateMail sends a hiring template, e.g. hiring_offered.tpl, to a candidate
+func (g *googleService) SendCandidateMail(templateName string, candidate *model.Candidate) (err error) {
+	if !mailutils.Email(candidate.Email) {
+		return ErrInvalidEmail
+	}
+
+	if err := g.ensureToken(g.appConfig.Google.TeamGoogleRefreshToken); err != nil {
+		return err
+	}
+
+	if err := g.prepareService(); err != nil {
+		return err
+	}
+
+	// Verify hr@d.foundation alias before sending
+	id := g.appConfig.Google.TeamEmailID
+	verified, err := g.IsAliasVerified(id, "hr@d.foundation")
+	if err != nil || !verified {
+		return ErrAliasNotVerified
+	}
+
+	encodedEmail, err := composeMailContent(g.appConfig,
+		&MailParseInfo{
+			spawnEmail,
+			templateName,
+			candidate,
+			map[string]interface{}{},
+		})
+	if err != nil {
+		return err
+	}
+
+	_, err = g.sendEmail(encodedEmail, id)
+	return err
+}
+
 // ListSendAsAliases lists all SendAs aliases for the given user
 func (g *googleService) ListSendAsAliases(userId string) ([]*gmail.SendAs, error) {
 	if g.service == nil {
@@ -9,7 +9,6 @@ import (
 	"golang.org/x/oauth2"
 	"google.golang.org/api/option"
 	"google.golang.org/api/sheets/v4"
-	"strings"
 )
 
 const SpreedSheetReadOnlyScope = "https://www.googleapis.com/auth/spreadsheets.readonly\t"
@@ -56,7 +55,9 @@ func (g *googleService) prepareService() error {
 	return nil
 }
 
-func (g *googleService) FetchSheetContent(fromIdx int) ([]DeliveryMetricRawData, error) {
+// FetchDeliveryMetricRows returns every row of the delivery metrics sheet, header included, the
+// rows are validated by the import
+func (g *googleService) FetchDeliveryMetricRows() ([][]string, error) {
 	DeliveryMetricSheetID := "1KXUVyDrC9199Dp6wpT6ovIkIvZRtf455eaqwZmvTAFU"
 	DeliveryMetricSheetRange := "All Data"
 	if err := g.ensureToken(g.appConfig.Google.AccountingGoogleRefreshToken); err != nil {
@@ -86,47 +87,6 @@ func (g *googleService) FetchSheetContent(fromIdx int) ([]DeliveryMetricRawData,
 	if err != nil {
 		return nil, fmt.Errorf("unable to unmarshal sheet data: %v", err)
 	}
-	deliveryMetricRawData := make([]DeliveryMetricRawData, 0)
 
-	for idx := fromIdx - 1; idx < len(sheetData.Values); idx++ {
-		itm := sheetData.Values[idx]
-
-		c0 := strings.TrimSpace(itm[0])
-		c1 := strings.TrimSpace(itm[1])
-		c2 := strings.TrimSpace(itm[2])
-		c3 := strings.TrimSpace(itm[3])
-		c4 := strings.TrimSpace(itm[4])
-		c5 := strings.TrimSpace(itm[5])
-		c6 := strings.TrimSpace(itm[6])
-
-		if c4 == "" || c5 == "" || c6 == "" {
-			continue
-		}
-
-		if c1 == "" {
-			c1 = "0"
-		}
-
-		if c2 == "" {
-			c2 = "0"
-		}
-
-		if c3 == "" {
-			c3 = "0"
-		}
-
-		tmp := DeliveryMetricRawData{
-			Person:        c0,
-			Weight:        c1,
-			Effort:        c2,
-			Effectiveness: c3,
-			Date:          c4,
-			Project:       c5,
-			Email:         c6,
-		}
-
-		deliveryMetricRawData = append(deliveryMetricRawData, tmp)
-	}
-
-	return deliveryMetricRawData, nil
+	return sheetData.Values, nil
 }
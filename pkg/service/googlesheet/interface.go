@@ -3,5 +3,5 @@ package googlesheet
 var ()
 
 type IService interface {
-	FetchSheetContent(fromIdx int) ([]DeliveryMetricRawData, error)
+	FetchDeliveryMetricRows() ([][]string, error)
 }
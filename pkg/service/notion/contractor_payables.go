@@ -900,6 +900,101 @@ func (s *ContractorPayablesService) QueryPendingPayablesByPeriod(ctx context.Con
 	return payables, nil
 }
 
+// PaidPayable represents a payable record with Paid status
+type PaidPayable struct {
+	PageID           string  // Payable page ID
+	ContractorPageID string  // From Contractor relation
+	ContractorName   string  // Rollup from Contractor (Full Name)
+	Discord          string  // Discord username from Contractor
+	Total            float64 // Total amount
+	Currency         string  // USD or VND
+	PaymentDate      string  // YYYY-MM-DD
+}
+
+// QueryPaidPayablesByPaymentDate queries the contractor payables with Payment Status="Paid"
+// and a Payment Date between from and to, both included.
+// Returns empty slice if no results found (not an error).
+func (s *ContractorPayablesService) QueryPaidPayablesByPaymentDate(ctx context.Context, from, to time.Time) ([]PaidPayable, error) {
+	payablesDBID := s.cfg.Notion.Databases.ContractorPayables
+	if payablesDBID == "" {
+		return nil, errors.New("contractor payables database ID not configured")
+	}
+
+	s.logger.Debug(fmt.Sprintf("[DEBUG] contractor_payables: querying paid payables from=%s to=%s", from.Format("2006-01-02"), to.Format("2006-01-02")))
+
+	query := &nt.DatabaseQuery{
+		Filter: &nt.DatabaseQueryFilter{
+			And: []nt.DatabaseQueryFilter{
+				{
+					Property: "Payment Status",
+					DatabaseQueryPropertyFilter: nt.DatabaseQueryPropertyFilter{
+						Status: &nt.StatusDatabaseQueryFilter{Equals: "Paid"},
+					},
+				},
+				{
+					Property: "Payment Date",
+					DatabaseQueryPropertyFilter: nt.DatabaseQueryPropertyFilter{
+						Date: &nt.DatePropertyFilter{OnOrAfter: &from},
+					},
+				},
+				{
+					Property: "Payment Date",
+					DatabaseQueryPropertyFilter: nt.DatabaseQueryPropertyFilter{
+						Date: &nt.DatePropertyFilter{OnOrBefore: &to},
+					},
+				},
+			},
+		},
+		PageSize: 100,
+	}
+
+	var payables []PaidPayable
+	for {
+		resp, err := s.client.QueryDatabase(ctx, payablesDBID, query)
+		if err != nil {
+			s.logger.Error(err, fmt.Sprintf("[DEBUG] contractor_payables: failed to query paid payables: %v", err))
+			return nil, fmt.Errorf("failed to query contractor payables database: %w", err)
+		}
+
+		for _, page := range resp.Results {
+			props, ok := page.Properties.(nt.DatabasePageProperties)
+			if !ok {
+				s.logger.Debug("[DEBUG] contractor_payables: failed to cast page properties")
+				continue
+			}
+
+			payables = append(payables, PaidPayable{
+				PageID:           page.ID,
+				ContractorPageID: ExtractFirstRelationID(props, "Contractor"),
+				ContractorName:   ExtractRollupTitle(props, "Contractor Name"),
+				Discord:          ExtractRichTextFirst(props, "Discord"),
+				Total:            ExtractNumber(props, "Total"),
+				Currency:         ExtractSelect(props, "Currency"),
+				PaymentDate:      ExtractDateString(props, "Payment Date"),
+			})
+		}
+
+		if !resp.HasMore || resp.NextCursor == nil {
+			break
+		}
+		query.StartCursor = *resp.NextCursor
+	}
+
+	for i := range payables {
+		if payables[i].ContractorPageID != "" && payables[i].ContractorName == "" {
+			info := s.getContractorInfo(ctx, payables[i].ContractorPageID)
+			payables[i].ContractorName = info.Name
+			if payables[i].Discord == "" {
+				payables[i].Discord = info.Discord
+			}
+		}
+	}
+
+	s.logger.Debug(fmt.Sprintf("[DEBUG] contractor_payables: total paid payables found=%d", len(payables)))
+
+	return payables, nil
+}
+
 // UpdatePayableStatus updates a payable's Payment Status and Payment Date.
 // pageID: Payable page ID to update
 // status: New status value (e.g., "Paid")
@@ -101,8 +101,6 @@ func (w *wiseService) getTWRate(sourceCurrency, targetCurrency string) (float64,
 		return targetRate / sourceRate, nil
 	}
 
-	var conversionRate []model.WiseConversionRate
-
 	l := w.l.Fields(logger.Fields{
 		"handler":         "wise",
 		"method":          "getTWRate",
@@ -122,40 +120,59 @@ func (w *wiseService) getTWRate(sourceCurrency, targetCurrency string) (float64,
 
 	// build up request
 	url := fmt.Sprintf("%v?source=%v&target=%v", w.getUrl(rates), sourceCurrency, targetCurrency)
-	req, err := w.newRequest("GET", url, nil)
+	rate, err := w.requestRate(l, url)
 	if err != nil {
-		l.Error(err, "can't build request")
 		return 0, err
 	}
 
-	client := http.Client{
-		Timeout: 5 * time.Second,
+	// save to cache for further request within 5 minutes
+	w.setCache(sourceCurrency+targetCurrency, rate)
+
+	return rate, nil
+}
+
+// GetRateAt returns the rate between two currencies at the given point in time
+func (w *wiseService) GetRateAt(sourceCurrency, targetCurrency string, at time.Time) (float64, error) {
+	if sourceCurrency == targetCurrency {
+		return 1, nil
 	}
 
-	// read response
-	resp, err := client.Do(req)
-	if err != nil {
-		l.Error(err, "can't get response")
-		return 0, err
+	// Use mock data if not prod AND UseRealAPI is not enabled
+	if w.cfg.Env != "prod" && !w.cfg.Wise.UseRealAPI {
+		sourceRate, err := getLocalRate(sourceCurrency)
+		if err != nil {
+			return 0, err
+		}
+		targetRate, err := getLocalRate(targetCurrency)
+		if err != nil {
+			return 0, err
+		}
+		return targetRate / sourceRate, nil
 	}
-	defer resp.Body.Close()
-	body := resp.Body
-	res, err := io.ReadAll(body)
-	if err != nil {
-		l.Error(err, "can't read response")
-		return 0, err
+
+	l := w.l.Fields(logger.Fields{
+		"handler":         "wise",
+		"method":          "GetRateAt",
+		"source_currency": sourceCurrency,
+		"target_currency": targetCurrency,
+		"at":              at,
+	})
+
+	cacheKey := sourceCurrency + targetCurrency + at.UTC().Format(time.RFC3339)
+	rate := w.getCache(cacheKey)
+	if rate != 0 {
+		return rate, nil
 	}
 
-	err = json.Unmarshal(res, &conversionRate)
-	if len(conversionRate) == 0 {
-		l.Fields(logger.Fields{"msg": string(res)}).Error(err, "can't unmarshal response")
-		return 0, errors.New("cannot get exchange rates")
+	url := fmt.Sprintf("%v?source=%v&target=%v&time=%v", w.getUrl(rates), sourceCurrency, targetCurrency, at.UTC().Format("2006-01-02T15:04:05"))
+	rate, err := w.requestRate(l, url)
+	if err != nil {
+		return 0, err
 	}
 
-	// save to cache for further request within 5 minutes
-	w.setCache(sourceCurrency+targetCurrency, conversionRate[0].Rate)
+	w.setCache(cacheKey, rate)
 
-	return conversionRate[0].Rate, nil
+	return rate, nil
 }
 
 // ///////////////////
@@ -193,6 +210,39 @@ func getLocalRate(target string) (float64, error) {
 	return 1, nil
 }
 
+// requestRate calls the Wise rates api and returns the first rate in the response
+func (w *wiseService) requestRate(l logger.Logger, url string) (float64, error) {
+	var conversionRate []model.WiseConversionRate
+
+	req, err := w.newRequest("GET", url, nil)
+	if err != nil {
+		l.Error(err, "can't build request")
+		return 0, err
+	}
+
+	// read response
+	resp, err := client.Do(req)
+	if err != nil {
+		l.Error(err, "can't get response")
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	res, err := io.ReadAll(resp.Body)
+	if err != nil {
+		l.Error(err, "can't read response")
+		return 0, err
+	}
+
+	err = json.Unmarshal(res, &conversionRate)
+	if len(conversionRate) == 0 {
+		l.Fields(logger.Fields{"msg": string(res)}).Error(err, "can't unmarshal response")
+		return 0, errors.New("cannot get exchange rates")
+	}
+
+	return conversionRate[0].Rate, nil
+}
+
 func (w *wiseService) getUrl(api string) string {
 	return w.cfg.Wise.Url + apiV1 + api
 }
@@ -265,6 +265,15 @@ func (s *Service) GetUSDToVNDRate() (float64, error) {
 	return rate, nil
 }
 
+// GetUSDToVNDRateAt gets the USD to VND exchange rate at the given date using the Wise API
+func (s *Service) GetUSDToVNDRateAt(date time.Time) (float64, error) {
+	rate, err := s.wiseService.GetRateAt("USD", "VND", date)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get USD to VND exchange rate at %s from Wise API: %w", date.Format("2006-01-02"), err)
+	}
+	return rate, nil
+}
+
 // FinancialReportParams represents parameters for financial report generation
 type FinancialReportParams struct {
 	Month                  int     `json:"month" binding:"required,min=1,max=12"`
@@ -29,6 +29,10 @@ func (m *mockWiseService) GetRate(source, target string) (rate float64, err erro
 	return m.rateToReturn, m.errorToReturn
 }
 
+func (m *mockWiseService) GetRateAt(source, target string, at time.Time) (rate float64, err error) {
+	return m.rateToReturn, m.errorToReturn
+}
+
 func TestService_ValidateMonthlyPayrollParams(t *testing.T) {
 	service := &Service{
 		store: store.New(),
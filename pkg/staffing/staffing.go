@@ -0,0 +1,294 @@
+// Package staffing ranks the employees for a project slot on how well they fit it: the stacks of
+// the project they know, the positions and the seniority the slot asks for, their prior work for
+// the client, their MMA score and how much of their time is free. Every score comes with the
+// reasons behind it, so a staffing call can be argued rather than remembered.
+package staffing
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MMAScale is the highest mastery, autonomy and meaning score
+const MMAScale = 5
+
+const (
+	FactorStack       = "stack"
+	FactorPosition    = "position"
+	FactorSeniority   = "seniority"
+	FactorClient      = "client"
+	FactorMMA         = "mma"
+	FactorUtilization = "utilization"
+)
+
+// Weights is the share of each factor in the score, they do not need to sum to 1
+type Weights struct {
+	Stack       float64
+	Position    float64
+	Seniority   float64
+	Client      float64
+	MMA         float64
+	Utilization float64
+}
+
+// DefaultWeights favours the fit of the skills over the rest
+var DefaultWeights = Weights{
+	Stack:       0.3,
+	Position:    0.15,
+	Seniority:   0.2,
+	Client:      0.1,
+	MMA:         0.1,
+	Utilization: 0.15,
+}
+
+// Slot is what the slot asks for, Stacks are the ones of its project and EmployeeID the member on
+// it, empty while it is open
+type Slot struct {
+	ID             string
+	ProjectID      string
+	ProjectName    string
+	DeploymentType string
+	Status         string
+	EmployeeID     string
+	SeniorityName  string
+	SeniorityLevel int
+	Positions      []string
+	Stacks         []string
+	ClientName     string
+}
+
+type MMA struct {
+	Mastery  float64
+	Autonomy float64
+	Meaning  float64
+}
+
+// Candidate is an employee free for the slot from AvailableFrom. Utilization is the share of
+// their current week already allocated, ClientProjects the projects they worked on for the
+// client of the slot and MMA their latest score, nil when never rated.
+type Candidate struct {
+	EmployeeID     string
+	Name           string
+	SeniorityName  string
+	SeniorityLevel int
+	Stacks         []string
+	Positions      []string
+	Chapters       []string
+	ClientProjects []string
+	MMA            *MMA
+	Utilization    float64
+	AvailableFrom  time.Time
+}
+
+// Factor is the score of a candidate on one criterion, from 0 to 1, and why
+type Factor struct {
+	Name   string
+	Weight float64
+	Score  float64
+	Reason string
+}
+
+// Recommendation is a ranked candidate, Score goes from 0 to 100
+type Recommendation struct {
+	Candidate
+	Rank    int
+	Score   float64
+	Factors []Factor
+}
+
+// Shortlist is the ranked candidates free for a slot within the weeks from From
+type Shortlist struct {
+	Slot       Slot
+	From       time.Time
+	Weeks      int
+	Candidates []Recommendation
+}
+
+// Rank scores the candidates for the slot, the best first. Candidates with the same score are
+// ordered by the earliest available, then by name.
+func Rank(slot Slot, candidates []Candidate, w Weights) []Recommendation {
+	res := make([]Recommendation, 0, len(candidates))
+	for _, c := range candidates {
+		res = append(res, Score(slot, c, w))
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		if !res[i].AvailableFrom.Equal(res[j].AvailableFrom) {
+			return res[i].AvailableFrom.Before(res[j].AvailableFrom)
+		}
+		return res[i].Name < res[j].Name
+	})
+	for i := range res {
+		res[i].Rank = i + 1
+	}
+
+	return res
+}
+
+// Score scores a candidate for the slot
+func Score(slot Slot, c Candidate, w Weights) Recommendation {
+	factors := []Factor{
+		stackFactor(slot, c, w.Stack),
+		positionFactor(slot, c, w.Position),
+		seniorityFactor(slot, c, w.Seniority),
+		clientFactor(slot, c, w.Client),
+		mmaFactor(c, w.MMA),
+		utilizationFactor(c, w.Utilization),
+	}
+
+	var total, weights float64
+	for _, f := range factors {
+		total += f.Score * f.Weight
+		weights += f.Weight
+	}
+
+	score := 0.0
+	if weights > 0 {
+		score = round(total / weights * 100)
+	}
+
+	return Recommendation{Candidate: c, Score: score, Factors: factors}
+}
+
+func stackFactor(slot Slot, c Candidate, weight float64) Factor {
+	f := Factor{Name: FactorStack, Weight: weight}
+	if len(slot.Stacks) == 0 {
+		f.Score = 1
+		f.Reason = "the project has no stack to match"
+		return f
+	}
+
+	known := overlap(slot.Stacks, c.Stacks)
+	f.Score = float64(len(known)) / float64(len(slot.Stacks))
+	if len(known) == 0 {
+		f.Reason = fmt.Sprintf("knows none of %s", strings.Join(slot.Stacks, ", "))
+		return f
+	}
+	f.Reason = fmt.Sprintf("knows %s of %s", strings.Join(known, ", "), strings.Join(slot.Stacks, ", "))
+	return f
+}
+
+func positionFactor(slot Slot, c Candidate, weight float64) Factor {
+	f := Factor{Name: FactorPosition, Weight: weight}
+	if len(slot.Positions) == 0 {
+		f.Score = 1
+		f.Reason = "the slot asks for no position"
+		return f
+	}
+
+	held := overlap(slot.Positions, c.Positions)
+	if len(held) == 0 {
+		f.Reason = fmt.Sprintf("is not a %s", strings.Join(slot.Positions, " or "))
+		return f
+	}
+	f.Score = float64(len(held)) / float64(len(slot.Positions))
+	f.Reason = fmt.Sprintf("is a %s", strings.Join(held, " and "))
+	return f
+}
+
+// seniorityFactor takes a level off every level of gap, a level under the slot costs more than
+// a level over it
+func seniorityFactor(slot Slot, c Candidate, weight float64) Factor {
+	f := Factor{Name: FactorSeniority, Weight: weight}
+	if slot.SeniorityLevel == 0 {
+		f.Score = 1
+		f.Reason = "the slot asks for no seniority"
+		return f
+	}
+	if c.SeniorityLevel == 0 {
+		f.Reason = fmt.Sprintf("has no seniority for a %s slot", slot.SeniorityName)
+		return f
+	}
+
+	gap := c.SeniorityLevel - slot.SeniorityLevel
+	switch {
+	case gap == 0:
+		f.Score = 1
+		f.Reason = fmt.Sprintf("is %s as the slot asks", c.SeniorityName)
+	case gap > 0:
+		f.Score = math.Max(0, 1-0.25*float64(gap))
+		f.Reason = fmt.Sprintf("is %s for a %s slot, %s above", c.SeniorityName, slot.SeniorityName, levels(gap))
+	default:
+		f.Score = math.Max(0, 1-0.5*float64(-gap))
+		f.Reason = fmt.Sprintf("is %s for a %s slot, %s under", c.SeniorityName, slot.SeniorityName, levels(-gap))
+	}
+	return f
+}
+
+func clientFactor(slot Slot, c Candidate, weight float64) Factor {
+	f := Factor{Name: FactorClient, Weight: weight}
+	if slot.ClientName == "" {
+		f.Reason = "the project has no client"
+		return f
+	}
+	if len(c.ClientProjects) == 0 {
+		f.Reason = fmt.Sprintf("never worked for %s", slot.ClientName)
+		return f
+	}
+
+	f.Score = 1
+	f.Reason = fmt.Sprintf("worked for %s on %s", slot.ClientName, strings.Join(c.ClientProjects, ", "))
+	return f
+}
+
+// mmaFactor gives the candidates never rated the middle of the scale, not to push the newcomers
+// to the bottom
+func mmaFactor(c Candidate, weight float64) Factor {
+	f := Factor{Name: FactorMMA, Weight: weight}
+	if c.MMA == nil {
+		f.Score = 0.5
+		f.Reason = "has no MMA score yet"
+		return f
+	}
+
+	avg := (c.MMA.Mastery + c.MMA.Autonomy + c.MMA.Meaning) / 3
+	f.Score = clamp(avg / MMAScale)
+	f.Reason = fmt.Sprintf("scores %.1f/%d on MMA (mastery %.1f, autonomy %.1f, meaning %.1f)",
+		avg, MMAScale, c.MMA.Mastery, c.MMA.Autonomy, c.MMA.Meaning)
+	return f
+}
+
+func utilizationFactor(c Candidate, weight float64) Factor {
+	f := Factor{Name: FactorUtilization, Weight: weight}
+	f.Score = clamp(1 - c.Utilization)
+	f.Reason = fmt.Sprintf("is %d%% allocated this week, free from %s",
+		int(math.Round(c.Utilization*100)), c.AvailableFrom.Format("2006-01-02"))
+	return f
+}
+
+// overlap returns the wanted values the candidate has, compared case insensitively
+func overlap(wanted, has []string) []string {
+	set := map[string]bool{}
+	for _, h := range has {
+		set[strings.ToLower(strings.TrimSpace(h))] = true
+	}
+
+	res := []string{}
+	for _, v := range wanted {
+		if set[strings.ToLower(strings.TrimSpace(v))] {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func levels(n int) string {
+	if n == 1 {
+		return "one level"
+	}
+	return fmt.Sprintf("%d levels", n)
+}
+
+func clamp(v float64) float64 {
+	return math.Min(1, math.Max(0, v))
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
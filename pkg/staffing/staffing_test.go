@@ -0,0 +1,115 @@
+package staffing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var monday = time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+var slot = Slot{
+	SeniorityName:  "Senior",
+	SeniorityLevel: 3,
+	Positions:      []string{"Backend"},
+	Stacks:         []string{"Golang", "React"},
+	ClientName:     "Acme",
+}
+
+func factor(r Recommendation, name string) Factor {
+	for _, f := range r.Factors {
+		if f.Name == name {
+			return f
+		}
+	}
+	return Factor{}
+}
+
+func TestScore_Factors(t *testing.T) {
+	r := Score(slot, Candidate{
+		Name:           "alice",
+		SeniorityName:  "Mid",
+		SeniorityLevel: 2,
+		Stacks:         []string{"golang", "Elixir"},
+		Positions:      []string{"Backend", "Frontend"},
+		ClientProjects: []string{"Acme Web"},
+		MMA:            &MMA{Mastery: 4, Autonomy: 3, Meaning: 5},
+		Utilization:    0.5,
+		AvailableFrom:  monday,
+	}, DefaultWeights)
+
+	stack := factor(r, FactorStack)
+	require.Equal(t, 0.5, stack.Score)
+	require.Equal(t, "knows Golang of Golang, React", stack.Reason)
+
+	require.Equal(t, 1.0, factor(r, FactorPosition).Score)
+
+	seniority := factor(r, FactorSeniority)
+	require.Equal(t, 0.5, seniority.Score)
+	require.Equal(t, "is Mid for a Senior slot, one level under", seniority.Reason)
+
+	client := factor(r, FactorClient)
+	require.Equal(t, 1.0, client.Score)
+	require.Equal(t, "worked for Acme on Acme Web", client.Reason)
+
+	require.Equal(t, 0.8, factor(r, FactorMMA).Score)
+
+	utilization := factor(r, FactorUtilization)
+	require.Equal(t, 0.5, utilization.Score)
+	require.Equal(t, "is 50% allocated this week, free from 2026-10-19", utilization.Reason)
+
+	// 0.3*0.5 + 0.15*1 + 0.2*0.5 + 0.1*1 + 0.1*0.8 + 0.15*0.5 = 0.655
+	require.Equal(t, 65.5, r.Score)
+}
+
+func TestScore_Seniority(t *testing.T) {
+	over := Score(slot, Candidate{SeniorityName: "Principal", SeniorityLevel: 5}, DefaultWeights)
+	require.Equal(t, 0.5, factor(over, FactorSeniority).Score)
+	require.Equal(t, "is Principal for a Senior slot, 2 levels above", factor(over, FactorSeniority).Reason)
+
+	under := Score(slot, Candidate{SeniorityName: "Junior", SeniorityLevel: 1}, DefaultWeights)
+	require.Equal(t, 0.0, factor(under, FactorSeniority).Score)
+
+	none := Score(slot, Candidate{}, DefaultWeights)
+	require.Equal(t, 0.0, factor(none, FactorSeniority).Score)
+}
+
+func TestScore_Neutral(t *testing.T) {
+	r := Score(Slot{}, Candidate{AvailableFrom: monday}, DefaultWeights)
+
+	require.Equal(t, 1.0, factor(r, FactorStack).Score)
+	require.Equal(t, 1.0, factor(r, FactorPosition).Score)
+	require.Equal(t, 1.0, factor(r, FactorSeniority).Score)
+	require.Equal(t, 0.0, factor(r, FactorClient).Score)
+	require.Equal(t, "the project has no client", factor(r, FactorClient).Reason)
+	// newcomers never rated land in the middle
+	require.Equal(t, 0.5, factor(r, FactorMMA).Score)
+	require.Equal(t, "has no MMA score yet", factor(r, FactorMMA).Reason)
+}
+
+func TestRank(t *testing.T) {
+	later := monday.AddDate(0, 0, 14)
+	candidates := []Candidate{
+		{Name: "carol", SeniorityLevel: 3, Stacks: []string{"Golang"}, Utilization: 1, AvailableFrom: later},
+		{Name: "bob", SeniorityLevel: 3, Stacks: []string{"Golang", "React"}, Positions: []string{"Backend"}, AvailableFrom: monday},
+		{Name: "alice", SeniorityLevel: 3, Stacks: []string{"Golang"}, Utilization: 1, AvailableFrom: monday},
+		{Name: "dave", SeniorityLevel: 3, Stacks: []string{"Golang"}, Utilization: 1, AvailableFrom: monday},
+	}
+
+	res := Rank(slot, candidates, DefaultWeights)
+	require.Len(t, res, 4)
+
+	names := []string{}
+	for i, r := range res {
+		require.Equal(t, i+1, r.Rank)
+		names = append(names, r.Name)
+	}
+	// the same score goes to the earliest available, then by name
+	require.Equal(t, []string{"bob", "alice", "dave", "carol"}, names)
+}
+
+func TestScore_ZeroWeights(t *testing.T) {
+	r := Score(slot, Candidate{}, Weights{})
+	require.Equal(t, 0.0, r.Score)
+}
@@ -37,6 +37,11 @@ func (s *accountingService) GetAccountingTransactions(db *gorm.DB) ([]model.Acco
 		Error
 }
 
+func (s *accountingService) GetTransactionByID(db *gorm.DB, id string) (*model.AccountingTransaction, error) {
+	var transaction model.AccountingTransaction
+	return &transaction, db.Where("id = ?", id).Preload("CurrencyInfo").First(&transaction).Error
+}
+
 func (s *accountingService) CreateTransaction(db *gorm.DB, transaction *model.AccountingTransaction) error {
 	return db.Create(transaction).Error
 }
@@ -98,3 +103,15 @@ func (s *accountingService) CreateMultipleTransaction(db *gorm.DB, transactions
 	tx.Commit()
 	return nil
 }
+
+// GetUnpostedTransactions get the transactions which have no journal entry in the general ledger yet,
+// the depreciation is left out as it is posted from the asset depreciation
+func (s *accountingService) GetUnpostedTransactions(db *gorm.DB, limit int) ([]model.AccountingTransaction, error) {
+	var transactions []model.AccountingTransaction
+	return transactions, db.
+		Where("NOT EXISTS (SELECT 1 FROM journal_entries je WHERE je.accounting_transaction_id = accounting_transactions.id AND je.deleted_at IS NULL)").
+		Where("metadata->>'source' IS DISTINCT FROM ?", model.AssetDepreciationSource).
+		Order("date").
+		Limit(limit).
+		Find(&transactions).Error
+}
@@ -10,7 +10,9 @@ import (
 type IStore interface {
 	CreateTransaction(db *gorm.DB, transaction *model.AccountingTransaction) error
 	GetAccountingTransactions(db *gorm.DB) ([]model.AccountingTransaction, error)
+	GetTransactionByID(db *gorm.DB, id string) (*model.AccountingTransaction, error)
 	GetAccountingCategories(db *gorm.DB) ([]model.AccountingCategory, error)
 	DeleteTransaction(db *gorm.DB, t *model.AccountingTransaction) error
 	CreateMultipleTransaction(db *gorm.DB, transactions []*model.AccountingTransaction) error
+	GetUnpostedTransactions(db *gorm.DB, limit int) ([]model.AccountingTransaction, error)
 }
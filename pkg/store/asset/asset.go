@@ -0,0 +1,95 @@
+package asset
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, asset *model.Asset) (*model.Asset, error) {
+	return asset, db.Create(asset).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Asset, updatedFields ...string) (*model.Asset, error) {
+	asset := model.Asset{}
+	return &asset, db.Model(&asset).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// One get an asset with its holder, its assignment history and its booked depreciation
+func (s *store) One(db *gorm.DB, id string) (*model.Asset, error) {
+	var asset model.Asset
+	return &asset, db.Where("id = ?", id).
+		Preload("Currency").
+		Preload("Holder", "deleted_at IS NULL").
+		Preload("Expense").
+		Preload("Assignments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("asset_assignments.assigned_at DESC, asset_assignments.created_at DESC")
+		}).
+		Preload("Assignments.Employee").
+		Preload("Depreciations", func(db *gorm.DB) *gorm.DB {
+			return db.Order("asset_depreciations.year, asset_depreciations.month")
+		}).
+		First(&asset).Error
+}
+
+func (s *store) OneBySerialNumber(db *gorm.DB, serialNumber string) (*model.Asset, error) {
+	var asset model.Asset
+	return &asset, db.Where("serial_number = ?", serialNumber).First(&asset).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Asset, int64, error) {
+	var (
+		total  int64
+		assets []*model.Asset
+	)
+
+	db = db.Model(&model.Asset{})
+	if query.Category != "" {
+		db = db.Where("category = ?", query.Category)
+	}
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.HolderID != "" {
+		db = db.Where("used_by = ?", query.HolderID)
+	}
+	if query.Keyword != "" {
+		keyword := "%" + query.Keyword + "%"
+		db = db.Where("name ILIKE ? OR serial_number ILIKE ?", keyword, keyword)
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return assets, total, db.
+		Preload("Currency").
+		Preload("Holder", "deleted_at IS NULL").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&assets).Error
+}
+
+// AllDepreciable get the assets with a cost spread over a useful life
+func (s *store) AllDepreciable(db *gorm.DB) ([]*model.Asset, error) {
+	var assets []*model.Asset
+	return assets, db.
+		Where("useful_life_months > 0 AND price > 0 AND purchased_at IS NOT NULL").
+		Preload("Currency").
+		Find(&assets).Error
+}
+
+// AllByHolderID get the assets an employee has not returned yet
+func (s *store) AllByHolderID(db *gorm.DB, employeeID string) ([]*model.Asset, error) {
+	var assets []*model.Asset
+	return assets, db.Where("used_by = ? AND status = ?", employeeID, model.AssetStatusAssigned).
+		Order("name").
+		Find(&assets).Error
+}
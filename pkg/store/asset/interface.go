@@ -0,0 +1,25 @@
+package asset
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, asset *model.Asset) (*model.Asset, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Asset, updatedFields ...string) (*model.Asset, error)
+	One(db *gorm.DB, id string) (*model.Asset, error)
+	OneBySerialNumber(db *gorm.DB, serialNumber string) (*model.Asset, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Asset, int64, error)
+	AllDepreciable(db *gorm.DB) ([]*model.Asset, error)
+	AllByHolderID(db *gorm.DB, employeeID string) ([]*model.Asset, error)
+}
+
+// Query present asset query from user
+type Query struct {
+	Category model.AssetCategory
+	Status   model.AssetStatus
+	HolderID string
+	Keyword  string
+}
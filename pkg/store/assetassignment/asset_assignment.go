@@ -0,0 +1,28 @@
+package assetassignment
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, assignment *model.AssetAssignment) (*model.AssetAssignment, error) {
+	return assignment, db.Create(assignment).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.AssetAssignment, updatedFields ...string) (*model.AssetAssignment, error) {
+	assignment := model.AssetAssignment{}
+	return &assignment, db.Model(&assignment).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneOpenByAssetID get the assignment of the employee holding the asset
+func (s *store) OneOpenByAssetID(db *gorm.DB, assetID string) (*model.AssetAssignment, error) {
+	var assignment model.AssetAssignment
+	return &assignment, db.Where("asset_id = ? AND returned_at IS NULL", assetID).First(&assignment).Error
+}
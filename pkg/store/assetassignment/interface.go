@@ -0,0 +1,13 @@
+package assetassignment
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, assignment *model.AssetAssignment) (*model.AssetAssignment, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.AssetAssignment, updatedFields ...string) (*model.AssetAssignment, error)
+	OneOpenByAssetID(db *gorm.DB, assetID string) (*model.AssetAssignment, error)
+}
@@ -0,0 +1,26 @@
+package assetdepreciation
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, depreciation *model.AssetDepreciation) (*model.AssetDepreciation, error) {
+	return depreciation, db.Create(depreciation).Error
+}
+
+// IsExist tells whether the depreciation of the month is already booked for the asset
+func (s *store) IsExist(db *gorm.DB, assetID string, year, month int) (bool, error) {
+	var total int64
+	err := db.Model(&model.AssetDepreciation{}).
+		Where("asset_id = ? AND year = ? AND month = ?", assetID, year, month).
+		Count(&total).Error
+	return total > 0, err
+}
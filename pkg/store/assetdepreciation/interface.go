@@ -0,0 +1,12 @@
+package assetdepreciation
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, depreciation *model.AssetDepreciation) (*model.AssetDepreciation, error)
+	IsExist(db *gorm.DB, assetID string, year, month int) (bool, error)
+}
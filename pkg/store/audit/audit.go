@@ -1,6 +1,8 @@
 package audit
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -24,6 +26,16 @@ func (s *store) All(db *gorm.DB) ([]*model.Audit, error) {
 	return audit, db.Find(&audit).Error
 }
 
+// AllByParticipant get the audits an employee took part in, audited in the period
+func (s *store) AllByParticipant(db *gorm.DB, employeeID string, from, to time.Time) ([]*model.Audit, error) {
+	var audits []*model.Audit
+	return audits, db.
+		Where("id IN (SELECT audit_id FROM audit_participants WHERE employee_id = ? AND deleted_at IS NULL)", employeeID).
+		Where("audited_at >= ? AND audited_at < ?", from, to).
+		Order("audited_at").
+		Find(&audits).Error
+}
+
 // Delete delete 1 audit by id
 func (s *store) Delete(db *gorm.DB, id string) error {
 	return db.Where("id = ?", id).Delete(&model.Audit{}).Error
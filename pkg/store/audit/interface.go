@@ -1,6 +1,8 @@
 package audit
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -9,6 +11,7 @@ import (
 type IStore interface {
 	One(db *gorm.DB, id string) (audit *model.Audit, err error)
 	All(db *gorm.DB) (audits []*model.Audit, err error)
+	AllByParticipant(db *gorm.DB, employeeID string, from, to time.Time) (audits []*model.Audit, err error)
 	Delete(db *gorm.DB, id string) (err error)
 	Create(db *gorm.DB, e *model.Audit) (audit *model.Audit, err error)
 	Update(db *gorm.DB, audit *model.Audit) (a *model.Audit, err error)
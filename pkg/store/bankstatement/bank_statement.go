@@ -0,0 +1,47 @@
+package bankstatement
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, statement *model.BankStatement) (*model.BankStatement, error) {
+	return statement, db.Create(statement).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.BankStatement, error) {
+	var statement model.BankStatement
+	return &statement, db.Where("id = ?", id).First(&statement).Error
+}
+
+// All get the imported statements, the latest first
+func (s *store) All(db *gorm.DB, pagination model.Pagination) ([]*model.BankStatement, int64, error) {
+	var (
+		total      int64
+		statements []*model.BankStatement
+	)
+
+	query := db.Model(&model.BankStatement{})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return statements, total, query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&statements).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, statement model.BankStatement, updatedFields ...string) (*model.BankStatement, error) {
+	st := model.BankStatement{}
+	return &st, db.Model(&st).Where("id = ?", id).Select(updatedFields).Updates(statement).First(&st).Error
+}
@@ -0,0 +1,14 @@
+package bankstatement
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, statement *model.BankStatement) (*model.BankStatement, error)
+	One(db *gorm.DB, id string) (*model.BankStatement, error)
+	All(db *gorm.DB, pagination model.Pagination) ([]*model.BankStatement, int64, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, statement model.BankStatement, updatedFields ...string) (*model.BankStatement, error)
+}
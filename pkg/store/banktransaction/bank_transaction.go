@@ -0,0 +1,86 @@
+package banktransaction
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) CreateMany(db *gorm.DB, transactions []model.BankTransaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+	return db.CreateInBatches(transactions, 100).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.BankTransaction, error) {
+	var tx model.BankTransaction
+	return &tx, db.Where("id = ?", id).First(&tx).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.BankTransaction, int64, error) {
+	var (
+		total        int64
+		transactions []*model.BankTransaction
+	)
+
+	db = db.Model(&model.BankTransaction{})
+	if query.StatementID != "" {
+		db = db.Where("bank_statement_id = ?", query.StatementID)
+	}
+	if len(query.Statuses) > 0 {
+		db = db.Where("status IN ?", query.Statuses)
+	}
+	if query.Direction != "" {
+		db = db.Where("direction = ?", query.Direction)
+	}
+	if query.MatchType != "" {
+		db = db.Where("match_type = ?", query.MatchType)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Sort != "" {
+		db = db.Order(pagination.Sort)
+	} else {
+		db = db.Order("booked_at DESC, created_at DESC")
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return transactions, total, db.Limit(limit).Offset(offset).Find(&transactions).Error
+}
+
+// GetExistingFingerprints returns the fingerprints already imported among the given ones
+func (s *store) GetExistingFingerprints(db *gorm.DB, fingerprints []string) ([]string, error) {
+	var existing []string
+	if len(fingerprints) == 0 {
+		return existing, nil
+	}
+	return existing, db.Model(&model.BankTransaction{}).
+		Where("fingerprint IN ?", fingerprints).
+		Pluck("fingerprint", &existing).Error
+}
+
+// GetMatchedIDs returns the records among matchIDs already reconciled with a bank transaction
+func (s *store) GetMatchedIDs(db *gorm.DB, matchType model.BankMatchType, matchIDs []string) ([]string, error) {
+	var matched []string
+	if len(matchIDs) == 0 {
+		return matched, nil
+	}
+	return matched, db.Model(&model.BankTransaction{}).
+		Where("status = ? AND match_type = ? AND match_id IN ?", model.BankTransactionStatusMatched, matchType, matchIDs).
+		Pluck("match_id", &matched).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, transaction model.BankTransaction, updatedFields ...string) (*model.BankTransaction, error) {
+	tx := model.BankTransaction{}
+	return &tx, db.Model(&tx).Where("id = ?", id).Select(updatedFields).Updates(transaction).First(&tx).Error
+}
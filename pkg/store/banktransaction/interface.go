@@ -0,0 +1,24 @@
+package banktransaction
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	CreateMany(db *gorm.DB, transactions []model.BankTransaction) error
+	One(db *gorm.DB, id string) (*model.BankTransaction, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.BankTransaction, int64, error)
+	GetExistingFingerprints(db *gorm.DB, fingerprints []string) ([]string, error)
+	GetMatchedIDs(db *gorm.DB, matchType model.BankMatchType, matchIDs []string) ([]string, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, transaction model.BankTransaction, updatedFields ...string) (*model.BankTransaction, error)
+}
+
+// Query present bank transaction query from user
+type Query struct {
+	StatementID string
+	Statuses    []model.BankTransactionStatus
+	Direction   model.BankTransactionDirection
+	MatchType   model.BankMatchType
+}
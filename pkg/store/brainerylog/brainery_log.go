@@ -19,6 +19,12 @@ func (s *store) Create(db *gorm.DB, b []model.BraineryLog) (braineryLog []model.
 	return b, db.Create(b).Error
 }
 
+// All gets all brainery logs
+func (s *store) All(db *gorm.DB) ([]*model.BraineryLog, error) {
+	var logs []*model.BraineryLog
+	return logs, db.Order("published_at DESC").Find(&logs).Error
+}
+
 // GetLimitByTimeRange gets brainery logs in a specific time range, with limit
 func (s *store) GetLimitByTimeRange(db *gorm.DB, start, end *time.Time, limit int) ([]*model.BraineryLog, error) {
 	var logs []*model.BraineryLog
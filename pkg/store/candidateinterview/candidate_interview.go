@@ -0,0 +1,47 @@
+package candidateinterview
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, interview *model.CandidateInterview) (*model.CandidateInterview, error) {
+	return interview, db.Create(interview).Error
+}
+
+func (s *store) Update(db *gorm.DB, interview *model.CandidateInterview) (*model.CandidateInterview, error) {
+	return interview, db.Omit("Interviewer").Save(interview).Error
+}
+
+// One get an interview of a candidate
+func (s *store) One(db *gorm.DB, candidateID string, id string) (*model.CandidateInterview, error) {
+	var interview model.CandidateInterview
+	return &interview, db.Where("candidate_id = ? AND id = ?", candidateID, id).
+		Preload("Interviewer").
+		First(&interview).Error
+}
+
+// IsExist tells whether the interviewer is already assigned to the candidate at the stage
+func (s *store) IsExist(db *gorm.DB, candidateID string, stageID *model.UUID, interviewerID string) (bool, error) {
+	var total int64
+
+	db = db.Model(&model.CandidateInterview{}).Where("candidate_id = ? AND interviewer_id = ?", candidateID, interviewerID)
+	if stageID == nil {
+		db = db.Where("stage_id IS NULL")
+	} else {
+		db = db.Where("stage_id = ?", stageID)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
@@ -0,0 +1,14 @@
+package candidateinterview
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, interview *model.CandidateInterview) (*model.CandidateInterview, error)
+	Update(db *gorm.DB, interview *model.CandidateInterview) (*model.CandidateInterview, error)
+	One(db *gorm.DB, candidateID string, id string) (*model.CandidateInterview, error)
+	IsExist(db *gorm.DB, candidateID string, stageID *model.UUID, interviewerID string) (bool, error)
+}
@@ -0,0 +1,35 @@
+package candidatetransition
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, transition *model.CandidateStageTransition) (*model.CandidateStageTransition, error) {
+	return transition, db.Create(transition).Error
+}
+
+func (s *store) Update(db *gorm.DB, transition *model.CandidateStageTransition) (*model.CandidateStageTransition, error) {
+	return transition, db.Model(transition).Updates(map[string]interface{}{
+		"email_sent_at": transition.EmailSentAt,
+		"email_error":   transition.EmailError,
+	}).Error
+}
+
+// AllByCandidateID get the transitions of a candidate, the oldest first
+func (s *store) AllByCandidateID(db *gorm.DB, candidateID string) ([]*model.CandidateStageTransition, error) {
+	var transitions []*model.CandidateStageTransition
+	return transitions, db.Where("candidate_id = ?", candidateID).
+		Preload("FromStage").
+		Preload("ToStage").
+		Preload("Actor").
+		Order("created_at").
+		Find(&transitions).Error
+}
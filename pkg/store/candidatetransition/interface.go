@@ -0,0 +1,13 @@
+package candidatetransition
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, transition *model.CandidateStageTransition) (*model.CandidateStageTransition, error)
+	Update(db *gorm.DB, transition *model.CandidateStageTransition) (*model.CandidateStageTransition, error)
+	AllByCandidateID(db *gorm.DB, candidateID string) ([]*model.CandidateStageTransition, error)
+}
@@ -0,0 +1,104 @@
+package capacity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// GetEmployees returns the employees of the company deployable on projects, the ones left and the
+// sales and operations people out, like the resource availability dashboard
+func (s *store) GetEmployees(db *gorm.DB) ([]model.CapacityEmployee, error) {
+	var res []model.CapacityEmployee
+	return res, db.Raw(`
+		SELECT employees.id AS employee_id, employees.full_name, employees.display_name,
+			seniorities.name AS seniority_name, string_agg(DISTINCT stacks.name, ',') AS stacks
+		FROM employees
+			LEFT JOIN seniorities ON seniorities.id = employees.seniority_id
+			LEFT JOIN employee_stacks ON employee_stacks.employee_id = employees.id AND employee_stacks.deleted_at IS NULL
+			LEFT JOIN stacks ON stacks.id = employee_stacks.stack_id AND stacks.deleted_at IS NULL
+		WHERE employees.deleted_at IS NULL
+			AND employees.working_status <> ?
+			AND employees.id IN (
+				SELECT eo.employee_id
+				FROM employee_organizations eo JOIN organizations o ON eo.organization_id = o.id
+				WHERE o.deleted_at IS NULL AND eo.deleted_at IS NULL AND o.code = ?
+			)
+			AND employees.id IN (
+				SELECT e2.id
+				FROM employees e2
+					LEFT JOIN employee_chapters ec ON ec.employee_id = e2.id
+					LEFT JOIN chapters c ON ec.chapter_id = c.id
+				WHERE ec.deleted_at IS NULL
+					AND c.deleted_at IS NULL
+					AND (c.code IS NULL OR (c.code <> 'sales' AND c.code <> 'operations'))
+			)
+		GROUP BY employees.id, seniorities.name
+		ORDER BY employees.full_name`,
+		model.WorkingStatusLeft, model.OrganizationCodeDwarves).
+		Scan(&res).Error
+}
+
+// GetDeployments returns the deployments on the client projects overlapping the range, the
+// internal projects do not take anyone off the bench
+func (s *store) GetDeployments(db *gorm.DB, from, to time.Time) ([]model.CapacityDeployment, error) {
+	var res []model.CapacityDeployment
+	return res, db.Raw(`
+		SELECT project_members.id AS project_member_id, project_members.project_id, projects.name AS project_name,
+			project_members.project_slot_id, project_members.employee_id, project_members.deployment_type,
+			project_members.start_date, project_members.end_date
+		FROM project_members
+			JOIN projects ON projects.id = project_members.project_id AND projects.deleted_at IS NULL
+		WHERE project_members.deleted_at IS NULL
+			AND project_members.status <> ?
+			AND projects.status IN ?
+			AND projects.type <> ?
+			AND (project_members.start_date IS NULL OR project_members.start_date < ?)
+			AND (project_members.end_date IS NULL OR project_members.end_date >= ?)`,
+		model.ProjectMemberStatusInactive,
+		[]model.ProjectStatus{model.ProjectStatusOnBoarding, model.ProjectStatusActive},
+		model.ProjectTypeDwarves, to, from).
+		Scan(&res).Error
+}
+
+// GetSlots returns the slots of the running client projects
+func (s *store) GetSlots(db *gorm.DB) ([]model.CapacitySlot, error) {
+	var res []model.CapacitySlot
+	return res, db.Raw(`
+		SELECT project_slots.id AS slot_id, project_slots.project_id, projects.name AS project_name,
+			projects.end_date AS project_end_date, seniorities.name AS seniority_name, project_slots.deployment_type
+		FROM project_slots
+			JOIN projects ON projects.id = project_slots.project_id AND projects.deleted_at IS NULL
+			LEFT JOIN seniorities ON seniorities.id = project_slots.seniority_id
+		WHERE project_slots.deleted_at IS NULL
+			AND project_slots.status <> ?
+			AND projects.status IN ?
+			AND projects.type <> ?
+		ORDER BY projects.name`,
+		model.ProjectMemberStatusInactive,
+		[]model.ProjectStatus{model.ProjectStatusOnBoarding, model.ProjectStatusActive},
+		model.ProjectTypeDwarves).
+		Scan(&res).Error
+}
+
+// GetLeaves returns the approved days off overlapping the range, working remotely is not a leave
+func (s *store) GetLeaves(db *gorm.DB, from, to time.Time) ([]model.CapacityLeave, error) {
+	var res []model.CapacityLeave
+	return res, db.Raw(`
+		SELECT creator_id AS employee_id, start_date, end_date, shift
+		FROM on_leave_requests
+		WHERE deleted_at IS NULL
+			AND type = 'off'
+			AND start_date < ?
+			AND end_date >= ?`,
+		to, from).
+		Scan(&res).Error
+}
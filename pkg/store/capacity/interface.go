@@ -0,0 +1,17 @@
+package capacity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// IStore reads what the capacity of the employees is planned from, for the weeks in [from, to)
+type IStore interface {
+	GetEmployees(db *gorm.DB) ([]model.CapacityEmployee, error)
+	GetDeployments(db *gorm.DB, from, to time.Time) ([]model.CapacityDeployment, error)
+	GetSlots(db *gorm.DB) ([]model.CapacitySlot, error)
+	GetLeaves(db *gorm.DB, from, to time.Time) ([]model.CapacityLeave, error)
+}
@@ -0,0 +1,98 @@
+package cashflow
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) GetOpenReceivables(db *gorm.DB) ([]model.CashFlowReceivable, error) {
+	var res []model.CashFlowReceivable
+	return res, db.Raw(`
+		SELECT invoices.id AS invoice_id, invoices.number, invoices.project_id, projects.client_id,
+			invoices.invoiced_at, invoices.due_at, invoices.total, currencies.name AS currency
+		FROM invoices
+			LEFT JOIN projects ON projects.id = invoices.project_id
+			LEFT JOIN bank_accounts ON bank_accounts.id = invoices.bank_id
+			LEFT JOIN currencies ON currencies.id = bank_accounts.currency_id
+		WHERE invoices.deleted_at IS NULL
+			AND invoices.status IN ?`,
+		[]model.InvoiceStatus{model.InvoiceStatusSent, model.InvoiceStatusOverdue}).
+		Scan(&res).Error
+}
+
+// GetRecurringRevenues returns the monthly billing of the active projects, summed from the rates
+// of their official members, with the last month the project was invoiced for
+func (s *store) GetRecurringRevenues(db *gorm.DB) ([]model.CashFlowRecurringRevenue, error) {
+	var res []model.CashFlowRecurringRevenue
+	return res, db.Raw(`
+		SELECT projects.id AS project_id, projects.name AS project_name, projects.client_id,
+			currencies.name AS currency, SUM(project_members.rate) AS monthly_amount,
+			COALESCE(last_invoice.year, 0) AS last_invoiced_year, COALESCE(last_invoice.month, 0) AS last_invoiced_month
+		FROM projects
+			JOIN project_members ON project_members.project_id = projects.id
+				AND project_members.deleted_at IS NULL
+				AND project_members.status = 'active'
+				AND project_members.deployment_type = 'official'
+				AND (project_members.end_date IS NULL OR project_members.end_date > now())
+			LEFT JOIN bank_accounts ON bank_accounts.id = projects.bank_account_id
+			LEFT JOIN currencies ON currencies.id = bank_accounts.currency_id
+			LEFT JOIN LATERAL (
+				SELECT invoices.year, invoices.month
+				FROM invoices
+				WHERE invoices.project_id = projects.id
+					AND invoices.deleted_at IS NULL
+					AND invoices.status NOT IN ?
+				ORDER BY invoices.year DESC, invoices.month DESC
+				LIMIT 1
+			) last_invoice ON TRUE
+		WHERE projects.deleted_at IS NULL
+			AND projects.status = ?
+			AND projects.type IN ?
+		GROUP BY projects.id, projects.name, projects.client_id, currencies.name, last_invoice.year, last_invoice.month
+		HAVING SUM(project_members.rate) > 0`,
+		[]model.InvoiceStatus{model.InvoiceStatusDraft, model.InvoiceStatusError},
+		model.ProjectStatusActive,
+		[]model.ProjectType{model.ProjectTypeTimeMaterial, model.ProjectTypeFixedCost}).
+		Scan(&res).Error
+}
+
+func (s *store) GetBaseSalaries(db *gorm.DB) ([]model.CashFlowSalary, error) {
+	var res []model.CashFlowSalary
+	return res, db.Raw(`
+		SELECT employees.id AS employee_id, employees.full_name, base_salaries.batch,
+			base_salaries.company_account_amount + base_salaries.personal_account_amount AS amount,
+			currencies.name AS currency, employees.left_date
+		FROM base_salaries
+			JOIN employees ON employees.id = base_salaries.employee_id AND employees.deleted_at IS NULL
+			LEFT JOIN currencies ON currencies.id = base_salaries.currency_id
+		WHERE base_salaries.deleted_at IS NULL
+			AND employees.working_status <> ?`,
+		model.WorkingStatusLeft).
+		Scan(&res).Error
+}
+
+func (s *store) GetActuals(db *gorm.DB, from, to time.Time) ([]model.CashFlowActual, error) {
+	var res []model.CashFlowActual
+	return res, db.Raw(`
+		SELECT date::date AS date,
+			SUM(CASE WHEN type = ? THEN vnd ELSE 0 END) AS inflow,
+			SUM(CASE WHEN type <> ? THEN vnd ELSE 0 END) AS outflow
+		FROM (
+			SELECT date, type, COALESCE(NULLIF(conversion_amount, 0), amount * NULLIF(conversion_rate, 0), amount) AS vnd
+			FROM accounting_transactions
+			WHERE deleted_at IS NULL AND date >= ? AND date < ?
+		) t
+		GROUP BY date::date
+		ORDER BY date::date`,
+		model.AccountingIncome, model.AccountingIncome, from, to).
+		Scan(&res).Error
+}
@@ -0,0 +1,19 @@
+package cashflow
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// IStore reads the receivables, payables and actuals the cash-flow forecast is built from
+type IStore interface {
+	GetOpenReceivables(db *gorm.DB) ([]model.CashFlowReceivable, error)
+	GetRecurringRevenues(db *gorm.DB) ([]model.CashFlowRecurringRevenue, error)
+	GetBaseSalaries(db *gorm.DB) ([]model.CashFlowSalary, error)
+
+	// GetActuals returns the cash booked each day in [from, to), in VND
+	GetActuals(db *gorm.DB, from, to time.Time) ([]model.CashFlowActual, error)
+}
@@ -0,0 +1,45 @@
+package cashflowforecast
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, forecast *model.CashFlowForecast) (*model.CashFlowForecast, error) {
+	return forecast, db.Create(forecast).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.CashFlowForecast, error) {
+	var forecast model.CashFlowForecast
+	return &forecast, db.Where("id = ?", id).First(&forecast).Error
+}
+
+// All get the saved forecasts, the latest first
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.CashFlowForecast, int64, error) {
+	var (
+		total     int64
+		forecasts []*model.CashFlowForecast
+	)
+
+	db = db.Model(&model.CashFlowForecast{})
+	if query.Horizon != "" {
+		db = db.Where("horizon = ?", query.Horizon)
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return forecasts, total, db.
+		Order("start_date DESC, created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&forecasts).Error
+}
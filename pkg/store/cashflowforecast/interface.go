@@ -0,0 +1,18 @@
+package cashflowforecast
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, forecast *model.CashFlowForecast) (*model.CashFlowForecast, error)
+	One(db *gorm.DB, id string) (*model.CashFlowForecast, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.CashFlowForecast, int64, error)
+}
+
+// Query present cash flow forecast query from user
+type Query struct {
+	Horizon model.CashFlowHorizon
+}
@@ -0,0 +1,119 @@
+package clientportal
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// activeAccess keeps the accesses not revoked whose contact and client still exist
+func activeAccess(db *gorm.DB) *gorm.DB {
+	return db.
+		Where("client_portal_accesses.revoked_at IS NULL").
+		Where("EXISTS (SELECT 1 FROM client_contacts WHERE client_contacts.id = client_portal_accesses.client_contact_id AND client_contacts.deleted_at IS NULL)").
+		Where("EXISTS (SELECT 1 FROM clients WHERE clients.id = client_portal_accesses.client_id AND clients.deleted_at IS NULL)")
+}
+
+func (s *store) CreateAccess(db *gorm.DB, access *model.ClientPortalAccess) (*model.ClientPortalAccess, error) {
+	return access, db.Create(access).Error
+}
+
+func (s *store) UpdateAccessSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ClientPortalAccess, updatedFields ...string) (*model.ClientPortalAccess, error) {
+	access := model.ClientPortalAccess{}
+	return &access, db.Model(&access).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// OneAccess get an access by id, revoked or not
+func (s *store) OneAccess(db *gorm.DB, id string) (*model.ClientPortalAccess, error) {
+	var access model.ClientPortalAccess
+	return &access, db.Where("id = ?", id).
+		Preload("Client").
+		Preload("ClientContact").
+		First(&access).Error
+}
+
+// OneActiveAccess get an access by id as long as it can still log in
+func (s *store) OneActiveAccess(db *gorm.DB, id string) (*model.ClientPortalAccess, error) {
+	var access model.ClientPortalAccess
+	return &access, activeAccess(db).
+		Where("client_portal_accesses.id = ?", id).
+		Preload("Client").
+		Preload("ClientContact").
+		First(&access).Error
+}
+
+// OneActiveAccessByEmail get the access an email logs in with
+func (s *store) OneActiveAccessByEmail(db *gorm.DB, email string) (*model.ClientPortalAccess, error) {
+	var access model.ClientPortalAccess
+	return &access, activeAccess(db).
+		Where("lower(client_portal_accesses.email) = ?", strings.ToLower(strings.TrimSpace(email))).
+		Preload("Client").
+		Preload("ClientContact").
+		First(&access).Error
+}
+
+func (s *store) AllAccesses(db *gorm.DB, query AccessQuery) ([]*model.ClientPortalAccess, error) {
+	var accesses []*model.ClientPortalAccess
+
+	db = db.Model(&model.ClientPortalAccess{})
+	if query.ClientID != "" {
+		db = db.Where("client_id = ?", query.ClientID)
+	}
+	if query.ClientContactID != "" {
+		db = db.Where("client_contact_id = ?", query.ClientContactID)
+	}
+	if !query.IncludeRevoked {
+		db = db.Where("revoked_at IS NULL")
+	}
+
+	return accesses, db.
+		Preload("ClientContact").
+		Order("created_at DESC").
+		Find(&accesses).Error
+}
+
+// GetTeam returns the members currently deployed on the active projects of the client. Shadow
+// members are left out as they are not part of the billed team.
+func (s *store) GetTeam(db *gorm.DB, clientID string) ([]model.ClientPortalTeamMember, error) {
+	var res []model.ClientPortalTeamMember
+	return res, db.Raw(`
+		SELECT projects.id AS project_id, projects.name AS project_name,
+			employees.id AS employee_id, employees.full_name, employees.display_name, employees.avatar,
+			employees.team_email, seniorities.name AS seniority_name,
+			project_members.deployment_type, project_members.start_date,
+			(SELECT string_agg(DISTINCT positions.name, ',')
+				FROM project_member_positions
+					JOIN positions ON positions.id = project_member_positions.position_id AND positions.deleted_at IS NULL
+				WHERE project_member_positions.project_member_id = project_members.id
+					AND project_member_positions.deleted_at IS NULL) AS positions,
+			EXISTS (SELECT 1
+				FROM project_heads
+				WHERE project_heads.project_id = projects.id
+					AND project_heads.employee_id = employees.id
+					AND project_heads.deleted_at IS NULL
+					AND (project_heads.end_date IS NULL OR project_heads.end_date > now())) AS is_lead
+		FROM project_members
+			JOIN projects ON projects.id = project_members.project_id AND projects.deleted_at IS NULL
+			JOIN employees ON employees.id = project_members.employee_id AND employees.deleted_at IS NULL
+			LEFT JOIN seniorities ON seniorities.id = project_members.seniority_id
+		WHERE project_members.deleted_at IS NULL
+			AND projects.client_id = ?
+			AND projects.status IN ?
+			AND project_members.status IN ?
+			AND project_members.deployment_type <> ?
+			AND (project_members.end_date IS NULL OR project_members.end_date > now())
+		ORDER BY projects.name, is_lead DESC, employees.full_name`,
+		clientID,
+		[]model.ProjectStatus{model.ProjectStatusActive, model.ProjectStatusOnBoarding},
+		[]model.ProjectMemberStatus{model.ProjectMemberStatusActive, model.ProjectMemberStatusOnBoarding},
+		model.MemberDeploymentTypeShadow).
+		Scan(&res).Error
+}
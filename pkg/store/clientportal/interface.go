@@ -0,0 +1,24 @@
+package clientportal
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	CreateAccess(db *gorm.DB, access *model.ClientPortalAccess) (*model.ClientPortalAccess, error)
+	UpdateAccessSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ClientPortalAccess, updatedFields ...string) (*model.ClientPortalAccess, error)
+	OneAccess(db *gorm.DB, id string) (*model.ClientPortalAccess, error)
+	OneActiveAccess(db *gorm.DB, id string) (*model.ClientPortalAccess, error)
+	OneActiveAccessByEmail(db *gorm.DB, email string) (*model.ClientPortalAccess, error)
+	AllAccesses(db *gorm.DB, query AccessQuery) ([]*model.ClientPortalAccess, error)
+	GetTeam(db *gorm.DB, clientID string) ([]model.ClientPortalTeamMember, error)
+}
+
+// AccessQuery present client portal access query from user
+type AccessQuery struct {
+	ClientID        string
+	ClientContactID string
+	IncludeRevoked  bool
+}
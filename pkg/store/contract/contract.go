@@ -0,0 +1,153 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, contract *model.Contract) (*model.Contract, error) {
+	return contract, db.Omit("Rates", "Attachments").Create(contract).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Contract, updatedFields ...string) (*model.Contract, error) {
+	contract := model.Contract{}
+	return &contract, db.Model(&contract).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// One get a contract with its rate card and attachments
+func (s *store) One(db *gorm.DB, id string) (*model.Contract, error) {
+	var contract model.Contract
+	return &contract, db.Where("id = ?", id).
+		Preload("Client", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Currency").
+		Preload("Rates", "deleted_at IS NULL").
+		Preload("Rates.Seniority").
+		Preload("Rates.Position").
+		Preload("Attachments", "deleted_at IS NULL").
+		First(&contract).Error
+}
+
+// IsCodeExist check whether another contract has the code
+func (s *store) IsCodeExist(db *gorm.DB, code string, excludeID string) (bool, error) {
+	type res struct {
+		Result bool
+	}
+
+	result := res{}
+	query := db.Raw("SELECT EXISTS (SELECT * FROM contracts WHERE code = ? AND id::text <> ? AND deleted_at IS NULL) as result", code, excludeID)
+
+	return result.Result, query.Scan(&result).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Contract, int64, error) {
+	var (
+		total     int64
+		contracts []*model.Contract
+	)
+
+	db = db.Model(&model.Contract{})
+	if query.ClientID != "" {
+		db = db.Where("client_id = ?", query.ClientID)
+	}
+	if query.ProjectID != "" {
+		db = db.Where("project_id = ?", query.ProjectID)
+	}
+	if query.Type != "" {
+		db = db.Where("type = ?", query.Type)
+	}
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return contracts, total, db.
+		Preload("Client", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Currency").
+		Order("start_date DESC, created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&contracts).Error
+}
+
+// GetByProject returns the active contracts of the client covering the project, its SOWs and the
+// contracts of the whole client, with their rate cards
+func (s *store) GetByProject(db *gorm.DB, clientID, projectID string) ([]*model.Contract, error) {
+	var contracts []*model.Contract
+	return contracts, db.
+		Where("client_id = ? AND status = ?", clientID, model.ContractStatusActive).
+		Where("project_id IS NULL OR project_id = ?", projectID).
+		Preload("Rates", "deleted_at IS NULL").
+		Find(&contracts).Error
+}
+
+// GetExpiring returns the active contracts ending by until whose expiry is not announced yet
+func (s *store) GetExpiring(db *gorm.DB, until time.Time) ([]*model.Contract, error) {
+	var contracts []*model.Contract
+	return contracts, db.
+		Where("status = ? AND end_date IS NOT NULL AND end_date <= ? AND expiry_notified_at IS NULL", model.ContractStatusActive, until).
+		Preload("Client", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Order("end_date").
+		Find(&contracts).Error
+}
+
+// contractProjects keeps the rows of the projects a contract covers
+func contractProjects(db *gorm.DB, column string, contract *model.Contract) *gorm.DB {
+	if contract.ProjectID != nil {
+		return db.Where(column+" = ?", *contract.ProjectID)
+	}
+	return db.Where(column+" IN (SELECT id FROM projects WHERE client_id = ? AND deleted_at IS NULL)", contract.ClientID)
+}
+
+// GetBilledTotal sums the invoices of the months the contract covers on its projects
+func (s *store) GetBilledTotal(db *gorm.DB, contract *model.Contract, excludeInvoiceID string) (decimal.Decimal, error) {
+	var total decimal.NullDecimal
+
+	query := db.Model(&model.Invoice{}).
+		Select("SUM(total)").
+		Where("status IN ?", model.ContractInvoiceStatuses).
+		Where("make_date(year, month, 1) >= date_trunc('month', ?::date)", contract.StartDate)
+	if contract.EndDate != nil {
+		query = query.Where("make_date(year, month, 1) <= ?", *contract.EndDate)
+	}
+	if excludeInvoiceID != "" {
+		query = query.Where("id <> ?", excludeInvoiceID)
+	}
+	query = contractProjects(query, "project_id", contract)
+
+	if err := query.Scan(&total).Error; err != nil {
+		return decimal.Zero, err
+	}
+
+	return total.Decimal, nil
+}
+
+// GetAccountManagers returns the current account managers of the projects the contract covers
+func (s *store) GetAccountManagers(db *gorm.DB, contract *model.Contract) ([]*model.Employee, error) {
+	var employees []*model.Employee
+
+	heads := contractProjects(db.Model(&model.ProjectHead{}).
+		Select("employee_id").
+		Where("position = ? AND deleted_at IS NULL AND (end_date IS NULL OR end_date > now())", model.HeadPositionAccountManager),
+		"project_id", contract)
+
+	return employees, db.
+		Where("id IN (?)", heads).
+		Preload("DiscordAccount").
+		Find(&employees).Error
+}
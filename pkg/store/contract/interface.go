@@ -0,0 +1,30 @@
+package contract
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, contract *model.Contract) (*model.Contract, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Contract, updatedFields ...string) (*model.Contract, error)
+	One(db *gorm.DB, id string) (*model.Contract, error)
+	IsCodeExist(db *gorm.DB, code string, excludeID string) (bool, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Contract, int64, error)
+	GetByProject(db *gorm.DB, clientID, projectID string) ([]*model.Contract, error)
+	GetExpiring(db *gorm.DB, until time.Time) ([]*model.Contract, error)
+	GetBilledTotal(db *gorm.DB, contract *model.Contract, excludeInvoiceID string) (decimal.Decimal, error)
+	GetAccountManagers(db *gorm.DB, contract *model.Contract) ([]*model.Employee, error)
+}
+
+// Query present contract query from user
+type Query struct {
+	ClientID  string
+	ProjectID string
+	Type      model.ContractType
+	Status    model.ContractStatus
+}
@@ -0,0 +1,17 @@
+package contractattachment
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, attachment *model.ContractAttachment) (*model.ContractAttachment, error) {
+	return attachment, db.Create(attachment).Error
+}
@@ -0,0 +1,11 @@
+package contractattachment
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, attachment *model.ContractAttachment) (*model.ContractAttachment, error)
+}
@@ -0,0 +1,24 @@
+package contractrate
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) BatchCreate(db *gorm.DB, rates []model.ContractRate) ([]model.ContractRate, error) {
+	if len(rates) == 0 {
+		return rates, nil
+	}
+	return rates, db.Create(&rates).Error
+}
+
+func (s *store) DeleteByContractID(db *gorm.DB, contractID string) error {
+	return db.Where("contract_id = ?", contractID).Delete(&model.ContractRate{}).Error
+}
@@ -0,0 +1,12 @@
+package contractrate
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	BatchCreate(db *gorm.DB, rates []model.ContractRate) ([]model.ContractRate, error)
+	DeleteByContractID(db *gorm.DB, contractID string) error
+}
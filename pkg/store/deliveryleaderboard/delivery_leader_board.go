@@ -0,0 +1,42 @@
+package deliveryleaderboard
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// All get the leaderboard definitions by name
+func (s *store) All(db *gorm.DB, activeOnly bool) ([]*model.DeliveryLeaderBoard, error) {
+	var boards []*model.DeliveryLeaderBoard
+
+	query := db.Order("name")
+	if activeOnly {
+		query = query.Where("is_active IS TRUE")
+	}
+	return boards, query.Find(&boards).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.DeliveryLeaderBoard, error) {
+	var board model.DeliveryLeaderBoard
+	return &board, db.Where("id = ?", id).First(&board).Error
+}
+
+func (s *store) Create(db *gorm.DB, board *model.DeliveryLeaderBoard) (*model.DeliveryLeaderBoard, error) {
+	return board, db.Create(board).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, board model.DeliveryLeaderBoard, updatedFields ...string) (*model.DeliveryLeaderBoard, error) {
+	rs := model.DeliveryLeaderBoard{}
+	return &rs, db.Model(&rs).Where("id = ?", id).Select(updatedFields).Updates(board).First(&rs).Error
+}
+
+func (s *store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.DeliveryLeaderBoard{}).Error
+}
@@ -0,0 +1,15 @@
+package deliveryleaderboard
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	All(db *gorm.DB, activeOnly bool) ([]*model.DeliveryLeaderBoard, error)
+	One(db *gorm.DB, id string) (*model.DeliveryLeaderBoard, error)
+	Create(db *gorm.DB, board *model.DeliveryLeaderBoard) (*model.DeliveryLeaderBoard, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, board model.DeliveryLeaderBoard, updatedFields ...string) (*model.DeliveryLeaderBoard, error)
+	Delete(db *gorm.DB, id string) error
+}
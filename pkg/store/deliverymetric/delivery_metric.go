@@ -70,8 +70,58 @@ func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel mod
 	return &rs, db.Model(&rs).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
 }
 
+// DeleteByIDs delete the delivery metrics by id, for good: the metrics are summed without looking
+// at their deletion
+func (s *store) DeleteByIDs(db *gorm.DB, ids []string) error {
+	return db.Unscoped().Where("id IN ?", ids).Delete(&model.DeliveryMetric{}).Error
+}
+
 // GetLatest get latest delivery metric by id
 func (s *store) GetLatest(db *gorm.DB) (*model.DeliveryMetric, error) {
 	var rs *model.DeliveryMetric
 	return rs, db.Order("ref DESC").Limit(1).First(&rs).Error
 }
+
+// ListBySourcePrefix get the delivery metrics imported from the rows of a source or a dataset, by
+// the prefix of their source key
+func (s *store) ListBySourcePrefix(db *gorm.DB, prefix string) ([]model.DeliveryMetric, error) {
+	var rs []model.DeliveryMetric
+	return rs, db.Where("STRPOS(source_key, ?) = 1", prefix).Order("created_at").Find(&rs).Error
+}
+
+// ListUnkeyedByDates get the delivery metrics of the given weeks synced before the imports were idempotent
+func (s *store) ListUnkeyedByDates(db *gorm.DB, dates []time.Time) ([]model.DeliveryMetric, error) {
+	var rs []model.DeliveryMetric
+	return rs, db.Where("date IN ? AND (source_key IS NULL OR source_key = '')", dates).Order("created_at").Find(&rs).Error
+}
+
+// SumByEmployee get the metrics of each employee summed over a period, narrowed to a project or
+// the members of a chapter
+func (s *store) SumByEmployee(db *gorm.DB, filter SumFilter) ([]model.DeliveryMetricSum, error) {
+	var rs []model.DeliveryMetricSum
+
+	query := db.Model(&model.DeliveryMetric{}).
+		Select(`employee_id,
+			SUM(weight) AS weight,
+			SUM(effort) AS effort,
+			AVG(effectiveness) AS effectiveness`).
+		Where("date >= ? AND date < ?", filter.From, filter.To)
+	if filter.ProjectID != "" {
+		query = query.Where("project_id = ?", filter.ProjectID)
+	}
+	if filter.ChapterID != "" {
+		query = query.Where("employee_id IN (SELECT employee_id FROM employee_chapters WHERE chapter_id = ? AND deleted_at IS NULL)", filter.ChapterID)
+	}
+
+	return rs, query.Group("employee_id").Find(&rs).Error
+}
+
+// GetLeadIDs get the employees leading a chapter or an ongoing project as its technical lead
+func (s *store) GetLeadIDs(db *gorm.DB) ([]model.UUID, error) {
+	var rs []model.UUID
+	return rs, db.Raw(`SELECT lead_id FROM chapters WHERE lead_id IS NOT NULL AND deleted_at IS NULL
+		UNION
+		SELECT employee_id FROM project_heads
+		WHERE position = ? AND deleted_at IS NULL AND (end_date IS NULL OR end_date > now())`,
+		model.HeadPositionTechnicalLead).Scan(&rs).Error
+}
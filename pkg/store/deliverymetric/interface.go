@@ -8,6 +8,14 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/model"
 )
 
+// SumFilter is the period, from included to excluded, and the scope of the summed metrics
+type SumFilter struct {
+	From      time.Time
+	To        time.Time
+	ProjectID string
+	ChapterID string
+}
+
 type IStore interface {
 	One(db *gorm.DB, id string) (client *model.DeliveryMetric, err error)
 	GetLatest(db *gorm.DB) (*model.DeliveryMetric, error)
@@ -15,7 +23,12 @@ type IStore interface {
 	GetLatestMonth(db *gorm.DB) (*time.Time, error)
 	GetTopWeighMetrics(db *gorm.DB, w *time.Time, limit int) ([]model.TopWeightMetric, error)
 	GetTopMonthlyWeighMetrics(db *gorm.DB, m *time.Time, limit int) ([]model.DeliveryMetric, error)
+	ListBySourcePrefix(db *gorm.DB, prefix string) ([]model.DeliveryMetric, error)
+	ListUnkeyedByDates(db *gorm.DB, dates []time.Time) ([]model.DeliveryMetric, error)
+	SumByEmployee(db *gorm.DB, filter SumFilter) ([]model.DeliveryMetricSum, error)
+	GetLeadIDs(db *gorm.DB) ([]model.UUID, error)
 
 	Create(db *gorm.DB, e []model.DeliveryMetric) (rs []model.DeliveryMetric, err error)
 	UpdateSelectedFieldsByID(db *gorm.DB, id string, client model.DeliveryMetric, updatedFields ...string) (a *model.DeliveryMetric, err error)
+	DeleteByIDs(db *gorm.DB, ids []string) error
 }
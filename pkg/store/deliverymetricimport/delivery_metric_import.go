@@ -0,0 +1,42 @@
+package deliverymetricimport
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, i *model.DeliveryMetricImport) (*model.DeliveryMetricImport, error) {
+	return i, db.Create(i).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.DeliveryMetricImport, error) {
+	var i model.DeliveryMetricImport
+	return &i, db.Where("id = ?", id).First(&i).Error
+}
+
+// All get the imports, the latest first
+func (s *store) All(db *gorm.DB, pagination model.Pagination) ([]*model.DeliveryMetricImport, int64, error) {
+	var (
+		total   int64
+		imports []*model.DeliveryMetricImport
+	)
+
+	query := db.Model(&model.DeliveryMetricImport{})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return imports, total, query.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&imports).Error
+}
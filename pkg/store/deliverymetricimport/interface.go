@@ -0,0 +1,13 @@
+package deliverymetricimport
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, i *model.DeliveryMetricImport) (*model.DeliveryMetricImport, error)
+	One(db *gorm.DB, id string) (*model.DeliveryMetricImport, error)
+	All(db *gorm.DB, pagination model.Pagination) ([]*model.DeliveryMetricImport, int64, error)
+}
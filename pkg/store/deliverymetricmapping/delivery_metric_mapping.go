@@ -0,0 +1,47 @@
+package deliverymetricmapping
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// All get the mappings of a kind, every mapping when the kind is empty
+func (s *store) All(db *gorm.DB, kind model.DeliveryMetricMappingKind) ([]*model.DeliveryMetricMapping, error) {
+	var mappings []*model.DeliveryMetricMapping
+
+	query := db.Order("kind, value")
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+	return mappings, query.Find(&mappings).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.DeliveryMetricMapping, error) {
+	var m model.DeliveryMetricMapping
+	return &m, db.Where("id = ?", id).First(&m).Error
+}
+
+func (s *store) OneByValue(db *gorm.DB, kind model.DeliveryMetricMappingKind, value string) (*model.DeliveryMetricMapping, error) {
+	var m model.DeliveryMetricMapping
+	return &m, db.Where("kind = ? AND value = ?", kind, value).First(&m).Error
+}
+
+func (s *store) Create(db *gorm.DB, m *model.DeliveryMetricMapping) (*model.DeliveryMetricMapping, error) {
+	return m, db.Create(m).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, m model.DeliveryMetricMapping, updatedFields ...string) (*model.DeliveryMetricMapping, error) {
+	rs := model.DeliveryMetricMapping{}
+	return &rs, db.Model(&rs).Where("id = ?", id).Select(updatedFields).Updates(m).First(&rs).Error
+}
+
+func (s *store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.DeliveryMetricMapping{}).Error
+}
@@ -0,0 +1,16 @@
+package deliverymetricmapping
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	All(db *gorm.DB, kind model.DeliveryMetricMappingKind) ([]*model.DeliveryMetricMapping, error)
+	One(db *gorm.DB, id string) (*model.DeliveryMetricMapping, error)
+	OneByValue(db *gorm.DB, kind model.DeliveryMetricMappingKind, value string) (*model.DeliveryMetricMapping, error)
+	Create(db *gorm.DB, m *model.DeliveryMetricMapping) (*model.DeliveryMetricMapping, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, m model.DeliveryMetricMapping, updatedFields ...string) (*model.DeliveryMetricMapping, error)
+	Delete(db *gorm.DB, id string) error
+}
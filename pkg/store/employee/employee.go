@@ -1,6 +1,8 @@
 package employee
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -360,6 +362,12 @@ func (s *store) ListWithMMAScore(db *gorm.DB) ([]model.EmployeeMMAScoreData, err
 	return employees, db.Raw(query, model.WorkingStatusLeft).Scan(&employees).Error
 }
 
+// GetLatestMMAScore get the latest mma score of an employee rated before the time
+func (s *store) GetLatestMMAScore(db *gorm.DB, employeeID string, before time.Time) (*model.EmployeeMMAScore, error) {
+	var score *model.EmployeeMMAScore
+	return score, db.Where("employee_id = ? AND rated_at < ?", employeeID, before).Order("rated_at DESC").First(&score).Error
+}
+
 // OneByDisplayName get 1 employee by display name
 func (s *store) OneByDisplayName(db *gorm.DB, displayName string) (*model.Employee, error) {
 	var employee *model.Employee
@@ -368,3 +376,15 @@ func (s *store) OneByDisplayName(db *gorm.DB, displayName string) (*model.Employ
 
 // GetWithMMAScore returns list of employees with their latest MMAScore
 // ... existing code ...
+
+// CountActive count the employees who have not left, in an organization when it is given
+func (s *store) CountActive(db *gorm.DB, organizationID string) (int64, error) {
+	var total int64
+
+	query := db.Model(&model.Employee{}).Where("employees.working_status <> ?", model.WorkingStatusLeft)
+	if organizationID != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM employee_organizations eo WHERE eo.employee_id = employees.id AND eo.organization_id = ? AND eo.deleted_at IS NULL)", organizationID)
+	}
+
+	return total, query.Count(&total).Error
+}
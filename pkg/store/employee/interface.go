@@ -1,6 +1,8 @@
 package employee
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 
 	"github.com/dwarvesf/fortress-api/pkg/model"
@@ -25,10 +27,12 @@ type IStore interface {
 	GetByDiscordUsername(db *gorm.DB, discordUsername string) (*model.Employee, error)
 	ListByDiscordRequest(db *gorm.DB, in DiscordRequestFilter, preload bool) ([]model.Employee, error)
 	ListWithMMAScore(db *gorm.DB) ([]model.EmployeeMMAScoreData, error)
+	GetLatestMMAScore(db *gorm.DB, employeeID string, before time.Time) (*model.EmployeeMMAScore, error)
 	SimpleList(db *gorm.DB) ([]*model.Employee, error)
 	GetRawList(db *gorm.DB, filter EmployeeFilter) ([]model.Employee, error)
 
 	IsExist(db *gorm.DB, id string) (bool, error)
+	CountActive(db *gorm.DB, organizationID string) (int64, error)
 
 	Update(db *gorm.DB, employee *model.Employee) (*model.Employee, error)
 	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Employee, updatedFields ...string) (*model.Employee, error)
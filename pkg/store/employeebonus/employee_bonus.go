@@ -17,3 +17,18 @@ func (s *store) GetByUserID(db *gorm.DB, id model.UUID) ([]model.EmployeeBonus,
 	var res []model.EmployeeBonus
 	return res, db.Where("is_active = true AND employee_id = ?", id).Find(&res).Error
 }
+
+func (s *store) Create(db *gorm.DB, bonus *model.EmployeeBonus) (*model.EmployeeBonus, error) {
+	if bonus.ID.IsZero() {
+		bonus.ID = model.NewUUID()
+	}
+	return bonus, db.Create(bonus).Error
+}
+
+// Deactivate stops paying the bonuses in the next payrolls
+func (s *store) Deactivate(db *gorm.DB, ids []model.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return db.Model(&model.EmployeeBonus{}).Where("id IN ?", ids).Update("is_active", false).Error
+}
@@ -9,4 +9,6 @@ import (
 // IStore is an interface that abstract database method for bonus
 type IStore interface {
 	GetByUserID(db *gorm.DB, id model.UUID) ([]model.EmployeeBonus, error)
+	Create(db *gorm.DB, bonus *model.EmployeeBonus) (*model.EmployeeBonus, error)
+	Deactivate(db *gorm.DB, ids []model.UUID) error
 }
@@ -154,3 +154,61 @@ func (s *store) GetAverageAnswerEngagementByFilter(db *gorm.DB, filter model.Eng
 
 	return result, query.Find(&result).Error
 }
+
+// ListSurveyAnswers return the answers of the done reviewers of the surveys
+func (s *store) ListSurveyAnswers(db *gorm.DB, filter SurveyAnswerFilter) ([]*model.SurveyAnswer, error) {
+	var result []*model.SurveyAnswer
+
+	selects := `eq.event_id, fe.title AS event_title, fe.start_date AS event_date, eq.question_id, eq.content, eq.type, eq."order", er.reviewer_id, er.relationship, eq.answer`
+
+	query := db.Table("employee_event_questions eq").
+		Joins("JOIN employee_event_reviewers er ON eq.employee_event_reviewer_id = er.id AND er.deleted_at IS NULL").
+		Joins("JOIN feedback_events fe ON eq.event_id = fe.id").
+		Where("eq.deleted_at IS NULL").
+		Where("er.reviewer_status = ? AND er.is_forced_done = FALSE", model.EventReviewerStatusDone).
+		Where("eq.answer <> ''")
+
+	if len(filter.EventIDs) > 0 {
+		query = query.Where("eq.event_id IN ?", filter.EventIDs)
+	}
+	if filter.QuestionID != "" {
+		query = query.Where("eq.question_id = ?", filter.QuestionID)
+	}
+	if len(filter.TopicIDs) > 0 {
+		query = query.Where("er.employee_event_topic_id IN ?", filter.TopicIDs)
+	}
+	if filter.EmployeeID != "" {
+		query = query.Where("er.employee_event_topic_id IN (SELECT id FROM employee_event_topics WHERE employee_id = ? AND deleted_at IS NULL)", filter.EmployeeID)
+	}
+	if filter.Subtype != "" {
+		query = query.Where("fe.subtype = ?", filter.Subtype)
+	}
+	if filter.From != nil {
+		query = query.Where("fe.start_date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("fe.start_date < ?", *filter.To)
+	}
+
+	switch filter.GroupBy {
+	case model.EngagementDashboardFilterChapter:
+		query = query.
+			Joins("JOIN employee_chapters ec ON er.reviewer_id = ec.employee_id AND ec.deleted_at IS NULL").
+			Joins("JOIN chapters f ON ec.chapter_id = f.id")
+		selects += ", f.name AS group_name"
+	case model.EngagementDashboardFilterSeniority:
+		query = query.
+			Joins("JOIN employees e ON er.reviewer_id = e.id").
+			Joins("JOIN seniorities f ON e.seniority_id = f.id")
+		selects += ", f.name AS group_name"
+	case model.EngagementDashboardFilterProject:
+		// The project of a work survey is its topic, the others the active projects of the reviewer
+		query = query.
+			Joins("JOIN employee_event_topics et ON er.employee_event_topic_id = et.id").
+			Joins("LEFT JOIN project_members pm ON et.project_id IS NULL AND er.reviewer_id = pm.employee_id AND pm.status = ? AND pm.deleted_at IS NULL", model.ProjectMemberStatusActive).
+			Joins("JOIN projects f ON f.id = COALESCE(et.project_id, pm.project_id)")
+		selects += ", f.name AS group_name"
+	}
+
+	return result, query.Select(selects).Order(`fe.start_date, eq."order"`).Find(&result).Error
+}
@@ -19,4 +19,22 @@ type IStore interface {
 	CountLikertScaleByEventIDAndDomain(db *gorm.DB, eventID string, domain string) (*model.LikertScaleCount, error)
 	GetAverageAnswerEngagementByTime(db *gorm.DB, times []time.Time) ([]*model.StatisticEngagementDashboard, error)
 	GetAverageAnswerEngagementByFilter(db *gorm.DB, filter model.EngagementDashboardFilter, time *time.Time) ([]*model.StatisticEngagementDashboard, error)
+	ListSurveyAnswers(db *gorm.DB, filter SurveyAnswerFilter) ([]*model.SurveyAnswer, error)
+}
+
+// SurveyAnswerFilter selects the answers of the done reviewers of the surveys
+type SurveyAnswerFilter struct {
+	EventIDs   []string
+	QuestionID string
+	// TopicIDs, EmployeeID, Subtype, From and To select the answers about an employee: their
+	// topics, the topics of which they are the subject, in the events of the subtype started in
+	// the period
+	TopicIDs   []string
+	EmployeeID string
+	Subtype    model.EventSubtype
+	From       *time.Time
+	To         *time.Time
+	// GroupBy sets the team of the reviewer on the answers, chapter, seniority or project. An
+	// answer is listed once per team of the reviewer.
+	GroupBy model.EngagementDashboardFilter
 }
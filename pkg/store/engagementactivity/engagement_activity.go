@@ -0,0 +1,57 @@
+package engagementactivity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// Upsert adds the activities to the ones of the same day, user and channel
+func (s *store) Upsert(db *gorm.DB, activities []model.EngagementActivity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "date"}, {Name: "discord_user_id"}, {Name: "channel_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"message_count":  gorm.Expr("engagement_activities.message_count + excluded.message_count"),
+			"reaction_count": gorm.Expr("engagement_activities.reaction_count + excluded.reaction_count"),
+			"updated_at":     gorm.Expr("now()"),
+		}),
+	}).CreateInBatches(&activities, 500).Error
+}
+
+func (s *store) Count(db *gorm.DB) (int64, error) {
+	var total int64
+	return total, db.Model(&model.EngagementActivity{}).Count(&total).Error
+}
+
+// GetTotals sums the activity seen so far of each user in each channel, the baseline included
+func (s *store) GetTotals(db *gorm.DB) ([]model.EngagementActivity, error) {
+	var res []model.EngagementActivity
+	return res, db.Model(&model.EngagementActivity{}).
+		Select("discord_user_id, channel_id, SUM(message_count) AS message_count, SUM(reaction_count) AS reaction_count").
+		Group("discord_user_id, channel_id").
+		Scan(&res).Error
+}
+
+// GetByPeriod sums the activity of each user in each channel over the days in [from, to), the
+// baseline left out
+func (s *store) GetByPeriod(db *gorm.DB, from, to time.Time) ([]model.EngagementActivity, error) {
+	var res []model.EngagementActivity
+	return res, db.Model(&model.EngagementActivity{}).
+		Select(`discord_user_id, channel_id, MAX(category_id) AS category_id,
+			SUM(message_count) AS message_count, SUM(reaction_count) AS reaction_count`).
+		Where("is_baseline IS FALSE AND date >= ? AND date < ?", from.Format("2006-01-02"), to.Format("2006-01-02")).
+		Group("discord_user_id, channel_id").
+		Scan(&res).Error
+}
@@ -0,0 +1,16 @@
+package engagementactivity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Upsert(db *gorm.DB, activities []model.EngagementActivity) error
+	Count(db *gorm.DB) (int64, error)
+	GetTotals(db *gorm.DB) ([]model.EngagementActivity, error)
+	GetByPeriod(db *gorm.DB, from, to time.Time) ([]model.EngagementActivity, error)
+}
@@ -0,0 +1,35 @@
+package engagementchannelstat
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) BatchCreate(db *gorm.DB, stats []*model.EngagementChannelStat) ([]*model.EngagementChannelStat, error) {
+	if len(stats) == 0 {
+		return stats, nil
+	}
+	return stats, db.CreateInBatches(&stats, 500).Error
+}
+
+// DeleteByWeek removes the stats of the week for them to be computed again
+func (s *store) DeleteByWeek(db *gorm.DB, weekStart time.Time) error {
+	return db.Unscoped().Where("week_start = ?", weekStart.Format("2006-01-02")).Delete(&model.EngagementChannelStat{}).Error
+}
+
+func (s *store) AllSince(db *gorm.DB, from time.Time) ([]*model.EngagementChannelStat, error) {
+	var stats []*model.EngagementChannelStat
+	return stats, db.
+		Where("week_start >= ?", from.Format("2006-01-02")).
+		Order("week_start, channel_id").
+		Find(&stats).Error
+}
@@ -0,0 +1,15 @@
+package engagementchannelstat
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	BatchCreate(db *gorm.DB, stats []*model.EngagementChannelStat) ([]*model.EngagementChannelStat, error)
+	DeleteByWeek(db *gorm.DB, weekStart time.Time) error
+	AllSince(db *gorm.DB, from time.Time) ([]*model.EngagementChannelStat, error)
+}
@@ -0,0 +1,55 @@
+package engagementmemberstat
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// Upsert replaces the stats of the employees
+func (s *store) Upsert(db *gorm.DB, stats []*model.EngagementMemberStat) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	return db.Omit("Employee").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "employee_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"discord_user_id", "last_active_at", "recent_messages", "recent_reactions", "computed_on", "updated_at",
+		}),
+	}).CreateInBatches(&stats, 500).Error
+}
+
+// DeleteNotComputedOn removes the stats of the employees who were not members on the day, like
+// the ones who left
+func (s *store) DeleteNotComputedOn(db *gorm.DB, date time.Time) error {
+	return db.Unscoped().Where("computed_on <> ?", date.Format("2006-01-02")).Delete(&model.EngagementMemberStat{}).Error
+}
+
+// GetQuiet get the members not active since the time, the quiet for longest first
+func (s *store) GetQuiet(db *gorm.DB, query Query) ([]*model.EngagementMemberStat, error) {
+	var stats []*model.EngagementMemberStat
+
+	db = db.Joins("JOIN employees ON employees.id = engagement_member_stats.employee_id AND employees.deleted_at IS NULL").
+		Where("engagement_member_stats.last_active_at IS NULL OR engagement_member_stats.last_active_at < ?", query.Since)
+	if query.LineManagerID != "" {
+		db = db.Where("employees.line_manager_id = ?", query.LineManagerID)
+	}
+	if query.ChapterID != "" {
+		db = db.Where("employees.id IN (SELECT employee_id FROM employee_chapters WHERE deleted_at IS NULL AND chapter_id = ?)", query.ChapterID)
+	}
+
+	return stats, db.
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Employee.LineManager", "deleted_at IS NULL").
+		Order("engagement_member_stats.last_active_at NULLS FIRST, employees.full_name").
+		Find(&stats).Error
+}
@@ -0,0 +1,22 @@
+package engagementmemberstat
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Upsert(db *gorm.DB, stats []*model.EngagementMemberStat) error
+	DeleteNotComputedOn(db *gorm.DB, date time.Time) error
+	GetQuiet(db *gorm.DB, query Query) ([]*model.EngagementMemberStat, error)
+}
+
+// Query present engagement member stat query from user
+type Query struct {
+	Since         time.Time // quiet since, never active included
+	LineManagerID string
+	ChapterID     string
+}
@@ -46,3 +46,8 @@ func (s *store) GetLastMessageID(db *gorm.DB, channelID string) (string, error)
 		Error
 	return lastMessageID, err
 }
+
+func (s *store) All(db *gorm.DB) ([]*model.EngagementsRollup, error) {
+	var records []*model.EngagementsRollup
+	return records, db.Table("engagements_rollup").Where("deleted_at IS NULL").Find(&records).Error
+}
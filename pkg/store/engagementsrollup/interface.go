@@ -8,4 +8,5 @@ import (
 type IStore interface {
 	Upsert(db *gorm.DB, record *model.EngagementsRollup) (*model.EngagementsRollup, error)
 	GetLastMessageID(db *gorm.DB, channelID string) (string, error)
+	All(db *gorm.DB) ([]*model.EngagementsRollup, error)
 }
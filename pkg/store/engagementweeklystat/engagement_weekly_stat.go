@@ -0,0 +1,41 @@
+package engagementweeklystat
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) BatchCreate(db *gorm.DB, stats []*model.EngagementWeeklyStat) ([]*model.EngagementWeeklyStat, error) {
+	if len(stats) == 0 {
+		return stats, nil
+	}
+	return stats, db.Omit("Chapter").Create(&stats).Error
+}
+
+// DeleteByWeek removes the stats of the week for them to be computed again
+func (s *store) DeleteByWeek(db *gorm.DB, weekStart time.Time) error {
+	return db.Unscoped().Where("week_start = ?", weekStart.Format("2006-01-02")).Delete(&model.EngagementWeeklyStat{}).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query) ([]*model.EngagementWeeklyStat, error) {
+	var stats []*model.EngagementWeeklyStat
+
+	db = db.Where("scope = ? AND week_start >= ?", query.Scope, query.From.Format("2006-01-02"))
+	if query.ChapterID != "" {
+		db = db.Where("chapter_id = ?", query.ChapterID)
+	}
+
+	return stats, db.
+		Preload("Chapter", "deleted_at IS NULL").
+		Order("week_start, chapter_id").
+		Find(&stats).Error
+}
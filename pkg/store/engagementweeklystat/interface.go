@@ -0,0 +1,22 @@
+package engagementweeklystat
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	BatchCreate(db *gorm.DB, stats []*model.EngagementWeeklyStat) ([]*model.EngagementWeeklyStat, error)
+	DeleteByWeek(db *gorm.DB, weekStart time.Time) error
+	All(db *gorm.DB, query Query) ([]*model.EngagementWeeklyStat, error)
+}
+
+// Query present engagement weekly stat query from user
+type Query struct {
+	Scope     model.EngagementScope
+	ChapterID string
+	From      time.Time
+}
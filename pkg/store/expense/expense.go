@@ -44,3 +44,8 @@ func (s *store) GetByQuery(db *gorm.DB, q *ExpenseQuery) (*model.Expense, error)
 
 	return e, db.First(e).Error
 }
+
+func (s *store) One(db *gorm.DB, id string) (*model.Expense, error) {
+	var e model.Expense
+	return &e, db.Where("id = ?", id).First(&e).Error
+}
@@ -12,6 +12,7 @@ type IStore interface {
 	Update(db *gorm.DB, e *model.Expense) (*model.Expense, error)
 	GetValuation(db *gorm.DB, y int) (*model.CurrencyView, error)
 	GetByQuery(db *gorm.DB, q *ExpenseQuery) (*model.Expense, error)
+	One(db *gorm.DB, id string) (*model.Expense, error)
 }
 
 type ExpenseQuery struct {
@@ -0,0 +1,70 @@
+package fxrate
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// Upsert create or update the rate of a pair for a date and source
+func (s *store) Upsert(db *gorm.DB, rate *model.FxRate) error {
+	return db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "date"},
+			{Name: "source_currency"},
+			{Name: "target_currency"},
+			{Name: "source"},
+		},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"rate":       rate.Rate,
+			"note":       rate.Note,
+			"created_by": rate.CreatedBy,
+			"updated_at": time.Now(),
+			"deleted_at": nil,
+		}),
+	}).Create(rate).Error
+}
+
+// ListInRange get rates matching the query, latest date first
+func (s *store) ListInRange(db *gorm.DB, query Query) ([]model.FxRate, error) {
+	var rates []model.FxRate
+
+	if query.SourceCurrency != "" {
+		db = db.Where("source_currency = ?", query.SourceCurrency)
+	}
+	if query.TargetCurrency != "" {
+		db = db.Where("target_currency = ?", query.TargetCurrency)
+	}
+	if query.Source != "" {
+		db = db.Where("source = ?", query.Source)
+	}
+	if query.From != nil {
+		db = db.Where("date >= ?", query.From.Format("2006-01-02"))
+	}
+	if query.To != nil {
+		db = db.Where("date <= ?", query.To.Format("2006-01-02"))
+	}
+
+	return rates, db.Order("date DESC, source_currency, target_currency").Find(&rates).Error
+}
+
+// ListOnOrBefore get rates of a pair between date - lookbackDays and date, latest date first
+func (s *store) ListOnOrBefore(db *gorm.DB, src, dst string, date time.Time, lookbackDays int) ([]model.FxRate, error) {
+	var rates []model.FxRate
+	from := date.AddDate(0, 0, -lookbackDays)
+
+	return rates, db.
+		Where("source_currency = ? AND target_currency = ?", src, dst).
+		Where("date <= ? AND date >= ?", date.Format("2006-01-02"), from.Format("2006-01-02")).
+		Order("date DESC").
+		Find(&rates).Error
+}
@@ -0,0 +1,24 @@
+package fxrate
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Upsert(db *gorm.DB, rate *model.FxRate) error
+	ListInRange(db *gorm.DB, query Query) ([]model.FxRate, error)
+	ListOnOrBefore(db *gorm.DB, src, dst string, date time.Time, lookbackDays int) ([]model.FxRate, error)
+}
+
+// Query present fx rate query from user
+type Query struct {
+	SourceCurrency string
+	TargetCurrency string
+	Source         model.FxRateSource
+	From           *time.Time
+	To             *time.Time
+}
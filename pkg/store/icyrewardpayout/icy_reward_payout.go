@@ -0,0 +1,133 @@
+package icyrewardpayout
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// Create inserts the payout unless one has its idempotency key already, it returns whether it did
+func (s *store) Create(db *gorm.DB, payout *model.IcyRewardPayout) (bool, error) {
+	result := db.Omit(clause.Associations).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "idempotency_key"}}, DoNothing: true}).
+		Create(payout)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.IcyRewardPayout, updatedFields ...string) (*model.IcyRewardPayout, error) {
+	payout := model.IcyRewardPayout{}
+	return &payout, db.Model(&payout).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// UpdateStatus updates the payout only while it is in one of the from statuses, it returns whether
+// it did so that a payout is never taken over twice
+func (s *store) UpdateStatus(db *gorm.DB, id string, from []model.IcyRewardPayoutStatus, updateModel model.IcyRewardPayout, updatedFields ...string) (bool, error) {
+	result := db.Model(&model.IcyRewardPayout{}).
+		Where("id = ? AND status IN ?", id, from).
+		Select(updatedFields).
+		Updates(updateModel)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.IcyRewardPayout, error) {
+	var payout model.IcyRewardPayout
+	return &payout, db.Where("id = ?", id).
+		Preload("Rule").
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Employee.DiscordAccount").
+		Preload("Reviewer", "deleted_at IS NULL").
+		First(&payout).Error
+}
+
+func (s *store) OneByKey(db *gorm.DB, key string) (*model.IcyRewardPayout, error) {
+	var payout model.IcyRewardPayout
+	return &payout, db.Where("idempotency_key = ?", key).
+		Preload("Rule").
+		Preload("Employee", "deleted_at IS NULL").
+		First(&payout).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.IcyRewardPayout, int64, error) {
+	var (
+		total   int64
+		payouts []*model.IcyRewardPayout
+	)
+
+	db = db.Model(&model.IcyRewardPayout{})
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.RuleID != "" {
+		db = db.Where("rule_id = ?", query.RuleID)
+	}
+	if query.EmployeeID != "" {
+		db = db.Where("employee_id = ?", query.EmployeeID)
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return payouts, total, db.
+		Preload("Rule").
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Reviewer", "deleted_at IS NULL").
+		Order("occurred_at DESC, created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&payouts).Error
+}
+
+// CountInPeriod counts the payouts of the employee by the rule since the start of the cap period
+func (s *store) CountInPeriod(db *gorm.DB, ruleID, employeeID string, from time.Time) (int64, error) {
+	var count int64
+	return count, db.Model(&model.IcyRewardPayout{}).
+		Where("rule_id = ? AND employee_id = ? AND occurred_at >= ?", ruleID, employeeID, from).
+		Where("status IN ?", model.IcyRewardCommittedStatuses).
+		Count(&count).Error
+}
+
+// SumSince sums the committed payouts since the time, of the rule or of all the rules when empty
+func (s *store) SumSince(db *gorm.DB, ruleID string, from time.Time) (decimal.Decimal, error) {
+	var res struct {
+		Total decimal.Decimal
+	}
+
+	query := db.Model(&model.IcyRewardPayout{}).
+		Select("COALESCE(SUM(amount), 0) AS total").
+		Where("occurred_at >= ? AND status IN ?", from, model.IcyRewardCommittedStatuses)
+	if ruleID != "" {
+		query = query.Where("rule_id = ?", ruleID)
+	}
+	return res.Total, query.Scan(&res).Error
+}
+
+// SpendByRule sums the payouts of each rule in [from, to)
+func (s *store) SpendByRule(db *gorm.DB, from, to time.Time) ([]model.IcyRewardRuleSpend, error) {
+	var res []model.IcyRewardRuleSpend
+	return res, db.Model(&model.IcyRewardPayout{}).
+		Select(`rule_id,
+			COALESCE(SUM(amount) FILTER (WHERE status = ?), 0) AS paid,
+			COALESCE(SUM(amount) FILTER (WHERE status = ?), 0) AS in_review,
+			COUNT(*) FILTER (WHERE status IN ?) AS payouts,
+			COUNT(*) FILTER (WHERE status = ?) AS skipped,
+			COUNT(DISTINCT employee_id) FILTER (WHERE status IN ?) AS employees`,
+			model.IcyRewardPayoutStatusPaid,
+			model.IcyRewardPayoutStatusPendingReview,
+			model.IcyRewardCommittedStatuses,
+			model.IcyRewardPayoutStatusSkipped,
+			model.IcyRewardCommittedStatuses).
+		Where("occurred_at >= ? AND occurred_at < ?", from, to).
+		Group("rule_id").
+		Scan(&res).Error
+}
@@ -0,0 +1,29 @@
+package icyrewardpayout
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, payout *model.IcyRewardPayout) (bool, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.IcyRewardPayout, updatedFields ...string) (*model.IcyRewardPayout, error)
+	UpdateStatus(db *gorm.DB, id string, from []model.IcyRewardPayoutStatus, updateModel model.IcyRewardPayout, updatedFields ...string) (bool, error)
+	One(db *gorm.DB, id string) (*model.IcyRewardPayout, error)
+	OneByKey(db *gorm.DB, key string) (*model.IcyRewardPayout, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.IcyRewardPayout, int64, error)
+	CountInPeriod(db *gorm.DB, ruleID, employeeID string, from time.Time) (int64, error)
+	SumSince(db *gorm.DB, ruleID string, from time.Time) (decimal.Decimal, error)
+	SpendByRule(db *gorm.DB, from, to time.Time) ([]model.IcyRewardRuleSpend, error)
+}
+
+// Query present icy reward payout query from user
+type Query struct {
+	Status     model.IcyRewardPayoutStatus
+	RuleID     string
+	EmployeeID string
+}
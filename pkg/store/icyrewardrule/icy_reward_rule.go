@@ -0,0 +1,51 @@
+package icyrewardrule
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, rule *model.IcyRewardRule) (*model.IcyRewardRule, error) {
+	return rule, db.Create(rule).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.IcyRewardRule, updatedFields ...string) (*model.IcyRewardRule, error) {
+	rule := model.IcyRewardRule{}
+	return &rule, db.Model(&rule).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.IcyRewardRule, error) {
+	var rule model.IcyRewardRule
+	return &rule, db.Where("id = ?", id).First(&rule).Error
+}
+
+func (s *store) OneActiveByTrigger(db *gorm.DB, trigger model.IcyRewardTrigger) (*model.IcyRewardRule, error) {
+	var rule model.IcyRewardRule
+	return &rule, db.Where("trigger = ? AND is_active IS TRUE", trigger).First(&rule).Error
+}
+
+func (s *store) All(db *gorm.DB) ([]*model.IcyRewardRule, error) {
+	var rules []*model.IcyRewardRule
+	return rules, db.Order("name").Find(&rules).Error
+}
+
+// IsTriggerExist tells whether another rule pays for the trigger
+func (s *store) IsTriggerExist(db *gorm.DB, trigger model.IcyRewardTrigger, exceptID string) (bool, error) {
+	type res struct {
+		Result bool
+	}
+
+	result := res{}
+	query := db.Model(&model.IcyRewardRule{}).Select("1").Where("trigger = ? AND deleted_at IS NULL", trigger)
+	if exceptID != "" {
+		query = query.Where("id <> ?", exceptID)
+	}
+	return result.Result, db.Raw("SELECT EXISTS (?) as result", query).Scan(&result).Error
+}
@@ -0,0 +1,16 @@
+package icyrewardrule
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, rule *model.IcyRewardRule) (*model.IcyRewardRule, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.IcyRewardRule, updatedFields ...string) (*model.IcyRewardRule, error)
+	One(db *gorm.DB, id string) (*model.IcyRewardRule, error)
+	OneActiveByTrigger(db *gorm.DB, trigger model.IcyRewardTrigger) (*model.IcyRewardRule, error)
+	All(db *gorm.DB) ([]*model.IcyRewardRule, error)
+	IsTriggerExist(db *gorm.DB, trigger model.IcyRewardTrigger, exceptID string) (bool, error)
+}
@@ -25,3 +25,13 @@ func (s *store) Create(db *gorm.DB, model []model.IcyTransaction) error {
 		UpdateAll: true,
 	}).Create(&model).Error
 }
+
+// GetUnposted get the transactions which have no journal entry in the general ledger yet
+func (s *store) GetUnposted(db *gorm.DB, limit int) ([]model.IcyTransaction, error) {
+	var transactions []model.IcyTransaction
+	return transactions, db.
+		Where("NOT EXISTS (SELECT 1 FROM journal_entries je WHERE je.source_type = ? AND je.source_id = icy_transactions.id::TEXT AND je.deleted_at IS NULL)", model.JournalSourceIcy).
+		Order("txn_time").
+		Limit(limit).
+		Find(&transactions).Error
+}
@@ -8,4 +8,5 @@ import (
 
 type IStore interface {
 	Create(db *gorm.DB, model []model.IcyTransaction) error
+	GetUnposted(db *gorm.DB, limit int) ([]model.IcyTransaction, error)
 }
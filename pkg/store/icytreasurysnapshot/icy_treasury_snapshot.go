@@ -0,0 +1,58 @@
+package icytreasurysnapshot
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// Create inserts the snapshot with its vault balances
+func (s *store) Create(db *gorm.DB, snapshot *model.IcyTreasurySnapshot) (*model.IcyTreasurySnapshot, error) {
+	return snapshot, db.Create(snapshot).Error
+}
+
+// One get a snapshot with its vault balances, largest first
+func (s *store) One(db *gorm.DB, id string) (*model.IcyTreasurySnapshot, error) {
+	var snapshot model.IcyTreasurySnapshot
+	return &snapshot, db.Where("id = ?", id).
+		Preload("VaultBalances", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("amount DESC")
+		}).
+		First(&snapshot).Error
+}
+
+func (s *store) OneByDate(db *gorm.DB, date time.Time) (*model.IcyTreasurySnapshot, error) {
+	var snapshot model.IcyTreasurySnapshot
+	return &snapshot, db.Where("snapshot_date = ?", date.Format("2006-01-02")).First(&snapshot).Error
+}
+
+// LatestBefore get the latest snapshot taken before the day
+func (s *store) LatestBefore(db *gorm.DB, date time.Time) (*model.IcyTreasurySnapshot, error) {
+	var snapshot model.IcyTreasurySnapshot
+	return &snapshot, db.Where("snapshot_date < ?", date.Format("2006-01-02")).
+		Order("snapshot_date DESC").
+		First(&snapshot).Error
+}
+
+// All get the snapshots in the range of days, oldest first
+func (s *store) All(db *gorm.DB, query Query) ([]*model.IcyTreasurySnapshot, error) {
+	var snapshots []*model.IcyTreasurySnapshot
+
+	db = db.Model(&model.IcyTreasurySnapshot{})
+	if query.From != nil {
+		db = db.Where("snapshot_date >= ?", query.From.Format("2006-01-02"))
+	}
+	if query.To != nil {
+		db = db.Where("snapshot_date <= ?", query.To.Format("2006-01-02"))
+	}
+
+	return snapshots, db.Order("snapshot_date").Find(&snapshots).Error
+}
@@ -0,0 +1,23 @@
+package icytreasurysnapshot
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, snapshot *model.IcyTreasurySnapshot) (*model.IcyTreasurySnapshot, error)
+	One(db *gorm.DB, id string) (*model.IcyTreasurySnapshot, error)
+	OneByDate(db *gorm.DB, date time.Time) (*model.IcyTreasurySnapshot, error)
+	LatestBefore(db *gorm.DB, date time.Time) (*model.IcyTreasurySnapshot, error)
+	All(db *gorm.DB, query Query) ([]*model.IcyTreasurySnapshot, error)
+}
+
+// Query present icy treasury snapshot query from user
+type Query struct {
+	From *time.Time
+	To   *time.Time
+}
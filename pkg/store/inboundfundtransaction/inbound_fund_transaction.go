@@ -39,3 +39,13 @@ func (s *store) Get(db *gorm.DB, q Query) ([]model.InboundFundTransaction, error
 	}
 	return res, db.Find(&res).Error
 }
+
+func (s *store) One(db *gorm.DB, id string) (*model.InboundFundTransaction, error) {
+	var ift model.InboundFundTransaction
+	return &ift, db.Where("id = ?", id).
+		Preload("Invoice").
+		Preload("Invoice.Project").
+		Preload("Invoice.Project.BankAccount", "deleted_at IS NULL").
+		Preload("Invoice.Project.BankAccount.Currency", "deleted_at IS NULL").
+		First(&ift).Error
+}
@@ -11,4 +11,5 @@ type IStore interface {
 	DeleteUnpaidByInvoiceID(db *gorm.DB, invoiceID string) error
 	GetByInvoiceID(db *gorm.DB, invoiceID string) (*model.InboundFundTransaction, error)
 	Get(db *gorm.DB, q Query) ([]model.InboundFundTransaction, error)
+	One(db *gorm.DB, id string) (*model.InboundFundTransaction, error)
 }
@@ -33,6 +33,7 @@ type Query struct {
 type GetInvoicesFilter struct {
 	Preload       bool
 	ProjectIDs    []string
+	ClientID      string
 	Statuses      []string
 	InvoiceNumber string
 }
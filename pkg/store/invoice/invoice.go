@@ -61,6 +61,9 @@ func (s *store) All(db *gorm.DB, filter GetInvoicesFilter, pagination model.Pagi
 	if len(filter.ProjectIDs) > 0 {
 		baseQuery = baseQuery.Where("project_id IN (?)", filter.ProjectIDs)
 	}
+	if filter.ClientID != "" {
+		baseQuery = baseQuery.Where("project_id IN (SELECT id FROM projects WHERE client_id = ?)", filter.ClientID)
+	}
 	if len(filter.Statuses) > 0 {
 		baseQuery = baseQuery.Where("status IN (?)", filter.Statuses)
 	}
@@ -77,6 +80,9 @@ func (s *store) All(db *gorm.DB, filter GetInvoicesFilter, pagination model.Pagi
 	if len(filter.ProjectIDs) > 0 {
 		query = query.Where("project_id IN (?)", filter.ProjectIDs)
 	}
+	if filter.ClientID != "" {
+		query = query.Where("project_id IN (SELECT id FROM projects WHERE client_id = ?)", filter.ClientID)
+	}
 	if len(filter.Statuses) > 0 {
 		query = query.Where("status IN (?)", filter.Statuses)
 	}
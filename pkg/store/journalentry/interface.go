@@ -0,0 +1,26 @@
+package journalentry
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, entry *model.JournalEntry) (*model.JournalEntry, error)
+	One(db *gorm.DB, id string) (*model.JournalEntry, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.JournalEntry, int64, error)
+	GetBySource(db *gorm.DB, sourceType model.JournalSource, sourceID string) (*model.JournalEntry, error)
+	GetByAccountingTransactionID(db *gorm.DB, accountingTransactionID string) (*model.JournalEntry, error)
+	SumByAccount(db *gorm.DB, from, to *time.Time) ([]model.LedgerAccountBalance, error)
+}
+
+// Query present journal entry query from user
+type Query struct {
+	SourceType model.JournalSource
+	AccountID  string
+	From       *time.Time
+	To         *time.Time
+}
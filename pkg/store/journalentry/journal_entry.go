@@ -0,0 +1,103 @@
+package journalentry
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// Create create the entry together with its lines
+func (s *store) Create(db *gorm.DB, entry *model.JournalEntry) (*model.JournalEntry, error) {
+	return entry, db.Create(entry).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.JournalEntry, error) {
+	var entry model.JournalEntry
+	return &entry, db.Where("id = ?", id).
+		Preload("Lines", "deleted_at IS NULL").
+		Preload("Lines.Account").
+		First(&entry).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.JournalEntry, int64, error) {
+	var (
+		total   int64
+		entries []*model.JournalEntry
+	)
+
+	db = db.Model(&model.JournalEntry{})
+	if query.SourceType != "" {
+		db = db.Where("source_type = ?", query.SourceType)
+	}
+	if query.AccountID != "" {
+		db = db.Where("id IN (SELECT journal_entry_id FROM journal_lines WHERE account_id = ? AND deleted_at IS NULL)", query.AccountID)
+	}
+	if query.From != nil {
+		db = db.Where("date >= ?", query.From.Format("2006-01-02"))
+	}
+	if query.To != nil {
+		db = db.Where("date <= ?", query.To.Format("2006-01-02"))
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if pagination.Sort != "" {
+		db = db.Order(pagination.Sort)
+	} else {
+		db = db.Order("date DESC, created_at DESC")
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return entries, total, db.
+		Preload("Lines", "deleted_at IS NULL").
+		Preload("Lines.Account").
+		Limit(limit).
+		Offset(offset).
+		Find(&entries).Error
+}
+
+func (s *store) GetBySource(db *gorm.DB, sourceType model.JournalSource, sourceID string) (*model.JournalEntry, error) {
+	var entry model.JournalEntry
+	return &entry, db.Where("source_type = ? AND source_id = ?", sourceType, sourceID).
+		Preload("Lines", "deleted_at IS NULL").
+		First(&entry).Error
+}
+
+func (s *store) GetByAccountingTransactionID(db *gorm.DB, accountingTransactionID string) (*model.JournalEntry, error) {
+	var entry model.JournalEntry
+	return &entry, db.Where("accounting_transaction_id = ? AND reversal_of_id IS NULL", accountingTransactionID).
+		Preload("Lines", "deleted_at IS NULL").
+		First(&entry).Error
+}
+
+// SumByAccount sums the debits and credits posted on every account between from and to, both optional
+func (s *store) SumByAccount(db *gorm.DB, from, to *time.Time) ([]model.LedgerAccountBalance, error) {
+	var balances []model.LedgerAccountBalance
+
+	entries := db.Table("journal_entries").Select("id").Where("deleted_at IS NULL")
+	if from != nil {
+		entries = entries.Where("date >= ?", from.Format("2006-01-02"))
+	}
+	if to != nil {
+		entries = entries.Where("date <= ?", to.Format("2006-01-02"))
+	}
+
+	return balances, db.Table("ledger_accounts la").
+		Select("la.id AS account_id, la.code, la.name, la.type, COALESCE(SUM(jl.debit), 0) AS debit, COALESCE(SUM(jl.credit), 0) AS credit").
+		Joins("JOIN journal_lines jl ON jl.account_id = la.id AND jl.deleted_at IS NULL").
+		Where("jl.journal_entry_id IN (?)", entries).
+		Where("la.deleted_at IS NULL").
+		Group("la.id, la.code, la.name, la.type").
+		Order("la.code").
+		Scan(&balances).Error
+}
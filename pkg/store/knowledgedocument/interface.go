@@ -0,0 +1,26 @@
+package knowledgedocument
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Upsert(db *gorm.DB, docs []model.KnowledgeDocument) error
+	DeleteBySourceIDs(db *gorm.DB, source model.KnowledgeSource, sourceIDs []string) error
+	GetSourceIDs(db *gorm.DB, source model.KnowledgeSource) ([]string, error)
+	Search(db *gorm.DB, query Query, pagination model.Pagination) ([]model.KnowledgeSearchResult, int64, error)
+}
+
+// Query present knowledge document query from user
+type Query struct {
+	Keyword string
+	Source  model.KnowledgeSource
+	Author  string
+	Tag     string
+	From    *time.Time
+	To      *time.Time // the last day of the range, included
+}
@@ -0,0 +1,85 @@
+package knowledgedocument
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// headlineOptions highlights the matches of the snippets with <mark>, in up to 2 fragments
+const headlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter= ... "
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// Upsert creates the documents, or updates the ones of the same sources
+func (s *store) Upsert(db *gorm.DB, docs []model.KnowledgeDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "source"}, {Name: "source_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "url", "content", "authors", "tags", "published_at", "updated_at"}),
+	}).Create(&docs).Error
+}
+
+// DeleteBySourceIDs deletes the documents of the sources, the index has no history to keep
+func (s *store) DeleteBySourceIDs(db *gorm.DB, source model.KnowledgeSource, sourceIDs []string) error {
+	if len(sourceIDs) == 0 {
+		return nil
+	}
+	return db.Unscoped().Where("source = ? AND source_id IN ?", source, sourceIDs).Delete(&model.KnowledgeDocument{}).Error
+}
+
+// GetSourceIDs gets the IDs of the sources indexed
+func (s *store) GetSourceIDs(db *gorm.DB, source model.KnowledgeSource) ([]string, error) {
+	var ids []string
+	return ids, db.Model(&model.KnowledgeDocument{}).Where("source = ?", source).Pluck("source_id", &ids).Error
+}
+
+// Search ranks the documents matching the keyword, a web search query: quoted phrases, OR and -
+// to exclude a word
+func (s *store) Search(db *gorm.DB, query Query, pagination model.Pagination) ([]model.KnowledgeSearchResult, int64, error) {
+	var (
+		total   int64
+		results []model.KnowledgeSearchResult
+	)
+
+	db = db.Model(&model.KnowledgeDocument{}).
+		Where("search_vector @@ websearch_to_tsquery('english', ?)", query.Keyword)
+	if query.Source != "" {
+		db = db.Where("source = ?", query.Source)
+	}
+	if query.Author != "" {
+		db = db.Where("authors @> jsonb_build_array(?::TEXT)", strings.ToLower(query.Author))
+	}
+	if query.Tag != "" {
+		db = db.Where("tags @> jsonb_build_array(?::TEXT)", strings.ToLower(query.Tag))
+	}
+	if query.From != nil {
+		db = db.Where("published_at >= ?", *query.From)
+	}
+	if query.To != nil {
+		db = db.Where("published_at < ?", query.To.AddDate(0, 0, 1))
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return results, total, db.
+		Select(`knowledge_documents.*,
+			ts_rank_cd(search_vector, websearch_to_tsquery('english', ?)) AS rank,
+			ts_headline('english', CASE WHEN content = '' THEN title ELSE content END, websearch_to_tsquery('english', ?), ?) AS snippet`,
+			query.Keyword, query.Keyword, headlineOptions).
+		Order("rank DESC, published_at DESC NULLS LAST").
+		Limit(limit).
+		Offset(offset).
+		Scan(&results).Error
+}
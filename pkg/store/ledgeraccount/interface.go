@@ -0,0 +1,15 @@
+package ledgeraccount
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	All(db *gorm.DB, activeOnly bool) ([]model.LedgerAccount, error)
+	One(db *gorm.DB, id string) (*model.LedgerAccount, error)
+	GetByCodes(db *gorm.DB, codes []string) ([]model.LedgerAccount, error)
+	Create(db *gorm.DB, account *model.LedgerAccount) (*model.LedgerAccount, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, account model.LedgerAccount, updatedFields ...string) (*model.LedgerAccount, error)
+}
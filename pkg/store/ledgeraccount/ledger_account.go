@@ -0,0 +1,41 @@
+package ledgeraccount
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// All get the chart of accounts ordered by code
+func (s *store) All(db *gorm.DB, activeOnly bool) ([]model.LedgerAccount, error) {
+	var accounts []model.LedgerAccount
+	if activeOnly {
+		db = db.Where("is_active IS TRUE")
+	}
+	return accounts, db.Order("code").Find(&accounts).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.LedgerAccount, error) {
+	var account model.LedgerAccount
+	return &account, db.Where("id = ?", id).First(&account).Error
+}
+
+func (s *store) GetByCodes(db *gorm.DB, codes []string) ([]model.LedgerAccount, error) {
+	var accounts []model.LedgerAccount
+	return accounts, db.Where("code IN ?", codes).Find(&accounts).Error
+}
+
+func (s *store) Create(db *gorm.DB, account *model.LedgerAccount) (*model.LedgerAccount, error) {
+	return account, db.Create(account).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, account model.LedgerAccount, updatedFields ...string) (*model.LedgerAccount, error) {
+	a := model.LedgerAccount{}
+	return &a, db.Model(&a).Where("id = ?", id).Select(updatedFields).Updates(account).First(&a).Error
+}
@@ -0,0 +1,12 @@
+package memoingestion
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, ingestion *model.MemoIngestion) (*model.MemoIngestion, error)
+	Latest(db *gorm.DB) (*model.MemoIngestion, error)
+}
@@ -0,0 +1,23 @@
+package memoingestion
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, ingestion *model.MemoIngestion) (*model.MemoIngestion, error) {
+	return ingestion, db.Create(ingestion).Error
+}
+
+// Latest gets the last memo ingestion, the next one diffs the repository from its commit
+func (s *store) Latest(db *gorm.DB) (*model.MemoIngestion, error) {
+	var ingestion model.MemoIngestion
+	return &ingestion, db.Order("created_at DESC").First(&ingestion).Error
+}
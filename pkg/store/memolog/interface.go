@@ -16,4 +16,13 @@ type IStore interface {
 	ListNonAuthor(db *gorm.DB) ([]model.MemoLog, error)
 	CreateMemoAuthor(db *gorm.DB, memoAuthor *model.MemoAuthor) error
 	GetTopAuthors(db *gorm.DB, limit int, from, to *time.Time) ([]model.DiscordAccountMemoRank, error)
+
+	// OneByFilePath and OneByURL include the deleted memos, the file path and the URL stay unique
+	// across them
+	OneByFilePath(db *gorm.DB, filePath string) (*model.MemoLog, error)
+	OneByURL(db *gorm.DB, url string) (*model.MemoLog, error)
+	OneByTitle(db *gorm.DB, title string, source model.MemoLogSource) (*model.MemoLog, error)
+	ListUnresolved(db *gorm.DB) ([]model.MemoLog, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.MemoLog, updatedFields ...string) (*model.MemoLog, error)
+	Delete(db *gorm.DB, id string) error
 }
@@ -161,3 +161,41 @@ func (s *store) GetTopAuthors(db *gorm.DB, limit int, from, to *time.Time) ([]mo
 	var topAuthors []model.DiscordAccountMemoRank
 	return topAuthors, db.Raw(query, from, to, limit).Scan(&topAuthors).Error
 }
+
+// OneByFilePath gets the memo log read from the file, deleted or not
+func (s *store) OneByFilePath(db *gorm.DB, filePath string) (*model.MemoLog, error) {
+	var log model.MemoLog
+	return &log, db.Unscoped().Where("file_path = ?", filePath).First(&log).Error
+}
+
+// OneByURL gets the memo log of the URL, deleted or not
+func (s *store) OneByURL(db *gorm.DB, url string) (*model.MemoLog, error) {
+	var log model.MemoLog
+	return &log, db.Unscoped().Where("url = ?", url).First(&log).Error
+}
+
+// OneByTitle gets the latest memo log of the source with the title, case-insensitive
+func (s *store) OneByTitle(db *gorm.DB, title string, source model.MemoLogSource) (*model.MemoLog, error) {
+	var log model.MemoLog
+	return &log, db.Where("LOWER(title) = LOWER(?) AND source = ?", title, source).
+		Order("published_at DESC").
+		First(&log).Error
+}
+
+// ListUnresolved gets the memo logs with authors not resolved to a Discord account yet
+func (s *store) ListUnresolved(db *gorm.DB) ([]model.MemoLog, error) {
+	var logs []model.MemoLog
+	return logs, db.Where("unresolved_authors IS NOT NULL AND jsonb_array_length(unresolved_authors) > 0").Find(&logs).Error
+}
+
+// UpdateSelectedFieldsByID just update selected fields by id, a deleted memo log is restored by
+// selecting deleted_at
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.MemoLog, updatedFields ...string) (*model.MemoLog, error) {
+	log := model.MemoLog{}
+	return &log, db.Unscoped().Model(&log).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// Delete soft deletes the memo log
+func (s *store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.MemoLog{}).Error
+}
@@ -0,0 +1,26 @@
+package officecheckin
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, checkin *model.OfficeCheckin) (*model.OfficeCheckin, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OfficeCheckin, updatedFields ...string) (*model.OfficeCheckin, error)
+	One(db *gorm.DB, id string) (*model.OfficeCheckin, error)
+	OneByDate(db *gorm.DB, employeeID string, date time.Time) (*model.OfficeCheckin, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.OfficeCheckin, int64, error)
+	GetAttendances(db *gorm.DB, from, to time.Time, officeLocationID string) ([]model.OfficeAttendance, error)
+}
+
+// Query present office check-in query from user
+type Query struct {
+	EmployeeID       string
+	OfficeLocationID string
+	From             *time.Time
+	To               *time.Time
+}
@@ -0,0 +1,95 @@
+package officecheckin
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, checkin *model.OfficeCheckin) (*model.OfficeCheckin, error) {
+	return checkin, db.Omit("Employee", "OfficeLocation").Create(checkin).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OfficeCheckin, updatedFields ...string) (*model.OfficeCheckin, error) {
+	checkin := model.OfficeCheckin{}
+	return &checkin, db.Model(&checkin).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.OfficeCheckin, error) {
+	var checkin model.OfficeCheckin
+	return &checkin, db.Where("id = ?", id).
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("OfficeLocation").
+		First(&checkin).Error
+}
+
+func (s *store) OneByDate(db *gorm.DB, employeeID string, date time.Time) (*model.OfficeCheckin, error) {
+	var checkin model.OfficeCheckin
+	return &checkin, db.Where("employee_id = ? AND date = ?", employeeID, date.Format("2006-01-02")).
+		Preload("OfficeLocation").
+		First(&checkin).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.OfficeCheckin, int64, error) {
+	var (
+		total    int64
+		checkins []*model.OfficeCheckin
+	)
+
+	db = db.Model(&model.OfficeCheckin{})
+	if query.EmployeeID != "" {
+		db = db.Where("employee_id = ?", query.EmployeeID)
+	}
+	if query.OfficeLocationID != "" {
+		db = db.Where("office_location_id = ?", query.OfficeLocationID)
+	}
+	if query.From != nil {
+		db = db.Where("date >= ?", query.From.Format("2006-01-02"))
+	}
+	if query.To != nil {
+		db = db.Where("date <= ?", query.To.Format("2006-01-02"))
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return checkins, total, db.
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("OfficeLocation").
+		Order("date DESC, checked_in_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&checkins).Error
+}
+
+// GetAttendances counts the days at the offices of each employee in [from, to) and sums the hours
+// of the days checked out
+func (s *store) GetAttendances(db *gorm.DB, from, to time.Time, officeLocationID string) ([]model.OfficeAttendance, error) {
+	var res []model.OfficeAttendance
+
+	query := db.Table("office_checkins").
+		Select(`office_checkins.employee_id, employees.full_name, employees.username,
+			COUNT(*) AS days,
+			COUNT(office_checkins.checked_out_at) AS checked_out,
+			COALESCE(SUM(EXTRACT(EPOCH FROM office_checkins.checked_out_at - office_checkins.checked_in_at)) / 3600, 0) AS hours`).
+		Joins("JOIN employees ON employees.id = office_checkins.employee_id").
+		Where("office_checkins.deleted_at IS NULL AND office_checkins.date >= ? AND office_checkins.date < ?",
+			from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if officeLocationID != "" {
+		query = query.Where("office_checkins.office_location_id = ?", officeLocationID)
+	}
+
+	return res, query.
+		Group("office_checkins.employee_id, employees.full_name, employees.username").
+		Order("days DESC, employees.full_name").
+		Scan(&res).Error
+}
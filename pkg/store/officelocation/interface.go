@@ -0,0 +1,14 @@
+package officelocation
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, location *model.OfficeLocation) (*model.OfficeLocation, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OfficeLocation, updatedFields ...string) (*model.OfficeLocation, error)
+	One(db *gorm.DB, id string) (*model.OfficeLocation, error)
+	All(db *gorm.DB, activeOnly bool) ([]*model.OfficeLocation, error)
+}
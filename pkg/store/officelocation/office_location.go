@@ -0,0 +1,36 @@
+package officelocation
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, location *model.OfficeLocation) (*model.OfficeLocation, error) {
+	return location, db.Create(location).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OfficeLocation, updatedFields ...string) (*model.OfficeLocation, error) {
+	location := model.OfficeLocation{}
+	return &location, db.Model(&location).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.OfficeLocation, error) {
+	var location model.OfficeLocation
+	return &location, db.Where("id = ?", id).First(&location).Error
+}
+
+func (s *store) All(db *gorm.DB, activeOnly bool) ([]*model.OfficeLocation, error) {
+	var locations []*model.OfficeLocation
+
+	if activeOnly {
+		db = db.Where("is_active IS TRUE")
+	}
+	return locations, db.Order("name").Find(&locations).Error
+}
@@ -10,4 +10,20 @@ import (
 
 type IStore interface {
 	FindOperationByMonth(db *gorm.DB, month time.Month) ([]*model.OperationalService, error)
+
+	Create(db *gorm.DB, service *model.OperationalService) (*model.OperationalService, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OperationalService, updatedFields ...string) (*model.OperationalService, error)
+	Delete(db *gorm.DB, id string) error
+	One(db *gorm.DB, id string) (*model.OperationalService, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.OperationalService, int64, error)
+	AllActive(db *gorm.DB) ([]*model.OperationalService, error)
+	AllStartedBy(db *gorm.DB, date time.Time) ([]*model.OperationalService, error)
+}
+
+// Query present operational service query from user
+type Query struct {
+	OrganizationID string
+	OwnerID        string
+	IsActive       *bool
+	Keyword        string
 }
@@ -25,3 +25,89 @@ func (s store) FindOperationByMonth(db *gorm.DB, month time.Month) ([]*model.Ope
 	}
 	return res, nil
 }
+
+func (s store) Create(db *gorm.DB, service *model.OperationalService) (*model.OperationalService, error) {
+	return service, db.Create(service).Error
+}
+
+func (s store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OperationalService, updatedFields ...string) (*model.OperationalService, error) {
+	service := model.OperationalService{}
+	return &service, db.Model(&service).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+func (s store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.OperationalService{}).Error
+}
+
+// One get a service with the seats currently held
+func (s store) One(db *gorm.DB, id string) (*model.OperationalService, error) {
+	var service model.OperationalService
+	return &service, db.Where("id = ?", id).
+		Preload("Currency").
+		Preload("Owner", "deleted_at IS NULL").
+		Preload("Organization", "deleted_at IS NULL").
+		Preload("Seats", func(db *gorm.DB) *gorm.DB {
+			return db.Where("released_at IS NULL").Order("operational_service_seats.assigned_at")
+		}).
+		Preload("Seats.Employee").
+		First(&service).Error
+}
+
+func (s store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.OperationalService, int64, error) {
+	var (
+		total    int64
+		services []*model.OperationalService
+	)
+
+	db = db.Model(&model.OperationalService{})
+	if query.OrganizationID != "" {
+		db = db.Where("organization_id = ?", query.OrganizationID)
+	}
+	if query.OwnerID != "" {
+		db = db.Where("owner_id = ?", query.OwnerID)
+	}
+	if query.IsActive != nil {
+		db = db.Where("is_active = ?", *query.IsActive)
+	}
+	if query.Keyword != "" {
+		keyword := "%" + query.Keyword + "%"
+		db = db.Where("name ILIKE ? OR vendor ILIKE ? OR plan ILIKE ?", keyword, keyword, keyword)
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return services, total, db.
+		Preload("Currency").
+		Preload("Owner", "deleted_at IS NULL").
+		Preload("Organization", "deleted_at IS NULL").
+		Order("renewal_date NULLS LAST, name").
+		Limit(limit).
+		Offset(offset).
+		Find(&services).Error
+}
+
+// AllActive get the services still paid for, with the seats currently held and their holders
+func (s store) AllActive(db *gorm.DB) ([]*model.OperationalService, error) {
+	var services []*model.OperationalService
+	return services, db.Where("is_active IS TRUE").
+		Preload("Currency").
+		Preload("Owner", "deleted_at IS NULL").
+		Preload("Owner.DiscordAccount", "deleted_at IS NULL").
+		Preload("Organization", "deleted_at IS NULL").
+		Preload("Seats", "released_at IS NULL").
+		Preload("Seats.Employee").
+		Order("name").
+		Find(&services).Error
+}
+
+// AllStartedBy get the services started by a date, the ones already ended included
+func (s store) AllStartedBy(db *gorm.DB, date time.Time) ([]*model.OperationalService, error) {
+	var services []*model.OperationalService
+	return services, db.Where("start_at <= ?", date).
+		Preload("Currency").
+		Preload("Organization", "deleted_at IS NULL").
+		Order("name").
+		Find(&services).Error
+}
@@ -0,0 +1,14 @@
+package operationalserviceseat
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, seat *model.OperationalServiceSeat) (*model.OperationalServiceSeat, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OperationalServiceSeat, updatedFields ...string) (*model.OperationalServiceSeat, error)
+	One(db *gorm.DB, id string) (*model.OperationalServiceSeat, error)
+	OneOpen(db *gorm.DB, serviceID string, employeeID string) (*model.OperationalServiceSeat, error)
+}
@@ -0,0 +1,34 @@
+package operationalserviceseat
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, seat *model.OperationalServiceSeat) (*model.OperationalServiceSeat, error) {
+	return seat, db.Create(seat).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.OperationalServiceSeat, updatedFields ...string) (*model.OperationalServiceSeat, error) {
+	seat := model.OperationalServiceSeat{}
+	return &seat, db.Model(&seat).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.OperationalServiceSeat, error) {
+	var seat model.OperationalServiceSeat
+	return &seat, db.Where("id = ?", id).Preload("Employee").First(&seat).Error
+}
+
+// OneOpen get the seat of a service an employee currently holds
+func (s *store) OneOpen(db *gorm.DB, serviceID string, employeeID string) (*model.OperationalServiceSeat, error) {
+	var seat model.OperationalServiceSeat
+	return &seat, db.Where("operational_service_id = ? AND employee_id = ? AND released_at IS NULL", serviceID, employeeID).
+		First(&seat).Error
+}
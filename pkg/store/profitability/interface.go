@@ -0,0 +1,17 @@
+package profitability
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// IStore reads the revenue and the costs of the projects for the months in [from, to)
+type IStore interface {
+	GetRevenues(db *gorm.DB, from, to time.Time) ([]model.ProfitabilityRevenue, error)
+	GetMembers(db *gorm.DB, from, to time.Time) ([]model.ProfitabilityMember, error)
+	GetPayrollSalaries(db *gorm.DB, from, to time.Time) ([]model.ProfitabilitySalary, error)
+	GetCommissions(db *gorm.DB, from, to time.Time) ([]model.ProfitabilityCommission, error)
+}
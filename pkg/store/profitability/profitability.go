@@ -0,0 +1,86 @@
+package profitability
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// GetRevenues returns the sent, overdue and paid invoices of the months in the range
+func (s *store) GetRevenues(db *gorm.DB, from, to time.Time) ([]model.ProfitabilityRevenue, error) {
+	var res []model.ProfitabilityRevenue
+	return res, db.Raw(`
+		SELECT invoices.project_id, projects.name AS project_name, invoices.year, invoices.month,
+			invoices.invoiced_at, invoices.total, invoices.conversion_amount, currencies.name AS currency
+		FROM invoices
+			JOIN projects ON projects.id = invoices.project_id AND projects.deleted_at IS NULL
+			LEFT JOIN bank_accounts ON bank_accounts.id = invoices.bank_id
+			LEFT JOIN currencies ON currencies.id = bank_accounts.currency_id
+		WHERE invoices.deleted_at IS NULL
+			AND invoices.status IN ?
+			AND make_date(invoices.year, invoices.month, 1) >= ?
+			AND make_date(invoices.year, invoices.month, 1) < ?`,
+		[]model.InvoiceStatus{model.InvoiceStatusSent, model.InvoiceStatusOverdue, model.InvoiceStatusPaid},
+		from, to).
+		Scan(&res).Error
+}
+
+// GetMembers returns the deployments overlapping the range, the pending ones left out
+func (s *store) GetMembers(db *gorm.DB, from, to time.Time) ([]model.ProfitabilityMember, error) {
+	var res []model.ProfitabilityMember
+	return res, db.Raw(`
+		SELECT project_members.project_id, projects.name AS project_name, project_members.employee_id,
+			employees.full_name, employees.working_status, discord_accounts.username AS discord_username,
+			project_members.deployment_type, project_members.start_date, project_members.end_date
+		FROM project_members
+			JOIN projects ON projects.id = project_members.project_id AND projects.deleted_at IS NULL
+			JOIN employees ON employees.id = project_members.employee_id AND employees.deleted_at IS NULL
+			LEFT JOIN discord_accounts ON discord_accounts.id = employees.discord_account_id
+		WHERE project_members.deleted_at IS NULL
+			AND project_members.status <> ?
+			AND project_members.start_date < ?
+			AND (project_members.end_date IS NULL OR project_members.end_date >= ?)`,
+		model.ProjectMemberStatusPending, to, from).
+		Scan(&res).Error
+}
+
+// GetPayrollSalaries returns the salary cost of the payrolls in the range, in VND. The commissions
+// are reported on the projects of their invoices and the project bonuses are not a salary, both
+// are taken out of the total.
+func (s *store) GetPayrollSalaries(db *gorm.DB, from, to time.Time) ([]model.ProfitabilitySalary, error) {
+	var res []model.ProfitabilitySalary
+	return res, db.Raw(`
+		SELECT employee_id, year, month,
+			SUM(total - commission_amount - project_bonus_amount) AS amount
+		FROM payrolls
+		WHERE make_date(year, month, 1) >= ?
+			AND make_date(year, month, 1) < ?
+		GROUP BY employee_id, year, month`,
+		from, to).
+		Scan(&res).Error
+}
+
+// GetCommissions returns the commissions on the invoices of the months in the range, in VND
+func (s *store) GetCommissions(db *gorm.DB, from, to time.Time) ([]model.ProfitabilityCommission, error) {
+	var res []model.ProfitabilityCommission
+	return res, db.Raw(`
+		SELECT invoices.project_id, projects.name AS project_name, invoices.year, invoices.month,
+			SUM(employee_commissions.amount) AS amount
+		FROM employee_commissions
+			JOIN invoices ON invoices.id = employee_commissions.invoice_id AND invoices.deleted_at IS NULL
+			JOIN projects ON projects.id = invoices.project_id AND projects.deleted_at IS NULL
+		WHERE employee_commissions.deleted_at IS NULL
+			AND make_date(invoices.year, invoices.month, 1) >= ?
+			AND make_date(invoices.year, invoices.month, 1) < ?
+		GROUP BY invoices.project_id, projects.name, invoices.year, invoices.month`,
+		from, to).
+		Scan(&res).Error
+}
@@ -0,0 +1,22 @@
+package projectbudget
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, budget *model.ProjectBudget) (*model.ProjectBudget, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ProjectBudget, updatedFields ...string) (*model.ProjectBudget, error)
+	One(db *gorm.DB, projectID, id string) (*model.ProjectBudget, error)
+	All(db *gorm.DB, query Query) ([]*model.ProjectBudget, error)
+	GetInvoices(db *gorm.DB, budget *model.ProjectBudget) ([]model.ProjectBudgetInvoice, error)
+	GetProjectHeads(db *gorm.DB, projectID string) ([]*model.Employee, error)
+}
+
+// Query present project budget query from user
+type Query struct {
+	ProjectID string
+	Status    model.ProjectBudgetStatus
+}
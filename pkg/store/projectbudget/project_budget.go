@@ -0,0 +1,86 @@
+package projectbudget
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, budget *model.ProjectBudget) (*model.ProjectBudget, error) {
+	return budget, db.Omit("Milestones").Create(budget).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.ProjectBudget, updatedFields ...string) (*model.ProjectBudget, error) {
+	budget := model.ProjectBudget{}
+	return &budget, db.Model(&budget).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// One get a budget of the project with its milestones
+func (s *store) One(db *gorm.DB, projectID, id string) (*model.ProjectBudget, error) {
+	var budget model.ProjectBudget
+	return &budget, db.Where("id = ? AND project_id = ?", id, projectID).
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Currency").
+		Preload("Milestones", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("due_date NULLS LAST, created_at")
+		}).
+		Preload("Milestones.Invoice", "deleted_at IS NULL").
+		First(&budget).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query) ([]*model.ProjectBudget, error) {
+	var budgets []*model.ProjectBudget
+
+	db = db.Model(&model.ProjectBudget{})
+	if query.ProjectID != "" {
+		db = db.Where("project_id = ?", query.ProjectID)
+	}
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+
+	return budgets, db.
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Currency").
+		Order("start_date DESC, created_at DESC").
+		Find(&budgets).Error
+}
+
+// GetInvoices returns the issued and paid invoices of the project in the months the budget covers
+func (s *store) GetInvoices(db *gorm.DB, budget *model.ProjectBudget) ([]model.ProjectBudgetInvoice, error) {
+	var res []model.ProjectBudgetInvoice
+
+	query := db.Table("invoices").
+		Select(`invoices.id, invoices.number, invoices.year, invoices.month, invoices.status, invoices.total,
+			currencies.name AS currency, invoices.invoiced_at, invoices.paid_at`).
+		Joins("LEFT JOIN bank_accounts ON bank_accounts.id = invoices.bank_id").
+		Joins("LEFT JOIN currencies ON currencies.id = bank_accounts.currency_id").
+		Where("invoices.deleted_at IS NULL AND invoices.project_id = ?", budget.ProjectID).
+		Where("invoices.status IN ?", model.ProjectBudgetInvoiceStatuses).
+		Where("make_date(invoices.year, invoices.month, 1) >= date_trunc('month', ?::date)", budget.StartDate)
+	if budget.EndDate != nil {
+		query = query.Where("make_date(invoices.year, invoices.month, 1) <= ?", *budget.EndDate)
+	}
+
+	return res, query.Order("invoices.year, invoices.month, invoices.invoiced_at").Scan(&res).Error
+}
+
+// GetProjectHeads returns the current heads of the project, whatever their position
+func (s *store) GetProjectHeads(db *gorm.DB, projectID string) ([]*model.Employee, error) {
+	var employees []*model.Employee
+
+	heads := db.Model(&model.ProjectHead{}).
+		Select("employee_id").
+		Where("project_id = ? AND deleted_at IS NULL AND (end_date IS NULL OR end_date > now())", projectID)
+
+	return employees, db.
+		Where("id IN (?)", heads).
+		Preload("DiscordAccount").
+		Find(&employees).Error
+}
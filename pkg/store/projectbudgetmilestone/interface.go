@@ -0,0 +1,12 @@
+package projectbudgetmilestone
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	BatchCreate(db *gorm.DB, milestones []model.ProjectBudgetMilestone) ([]model.ProjectBudgetMilestone, error)
+	DeleteByBudgetID(db *gorm.DB, budgetID string) error
+}
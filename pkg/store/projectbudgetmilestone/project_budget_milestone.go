@@ -0,0 +1,24 @@
+package projectbudgetmilestone
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) BatchCreate(db *gorm.DB, milestones []model.ProjectBudgetMilestone) ([]model.ProjectBudgetMilestone, error) {
+	if len(milestones) == 0 {
+		return milestones, nil
+	}
+	return milestones, db.Omit("Invoice").Create(&milestones).Error
+}
+
+func (s *store) DeleteByBudgetID(db *gorm.DB, budgetID string) error {
+	return db.Where("project_budget_id = ?", budgetID).Delete(&model.ProjectBudgetMilestone{}).Error
+}
@@ -17,4 +17,18 @@ type IStore interface {
 	GetByDuration(db *gorm.DB, from, to time.Time) ([]model.Candidate, error)
 	GetAll(db *gorm.DB) ([]model.Candidate, error)
 	GetOffered(db *gorm.DB, batchDate, dueDate time.Time) ([]model.Candidate, error)
+
+	Create(db *gorm.DB, candidate *model.Candidate) (*model.Candidate, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Candidate, updatedFields ...string) (*model.Candidate, error)
+	One(db *gorm.DB, id string) (*model.Candidate, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Candidate, int64, error)
+	CountByStageID(db *gorm.DB, stageID string) (int64, error)
+}
+
+// Query present candidate query from user
+type Query struct {
+	PositionID string
+	StageID    string
+	Status     model.CandidateStatus
+	Keyword    string
 }
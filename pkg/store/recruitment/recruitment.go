@@ -48,3 +48,71 @@ func (s *store) GetOffered(db *gorm.DB, batchDate, dueDate time.Time) ([]model.C
 	var c []model.Candidate
 	return c, db.Where("offer_start_date > ? AND offer_start_date < ? AND status = ?", batchDate, dueDate, model.HiredCandidateStatus).Find(&c).Error
 }
+
+func (s *store) Create(db *gorm.DB, candidate *model.Candidate) (*model.Candidate, error) {
+	return candidate, db.Create(candidate).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Candidate, updatedFields ...string) (*model.Candidate, error) {
+	candidate := model.Candidate{}
+	return &candidate, db.Model(&candidate).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// One get a candidate with its stage, its transitions and its interviews
+func (s *store) One(db *gorm.DB, id string) (*model.Candidate, error) {
+	var c model.Candidate
+	return &c, db.Where("id = ?", id).
+		Preload("Position", "deleted_at IS NULL").
+		Preload("Stage", "deleted_at IS NULL").
+		Preload("Transitions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("candidate_stage_transitions.created_at")
+		}).
+		Preload("Transitions.FromStage").
+		Preload("Transitions.ToStage").
+		Preload("Transitions.Actor").
+		Preload("Interviews", func(db *gorm.DB) *gorm.DB {
+			return db.Order("candidate_interviews.scheduled_at NULLS LAST, candidate_interviews.created_at")
+		}).
+		Preload("Interviews.Interviewer").
+		First(&c).Error
+}
+
+// All get the candidates of the pipeline, the latest first
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Candidate, int64, error) {
+	var (
+		total      int64
+		candidates []*model.Candidate
+	)
+
+	db = db.Model(&model.Candidate{})
+	if query.PositionID != "" {
+		db = db.Where("position_id = ?", query.PositionID)
+	}
+	if query.StageID != "" {
+		db = db.Where("stage_id = ?", query.StageID)
+	}
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.Keyword != "" {
+		keyword := "%" + query.Keyword + "%"
+		db = db.Where("name ILIKE ? OR email ILIKE ? OR phone ILIKE ?", keyword, keyword, keyword)
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return candidates, total, db.
+		Preload("Position", "deleted_at IS NULL").
+		Preload("Stage", "deleted_at IS NULL").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&candidates).Error
+}
+
+func (s *store) CountByStageID(db *gorm.DB, stageID string) (int64, error) {
+	var total int64
+	return total, db.Model(&model.Candidate{}).Where("stage_id = ?", stageID).Count(&total).Error
+}
@@ -0,0 +1,21 @@
+package recruitmentstage
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, stage *model.RecruitmentStage) (*model.RecruitmentStage, error)
+	Update(db *gorm.DB, stage *model.RecruitmentStage) (*model.RecruitmentStage, error)
+	Delete(db *gorm.DB, id string) error
+	One(db *gorm.DB, id string) (*model.RecruitmentStage, error)
+	All(db *gorm.DB, query Query) ([]*model.RecruitmentStage, error)
+}
+
+// Query present recruitment stage query from user
+type Query struct {
+	// PositionID keeps the stages of the position along with the default ones
+	PositionID string
+}
@@ -0,0 +1,41 @@
+package recruitmentstage
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, stage *model.RecruitmentStage) (*model.RecruitmentStage, error) {
+	return stage, db.Create(stage).Error
+}
+
+func (s *store) Update(db *gorm.DB, stage *model.RecruitmentStage) (*model.RecruitmentStage, error) {
+	return stage, db.Save(stage).Error
+}
+
+func (s *store) Delete(db *gorm.DB, id string) error {
+	return db.Where("id = ?", id).Delete(&model.RecruitmentStage{}).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.RecruitmentStage, error) {
+	var stage model.RecruitmentStage
+	return &stage, db.Where("id = ?", id).First(&stage).Error
+}
+
+// All get the stages ordered as in the pipeline
+func (s *store) All(db *gorm.DB, query Query) ([]*model.RecruitmentStage, error) {
+	var stages []*model.RecruitmentStage
+
+	if query.PositionID != "" {
+		db = db.Where("position_id IS NULL OR position_id = ?", query.PositionID)
+	}
+
+	return stages, db.Order("position_id NULLS FIRST, sort_order, created_at").Find(&stages).Error
+}
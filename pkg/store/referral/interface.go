@@ -0,0 +1,22 @@
+package referral
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, referral *model.Referral) (*model.Referral, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Referral, updatedFields ...string) (*model.Referral, error)
+	One(db *gorm.DB, id string) (*model.Referral, error)
+	OneByCandidateID(db *gorm.DB, candidateID string) (*model.Referral, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Referral, int64, error)
+	AllInProgress(db *gorm.DB) ([]*model.Referral, error)
+}
+
+// Query present referral query from user
+type Query struct {
+	ReferrerID string
+	Status     model.ReferralStatus
+}
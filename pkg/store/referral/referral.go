@@ -0,0 +1,88 @@
+package referral
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, referral *model.Referral) (*model.Referral, error) {
+	return referral, db.Create(referral).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Referral, updatedFields ...string) (*model.Referral, error) {
+	referral := model.Referral{}
+	return &referral, db.Model(&referral).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// One get a referral with its referrer, its hire and its bonuses
+func (s *store) One(db *gorm.DB, id string) (*model.Referral, error) {
+	var referral model.Referral
+	return &referral, db.Where("id = ?", id).
+		Preload("Referrer", "deleted_at IS NULL").
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Bonuses", func(db *gorm.DB) *gorm.DB {
+			return db.Order("referral_bonuses.reached_at")
+		}).
+		Preload("Bonuses.Milestone").
+		First(&referral).Error
+}
+
+func (s *store) OneByCandidateID(db *gorm.DB, candidateID string) (*model.Referral, error) {
+	var referral model.Referral
+	return &referral, db.Where("candidate_id = ?", candidateID).First(&referral).Error
+}
+
+// All get the referrals, the latest first
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Referral, int64, error) {
+	var (
+		total     int64
+		referrals []*model.Referral
+	)
+
+	db = db.Model(&model.Referral{})
+	if query.ReferrerID != "" {
+		db = db.Where("referrer_id = ?", query.ReferrerID)
+	}
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return referrals, total, db.
+		Preload("Referrer", "deleted_at IS NULL").
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Bonuses", func(db *gorm.DB) *gorm.DB {
+			return db.Order("referral_bonuses.reached_at")
+		}).
+		Preload("Bonuses.Milestone").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&referrals).Error
+}
+
+// AllInProgress get the referrals of the hires who can still reach a milestone
+func (s *store) AllInProgress(db *gorm.DB) ([]*model.Referral, error) {
+	var referrals []*model.Referral
+	return referrals, db.
+		Where("employee_id IS NOT NULL AND status NOT IN ?", []model.ReferralStatus{
+			model.ReferralStatusCompleted,
+			model.ReferralStatusFailed,
+			model.ReferralStatusLeft,
+		}).
+		Preload("Referrer").
+		Preload("Employee").
+		Preload("Candidate").
+		Preload("Bonuses").
+		Find(&referrals).Error
+}
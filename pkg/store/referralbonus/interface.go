@@ -0,0 +1,15 @@
+package referralbonus
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, bonus *model.ReferralBonus) (*model.ReferralBonus, error)
+	AllScheduledByReferrerID(db *gorm.DB, referrerID string) ([]*model.ReferralBonus, error)
+	UpdateStatus(db *gorm.DB, ids []model.UUID, status model.ReferralBonusStatus, paidAt *time.Time) error
+}
@@ -0,0 +1,37 @@
+package referralbonus
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, bonus *model.ReferralBonus) (*model.ReferralBonus, error) {
+	return bonus, db.Create(bonus).Error
+}
+
+// AllScheduledByReferrerID get the bonuses waiting for the payroll of the referrer
+func (s *store) AllScheduledByReferrerID(db *gorm.DB, referrerID string) ([]*model.ReferralBonus, error) {
+	var bonuses []*model.ReferralBonus
+	return bonuses, db.Where("referrer_id = ? AND status = ?", referrerID, model.ReferralBonusStatusScheduled).
+		Preload("Milestone").
+		Preload("EmployeeBonus").
+		Find(&bonuses).Error
+}
+
+func (s *store) UpdateStatus(db *gorm.DB, ids []model.UUID, status model.ReferralBonusStatus, paidAt *time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return db.Model(&model.ReferralBonus{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{"status": status, "paid_at": paidAt}).Error
+}
@@ -0,0 +1,15 @@
+package referralmilestone
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, milestone *model.ReferralBonusMilestone) (*model.ReferralBonusMilestone, error)
+	Update(db *gorm.DB, milestone *model.ReferralBonusMilestone) (*model.ReferralBonusMilestone, error)
+	One(db *gorm.DB, id string) (*model.ReferralBonusMilestone, error)
+	OneByCode(db *gorm.DB, code string) (*model.ReferralBonusMilestone, error)
+	All(db *gorm.DB) ([]*model.ReferralBonusMilestone, error)
+}
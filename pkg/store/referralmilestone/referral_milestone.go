@@ -0,0 +1,37 @@
+package referralmilestone
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, milestone *model.ReferralBonusMilestone) (*model.ReferralBonusMilestone, error) {
+	return milestone, db.Create(milestone).Error
+}
+
+func (s *store) Update(db *gorm.DB, milestone *model.ReferralBonusMilestone) (*model.ReferralBonusMilestone, error) {
+	return milestone, db.Save(milestone).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.ReferralBonusMilestone, error) {
+	var milestone model.ReferralBonusMilestone
+	return &milestone, db.Where("id = ?", id).First(&milestone).Error
+}
+
+func (s *store) OneByCode(db *gorm.DB, code string) (*model.ReferralBonusMilestone, error) {
+	var milestone model.ReferralBonusMilestone
+	return &milestone, db.Where("code = ?", code).First(&milestone).Error
+}
+
+// All get the milestones in the order of the program
+func (s *store) All(db *gorm.DB) ([]*model.ReferralBonusMilestone, error) {
+	var milestones []*model.ReferralBonusMilestone
+	return milestones, db.Order("sort_order, created_at").Find(&milestones).Error
+}
@@ -0,0 +1,14 @@
+package reviewcycle
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	All(db *gorm.DB) ([]*model.ReviewCycle, error)
+	One(db *gorm.DB, id string) (*model.ReviewCycle, error)
+	Create(db *gorm.DB, cycle *model.ReviewCycle) (*model.ReviewCycle, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, cycle model.ReviewCycle, updatedFields ...string) (*model.ReviewCycle, error)
+}
@@ -0,0 +1,33 @@
+package reviewcycle
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// All get the review cycles, latest period first
+func (s *store) All(db *gorm.DB) ([]*model.ReviewCycle, error) {
+	var cycles []*model.ReviewCycle
+	return cycles, db.Order("period_from DESC, created_at DESC").Find(&cycles).Error
+}
+
+func (s *store) One(db *gorm.DB, id string) (*model.ReviewCycle, error) {
+	var cycle model.ReviewCycle
+	return &cycle, db.Where("id = ?", id).First(&cycle).Error
+}
+
+func (s *store) Create(db *gorm.DB, cycle *model.ReviewCycle) (*model.ReviewCycle, error) {
+	return cycle, db.Create(cycle).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, cycle model.ReviewCycle, updatedFields ...string) (*model.ReviewCycle, error) {
+	rs := model.ReviewCycle{}
+	return &rs, db.Model(&rs).Where("id = ?", id).Select(updatedFields).Updates(cycle).First(&rs).Error
+}
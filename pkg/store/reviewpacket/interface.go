@@ -0,0 +1,15 @@
+package reviewpacket
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	ListByCycleID(db *gorm.DB, cycleID string, preload bool) ([]*model.ReviewPacket, error)
+	One(db *gorm.DB, cycleID string, id string, preload bool) (*model.ReviewPacket, error)
+	BatchCreate(db *gorm.DB, packets []*model.ReviewPacket) ([]*model.ReviewPacket, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, packet model.ReviewPacket, updatedFields ...string) (*model.ReviewPacket, error)
+	UpdateStatus(db *gorm.DB, id string, from model.ReviewPacketStatus, packet model.ReviewPacket, updatedFields ...string) (bool, error)
+}
@@ -0,0 +1,63 @@
+package reviewpacket
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// ListByCycleID get the packets of a review cycle, with the employees and their seniorities when
+// preloading
+func (s *store) ListByCycleID(db *gorm.DB, cycleID string, preload bool) ([]*model.ReviewPacket, error) {
+	var packets []*model.ReviewPacket
+
+	query := db.Where("cycle_id = ?", cycleID).Order("created_at")
+	if preload {
+		query = withRelations(query)
+	}
+	return packets, query.Find(&packets).Error
+}
+
+// One get a packet of a review cycle
+func (s *store) One(db *gorm.DB, cycleID string, id string, preload bool) (*model.ReviewPacket, error) {
+	var packet model.ReviewPacket
+
+	query := db.Where("cycle_id = ? AND id = ?", cycleID, id)
+	if preload {
+		query = withRelations(query)
+	}
+	return &packet, query.First(&packet).Error
+}
+
+func (s *store) BatchCreate(db *gorm.DB, packets []*model.ReviewPacket) ([]*model.ReviewPacket, error) {
+	return packets, db.Create(&packets).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, packet model.ReviewPacket, updatedFields ...string) (*model.ReviewPacket, error) {
+	rs := model.ReviewPacket{}
+	return &rs, db.Model(&rs).Where("id = ?", id).Select(updatedFields).Updates(packet).First(&rs).Error
+}
+
+// UpdateStatus updates the packet only while it is in the from status, it returns whether it did so
+// that a packet is never decided twice
+func (s *store) UpdateStatus(db *gorm.DB, id string, from model.ReviewPacketStatus, packet model.ReviewPacket, updatedFields ...string) (bool, error) {
+	result := db.Model(&model.ReviewPacket{}).
+		Where("id = ? AND status = ?", id, from).
+		Select(updatedFields).
+		Updates(packet)
+	return result.RowsAffected > 0, result.Error
+}
+
+func withRelations(query *gorm.DB) *gorm.DB {
+	return query.
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Manager", "deleted_at IS NULL").
+		Preload("CurrentSeniority").
+		Preload("ProposedSeniority")
+}
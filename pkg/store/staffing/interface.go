@@ -0,0 +1,14 @@
+package staffing
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// IStore reads what a project slot asks for and what the employees bring to it
+type IStore interface {
+	GetSlot(db *gorm.DB, projectID, slotID string) (*model.StaffingSlot, error)
+	GetProfiles(db *gorm.DB, employeeIDs []string) ([]model.StaffingProfile, error)
+	GetClientProjects(db *gorm.DB, clientID string) ([]model.StaffingClientProject, error)
+}
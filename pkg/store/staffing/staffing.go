@@ -0,0 +1,109 @@
+package staffing
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// GetSlot returns the slot of the project with its positions and the stacks of the project
+func (s *store) GetSlot(db *gorm.DB, projectID, slotID string) (*model.StaffingSlot, error) {
+	var res model.StaffingSlot
+	result := db.Raw(`
+		SELECT project_slots.id AS slot_id, projects.id AS project_id, projects.name AS project_name,
+			clients.id AS client_id, clients.name AS client_name,
+			seniorities.name AS seniority_name, seniorities.level AS seniority_level,
+			project_slots.deployment_type, project_slots.status,
+			(SELECT string_agg(DISTINCT positions.name, ',')
+				FROM project_slot_positions
+					JOIN positions ON positions.id = project_slot_positions.position_id AND positions.deleted_at IS NULL
+				WHERE project_slot_positions.project_slot_id = project_slots.id
+					AND project_slot_positions.deleted_at IS NULL) AS positions,
+			(SELECT string_agg(DISTINCT stacks.name, ',')
+				FROM project_stacks
+					JOIN stacks ON stacks.id = project_stacks.stack_id AND stacks.deleted_at IS NULL
+				WHERE project_stacks.project_id = projects.id
+					AND project_stacks.deleted_at IS NULL) AS stacks,
+			(SELECT project_members.employee_id
+				FROM project_members
+				WHERE project_members.project_slot_id = project_slots.id
+					AND project_members.deleted_at IS NULL
+					AND project_members.status <> ?
+					AND (project_members.end_date IS NULL OR project_members.end_date > now())
+				LIMIT 1) AS employee_id
+		FROM project_slots
+			JOIN projects ON projects.id = project_slots.project_id AND projects.deleted_at IS NULL
+			LEFT JOIN clients ON clients.id = projects.client_id AND clients.deleted_at IS NULL
+			LEFT JOIN seniorities ON seniorities.id = project_slots.seniority_id
+		WHERE project_slots.deleted_at IS NULL
+			AND project_slots.project_id = ?
+			AND project_slots.id = ?`,
+		model.ProjectMemberStatusInactive, projectID, slotID).
+		Scan(&res)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &res, nil
+}
+
+// GetProfiles returns the seniority, positions, chapters and latest MMA score of the employees
+func (s *store) GetProfiles(db *gorm.DB, employeeIDs []string) ([]model.StaffingProfile, error) {
+	var res []model.StaffingProfile
+	if len(employeeIDs) == 0 {
+		return res, nil
+	}
+
+	return res, db.Raw(`
+		SELECT employees.id AS employee_id, COALESCE(seniorities.level, 0) AS seniority_level,
+			(SELECT string_agg(DISTINCT positions.name, ',')
+				FROM employee_positions
+					JOIN positions ON positions.id = employee_positions.position_id AND positions.deleted_at IS NULL
+				WHERE employee_positions.employee_id = employees.id
+					AND employee_positions.deleted_at IS NULL) AS positions,
+			(SELECT string_agg(DISTINCT chapters.name, ',')
+				FROM employee_chapters
+					JOIN chapters ON chapters.id = employee_chapters.chapter_id AND chapters.deleted_at IS NULL
+				WHERE employee_chapters.employee_id = employees.id
+					AND employee_chapters.deleted_at IS NULL) AS chapters,
+			m.mastery_score, m.autonomy_score, m.meaning_score
+		FROM employees
+			LEFT JOIN seniorities ON seniorities.id = employees.seniority_id
+			LEFT JOIN (
+				SELECT employee_id, mastery_score, autonomy_score, meaning_score
+				FROM employee_mma_scores
+				WHERE deleted_at IS NULL AND (employee_id, rated_at) IN (
+					SELECT employee_id, MAX(rated_at) AS rated_at
+					FROM employee_mma_scores
+					WHERE deleted_at IS NULL
+					GROUP BY employee_id
+				)
+			) m ON m.employee_id = employees.id
+		WHERE employees.id IN ?`,
+		employeeIDs).
+		Scan(&res).Error
+}
+
+// GetClientProjects returns the projects of the client each employee was a member of
+func (s *store) GetClientProjects(db *gorm.DB, clientID string) ([]model.StaffingClientProject, error) {
+	var res []model.StaffingClientProject
+	return res, db.Raw(`
+		SELECT DISTINCT project_members.employee_id, projects.name AS project_name
+		FROM project_members
+			JOIN projects ON projects.id = project_members.project_id AND projects.deleted_at IS NULL
+		WHERE project_members.deleted_at IS NULL
+			AND (project_members.start_date IS NULL OR project_members.start_date <= now())
+			AND projects.client_id = ?
+		ORDER BY project_name`,
+		clientID).
+		Scan(&res).Error
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store/agentworkflow"
 	"github.com/dwarvesf/fortress-api/pkg/store/apikey"
 	"github.com/dwarvesf/fortress-api/pkg/store/apikeyrole"
+	"github.com/dwarvesf/fortress-api/pkg/store/asset"
+	"github.com/dwarvesf/fortress-api/pkg/store/assetassignment"
+	"github.com/dwarvesf/fortress-api/pkg/store/assetdepreciation"
 	"github.com/dwarvesf/fortress-api/pkg/store/audit"
 	"github.com/dwarvesf/fortress-api/pkg/store/auditactionitem"
 	"github.com/dwarvesf/fortress-api/pkg/store/auditcycle"
@@ -15,20 +18,34 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store/auditparticipant"
 	"github.com/dwarvesf/fortress-api/pkg/store/bank"
 	"github.com/dwarvesf/fortress-api/pkg/store/bankaccount"
+	"github.com/dwarvesf/fortress-api/pkg/store/bankstatement"
+	"github.com/dwarvesf/fortress-api/pkg/store/banktransaction"
 	"github.com/dwarvesf/fortress-api/pkg/store/basesalary"
 	"github.com/dwarvesf/fortress-api/pkg/store/brainerylog"
 	"github.com/dwarvesf/fortress-api/pkg/store/cachedpayroll"
+	"github.com/dwarvesf/fortress-api/pkg/store/candidateinterview"
+	"github.com/dwarvesf/fortress-api/pkg/store/candidatetransition"
+	"github.com/dwarvesf/fortress-api/pkg/store/capacity"
+	"github.com/dwarvesf/fortress-api/pkg/store/cashflow"
+	"github.com/dwarvesf/fortress-api/pkg/store/cashflowforecast"
 	"github.com/dwarvesf/fortress-api/pkg/store/chapter"
 	"github.com/dwarvesf/fortress-api/pkg/store/client"
 	"github.com/dwarvesf/fortress-api/pkg/store/clientcontact"
+	"github.com/dwarvesf/fortress-api/pkg/store/clientportal"
 	"github.com/dwarvesf/fortress-api/pkg/store/companyinfo"
 	"github.com/dwarvesf/fortress-api/pkg/store/config"
 	"github.com/dwarvesf/fortress-api/pkg/store/content"
+	"github.com/dwarvesf/fortress-api/pkg/store/contract"
+	"github.com/dwarvesf/fortress-api/pkg/store/contractattachment"
+	"github.com/dwarvesf/fortress-api/pkg/store/contractrate"
 	"github.com/dwarvesf/fortress-api/pkg/store/conversionrate"
 	"github.com/dwarvesf/fortress-api/pkg/store/country"
 	"github.com/dwarvesf/fortress-api/pkg/store/currency"
 	"github.com/dwarvesf/fortress-api/pkg/store/dashboard"
+	"github.com/dwarvesf/fortress-api/pkg/store/deliveryleaderboard"
 	"github.com/dwarvesf/fortress-api/pkg/store/deliverymetric"
+	"github.com/dwarvesf/fortress-api/pkg/store/deliverymetricimport"
+	"github.com/dwarvesf/fortress-api/pkg/store/deliverymetricmapping"
 	"github.com/dwarvesf/fortress-api/pkg/store/deliverymetricmonthly"
 	"github.com/dwarvesf/fortress-api/pkg/store/deliverymetricweekly"
 	"github.com/dwarvesf/fortress-api/pkg/store/discordaccount"
@@ -46,24 +63,42 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store/employeeposition"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeerole"
 	"github.com/dwarvesf/fortress-api/pkg/store/employeestack"
+	"github.com/dwarvesf/fortress-api/pkg/store/engagementactivity"
+	"github.com/dwarvesf/fortress-api/pkg/store/engagementchannelstat"
+	"github.com/dwarvesf/fortress-api/pkg/store/engagementmemberstat"
 	"github.com/dwarvesf/fortress-api/pkg/store/engagementsrollup"
+	"github.com/dwarvesf/fortress-api/pkg/store/engagementweeklystat"
 	"github.com/dwarvesf/fortress-api/pkg/store/eventspeaker"
 	"github.com/dwarvesf/fortress-api/pkg/store/expense"
 	"github.com/dwarvesf/fortress-api/pkg/store/feedbackevent"
+	"github.com/dwarvesf/fortress-api/pkg/store/fxrate"
 	"github.com/dwarvesf/fortress-api/pkg/store/icydistribution"
+	"github.com/dwarvesf/fortress-api/pkg/store/icyrewardpayout"
+	"github.com/dwarvesf/fortress-api/pkg/store/icyrewardrule"
 	"github.com/dwarvesf/fortress-api/pkg/store/icytransaction"
+	"github.com/dwarvesf/fortress-api/pkg/store/icytreasurysnapshot"
 	"github.com/dwarvesf/fortress-api/pkg/store/inboundfundtransaction"
 	"github.com/dwarvesf/fortress-api/pkg/store/invoice"
 	"github.com/dwarvesf/fortress-api/pkg/store/invoicenumbercaching"
+	"github.com/dwarvesf/fortress-api/pkg/store/journalentry"
+	"github.com/dwarvesf/fortress-api/pkg/store/knowledgedocument"
+	"github.com/dwarvesf/fortress-api/pkg/store/ledgeraccount"
+	"github.com/dwarvesf/fortress-api/pkg/store/memoingestion"
 	"github.com/dwarvesf/fortress-api/pkg/store/memolog"
+	"github.com/dwarvesf/fortress-api/pkg/store/officecheckin"
+	"github.com/dwarvesf/fortress-api/pkg/store/officelocation"
 	"github.com/dwarvesf/fortress-api/pkg/store/onleaverequest"
 	"github.com/dwarvesf/fortress-api/pkg/store/operationalservice"
+	"github.com/dwarvesf/fortress-api/pkg/store/operationalserviceseat"
 	"github.com/dwarvesf/fortress-api/pkg/store/organization"
 	"github.com/dwarvesf/fortress-api/pkg/store/payroll"
 	"github.com/dwarvesf/fortress-api/pkg/store/permission"
 	"github.com/dwarvesf/fortress-api/pkg/store/physicalcheckin"
 	"github.com/dwarvesf/fortress-api/pkg/store/position"
+	"github.com/dwarvesf/fortress-api/pkg/store/profitability"
 	"github.com/dwarvesf/fortress-api/pkg/store/project"
+	"github.com/dwarvesf/fortress-api/pkg/store/projectbudget"
+	"github.com/dwarvesf/fortress-api/pkg/store/projectbudgetmilestone"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectcommissionconfig"
 	"github.com/dwarvesf/fortress-api/pkg/store/projecthead"
 	"github.com/dwarvesf/fortress-api/pkg/store/projectmember"
@@ -74,12 +109,22 @@ import (
 	"github.com/dwarvesf/fortress-api/pkg/store/projectstack"
 	"github.com/dwarvesf/fortress-api/pkg/store/question"
 	"github.com/dwarvesf/fortress-api/pkg/store/recruitment"
+	"github.com/dwarvesf/fortress-api/pkg/store/recruitmentstage"
+	"github.com/dwarvesf/fortress-api/pkg/store/referral"
+	"github.com/dwarvesf/fortress-api/pkg/store/referralbonus"
+	"github.com/dwarvesf/fortress-api/pkg/store/referralmilestone"
+	"github.com/dwarvesf/fortress-api/pkg/store/reviewcycle"
+	"github.com/dwarvesf/fortress-api/pkg/store/reviewpacket"
 	"github.com/dwarvesf/fortress-api/pkg/store/role"
 	"github.com/dwarvesf/fortress-api/pkg/store/salaryadvance"
 	"github.com/dwarvesf/fortress-api/pkg/store/schedule"
 	"github.com/dwarvesf/fortress-api/pkg/store/seniority"
 	"github.com/dwarvesf/fortress-api/pkg/store/socialaccount"
 	"github.com/dwarvesf/fortress-api/pkg/store/stack"
+	"github.com/dwarvesf/fortress-api/pkg/store/staffing"
+	"github.com/dwarvesf/fortress-api/pkg/store/surveythemesummary"
+	"github.com/dwarvesf/fortress-api/pkg/store/timesheet"
+	"github.com/dwarvesf/fortress-api/pkg/store/timesheetentry"
 	"github.com/dwarvesf/fortress-api/pkg/store/valuation"
 	"github.com/dwarvesf/fortress-api/pkg/store/workunit"
 	"github.com/dwarvesf/fortress-api/pkg/store/workunitmember"
@@ -94,6 +139,9 @@ type Store struct {
 	AgentWorkflow           agentworkflow.IStore
 	APIKey                  apikey.IStore
 	APIKeyRole              apikeyrole.IStore
+	Asset                   asset.IStore
+	AssetAssignment         assetassignment.IStore
+	AssetDepreciation       assetdepreciation.IStore
 	Audit                   audit.IStore
 	AuditActionItem         auditactionitem.IStore
 	AuditCycle              auditcycle.IStore
@@ -102,21 +150,35 @@ type Store struct {
 	SalaryAdvance           salaryadvance.IStore
 	Bank                    bank.IStore
 	BankAccount             bankaccount.IStore
+	BankStatement           bankstatement.IStore
+	BankTransaction         banktransaction.IStore
 	BaseSalary              basesalary.IStore
 	Bonus                   employeebonus.IStore
 	BraineryLog             brainerylog.IStore
 	CachedPayroll           cachedpayroll.IStore
+	CandidateInterview      candidateinterview.IStore
+	CandidateTransition     candidatetransition.IStore
+	Capacity                capacity.IStore
+	CashFlow                cashflow.IStore
+	CashFlowForecast        cashflowforecast.IStore
 	Chapter                 chapter.IStore
 	Client                  client.IStore
 	ClientContact           clientcontact.IStore
+	ClientPortal            clientportal.IStore
 	CompanyInfo             companyinfo.IStore
 	Content                 content.IStore
+	Contract                contract.IStore
+	ContractAttachment      contractattachment.IStore
+	ContractRate            contractrate.IStore
 	ConversionRate          conversionrate.IStore
 	Country                 country.IStore
 	Currency                currency.IStore
 	Config                  config.IStore
 	Dashboard               dashboard.IStore
+	DeliveryLeaderBoard     deliveryleaderboard.IStore
 	DeliveryMetric          deliverymetric.IStore
+	DeliveryMetricImport    deliverymetricimport.IStore
+	DeliveryMetricMapping   deliverymetricmapping.IStore
 	DiscordAccount          discordaccount.IStore
 	DiscordEvent            discordevent.IStore
 	DiscordLogTemplate      discordtemplate.IStore
@@ -131,24 +193,42 @@ type Store struct {
 	EmployeePosition        employeeposition.IStore
 	EmployeeRole            employeerole.IStore
 	EmployeeStack           employeestack.IStore
+	EngagementActivity      engagementactivity.IStore
+	EngagementChannelStat   engagementchannelstat.IStore
+	EngagementMemberStat    engagementmemberstat.IStore
 	EventSpeaker            eventspeaker.IStore
 	EngagementsRollup       engagementsrollup.IStore
+	EngagementWeeklyStat    engagementweeklystat.IStore
 	Expense                 expense.IStore
 	FeedbackEvent           feedbackevent.IStore
+	FxRate                  fxrate.IStore
 	IcyDistribution         icydistribution.IStore
+	IcyRewardPayout         icyrewardpayout.IStore
+	IcyRewardRule           icyrewardrule.IStore
 	IcyTransaction          icytransaction.IStore
+	IcyTreasurySnapshot     icytreasurysnapshot.IStore
 	InboundFundTransaction  inboundfundtransaction.IStore
 	Invoice                 invoice.IStore
 	InvoiceNumberCaching    invoicenumbercaching.IStore
+	JournalEntry            journalentry.IStore
+	KnowledgeDocument       knowledgedocument.IStore
+	LedgerAccount           ledgeraccount.IStore
+	MemoIngestion           memoingestion.IStore
 	MemoLog                 memolog.IStore
 	MonthlyDeliveryMetric   deliverymetricmonthly.IStore
+	OfficeCheckin           officecheckin.IStore
+	OfficeLocation          officelocation.IStore
 	OnLeaveRequest          onleaverequest.IStore
 	OperationalService      operationalservice.IStore
+	OperationalServiceSeat  operationalserviceseat.IStore
 	Organization            organization.IStore
 	Payroll                 payroll.IStore
 	Permission              permission.IStore
 	Position                position.IStore
+	Profitability           profitability.IStore
 	Project                 project.IStore
+	ProjectBudget           projectbudget.IStore
+	ProjectBudgetMilestone  projectbudgetmilestone.IStore
 	ProjectCommissionConfig projectcommissionconfig.IStore
 	ProjectHead             projecthead.IStore
 	ProjectMember           projectmember.IStore
@@ -160,11 +240,21 @@ type Store struct {
 	PhysicalCheckin         physicalcheckin.IStore
 	Question                question.IStore
 	Recruitment             recruitment.IStore
+	RecruitmentStage        recruitmentstage.IStore
+	Referral                referral.IStore
+	ReferralBonus           referralbonus.IStore
+	ReferralMilestone       referralmilestone.IStore
+	ReviewCycle             reviewcycle.IStore
+	ReviewPacket            reviewpacket.IStore
 	Role                    role.IStore
 	Schedule                schedule.IStore
 	Seniority               seniority.IStore
 	SocialAccount           socialaccount.IStore
 	Stack                   stack.IStore
+	Staffing                staffing.IStore
+	SurveyThemeSummary      surveythemesummary.IStore
+	Timesheet               timesheet.IStore
+	TimesheetEntry          timesheetentry.IStore
 	Valuation               valuation.IStore
 	WeeklyDeliveryMetric    deliverymetricweekly.IStore
 	WorkUnit                workunit.IStore
@@ -181,6 +271,9 @@ func New() *Store {
 		AgentWorkflow:           agentworkflow.New(),
 		APIKey:                  apikey.New(),
 		APIKeyRole:              apikeyrole.New(),
+		Asset:                   asset.New(),
+		AssetAssignment:         assetassignment.New(),
+		AssetDepreciation:       assetdepreciation.New(),
 		Audit:                   audit.New(),
 		AuditActionItem:         auditactionitem.New(),
 		AuditCycle:              auditcycle.New(),
@@ -189,21 +282,35 @@ func New() *Store {
 		SalaryAdvance:           salaryadvance.New(),
 		Bank:                    bank.New(),
 		BankAccount:             bankaccount.New(),
+		BankStatement:           bankstatement.New(),
+		BankTransaction:         banktransaction.New(),
 		BaseSalary:              basesalary.New(),
 		Bonus:                   employeebonus.New(),
 		BraineryLog:             brainerylog.New(),
 		CachedPayroll:           cachedpayroll.New(),
+		CandidateInterview:      candidateinterview.New(),
+		CandidateTransition:     candidatetransition.New(),
+		Capacity:                capacity.New(),
+		CashFlow:                cashflow.New(),
+		CashFlowForecast:        cashflowforecast.New(),
 		Chapter:                 chapter.New(),
 		Client:                  client.New(),
 		ClientContact:           clientcontact.New(),
+		ClientPortal:            clientportal.New(),
 		CompanyInfo:             companyinfo.New(),
 		Content:                 content.New(),
+		Contract:                contract.New(),
+		ContractAttachment:      contractattachment.New(),
+		ContractRate:            contractrate.New(),
 		ConversionRate:          conversionrate.New(),
 		Country:                 country.New(),
 		Currency:                currency.New(),
 		Config:                  config.New(),
 		Dashboard:               dashboard.New(),
+		DeliveryLeaderBoard:     deliveryleaderboard.New(),
 		DeliveryMetric:          deliverymetric.New(),
+		DeliveryMetricImport:    deliverymetricimport.New(),
+		DeliveryMetricMapping:   deliverymetricmapping.New(),
 		DiscordAccount:          discordaccount.New(),
 		DiscordEvent:            discordevent.New(),
 		DiscordLogTemplate:      discordtemplate.New(),
@@ -218,24 +325,42 @@ func New() *Store {
 		EmployeePosition:        employeeposition.New(),
 		EmployeeRole:            employeerole.New(),
 		EmployeeStack:           employeestack.New(),
+		EngagementActivity:      engagementactivity.New(),
+		EngagementChannelStat:   engagementchannelstat.New(),
+		EngagementMemberStat:    engagementmemberstat.New(),
 		EngagementsRollup:       engagementsrollup.New(),
+		EngagementWeeklyStat:    engagementweeklystat.New(),
 		EventSpeaker:            eventspeaker.New(),
 		Expense:                 expense.New(),
 		FeedbackEvent:           feedbackevent.New(),
+		FxRate:                  fxrate.New(),
 		IcyDistribution:         icydistribution.New(),
+		IcyRewardPayout:         icyrewardpayout.New(),
+		IcyRewardRule:           icyrewardrule.New(),
 		IcyTransaction:          icytransaction.New(),
+		IcyTreasurySnapshot:     icytreasurysnapshot.New(),
 		InboundFundTransaction:  inboundfundtransaction.New(),
 		Invoice:                 invoice.New(),
 		InvoiceNumberCaching:    invoicenumbercaching.New(),
+		JournalEntry:            journalentry.New(),
+		KnowledgeDocument:       knowledgedocument.New(),
+		LedgerAccount:           ledgeraccount.New(),
+		MemoIngestion:           memoingestion.New(),
 		MemoLog:                 memolog.New(),
 		MonthlyDeliveryMetric:   deliverymetricmonthly.New(),
+		OfficeCheckin:           officecheckin.New(),
+		OfficeLocation:          officelocation.New(),
 		OnLeaveRequest:          onleaverequest.New(),
 		OperationalService:      operationalservice.New(),
+		OperationalServiceSeat:  operationalserviceseat.New(),
 		Organization:            organization.New(),
 		Payroll:                 payroll.New(),
 		Permission:              permission.New(),
 		Position:                position.New(),
+		Profitability:           profitability.New(),
 		Project:                 project.New(),
+		ProjectBudget:           projectbudget.New(),
+		ProjectBudgetMilestone:  projectbudgetmilestone.New(),
 		ProjectCommissionConfig: projectcommissionconfig.New(),
 		ProjectHead:             projecthead.New(),
 		ProjectMember:           projectmember.New(),
@@ -247,11 +372,21 @@ func New() *Store {
 		PhysicalCheckin:         physicalcheckin.New(),
 		Question:                question.New(),
 		Recruitment:             recruitment.New(),
+		RecruitmentStage:        recruitmentstage.New(),
+		Referral:                referral.New(),
+		ReferralBonus:           referralbonus.New(),
+		ReferralMilestone:       referralmilestone.New(),
+		ReviewCycle:             reviewcycle.New(),
+		ReviewPacket:            reviewpacket.New(),
 		Role:                    role.New(),
 		Schedule:                schedule.New(),
 		Seniority:               seniority.New(),
 		SocialAccount:           socialaccount.New(),
 		Stack:                   stack.New(),
+		Staffing:                staffing.New(),
+		SurveyThemeSummary:      surveythemesummary.New(),
+		Timesheet:               timesheet.New(),
+		TimesheetEntry:          timesheetentry.New(),
 		Valuation:               valuation.New(),
 		WeeklyDeliveryMetric:    deliverymetricweekly.New(),
 		WorkUnit:                workunit.New(),
@@ -0,0 +1,14 @@
+package surveythemesummary
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	ListByEventID(db *gorm.DB, eventID string) ([]*model.SurveyThemeSummary, error)
+	OneByEventAndQuestion(db *gorm.DB, eventID string, questionID string) (*model.SurveyThemeSummary, error)
+	Create(db *gorm.DB, summary *model.SurveyThemeSummary) (*model.SurveyThemeSummary, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, summary model.SurveyThemeSummary, updatedFields ...string) (*model.SurveyThemeSummary, error)
+}
@@ -0,0 +1,33 @@
+package surveythemesummary
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+// ListByEventID get the theme summaries of a survey
+func (s *store) ListByEventID(db *gorm.DB, eventID string) ([]*model.SurveyThemeSummary, error) {
+	var summaries []*model.SurveyThemeSummary
+	return summaries, db.Where("event_id = ?", eventID).Order("created_at").Find(&summaries).Error
+}
+
+func (s *store) OneByEventAndQuestion(db *gorm.DB, eventID string, questionID string) (*model.SurveyThemeSummary, error) {
+	var summary model.SurveyThemeSummary
+	return &summary, db.Where("event_id = ? AND question_id = ?", eventID, questionID).First(&summary).Error
+}
+
+func (s *store) Create(db *gorm.DB, summary *model.SurveyThemeSummary) (*model.SurveyThemeSummary, error) {
+	return summary, db.Create(summary).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, summary model.SurveyThemeSummary, updatedFields ...string) (*model.SurveyThemeSummary, error) {
+	rs := model.SurveyThemeSummary{}
+	return &rs, db.Model(&rs).Where("id = ?", id).Select(updatedFields).Updates(summary).First(&rs).Error
+}
@@ -0,0 +1,30 @@
+package timesheet
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, timesheet *model.Timesheet) (*model.Timesheet, error)
+	UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Timesheet, updatedFields ...string) (*model.Timesheet, error)
+	One(db *gorm.DB, id string) (*model.Timesheet, error)
+	OneByWeek(db *gorm.DB, employeeID, projectID string, weekStart time.Time) (*model.Timesheet, error)
+	All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Timesheet, int64, error)
+	GetBillableHours(db *gorm.DB, projectID string, from, to time.Time) ([]model.TimesheetBillableHours, error)
+	LockByInvoiceID(db *gorm.DB, invoiceID string, lockedAt time.Time) (int64, error)
+}
+
+// Query present timesheet query from user. ViewerID limits the timesheets to the ones of the
+// viewer and of the projects they lead.
+type Query struct {
+	EmployeeID string
+	ProjectID  string
+	Status     model.TimesheetStatus
+	From       *time.Time
+	To         *time.Time
+	ViewerID   string
+}
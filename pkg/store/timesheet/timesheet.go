@@ -0,0 +1,127 @@
+package timesheet
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, timesheet *model.Timesheet) (*model.Timesheet, error) {
+	return timesheet, db.Create(timesheet).Error
+}
+
+func (s *store) UpdateSelectedFieldsByID(db *gorm.DB, id string, updateModel model.Timesheet, updatedFields ...string) (*model.Timesheet, error) {
+	timesheet := model.Timesheet{}
+	return &timesheet, db.Model(&timesheet).Where("id = ?", id).Select(updatedFields).Updates(updateModel).Error
+}
+
+// One get a timesheet with its entries by day
+func (s *store) One(db *gorm.DB, id string) (*model.Timesheet, error) {
+	var timesheet model.Timesheet
+	return &timesheet, db.Where("id = ?", id).
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Reviewer", "deleted_at IS NULL").
+		Preload("Entries", func(db *gorm.DB) *gorm.DB {
+			return db.Order("timesheet_entries.date, timesheet_entries.created_at")
+		}).
+		Preload("Entries.WorkUnit", "deleted_at IS NULL").
+		First(&timesheet).Error
+}
+
+func (s *store) OneByWeek(db *gorm.DB, employeeID, projectID string, weekStart time.Time) (*model.Timesheet, error) {
+	var timesheet model.Timesheet
+	return &timesheet, db.
+		Where("employee_id = ? AND project_id = ? AND week_start = ?", employeeID, projectID, weekStart).
+		First(&timesheet).Error
+}
+
+func (s *store) All(db *gorm.DB, query Query, pagination model.Pagination) ([]*model.Timesheet, int64, error) {
+	var (
+		total      int64
+		timesheets []*model.Timesheet
+	)
+
+	db = db.Model(&model.Timesheet{})
+	if query.EmployeeID != "" {
+		db = db.Where("employee_id = ?", query.EmployeeID)
+	}
+	if query.ProjectID != "" {
+		db = db.Where("project_id = ?", query.ProjectID)
+	}
+	if query.Status != "" {
+		db = db.Where("status = ?", query.Status)
+	}
+	if query.From != nil {
+		db = db.Where("week_start >= ?", *query.From)
+	}
+	if query.To != nil {
+		db = db.Where("week_start <= ?", *query.To)
+	}
+	if query.ViewerID != "" {
+		db = db.Where(`employee_id = ? OR project_id IN (
+			SELECT project_id FROM project_heads
+			WHERE deleted_at IS NULL AND employee_id = ? AND position IN ? AND (end_date IS NULL OR end_date > now())
+		)`, query.ViewerID, query.ViewerID, model.TimesheetApproverPositions)
+	}
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pagination.ToLimitOffset()
+	return timesheets, total, db.
+		Preload("Employee", "deleted_at IS NULL").
+		Preload("Project", "deleted_at IS NULL").
+		Preload("Entries").
+		Order("week_start DESC, created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&timesheets).Error
+}
+
+// GetBillableHours sums the approved hours of each member in [from, to) not invoiced yet, at the
+// rate of their latest membership started by then. A week over two months is locked by the
+// invoice of the first one, its hours of the second one are still to bill.
+func (s *store) GetBillableHours(db *gorm.DB, projectID string, from, to time.Time) ([]model.TimesheetBillableHours, error) {
+	var res []model.TimesheetBillableHours
+	return res, db.Raw(`
+		SELECT t.employee_id, employees.full_name, pm.id AS project_member_id, COALESCE(pm.rate, 0) AS rate,
+			SUM(te.hours) AS hours
+		FROM timesheet_entries te
+			JOIN timesheets t ON t.id = te.timesheet_id AND t.deleted_at IS NULL
+			JOIN employees ON employees.id = t.employee_id
+			LEFT JOIN LATERAL (
+				SELECT project_members.id, project_members.rate
+				FROM project_members
+				WHERE project_members.deleted_at IS NULL
+					AND project_members.project_id = t.project_id
+					AND project_members.employee_id = t.employee_id
+					AND (project_members.start_date IS NULL OR project_members.start_date < ?)
+				ORDER BY project_members.start_date DESC NULLS LAST
+				LIMIT 1
+			) pm ON TRUE
+		WHERE te.deleted_at IS NULL
+			AND te.invoice_id IS NULL
+			AND t.project_id = ?
+			AND t.status IN ?
+			AND te.date >= ? AND te.date < ?
+		GROUP BY t.employee_id, employees.full_name, pm.id, pm.rate`,
+		to, projectID, model.TimesheetBillableStatuses, from, to).
+		Scan(&res).Error
+}
+
+// LockByInvoiceID locks the timesheets with hours billed on the invoice
+func (s *store) LockByInvoiceID(db *gorm.DB, invoiceID string, lockedAt time.Time) (int64, error) {
+	result := db.Model(&model.Timesheet{}).
+		Where("id IN (SELECT timesheet_id FROM timesheet_entries WHERE deleted_at IS NULL AND invoice_id = ?)", invoiceID).
+		Updates(map[string]interface{}{"status": model.TimesheetStatusLocked, "locked_at": lockedAt})
+	return result.RowsAffected, result.Error
+}
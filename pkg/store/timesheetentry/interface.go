@@ -0,0 +1,15 @@
+package timesheetentry
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IStore interface {
+	Create(db *gorm.DB, entries ...*model.TimesheetEntry) error
+	DeleteByTimesheetID(db *gorm.DB, timesheetID string) error
+	SetInvoiceID(db *gorm.DB, projectID string, from, to time.Time, invoiceID string) (int64, error)
+}
@@ -0,0 +1,37 @@
+package timesheetentry
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type store struct{}
+
+func New() IStore {
+	return &store{}
+}
+
+func (s *store) Create(db *gorm.DB, entries ...*model.TimesheetEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return db.Create(entries).Error
+}
+
+func (s *store) DeleteByTimesheetID(db *gorm.DB, timesheetID string) error {
+	return db.Where("timesheet_id = ?", timesheetID).Delete(&model.TimesheetEntry{}).Error
+}
+
+// SetInvoiceID bills the approved entries of the project in [from, to) not invoiced yet on the
+// invoice
+func (s *store) SetInvoiceID(db *gorm.DB, projectID string, from, to time.Time, invoiceID string) (int64, error) {
+	result := db.Model(&model.TimesheetEntry{}).
+		Where("invoice_id IS NULL AND date >= ? AND date < ?", from, to).
+		Where("timesheet_id IN (SELECT id FROM timesheets WHERE deleted_at IS NULL AND project_id = ? AND status IN ?)",
+			projectID, model.TimesheetBillableStatuses).
+		Update("invoice_id", invoiceID)
+	return result.RowsAffected, result.Error
+}
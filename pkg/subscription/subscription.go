@@ -0,0 +1,122 @@
+// Package subscription works out the billing of the operational services: when they renew,
+// when they are charged, and how many of their seats are actually needed
+package subscription
+
+import "time"
+
+// NextRenewal rolls a renewal date forward by billing cycles until it is not before today
+func NextRenewal(renewal time.Time, cycleMonths int, today time.Time) time.Time {
+	if cycleMonths <= 0 {
+		cycleMonths = 1
+	}
+
+	today = truncateDay(today)
+	next := truncateDay(renewal)
+	for i := 1; next.Before(today); i++ {
+		next = addMonths(truncateDay(renewal), i*cycleMonths)
+	}
+	return next
+}
+
+// ReminderDue tells whether the owner of a service should be reminded of its renewal today:
+// the renewal is at most days away and it has not been reminded of yet
+func ReminderDue(renewal time.Time, days int, reminded *time.Time, today time.Time) bool {
+	renewal = truncateDay(renewal)
+	today = truncateDay(today)
+	if today.After(renewal) || today.Before(renewal.AddDate(0, 0, -days)) {
+		return false
+	}
+	return reminded == nil || !truncateDay(*reminded).Equal(renewal)
+}
+
+// ChargedIn tells whether a service billed every cycleMonths on the month of anchor is charged
+// in a month, anchor being any of its billing dates, past or future
+func ChargedIn(anchor time.Time, cycleMonths int, year int, month time.Month) bool {
+	if cycleMonths <= 0 {
+		cycleMonths = 1
+	}
+
+	diff := (year-anchor.Year())*12 + int(month) - int(anchor.Month())
+	return (diff%cycleMonths+cycleMonths)%cycleMonths == 0
+}
+
+// MonthlyCost spreads the amount of a bill over the months it covers
+func MonthlyCost(amount float64, cycleMonths int) float64 {
+	if cycleMonths <= 0 {
+		return amount
+	}
+	return amount / float64(cycleMonths)
+}
+
+// Holder is an employee holding a seat of a service
+type Holder struct {
+	EmployeeID string
+	Active     bool // false once the employee has left
+}
+
+// Seats is what the seats of a service are reconciled against. When no seat is tracked per
+// employee the service is taken as used by the whole headcount.
+type Seats struct {
+	Purchased int
+	Holders   []Holder
+	Headcount int
+}
+
+// Reconciliation compares the seats paid for with the seats needed
+type Reconciliation struct {
+	Purchased     int
+	Needed        int
+	Tracked       bool // the needed seats come from the holders rather than the headcount
+	HeldByLeavers []string
+	Excess        int
+	Shortage      int
+}
+
+// Reconcile compares the seats paid for with the active holders, or the headcount when no
+// holder is tracked. The seats still held by employees who left are counted as excess. A
+// service without seats is billed flat and has nothing to reconcile.
+func Reconcile(s Seats) Reconciliation {
+	rs := Reconciliation{
+		Purchased:     s.Purchased,
+		Needed:        s.Headcount,
+		Tracked:       len(s.Holders) > 0,
+		HeldByLeavers: []string{},
+	}
+
+	if rs.Tracked {
+		rs.Needed = 0
+		for _, h := range s.Holders {
+			if !h.Active {
+				rs.HeldByLeavers = append(rs.HeldByLeavers, h.EmployeeID)
+				continue
+			}
+			rs.Needed++
+		}
+	}
+
+	if s.Purchased <= 0 {
+		return rs
+	}
+	if s.Purchased > rs.Needed {
+		rs.Excess = s.Purchased - rs.Needed
+	} else {
+		rs.Shortage = rs.Needed - s.Purchased
+	}
+	return rs
+}
+
+// addMonths adds months to a date, keeping it on the last day of the month when the day
+// does not exist in the target month
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, t.Location())
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,160 @@
+package subscription
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNextRenewal(t *testing.T) {
+	tests := []struct {
+		name    string
+		renewal time.Time
+		cycle   int
+		today   time.Time
+		want    time.Time
+	}{
+		{
+			name:    "renewal still ahead",
+			renewal: date(2026, 11, 5),
+			cycle:   1,
+			today:   date(2026, 10, 19),
+			want:    date(2026, 11, 5),
+		},
+		{
+			name:    "renewal today",
+			renewal: date(2026, 10, 19),
+			cycle:   1,
+			today:   date(2026, 10, 19),
+			want:    date(2026, 10, 19),
+		},
+		{
+			name:    "monthly renewal passed",
+			renewal: date(2026, 8, 10),
+			cycle:   1,
+			today:   date(2026, 10, 19),
+			want:    date(2026, 11, 10),
+		},
+		{
+			name:    "yearly renewal passed",
+			renewal: date(2025, 3, 1),
+			cycle:   12,
+			today:   date(2026, 10, 19),
+			want:    date(2027, 3, 1),
+		},
+		{
+			name:    "end of month is kept",
+			renewal: date(2026, 1, 31),
+			cycle:   1,
+			today:   date(2026, 2, 15),
+			want:    date(2026, 2, 28),
+		},
+		{
+			name:    "end of month does not drift",
+			renewal: date(2026, 1, 31),
+			cycle:   1,
+			today:   date(2026, 3, 1),
+			want:    date(2026, 3, 31),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, NextRenewal(tt.renewal, tt.cycle, tt.today))
+		})
+	}
+}
+
+func TestReminderDue(t *testing.T) {
+	renewal := date(2026, 10, 25)
+	reminded := date(2026, 10, 25)
+	previous := date(2026, 9, 25)
+
+	tests := []struct {
+		name     string
+		reminded *time.Time
+		today    time.Time
+		want     bool
+	}{
+		{name: "too early", today: date(2026, 10, 17), want: false},
+		{name: "first day of the window", today: date(2026, 10, 18), want: true},
+		{name: "renewal day", today: date(2026, 10, 25), want: true},
+		{name: "renewal passed", today: date(2026, 10, 26), want: false},
+		{name: "already reminded", reminded: &reminded, today: date(2026, 10, 20), want: false},
+		{name: "reminded of the previous renewal", reminded: &previous, today: date(2026, 10, 20), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ReminderDue(renewal, 7, tt.reminded, tt.today))
+		})
+	}
+}
+
+func TestChargedIn(t *testing.T) {
+	anchor := date(2026, 2, 14)
+
+	require.True(t, ChargedIn(anchor, 1, 2026, time.January))
+	require.True(t, ChargedIn(anchor, 1, 2026, time.October))
+	require.True(t, ChargedIn(anchor, 3, 2026, time.May))
+	require.False(t, ChargedIn(anchor, 3, 2026, time.June))
+	require.True(t, ChargedIn(anchor, 3, 2025, time.November))
+	require.True(t, ChargedIn(anchor, 12, 2027, time.February))
+	require.True(t, ChargedIn(anchor, 12, 2025, time.February))
+	require.False(t, ChargedIn(anchor, 12, 2026, time.December))
+}
+
+func TestMonthlyCost(t *testing.T) {
+	require.Equal(t, 100.0, MonthlyCost(100, 1))
+	require.Equal(t, 100.0, MonthlyCost(1200, 12))
+	require.Equal(t, 50.0, MonthlyCost(150, 3))
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name  string
+		seats Seats
+		want  Reconciliation
+	}{
+		{
+			name:  "untracked seats against the headcount",
+			seats: Seats{Purchased: 50, Headcount: 42},
+			want:  Reconciliation{Purchased: 50, Needed: 42, HeldByLeavers: []string{}, Excess: 8},
+		},
+		{
+			name:  "untracked seats short of the headcount",
+			seats: Seats{Purchased: 40, Headcount: 42},
+			want:  Reconciliation{Purchased: 40, Needed: 42, HeldByLeavers: []string{}, Shortage: 2},
+		},
+		{
+			name: "seats held by leavers",
+			seats: Seats{
+				Purchased: 4,
+				Holders: []Holder{
+					{EmployeeID: "a", Active: true},
+					{EmployeeID: "b", Active: false},
+					{EmployeeID: "c", Active: true},
+					{EmployeeID: "d", Active: false},
+				},
+				Headcount: 42,
+			},
+			want: Reconciliation{Purchased: 4, Needed: 2, Tracked: true, HeldByLeavers: []string{"b", "d"}, Excess: 2},
+		},
+		{
+			name:  "flat billed service",
+			seats: Seats{Purchased: 0, Headcount: 42},
+			want:  Reconciliation{Purchased: 0, Needed: 42, HeldByLeavers: []string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Reconcile(tt.seats))
+		})
+	}
+}
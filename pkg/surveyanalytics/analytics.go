@@ -0,0 +1,295 @@
+// Package surveyanalytics aggregates the answers of the surveys without exposing a respondent: a
+// group answered by fewer respondents than the minimum size is suppressed.
+package surveyanalytics
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultMinGroupSize is the number of respondents from which a group is shown
+const DefaultMinGroupSize = 5
+
+// Answer is an answer to a question, Group is the team of the respondent when comparing teams
+type Answer struct {
+	EventID      string
+	EventTitle   string
+	EventDate    time.Time
+	QuestionID   string
+	Content      string
+	Order        int64
+	RespondentID string
+	Group        string
+	Value        string
+}
+
+// Distribution is how a question was answered
+type Distribution struct {
+	QuestionID  string
+	Content     string
+	Order       int64
+	Respondents int
+	Answers     int
+	// Counts is the number of answers per value, 1 strongly disagree to 5 strongly agree
+	Counts     map[string]int
+	Average    decimal.Decimal
+	Suppressed bool
+}
+
+// Point is the average of a question in a survey cycle
+type Point struct {
+	EventID     string
+	EventTitle  string
+	EventDate   time.Time
+	Respondents int
+	Average     decimal.Decimal
+	Suppressed  bool
+}
+
+// Trend is a question across the survey cycles, oldest first
+type Trend struct {
+	QuestionID string
+	Content    string
+	Points     []Point
+}
+
+// GroupScore is the average of a question in a team
+type GroupScore struct {
+	Group       string
+	Respondents int
+	Average     decimal.Decimal
+	Suppressed  bool
+}
+
+// Comparison is a question compared between the teams, best first
+type Comparison struct {
+	QuestionID string
+	Content    string
+	Groups     []GroupScore
+}
+
+// stats accumulates the answers of a question in a cycle or a group
+type stats struct {
+	respondents map[string]bool
+	counts      map[string]int
+	sum         decimal.Decimal
+	answers     int
+}
+
+func newStats() *stats {
+	return &stats{respondents: map[string]bool{}, counts: map[string]int{}}
+}
+
+func (s *stats) add(a Answer, value decimal.Decimal) {
+	s.respondents[a.RespondentID] = true
+	s.counts[a.Value]++
+	s.sum = s.sum.Add(value)
+	s.answers++
+}
+
+func (s *stats) average() decimal.Decimal {
+	if s.answers == 0 {
+		return decimal.Zero
+	}
+	return s.sum.Div(decimal.NewFromInt(int64(s.answers))).Round(2)
+}
+
+// question is the answers of a question grouped by a key, in the order the keys came
+type question struct {
+	id      string
+	content string
+	order   int64
+	keys    []string
+	stats   map[string]*stats
+}
+
+// group groups the scored answers by question then by key, the answers which are not a score
+// (free text, empty) are left out
+func group(answers []Answer, key func(Answer) string) []*question {
+	var questions []*question
+	byID := map[string]*question{}
+	for _, a := range answers {
+		value, ok := score(a.Value)
+		if !ok {
+			continue
+		}
+
+		q := byID[a.QuestionID]
+		if q == nil {
+			q = &question{id: a.QuestionID, content: a.Content, order: a.Order, stats: map[string]*stats{}}
+			byID[a.QuestionID] = q
+			questions = append(questions, q)
+		}
+
+		k := key(a)
+		s := q.stats[k]
+		if s == nil {
+			s = newStats()
+			q.stats[k] = s
+			q.keys = append(q.keys, k)
+		}
+		s.add(a, value)
+	}
+
+	sort.SliceStable(questions, func(i, j int) bool {
+		return questions[i].order < questions[j].order
+	})
+	return questions
+}
+
+// score parses a likert-scale answer
+func score(value string) (decimal.Decimal, bool) {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 1 || v > 5 {
+		return decimal.Zero, false
+	}
+	return decimal.NewFromInt(int64(v)), true
+}
+
+// Distributions counts the answers of every likert-scale question
+func Distributions(answers []Answer, minGroupSize int) []Distribution {
+	questions := group(answers, func(Answer) string { return "" })
+
+	rs := make([]Distribution, 0, len(questions))
+	for _, q := range questions {
+		s := q.stats[""]
+		d := Distribution{
+			QuestionID: q.id,
+			Content:    q.content,
+			Order:      q.order,
+		}
+		if len(s.respondents) < minGroupSize {
+			d.Suppressed = true
+		} else {
+			d.Respondents = len(s.respondents)
+			d.Answers = s.answers
+			d.Counts = s.counts
+			d.Average = s.average()
+		}
+		rs = append(rs, d)
+	}
+	return rs
+}
+
+// Trends follows the average of every likert-scale question over the survey cycles
+func Trends(answers []Answer, minGroupSize int) []Trend {
+	cycles := map[string]Answer{}
+	for _, a := range answers {
+		cycles[a.EventID] = a
+	}
+	questions := group(answers, func(a Answer) string { return a.EventID })
+
+	rs := make([]Trend, 0, len(questions))
+	for _, q := range questions {
+		t := Trend{QuestionID: q.id, Content: q.content}
+		for _, eventID := range q.keys {
+			s := q.stats[eventID]
+			p := Point{
+				EventID:    eventID,
+				EventTitle: cycles[eventID].EventTitle,
+				EventDate:  cycles[eventID].EventDate,
+			}
+			if len(s.respondents) < minGroupSize {
+				p.Suppressed = true
+			} else {
+				p.Respondents = len(s.respondents)
+				p.Average = s.average()
+			}
+			t.Points = append(t.Points, p)
+		}
+
+		sort.SliceStable(t.Points, func(i, j int) bool {
+			return t.Points[i].EventDate.Before(t.Points[j].EventDate)
+		})
+		rs = append(rs, t)
+	}
+	return rs
+}
+
+// Compare ranks the teams on every likert-scale question. When a single team is suppressed, the
+// smallest team shown is suppressed too, so the hidden team can't be derived from the others and
+// the overall distribution.
+func Compare(answers []Answer, minGroupSize int) []Comparison {
+	questions := group(answers, func(a Answer) string { return a.Group })
+
+	rs := make([]Comparison, 0, len(questions))
+	for _, q := range questions {
+		c := Comparison{QuestionID: q.id, Content: q.content}
+
+		suppressed := 0
+		for _, name := range q.keys {
+			s := q.stats[name]
+			g := GroupScore{
+				Group:       name,
+				Respondents: len(s.respondents),
+				Average:     s.average(),
+			}
+			if g.Respondents < minGroupSize {
+				g.Suppressed = true
+				suppressed++
+			}
+			c.Groups = append(c.Groups, g)
+		}
+
+		if suppressed == 1 {
+			smallest := -1
+			for i, g := range c.Groups {
+				if g.Suppressed {
+					continue
+				}
+				if smallest < 0 || g.Respondents < c.Groups[smallest].Respondents {
+					smallest = i
+				}
+			}
+			if smallest >= 0 {
+				c.Groups[smallest].Suppressed = true
+			}
+		}
+
+		for i := range c.Groups {
+			if c.Groups[i].Suppressed {
+				c.Groups[i].Respondents = 0
+				c.Groups[i].Average = decimal.Zero
+			}
+		}
+
+		sort.SliceStable(c.Groups, func(i, j int) bool {
+			a, b := c.Groups[i], c.Groups[j]
+			if a.Suppressed != b.Suppressed {
+				return !a.Suppressed
+			}
+			if !a.Average.Equal(b.Average) {
+				return a.Average.GreaterThan(b.Average)
+			}
+			return a.Group < b.Group
+		})
+		rs = append(rs, c)
+	}
+	return rs
+}
+
+// Texts returns the free-text answers of a question when enough respondents wrote one, sorted so
+// their order tells nothing about who wrote them
+func Texts(answers []Answer, questionID string, minGroupSize int) ([]string, bool) {
+	respondents := map[string]bool{}
+	var texts []string
+	for _, a := range answers {
+		if a.QuestionID != questionID || a.Value == "" {
+			continue
+		}
+		if _, ok := score(a.Value); ok {
+			continue
+		}
+		respondents[a.RespondentID] = true
+		texts = append(texts, a.Value)
+	}
+
+	if len(respondents) < minGroupSize {
+		return nil, false
+	}
+	sort.Strings(texts)
+	return texts, true
+}
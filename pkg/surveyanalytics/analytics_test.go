@@ -0,0 +1,127 @@
+package surveyanalytics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// answers returns an answer per value, each from its own respondent
+func answers(eventID string, date time.Time, questionID, group string, values ...string) []Answer {
+	rs := make([]Answer, 0, len(values))
+	for i, v := range values {
+		rs = append(rs, Answer{
+			EventID:      eventID,
+			EventTitle:   "Engagement " + eventID,
+			EventDate:    date,
+			QuestionID:   questionID,
+			Content:      "question " + questionID,
+			RespondentID: fmt.Sprintf("%v-%v-%v", eventID, group, i),
+			Group:        group,
+			Value:        v,
+		})
+	}
+	return rs
+}
+
+func TestDistributions(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	var in []Answer
+	in = append(in, answers("e1", now, "q1", "", "5", "4", "4", "2", "5", "")...)
+	in = append(in, answers("e1", now, "q2", "", "3", "3")...)
+	in = append(in, answers("e1", now, "q3", "", "great team", "more focus time", "1:1s", "docs", "pairing")...)
+
+	rs := Distributions(in, 5)
+	require.Len(t, rs, 2)
+
+	assert.Equal(t, "q1", rs[0].QuestionID)
+	assert.False(t, rs[0].Suppressed)
+	assert.Equal(t, 5, rs[0].Respondents)
+	assert.Equal(t, map[string]int{"5": 2, "4": 2, "2": 1}, rs[0].Counts)
+	assert.Equal(t, "4", rs[0].Average.String())
+
+	assert.Equal(t, "q2", rs[1].QuestionID)
+	assert.True(t, rs[1].Suppressed)
+	assert.Nil(t, rs[1].Counts)
+	assert.Zero(t, rs[1].Respondents)
+}
+
+func TestTrends(t *testing.T) {
+	june := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	var in []Answer
+	in = append(in, answers("june", june, "q1", "", "5", "5", "4", "4", "4")...)
+	in = append(in, answers("march", march, "q1", "", "3", "4", "3", "3", "2")...)
+	in = append(in, answers("december", march.AddDate(0, -3, 0), "q1", "", "1", "2")...)
+
+	rs := Trends(in, 5)
+	require.Len(t, rs, 1)
+	require.Len(t, rs[0].Points, 3)
+
+	assert.Equal(t, "december", rs[0].Points[0].EventID)
+	assert.True(t, rs[0].Points[0].Suppressed)
+	assert.Equal(t, "march", rs[0].Points[1].EventID)
+	assert.Equal(t, "3", rs[0].Points[1].Average.String())
+	assert.Equal(t, "june", rs[0].Points[2].EventID)
+	assert.Equal(t, "4.4", rs[0].Points[2].Average.String())
+}
+
+func TestCompare(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("a single small team suppresses the smallest team shown", func(t *testing.T) {
+		var in []Answer
+		in = append(in, answers("e1", now, "q1", "backend", "4", "4", "4", "4", "4", "4", "4")...)
+		in = append(in, answers("e1", now, "q1", "frontend", "5", "5", "5", "5", "5")...)
+		in = append(in, answers("e1", now, "q1", "design", "1", "2")...)
+
+		rs := Compare(in, 5)
+		require.Len(t, rs, 1)
+		require.Len(t, rs[0].Groups, 3)
+
+		assert.Equal(t, "backend", rs[0].Groups[0].Group)
+		assert.False(t, rs[0].Groups[0].Suppressed)
+		assert.Equal(t, 7, rs[0].Groups[0].Respondents)
+		assert.Equal(t, "4", rs[0].Groups[0].Average.String())
+
+		for _, g := range rs[0].Groups[1:] {
+			assert.True(t, g.Suppressed, g.Group)
+			assert.Zero(t, g.Respondents)
+			assert.True(t, g.Average.IsZero())
+		}
+	})
+
+	t.Run("teams above the minimum size are ranked", func(t *testing.T) {
+		var in []Answer
+		in = append(in, answers("e1", now, "q1", "backend", "3", "3", "3", "3", "3")...)
+		in = append(in, answers("e1", now, "q1", "frontend", "5", "5", "5", "5", "4")...)
+		in = append(in, answers("e1", now, "q1", "design", "1", "2")...)
+		in = append(in, answers("e1", now, "q1", "qa", "1")...)
+
+		rs := Compare(in, 5)
+		require.Len(t, rs[0].Groups, 4)
+
+		var shown []string
+		for _, g := range rs[0].Groups {
+			if !g.Suppressed {
+				shown = append(shown, g.Group)
+			}
+		}
+		assert.Equal(t, []string{"frontend", "backend"}, shown)
+	})
+}
+
+func TestTexts(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	in := answers("e1", now, "q3", "", "more focus time", "", "1:1s", "docs", "pairing", "great team")
+
+	texts, ok := Texts(in, "q3", 5)
+	require.True(t, ok)
+	assert.Equal(t, []string{"1:1s", "docs", "great team", "more focus time", "pairing"}, texts)
+
+	_, ok = Texts(in, "q3", 6)
+	assert.False(t, ok)
+}
@@ -0,0 +1,90 @@
+// Package timesheet checks the weekly timesheets of the employees and turns their approved hours
+// into invoice line items
+package timesheet
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+// MaxHoursPerDay is the most hours a day can hold across the entries of a timesheet
+var MaxHoursPerDay = decimal.NewFromInt(24)
+
+var (
+	ErrWeekStartNotMonday = errors.New("week start must be a Monday")
+	ErrEntryOutsideWeek   = errors.New("entry date is outside the week of the timesheet")
+	ErrInvalidHours       = errors.New("entry hours must be more than 0 and at most 24")
+	ErrDayOverbooked      = errors.New("entries of a day sum to more than 24 hours")
+)
+
+// Entry is the hours logged on a day
+type Entry struct {
+	Date  time.Time
+	Hours decimal.Decimal
+}
+
+// WeekStart returns the Monday of the week of t
+func WeekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+// Validate checks that the entries fall within the week starting on weekStart and that no day
+// holds more than MaxHoursPerDay
+func Validate(weekStart time.Time, entries []Entry) error {
+	weekStart = time.Date(weekStart.Year(), weekStart.Month(), weekStart.Day(), 0, 0, 0, 0, time.UTC)
+	if weekStart.Weekday() != time.Monday {
+		return ErrWeekStartNotMonday
+	}
+	weekEnd := weekStart.AddDate(0, 0, 7)
+
+	days := map[time.Time]decimal.Decimal{}
+	for _, e := range entries {
+		date := time.Date(e.Date.Year(), e.Date.Month(), e.Date.Day(), 0, 0, 0, 0, time.UTC)
+		if date.Before(weekStart) || !date.Before(weekEnd) {
+			return ErrEntryOutsideWeek
+		}
+		if !e.Hours.IsPositive() || e.Hours.GreaterThan(MaxHoursPerDay) {
+			return ErrInvalidHours
+		}
+
+		days[date] = days[date].Add(e.Hours)
+		if days[date].GreaterThan(MaxHoursPerDay) {
+			return ErrDayOverbooked
+		}
+	}
+
+	return nil
+}
+
+// LineItems bills the hours of each member at their rate, one line per member ordered by name.
+// The period names the month in the description, like "October 2026".
+func LineItems(hours []model.TimesheetBillableHours, period string) []model.InvoiceItem {
+	sorted := make([]model.TimesheetBillableHours, len(hours))
+	copy(sorted, hours)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].FullName < sorted[j].FullName
+	})
+
+	items := make([]model.InvoiceItem, 0, len(sorted))
+	for _, h := range sorted {
+		if !h.Hours.IsPositive() {
+			continue
+		}
+		items = append(items, model.InvoiceItem{
+			Quantity:     h.Hours.Round(2).InexactFloat64(),
+			UnitCost:     h.Rate.Round(2).InexactFloat64(),
+			Cost:         h.Hours.Mul(h.Rate).Round(2).InexactFloat64(),
+			DiscountType: "None",
+			Description:  fmt.Sprintf("%s - %s (%s hours)", h.FullName, period, h.Hours.Round(2).String()),
+		})
+	}
+
+	return items
+}
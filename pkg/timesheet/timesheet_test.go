@@ -0,0 +1,94 @@
+package timesheet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func hours(h float64) decimal.Decimal {
+	return decimal.NewFromFloat(h)
+}
+
+func TestWeekStart(t *testing.T) {
+	require.Equal(t, date(2026, 10, 19), WeekStart(date(2026, 10, 19)))
+	require.Equal(t, date(2026, 10, 19), WeekStart(time.Date(2026, 10, 22, 15, 30, 0, 0, time.UTC)))
+	// a Sunday belongs to the week started the Monday before
+	require.Equal(t, date(2026, 10, 19), WeekStart(date(2026, 10, 25)))
+}
+
+func TestValidate(t *testing.T) {
+	monday := date(2026, 10, 19)
+
+	tests := map[string]struct {
+		weekStart time.Time
+		entries   []Entry
+		err       error
+	}{
+		"valid week": {
+			weekStart: monday,
+			entries: []Entry{
+				{Date: monday, Hours: hours(8)},
+				{Date: monday, Hours: hours(0.5)},
+				{Date: date(2026, 10, 25), Hours: hours(2)},
+			},
+		},
+		"no entry": {
+			weekStart: monday,
+		},
+		"week start on a Tuesday": {
+			weekStart: date(2026, 10, 20),
+			err:       ErrWeekStartNotMonday,
+		},
+		"entry of the next week": {
+			weekStart: monday,
+			entries:   []Entry{{Date: date(2026, 10, 26), Hours: hours(8)}},
+			err:       ErrEntryOutsideWeek,
+		},
+		"entry of the week before": {
+			weekStart: monday,
+			entries:   []Entry{{Date: date(2026, 10, 18), Hours: hours(8)}},
+			err:       ErrEntryOutsideWeek,
+		},
+		"zero hours": {
+			weekStart: monday,
+			entries:   []Entry{{Date: monday, Hours: hours(0)}},
+			err:       ErrInvalidHours,
+		},
+		"day over 24 hours": {
+			weekStart: monday,
+			entries: []Entry{
+				{Date: monday, Hours: hours(16)},
+				{Date: monday, Hours: hours(9)},
+			},
+			err: ErrDayOverbooked,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.err, Validate(tt.weekStart, tt.entries))
+		})
+	}
+}
+
+func TestLineItems(t *testing.T) {
+	items := LineItems([]model.TimesheetBillableHours{
+		{FullName: "Minh Le", Rate: hours(40), Hours: hours(12.5)},
+		{FullName: "An Nguyen", Rate: hours(55.5), Hours: hours(80)},
+		{FullName: "Bao Tran", Rate: hours(40), Hours: hours(0)},
+	}, "October 2026")
+
+	require.Equal(t, []model.InvoiceItem{
+		{Quantity: 80, UnitCost: 55.5, Cost: 4440, DiscountType: "None", Description: "An Nguyen - October 2026 (80 hours)"},
+		{Quantity: 12.5, UnitCost: 40, Cost: 500, DiscountType: "None", Description: "Minh Le - October 2026 (12.5 hours)"},
+	}, items)
+}
@@ -0,0 +1,64 @@
+package authutils
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils"
+)
+
+// ErrClientPortalDisabled is returned when no key is configured to sign the client tokens
+var ErrClientPortalDisabled = errors.New("client portal is not configured")
+
+// GenerateClientPortalToken signs the claims of a client contact for the client portal
+func GenerateClientPortalToken(claims *model.ClientPortalClaims, expiresAt int64, secretKey string) (string, error) {
+	if secretKey == "" {
+		return "", ErrClientPortalDisabled
+	}
+
+	claims.Audience = model.ClientPortalAudience
+	claims.ExpiresAt = expiresAt
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(secretKey))
+}
+
+// ParseClientPortalToken validates a client portal token and returns its claims, tokens of
+// another audience are rejected even when signed with the same key
+func ParseClientPortalToken(tokenString string, secretKey string) (*model.ClientPortalClaims, error) {
+	if secretKey == "" {
+		return nil, ErrClientPortalDisabled
+	}
+
+	claims := &model.ClientPortalClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, utils.ErrBadToken
+		}
+		return []byte(secretKey), nil
+	})
+	if err != nil || token == nil || !token.Valid {
+		var vErr *jwt.ValidationError
+		if errors.As(err, &vErr) && vErr.Errors&jwt.ValidationErrorExpired != 0 {
+			return nil, utils.ErrInvalidToken
+		}
+		return nil, utils.ErrBadToken
+	}
+	if !claims.VerifyAudience(model.ClientPortalAudience, true) || claims.AccessID == "" {
+		return nil, utils.ErrBadToken
+	}
+
+	return claims, nil
+}
+
+// GetClientPortalAccess returns the access of the client set by the client portal middleware
+func GetClientPortalAccess(c *gin.Context) (*model.ClientPortalAccess, bool) {
+	v, ok := c.Get(model.ClientPortalAccessContextKey)
+	if !ok {
+		return nil, false
+	}
+	access, ok := v.(*model.ClientPortalAccess)
+	return access, ok && access != nil
+}
@@ -0,0 +1,65 @@
+package authutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/utils"
+)
+
+func TestParseClientPortalToken(t *testing.T) {
+	secret := "ClientPortalJWTSecretKey"
+	newClaims := func() *model.ClientPortalClaims {
+		return &model.ClientPortalClaims{
+			AccessID:  "8fc5f7cc-8cd2-4a5a-9b1b-5b4f3e7a8f10",
+			ContactID: "a4a1d5b8-2b8f-45f4-bd42-0e5e2b7c1e22",
+			ClientID:  "0b8e0f9f-3c43-4f3b-8e37-6f1dbb2e6a77",
+		}
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		token, err := GenerateClientPortalToken(newClaims(), time.Now().Add(time.Hour).Unix(), secret)
+		require.NoError(t, err)
+
+		claims, err := ParseClientPortalToken(token, secret)
+		require.NoError(t, err)
+		require.Equal(t, "8fc5f7cc-8cd2-4a5a-9b1b-5b4f3e7a8f10", claims.AccessID)
+		require.Equal(t, "0b8e0f9f-3c43-4f3b-8e37-6f1dbb2e6a77", claims.ClientID)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		token, err := GenerateClientPortalToken(newClaims(), time.Now().Add(-time.Hour).Unix(), secret)
+		require.NoError(t, err)
+
+		_, err = ParseClientPortalToken(token, secret)
+		require.ErrorIs(t, err, utils.ErrInvalidToken)
+	})
+
+	t.Run("signed with another key", func(t *testing.T) {
+		token, err := GenerateClientPortalToken(newClaims(), time.Now().Add(time.Hour).Unix(), "another")
+		require.NoError(t, err)
+
+		_, err = ParseClientPortalToken(token, secret)
+		require.ErrorIs(t, err, utils.ErrBadToken)
+	})
+
+	t.Run("employee token signed with the same key", func(t *testing.T) {
+		token, err := GenerateJWTToken(&model.AuthenticationInfo{UserID: "2655832e-f009-4b73-a535-64c3a22e558f"},
+			time.Now().Add(time.Hour).Unix(), secret)
+		require.NoError(t, err)
+
+		_, err = ParseClientPortalToken(token, secret)
+		require.ErrorIs(t, err, utils.ErrBadToken)
+	})
+
+	t.Run("portal not configured", func(t *testing.T) {
+		_, err := GenerateClientPortalToken(newClaims(), time.Now().Add(time.Hour).Unix(), "")
+		require.ErrorIs(t, err, ErrClientPortalDisabled)
+
+		_, err = ParseClientPortalToken("token", "")
+		require.ErrorIs(t, err, ErrClientPortalDisabled)
+	})
+}
@@ -99,6 +99,11 @@ func ParseStringToDateWithFormat(s, format string) (*time.Time, error) {
 	return &t, nil
 }
 
+// ParseOptionalDate parse an optional YYYY-MM-DD date, an empty string is no date
+func ParseOptionalDate(s string) (*time.Time, error) {
+	return ParseStringToDateWithFormat(s, dataFormat)
+}
+
 // IsSameDay indicate same day (ignore hour, minutes, seconds, ...)
 func IsSameDay(a, b time.Time) bool {
 	return a.Year() == b.Year() &&
@@ -48,6 +48,41 @@ func TestLastDayOfMonth(t *testing.T) {
 	}
 }
 
+func TestParseOptionalDate(t *testing.T) {
+	testcases := []struct {
+		name    string
+		input   string
+		want    *time.Time
+		wantErr bool
+	}{
+		{
+			name:  "case date",
+			input: "2026-10-19",
+			want:  func() *time.Time { d := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC); return &d }(),
+		},
+		{
+			name:  "case empty",
+			input: "",
+		},
+		{
+			name:    "case invalid date",
+			input:   "19/10/2026",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ParseOptionalDate(tc.input)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("timeutil.ParseOptionalDate() want error: %v, got error: %v", tc.wantErr, err)
+			}
+			if !reflect.DeepEqual(out, tc.want) {
+				t.Errorf("timeutil.ParseOptionalDate() want output: %v, got output: %v", tc.want, out)
+			}
+		})
+	}
+}
+
 func TestLastMonthYear(t *testing.T) {
 	testcases := []struct {
 		name      string
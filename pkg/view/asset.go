@@ -1,5 +1,12 @@
 package view
 
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/depreciation"
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
 type ContentData struct {
 	Url string `json:"url"`
 } // @name ContentData
@@ -14,3 +21,172 @@ func ToContentData(url string) *ContentData {
 		Url: url,
 	}
 }
+
+type Asset struct {
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	SerialNumber     string             `json:"serialNumber"`
+	Category         string             `json:"category"`
+	Status           string             `json:"status"`
+	Cost             int64              `json:"cost"`
+	Currency         string             `json:"currency"`
+	PurchaseDate     *time.Time         `json:"purchaseDate"`
+	UsefulLifeMonths int                `json:"usefulLifeMonths"`
+	SalvageValue     int64              `json:"salvageValue"`
+	BookValue        int64              `json:"bookValue"` // at the end of the current month
+	ExpenseID        *string            `json:"expenseID"`
+	Location         string             `json:"location"`
+	Note             string             `json:"note"`
+	Holder           *BasicEmployeeInfo `json:"holder"`
+	CreatedAt        time.Time          `json:"createdAt"`
+} // @name Asset
+
+type AssetAssignment struct {
+	ID                string             `json:"id"`
+	AssetID           string             `json:"assetID"`
+	Employee          *BasicEmployeeInfo `json:"employee"`
+	AssignedAt        time.Time          `json:"assignedAt"`
+	AssignedCondition string             `json:"assignedCondition"`
+	AssignedBy        *string            `json:"assignedBy"`
+	ReturnedAt        *time.Time         `json:"returnedAt"`
+	ReturnedCondition string             `json:"returnedCondition"`
+	ReceivedBy        *string            `json:"receivedBy"`
+	Note              string             `json:"note"`
+} // @name AssetAssignment
+
+type AssetDepreciation struct {
+	ID                      string  `json:"id"`
+	AssetID                 string  `json:"assetID"`
+	Month                   int     `json:"month"`
+	Year                    int     `json:"year"`
+	Amount                  int64   `json:"amount"`
+	Accumulated             int64   `json:"accumulated"`
+	BookValue               int64   `json:"bookValue"`
+	Currency                string  `json:"currency"`
+	AccountingTransactionID *string `json:"accountingTransactionID"`
+	JournalEntryID          *string `json:"journalEntryID"`
+} // @name AssetDepreciation
+
+type AssetDetail struct {
+	Asset
+	Assignments   []AssetAssignment   `json:"assignments"`
+	Depreciations []AssetDepreciation `json:"depreciations"`
+} // @name AssetDetail
+
+func ToAsset(a *model.Asset) *Asset {
+	if a == nil {
+		return nil
+	}
+
+	rs := &Asset{
+		ID:               a.ID.String(),
+		Name:             a.Name,
+		SerialNumber:     a.SerialNumber,
+		Category:         a.Category.String(),
+		Status:           a.Status.String(),
+		Cost:             a.Price,
+		Currency:         a.CurrencyName(),
+		PurchaseDate:     a.PurchaseDate,
+		UsefulLifeMonths: a.UsefulLifeMonths,
+		SalvageValue:     a.SalvageValue,
+		BookValue:        a.Price,
+		ExpenseID:        uuidPtrToString(a.ExpenseID),
+		Location:         a.Location,
+		Note:             a.Note,
+		CreatedAt:        a.CreatedAt,
+	}
+	if a.PurchaseDate != nil {
+		rs.BookValue = depreciation.BookValueAt(depreciation.Asset{
+			Cost:             a.Price,
+			SalvageValue:     a.SalvageValue,
+			UsefulLifeMonths: a.UsefulLifeMonths,
+			PurchaseDate:     *a.PurchaseDate,
+		}, time.Now())
+	}
+	if a.Holder != nil {
+		rs.Holder = toBasicEmployeeInfo(*a.Holder)
+	}
+	return rs
+}
+
+func ToAssets(assets []*model.Asset) []Asset {
+	rs := make([]Asset, 0, len(assets))
+	for _, a := range assets {
+		rs = append(rs, *ToAsset(a))
+	}
+	return rs
+}
+
+func ToAssetDetail(a *model.Asset) *AssetDetail {
+	if a == nil {
+		return nil
+	}
+
+	rs := &AssetDetail{
+		Asset:         *ToAsset(a),
+		Assignments:   make([]AssetAssignment, 0, len(a.Assignments)),
+		Depreciations: ToAssetDepreciations(a.Depreciations),
+	}
+	for i := range a.Assignments {
+		rs.Assignments = append(rs.Assignments, *ToAssetAssignment(&a.Assignments[i]))
+	}
+	return rs
+}
+
+func ToAssetAssignment(a *model.AssetAssignment) *AssetAssignment {
+	if a == nil {
+		return nil
+	}
+
+	rs := &AssetAssignment{
+		ID:                a.ID.String(),
+		AssetID:           a.AssetID.String(),
+		AssignedAt:        a.AssignedAt,
+		AssignedCondition: a.AssignedCondition,
+		AssignedBy:        uuidPtrToString(a.AssignedBy),
+		ReturnedAt:        a.ReturnedAt,
+		ReturnedCondition: a.ReturnedCondition,
+		ReceivedBy:        uuidPtrToString(a.ReceivedBy),
+		Note:              a.Note,
+	}
+	if a.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*a.Employee)
+	}
+	return rs
+}
+
+func ToAssetDepreciations(depreciations []model.AssetDepreciation) []AssetDepreciation {
+	rs := make([]AssetDepreciation, 0, len(depreciations))
+	for _, d := range depreciations {
+		rs = append(rs, AssetDepreciation{
+			ID:                      d.ID.String(),
+			AssetID:                 d.AssetID.String(),
+			Month:                   d.Month,
+			Year:                    d.Year,
+			Amount:                  d.Amount,
+			Accumulated:             d.Accumulated,
+			BookValue:               d.BookValue,
+			Currency:                d.Currency,
+			AccountingTransactionID: uuidPtrToString(d.AccountingTransactionID),
+			JournalEntryID:          uuidPtrToString(d.JournalEntryID),
+		})
+	}
+	return rs
+}
+
+type AssetsResponse struct {
+	PaginationResponse
+	Data []Asset `json:"data"`
+} // @name AssetsResponse
+
+type AssetDetailResponse struct {
+	Data *AssetDetail `json:"data"`
+} // @name AssetDetailResponse
+
+type AssetAssignmentResponse struct {
+	Data *AssetAssignment `json:"data"`
+} // @name AssetAssignmentResponse
+
+type AssetDepreciationsResponse struct {
+	Data []AssetDepreciation `json:"data"`
+} // @name AssetDepreciationsResponse
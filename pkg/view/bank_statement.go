@@ -0,0 +1,142 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type BankStatement struct {
+	ID               string     `json:"id"`
+	FileName         string     `json:"fileName"`
+	Format           string     `json:"format"`
+	AccountNumber    string     `json:"accountNumber"`
+	Currency         string     `json:"currency"`
+	PeriodStart      *time.Time `json:"periodStart"`
+	PeriodEnd        *time.Time `json:"periodEnd"`
+	TransactionCount int        `json:"transactionCount"`
+	ImportedBy       *string    `json:"importedBy"`
+	CreatedAt        time.Time  `json:"createdAt"`
+} // @name BankStatement
+
+func ToBankStatement(s *model.BankStatement) *BankStatement {
+	if s == nil {
+		return nil
+	}
+	return &BankStatement{
+		ID:               s.ID.String(),
+		FileName:         s.FileName,
+		Format:           s.Format.String(),
+		AccountNumber:    s.AccountNumber,
+		Currency:         s.Currency,
+		PeriodStart:      s.PeriodStart,
+		PeriodEnd:        s.PeriodEnd,
+		TransactionCount: s.TransactionCount,
+		ImportedBy:       uuidPtrToString(s.ImportedBy),
+		CreatedAt:        s.CreatedAt,
+	}
+}
+
+func ToBankStatements(statements []*model.BankStatement) []BankStatement {
+	rs := make([]BankStatement, 0, len(statements))
+	for _, s := range statements {
+		rs = append(rs, *ToBankStatement(s))
+	}
+	return rs
+}
+
+type BankTransaction struct {
+	ID              string     `json:"id"`
+	BankStatementID string     `json:"bankStatementID"`
+	ExternalID      string     `json:"externalID"`
+	BookedAt        time.Time  `json:"bookedAt"`
+	Direction       string     `json:"direction"`
+	Amount          float64    `json:"amount"`
+	Currency        string     `json:"currency"`
+	Counterparty    string     `json:"counterparty"`
+	Memo            string     `json:"memo"`
+	Reference       string     `json:"reference"`
+	Status          string     `json:"status"`
+	MatchType       string     `json:"matchType"`
+	MatchID         string     `json:"matchID"`
+	MatchReference  string     `json:"matchReference"`
+	MatchScore      float64    `json:"matchScore"`
+	MatchNote       string     `json:"matchNote"`
+	MatchedAt       *time.Time `json:"matchedAt"`
+	MatchedBy       *string    `json:"matchedBy"`
+} // @name BankTransaction
+
+func ToBankTransaction(t *model.BankTransaction) *BankTransaction {
+	if t == nil {
+		return nil
+	}
+	return &BankTransaction{
+		ID:              t.ID.String(),
+		BankStatementID: t.BankStatementID.String(),
+		ExternalID:      t.ExternalID,
+		BookedAt:        t.BookedAt,
+		Direction:       t.Direction.String(),
+		Amount:          t.Amount,
+		Currency:        t.Currency,
+		Counterparty:    t.Counterparty,
+		Memo:            t.Memo,
+		Reference:       t.Reference,
+		Status:          t.Status.String(),
+		MatchType:       t.MatchType.String(),
+		MatchID:         t.MatchID,
+		MatchReference:  t.MatchReference,
+		MatchScore:      t.MatchScore,
+		MatchNote:       t.MatchNote,
+		MatchedAt:       t.MatchedAt,
+		MatchedBy:       uuidPtrToString(t.MatchedBy),
+	}
+}
+
+func ToBankTransactions(transactions []*model.BankTransaction) []BankTransaction {
+	rs := make([]BankTransaction, 0, len(transactions))
+	for _, t := range transactions {
+		rs = append(rs, *ToBankTransaction(t))
+	}
+	return rs
+}
+
+type BankStatementImportResult struct {
+	Statement  *BankStatement `json:"statement"`
+	Imported   int            `json:"imported"`
+	Duplicates int            `json:"duplicates"`
+	Matched    int            `json:"matched"`
+	Review     int            `json:"review"`
+	Unmatched  int            `json:"unmatched"`
+} // @name BankStatementImportResult
+
+func ToBankStatementImportResult(r *model.BankStatementImportResult) *BankStatementImportResult {
+	if r == nil {
+		return nil
+	}
+	return &BankStatementImportResult{
+		Statement:  ToBankStatement(r.Statement),
+		Imported:   r.Imported,
+		Duplicates: r.Duplicates,
+		Matched:    r.Matched,
+		Review:     r.Review,
+		Unmatched:  r.Unmatched,
+	}
+}
+
+type BankStatementImportResultResponse struct {
+	Data *BankStatementImportResult `json:"data"`
+} // @name BankStatementImportResultResponse
+
+type BankStatementsResponse struct {
+	PaginationResponse
+	Data []BankStatement `json:"data"`
+} // @name BankStatementsResponse
+
+type BankTransactionResponse struct {
+	Data *BankTransaction `json:"data"`
+} // @name BankTransactionResponse
+
+type BankTransactionsResponse struct {
+	PaginationResponse
+	Data []BankTransaction `json:"data"`
+} // @name BankTransactionsResponse
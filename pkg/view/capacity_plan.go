@@ -0,0 +1,124 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/capacity"
+)
+
+type CapacityWeek struct {
+	Start     time.Time `json:"start"` // the Monday of the week
+	Capacity  float64   `json:"capacity"`
+	Allocated float64   `json:"allocated"`
+	Billable  float64   `json:"billable"`
+	Bench     float64   `json:"bench"`
+	Unstaffed float64   `json:"unstaffed"`
+} // @name CapacityWeek
+
+type CapacityEmployeePlan struct {
+	EmployeeID string         `json:"employeeID"`
+	Name       string         `json:"name"`
+	Seniority  string         `json:"seniority"`
+	Stacks     []string       `json:"stacks"`
+	BenchFrom  *time.Time     `json:"benchFrom"` // the first week with half of it or more free
+	BenchWeeks int            `json:"benchWeeks"`
+	Weeks      []CapacityWeek `json:"weeks"`
+} // @name CapacityEmployeePlan
+
+type CapacityGroupPlan struct {
+	Name      string         `json:"name"`
+	Headcount int            `json:"headcount"`
+	Weeks     []CapacityWeek `json:"weeks"`
+} // @name CapacityGroupPlan
+
+type CapacitySlotGap struct {
+	SlotID         string    `json:"slotID"`
+	ProjectID      string    `json:"projectID"`
+	ProjectName    string    `json:"projectName"`
+	Seniority      string    `json:"seniority"`
+	DeploymentType string    `json:"deploymentType"`
+	UnstaffedFrom  time.Time `json:"unstaffedFrom"`
+	Weeks          int       `json:"weeks"`
+} // @name CapacitySlotGap
+
+type CapacityPlan struct {
+	Weeks          []time.Time            `json:"weeks"`
+	Total          []CapacityWeek         `json:"total"`
+	Employees      []CapacityEmployeePlan `json:"employees"`
+	Stacks         []CapacityGroupPlan    `json:"stacks"`
+	Seniorities    []CapacityGroupPlan    `json:"seniorities"`
+	UnstaffedSlots []CapacitySlotGap      `json:"unstaffedSlots"`
+} // @name CapacityPlan
+
+type CapacityPlanResponse struct {
+	Data *CapacityPlan `json:"data"`
+} // @name CapacityPlanResponse
+
+func ToCapacityPlan(p *capacity.Plan) *CapacityPlan {
+	if p == nil {
+		return nil
+	}
+
+	rs := &CapacityPlan{
+		Weeks:          p.Weeks,
+		Total:          toCapacityWeeks(p.Total),
+		Employees:      make([]CapacityEmployeePlan, 0, len(p.Employees)),
+		Stacks:         toCapacityGroupPlans(p.Stacks),
+		Seniorities:    toCapacityGroupPlans(p.Seniorities),
+		UnstaffedSlots: make([]CapacitySlotGap, 0, len(p.UnstaffedSlots)),
+	}
+	for _, e := range p.Employees {
+		stacks := e.Stacks
+		if stacks == nil {
+			stacks = []string{}
+		}
+		rs.Employees = append(rs.Employees, CapacityEmployeePlan{
+			EmployeeID: e.ID,
+			Name:       e.Name,
+			Seniority:  e.Seniority,
+			Stacks:     stacks,
+			BenchFrom:  e.BenchFrom,
+			BenchWeeks: e.BenchWeeks,
+			Weeks:      toCapacityWeeks(e.Weeks),
+		})
+	}
+	for _, s := range p.UnstaffedSlots {
+		rs.UnstaffedSlots = append(rs.UnstaffedSlots, CapacitySlotGap{
+			SlotID:         s.ID,
+			ProjectID:      s.ProjectID,
+			ProjectName:    s.ProjectName,
+			Seniority:      s.Seniority,
+			DeploymentType: s.DeploymentType.String(),
+			UnstaffedFrom:  s.UnstaffedFrom,
+			Weeks:          s.Weeks,
+		})
+	}
+	return rs
+}
+
+func toCapacityGroupPlans(groups []capacity.GroupPlan) []CapacityGroupPlan {
+	rs := make([]CapacityGroupPlan, 0, len(groups))
+	for _, g := range groups {
+		rs = append(rs, CapacityGroupPlan{
+			Name:      g.Name,
+			Headcount: g.Headcount,
+			Weeks:     toCapacityWeeks(g.Weeks),
+		})
+	}
+	return rs
+}
+
+func toCapacityWeeks(weeks []capacity.Week) []CapacityWeek {
+	rs := make([]CapacityWeek, 0, len(weeks))
+	for _, w := range weeks {
+		rs = append(rs, CapacityWeek{
+			Start:     w.Start,
+			Capacity:  w.Capacity,
+			Allocated: w.Allocated,
+			Billable:  w.Billable,
+			Bench:     w.Bench,
+			Unstaffed: w.Unstaffed,
+		})
+	}
+	return rs
+}
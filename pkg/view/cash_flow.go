@@ -0,0 +1,193 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type CashFlowLine struct {
+	Category string  `json:"category"`
+	Amount   float64 `json:"amount"`
+} // @name CashFlowLine
+
+type CashFlowPeriod struct {
+	Start          time.Time      `json:"start"`
+	End            time.Time      `json:"end"`
+	Inflow         float64        `json:"inflow"`
+	Outflow        float64        `json:"outflow"`
+	Net            float64        `json:"net"`
+	OpeningBalance float64        `json:"openingBalance"`
+	ClosingBalance float64        `json:"closingBalance"`
+	Lines          []CashFlowLine `json:"lines"`
+} // @name CashFlowPeriod
+
+type CashFlowScenario struct {
+	ChurnClientIDs     []string   `json:"churnClientIDs"`
+	ChurnDate          *time.Time `json:"churnDate"`
+	NewHires           int        `json:"newHires"`
+	NewHireMonthlyCost float64    `json:"newHireMonthlyCost"`
+	NewHireStartDate   *time.Time `json:"newHireStartDate"`
+	FXShiftPercent     float64    `json:"fxShiftPercent"`
+	OpeningBalance     float64    `json:"openingBalance"`
+} // @name CashFlowScenario
+
+type CashFlowForecast struct {
+	ID        string           `json:"id,omitempty"`
+	Horizon   string           `json:"horizon"`
+	StartDate time.Time        `json:"startDate"`
+	Currency  string           `json:"currency"`
+	Scenario  CashFlowScenario `json:"scenario"`
+	Periods   []CashFlowPeriod `json:"periods"`
+	Warnings  []string         `json:"warnings"`
+	CreatedBy *string          `json:"createdBy,omitempty"`
+	CreatedAt *time.Time       `json:"createdAt,omitempty"`
+} // @name CashFlowForecast
+
+// ToCashFlowForecast returns the forecast computed from the report, with the snapshot
+// details when it was saved
+func ToCashFlowForecast(forecast *model.CashFlowForecast, report *model.CashFlowReport) *CashFlowForecast {
+	if report == nil {
+		return nil
+	}
+
+	rs := &CashFlowForecast{
+		Horizon:   report.Horizon.String(),
+		StartDate: report.StartDate,
+		Currency:  report.Currency,
+		Scenario:  toCashFlowScenario(report.Scenario),
+		Periods:   toCashFlowPeriods(report.Periods),
+		Warnings:  report.Warnings,
+	}
+	if rs.Warnings == nil {
+		rs.Warnings = []string{}
+	}
+	if forecast != nil {
+		createdAt := forecast.CreatedAt
+		rs.ID = forecast.ID.String()
+		rs.CreatedBy = uuidPtrToString(forecast.CreatedBy)
+		rs.CreatedAt = &createdAt
+	}
+
+	return rs
+}
+
+func toCashFlowScenario(s model.CashFlowScenario) CashFlowScenario {
+	return CashFlowScenario{
+		ChurnClientIDs:     s.ChurnClientIDs,
+		ChurnDate:          s.ChurnDate,
+		NewHires:           s.NewHires,
+		NewHireMonthlyCost: s.NewHireMonthlyCost,
+		NewHireStartDate:   s.NewHireStartDate,
+		FXShiftPercent:     s.FXShiftPercent,
+		OpeningBalance:     s.OpeningBalance,
+	}
+}
+
+func toCashFlowPeriods(periods []model.CashFlowPeriod) []CashFlowPeriod {
+	rs := make([]CashFlowPeriod, 0, len(periods))
+	for _, p := range periods {
+		lines := make([]CashFlowLine, 0, len(p.Lines))
+		for _, l := range p.Lines {
+			lines = append(lines, CashFlowLine{Category: l.Category.String(), Amount: l.Amount})
+		}
+
+		rs = append(rs, CashFlowPeriod{
+			Start:          p.Start,
+			End:            p.End,
+			Inflow:         p.Inflow,
+			Outflow:        p.Outflow,
+			Net:            p.Net,
+			OpeningBalance: p.OpeningBalance,
+			ClosingBalance: p.ClosingBalance,
+			Lines:          lines,
+		})
+	}
+	return rs
+}
+
+// CashFlowForecastSummary is a saved forecast without its periods
+type CashFlowForecastSummary struct {
+	ID        string    `json:"id"`
+	Horizon   string    `json:"horizon"`
+	StartDate time.Time `json:"startDate"`
+	Currency  string    `json:"currency"`
+	CreatedBy *string   `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+} // @name CashFlowForecastSummary
+
+func ToCashFlowForecastSummaries(forecasts []*model.CashFlowForecast) []CashFlowForecastSummary {
+	rs := make([]CashFlowForecastSummary, 0, len(forecasts))
+	for _, f := range forecasts {
+		rs = append(rs, CashFlowForecastSummary{
+			ID:        f.ID.String(),
+			Horizon:   f.Horizon.String(),
+			StartDate: f.StartDate,
+			Currency:  f.Currency,
+			CreatedBy: uuidPtrToString(f.CreatedBy),
+			CreatedAt: f.CreatedAt,
+		})
+	}
+	return rs
+}
+
+type CashFlowVariance struct {
+	Start           time.Time `json:"start"`
+	End             time.Time `json:"end"`
+	Complete        bool      `json:"complete"`
+	ForecastInflow  float64   `json:"forecastInflow"`
+	ActualInflow    float64   `json:"actualInflow"`
+	ForecastOutflow float64   `json:"forecastOutflow"`
+	ActualOutflow   float64   `json:"actualOutflow"`
+	ForecastNet     float64   `json:"forecastNet"`
+	ActualNet       float64   `json:"actualNet"`
+	NetVariance     float64   `json:"netVariance"`
+} // @name CashFlowVariance
+
+type CashFlowComparison struct {
+	Forecast  CashFlowForecastSummary `json:"forecast"`
+	Variances []CashFlowVariance      `json:"variances"`
+} // @name CashFlowComparison
+
+func ToCashFlowComparison(forecast *model.CashFlowForecast, variances []model.CashFlowVariance) *CashFlowComparison {
+	if forecast == nil {
+		return nil
+	}
+
+	rs := &CashFlowComparison{
+		Forecast:  ToCashFlowForecastSummaries([]*model.CashFlowForecast{forecast})[0],
+		Variances: make([]CashFlowVariance, 0, len(variances)),
+	}
+	for _, v := range variances {
+		rs.Variances = append(rs.Variances, CashFlowVariance{
+			Start:           v.Start,
+			End:             v.End,
+			Complete:        v.Complete,
+			ForecastInflow:  v.ForecastInflow,
+			ActualInflow:    v.ActualInflow,
+			ForecastOutflow: v.ForecastOutflow,
+			ActualOutflow:   v.ActualOutflow,
+			ForecastNet:     v.ForecastNet,
+			ActualNet:       v.ActualNet,
+			NetVariance:     v.NetVariance,
+		})
+	}
+	return rs
+}
+
+type CashFlowForecastResponse struct {
+	Data *CashFlowForecast `json:"data"`
+} // @name CashFlowForecastResponse
+
+type CashFlowForecastSummariesResponse struct {
+	PaginationResponse
+	Data []CashFlowForecastSummary `json:"data"`
+} // @name CashFlowForecastSummariesResponse
+
+type CashFlowForecastSummariesListResponse struct {
+	Data []CashFlowForecastSummary `json:"data"`
+} // @name CashFlowForecastSummariesListResponse
+
+type CashFlowComparisonResponse struct {
+	Data *CashFlowComparison `json:"data"`
+} // @name CashFlowComparisonResponse
@@ -0,0 +1,313 @@
+package view
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ClientPortalContact struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+} // @name ClientPortalContact
+
+type ClientPortalClient struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Avatar string `json:"avatar"`
+} // @name ClientPortalClient
+
+type ClientPortalAccess struct {
+	ID          string               `json:"id"`
+	Contact     *ClientPortalContact `json:"contact"`
+	Email       string               `json:"email"`
+	LastLoginAt *time.Time           `json:"lastLoginAt"`
+	RevokedAt   *time.Time           `json:"revokedAt"`
+	CreatedAt   time.Time            `json:"createdAt"`
+} // @name ClientPortalAccess
+
+// ClientPortalGrantedAccess carries the access key, it is only returned once
+type ClientPortalGrantedAccess struct {
+	ClientPortalAccess
+	AccessKey string `json:"accessKey"`
+} // @name ClientPortalGrantedAccess
+
+type ClientPortalLogin struct {
+	AccessToken string               `json:"accessToken"`
+	ExpiresAt   time.Time            `json:"expiresAt"`
+	Email       string               `json:"email"`
+	Contact     *ClientPortalContact `json:"contact"`
+	Client      *ClientPortalClient  `json:"client"`
+} // @name ClientPortalLogin
+
+type ClientPortalMe struct {
+	Email   string               `json:"email"`
+	Contact *ClientPortalContact `json:"contact"`
+	Client  *ClientPortalClient  `json:"client"`
+} // @name ClientPortalMe
+
+type ClientPortalInvoice struct {
+	ID          string            `json:"id"`
+	Number      string            `json:"number"`
+	Status      string            `json:"status"`
+	Month       int               `json:"month"`
+	Year        int               `json:"year"`
+	Description string            `json:"description"`
+	InvoicedAt  *time.Time        `json:"invoicedAt"`
+	DueAt       *time.Time        `json:"dueAt"`
+	PaidAt      *time.Time        `json:"paidAt"`
+	SubTotal    float64           `json:"subTotal"`
+	Tax         float64           `json:"tax"`
+	Discount    float64           `json:"discount"`
+	Total       float64           `json:"total"`
+	Currency    string            `json:"currency"`
+	Project     *BasicProjectInfo `json:"project"`
+} // @name ClientPortalInvoice
+
+type ClientPortalReceipt struct {
+	ReceiptNumber string            `json:"receiptNumber"`
+	InvoiceID     string            `json:"invoiceID"`
+	InvoiceNumber string            `json:"invoiceNumber"`
+	Period        string            `json:"period"`
+	PaidAt        time.Time         `json:"paidAt"`
+	Amount        float64           `json:"amount"`
+	Currency      string            `json:"currency"`
+	Client        string            `json:"client"`
+	Project       *BasicProjectInfo `json:"project"`
+} // @name ClientPortalReceipt
+
+type ClientPortalTeamMember struct {
+	EmployeeID     string     `json:"employeeID"`
+	FullName       string     `json:"fullName"`
+	DisplayName    string     `json:"displayName"`
+	Avatar         string     `json:"avatar"`
+	TeamEmail      string     `json:"teamEmail"`
+	Seniority      string     `json:"seniority"`
+	Positions      []string   `json:"positions"`
+	DeploymentType string     `json:"deploymentType"`
+	StartDate      *time.Time `json:"startDate"`
+	IsLead         bool       `json:"isLead"`
+} // @name ClientPortalTeamMember
+
+type ClientPortalProjectTeam struct {
+	Project *BasicProjectInfo        `json:"project"`
+	Members []ClientPortalTeamMember `json:"members"`
+} // @name ClientPortalProjectTeam
+
+func toClientPortalContact(c *model.ClientContact) *ClientPortalContact {
+	if c == nil {
+		return nil
+	}
+
+	return &ClientPortalContact{
+		ID:   c.ID.String(),
+		Name: c.Name,
+		Role: c.Role,
+	}
+}
+
+func toClientPortalClient(c *model.Client) *ClientPortalClient {
+	if c == nil {
+		return nil
+	}
+
+	return &ClientPortalClient{
+		ID:     c.ID.String(),
+		Name:   c.Name,
+		Avatar: c.Avatar,
+	}
+}
+
+func ToClientPortalAccess(a *model.ClientPortalAccess) ClientPortalAccess {
+	return ClientPortalAccess{
+		ID:          a.ID.String(),
+		Contact:     toClientPortalContact(a.ClientContact),
+		Email:       a.Email,
+		LastLoginAt: a.LastLoginAt,
+		RevokedAt:   a.RevokedAt,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func ToClientPortalAccesses(accesses []*model.ClientPortalAccess) []ClientPortalAccess {
+	res := make([]ClientPortalAccess, 0, len(accesses))
+	for _, a := range accesses {
+		res = append(res, ToClientPortalAccess(a))
+	}
+
+	return res
+}
+
+func ToClientPortalGrantedAccess(a *model.ClientPortalAccess, key string) ClientPortalGrantedAccess {
+	return ClientPortalGrantedAccess{
+		ClientPortalAccess: ToClientPortalAccess(a),
+		AccessKey:          key,
+	}
+}
+
+func ToClientPortalLogin(a *model.ClientPortalAccess, token string, expiresAt time.Time) ClientPortalLogin {
+	return ClientPortalLogin{
+		AccessToken: token,
+		ExpiresAt:   expiresAt,
+		Email:       a.Email,
+		Contact:     toClientPortalContact(a.ClientContact),
+		Client:      toClientPortalClient(a.Client),
+	}
+}
+
+func ToClientPortalMe(a *model.ClientPortalAccess) ClientPortalMe {
+	return ClientPortalMe{
+		Email:   a.Email,
+		Contact: toClientPortalContact(a.ClientContact),
+		Client:  toClientPortalClient(a.Client),
+	}
+}
+
+// invoiceCurrency is the currency of the bank account the invoice is paid to
+func invoiceCurrency(iv *model.Invoice) string {
+	if iv.Bank != nil && iv.Bank.Currency != nil {
+		return iv.Bank.Currency.Name
+	}
+	if iv.Project != nil && iv.Project.BankAccount != nil && iv.Project.BankAccount.Currency != nil {
+		return iv.Project.BankAccount.Currency.Name
+	}
+	return ""
+}
+
+func toClientPortalProject(p *model.Project) *BasicProjectInfo {
+	if p == nil {
+		return nil
+	}
+
+	return &BasicProjectInfo{
+		ID:   p.ID.String(),
+		Name: p.Name,
+		Code: p.Code,
+	}
+}
+
+func ToClientPortalInvoice(iv *model.Invoice) ClientPortalInvoice {
+	return ClientPortalInvoice{
+		ID:          iv.ID.String(),
+		Number:      iv.Number,
+		Status:      iv.Status.String(),
+		Month:       iv.Month,
+		Year:        iv.Year,
+		Description: iv.Description,
+		InvoicedAt:  iv.InvoicedAt,
+		DueAt:       iv.DueAt,
+		PaidAt:      iv.PaidAt,
+		SubTotal:    iv.SubTotal,
+		Tax:         iv.Tax,
+		Discount:    iv.Discount,
+		Total:       iv.Total,
+		Currency:    invoiceCurrency(iv),
+		Project:     toClientPortalProject(iv.Project),
+	}
+}
+
+func ToClientPortalInvoices(invoices []*model.Invoice) []ClientPortalInvoice {
+	res := make([]ClientPortalInvoice, 0, len(invoices))
+	for _, iv := range invoices {
+		res = append(res, ToClientPortalInvoice(iv))
+	}
+
+	return res
+}
+
+func ToClientPortalReceipt(iv *model.Invoice, client *model.Client) ClientPortalReceipt {
+	res := ClientPortalReceipt{
+		ReceiptNumber: "RCPT-" + iv.Number,
+		InvoiceID:     iv.ID.String(),
+		InvoiceNumber: iv.Number,
+		Amount:        iv.Total,
+		Currency:      invoiceCurrency(iv),
+		Project:       toClientPortalProject(iv.Project),
+	}
+	if iv.PaidAt != nil {
+		res.PaidAt = *iv.PaidAt
+	}
+	if iv.Month >= 1 && iv.Month <= 12 {
+		res.Period = time.Date(iv.Year, time.Month(iv.Month), 1, 0, 0, 0, 0, time.UTC).Format("January 2006")
+	}
+	if client != nil {
+		res.Client = client.Name
+	}
+
+	return res
+}
+
+// ToClientPortalTeams groups the members by project, keeping the order of the members
+func ToClientPortalTeams(members []model.ClientPortalTeamMember) []ClientPortalProjectTeam {
+	res := make([]ClientPortalProjectTeam, 0)
+	idx := map[model.UUID]int{}
+	for _, m := range members {
+		i, ok := idx[m.ProjectID]
+		if !ok {
+			i = len(res)
+			idx[m.ProjectID] = i
+			res = append(res, ClientPortalProjectTeam{
+				Project: &BasicProjectInfo{ID: m.ProjectID.String(), Name: m.ProjectName},
+				Members: []ClientPortalTeamMember{},
+			})
+		}
+
+		positions := []string{}
+		if m.Positions != "" {
+			positions = strings.Split(m.Positions, ",")
+		}
+		res[i].Members = append(res[i].Members, ClientPortalTeamMember{
+			EmployeeID:     m.EmployeeID.String(),
+			FullName:       m.FullName,
+			DisplayName:    m.DisplayName,
+			Avatar:         m.Avatar,
+			TeamEmail:      m.TeamEmail,
+			Seniority:      m.SeniorityName,
+			Positions:      positions,
+			DeploymentType: m.DeploymentType.String(),
+			StartDate:      m.StartDate,
+			IsLead:         m.IsLead,
+		})
+	}
+
+	return res
+}
+
+type ClientPortalAccessesResponse struct {
+	Data []ClientPortalAccess `json:"data"`
+} // @name ClientPortalAccessesResponse
+
+type ClientPortalAccessResponse struct {
+	Data ClientPortalAccess `json:"data"`
+} // @name ClientPortalAccessResponse
+
+type ClientPortalGrantedAccessResponse struct {
+	Data ClientPortalGrantedAccess `json:"data"`
+} // @name ClientPortalGrantedAccessResponse
+
+type ClientPortalLoginResponse struct {
+	Data ClientPortalLogin `json:"data"`
+} // @name ClientPortalLoginResponse
+
+type ClientPortalMeResponse struct {
+	Data ClientPortalMe `json:"data"`
+} // @name ClientPortalMeResponse
+
+type ClientPortalInvoicesResponse struct {
+	PaginationResponse
+	Data []ClientPortalInvoice `json:"data"`
+} // @name ClientPortalInvoicesResponse
+
+type ClientPortalInvoiceResponse struct {
+	Data ClientPortalInvoice `json:"data"`
+} // @name ClientPortalInvoiceResponse
+
+type ClientPortalReceiptResponse struct {
+	Data ClientPortalReceipt `json:"data"`
+} // @name ClientPortalReceiptResponse
+
+type ClientPortalTeamResponse struct {
+	Data []ClientPortalProjectTeam `json:"data"`
+} // @name ClientPortalTeamResponse
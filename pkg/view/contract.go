@@ -0,0 +1,149 @@
+package view
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type Contract struct {
+	ID               string            `json:"id"`
+	Code             string            `json:"code"`
+	Name             string            `json:"name"`
+	Type             string            `json:"type"`
+	Status           string            `json:"status"`
+	Client           *BasicClientInfo  `json:"client"`
+	Project          *BasicProjectInfo `json:"project"` // empty for a contract covering the whole client
+	StartDate        time.Time         `json:"startDate"`
+	EndDate          *time.Time        `json:"endDate"`
+	SignedAt         *time.Time        `json:"signedAt"`
+	Currency         string            `json:"currency"`
+	PaymentTermDays  int               `json:"paymentTermDays"`
+	ValueCap         *decimal.Decimal  `json:"valueCap"`
+	Note             string            `json:"note"`
+	ExpiryNotifiedAt *time.Time        `json:"expiryNotifiedAt"`
+} // @name Contract
+
+type ContractRate struct {
+	ID        string          `json:"id"`
+	Seniority *Seniority      `json:"seniority"`
+	Position  *Position       `json:"position"`
+	Rate      decimal.Decimal `json:"rate"`
+	Unit      string          `json:"unit"`
+} // @name ContractRate
+
+type ContractAttachment struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"contentType"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"createdAt"`
+} // @name ContractAttachment
+
+type ContractDetail struct {
+	Contract
+	Rates       []ContractRate       `json:"rates"`
+	Attachments []ContractAttachment `json:"attachments"`
+} // @name ContractDetail
+
+func ToContract(c *model.Contract) *Contract {
+	if c == nil {
+		return nil
+	}
+
+	rs := &Contract{
+		ID:               c.ID.String(),
+		Code:             c.Code,
+		Name:             c.Name,
+		Type:             c.Type.String(),
+		Status:           c.Status.String(),
+		Client:           ToBasicClientInfo(c.Client),
+		StartDate:        c.StartDate,
+		EndDate:          c.EndDate,
+		SignedAt:         c.SignedAt,
+		PaymentTermDays:  c.PaymentTermDays,
+		Note:             c.Note,
+		ExpiryNotifiedAt: c.ExpiryNotifiedAt,
+	}
+	if c.Project != nil {
+		rs.Project = toBasicProjectInfo(*c.Project)
+	}
+	if c.Currency != nil {
+		rs.Currency = c.Currency.Name
+	}
+	if c.ValueCap.Valid {
+		valueCap := c.ValueCap.Decimal
+		rs.ValueCap = &valueCap
+	}
+	return rs
+}
+
+func ToContracts(contracts []*model.Contract) []Contract {
+	rs := make([]Contract, 0, len(contracts))
+	for _, c := range contracts {
+		rs = append(rs, *ToContract(c))
+	}
+	return rs
+}
+
+func ToContractDetail(c *model.Contract) *ContractDetail {
+	if c == nil {
+		return nil
+	}
+
+	rs := &ContractDetail{
+		Contract:    *ToContract(c),
+		Rates:       make([]ContractRate, 0, len(c.Rates)),
+		Attachments: make([]ContractAttachment, 0, len(c.Attachments)),
+	}
+	for _, r := range c.Rates {
+		rate := ContractRate{
+			ID:       r.ID.String(),
+			Position: ToPosition(r.Position),
+			Rate:     r.Rate,
+			Unit:     r.Unit.String(),
+		}
+		if r.Seniority != nil {
+			seniority := ToSeniority(*r.Seniority)
+			rate.Seniority = &seniority
+		}
+		rs.Rates = append(rs.Rates, rate)
+	}
+	for i := range c.Attachments {
+		rs.Attachments = append(rs.Attachments, *ToContractAttachment(&c.Attachments[i]))
+	}
+	return rs
+}
+
+func ToContractAttachment(a *model.ContractAttachment) *ContractAttachment {
+	if a == nil {
+		return nil
+	}
+
+	return &ContractAttachment{
+		ID:          a.ID.String(),
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		URL:         a.URL,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+type ContractsResponse struct {
+	PaginationResponse
+	Data []Contract `json:"data"`
+} // @name ContractsResponse
+
+type ContractDetailResponse struct {
+	Data *ContractDetail `json:"data"`
+} // @name ContractDetailResponse
+
+type ContractAttachmentResponse struct {
+	Data *ContractAttachment `json:"data"`
+} // @name ContractAttachmentResponse
+
+type ContractsListResponse struct {
+	Data []Contract `json:"data"`
+} // @name ContractsListResponse
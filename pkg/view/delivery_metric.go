@@ -31,14 +31,19 @@ type DeliveryLeaderBoardResponse struct {
 }
 
 type WeeklyLeaderBoard struct {
-	Date  *time.Time        `json:"date"`
-	Items []LeaderBoardItem `json:"items"`
+	Name    string            `json:"name"`
+	Formula string            `json:"formula"`
+	Period  string            `json:"period"`
+	Date    *time.Time        `json:"date"`
+	Items   []LeaderBoardItem `json:"items"`
 }
 
 type LeaderBoardItem struct {
 	EmployeeID      string          `json:"employeeID"`
 	EmployeeName    string          `json:"employeeName"`
-	Points          decimal.Decimal `json:"points"`
+	Points          decimal.Decimal `json:"points"` // the score of the board formula
+	Weight          decimal.Decimal `json:"weight"`
+	Effort          decimal.Decimal `json:"effort"`
 	Effectiveness   decimal.Decimal `json:"effectiveness"`
 	DiscordID       string          `json:"discordID"`
 	DiscordUsername string          `json:"discordUsername"`
@@ -53,6 +58,8 @@ func ToDeliveryMetricLeaderBoard(board *model.LeaderBoard) *WeeklyLeaderBoard {
 			EmployeeID:      m.EmployeeID,
 			EmployeeName:    m.EmployeeName,
 			Points:          m.Points,
+			Weight:          m.Weight,
+			Effort:          m.Effort,
 			Effectiveness:   m.Effectiveness,
 			DiscordID:       m.DiscordID,
 			DiscordUsername: m.DiscordUsername,
@@ -61,9 +68,62 @@ func ToDeliveryMetricLeaderBoard(board *model.LeaderBoard) *WeeklyLeaderBoard {
 	}
 
 	return &WeeklyLeaderBoard{
-		Date:  board.Date,
-		Items: items,
+		Name:    board.Name,
+		Formula: board.Formula,
+		Period:  board.Period.String(),
+		Date:    board.Date,
+		Items:   items,
+	}
+}
+
+type DeliveryLeaderBoard struct {
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Formula          string          `json:"formula"`
+	Scope            string          `json:"scope"`
+	ScopeID          *string         `json:"scopeID"`
+	Period           string          `json:"period"`
+	ExcludeLeads     bool            `json:"excludeLeads"`
+	MinEffort        decimal.Decimal `json:"minEffort"`
+	Size             int             `json:"size"`
+	DiscordChannelID string          `json:"discordChannelID"`
+	IsActive         bool            `json:"isActive"`
+} // @name DeliveryLeaderBoard
+
+type DeliveryLeaderBoardDefinitionResponse struct {
+	Data DeliveryLeaderBoard `json:"data"`
+} // @name DeliveryLeaderBoardDefinitionResponse
+
+type DeliveryLeaderBoardListResponse struct {
+	Data []DeliveryLeaderBoard `json:"data"`
+} // @name DeliveryLeaderBoardListResponse
+
+func ToDeliveryLeaderBoard(board *model.DeliveryLeaderBoard) DeliveryLeaderBoard {
+	rs := DeliveryLeaderBoard{
+		ID:               board.ID.String(),
+		Name:             board.Name,
+		Formula:          board.Formula,
+		Scope:            board.Scope.String(),
+		Period:           board.Period.String(),
+		ExcludeLeads:     board.ExcludeLeads,
+		MinEffort:        board.MinEffort,
+		Size:             board.Size,
+		DiscordChannelID: board.DiscordChannelID,
+		IsActive:         board.IsActive,
 	}
+	if board.ScopeID != nil {
+		scopeID := board.ScopeID.String()
+		rs.ScopeID = &scopeID
+	}
+	return rs
+}
+
+func ToDeliveryLeaderBoards(boards []*model.DeliveryLeaderBoard) []DeliveryLeaderBoard {
+	rs := make([]DeliveryLeaderBoard, 0, len(boards))
+	for _, b := range boards {
+		rs = append(rs, ToDeliveryLeaderBoard(b))
+	}
+	return rs
 }
 
 func ToDeliveryMetricWeeklyReport(in *model.WeeklyReport) *DeliveryMetricWeeklyReport {
@@ -146,3 +206,123 @@ type MonthlyLeaderBoard struct {
 	Date  *time.Time        `json:"date"`
 	Items []LeaderBoardItem `json:"items"`
 }
+
+type DeliveryMetricImport struct {
+	ID            string                    `json:"id"`
+	CreatedAt     time.Time                 `json:"createdAt"`
+	Source        string                    `json:"source"`
+	FileName      string                    `json:"fileName"`
+	Dataset       string                    `json:"dataset"`
+	DryRun        bool                      `json:"dryRun"`
+	TotalRows     int                       `json:"totalRows"`
+	CreatedRows   int                       `json:"createdRows"`
+	UpdatedRows   int                       `json:"updatedRows"`
+	UnchangedRows int                       `json:"unchangedRows"`
+	RejectedRows  int                       `json:"rejectedRows"`
+	DeletedRows   int                       `json:"deletedRows"`
+	Rejections    []DeliveryMetricRejection `json:"rejections"`
+	ImportedBy    string                    `json:"importedBy"`
+} // @name DeliveryMetricImport
+
+type DeliveryMetricRejection struct {
+	Line    int      `json:"line"`
+	RowID   string   `json:"rowId,omitempty"`
+	Person  string   `json:"person"`
+	Email   string   `json:"email"`
+	Project string   `json:"project"`
+	Date    string   `json:"date"`
+	Reasons []string `json:"reasons"`
+} // @name DeliveryMetricRejection
+
+type DeliveryMetricImportResponse struct {
+	Data DeliveryMetricImport `json:"data"`
+} // @name DeliveryMetricImportResponse
+
+type DeliveryMetricImportsResponse struct {
+	Data []DeliveryMetricImport `json:"data"`
+} // @name DeliveryMetricImportsResponse
+
+func ToDeliveryMetricImport(i *model.DeliveryMetricImport) DeliveryMetricImport {
+	rs := DeliveryMetricImport{
+		ID:            i.ID.String(),
+		CreatedAt:     i.CreatedAt,
+		Source:        i.Source.String(),
+		FileName:      i.FileName,
+		Dataset:       i.Dataset,
+		DryRun:        i.DryRun,
+		TotalRows:     i.TotalRows,
+		CreatedRows:   i.CreatedRows,
+		UpdatedRows:   i.UpdatedRows,
+		UnchangedRows: i.UnchangedRows,
+		RejectedRows:  i.RejectedRows,
+		DeletedRows:   i.DeletedRows,
+		Rejections:    make([]DeliveryMetricRejection, 0, len(i.Rejections)),
+	}
+	if i.ImportedBy != nil {
+		rs.ImportedBy = i.ImportedBy.String()
+	}
+
+	for _, r := range i.Rejections {
+		rs.Rejections = append(rs.Rejections, DeliveryMetricRejection{
+			Line:    r.Line,
+			RowID:   r.RowID,
+			Person:  r.Person,
+			Email:   r.Email,
+			Project: r.Project,
+			Date:    r.Date,
+			Reasons: r.Reasons,
+		})
+	}
+
+	return rs
+}
+
+// ToDeliveryMetricImports returns the imports without their rejections, they are read one import at a time
+func ToDeliveryMetricImports(imports []*model.DeliveryMetricImport) []DeliveryMetricImport {
+	rs := make([]DeliveryMetricImport, 0, len(imports))
+	for _, i := range imports {
+		v := ToDeliveryMetricImport(i)
+		v.Rejections = nil
+		rs = append(rs, v)
+	}
+	return rs
+}
+
+type DeliveryMetricMapping struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Kind      string    `json:"kind"`
+	Value     string    `json:"value"`
+	TargetID  string    `json:"targetID"`
+	CreatedBy string    `json:"createdBy"`
+} // @name DeliveryMetricMapping
+
+type DeliveryMetricMappingResponse struct {
+	Data DeliveryMetricMapping `json:"data"`
+} // @name DeliveryMetricMappingResponse
+
+type DeliveryMetricMappingsResponse struct {
+	Data []DeliveryMetricMapping `json:"data"`
+} // @name DeliveryMetricMappingsResponse
+
+func ToDeliveryMetricMapping(m *model.DeliveryMetricMapping) DeliveryMetricMapping {
+	rs := DeliveryMetricMapping{
+		ID:        m.ID.String(),
+		CreatedAt: m.CreatedAt,
+		Kind:      m.Kind.String(),
+		Value:     m.Value,
+		TargetID:  m.TargetID.String(),
+	}
+	if m.CreatedBy != nil {
+		rs.CreatedBy = m.CreatedBy.String()
+	}
+	return rs
+}
+
+func ToDeliveryMetricMappings(mappings []*model.DeliveryMetricMapping) []DeliveryMetricMapping {
+	rs := make([]DeliveryMetricMapping, 0, len(mappings))
+	for _, m := range mappings {
+		rs = append(rs, ToDeliveryMetricMapping(m))
+	}
+	return rs
+}
@@ -0,0 +1,152 @@
+package view
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type EngagementAggregation struct {
+	Date       time.Time   `json:"date"`
+	IsBaseline bool        `json:"isBaseline"` // the first run records the rollups without a date
+	Activities int         `json:"activities"`
+	WeekStarts []time.Time `json:"weekStarts"`
+	Members    int         `json:"members"`
+} // @name EngagementAggregation
+
+type EngagementTrend struct {
+	WeekStart           time.Time `json:"weekStart"`
+	Scope               string    `json:"scope"`
+	Chapter             *Chapter  `json:"chapter"`
+	Members             int       `json:"members"`
+	ActiveMembers       int       `json:"activeMembers"`
+	ParticipationRate   float64   `json:"participationRate"` // percent of the members active
+	MessageCount        int       `json:"messageCount"`
+	ReactionCount       int       `json:"reactionCount"`
+	MessageChange       *float64  `json:"messageChange"` // percent from the week before
+	ActiveMembersChange *float64  `json:"activeMembersChange"`
+} // @name EngagementTrend
+
+type EngagementQuietMember struct {
+	Employee        *BasicEmployeeInfo `json:"employee"`
+	LineManager     *BasicEmployeeInfo `json:"lineManager"`
+	DiscordUserID   string             `json:"discordUserID"`
+	LastActiveAt    *time.Time         `json:"lastActiveAt"` // empty when never seen active
+	QuietDays       *int               `json:"quietDays"`
+	RecentMessages  int                `json:"recentMessages"` // over the last four weeks
+	RecentReactions int                `json:"recentReactions"`
+	ComputedOn      time.Time          `json:"computedOn"`
+} // @name EngagementQuietMember
+
+type EngagementChannelHealth struct {
+	ChannelID        string     `json:"channelID"`
+	CategoryID       string     `json:"categoryID"`
+	ChannelName      string     `json:"channelName"`
+	Status           string     `json:"status"` // dormant, declining, concentrated or healthy
+	MessageCount     int        `json:"messageCount"`
+	PreviousMessages int        `json:"previousMessages"` // over as many weeks before
+	MessageChange    *float64   `json:"messageChange"`
+	ActiveMembers    int        `json:"activeMembers"`
+	TopMemberShare   float64    `json:"topMemberShare"` // share of the messages from the most active member of each week
+	LastActiveWeek   *time.Time `json:"lastActiveWeek"`
+} // @name EngagementChannelHealth
+
+func ToEngagementAggregation(a *model.EngagementAggregation) *EngagementAggregation {
+	return &EngagementAggregation{
+		Date:       a.Date,
+		IsBaseline: a.IsBaseline,
+		Activities: a.Activities,
+		WeekStarts: a.WeekStarts,
+		Members:    a.Members,
+	}
+}
+
+func ToEngagementTrends(trends []model.EngagementTrend) []EngagementTrend {
+	rs := make([]EngagementTrend, 0, len(trends))
+	for _, t := range trends {
+		r := EngagementTrend{
+			WeekStart:           t.WeekStart,
+			Scope:               t.Scope.String(),
+			Members:             t.Members,
+			ActiveMembers:       t.ActiveMembers,
+			ParticipationRate:   t.ParticipationRate,
+			MessageCount:        t.MessageCount,
+			ReactionCount:       t.ReactionCount,
+			MessageChange:       t.MessageChange,
+			ActiveMembersChange: t.ActiveMembersChange,
+		}
+		if t.Chapter != nil {
+			r.Chapter = &Chapter{
+				ID:   t.Chapter.ID.String(),
+				Code: t.Chapter.Code,
+				Name: t.Chapter.Name,
+			}
+			if t.Chapter.LeadID != nil {
+				r.Chapter.LeadID = t.Chapter.LeadID.String()
+			}
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+func ToEngagementQuietMembers(stats []*model.EngagementMemberStat, now time.Time) []EngagementQuietMember {
+	rs := make([]EngagementQuietMember, 0, len(stats))
+	for _, s := range stats {
+		r := EngagementQuietMember{
+			DiscordUserID:   strconv.FormatInt(s.DiscordUserID, 10),
+			LastActiveAt:    s.LastActiveAt,
+			RecentMessages:  s.RecentMessages,
+			RecentReactions: s.RecentReactions,
+			ComputedOn:      s.ComputedOn,
+		}
+		if s.LastActiveAt != nil {
+			days := int(now.Sub(*s.LastActiveAt).Hours() / 24)
+			r.QuietDays = &days
+		}
+		if s.Employee != nil {
+			r.Employee = toBasicEmployeeInfo(*s.Employee)
+			if s.Employee.LineManager != nil {
+				r.LineManager = toBasicEmployeeInfo(*s.Employee.LineManager)
+			}
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+func ToEngagementChannelHealth(channels []model.EngagementChannelHealth) []EngagementChannelHealth {
+	rs := make([]EngagementChannelHealth, 0, len(channels))
+	for _, c := range channels {
+		rs = append(rs, EngagementChannelHealth{
+			ChannelID:        strconv.FormatInt(c.ChannelID, 10),
+			CategoryID:       strconv.FormatInt(c.CategoryID, 10),
+			ChannelName:      c.ChannelName,
+			Status:           c.Status.String(),
+			MessageCount:     c.MessageCount,
+			PreviousMessages: c.PreviousMessages,
+			MessageChange:    c.MessageChange,
+			ActiveMembers:    c.ActiveMembers,
+			TopMemberShare:   c.TopMemberShare,
+			LastActiveWeek:   c.LastActiveWeek,
+		})
+	}
+	return rs
+}
+
+type EngagementAggregationResponse struct {
+	Data EngagementAggregation `json:"data"`
+} // @name EngagementAggregationResponse
+
+type EngagementTrendsResponse struct {
+	Data []EngagementTrend `json:"data"`
+} // @name EngagementTrendsResponse
+
+type EngagementQuietMembersResponse struct {
+	Data []EngagementQuietMember `json:"data"`
+} // @name EngagementQuietMembersResponse
+
+type EngagementChannelHealthResponse struct {
+	Data []EngagementChannelHealth `json:"data"`
+} // @name EngagementChannelHealthResponse
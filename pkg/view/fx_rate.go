@@ -0,0 +1,81 @@
+package view
+
+import "github.com/dwarvesf/fortress-api/pkg/model"
+
+type FxRate struct {
+	ID             string  `json:"id"`
+	Date           string  `json:"date"`
+	SourceCurrency string  `json:"sourceCurrency"`
+	TargetCurrency string  `json:"targetCurrency"`
+	Rate           float64 `json:"rate"`
+	Source         string  `json:"source"`
+	Note           string  `json:"note"`
+} // @name FxRate
+
+func ToFxRate(r *model.FxRate) *FxRate {
+	if r == nil {
+		return nil
+	}
+
+	id := ""
+	if !r.ID.IsZero() {
+		id = r.ID.String()
+	}
+
+	return &FxRate{
+		ID:             id,
+		Date:           r.Date.Format("2006-01-02"),
+		SourceCurrency: r.SourceCurrency,
+		TargetCurrency: r.TargetCurrency,
+		Rate:           r.Rate,
+		Source:         r.Source.String(),
+		Note:           r.Note,
+	}
+}
+
+func ToFxRates(rates []model.FxRate) []FxRate {
+	rs := make([]FxRate, 0, len(rates))
+	for i := range rates {
+		rs = append(rs, *ToFxRate(&rates[i]))
+	}
+	return rs
+}
+
+type FxTransactionRate struct {
+	Type             string  `json:"type"`
+	ID               string  `json:"id"`
+	Date             string  `json:"date"`
+	SourceCurrency   string  `json:"sourceCurrency"`
+	TargetCurrency   string  `json:"targetCurrency"`
+	AppliedRate      float64 `json:"appliedRate"`
+	HistoricalRate   *FxRate `json:"historicalRate"`
+	DeviationPercent float64 `json:"deviationPercent"`
+} // @name FxTransactionRate
+
+func ToFxTransactionRate(r *model.FxTransactionRate) *FxTransactionRate {
+	if r == nil {
+		return nil
+	}
+	return &FxTransactionRate{
+		Type:             string(r.Type),
+		ID:               r.ID,
+		Date:             r.Date.Format("2006-01-02"),
+		SourceCurrency:   r.SourceCurrency,
+		TargetCurrency:   r.TargetCurrency,
+		AppliedRate:      r.AppliedRate,
+		HistoricalRate:   ToFxRate(r.HistoricalRate),
+		DeviationPercent: r.DeviationPercent,
+	}
+}
+
+type FxRateResponse struct {
+	Data *FxRate `json:"data"`
+} // @name FxRateResponse
+
+type FxRatesResponse struct {
+	Data []FxRate `json:"data"`
+} // @name FxRatesResponse
+
+type FxTransactionRateResponse struct {
+	Data *FxTransactionRate `json:"data"`
+} // @name FxTransactionRateResponse
@@ -0,0 +1,194 @@
+package view
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IcyRewardRule struct {
+	ID              string           `json:"id"`
+	Trigger         string           `json:"trigger"`
+	Name            string           `json:"name"`
+	Category        string           `json:"category"`
+	Amount          decimal.Decimal  `json:"amount"`
+	CapPerPeriod    int              `json:"capPerPeriod"` // 0 for no cap
+	CapPeriod       string           `json:"capPeriod"`
+	MonthlyBudget   *decimal.Decimal `json:"monthlyBudget"`   // no budget when empty
+	ReviewThreshold *decimal.Decimal `json:"reviewThreshold"` // the configured one when empty
+	IsActive        bool             `json:"isActive"`
+} // @name IcyRewardRule
+
+type IcyRewardPayout struct {
+	ID               string             `json:"id"`
+	Rule             *IcyRewardRule     `json:"rule"`
+	Employee         *BasicEmployeeInfo `json:"employee"`
+	Reference        string             `json:"reference"`
+	Amount           decimal.Decimal    `json:"amount"`
+	Status           string             `json:"status"`
+	Reason           string             `json:"reason"` // why it is skipped, in review, failed or rejected
+	OccurredAt       time.Time          `json:"occurredAt"`
+	Reviewer         *BasicEmployeeInfo `json:"reviewer"`
+	ReviewedAt       *time.Time         `json:"reviewedAt"`
+	MochiTxID        int64              `json:"mochiTxID"`
+	IcyTransactionID string             `json:"icyTransactionID"`
+	CreatedAt        time.Time          `json:"createdAt"`
+} // @name IcyRewardPayout
+
+type IcyRewardTriggered struct {
+	IcyRewardPayout
+	Created bool `json:"created"` // false when the event was rewarded already
+} // @name IcyRewardTriggered
+
+type IcyRewardRuleBudget struct {
+	Rule      IcyRewardRule    `json:"rule"`
+	Paid      decimal.Decimal  `json:"paid"`
+	InReview  decimal.Decimal  `json:"inReview"`
+	Remaining *decimal.Decimal `json:"remaining"` // left of the budget of the rule, empty without one
+	Payouts   int64            `json:"payouts"`
+	Skipped   int64            `json:"skipped"`
+	Employees int64            `json:"employees"`
+} // @name IcyRewardRuleBudget
+
+type IcyRewardBudget struct {
+	Month         string                `json:"month"` // YYYY-MM
+	MonthlyBudget *decimal.Decimal      `json:"monthlyBudget"`
+	Paid          decimal.Decimal       `json:"paid"`
+	InReview      decimal.Decimal       `json:"inReview"`
+	Remaining     *decimal.Decimal      `json:"remaining"` // left of the monthly budget, empty without one
+	Rules         []IcyRewardRuleBudget `json:"rules"`
+} // @name IcyRewardBudget
+
+func nullDecimal(d decimal.NullDecimal) *decimal.Decimal {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Decimal
+}
+
+func remaining(budget decimal.NullDecimal, spent ...decimal.Decimal) *decimal.Decimal {
+	if !budget.Valid {
+		return nil
+	}
+	left := budget.Decimal
+	for _, s := range spent {
+		left = left.Sub(s)
+	}
+	return &left
+}
+
+func ToIcyRewardRule(r *model.IcyRewardRule) *IcyRewardRule {
+	return &IcyRewardRule{
+		ID:              r.ID.String(),
+		Trigger:         r.Trigger.String(),
+		Name:            r.Name,
+		Category:        r.Category,
+		Amount:          r.Amount,
+		CapPerPeriod:    r.CapPerPeriod,
+		CapPeriod:       r.CapPeriod.String(),
+		MonthlyBudget:   nullDecimal(r.MonthlyBudget),
+		ReviewThreshold: nullDecimal(r.ReviewThreshold),
+		IsActive:        r.IsActive,
+	}
+}
+
+func ToIcyRewardRules(rules []*model.IcyRewardRule) []IcyRewardRule {
+	rs := make([]IcyRewardRule, 0, len(rules))
+	for _, r := range rules {
+		rs = append(rs, *ToIcyRewardRule(r))
+	}
+	return rs
+}
+
+func ToIcyRewardPayout(p *model.IcyRewardPayout) *IcyRewardPayout {
+	rs := &IcyRewardPayout{
+		ID:         p.ID.String(),
+		Reference:  p.Reference,
+		Amount:     p.Amount,
+		Status:     p.Status.String(),
+		Reason:     p.Reason,
+		OccurredAt: p.OccurredAt,
+		ReviewedAt: p.ReviewedAt,
+		MochiTxID:  p.MochiTxID,
+		CreatedAt:  p.CreatedAt,
+	}
+	if p.IcyTransactionID != nil {
+		rs.IcyTransactionID = p.IcyTransactionID.String()
+	}
+	if p.Rule != nil {
+		rs.Rule = ToIcyRewardRule(p.Rule)
+	}
+	if p.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*p.Employee)
+	}
+	if p.Reviewer != nil {
+		rs.Reviewer = toBasicEmployeeInfo(*p.Reviewer)
+	}
+	return rs
+}
+
+func ToIcyRewardPayouts(payouts []*model.IcyRewardPayout) []IcyRewardPayout {
+	rs := make([]IcyRewardPayout, 0, len(payouts))
+	for _, p := range payouts {
+		rs = append(rs, *ToIcyRewardPayout(p))
+	}
+	return rs
+}
+
+func ToIcyRewardTriggered(p *model.IcyRewardPayout, created bool) *IcyRewardTriggered {
+	return &IcyRewardTriggered{
+		IcyRewardPayout: *ToIcyRewardPayout(p),
+		Created:         created,
+	}
+}
+
+func ToIcyRewardBudget(r *model.IcyRewardBudgetReport) *IcyRewardBudget {
+	rs := &IcyRewardBudget{
+		Month:         time.Date(r.Year, time.Month(r.Month), 1, 0, 0, 0, 0, time.UTC).Format("2006-01"),
+		MonthlyBudget: nullDecimal(r.MonthlyBudget),
+		Paid:          r.Paid,
+		InReview:      r.InReview,
+		Remaining:     remaining(r.MonthlyBudget, r.Paid, r.InReview),
+		Rules:         make([]IcyRewardRuleBudget, 0, len(r.Rules)),
+	}
+	for i := range r.Rules {
+		b := r.Rules[i]
+		rs.Rules = append(rs.Rules, IcyRewardRuleBudget{
+			Rule:      *ToIcyRewardRule(&b.Rule),
+			Paid:      b.Paid,
+			InReview:  b.InReview,
+			Remaining: remaining(b.Rule.MonthlyBudget, b.Paid, b.InReview),
+			Payouts:   b.Payouts,
+			Skipped:   b.Skipped,
+			Employees: b.Employees,
+		})
+	}
+	return rs
+}
+
+type IcyRewardRuleResponse struct {
+	Data IcyRewardRule `json:"data"`
+} // @name IcyRewardRuleResponse
+
+type IcyRewardRulesResponse struct {
+	Data []IcyRewardRule `json:"data"`
+} // @name IcyRewardRulesResponse
+
+type IcyRewardPayoutResponse struct {
+	Data IcyRewardPayout `json:"data"`
+} // @name IcyRewardPayoutResponse
+
+type IcyRewardPayoutsResponse struct {
+	PaginationResponse
+	Data []IcyRewardPayout `json:"data"`
+} // @name IcyRewardPayoutsResponse
+
+type IcyRewardTriggeredResponse struct {
+	Data IcyRewardTriggered `json:"data"`
+} // @name IcyRewardTriggeredResponse
+
+type IcyRewardBudgetResponse struct {
+	Data IcyRewardBudget `json:"data"`
+} // @name IcyRewardBudgetResponse
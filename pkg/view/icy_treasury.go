@@ -0,0 +1,113 @@
+package view
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type IcyTreasurySnapshot struct {
+	ID                string           `json:"id"`
+	Date              string           `json:"date"` // YYYY-MM-DD
+	TakenAt           time.Time        `json:"takenAt"`
+	TotalSupply       decimal.Decimal  `json:"totalSupply"`
+	OnchainLocked     decimal.Decimal  `json:"onchainLocked"`
+	OffchainLocked    decimal.Decimal  `json:"offchainLocked"`
+	Circulating       decimal.Decimal  `json:"circulating"`
+	UsdcFund          decimal.Decimal  `json:"usdcFund"`
+	ConversionRate    decimal.Decimal  `json:"conversionRate"`
+	OffsetUsdc        decimal.Decimal  `json:"offsetUsdc"`
+	CustodyBalance    *decimal.Decimal `json:"custodyBalance"` // empty without custody addresses configured
+	LedgerDeposits    decimal.Decimal  `json:"ledgerDeposits"`
+	LedgerWithdrawals decimal.Decimal  `json:"ledgerWithdrawals"`
+	LedgerTxCount     int              `json:"ledgerTxCount"`
+	Drift             decimal.Decimal  `json:"drift"`
+	CumulativeDrift   decimal.Decimal  `json:"cumulativeDrift"`
+	DriftFlagged      bool             `json:"driftFlagged"`
+	Note              string           `json:"note"`
+} // @name IcyTreasurySnapshot
+
+type IcyTreasuryVaultBalance struct {
+	ProfileID   string          `json:"profileID"`
+	ProfileName string          `json:"profileName"`
+	ProfileType string          `json:"profileType"`
+	Amount      decimal.Decimal `json:"amount"`
+} // @name IcyTreasuryVaultBalance
+
+type IcyTreasurySnapshotDetail struct {
+	IcyTreasurySnapshot
+	VaultBalances []IcyTreasuryVaultBalance `json:"vaultBalances"`
+} // @name IcyTreasurySnapshotDetail
+
+type IcyTreasuryReconciliation struct {
+	From        string                `json:"from"` // YYYY-MM-DD of the first snapshot
+	To          string                `json:"to"`   // YYYY-MM-DD of the last snapshot
+	Drift       decimal.Decimal       `json:"drift"`
+	FlaggedDays int                   `json:"flaggedDays"`
+	Snapshots   []IcyTreasurySnapshot `json:"snapshots"`
+} // @name IcyTreasuryReconciliation
+
+func ToIcyTreasurySnapshot(s *model.IcyTreasurySnapshot) *IcyTreasurySnapshot {
+	return &IcyTreasurySnapshot{
+		ID:                s.ID.String(),
+		Date:              s.SnapshotDate.Format("2006-01-02"),
+		TakenAt:           s.TakenAt,
+		TotalSupply:       s.TotalSupply,
+		OnchainLocked:     s.OnchainLocked,
+		OffchainLocked:    s.OffchainLocked,
+		Circulating:       s.Circulating,
+		UsdcFund:          s.UsdcFund,
+		ConversionRate:    s.ConversionRate,
+		OffsetUsdc:        s.OffsetUsdc,
+		CustodyBalance:    nullDecimal(s.CustodyBalance),
+		LedgerDeposits:    s.LedgerDeposits,
+		LedgerWithdrawals: s.LedgerWithdrawals,
+		LedgerTxCount:     s.LedgerTxCount,
+		Drift:             s.Drift,
+		CumulativeDrift:   s.CumulativeDrift,
+		DriftFlagged:      s.DriftFlagged,
+		Note:              s.Note,
+	}
+}
+
+func ToIcyTreasurySnapshotDetail(s *model.IcyTreasurySnapshot) *IcyTreasurySnapshotDetail {
+	rs := &IcyTreasurySnapshotDetail{
+		IcyTreasurySnapshot: *ToIcyTreasurySnapshot(s),
+		VaultBalances:       make([]IcyTreasuryVaultBalance, 0, len(s.VaultBalances)),
+	}
+	for _, b := range s.VaultBalances {
+		rs.VaultBalances = append(rs.VaultBalances, IcyTreasuryVaultBalance{
+			ProfileID:   b.ProfileID,
+			ProfileName: b.ProfileName,
+			ProfileType: b.ProfileType,
+			Amount:      b.Amount,
+		})
+	}
+	return rs
+}
+
+func ToIcyTreasuryReconciliation(r *model.IcyTreasuryReconciliation) *IcyTreasuryReconciliation {
+	rs := &IcyTreasuryReconciliation{
+		Drift:       r.Drift,
+		FlaggedDays: r.FlaggedDays,
+		Snapshots:   make([]IcyTreasurySnapshot, 0, len(r.Snapshots)),
+	}
+	if len(r.Snapshots) > 0 {
+		rs.From = r.From.Format("2006-01-02")
+		rs.To = r.To.Format("2006-01-02")
+	}
+	for _, s := range r.Snapshots {
+		rs.Snapshots = append(rs.Snapshots, *ToIcyTreasurySnapshot(s))
+	}
+	return rs
+}
+
+type IcyTreasurySnapshotResponse struct {
+	Data IcyTreasurySnapshotDetail `json:"data"`
+} // @name IcyTreasurySnapshotResponse
+
+type IcyTreasuryReconciliationResponse struct {
+	Data IcyTreasuryReconciliation `json:"data"`
+} // @name IcyTreasuryReconciliationResponse
@@ -0,0 +1,64 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type KnowledgeSearchResult struct {
+	Source      string     `json:"source"`
+	SourceID    string     `json:"sourceID"`
+	Title       string     `json:"title"`
+	URL         string     `json:"url"`
+	Authors     []string   `json:"authors"`
+	Tags        []string   `json:"tags"`
+	PublishedAt *time.Time `json:"publishedAt"`
+	Rank        float64    `json:"rank"`
+	Snippet     string     `json:"snippet"` // the matches highlighted with <mark>
+} // @name KnowledgeSearchResult
+
+type KnowledgeIndexResult struct {
+	Source  string `json:"source"`
+	Indexed int    `json:"indexed"`
+	Removed int    `json:"removed"`
+} // @name KnowledgeIndexResult
+
+func ToKnowledgeSearchResults(results []model.KnowledgeSearchResult) []KnowledgeSearchResult {
+	rs := make([]KnowledgeSearchResult, 0, len(results))
+	for _, r := range results {
+		rs = append(rs, KnowledgeSearchResult{
+			Source:      r.Source.String(),
+			SourceID:    r.SourceID,
+			Title:       r.Title,
+			URL:         r.URL,
+			Authors:     r.Authors,
+			Tags:        r.Tags,
+			PublishedAt: r.PublishedAt,
+			Rank:        r.Rank,
+			Snippet:     r.Snippet,
+		})
+	}
+	return rs
+}
+
+func ToKnowledgeIndexResults(results []model.KnowledgeIndexResult) []KnowledgeIndexResult {
+	rs := make([]KnowledgeIndexResult, 0, len(results))
+	for _, r := range results {
+		rs = append(rs, KnowledgeIndexResult{
+			Source:  r.Source.String(),
+			Indexed: r.Indexed,
+			Removed: r.Removed,
+		})
+	}
+	return rs
+}
+
+type KnowledgeSearchResponse struct {
+	PaginationResponse
+	Data []KnowledgeSearchResult `json:"data"`
+} // @name KnowledgeSearchResponse
+
+type KnowledgeIndexResultsResponse struct {
+	Data []KnowledgeIndexResult `json:"data"`
+} // @name KnowledgeIndexResultsResponse
@@ -0,0 +1,208 @@
+package view
+
+import "github.com/dwarvesf/fortress-api/pkg/model"
+
+type LedgerAccount struct {
+	ID          string `json:"id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	IsActive    bool   `json:"isActive"`
+} // @name LedgerAccount
+
+func ToLedgerAccount(a *model.LedgerAccount) *LedgerAccount {
+	if a == nil {
+		return nil
+	}
+	return &LedgerAccount{
+		ID:          a.ID.String(),
+		Code:        a.Code,
+		Name:        a.Name,
+		Type:        a.Type.String(),
+		Description: a.Description,
+		IsActive:    a.IsActive,
+	}
+}
+
+func ToLedgerAccounts(accounts []model.LedgerAccount) []LedgerAccount {
+	rs := make([]LedgerAccount, 0, len(accounts))
+	for i := range accounts {
+		rs = append(rs, *ToLedgerAccount(&accounts[i]))
+	}
+	return rs
+}
+
+type JournalLine struct {
+	ID             string         `json:"id"`
+	AccountID      string         `json:"accountID"`
+	Account        *LedgerAccount `json:"account"`
+	Debit          int64          `json:"debit"`
+	Credit         int64          `json:"credit"`
+	Currency       string         `json:"currency"`
+	OriginalAmount float64        `json:"originalAmount"`
+	Memo           string         `json:"memo"`
+} // @name JournalLine
+
+type JournalEntry struct {
+	ID                      string        `json:"id"`
+	Date                    string        `json:"date"`
+	Description             string        `json:"description"`
+	SourceType              string        `json:"sourceType"`
+	SourceID                *string       `json:"sourceID"`
+	AccountingTransactionID *string       `json:"accountingTransactionID"`
+	ReversalOfID            *string       `json:"reversalOfID"`
+	CreatedBy               *string       `json:"createdBy"`
+	TotalDebit              int64         `json:"totalDebit"`
+	TotalCredit             int64         `json:"totalCredit"`
+	Lines                   []JournalLine `json:"lines"`
+} // @name JournalEntry
+
+func ToJournalEntry(e *model.JournalEntry) *JournalEntry {
+	if e == nil {
+		return nil
+	}
+
+	debit, credit := e.Totals()
+	rs := &JournalEntry{
+		ID:                      e.ID.String(),
+		Date:                    e.Date.Format("2006-01-02"),
+		Description:             e.Description,
+		SourceType:              e.SourceType.String(),
+		SourceID:                e.SourceID,
+		AccountingTransactionID: uuidPtrToString(e.AccountingTransactionID),
+		ReversalOfID:            uuidPtrToString(e.ReversalOfID),
+		CreatedBy:               uuidPtrToString(e.CreatedBy),
+		TotalDebit:              debit,
+		TotalCredit:             credit,
+		Lines:                   make([]JournalLine, 0, len(e.Lines)),
+	}
+
+	for _, l := range e.Lines {
+		rs.Lines = append(rs.Lines, JournalLine{
+			ID:             l.ID.String(),
+			AccountID:      l.AccountID.String(),
+			Account:        ToLedgerAccount(l.Account),
+			Debit:          l.Debit,
+			Credit:         l.Credit,
+			Currency:       l.Currency,
+			OriginalAmount: l.OriginalAmount,
+			Memo:           l.Memo,
+		})
+	}
+
+	return rs
+}
+
+func ToJournalEntries(entries []*model.JournalEntry) []JournalEntry {
+	rs := make([]JournalEntry, 0, len(entries))
+	for _, e := range entries {
+		rs = append(rs, *ToJournalEntry(e))
+	}
+	return rs
+}
+
+type LedgerAccountBalance struct {
+	AccountID string `json:"accountID"`
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Debit     int64  `json:"debit"`
+	Credit    int64  `json:"credit"`
+	Balance   int64  `json:"balance"`
+} // @name LedgerAccountBalance
+
+func toLedgerAccountBalances(balances []model.LedgerAccountBalance) []LedgerAccountBalance {
+	rs := make([]LedgerAccountBalance, 0, len(balances))
+	for _, b := range balances {
+		rs = append(rs, LedgerAccountBalance{
+			AccountID: b.AccountID.String(),
+			Code:      b.Code,
+			Name:      b.Name,
+			Type:      b.Type.String(),
+			Debit:     b.Debit,
+			Credit:    b.Credit,
+			Balance:   b.Balance(),
+		})
+	}
+	return rs
+}
+
+type TrialBalance struct {
+	AsOf        string                 `json:"asOf"`
+	Accounts    []LedgerAccountBalance `json:"accounts"`
+	TotalDebit  int64                  `json:"totalDebit"`
+	TotalCredit int64                  `json:"totalCredit"`
+	IsBalanced  bool                   `json:"isBalanced"`
+} // @name TrialBalance
+
+func ToTrialBalance(tb *model.TrialBalance) *TrialBalance {
+	if tb == nil {
+		return nil
+	}
+	return &TrialBalance{
+		AsOf:        tb.AsOf.Format("2006-01-02"),
+		Accounts:    toLedgerAccountBalances(tb.Accounts),
+		TotalDebit:  tb.TotalDebit,
+		TotalCredit: tb.TotalCredit,
+		IsBalanced:  tb.IsBalanced(),
+	}
+}
+
+type ProfitAndLoss struct {
+	From         string                 `json:"from"`
+	To           string                 `json:"to"`
+	Income       []LedgerAccountBalance `json:"income"`
+	Expenses     []LedgerAccountBalance `json:"expenses"`
+	TotalIncome  int64                  `json:"totalIncome"`
+	TotalExpense int64                  `json:"totalExpense"`
+	NetIncome    int64                  `json:"netIncome"`
+} // @name ProfitAndLoss
+
+func ToProfitAndLoss(pl *model.ProfitAndLoss) *ProfitAndLoss {
+	if pl == nil {
+		return nil
+	}
+	return &ProfitAndLoss{
+		From:         pl.From.Format("2006-01-02"),
+		To:           pl.To.Format("2006-01-02"),
+		Income:       toLedgerAccountBalances(pl.Income),
+		Expenses:     toLedgerAccountBalances(pl.Expenses),
+		TotalIncome:  pl.TotalIncome,
+		TotalExpense: pl.TotalExpense,
+		NetIncome:    pl.NetIncome,
+	}
+}
+
+type LedgerAccountResponse struct {
+	Data *LedgerAccount `json:"data"`
+} // @name LedgerAccountResponse
+
+type LedgerAccountsResponse struct {
+	Data []LedgerAccount `json:"data"`
+} // @name LedgerAccountsResponse
+
+type JournalEntryResponse struct {
+	Data *JournalEntry `json:"data"`
+} // @name JournalEntryResponse
+
+type JournalEntriesResponse struct {
+	PaginationResponse
+	Data []JournalEntry `json:"data"`
+} // @name JournalEntriesResponse
+
+type TrialBalanceResponse struct {
+	Data *TrialBalance `json:"data"`
+} // @name TrialBalanceResponse
+
+type ProfitAndLossResponse struct {
+	Data *ProfitAndLoss `json:"data"`
+} // @name ProfitAndLossResponse
+
+func uuidPtrToString(id *model.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
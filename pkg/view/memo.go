@@ -15,6 +15,7 @@ type MemoLog struct {
 	Authors     []MemoLogAuthor `json:"authors"`
 	Description string          `json:"description"`
 	PublishedAt *time.Time      `json:"publishedAt"`
+	EditedAt    *time.Time      `json:"editedAt"` // the last edit in the memo repository
 	Reward      decimal.Decimal `json:"reward"`
 	Category    []string        `json:"category"`
 } // @name MemoLog
@@ -82,6 +83,7 @@ func ToMemoLog(memoLogs []model.MemoLog) []MemoLog {
 			Authors:     authors,
 			Description: memoLog.Description,
 			PublishedAt: memoLog.PublishedAt,
+			EditedAt:    memoLog.EditedAt,
 			Reward:      memoLog.Reward,
 			Category:    memoLog.Category,
 		})
@@ -149,6 +151,7 @@ func ToMemoLogByDiscordID(memoLogs []model.MemoLog, discordMemoRank *model.Disco
 			Authors:     authors,
 			Description: memoLog.Description,
 			PublishedAt: memoLog.PublishedAt,
+			EditedAt:    memoLog.EditedAt,
 			Reward:      memoLog.Reward,
 			Category:    memoLog.Category,
 		})
@@ -197,3 +200,41 @@ func ToMemoTopAuthors(discordMemoRank []model.DiscordAccountMemoRank) []AuthorRa
 
 	return rs
 }
+
+// MemoIngestion is a run of the memo ingestion from the memo repository
+type MemoIngestion struct {
+	ID                string    `json:"id"`
+	CommitSHA         string    `json:"commitSHA"`
+	PreviousCommitSHA string    `json:"previousCommitSHA"`
+	IsFullScan        bool      `json:"isFullScan"`
+	Created           int       `json:"created"`
+	Updated           int       `json:"updated"`
+	Renamed           int       `json:"renamed"`
+	Deleted           int       `json:"deleted"`
+	Drafts            int       `json:"drafts"`
+	Skipped           int       `json:"skipped"`           // the files that fail to parse
+	UnresolvedAuthors []string  `json:"unresolvedAuthors"` // GitHub handles without a social account
+	CreatedAt         time.Time `json:"createdAt"`
+} // @name MemoIngestion
+
+// MemoIngestionResponse response for a memo ingestion
+type MemoIngestionResponse struct {
+	Data MemoIngestion `json:"data"`
+} // @name MemoIngestionResponse
+
+func ToMemoIngestion(i *model.MemoIngestion) *MemoIngestion {
+	return &MemoIngestion{
+		ID:                i.ID.String(),
+		CommitSHA:         i.CommitSHA,
+		PreviousCommitSHA: i.PreviousCommitSHA,
+		IsFullScan:        i.IsFullScan,
+		Created:           i.Created,
+		Updated:           i.Updated,
+		Renamed:           i.Renamed,
+		Deleted:           i.Deleted,
+		Drafts:            i.Drafts,
+		Skipped:           i.Skipped,
+		UnresolvedAuthors: i.UnresolvedAuthors,
+		CreatedAt:         i.CreatedAt,
+	}
+}
@@ -0,0 +1,176 @@
+package view
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/officecheckin"
+)
+
+type OfficeLocation struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Address           string    `json:"address"`
+	Latitude          *float64  `json:"latitude"`
+	Longitude         *float64  `json:"longitude"`
+	GeofenceRadius    int       `json:"geofenceRadius"` // meters, 0 for no geofence
+	QRRotationSeconds int       `json:"qrRotationSeconds"`
+	IsActive          bool      `json:"isActive"`
+	CreatedAt         time.Time `json:"createdAt"`
+} // @name OfficeLocation
+
+type OfficeQRCode struct {
+	Location  OfficeLocation `json:"location"`
+	Code      string         `json:"code"`
+	ExpiresAt time.Time      `json:"expiresAt"`
+} // @name OfficeQRCode
+
+type OfficeCheckin struct {
+	ID               string             `json:"id"`
+	Employee         *BasicEmployeeInfo `json:"employee"`
+	OfficeLocationID string             `json:"officeLocationID"`
+	OfficeName       string             `json:"officeName"`
+	Date             time.Time          `json:"date"`
+	CheckedInAt      time.Time          `json:"checkedInAt"`
+	CheckInDistance  *float64           `json:"checkInDistance"` // meters from the office
+	CheckedOutAt     *time.Time         `json:"checkedOutAt"`
+	CheckOutDistance *float64           `json:"checkOutDistance"`
+	Hours            float64            `json:"hours"`
+} // @name OfficeCheckin
+
+type OfficeAttendance struct {
+	EmployeeID string          `json:"employeeID"`
+	FullName   string          `json:"fullName"`
+	Username   string          `json:"username"`
+	Days       int64           `json:"days"`
+	CheckedOut int64           `json:"checkedOut"` // days checked out, the hours are of these days
+	Hours      float64         `json:"hours"`
+	Perk       decimal.Decimal `json:"perk"`
+} // @name OfficeAttendance
+
+type OfficeAttendanceReport struct {
+	Year         int                `json:"year"`
+	Month        int                `json:"month"`
+	PerkPerDay   decimal.Decimal    `json:"perkPerDay"`
+	PerkCurrency string             `json:"perkCurrency"`
+	Days         int64              `json:"days"`
+	Perk         decimal.Decimal    `json:"perk"`
+	Employees    []OfficeAttendance `json:"employees"`
+} // @name OfficeAttendanceReport
+
+func ToOfficeLocation(l *model.OfficeLocation) *OfficeLocation {
+	if l == nil {
+		return nil
+	}
+
+	return &OfficeLocation{
+		ID:                l.ID.String(),
+		Name:              l.Name,
+		Address:           l.Address,
+		Latitude:          l.Latitude,
+		Longitude:         l.Longitude,
+		GeofenceRadius:    l.GeofenceRadius,
+		QRRotationSeconds: l.QRRotationSeconds,
+		IsActive:          l.IsActive,
+		CreatedAt:         l.CreatedAt,
+	}
+}
+
+func ToOfficeLocations(locations []*model.OfficeLocation) []OfficeLocation {
+	rs := make([]OfficeLocation, 0, len(locations))
+	for _, l := range locations {
+		rs = append(rs, *ToOfficeLocation(l))
+	}
+	return rs
+}
+
+func ToOfficeQRCode(location *model.OfficeLocation, code string, expiresAt time.Time) *OfficeQRCode {
+	return &OfficeQRCode{
+		Location:  *ToOfficeLocation(location),
+		Code:      code,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func ToOfficeCheckin(c *model.OfficeCheckin) *OfficeCheckin {
+	if c == nil {
+		return nil
+	}
+
+	rs := &OfficeCheckin{
+		ID:               c.ID.String(),
+		OfficeLocationID: c.OfficeLocationID.String(),
+		Date:             c.Date,
+		CheckedInAt:      c.CheckedInAt,
+		CheckInDistance:  c.CheckInDistance,
+		CheckedOutAt:     c.CheckedOutAt,
+		CheckOutDistance: c.CheckOutDistance,
+		Hours:            officecheckin.WorkedHours(c.CheckedInAt, c.CheckedOutAt),
+	}
+	if c.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*c.Employee)
+	}
+	if c.OfficeLocation != nil {
+		rs.OfficeName = c.OfficeLocation.Name
+	}
+	return rs
+}
+
+func ToOfficeCheckins(checkins []*model.OfficeCheckin) []OfficeCheckin {
+	rs := make([]OfficeCheckin, 0, len(checkins))
+	for _, c := range checkins {
+		rs = append(rs, *ToOfficeCheckin(c))
+	}
+	return rs
+}
+
+func ToOfficeAttendanceReport(r *model.OfficeAttendanceReport) *OfficeAttendanceReport {
+	rs := &OfficeAttendanceReport{
+		Year:         r.Year,
+		Month:        r.Month,
+		PerkPerDay:   r.PerkPerDay,
+		PerkCurrency: r.PerkCurrency,
+		Days:         r.Days,
+		Perk:         r.Perk,
+		Employees:    make([]OfficeAttendance, 0, len(r.Employees)),
+	}
+	for _, a := range r.Employees {
+		rs.Employees = append(rs.Employees, OfficeAttendance{
+			EmployeeID: a.EmployeeID,
+			FullName:   a.FullName,
+			Username:   a.Username,
+			Days:       a.Days,
+			CheckedOut: a.CheckedOut,
+			Hours:      a.Hours,
+			Perk:       a.Perk,
+		})
+	}
+	return rs
+}
+
+type OfficeLocationResponse struct {
+	Data OfficeLocation `json:"data"`
+} // @name OfficeLocationResponse
+
+type OfficeLocationsResponse struct {
+	Data []OfficeLocation `json:"data"`
+} // @name OfficeLocationsResponse
+
+type OfficeQRCodeResponse struct {
+	Data OfficeQRCode `json:"data"`
+} // @name OfficeQRCodeResponse
+
+type OfficeCheckinResponse struct {
+	Data OfficeCheckin `json:"data"`
+} // @name OfficeCheckinResponse
+
+type OfficeCheckinsResponse struct {
+	PaginationResponse
+	Data []OfficeCheckin `json:"data"`
+} // @name OfficeCheckinsResponse
+
+type OfficeAttendanceReportResponse struct {
+	Data OfficeAttendanceReport `json:"data"`
+} // @name OfficeAttendanceReportResponse
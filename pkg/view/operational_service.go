@@ -0,0 +1,246 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type OperationalService struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	Vendor       string             `json:"vendor"`
+	Plan         string             `json:"plan"`
+	BillingCycle string             `json:"billingCycle"`
+	Amount       int                `json:"amount"`
+	SeatCount    int                `json:"seatCount"`
+	Currency     string             `json:"currency"`
+	StartAt      time.Time          `json:"startAt"`
+	EndAt        *time.Time         `json:"endAt"`
+	RenewalDate  *time.Time         `json:"renewalDate"`
+	ReminderDays int                `json:"reminderDays"`
+	Owner        *BasicEmployeeInfo `json:"owner"`
+	Organization *Organization      `json:"organization"`
+	CostCenter   string             `json:"costCenter"`
+	IsActive     bool               `json:"isActive"`
+	Note         string             `json:"note"`
+} // @name OperationalService
+
+type OperationalServiceSeat struct {
+	ID                   string             `json:"id"`
+	OperationalServiceID string             `json:"operationalServiceID"`
+	Employee             *BasicEmployeeInfo `json:"employee"`
+	AssignedAt           time.Time          `json:"assignedAt"`
+	ReleasedAt           *time.Time         `json:"releasedAt"`
+	Note                 string             `json:"note"`
+} // @name OperationalServiceSeat
+
+type OperationalServiceDetail struct {
+	OperationalService
+	Seats []OperationalServiceSeat `json:"seats"` // the seats currently held
+} // @name OperationalServiceDetail
+
+type OperationalServiceSeatReconciliation struct {
+	Service      OperationalService  `json:"service"`
+	Purchased    int                 `json:"purchased"`
+	Needed       int                 `json:"needed"`
+	Tracked      bool                `json:"tracked"` // the needed seats are the active holders rather than the headcount
+	Excess       int                 `json:"excess"`
+	Shortage     int                 `json:"shortage"`
+	Leavers      []BasicEmployeeInfo `json:"leavers"` // employees who left still holding a seat
+	SeatCost     float64             `json:"seatCost"`
+	MonthlyWaste float64             `json:"monthlyWaste"`
+} // @name OperationalServiceSeatReconciliation
+
+type OperationalServiceSpend struct {
+	ServiceID    string  `json:"serviceID"`
+	Name         string  `json:"name"`
+	Vendor       string  `json:"vendor"`
+	Plan         string  `json:"plan"`
+	CostCenter   string  `json:"costCenter"`
+	BillingCycle string  `json:"billingCycle"`
+	Currency     string  `json:"currency"`
+	Amount       float64 `json:"amount"`
+	Charged      float64 `json:"charged"`
+	Monthly      float64 `json:"monthly"`
+} // @name OperationalServiceSpend
+
+type OperationalServiceOrganizationSpend struct {
+	OrganizationID   string                    `json:"organizationID"`
+	OrganizationName string                    `json:"organizationName"`
+	Charged          float64                   `json:"charged"`
+	Monthly          float64                   `json:"monthly"`
+	Services         []OperationalServiceSpend `json:"services"`
+} // @name OperationalServiceOrganizationSpend
+
+type OperationalServiceSpendReport struct {
+	Year          int                                   `json:"year"`
+	Month         int                                   `json:"month"`
+	Currency      string                                `json:"currency"`
+	Charged       float64                               `json:"charged"`
+	Monthly       float64                               `json:"monthly"`
+	Organizations []OperationalServiceOrganizationSpend `json:"organizations"`
+	Warnings      []string                              `json:"warnings"`
+} // @name OperationalServiceSpendReport
+
+func ToOperationalService(s *model.OperationalService) *OperationalService {
+	if s == nil {
+		return nil
+	}
+
+	rs := &OperationalService{
+		ID:           s.ID.String(),
+		Name:         s.Name,
+		Vendor:       s.Vendor,
+		Plan:         s.Plan,
+		BillingCycle: s.BillingCycle.String(),
+		Amount:       s.Amount,
+		SeatCount:    s.SeatCount,
+		Currency:     s.CurrencyName(),
+		StartAt:      s.StartAt,
+		RenewalDate:  s.RenewalDate,
+		ReminderDays: s.ReminderDays,
+		Organization: ToOrganization(s.Organization),
+		CostCenter:   s.CostCenter,
+		IsActive:     s.IsActive,
+		Note:         s.Note,
+	}
+	if !s.EndAt.IsZero() {
+		endAt := s.EndAt
+		rs.EndAt = &endAt
+	}
+	if s.Owner != nil {
+		rs.Owner = toBasicEmployeeInfo(*s.Owner)
+	}
+	return rs
+}
+
+func ToOperationalServices(services []*model.OperationalService) []OperationalService {
+	rs := make([]OperationalService, 0, len(services))
+	for _, s := range services {
+		rs = append(rs, *ToOperationalService(s))
+	}
+	return rs
+}
+
+func ToOperationalServiceDetail(s *model.OperationalService) *OperationalServiceDetail {
+	if s == nil {
+		return nil
+	}
+
+	rs := &OperationalServiceDetail{
+		OperationalService: *ToOperationalService(s),
+		Seats:              make([]OperationalServiceSeat, 0, len(s.Seats)),
+	}
+	for i := range s.Seats {
+		rs.Seats = append(rs.Seats, *ToOperationalServiceSeat(&s.Seats[i]))
+	}
+	return rs
+}
+
+func ToOperationalServiceSeat(s *model.OperationalServiceSeat) *OperationalServiceSeat {
+	if s == nil {
+		return nil
+	}
+
+	rs := &OperationalServiceSeat{
+		ID:                   s.ID.String(),
+		OperationalServiceID: s.OperationalServiceID.String(),
+		AssignedAt:           s.AssignedAt,
+		ReleasedAt:           s.ReleasedAt,
+		Note:                 s.Note,
+	}
+	if s.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*s.Employee)
+	}
+	return rs
+}
+
+func ToOperationalServiceSeatReconciliations(reconciliations []*model.OperationalServiceSeatReconciliation) []OperationalServiceSeatReconciliation {
+	rs := make([]OperationalServiceSeatReconciliation, 0, len(reconciliations))
+	for _, r := range reconciliations {
+		leavers := make([]BasicEmployeeInfo, 0, len(r.Leavers))
+		for _, e := range r.Leavers {
+			leavers = append(leavers, *toBasicEmployeeInfo(*e))
+		}
+
+		rs = append(rs, OperationalServiceSeatReconciliation{
+			Service:      *ToOperationalService(r.Service),
+			Purchased:    r.Purchased,
+			Needed:       r.Needed,
+			Tracked:      r.Tracked,
+			Excess:       r.Excess,
+			Shortage:     r.Shortage,
+			Leavers:      leavers,
+			SeatCost:     r.SeatCost,
+			MonthlyWaste: r.MonthlyWaste,
+		})
+	}
+	return rs
+}
+
+func ToOperationalServiceSpendReport(r *model.OperationalServiceSpendReport) *OperationalServiceSpendReport {
+	if r == nil {
+		return nil
+	}
+
+	rs := &OperationalServiceSpendReport{
+		Year:          r.Year,
+		Month:         r.Month,
+		Currency:      r.Currency,
+		Charged:       r.Charged,
+		Monthly:       r.Monthly,
+		Organizations: make([]OperationalServiceOrganizationSpend, 0, len(r.Organizations)),
+		Warnings:      r.Warnings,
+	}
+	for _, o := range r.Organizations {
+		org := OperationalServiceOrganizationSpend{
+			OrganizationID:   o.OrganizationID,
+			OrganizationName: o.OrganizationName,
+			Charged:          o.Charged,
+			Monthly:          o.Monthly,
+			Services:         make([]OperationalServiceSpend, 0, len(o.Services)),
+		}
+		for _, s := range o.Services {
+			org.Services = append(org.Services, OperationalServiceSpend{
+				ServiceID:    s.ServiceID,
+				Name:         s.Name,
+				Vendor:       s.Vendor,
+				Plan:         s.Plan,
+				CostCenter:   s.CostCenter,
+				BillingCycle: s.BillingCycle.String(),
+				Currency:     s.Currency,
+				Amount:       s.Amount,
+				Charged:      s.Charged,
+				Monthly:      s.Monthly,
+			})
+		}
+		rs.Organizations = append(rs.Organizations, org)
+	}
+	return rs
+}
+
+type OperationalServicesResponse struct {
+	PaginationResponse
+	Data []OperationalService `json:"data"`
+} // @name OperationalServicesResponse
+
+type OperationalServiceDetailResponse struct {
+	Data *OperationalServiceDetail `json:"data"`
+} // @name OperationalServiceDetailResponse
+
+type OperationalServiceSeatResponse struct {
+	Data *OperationalServiceSeat `json:"data"`
+} // @name OperationalServiceSeatResponse
+
+type OperationalServiceSeatReconciliationsResponse struct {
+	Data []OperationalServiceSeatReconciliation `json:"data"`
+} // @name OperationalServiceSeatReconciliationsResponse
+
+type OperationalServiceSpendReportResponse struct {
+	Data *OperationalServiceSpendReport `json:"data"`
+} // @name OperationalServiceSpendReportResponse
+
+type OperationalServicesListResponse struct {
+	Data []OperationalService `json:"data"`
+} // @name OperationalServicesListResponse
@@ -0,0 +1,218 @@
+package view
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ProjectBudget struct {
+	ID               string            `json:"id"`
+	Project          *BasicProjectInfo `json:"project"`
+	PONumber         string            `json:"poNumber"`
+	Name             string            `json:"name"`
+	TotalValue       decimal.Decimal   `json:"totalValue"`
+	Currency         string            `json:"currency"`
+	StartDate        time.Time         `json:"startDate"`
+	EndDate          *time.Time        `json:"endDate"`
+	Status           string            `json:"status"`
+	AlertThresholds  []int64           `json:"alertThresholds"` // empty when the configured ones apply
+	AlertedThreshold int64             `json:"alertedThreshold"`
+	Note             string            `json:"note"`
+} // @name ProjectBudget
+
+type ProjectBudgetMilestone struct {
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Amount    decimal.Decimal `json:"amount"`
+	DueDate   *time.Time      `json:"dueDate"`
+	InvoiceID string          `json:"invoiceID"`
+	Invoiced  bool            `json:"invoiced"`
+	Paid      bool            `json:"paid"`
+} // @name ProjectBudgetMilestone
+
+type ProjectBudgetDetail struct {
+	ProjectBudget
+	Milestones []ProjectBudgetMilestone `json:"milestones"`
+} // @name ProjectBudgetDetail
+
+type ProjectBudgetInvoice struct {
+	ID         string     `json:"id"`
+	Number     string     `json:"number"`
+	Year       int        `json:"year"`
+	Month      int        `json:"month"`
+	Status     string     `json:"status"`
+	Total      float64    `json:"total"`
+	Currency   string     `json:"currency"`
+	InvoicedAt *time.Time `json:"invoicedAt"`
+	PaidAt     *time.Time `json:"paidAt"`
+} // @name ProjectBudgetInvoice
+
+type ProjectBudgetMonth struct {
+	Month     string  `json:"month"` // YYYY-MM
+	Drawn     float64 `json:"drawn"`
+	Burned    float64 `json:"burned"`
+	Remaining float64 `json:"remaining"`
+	Projected bool    `json:"projected"` // drawn at the current team cost rather than invoiced
+} // @name ProjectBudgetMonth
+
+type ProjectBudgetReport struct {
+	Budget           ProjectBudget          `json:"budget"`
+	Currency         string                 `json:"currency"`
+	Total            float64                `json:"total"`
+	Issued           float64                `json:"issued"`
+	Paid             float64                `json:"paid"`
+	Remaining        float64                `json:"remaining"`
+	BurnPercent      float64                `json:"burnPercent"`
+	MonthlyTeamCost  float64                `json:"monthlyTeamCost"`
+	MonthsLeft       float64                `json:"monthsLeft"`  // -1 when the budget does not run out
+	ExhaustedAt      string                 `json:"exhaustedAt"` // YYYY-MM, empty when the budget does not run out
+	ProjectedAtEnd   float64                `json:"projectedAtEnd"`
+	ProjectedOverrun float64                `json:"projectedOverrun"`
+	Months           []ProjectBudgetMonth   `json:"months"`
+	Invoices         []ProjectBudgetInvoice `json:"invoices"`
+	Warnings         []string               `json:"warnings"`
+} // @name ProjectBudgetReport
+
+func ToProjectBudget(b *model.ProjectBudget) *ProjectBudget {
+	if b == nil {
+		return nil
+	}
+
+	rs := &ProjectBudget{
+		ID:               b.ID.String(),
+		PONumber:         b.PONumber,
+		Name:             b.Name,
+		TotalValue:       b.TotalValue,
+		StartDate:        b.StartDate,
+		EndDate:          b.EndDate,
+		Status:           b.Status.String(),
+		AlertThresholds:  []int64(b.AlertThresholds),
+		AlertedThreshold: b.AlertedThreshold,
+		Note:             b.Note,
+	}
+	if rs.AlertThresholds == nil {
+		rs.AlertThresholds = []int64{}
+	}
+	if b.Project != nil {
+		rs.Project = toBasicProjectInfo(*b.Project)
+	}
+	if b.Currency != nil {
+		rs.Currency = b.Currency.Name
+	}
+	return rs
+}
+
+func ToProjectBudgets(budgets []*model.ProjectBudget) []ProjectBudget {
+	rs := make([]ProjectBudget, 0, len(budgets))
+	for _, b := range budgets {
+		rs = append(rs, *ToProjectBudget(b))
+	}
+	return rs
+}
+
+func ToProjectBudgetDetail(b *model.ProjectBudget) *ProjectBudgetDetail {
+	if b == nil {
+		return nil
+	}
+
+	rs := &ProjectBudgetDetail{
+		ProjectBudget: *ToProjectBudget(b),
+		Milestones:    make([]ProjectBudgetMilestone, 0, len(b.Milestones)),
+	}
+	for _, m := range b.Milestones {
+		milestone := ProjectBudgetMilestone{
+			ID:      m.ID.String(),
+			Name:    m.Name,
+			Amount:  m.Amount,
+			DueDate: m.DueDate,
+		}
+		if m.InvoiceID != nil {
+			milestone.InvoiceID = m.InvoiceID.String()
+		}
+		if m.Invoice != nil {
+			milestone.Invoiced = m.Invoice.Status != model.InvoiceStatusDraft
+			milestone.Paid = m.Invoice.Status == model.InvoiceStatusPaid
+		}
+		rs.Milestones = append(rs.Milestones, milestone)
+	}
+	return rs
+}
+
+func ToProjectBudgetReport(r *model.ProjectBudgetReport) *ProjectBudgetReport {
+	if r == nil {
+		return nil
+	}
+
+	rs := &ProjectBudgetReport{
+		Budget:           *ToProjectBudget(r.Budget),
+		Currency:         r.Currency,
+		Total:            r.Total,
+		Issued:           r.Issued,
+		Paid:             r.Paid,
+		Remaining:        r.Remaining,
+		BurnPercent:      r.BurnPercent,
+		MonthlyTeamCost:  r.MonthlyTeamCost,
+		MonthsLeft:       r.MonthsLeft,
+		ProjectedAtEnd:   r.ProjectedAtEnd,
+		ProjectedOverrun: r.ProjectedOverrun,
+		Months:           make([]ProjectBudgetMonth, 0, len(r.Months)),
+		Invoices:         make([]ProjectBudgetInvoice, 0, len(r.Invoices)),
+		Warnings:         r.Warnings,
+	}
+	if r.ExhaustedAt != nil {
+		rs.ExhaustedAt = r.ExhaustedAt.Format("2006-01")
+	}
+	if rs.Warnings == nil {
+		rs.Warnings = []string{}
+	}
+	for _, m := range r.Months {
+		rs.Months = append(rs.Months, ProjectBudgetMonth{
+			Month:     m.Month.Format("2006-01"),
+			Drawn:     m.Drawn,
+			Burned:    m.Burned,
+			Remaining: m.Remaining,
+			Projected: m.Projected,
+		})
+	}
+	for _, iv := range r.Invoices {
+		rs.Invoices = append(rs.Invoices, ProjectBudgetInvoice{
+			ID:         iv.ID,
+			Number:     iv.Number,
+			Year:       iv.Year,
+			Month:      iv.Month,
+			Status:     iv.Status.String(),
+			Total:      iv.Total,
+			Currency:   iv.Currency,
+			InvoicedAt: iv.InvoicedAt,
+			PaidAt:     iv.PaidAt,
+		})
+	}
+	return rs
+}
+
+func ToProjectBudgetReports(reports []*model.ProjectBudgetReport) []ProjectBudgetReport {
+	rs := make([]ProjectBudgetReport, 0, len(reports))
+	for _, r := range reports {
+		rs = append(rs, *ToProjectBudgetReport(r))
+	}
+	return rs
+}
+
+type ProjectBudgetsResponse struct {
+	Data []ProjectBudget `json:"data"`
+} // @name ProjectBudgetsResponse
+
+type ProjectBudgetDetailResponse struct {
+	Data *ProjectBudgetDetail `json:"data"`
+} // @name ProjectBudgetDetailResponse
+
+type ProjectBudgetReportResponse struct {
+	Data *ProjectBudgetReport `json:"data"`
+} // @name ProjectBudgetReportResponse
+
+type ProjectBudgetReportsResponse struct {
+	Data []ProjectBudgetReport `json:"data"`
+} // @name ProjectBudgetReportsResponse
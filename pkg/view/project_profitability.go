@@ -0,0 +1,104 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ProjectProfitabilityMonth struct {
+	Month                string  `json:"month,omitempty"` // YYYY-MM, empty on the total
+	Revenue              float64 `json:"revenue"`
+	SalaryCost           float64 `json:"salaryCost"`
+	ContractorCost       float64 `json:"contractorCost"`
+	CommissionCost       float64 `json:"commissionCost"`
+	TotalCost            float64 `json:"totalCost"`
+	GrossMargin          float64 `json:"grossMargin"`
+	GrossMarginPercent   float64 `json:"grossMarginPercent"`
+	AllocatedFTE         float64 `json:"allocatedFTE"`
+	BillableFTE          float64 `json:"billableFTE"`
+	EffectiveBillingRate float64 `json:"effectiveBillingRate"`
+} // @name ProjectProfitabilityMonth
+
+type ProjectProfitabilityTrend struct {
+	Direction    string  `json:"direction"`
+	MarginSlope  float64 `json:"marginSlope"`
+	MarginChange float64 `json:"marginChange"`
+} // @name ProjectProfitabilityTrend
+
+type ProjectProfitability struct {
+	ProjectID   string                      `json:"projectID"`
+	ProjectName string                      `json:"projectName"`
+	Months      []ProjectProfitabilityMonth `json:"months"`
+	Total       ProjectProfitabilityMonth   `json:"total"`
+	Trend       ProjectProfitabilityTrend   `json:"trend"`
+} // @name ProjectProfitability
+
+type ProjectProfitabilityReport struct {
+	From     string                 `json:"from"` // YYYY-MM
+	To       string                 `json:"to"`   // YYYY-MM
+	Currency string                 `json:"currency"`
+	Projects []ProjectProfitability `json:"projects"`
+	Warnings []string               `json:"warnings"`
+} // @name ProjectProfitabilityReport
+
+func ToProjectProfitabilityReport(r *model.ProjectProfitabilityReport) *ProjectProfitabilityReport {
+	if r == nil {
+		return nil
+	}
+
+	rs := &ProjectProfitabilityReport{
+		From:     r.From.Format("2006-01"),
+		To:       r.To.Format("2006-01"),
+		Currency: r.Currency,
+		Projects: make([]ProjectProfitability, 0, len(r.Projects)),
+		Warnings: r.Warnings,
+	}
+	if rs.Warnings == nil {
+		rs.Warnings = []string{}
+	}
+
+	for _, p := range r.Projects {
+		months := make([]ProjectProfitabilityMonth, 0, len(p.Months))
+		for _, m := range p.Months {
+			months = append(months, toProjectProfitabilityMonth(m, m.Month))
+		}
+
+		rs.Projects = append(rs.Projects, ProjectProfitability{
+			ProjectID:   p.ProjectID,
+			ProjectName: p.ProjectName,
+			Months:      months,
+			Total:       toProjectProfitabilityMonth(p.Total, time.Time{}),
+			Trend: ProjectProfitabilityTrend{
+				Direction:    p.Trend.Direction.String(),
+				MarginSlope:  p.Trend.MarginSlope,
+				MarginChange: p.Trend.MarginChange,
+			},
+		})
+	}
+
+	return rs
+}
+
+func toProjectProfitabilityMonth(m model.ProjectProfitabilityMonth, month time.Time) ProjectProfitabilityMonth {
+	rs := ProjectProfitabilityMonth{
+		Revenue:              m.Revenue,
+		SalaryCost:           m.SalaryCost,
+		ContractorCost:       m.ContractorCost,
+		CommissionCost:       m.CommissionCost,
+		TotalCost:            m.TotalCost,
+		GrossMargin:          m.GrossMargin,
+		GrossMarginPercent:   m.GrossMarginPercent,
+		AllocatedFTE:         m.AllocatedFTE,
+		BillableFTE:          m.BillableFTE,
+		EffectiveBillingRate: m.EffectiveBillingRate,
+	}
+	if !month.IsZero() {
+		rs.Month = month.Format("2006-01")
+	}
+	return rs
+}
+
+type ProjectProfitabilityReportResponse struct {
+	Data *ProjectProfitabilityReport `json:"data"`
+} // @name ProjectProfitabilityReportResponse
@@ -0,0 +1,248 @@
+package view
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/recruitment"
+)
+
+type RecruitmentStage struct {
+	ID              string  `json:"id"`
+	PositionID      *string `json:"positionID"`
+	Code            string  `json:"code"`
+	Name            string  `json:"name"`
+	SortOrder       int     `json:"sortOrder"`
+	CandidateStatus string  `json:"candidateStatus"`
+	EmailTemplate   string  `json:"emailTemplate"`
+	IsTerminal      bool    `json:"isTerminal"`
+} // @name RecruitmentStage
+
+func ToRecruitmentStage(s *model.RecruitmentStage) *RecruitmentStage {
+	if s == nil {
+		return nil
+	}
+	return &RecruitmentStage{
+		ID:              s.ID.String(),
+		PositionID:      uuidPtrToString(s.PositionID),
+		Code:            s.Code,
+		Name:            s.Name,
+		SortOrder:       s.SortOrder,
+		CandidateStatus: s.CandidateStatus.String(),
+		EmailTemplate:   s.EmailTemplate,
+		IsTerminal:      s.IsTerminal,
+	}
+}
+
+func ToRecruitmentStages(stages []*model.RecruitmentStage) []RecruitmentStage {
+	rs := make([]RecruitmentStage, 0, len(stages))
+	for _, s := range stages {
+		rs = append(rs, *ToRecruitmentStage(s))
+	}
+	return rs
+}
+
+type Candidate struct {
+	ID                string              `json:"id"`
+	Name              string              `json:"name"`
+	Email             string              `json:"email"`
+	Phone             string              `json:"phone"`
+	Role              string              `json:"role"`
+	Source            string              `json:"source"`
+	Type              string              `json:"type"`
+	Status            string              `json:"status"`
+	Detail            string              `json:"detail"`
+	CVUrl             string              `json:"cvUrl"`
+	CCAT              int                 `json:"ccat"`
+	EPP               int                 `json:"epp"`
+	IsReferral        bool                `json:"isReferral"`
+	ReferralInfo      *model.ReferralInfo `json:"referralInfo"`
+	OfferSalary       int                 `json:"offerSalary"`
+	OfferStartDate    *time.Time          `json:"offerStartDate"`
+	ProbationDuration int                 `json:"probationDuration"`
+	IsEmailSent       bool                `json:"isEmailSent"`
+	EmployeeID        *string             `json:"employeeID"`
+	Position          *Position           `json:"position"`
+	Stage             *RecruitmentStage   `json:"stage"`
+	CreatedAt         time.Time           `json:"createdAt"`
+} // @name Candidate
+
+func ToCandidate(c *model.Candidate) *Candidate {
+	rs := &Candidate{
+		ID:                c.ID.String(),
+		Name:              c.Name,
+		Email:             c.Email,
+		Phone:             c.Phone,
+		Role:              c.Role,
+		Source:            c.Source,
+		Type:              string(c.Type),
+		Status:            c.Status.String(),
+		Detail:            c.Detail,
+		CVUrl:             c.CVUrl,
+		CCAT:              c.CCAT,
+		EPP:               c.EPP,
+		IsReferral:        c.IsReferral,
+		OfferSalary:       c.OfferSalary,
+		OfferStartDate:    c.OfferStartDate,
+		ProbationDuration: c.ProbationDuration,
+		IsEmailSent:       c.IsEmailSent,
+		EmployeeID:        uuidPtrToString(c.EmployeeID),
+		Position:          ToPosition(c.Position),
+		Stage:             ToRecruitmentStage(c.Stage),
+		CreatedAt:         c.CreatedAt,
+	}
+
+	if len(c.ReferralInfo) > 0 {
+		var info model.ReferralInfo
+		if err := json.Unmarshal(c.ReferralInfo, &info); err == nil {
+			rs.ReferralInfo = &info
+		}
+	}
+	return rs
+}
+
+func ToCandidates(candidates []*model.Candidate) []Candidate {
+	rs := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		rs = append(rs, *ToCandidate(c))
+	}
+	return rs
+}
+
+type CandidateStageTransition struct {
+	ID            string             `json:"id"`
+	FromStage     *RecruitmentStage  `json:"fromStage"`
+	ToStage       *RecruitmentStage  `json:"toStage"`
+	Note          string             `json:"note"`
+	Actor         *BasicEmployeeInfo `json:"actor"`
+	EmailTemplate string             `json:"emailTemplate"`
+	EmailSentAt   *time.Time         `json:"emailSentAt"`
+	EmailError    string             `json:"emailError"`
+	CreatedAt     time.Time          `json:"createdAt"`
+} // @name CandidateStageTransition
+
+func ToCandidateStageTransition(t *model.CandidateStageTransition) *CandidateStageTransition {
+	rs := &CandidateStageTransition{
+		ID:            t.ID.String(),
+		FromStage:     ToRecruitmentStage(t.FromStage),
+		ToStage:       ToRecruitmentStage(t.ToStage),
+		Note:          t.Note,
+		EmailTemplate: t.EmailTemplate,
+		EmailSentAt:   t.EmailSentAt,
+		EmailError:    t.EmailError,
+		CreatedAt:     t.CreatedAt,
+	}
+	if t.Actor != nil {
+		rs.Actor = toBasicEmployeeInfo(*t.Actor)
+	}
+	return rs
+}
+
+func ToCandidateStageTransitions(transitions []*model.CandidateStageTransition) []CandidateStageTransition {
+	rs := make([]CandidateStageTransition, 0, len(transitions))
+	for _, t := range transitions {
+		rs = append(rs, *ToCandidateStageTransition(t))
+	}
+	return rs
+}
+
+type CandidateInterview struct {
+	ID             string             `json:"id"`
+	StageID        *string            `json:"stageID"`
+	Interviewer    *BasicEmployeeInfo `json:"interviewer"`
+	ScheduledAt    *time.Time         `json:"scheduledAt"`
+	Scores         map[string]int     `json:"scores"`
+	OverallScore   *float64           `json:"overallScore"`
+	Recommendation string             `json:"recommendation"`
+	Feedback       string             `json:"feedback"`
+	SubmittedAt    *time.Time         `json:"submittedAt"`
+} // @name CandidateInterview
+
+func ToCandidateInterview(i *model.CandidateInterview) *CandidateInterview {
+	rs := &CandidateInterview{
+		ID:             i.ID.String(),
+		StageID:        uuidPtrToString(i.StageID),
+		ScheduledAt:    i.ScheduledAt,
+		OverallScore:   i.OverallScore,
+		Recommendation: i.Recommendation.String(),
+		Feedback:       i.Feedback,
+		SubmittedAt:    i.SubmittedAt,
+	}
+	if i.Interviewer != nil {
+		rs.Interviewer = toBasicEmployeeInfo(*i.Interviewer)
+	}
+	if len(i.Scores) > 0 {
+		_ = json.Unmarshal(i.Scores, &rs.Scores)
+	}
+	return rs
+}
+
+type CandidateScorecardSummary struct {
+	Interviews      int            `json:"interviews"`
+	Submitted       int            `json:"submitted"`
+	AverageScore    *float64       `json:"averageScore"`
+	Recommendations map[string]int `json:"recommendations"`
+} // @name CandidateScorecardSummary
+
+type CandidateDetail struct {
+	Candidate
+	Transitions []CandidateStageTransition `json:"transitions"`
+	Interviews  []CandidateInterview       `json:"interviews"`
+	Scorecard   CandidateScorecardSummary  `json:"scorecard"`
+} // @name CandidateDetail
+
+func ToCandidateDetail(c *model.Candidate) *CandidateDetail {
+	rs := &CandidateDetail{
+		Candidate:   *ToCandidate(c),
+		Transitions: make([]CandidateStageTransition, 0, len(c.Transitions)),
+		Interviews:  make([]CandidateInterview, 0, len(c.Interviews)),
+	}
+	for i := range c.Transitions {
+		rs.Transitions = append(rs.Transitions, *ToCandidateStageTransition(&c.Transitions[i]))
+	}
+	for i := range c.Interviews {
+		rs.Interviews = append(rs.Interviews, *ToCandidateInterview(&c.Interviews[i]))
+	}
+
+	summary := recruitment.Summarize(c.Interviews)
+	rs.Scorecard = CandidateScorecardSummary{
+		Interviews:      summary.Interviews,
+		Submitted:       summary.Submitted,
+		AverageScore:    summary.AverageScore,
+		Recommendations: make(map[string]int, len(summary.Recommendations)),
+	}
+	for r, count := range summary.Recommendations {
+		rs.Scorecard.Recommendations[r.String()] = count
+	}
+	return rs
+}
+
+type RecruitmentStageResponse struct {
+	Data *RecruitmentStage `json:"data"`
+} // @name RecruitmentStageResponse
+
+type RecruitmentStagesResponse struct {
+	Data []RecruitmentStage `json:"data"`
+} // @name RecruitmentStagesResponse
+
+type CandidatesResponse struct {
+	PaginationResponse
+	Data []Candidate `json:"data"`
+} // @name CandidatesResponse
+
+type CandidateDetailResponse struct {
+	Data *CandidateDetail `json:"data"`
+} // @name CandidateDetailResponse
+
+type CandidateStageTransitionResponse struct {
+	Data *CandidateStageTransition `json:"data"`
+} // @name CandidateStageTransitionResponse
+
+type CandidateStageTransitionsResponse struct {
+	Data []CandidateStageTransition `json:"data"`
+} // @name CandidateStageTransitionsResponse
+
+type CandidateInterviewResponse struct {
+	Data *CandidateInterview `json:"data"`
+} // @name CandidateInterviewResponse
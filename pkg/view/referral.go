@@ -0,0 +1,162 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ReferralBonusMilestone struct {
+	ID           string `json:"id"`
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	Trigger      string `json:"trigger"`
+	TenureMonths int    `json:"tenureMonths"`
+	Amount       int64  `json:"amount"`
+	IsActive     bool   `json:"isActive"`
+	SortOrder    int    `json:"sortOrder"`
+} // @name ReferralBonusMilestone
+
+func ToReferralBonusMilestone(m *model.ReferralBonusMilestone) *ReferralBonusMilestone {
+	if m == nil {
+		return nil
+	}
+	return &ReferralBonusMilestone{
+		ID:           m.ID.String(),
+		Code:         m.Code,
+		Name:         m.Name,
+		Trigger:      m.Trigger.String(),
+		TenureMonths: m.TenureMonths,
+		Amount:       int64(m.Amount),
+		IsActive:     m.IsActive,
+		SortOrder:    m.SortOrder,
+	}
+}
+
+func ToReferralBonusMilestones(milestones []*model.ReferralBonusMilestone) []ReferralBonusMilestone {
+	rs := make([]ReferralBonusMilestone, 0, len(milestones))
+	for _, m := range milestones {
+		rs = append(rs, *ToReferralBonusMilestone(m))
+	}
+	return rs
+}
+
+type ReferralBonus struct {
+	ID            string     `json:"id"`
+	MilestoneID   string     `json:"milestoneID"`
+	MilestoneName string     `json:"milestoneName"`
+	Amount        int64      `json:"amount"`
+	Status        string     `json:"status"`
+	ReachedAt     time.Time  `json:"reachedAt"`
+	PaidAt        *time.Time `json:"paidAt"`
+} // @name ReferralBonus
+
+type Referral struct {
+	ID                string             `json:"id"`
+	Referrer          *BasicEmployeeInfo `json:"referrer"`
+	CandidateID       *string            `json:"candidateID"`
+	CandidateName     string             `json:"candidateName"`
+	CandidateEmail    string             `json:"candidateEmail"`
+	Role              string             `json:"role"`
+	Status            string             `json:"status"`
+	Employee          *BasicEmployeeInfo `json:"employee"`
+	HiredAt           *time.Time         `json:"hiredAt"`
+	ProbationPassedAt *time.Time         `json:"probationPassedAt"`
+	LeftAt            *time.Time         `json:"leftAt"`
+	Bonuses           []ReferralBonus    `json:"bonuses"`
+	TotalBonus        int64              `json:"totalBonus"` // scheduled and paid
+	PaidBonus         int64              `json:"paidBonus"`
+	CreatedAt         time.Time          `json:"createdAt"`
+} // @name Referral
+
+func ToReferral(r *model.Referral) *Referral {
+	if r == nil {
+		return nil
+	}
+
+	rs := &Referral{
+		ID:                r.ID.String(),
+		CandidateID:       uuidPtrToString(r.CandidateID),
+		CandidateName:     r.CandidateName,
+		CandidateEmail:    r.CandidateEmail,
+		Role:              r.Role,
+		Status:            r.Status.String(),
+		HiredAt:           r.HiredAt,
+		ProbationPassedAt: r.ProbationPassedAt,
+		LeftAt:            r.LeftAt,
+		Bonuses:           make([]ReferralBonus, 0, len(r.Bonuses)),
+		CreatedAt:         r.CreatedAt,
+	}
+	if r.Referrer != nil {
+		rs.Referrer = toBasicEmployeeInfo(*r.Referrer)
+	}
+	if r.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*r.Employee)
+	}
+
+	for _, b := range r.Bonuses {
+		bonus := toReferralBonus(b)
+		switch b.Status {
+		case model.ReferralBonusStatusPaid:
+			rs.PaidBonus += bonus.Amount
+			rs.TotalBonus += bonus.Amount
+		case model.ReferralBonusStatusScheduled:
+			rs.TotalBonus += bonus.Amount
+		}
+		rs.Bonuses = append(rs.Bonuses, bonus)
+	}
+
+	return rs
+}
+
+func ToReferrals(referrals []*model.Referral) []Referral {
+	rs := make([]Referral, 0, len(referrals))
+	for _, r := range referrals {
+		rs = append(rs, *ToReferral(r))
+	}
+	return rs
+}
+
+type ReferralBonusMilestoneResponse struct {
+	Data *ReferralBonusMilestone `json:"data"`
+} // @name ReferralBonusMilestoneResponse
+
+type ReferralBonusMilestonesResponse struct {
+	Data []ReferralBonusMilestone `json:"data"`
+} // @name ReferralBonusMilestonesResponse
+
+type ReferralResponse struct {
+	Data *Referral `json:"data"`
+} // @name ReferralResponse
+
+type ReferralsResponse struct {
+	PaginationResponse
+	Data []Referral `json:"data"`
+} // @name ReferralsResponse
+
+type ReferralBonusesResponse struct {
+	Data []ReferralBonus `json:"data"`
+} // @name ReferralBonusesResponse
+
+func ToReferralBonuses(bonuses []*model.ReferralBonus) []ReferralBonus {
+	rs := make([]ReferralBonus, 0, len(bonuses))
+	for _, b := range bonuses {
+		rs = append(rs, toReferralBonus(*b))
+	}
+	return rs
+}
+
+func toReferralBonus(b model.ReferralBonus) ReferralBonus {
+	rs := ReferralBonus{
+		ID:          b.ID.String(),
+		MilestoneID: b.MilestoneID.String(),
+		Amount:      int64(b.Amount),
+		Status:      b.Status.String(),
+		ReachedAt:   b.ReachedAt,
+		PaidAt:      b.PaidAt,
+	}
+	if b.Milestone != nil {
+		rs.MilestoneName = b.Milestone.Name
+	}
+	return rs
+}
@@ -0,0 +1,215 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type ReviewCycle struct {
+	ID                   string         `json:"id"`
+	Name                 string         `json:"name"`
+	PeriodFrom           time.Time      `json:"periodFrom"`
+	PeriodTo             time.Time      `json:"periodTo"`
+	Status               string         `json:"status"`
+	SelfReviewEventID    *string        `json:"selfReviewEventID"`
+	ManagerReviewEventID *string        `json:"managerReviewEventID"`
+	CreatedBy            string         `json:"createdBy"`
+	CreatedAt            time.Time      `json:"createdAt"`
+	Packets              []ReviewPacket `json:"packets,omitempty"`
+} // @name ReviewCycle
+
+type ReviewPacket struct {
+	ID                   string             `json:"id"`
+	CycleID              string             `json:"cycleID"`
+	Employee             *BasicEmployeeInfo `json:"employee"`
+	Manager              *BasicEmployeeInfo `json:"manager"`
+	SelfReviewTopicID    *string            `json:"selfReviewTopicID"`
+	ManagerReviewTopicID *string            `json:"managerReviewTopicID"`
+	Status               string             `json:"status"`
+	Evidence             *ReviewEvidence    `json:"evidence"`
+	SuggestedRating      int                `json:"suggestedRating"`
+	SuggestedScore       float64            `json:"suggestedScore"`
+	FinalRating          int                `json:"finalRating"`
+	CurrentSeniority     *Seniority         `json:"currentSeniority"`
+	ProposedSeniority    *Seniority         `json:"proposedSeniority"`
+	CalibrationNote      string             `json:"calibrationNote"`
+	CalibratedBy         *string            `json:"calibratedBy"`
+	CalibratedAt         *time.Time         `json:"calibratedAt"`
+	ApprovedBy           *string            `json:"approvedBy"` // who approved or rejected the calibration
+	ApprovedAt           *time.Time         `json:"approvedAt"`
+} // @name ReviewPacket
+
+// ReviewEvidence is the evidence of the period collected when the cycle went to calibration, a
+// missing piece is null
+type ReviewEvidence struct {
+	SelfReview    *ReviewFeedback `json:"selfReview"`
+	ManagerReview *ReviewFeedback `json:"managerReview"`
+	PeerReview    *ReviewFeedback `json:"peerReview"`
+	MMA           *ReviewMMA      `json:"mma"`
+	Delivery      *ReviewDelivery `json:"delivery"`
+	Audits        *ReviewAudits   `json:"audits"`
+	Signals       []ReviewSignal  `json:"signals"`
+	CollectedAt   time.Time       `json:"collectedAt"`
+} // @name ReviewEvidence
+
+type ReviewFeedback struct {
+	Average   float64         `json:"average"` // of the likert-scale answers, 1 to 5
+	Answers   int             `json:"answers"`
+	Reviewers int             `json:"reviewers"`
+	Comments  []ReviewComment `json:"comments"`
+} // @name ReviewFeedback
+
+type ReviewComment struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+} // @name ReviewComment
+
+type ReviewMMA struct {
+	Mastery  float64    `json:"mastery"`
+	Autonomy float64    `json:"autonomy"`
+	Meaning  float64    `json:"meaning"`
+	RatedAt  *time.Time `json:"ratedAt"`
+} // @name ReviewMMA
+
+type ReviewDelivery struct {
+	Weight        float64 `json:"weight"`
+	Effort        float64 `json:"effort"`
+	Effectiveness float64 `json:"effectiveness"`
+	Percentile    float64 `json:"percentile"` // share of the employees who delivered less weight
+} // @name ReviewDelivery
+
+type ReviewAudits struct {
+	Count        int     `json:"count"`
+	AverageScore float64 `json:"averageScore"`
+} // @name ReviewAudits
+
+type ReviewSignal struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+	Score  float64 `json:"score"`
+	Reason string  `json:"reason"`
+} // @name ReviewSignal
+
+type ReviewCycleResponse struct {
+	Data ReviewCycle `json:"data"`
+} // @name ReviewCycleResponse
+
+type ReviewCyclesResponse struct {
+	Data []ReviewCycle `json:"data"`
+} // @name ReviewCyclesResponse
+
+type ReviewPacketResponse struct {
+	Data ReviewPacket `json:"data"`
+} // @name ReviewPacketResponse
+
+func ToReviewCycle(c *model.ReviewCycle) ReviewCycle {
+	rs := ReviewCycle{
+		ID:                   c.ID.String(),
+		Name:                 c.Name,
+		PeriodFrom:           c.PeriodFrom,
+		PeriodTo:             c.PeriodTo,
+		Status:               c.Status.String(),
+		SelfReviewEventID:    uuidPtrToString(c.SelfReviewEventID),
+		ManagerReviewEventID: uuidPtrToString(c.ManagerReviewEventID),
+		CreatedBy:            c.CreatedBy.String(),
+		CreatedAt:            c.CreatedAt,
+	}
+	for _, p := range c.Packets {
+		rs.Packets = append(rs.Packets, ToReviewPacket(p))
+	}
+	return rs
+}
+
+func ToReviewCycles(cycles []*model.ReviewCycle) []ReviewCycle {
+	rs := make([]ReviewCycle, 0, len(cycles))
+	for _, c := range cycles {
+		rs = append(rs, ToReviewCycle(c))
+	}
+	return rs
+}
+
+func ToReviewPacket(p *model.ReviewPacket) ReviewPacket {
+	rs := ReviewPacket{
+		ID:                   p.ID.String(),
+		CycleID:              p.CycleID.String(),
+		SelfReviewTopicID:    uuidPtrToString(p.SelfReviewTopicID),
+		ManagerReviewTopicID: uuidPtrToString(p.ManagerReviewTopicID),
+		Status:               p.Status.String(),
+		SuggestedRating:      p.SuggestedRating,
+		SuggestedScore:       p.SuggestedScore,
+		FinalRating:          p.FinalRating,
+		CalibrationNote:      p.CalibrationNote,
+		CalibratedBy:         uuidPtrToString(p.CalibratedBy),
+		CalibratedAt:         p.CalibratedAt,
+		ApprovedBy:           uuidPtrToString(p.ApprovedBy),
+		ApprovedAt:           p.ApprovedAt,
+	}
+	if p.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*p.Employee)
+	}
+	if p.Manager != nil {
+		rs.Manager = toBasicEmployeeInfo(*p.Manager)
+	}
+	if p.CurrentSeniority != nil {
+		s := ToSeniority(*p.CurrentSeniority)
+		rs.CurrentSeniority = &s
+	}
+	if p.ProposedSeniority != nil {
+		s := ToSeniority(*p.ProposedSeniority)
+		rs.ProposedSeniority = &s
+	}
+	if p.Evidence != nil {
+		rs.Evidence = toReviewEvidence(p.Evidence)
+	}
+	return rs
+}
+
+func toReviewEvidence(e *model.ReviewEvidence) *ReviewEvidence {
+	rs := &ReviewEvidence{
+		SelfReview:    toReviewFeedback(e.SelfReview),
+		ManagerReview: toReviewFeedback(e.ManagerReview),
+		PeerReview:    toReviewFeedback(e.PeerReview),
+		Signals:       make([]ReviewSignal, 0, len(e.Signals)),
+		CollectedAt:   e.CollectedAt,
+	}
+	if e.MMA != nil {
+		rs.MMA = &ReviewMMA{
+			Mastery:  e.MMA.Mastery,
+			Autonomy: e.MMA.Autonomy,
+			Meaning:  e.MMA.Meaning,
+			RatedAt:  e.MMA.RatedAt,
+		}
+	}
+	if e.Delivery != nil {
+		rs.Delivery = &ReviewDelivery{
+			Weight:        e.Delivery.Weight,
+			Effort:        e.Delivery.Effort,
+			Effectiveness: e.Delivery.Effectiveness,
+			Percentile:    e.Delivery.Percentile,
+		}
+	}
+	if e.Audits != nil {
+		rs.Audits = &ReviewAudits{Count: e.Audits.Count, AverageScore: e.Audits.AverageScore}
+	}
+	for _, s := range e.Signals {
+		rs.Signals = append(rs.Signals, ReviewSignal{Name: s.Name, Weight: s.Weight, Score: s.Score, Reason: s.Reason})
+	}
+	return rs
+}
+
+func toReviewFeedback(f *model.ReviewFeedback) *ReviewFeedback {
+	if f == nil {
+		return nil
+	}
+	rs := &ReviewFeedback{
+		Average:   f.Average,
+		Answers:   f.Answers,
+		Reviewers: f.Reviewers,
+		Comments:  make([]ReviewComment, 0, len(f.Comments)),
+	}
+	for _, c := range f.Comments {
+		rs.Comments = append(rs.Comments, ReviewComment{Question: c.Question, Answer: c.Answer})
+	}
+	return rs
+}
@@ -0,0 +1,112 @@
+package view
+
+import (
+	"time"
+
+	"github.com/dwarvesf/fortress-api/pkg/staffing"
+)
+
+type RecommendationFactor struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+	Score  float64 `json:"score"` // from 0 to 1
+	Reason string  `json:"reason"`
+} // @name RecommendationFactor
+
+type SlotCandidate struct {
+	Rank           int                    `json:"rank"`
+	EmployeeID     string                 `json:"employeeID"`
+	Name           string                 `json:"name"`
+	Seniority      string                 `json:"seniority"`
+	Stacks         []string               `json:"stacks"`
+	Positions      []string               `json:"positions"`
+	Chapters       []string               `json:"chapters"`
+	ClientProjects []string               `json:"clientProjects"`
+	Utilization    float64                `json:"utilization"`   // the share of the current week allocated
+	AvailableFrom  time.Time              `json:"availableFrom"` // the first week free for the slot
+	Score          float64                `json:"score"`         // from 0 to 100
+	Factors        []RecommendationFactor `json:"factors"`
+} // @name SlotCandidate
+
+type RecommendedSlot struct {
+	ID             string   `json:"id"`
+	ProjectID      string   `json:"projectID"`
+	ProjectName    string   `json:"projectName"`
+	ClientName     string   `json:"clientName"`
+	Seniority      string   `json:"seniority"`
+	DeploymentType string   `json:"deploymentType"`
+	Status         string   `json:"status"`
+	Positions      []string `json:"positions"`
+	Stacks         []string `json:"stacks"`
+	EmployeeID     string   `json:"employeeID"` // the member on the slot, empty while it is open
+} // @name RecommendedSlot
+
+type SlotRecommendations struct {
+	Slot       RecommendedSlot `json:"slot"`
+	From       time.Time       `json:"from"`
+	Weeks      int             `json:"weeks"`
+	Candidates []SlotCandidate `json:"candidates"`
+} // @name SlotRecommendations
+
+type SlotRecommendationsResponse struct {
+	Data *SlotRecommendations `json:"data"`
+} // @name SlotRecommendationsResponse
+
+func ToSlotRecommendations(r *staffing.Shortlist) *SlotRecommendations {
+	if r == nil {
+		return nil
+	}
+
+	res := &SlotRecommendations{
+		Slot: RecommendedSlot{
+			ID:             r.Slot.ID,
+			ProjectID:      r.Slot.ProjectID,
+			ProjectName:    r.Slot.ProjectName,
+			ClientName:     r.Slot.ClientName,
+			Seniority:      r.Slot.SeniorityName,
+			DeploymentType: r.Slot.DeploymentType,
+			Status:         r.Slot.Status,
+			Positions:      orEmpty(r.Slot.Positions),
+			Stacks:         orEmpty(r.Slot.Stacks),
+			EmployeeID:     r.Slot.EmployeeID,
+		},
+		From:       r.From,
+		Weeks:      r.Weeks,
+		Candidates: make([]SlotCandidate, 0, len(r.Candidates)),
+	}
+
+	for _, c := range r.Candidates {
+		factors := make([]RecommendationFactor, 0, len(c.Factors))
+		for _, f := range c.Factors {
+			factors = append(factors, RecommendationFactor{
+				Name:   f.Name,
+				Weight: f.Weight,
+				Score:  f.Score,
+				Reason: f.Reason,
+			})
+		}
+		res.Candidates = append(res.Candidates, SlotCandidate{
+			Rank:           c.Rank,
+			EmployeeID:     c.EmployeeID,
+			Name:           c.Name,
+			Seniority:      c.SeniorityName,
+			Stacks:         orEmpty(c.Stacks),
+			Positions:      orEmpty(c.Positions),
+			Chapters:       orEmpty(c.Chapters),
+			ClientProjects: orEmpty(c.ClientProjects),
+			Utilization:    c.Utilization,
+			AvailableFrom:  c.AvailableFrom,
+			Score:          c.Score,
+			Factors:        factors,
+		})
+	}
+
+	return res
+}
+
+func orEmpty(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,166 @@
+package view
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+	"github.com/dwarvesf/fortress-api/pkg/surveyanalytics"
+)
+
+// A suppressed question, cycle or team was answered by fewer respondents than minGroupSize, its
+// numbers are left out
+type SurveyAnalytics struct {
+	EventID       string                       `json:"eventID"`
+	Title         string                       `json:"title"`
+	Subtype       string                       `json:"subtype"`
+	StartDate     *time.Time                   `json:"startDate"`
+	MinGroupSize  int                          `json:"minGroupSize"`
+	GroupBy       string                       `json:"groupBy"`
+	Distributions []SurveyQuestionDistribution `json:"distributions"`
+	Comparisons   []SurveyQuestionComparison   `json:"comparisons"`
+} // @name SurveyAnalytics
+
+type SurveyQuestionDistribution struct {
+	QuestionID  string          `json:"questionID"`
+	Content     string          `json:"content"`
+	Respondents int             `json:"respondents"`
+	Answers     int             `json:"answers"`
+	Counts      map[string]int  `json:"counts"` // answers per level, 1 strongly disagree to 5 strongly agree
+	Average     decimal.Decimal `json:"average"`
+	Suppressed  bool            `json:"suppressed"`
+} // @name SurveyQuestionDistribution
+
+type SurveyQuestionComparison struct {
+	QuestionID string            `json:"questionID"`
+	Content    string            `json:"content"`
+	Groups     []SurveyTeamScore `json:"groups"`
+} // @name SurveyQuestionComparison
+
+type SurveyTeamScore struct {
+	Name        string          `json:"name"`
+	Respondents int             `json:"respondents"`
+	Average     decimal.Decimal `json:"average"`
+	Suppressed  bool            `json:"suppressed"`
+} // @name SurveyTeamScore
+
+type SurveyQuestionTrend struct {
+	QuestionID string             `json:"questionID"`
+	Content    string             `json:"content"`
+	Cycles     []SurveyCycleScore `json:"cycles"`
+} // @name SurveyQuestionTrend
+
+type SurveyCycleScore struct {
+	EventID     string          `json:"eventID"`
+	Title       string          `json:"title"`
+	StartDate   time.Time       `json:"startDate"`
+	Respondents int             `json:"respondents"`
+	Average     decimal.Decimal `json:"average"`
+	Suppressed  bool            `json:"suppressed"`
+} // @name SurveyCycleScore
+
+type SurveyThemeSummary struct {
+	ID          string     `json:"id"`
+	QuestionID  string     `json:"questionID"`
+	Content     string     `json:"content"`
+	Summary     string     `json:"summary"` // markdown bullet points
+	AnswerCount int        `json:"answerCount"`
+	Model       string     `json:"model"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+} // @name SurveyThemeSummary
+
+type SurveyAnalyticsResponse struct {
+	Data SurveyAnalytics `json:"data"`
+} // @name SurveyAnalyticsResponse
+
+type SurveyTrendsResponse struct {
+	Data []SurveyQuestionTrend `json:"data"`
+} // @name SurveyTrendsResponse
+
+type SurveyThemeSummariesResponse struct {
+	Data []SurveyThemeSummary `json:"data"`
+} // @name SurveyThemeSummariesResponse
+
+func ToSurveyAnalytics(event *model.FeedbackEvent, minGroupSize int, groupBy string, distributions []surveyanalytics.Distribution, comparisons []surveyanalytics.Comparison) SurveyAnalytics {
+	rs := SurveyAnalytics{
+		EventID:       event.ID.String(),
+		Title:         event.Title,
+		Subtype:       string(event.Subtype),
+		StartDate:     event.StartDate,
+		MinGroupSize:  minGroupSize,
+		GroupBy:       groupBy,
+		Distributions: make([]SurveyQuestionDistribution, 0, len(distributions)),
+		Comparisons:   make([]SurveyQuestionComparison, 0, len(comparisons)),
+	}
+
+	for _, d := range distributions {
+		rs.Distributions = append(rs.Distributions, SurveyQuestionDistribution{
+			QuestionID:  d.QuestionID,
+			Content:     d.Content,
+			Respondents: d.Respondents,
+			Answers:     d.Answers,
+			Counts:      d.Counts,
+			Average:     d.Average,
+			Suppressed:  d.Suppressed,
+		})
+	}
+
+	for _, c := range comparisons {
+		groups := make([]SurveyTeamScore, 0, len(c.Groups))
+		for _, g := range c.Groups {
+			groups = append(groups, SurveyTeamScore{
+				Name:        g.Group,
+				Respondents: g.Respondents,
+				Average:     g.Average,
+				Suppressed:  g.Suppressed,
+			})
+		}
+		rs.Comparisons = append(rs.Comparisons, SurveyQuestionComparison{
+			QuestionID: c.QuestionID,
+			Content:    c.Content,
+			Groups:     groups,
+		})
+	}
+
+	return rs
+}
+
+func ToSurveyTrends(trends []surveyanalytics.Trend) []SurveyQuestionTrend {
+	rs := make([]SurveyQuestionTrend, 0, len(trends))
+	for _, t := range trends {
+		cycles := make([]SurveyCycleScore, 0, len(t.Points))
+		for _, p := range t.Points {
+			cycles = append(cycles, SurveyCycleScore{
+				EventID:     p.EventID,
+				Title:       p.EventTitle,
+				StartDate:   p.EventDate,
+				Respondents: p.Respondents,
+				Average:     p.Average,
+				Suppressed:  p.Suppressed,
+			})
+		}
+		rs = append(rs, SurveyQuestionTrend{
+			QuestionID: t.QuestionID,
+			Content:    t.Content,
+			Cycles:     cycles,
+		})
+	}
+	return rs
+}
+
+func ToSurveyThemeSummaries(summaries []*model.SurveyThemeSummary) []SurveyThemeSummary {
+	rs := make([]SurveyThemeSummary, 0, len(summaries))
+	for _, s := range summaries {
+		rs = append(rs, SurveyThemeSummary{
+			ID:          s.ID.String(),
+			QuestionID:  s.QuestionID.String(),
+			Content:     s.Content,
+			Summary:     s.Summary,
+			AnswerCount: s.AnswerCount,
+			Model:       s.Model,
+			UpdatedAt:   s.UpdatedAt,
+		})
+	}
+	return rs
+}
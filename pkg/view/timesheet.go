@@ -0,0 +1,164 @@
+package view
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dwarvesf/fortress-api/pkg/model"
+)
+
+type TimesheetEntry struct {
+	ID           string          `json:"id"`
+	Date         time.Time       `json:"date"`
+	Hours        decimal.Decimal `json:"hours"`
+	WorkUnitID   *string         `json:"workUnitID"`
+	WorkUnitName string          `json:"workUnitName"`
+	Description  string          `json:"description"`
+	InvoiceID    *string         `json:"invoiceID"`
+} // @name TimesheetEntry
+
+type Timesheet struct {
+	ID             string             `json:"id"`
+	Employee       *BasicEmployeeInfo `json:"employee"`
+	Project        *BasicProjectInfo  `json:"project"`
+	WeekStart      time.Time          `json:"weekStart"` // the Monday of the week
+	Status         string             `json:"status"`
+	TotalHours     decimal.Decimal    `json:"totalHours"`
+	Note           string             `json:"note"`
+	SubmittedAt    *time.Time         `json:"submittedAt"`
+	Reviewer       *BasicEmployeeInfo `json:"reviewer"`
+	ReviewedAt     *time.Time         `json:"reviewedAt"`
+	RejectedReason string             `json:"rejectedReason"`
+	LockedAt       *time.Time         `json:"lockedAt"`
+	CreatedAt      time.Time          `json:"createdAt"`
+} // @name Timesheet
+
+type TimesheetDetail struct {
+	Timesheet
+	Entries []TimesheetEntry `json:"entries"`
+} // @name TimesheetDetail
+
+type TimesheetBillableHours struct {
+	EmployeeID      string          `json:"employeeID"`
+	FullName        string          `json:"fullName"`
+	ProjectMemberID string          `json:"projectMemberID"`
+	Rate            decimal.Decimal `json:"rate"`
+	Hours           decimal.Decimal `json:"hours"`
+} // @name TimesheetBillableHours
+
+type TimesheetInvoiceLineItems struct {
+	Project   *BasicProjectInfo        `json:"project"`
+	Month     int                      `json:"month"`
+	Year      int                      `json:"year"`
+	Hours     []TimesheetBillableHours `json:"hours"`
+	LineItems []InvoiceItem            `json:"lineItems"`
+} // @name TimesheetInvoiceLineItems
+
+func ToTimesheet(t *model.Timesheet) *Timesheet {
+	if t == nil {
+		return nil
+	}
+
+	rs := &Timesheet{
+		ID:             t.ID.String(),
+		WeekStart:      t.WeekStart,
+		Status:         t.Status.String(),
+		TotalHours:     t.TotalHours(),
+		Note:           t.Note,
+		SubmittedAt:    t.SubmittedAt,
+		ReviewedAt:     t.ReviewedAt,
+		RejectedReason: t.RejectedReason,
+		LockedAt:       t.LockedAt,
+		CreatedAt:      t.CreatedAt,
+	}
+	if t.Employee != nil {
+		rs.Employee = toBasicEmployeeInfo(*t.Employee)
+	}
+	if t.Project != nil {
+		rs.Project = toBasicProjectInfo(*t.Project)
+	}
+	if t.Reviewer != nil {
+		rs.Reviewer = toBasicEmployeeInfo(*t.Reviewer)
+	}
+	return rs
+}
+
+func ToTimesheets(timesheets []*model.Timesheet) []Timesheet {
+	rs := make([]Timesheet, 0, len(timesheets))
+	for _, t := range timesheets {
+		rs = append(rs, *ToTimesheet(t))
+	}
+	return rs
+}
+
+func ToTimesheetDetail(t *model.Timesheet) *TimesheetDetail {
+	if t == nil {
+		return nil
+	}
+
+	rs := &TimesheetDetail{
+		Timesheet: *ToTimesheet(t),
+		Entries:   make([]TimesheetEntry, 0, len(t.Entries)),
+	}
+	for _, e := range t.Entries {
+		entry := TimesheetEntry{
+			ID:          e.ID.String(),
+			Date:        e.Date,
+			Hours:       e.Hours,
+			WorkUnitID:  uuidPtrToString(e.WorkUnitID),
+			Description: e.Description,
+			InvoiceID:   uuidPtrToString(e.InvoiceID),
+		}
+		if e.WorkUnit != nil {
+			entry.WorkUnitName = e.WorkUnit.Name
+		}
+		rs.Entries = append(rs.Entries, entry)
+	}
+	return rs
+}
+
+func ToTimesheetInvoiceLineItems(project *model.Project, year, month int, hours []model.TimesheetBillableHours, items []model.InvoiceItem) *TimesheetInvoiceLineItems {
+	rs := &TimesheetInvoiceLineItems{
+		Month:     month,
+		Year:      year,
+		Hours:     make([]TimesheetBillableHours, 0, len(hours)),
+		LineItems: make([]InvoiceItem, 0, len(items)),
+	}
+	if project != nil {
+		rs.Project = toBasicProjectInfo(*project)
+	}
+	for _, h := range hours {
+		rs.Hours = append(rs.Hours, TimesheetBillableHours{
+			EmployeeID:      h.EmployeeID,
+			FullName:        h.FullName,
+			ProjectMemberID: h.ProjectMemberID,
+			Rate:            h.Rate,
+			Hours:           h.Hours,
+		})
+	}
+	for _, item := range items {
+		rs.LineItems = append(rs.LineItems, InvoiceItem{
+			Quantity:    item.Quantity,
+			UnitCost:    item.UnitCost,
+			Discount:    item.Discount,
+			Cost:        item.Cost,
+			Description: item.Description,
+			IsExternal:  item.IsExternal,
+		})
+	}
+	return rs
+}
+
+type TimesheetsResponse struct {
+	PaginationResponse
+	Data []Timesheet `json:"data"`
+} // @name TimesheetsResponse
+
+type TimesheetDetailResponse struct {
+	Data *TimesheetDetail `json:"data"`
+} // @name TimesheetDetailResponse
+
+type TimesheetInvoiceLineItemsResponse struct {
+	Data *TimesheetInvoiceLineItems `json:"data"`
+} // @name TimesheetInvoiceLineItemsResponse